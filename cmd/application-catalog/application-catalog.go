@@ -39,6 +39,16 @@ func main() {
 	migrationsDir := flag.String("migrationsDir", "/usr/share/migrations", "directory containing database schema migrations")
 	defaultProjectUUID := flag.String("defaultProjectUUID", "28e65b24-522d-4462-9477-79d9c0bf6e8f", "default project UUID")
 	vaultServerAddress := flag.String("vaultServerAddress", "", "vault server address")
+	secretsBackend := flag.String("secretsBackend", "", "backend storing registry secrets; database, vault, kubernetes or file; vault if useSecretsService is set, database otherwise")
+	secretsKeyFile := flag.String("secretsKeyFile", "", "path to the key file used to encrypt registry secrets")
+	secretsNamespace := flag.String("secretsNamespace", "", "namespace of the registry secrets of the kubernetes backend; defaults to the namespace of the catalog")
+	secretsDir := flag.String("secretsDir", "", "directory of the registry secrets of the file backend")
+	watchQueueSize := flag.Int("watchQueueSize", northbound.ListenerQueueSize, "maximum number of events queued for each watcher")
+	watchOverflowPolicy := flag.String("watchOverflowPolicy", string(northbound.ListenerOverflowPolicy), "policy for watchers whose event queue is full; drop-oldest or disconnect")
+	watchRetention := flag.Duration("watchRetention", northbound.OutboxRetention, "period for which events are kept, within which watches may resume from their revision")
+	allowedDestinations := flag.String("allowedDestinations", "", "comma-separated networks, in CIDR notation, and host names that webhooks, registry connection tests and registry inventories may reach despite being internal")
+	metricsPort := flag.Int("metricsPort", 8082, "network port on which the metrics are served; zero disables them")
+	trashRetention := flag.Duration("trashRetention", northbound.TrashRetention, "period for which deleted entities are kept in the trash before they are purged")
 
 	ready := make(chan bool)
 	flag.Parse()
@@ -60,6 +70,25 @@ func main() {
 
 	northbound.UseSecretService = *useSecretsService
 	northbound.VaultServerAddress = *vaultServerAddress
+	err = northbound.ConfigureSecrets(northbound.SecretsConfig{
+		Backend:   *secretsBackend,
+		KeyFile:   *secretsKeyFile,
+		Namespace: *secretsNamespace,
+		Dir:       *secretsDir,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	northbound.ListenerQueueSize = *watchQueueSize
+	northbound.ListenerOverflowPolicy, err = northbound.ParseOverflowPolicy(*watchOverflowPolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = northbound.AllowDestinations(*allowedDestinations); err != nil {
+		log.Fatal(err)
+	}
+	northbound.OutboxRetention = *watchRetention
+	northbound.TrashRetention = *trashRetention
 
 	log.Info("Starting application-catalog")
 	version.LogVersion("  ")
@@ -81,6 +110,7 @@ func main() {
 		DatabaseDisableMigration: *databaseDisableMigration,
 		MigrationsDir:            *migrationsDir,
 		DefaultProjectUUID:       *defaultProjectUUID,
+		MetricsPort:              *metricsPort,
 	}
 
 	mgr := manager.NewManager(cfg)
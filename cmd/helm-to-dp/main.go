@@ -19,11 +19,13 @@ var (
 	namespace   string
 	includeAuth bool
 	rootCmd     = &cobra.Command{
-		Use:   "helm-to-dp <oci-url>",
+		Use:   "helm-to-dp <chart-url>",
 		Short: "Convert a Helm chart to a Deployment Package",
-		Long: `This tool converts OCI helm charts into Deployment Packages for use with
-the Edge Orchestrator. The tool will fetch the Helm chart from the specified OCI URL and
-validate that it is a valid Helm chart. The tool will then generate a Deployment Package
+		Long: `This tool converts helm charts into Deployment Packages for use with
+the Edge Orchestrator. The tool will fetch the Helm chart from the specified URL, either
+an OCI URL such as oci://registry-1.docker.io/bitnamicharts/wordpress:1.2.3 or the URL
+of a chart in a classic Helm repository such as https://charts.example.com/stable/wordpress:1.2.3,
+and validate that it is a valid Helm chart. The tool will then generate a Deployment Package
 from the Helm chart and store that package in an output directory`,
 	}
 )
@@ -42,7 +44,7 @@ func mainCommand(cmd *cobra.Command, args []string) {
 
 	url := args[0]
 
-	helm, err := helm.FetchHelmChartOCI(url, username, password)
+	helm, err := helm.FetchHelmChart(url, username, password)
 	verboseerror.FatalErrCheck(err, "Failed to fetch Helm chart: %v", err)
 
 	err = dp.GenerateDeploymentPackage(helm, valuesFile, outputDir, namespace, includeAuth)
@@ -0,0 +1,8 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package catalogv3
+
+ListAuditEventsRequest {
+    hasReadAccess
+}
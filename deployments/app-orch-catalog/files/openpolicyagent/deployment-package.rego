@@ -25,6 +25,18 @@ GetDeploymentPackageVersionsRequest {
     hasReadAccess
 }
 
+ResolveDeploymentPackageRequest {
+    hasReadAccess
+}
+
+ExportDeploymentPackageRequest {
+    hasReadAccess
+}
+
+ExportDeploymentPackageWithSensitiveInfoRequest {
+    hasReadAccess
+}
+
 ListDeploymentPackagesRequest {
     hasReadAccess
 }
@@ -38,3 +38,15 @@ WatchRegistriesWithSensitiveInfoRequest {
 WatchRegistriesRequest {
     hasReadAccess
 }
+
+TestRegistryConnectionRequest {
+    hasWriteAccess
+}
+
+ListRegistryChartsRequest {
+    hasReadAccess
+}
+
+ListChartVersionsRequest {
+    hasReadAccess
+}
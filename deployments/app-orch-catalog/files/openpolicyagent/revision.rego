@@ -0,0 +1,16 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package catalogv3
+
+ListRevisionsRequest {
+    hasReadAccess
+}
+
+GetRevisionRequest {
+    hasReadAccess
+}
+
+RestoreRevisionRequest {
+    hasWriteAccess
+}
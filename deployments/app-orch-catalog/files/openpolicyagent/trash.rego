@@ -0,0 +1,32 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package catalogv3
+
+ListDeletedRequest {
+    hasReadAccess
+}
+
+RestoreRegistryRequest {
+    hasWriteAccess
+}
+
+RestoreApplicationRequest {
+    hasWriteAccess
+}
+
+RestoreDeploymentPackageRequest {
+    hasWriteAccess
+}
+
+PurgeRegistryRequest {
+    hasWriteAccess
+}
+
+PurgeApplicationRequest {
+    hasWriteAccess
+}
+
+PurgeDeploymentPackageRequest {
+    hasWriteAccess
+}
@@ -0,0 +1,28 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package catalogv3
+
+CreateWebhookRequest {
+    hasWriteAccess
+}
+
+UpdateWebhookRequest {
+    hasWriteAccess
+}
+
+DeleteWebhookRequest {
+    hasWriteAccess
+}
+
+GetWebhookRequest {
+    hasReadAccess
+}
+
+ListWebhooksRequest {
+    hasReadAccess
+}
+
+ListWebhookDeliveriesRequest {
+    hasReadAccess
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/shared/verboseerror"
 	"gopkg.in/yaml.v2"
 	"os"
+	"strings"
 )
 
 const (
@@ -171,9 +172,13 @@ func GenerateDeploymentPackage(helm helm.HelmInfo, valuesFile string, outputDir
 		return &OutputError{Helm: helm, OutputDir: outputDir, Msg: "Failed to write deployment package YAML to file", Err: err}
 	}
 
+	description := "OCI registry for " + name
+	if !strings.HasPrefix(helm.OCIRegistry, "oci://") {
+		description = "Helm repository for " + name
+	}
 	registry := Registry{
 		Name:        registryName,
-		Description: "OCI registry for " + name,
+		Description: description,
 		Type:        "HELM",
 		RootURL:     helm.OCIRegistry,
 	}
@@ -233,3 +233,23 @@ defaultNamespaces:
 `
 	assert.Equal(t, expectedDPContent, string(dpContent))
 }
+
+func TestGenerateDeploymentPackageFromHelmRepository(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "test-deployment-package")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	h := helm.HelmInfo{
+		Name:        "test",
+		Version:     "1.0.0",
+		OCIRegistry: "https://charts.example.com/stable",
+	}
+
+	err = GenerateDeploymentPackage(h, "", tempDir, "", false)
+	assert.NoError(t, err)
+
+	regContent, err := os.ReadFile(fmt.Sprintf("%s/%s-registry.yaml", tempDir, h.Name))
+	assert.NoError(t, err)
+	assert.Contains(t, string(regContent), "description: Helm repository for test\n")
+	assert.Contains(t, string(regContent), "rootUrl: https://charts.example.com/stable\n")
+}
@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -31,6 +32,8 @@ type Application struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// The last update timestamp.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Opaque entity tag; changes whenever the entity is updated.
+	Etag int64 `json:"etag,omitempty"`
 	// UUID of the owner project.
 	ProjectUUID string `json:"project_uuid,omitempty"`
 	// Application version.
@@ -39,8 +42,24 @@ type Application struct {
 	ChartName string `json:"chart_name,omitempty"`
 	// A chart version.
 	ChartVersion string `json:"chart_version,omitempty"`
+	// Form of the version that orders semantic versions by precedence when compared bytewise.
+	VersionKey string `json:"version_key,omitempty"`
+	// Form of the chart version that orders semantic versions by precedence when compared bytewise.
+	ChartVersionKey string `json:"chart_version_key,omitempty"`
 	// Application kind; normal, addon, extension.
 	Kind string `json:"kind,omitempty"`
+	// Descriptions of the profiles, by which the application is searched along with its own columns.
+	SearchText string `json:"search_text,omitempty"`
+	// Labels of the Application, by which it is selected.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Indicates whether the Application version is deprecated.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Message explaining why the Application version is deprecated.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+	// Version suggested to be used in place of the deprecated one.
+	ReplacementVersion string `json:"replacement_version,omitempty"`
+	// The time at which the Application version was deprecated.
+	DeprecateTime *time.Time `json:"deprecate_time,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ApplicationQuery when eager-loading is set.
 	Edges                       ApplicationEdges `json:"edges"`
@@ -162,11 +181,15 @@ func (*Application) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case application.FieldID:
+		case application.FieldLabels:
+			values[i] = new([]byte)
+		case application.FieldDeprecated:
+			values[i] = new(sql.NullBool)
+		case application.FieldID, application.FieldEtag:
 			values[i] = new(sql.NullInt64)
-		case application.FieldName, application.FieldDisplayName, application.FieldDisplayNameLc, application.FieldDescription, application.FieldProjectUUID, application.FieldVersion, application.FieldChartName, application.FieldChartVersion, application.FieldKind:
+		case application.FieldName, application.FieldDisplayName, application.FieldDisplayNameLc, application.FieldDescription, application.FieldProjectUUID, application.FieldVersion, application.FieldChartName, application.FieldChartVersion, application.FieldVersionKey, application.FieldChartVersionKey, application.FieldKind, application.FieldSearchText, application.FieldDeprecationMessage, application.FieldReplacementVersion:
 			values[i] = new(sql.NullString)
-		case application.FieldCreateTime, application.FieldUpdateTime:
+		case application.FieldCreateTime, application.FieldUpdateTime, application.FieldDeprecateTime:
 			values[i] = new(sql.NullTime)
 		case application.ForeignKeys[0]: // application_default_profile
 			values[i] = new(sql.NullInt64)
@@ -231,6 +254,12 @@ func (a *Application) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdateTime = value.Time
 			}
+		case application.FieldEtag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				a.Etag = value.Int64
+			}
 		case application.FieldProjectUUID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
@@ -255,12 +284,63 @@ func (a *Application) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.ChartVersion = value.String
 			}
+		case application.FieldVersionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_key", values[i])
+			} else if value.Valid {
+				a.VersionKey = value.String
+			}
+		case application.FieldChartVersionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chart_version_key", values[i])
+			} else if value.Valid {
+				a.ChartVersionKey = value.String
+			}
 		case application.FieldKind:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field kind", values[i])
 			} else if value.Valid {
 				a.Kind = value.String
 			}
+		case application.FieldSearchText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_text", values[i])
+			} else if value.Valid {
+				a.SearchText = value.String
+			}
+		case application.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case application.FieldDeprecated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecated", values[i])
+			} else if value.Valid {
+				a.Deprecated = value.Bool
+			}
+		case application.FieldDeprecationMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecation_message", values[i])
+			} else if value.Valid {
+				a.DeprecationMessage = value.String
+			}
+		case application.FieldReplacementVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement_version", values[i])
+			} else if value.Valid {
+				a.ReplacementVersion = value.String
+			}
+		case application.FieldDeprecateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecate_time", values[i])
+			} else if value.Valid {
+				a.DeprecateTime = new(time.Time)
+				*a.DeprecateTime = value.Time
+			}
 		case application.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field application_default_profile", value)
@@ -376,6 +456,9 @@ func (a *Application) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(fmt.Sprintf("%v", a.Etag))
+	builder.WriteString(", ")
 	builder.WriteString("project_uuid=")
 	builder.WriteString(a.ProjectUUID)
 	builder.WriteString(", ")
@@ -388,8 +471,34 @@ func (a *Application) String() string {
 	builder.WriteString("chart_version=")
 	builder.WriteString(a.ChartVersion)
 	builder.WriteString(", ")
+	builder.WriteString("version_key=")
+	builder.WriteString(a.VersionKey)
+	builder.WriteString(", ")
+	builder.WriteString("chart_version_key=")
+	builder.WriteString(a.ChartVersionKey)
+	builder.WriteString(", ")
 	builder.WriteString("kind=")
 	builder.WriteString(a.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("search_text=")
+	builder.WriteString(a.SearchText)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", a.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("deprecated=")
+	builder.WriteString(fmt.Sprintf("%v", a.Deprecated))
+	builder.WriteString(", ")
+	builder.WriteString("deprecation_message=")
+	builder.WriteString(a.DeprecationMessage)
+	builder.WriteString(", ")
+	builder.WriteString("replacement_version=")
+	builder.WriteString(a.ReplacementVersion)
+	builder.WriteString(", ")
+	if v := a.DeprecateTime; v != nil {
+		builder.WriteString("deprecate_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
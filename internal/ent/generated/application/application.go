@@ -26,6 +26,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
 	// FieldProjectUUID holds the string denoting the project_uuid field in the database.
 	FieldProjectUUID = "project_uuid"
 	// FieldVersion holds the string denoting the version field in the database.
@@ -34,8 +36,24 @@ const (
 	FieldChartName = "chart_name"
 	// FieldChartVersion holds the string denoting the chart_version field in the database.
 	FieldChartVersion = "chart_version"
+	// FieldVersionKey holds the string denoting the version_key field in the database.
+	FieldVersionKey = "version_key"
+	// FieldChartVersionKey holds the string denoting the chart_version_key field in the database.
+	FieldChartVersionKey = "chart_version_key"
 	// FieldKind holds the string denoting the kind field in the database.
 	FieldKind = "kind"
+	// FieldSearchText holds the string denoting the search_text field in the database.
+	FieldSearchText = "search_text"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldDeprecated holds the string denoting the deprecated field in the database.
+	FieldDeprecated = "deprecated"
+	// FieldDeprecationMessage holds the string denoting the deprecation_message field in the database.
+	FieldDeprecationMessage = "deprecation_message"
+	// FieldReplacementVersion holds the string denoting the replacement_version field in the database.
+	FieldReplacementVersion = "replacement_version"
+	// FieldDeprecateTime holds the string denoting the deprecate_time field in the database.
+	FieldDeprecateTime = "deprecate_time"
 	// EdgeProfiles holds the string denoting the profiles edge name in mutations.
 	EdgeProfiles = "profiles"
 	// EdgeRegistryFk holds the string denoting the registry_fk edge name in mutations.
@@ -119,11 +137,20 @@ var Columns = []string{
 	FieldDescription,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldEtag,
 	FieldProjectUUID,
 	FieldVersion,
 	FieldChartName,
 	FieldChartVersion,
+	FieldVersionKey,
+	FieldChartVersionKey,
 	FieldKind,
+	FieldSearchText,
+	FieldLabels,
+	FieldDeprecated,
+	FieldDeprecationMessage,
+	FieldReplacementVersion,
+	FieldDeprecateTime,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "applications"
@@ -162,8 +189,14 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultEtag holds the default value on creation for the "etag" field.
+	DefaultEtag func() int64
+	// UpdateDefaultEtag holds the default value on update for the "etag" field.
+	UpdateDefaultEtag func() int64
 	// DefaultProjectUUID holds the default value on creation for the "project_uuid" field.
 	DefaultProjectUUID string
+	// DefaultDeprecated holds the default value on creation for the "deprecated" field.
+	DefaultDeprecated bool
 )
 
 // OrderOption defines the ordering options for the Application queries.
@@ -204,6 +237,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByEtag orders the results by the etag field.
+func ByEtag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEtag, opts...).ToFunc()
+}
+
 // ByProjectUUID orders the results by the project_uuid field.
 func ByProjectUUID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectUUID, opts...).ToFunc()
@@ -224,11 +262,46 @@ func ByChartVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChartVersion, opts...).ToFunc()
 }
 
+// ByVersionKey orders the results by the version_key field.
+func ByVersionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionKey, opts...).ToFunc()
+}
+
+// ByChartVersionKey orders the results by the chart_version_key field.
+func ByChartVersionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChartVersionKey, opts...).ToFunc()
+}
+
 // ByKind orders the results by the kind field.
 func ByKind(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKind, opts...).ToFunc()
 }
 
+// BySearchText orders the results by the search_text field.
+func BySearchText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchText, opts...).ToFunc()
+}
+
+// ByDeprecated orders the results by the deprecated field.
+func ByDeprecated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecated, opts...).ToFunc()
+}
+
+// ByDeprecationMessage orders the results by the deprecation_message field.
+func ByDeprecationMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecationMessage, opts...).ToFunc()
+}
+
+// ByReplacementVersion orders the results by the replacement_version field.
+func ByReplacementVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacementVersion, opts...).ToFunc()
+}
+
+// ByDeprecateTime orders the results by the deprecate_time field.
+func ByDeprecateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecateTime, opts...).ToFunc()
+}
+
 // ByProfilesCount orders the results by profiles count.
 func ByProfilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
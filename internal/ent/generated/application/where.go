@@ -85,6 +85,11 @@ func UpdateTime(v time.Time) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v int64) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldEtag, v))
+}
+
 // ProjectUUID applies equality check predicate on the "project_uuid" field. It's identical to ProjectUUIDEQ.
 func ProjectUUID(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldProjectUUID, v))
@@ -105,11 +110,46 @@ func ChartVersion(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldChartVersion, v))
 }
 
+// VersionKey applies equality check predicate on the "version_key" field. It's identical to VersionKeyEQ.
+func VersionKey(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldVersionKey, v))
+}
+
+// ChartVersionKey applies equality check predicate on the "chart_version_key" field. It's identical to ChartVersionKeyEQ.
+func ChartVersionKey(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldChartVersionKey, v))
+}
+
 // Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
 func Kind(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldKind, v))
 }
 
+// SearchText applies equality check predicate on the "search_text" field. It's identical to SearchTextEQ.
+func SearchText(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldSearchText, v))
+}
+
+// Deprecated applies equality check predicate on the "deprecated" field. It's identical to DeprecatedEQ.
+func Deprecated(v bool) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// DeprecationMessage applies equality check predicate on the "deprecation_message" field. It's identical to DeprecationMessageEQ.
+func DeprecationMessage(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldDeprecationMessage, v))
+}
+
+// ReplacementVersion applies equality check predicate on the "replacement_version" field. It's identical to ReplacementVersionEQ.
+func ReplacementVersion(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldReplacementVersion, v))
+}
+
+// DeprecateTime applies equality check predicate on the "deprecate_time" field. It's identical to DeprecateTimeEQ.
+func DeprecateTime(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldDeprecateTime, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldName, v))
@@ -480,6 +520,56 @@ func UpdateTimeLTE(v time.Time) predicate.Application {
 	return predicate.Application(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v int64) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v int64) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...int64) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...int64) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v int64) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v int64) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v int64) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v int64) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldEtag))
+}
+
 // ProjectUUIDEQ applies the EQ predicate on the "project_uuid" field.
 func ProjectUUIDEQ(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldProjectUUID, v))
@@ -740,6 +830,156 @@ func ChartVersionContainsFold(v string) predicate.Application {
 	return predicate.Application(sql.FieldContainsFold(FieldChartVersion, v))
 }
 
+// VersionKeyEQ applies the EQ predicate on the "version_key" field.
+func VersionKeyEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldVersionKey, v))
+}
+
+// VersionKeyNEQ applies the NEQ predicate on the "version_key" field.
+func VersionKeyNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldVersionKey, v))
+}
+
+// VersionKeyIn applies the In predicate on the "version_key" field.
+func VersionKeyIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldVersionKey, vs...))
+}
+
+// VersionKeyNotIn applies the NotIn predicate on the "version_key" field.
+func VersionKeyNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldVersionKey, vs...))
+}
+
+// VersionKeyGT applies the GT predicate on the "version_key" field.
+func VersionKeyGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldVersionKey, v))
+}
+
+// VersionKeyGTE applies the GTE predicate on the "version_key" field.
+func VersionKeyGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldVersionKey, v))
+}
+
+// VersionKeyLT applies the LT predicate on the "version_key" field.
+func VersionKeyLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldVersionKey, v))
+}
+
+// VersionKeyLTE applies the LTE predicate on the "version_key" field.
+func VersionKeyLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldVersionKey, v))
+}
+
+// VersionKeyContains applies the Contains predicate on the "version_key" field.
+func VersionKeyContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldVersionKey, v))
+}
+
+// VersionKeyHasPrefix applies the HasPrefix predicate on the "version_key" field.
+func VersionKeyHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldVersionKey, v))
+}
+
+// VersionKeyHasSuffix applies the HasSuffix predicate on the "version_key" field.
+func VersionKeyHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldVersionKey, v))
+}
+
+// VersionKeyIsNil applies the IsNil predicate on the "version_key" field.
+func VersionKeyIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldVersionKey))
+}
+
+// VersionKeyNotNil applies the NotNil predicate on the "version_key" field.
+func VersionKeyNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldVersionKey))
+}
+
+// VersionKeyEqualFold applies the EqualFold predicate on the "version_key" field.
+func VersionKeyEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldVersionKey, v))
+}
+
+// VersionKeyContainsFold applies the ContainsFold predicate on the "version_key" field.
+func VersionKeyContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldVersionKey, v))
+}
+
+// ChartVersionKeyEQ applies the EQ predicate on the "chart_version_key" field.
+func ChartVersionKeyEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyNEQ applies the NEQ predicate on the "chart_version_key" field.
+func ChartVersionKeyNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyIn applies the In predicate on the "chart_version_key" field.
+func ChartVersionKeyIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldChartVersionKey, vs...))
+}
+
+// ChartVersionKeyNotIn applies the NotIn predicate on the "chart_version_key" field.
+func ChartVersionKeyNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldChartVersionKey, vs...))
+}
+
+// ChartVersionKeyGT applies the GT predicate on the "chart_version_key" field.
+func ChartVersionKeyGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyGTE applies the GTE predicate on the "chart_version_key" field.
+func ChartVersionKeyGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyLT applies the LT predicate on the "chart_version_key" field.
+func ChartVersionKeyLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyLTE applies the LTE predicate on the "chart_version_key" field.
+func ChartVersionKeyLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyContains applies the Contains predicate on the "chart_version_key" field.
+func ChartVersionKeyContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyHasPrefix applies the HasPrefix predicate on the "chart_version_key" field.
+func ChartVersionKeyHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyHasSuffix applies the HasSuffix predicate on the "chart_version_key" field.
+func ChartVersionKeyHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyIsNil applies the IsNil predicate on the "chart_version_key" field.
+func ChartVersionKeyIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldChartVersionKey))
+}
+
+// ChartVersionKeyNotNil applies the NotNil predicate on the "chart_version_key" field.
+func ChartVersionKeyNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldChartVersionKey))
+}
+
+// ChartVersionKeyEqualFold applies the EqualFold predicate on the "chart_version_key" field.
+func ChartVersionKeyEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldChartVersionKey, v))
+}
+
+// ChartVersionKeyContainsFold applies the ContainsFold predicate on the "chart_version_key" field.
+func ChartVersionKeyContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldChartVersionKey, v))
+}
+
 // KindEQ applies the EQ predicate on the "kind" field.
 func KindEQ(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldKind, v))
@@ -815,6 +1055,301 @@ func KindContainsFold(v string) predicate.Application {
 	return predicate.Application(sql.FieldContainsFold(FieldKind, v))
 }
 
+// SearchTextEQ applies the EQ predicate on the "search_text" field.
+func SearchTextEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldSearchText, v))
+}
+
+// SearchTextNEQ applies the NEQ predicate on the "search_text" field.
+func SearchTextNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldSearchText, v))
+}
+
+// SearchTextIn applies the In predicate on the "search_text" field.
+func SearchTextIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldSearchText, vs...))
+}
+
+// SearchTextNotIn applies the NotIn predicate on the "search_text" field.
+func SearchTextNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldSearchText, vs...))
+}
+
+// SearchTextGT applies the GT predicate on the "search_text" field.
+func SearchTextGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldSearchText, v))
+}
+
+// SearchTextGTE applies the GTE predicate on the "search_text" field.
+func SearchTextGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldSearchText, v))
+}
+
+// SearchTextLT applies the LT predicate on the "search_text" field.
+func SearchTextLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldSearchText, v))
+}
+
+// SearchTextLTE applies the LTE predicate on the "search_text" field.
+func SearchTextLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldSearchText, v))
+}
+
+// SearchTextContains applies the Contains predicate on the "search_text" field.
+func SearchTextContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldSearchText, v))
+}
+
+// SearchTextHasPrefix applies the HasPrefix predicate on the "search_text" field.
+func SearchTextHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldSearchText, v))
+}
+
+// SearchTextHasSuffix applies the HasSuffix predicate on the "search_text" field.
+func SearchTextHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldSearchText, v))
+}
+
+// SearchTextIsNil applies the IsNil predicate on the "search_text" field.
+func SearchTextIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldSearchText))
+}
+
+// SearchTextNotNil applies the NotNil predicate on the "search_text" field.
+func SearchTextNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldSearchText))
+}
+
+// SearchTextEqualFold applies the EqualFold predicate on the "search_text" field.
+func SearchTextEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldSearchText, v))
+}
+
+// SearchTextContainsFold applies the ContainsFold predicate on the "search_text" field.
+func SearchTextContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldSearchText, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldLabels))
+}
+
+// DeprecatedEQ applies the EQ predicate on the "deprecated" field.
+func DeprecatedEQ(v bool) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// DeprecatedNEQ applies the NEQ predicate on the "deprecated" field.
+func DeprecatedNEQ(v bool) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldDeprecated, v))
+}
+
+// DeprecationMessageEQ applies the EQ predicate on the "deprecation_message" field.
+func DeprecationMessageEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageNEQ applies the NEQ predicate on the "deprecation_message" field.
+func DeprecationMessageNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageIn applies the In predicate on the "deprecation_message" field.
+func DeprecationMessageIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldDeprecationMessage, vs...))
+}
+
+// DeprecationMessageNotIn applies the NotIn predicate on the "deprecation_message" field.
+func DeprecationMessageNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldDeprecationMessage, vs...))
+}
+
+// DeprecationMessageGT applies the GT predicate on the "deprecation_message" field.
+func DeprecationMessageGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageGTE applies the GTE predicate on the "deprecation_message" field.
+func DeprecationMessageGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageLT applies the LT predicate on the "deprecation_message" field.
+func DeprecationMessageLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageLTE applies the LTE predicate on the "deprecation_message" field.
+func DeprecationMessageLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageContains applies the Contains predicate on the "deprecation_message" field.
+func DeprecationMessageContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageHasPrefix applies the HasPrefix predicate on the "deprecation_message" field.
+func DeprecationMessageHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageHasSuffix applies the HasSuffix predicate on the "deprecation_message" field.
+func DeprecationMessageHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageIsNil applies the IsNil predicate on the "deprecation_message" field.
+func DeprecationMessageIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldDeprecationMessage))
+}
+
+// DeprecationMessageNotNil applies the NotNil predicate on the "deprecation_message" field.
+func DeprecationMessageNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldDeprecationMessage))
+}
+
+// DeprecationMessageEqualFold applies the EqualFold predicate on the "deprecation_message" field.
+func DeprecationMessageEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageContainsFold applies the ContainsFold predicate on the "deprecation_message" field.
+func DeprecationMessageContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldDeprecationMessage, v))
+}
+
+// ReplacementVersionEQ applies the EQ predicate on the "replacement_version" field.
+func ReplacementVersionEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionNEQ applies the NEQ predicate on the "replacement_version" field.
+func ReplacementVersionNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionIn applies the In predicate on the "replacement_version" field.
+func ReplacementVersionIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldReplacementVersion, vs...))
+}
+
+// ReplacementVersionNotIn applies the NotIn predicate on the "replacement_version" field.
+func ReplacementVersionNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldReplacementVersion, vs...))
+}
+
+// ReplacementVersionGT applies the GT predicate on the "replacement_version" field.
+func ReplacementVersionGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionGTE applies the GTE predicate on the "replacement_version" field.
+func ReplacementVersionGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionLT applies the LT predicate on the "replacement_version" field.
+func ReplacementVersionLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionLTE applies the LTE predicate on the "replacement_version" field.
+func ReplacementVersionLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionContains applies the Contains predicate on the "replacement_version" field.
+func ReplacementVersionContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionHasPrefix applies the HasPrefix predicate on the "replacement_version" field.
+func ReplacementVersionHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionHasSuffix applies the HasSuffix predicate on the "replacement_version" field.
+func ReplacementVersionHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionIsNil applies the IsNil predicate on the "replacement_version" field.
+func ReplacementVersionIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldReplacementVersion))
+}
+
+// ReplacementVersionNotNil applies the NotNil predicate on the "replacement_version" field.
+func ReplacementVersionNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldReplacementVersion))
+}
+
+// ReplacementVersionEqualFold applies the EqualFold predicate on the "replacement_version" field.
+func ReplacementVersionEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionContainsFold applies the ContainsFold predicate on the "replacement_version" field.
+func ReplacementVersionContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldReplacementVersion, v))
+}
+
+// DeprecateTimeEQ applies the EQ predicate on the "deprecate_time" field.
+func DeprecateTimeEQ(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeNEQ applies the NEQ predicate on the "deprecate_time" field.
+func DeprecateTimeNEQ(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeIn applies the In predicate on the "deprecate_time" field.
+func DeprecateTimeIn(vs ...time.Time) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldDeprecateTime, vs...))
+}
+
+// DeprecateTimeNotIn applies the NotIn predicate on the "deprecate_time" field.
+func DeprecateTimeNotIn(vs ...time.Time) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldDeprecateTime, vs...))
+}
+
+// DeprecateTimeGT applies the GT predicate on the "deprecate_time" field.
+func DeprecateTimeGT(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeGTE applies the GTE predicate on the "deprecate_time" field.
+func DeprecateTimeGTE(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeLT applies the LT predicate on the "deprecate_time" field.
+func DeprecateTimeLT(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeLTE applies the LTE predicate on the "deprecate_time" field.
+func DeprecateTimeLTE(v time.Time) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeIsNil applies the IsNil predicate on the "deprecate_time" field.
+func DeprecateTimeIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldDeprecateTime))
+}
+
+// DeprecateTimeNotNil applies the NotNil predicate on the "deprecate_time" field.
+func DeprecateTimeNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldDeprecateTime))
+}
+
 // HasProfiles applies the HasEdge predicate on the "profiles" edge.
 func HasProfiles() predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
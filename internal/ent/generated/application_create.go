@@ -101,6 +101,20 @@ func (ac *ApplicationCreate) SetNillableUpdateTime(t *time.Time) *ApplicationCre
 	return ac
 }
 
+// SetEtag sets the "etag" field.
+func (ac *ApplicationCreate) SetEtag(i int64) *ApplicationCreate {
+	ac.mutation.SetEtag(i)
+	return ac
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableEtag(i *int64) *ApplicationCreate {
+	if i != nil {
+		ac.SetEtag(*i)
+	}
+	return ac
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (ac *ApplicationCreate) SetProjectUUID(s string) *ApplicationCreate {
 	ac.mutation.SetProjectUUID(s)
@@ -133,6 +147,34 @@ func (ac *ApplicationCreate) SetChartVersion(s string) *ApplicationCreate {
 	return ac
 }
 
+// SetVersionKey sets the "version_key" field.
+func (ac *ApplicationCreate) SetVersionKey(s string) *ApplicationCreate {
+	ac.mutation.SetVersionKey(s)
+	return ac
+}
+
+// SetNillableVersionKey sets the "version_key" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableVersionKey(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetVersionKey(*s)
+	}
+	return ac
+}
+
+// SetChartVersionKey sets the "chart_version_key" field.
+func (ac *ApplicationCreate) SetChartVersionKey(s string) *ApplicationCreate {
+	ac.mutation.SetChartVersionKey(s)
+	return ac
+}
+
+// SetNillableChartVersionKey sets the "chart_version_key" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableChartVersionKey(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetChartVersionKey(*s)
+	}
+	return ac
+}
+
 // SetKind sets the "kind" field.
 func (ac *ApplicationCreate) SetKind(s string) *ApplicationCreate {
 	ac.mutation.SetKind(s)
@@ -147,6 +189,82 @@ func (ac *ApplicationCreate) SetNillableKind(s *string) *ApplicationCreate {
 	return ac
 }
 
+// SetSearchText sets the "search_text" field.
+func (ac *ApplicationCreate) SetSearchText(s string) *ApplicationCreate {
+	ac.mutation.SetSearchText(s)
+	return ac
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableSearchText(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetSearchText(*s)
+	}
+	return ac
+}
+
+// SetLabels sets the "labels" field.
+func (ac *ApplicationCreate) SetLabels(m map[string]string) *ApplicationCreate {
+	ac.mutation.SetLabels(m)
+	return ac
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (ac *ApplicationCreate) SetDeprecated(b bool) *ApplicationCreate {
+	ac.mutation.SetDeprecated(b)
+	return ac
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableDeprecated(b *bool) *ApplicationCreate {
+	if b != nil {
+		ac.SetDeprecated(*b)
+	}
+	return ac
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (ac *ApplicationCreate) SetDeprecationMessage(s string) *ApplicationCreate {
+	ac.mutation.SetDeprecationMessage(s)
+	return ac
+}
+
+// SetNillableDeprecationMessage sets the "deprecation_message" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableDeprecationMessage(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetDeprecationMessage(*s)
+	}
+	return ac
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (ac *ApplicationCreate) SetReplacementVersion(s string) *ApplicationCreate {
+	ac.mutation.SetReplacementVersion(s)
+	return ac
+}
+
+// SetNillableReplacementVersion sets the "replacement_version" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableReplacementVersion(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetReplacementVersion(*s)
+	}
+	return ac
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (ac *ApplicationCreate) SetDeprecateTime(t time.Time) *ApplicationCreate {
+	ac.mutation.SetDeprecateTime(t)
+	return ac
+}
+
+// SetNillableDeprecateTime sets the "deprecate_time" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableDeprecateTime(t *time.Time) *ApplicationCreate {
+	if t != nil {
+		ac.SetDeprecateTime(*t)
+	}
+	return ac
+}
+
 // AddProfileIDs adds the "profiles" edge to the Profile entity by IDs.
 func (ac *ApplicationCreate) AddProfileIDs(ids ...uint64) *ApplicationCreate {
 	ac.mutation.AddProfileIDs(ids...)
@@ -314,10 +432,18 @@ func (ac *ApplicationCreate) defaults() {
 		v := application.DefaultUpdateTime()
 		ac.mutation.SetUpdateTime(v)
 	}
+	if _, ok := ac.mutation.Etag(); !ok {
+		v := application.DefaultEtag()
+		ac.mutation.SetEtag(v)
+	}
 	if _, ok := ac.mutation.ProjectUUID(); !ok {
 		v := application.DefaultProjectUUID
 		ac.mutation.SetProjectUUID(v)
 	}
+	if _, ok := ac.mutation.Deprecated(); !ok {
+		v := application.DefaultDeprecated
+		ac.mutation.SetDeprecated(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -343,6 +469,9 @@ func (ac *ApplicationCreate) check() error {
 	if _, ok := ac.mutation.ChartVersion(); !ok {
 		return &ValidationError{Name: "chart_version", err: errors.New(`generated: missing required field "Application.chart_version"`)}
 	}
+	if _, ok := ac.mutation.Deprecated(); !ok {
+		return &ValidationError{Name: "deprecated", err: errors.New(`generated: missing required field "Application.deprecated"`)}
+	}
 	if _, ok := ac.mutation.RegistryFkID(); !ok {
 		return &ValidationError{Name: "registry_fk", err: errors.New(`generated: missing required edge "Application.registry_fk"`)}
 	}
@@ -396,6 +525,10 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		_spec.SetField(application.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := ac.mutation.Etag(); ok {
+		_spec.SetField(application.FieldEtag, field.TypeInt64, value)
+		_node.Etag = value
+	}
 	if value, ok := ac.mutation.ProjectUUID(); ok {
 		_spec.SetField(application.FieldProjectUUID, field.TypeString, value)
 		_node.ProjectUUID = value
@@ -412,10 +545,42 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		_spec.SetField(application.FieldChartVersion, field.TypeString, value)
 		_node.ChartVersion = value
 	}
+	if value, ok := ac.mutation.VersionKey(); ok {
+		_spec.SetField(application.FieldVersionKey, field.TypeString, value)
+		_node.VersionKey = value
+	}
+	if value, ok := ac.mutation.ChartVersionKey(); ok {
+		_spec.SetField(application.FieldChartVersionKey, field.TypeString, value)
+		_node.ChartVersionKey = value
+	}
 	if value, ok := ac.mutation.Kind(); ok {
 		_spec.SetField(application.FieldKind, field.TypeString, value)
 		_node.Kind = value
 	}
+	if value, ok := ac.mutation.SearchText(); ok {
+		_spec.SetField(application.FieldSearchText, field.TypeString, value)
+		_node.SearchText = value
+	}
+	if value, ok := ac.mutation.Labels(); ok {
+		_spec.SetField(application.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := ac.mutation.Deprecated(); ok {
+		_spec.SetField(application.FieldDeprecated, field.TypeBool, value)
+		_node.Deprecated = value
+	}
+	if value, ok := ac.mutation.DeprecationMessage(); ok {
+		_spec.SetField(application.FieldDeprecationMessage, field.TypeString, value)
+		_node.DeprecationMessage = value
+	}
+	if value, ok := ac.mutation.ReplacementVersion(); ok {
+		_spec.SetField(application.FieldReplacementVersion, field.TypeString, value)
+		_node.ReplacementVersion = value
+	}
+	if value, ok := ac.mutation.DeprecateTime(); ok {
+		_spec.SetField(application.FieldDeprecateTime, field.TypeTime, value)
+		_node.DeprecateTime = &value
+	}
 	if nodes := ac.mutation.ProfilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -113,6 +113,25 @@ func (au *ApplicationUpdate) SetUpdateTime(t time.Time) *ApplicationUpdate {
 	return au
 }
 
+// SetEtag sets the "etag" field.
+func (au *ApplicationUpdate) SetEtag(i int64) *ApplicationUpdate {
+	au.mutation.ResetEtag()
+	au.mutation.SetEtag(i)
+	return au
+}
+
+// AddEtag adds i to the "etag" field.
+func (au *ApplicationUpdate) AddEtag(i int64) *ApplicationUpdate {
+	au.mutation.AddEtag(i)
+	return au
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (au *ApplicationUpdate) ClearEtag() *ApplicationUpdate {
+	au.mutation.ClearEtag()
+	return au
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (au *ApplicationUpdate) SetProjectUUID(s string) *ApplicationUpdate {
 	au.mutation.SetProjectUUID(s)
@@ -169,6 +188,46 @@ func (au *ApplicationUpdate) SetNillableChartVersion(s *string) *ApplicationUpda
 	return au
 }
 
+// SetVersionKey sets the "version_key" field.
+func (au *ApplicationUpdate) SetVersionKey(s string) *ApplicationUpdate {
+	au.mutation.SetVersionKey(s)
+	return au
+}
+
+// SetNillableVersionKey sets the "version_key" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableVersionKey(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetVersionKey(*s)
+	}
+	return au
+}
+
+// ClearVersionKey clears the value of the "version_key" field.
+func (au *ApplicationUpdate) ClearVersionKey() *ApplicationUpdate {
+	au.mutation.ClearVersionKey()
+	return au
+}
+
+// SetChartVersionKey sets the "chart_version_key" field.
+func (au *ApplicationUpdate) SetChartVersionKey(s string) *ApplicationUpdate {
+	au.mutation.SetChartVersionKey(s)
+	return au
+}
+
+// SetNillableChartVersionKey sets the "chart_version_key" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableChartVersionKey(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetChartVersionKey(*s)
+	}
+	return au
+}
+
+// ClearChartVersionKey clears the value of the "chart_version_key" field.
+func (au *ApplicationUpdate) ClearChartVersionKey() *ApplicationUpdate {
+	au.mutation.ClearChartVersionKey()
+	return au
+}
+
 // SetKind sets the "kind" field.
 func (au *ApplicationUpdate) SetKind(s string) *ApplicationUpdate {
 	au.mutation.SetKind(s)
@@ -189,6 +248,112 @@ func (au *ApplicationUpdate) ClearKind() *ApplicationUpdate {
 	return au
 }
 
+// SetSearchText sets the "search_text" field.
+func (au *ApplicationUpdate) SetSearchText(s string) *ApplicationUpdate {
+	au.mutation.SetSearchText(s)
+	return au
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableSearchText(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetSearchText(*s)
+	}
+	return au
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (au *ApplicationUpdate) ClearSearchText() *ApplicationUpdate {
+	au.mutation.ClearSearchText()
+	return au
+}
+
+// SetLabels sets the "labels" field.
+func (au *ApplicationUpdate) SetLabels(m map[string]string) *ApplicationUpdate {
+	au.mutation.SetLabels(m)
+	return au
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (au *ApplicationUpdate) ClearLabels() *ApplicationUpdate {
+	au.mutation.ClearLabels()
+	return au
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (au *ApplicationUpdate) SetDeprecated(b bool) *ApplicationUpdate {
+	au.mutation.SetDeprecated(b)
+	return au
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableDeprecated(b *bool) *ApplicationUpdate {
+	if b != nil {
+		au.SetDeprecated(*b)
+	}
+	return au
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (au *ApplicationUpdate) SetDeprecationMessage(s string) *ApplicationUpdate {
+	au.mutation.SetDeprecationMessage(s)
+	return au
+}
+
+// SetNillableDeprecationMessage sets the "deprecation_message" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableDeprecationMessage(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetDeprecationMessage(*s)
+	}
+	return au
+}
+
+// ClearDeprecationMessage clears the value of the "deprecation_message" field.
+func (au *ApplicationUpdate) ClearDeprecationMessage() *ApplicationUpdate {
+	au.mutation.ClearDeprecationMessage()
+	return au
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (au *ApplicationUpdate) SetReplacementVersion(s string) *ApplicationUpdate {
+	au.mutation.SetReplacementVersion(s)
+	return au
+}
+
+// SetNillableReplacementVersion sets the "replacement_version" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableReplacementVersion(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetReplacementVersion(*s)
+	}
+	return au
+}
+
+// ClearReplacementVersion clears the value of the "replacement_version" field.
+func (au *ApplicationUpdate) ClearReplacementVersion() *ApplicationUpdate {
+	au.mutation.ClearReplacementVersion()
+	return au
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (au *ApplicationUpdate) SetDeprecateTime(t time.Time) *ApplicationUpdate {
+	au.mutation.SetDeprecateTime(t)
+	return au
+}
+
+// SetNillableDeprecateTime sets the "deprecate_time" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableDeprecateTime(t *time.Time) *ApplicationUpdate {
+	if t != nil {
+		au.SetDeprecateTime(*t)
+	}
+	return au
+}
+
+// ClearDeprecateTime clears the value of the "deprecate_time" field.
+func (au *ApplicationUpdate) ClearDeprecateTime() *ApplicationUpdate {
+	au.mutation.ClearDeprecateTime()
+	return au
+}
+
 // AddProfileIDs adds the "profiles" edge to the Profile entity by IDs.
 func (au *ApplicationUpdate) AddProfileIDs(ids ...uint64) *ApplicationUpdate {
 	au.mutation.AddProfileIDs(ids...)
@@ -475,6 +640,10 @@ func (au *ApplicationUpdate) defaults() {
 		v := application.UpdateDefaultUpdateTime()
 		au.mutation.SetUpdateTime(v)
 	}
+	if _, ok := au.mutation.Etag(); !ok && !au.mutation.EtagCleared() {
+		v := application.UpdateDefaultEtag()
+		au.mutation.SetEtag(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -521,6 +690,15 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.UpdateTime(); ok {
 		_spec.SetField(application.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.Etag(); ok {
+		_spec.SetField(application.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedEtag(); ok {
+		_spec.AddField(application.FieldEtag, field.TypeInt64, value)
+	}
+	if au.mutation.EtagCleared() {
+		_spec.ClearField(application.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := au.mutation.ProjectUUID(); ok {
 		_spec.SetField(application.FieldProjectUUID, field.TypeString, value)
 	}
@@ -533,12 +711,57 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.ChartVersion(); ok {
 		_spec.SetField(application.FieldChartVersion, field.TypeString, value)
 	}
+	if value, ok := au.mutation.VersionKey(); ok {
+		_spec.SetField(application.FieldVersionKey, field.TypeString, value)
+	}
+	if au.mutation.VersionKeyCleared() {
+		_spec.ClearField(application.FieldVersionKey, field.TypeString)
+	}
+	if value, ok := au.mutation.ChartVersionKey(); ok {
+		_spec.SetField(application.FieldChartVersionKey, field.TypeString, value)
+	}
+	if au.mutation.ChartVersionKeyCleared() {
+		_spec.ClearField(application.FieldChartVersionKey, field.TypeString)
+	}
 	if value, ok := au.mutation.Kind(); ok {
 		_spec.SetField(application.FieldKind, field.TypeString, value)
 	}
 	if au.mutation.KindCleared() {
 		_spec.ClearField(application.FieldKind, field.TypeString)
 	}
+	if value, ok := au.mutation.SearchText(); ok {
+		_spec.SetField(application.FieldSearchText, field.TypeString, value)
+	}
+	if au.mutation.SearchTextCleared() {
+		_spec.ClearField(application.FieldSearchText, field.TypeString)
+	}
+	if value, ok := au.mutation.Labels(); ok {
+		_spec.SetField(application.FieldLabels, field.TypeJSON, value)
+	}
+	if au.mutation.LabelsCleared() {
+		_spec.ClearField(application.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := au.mutation.Deprecated(); ok {
+		_spec.SetField(application.FieldDeprecated, field.TypeBool, value)
+	}
+	if value, ok := au.mutation.DeprecationMessage(); ok {
+		_spec.SetField(application.FieldDeprecationMessage, field.TypeString, value)
+	}
+	if au.mutation.DeprecationMessageCleared() {
+		_spec.ClearField(application.FieldDeprecationMessage, field.TypeString)
+	}
+	if value, ok := au.mutation.ReplacementVersion(); ok {
+		_spec.SetField(application.FieldReplacementVersion, field.TypeString, value)
+	}
+	if au.mutation.ReplacementVersionCleared() {
+		_spec.ClearField(application.FieldReplacementVersion, field.TypeString)
+	}
+	if value, ok := au.mutation.DeprecateTime(); ok {
+		_spec.SetField(application.FieldDeprecateTime, field.TypeTime, value)
+	}
+	if au.mutation.DeprecateTimeCleared() {
+		_spec.ClearField(application.FieldDeprecateTime, field.TypeTime)
+	}
 	if au.mutation.ProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -951,6 +1174,25 @@ func (auo *ApplicationUpdateOne) SetUpdateTime(t time.Time) *ApplicationUpdateOn
 	return auo
 }
 
+// SetEtag sets the "etag" field.
+func (auo *ApplicationUpdateOne) SetEtag(i int64) *ApplicationUpdateOne {
+	auo.mutation.ResetEtag()
+	auo.mutation.SetEtag(i)
+	return auo
+}
+
+// AddEtag adds i to the "etag" field.
+func (auo *ApplicationUpdateOne) AddEtag(i int64) *ApplicationUpdateOne {
+	auo.mutation.AddEtag(i)
+	return auo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (auo *ApplicationUpdateOne) ClearEtag() *ApplicationUpdateOne {
+	auo.mutation.ClearEtag()
+	return auo
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (auo *ApplicationUpdateOne) SetProjectUUID(s string) *ApplicationUpdateOne {
 	auo.mutation.SetProjectUUID(s)
@@ -1007,6 +1249,46 @@ func (auo *ApplicationUpdateOne) SetNillableChartVersion(s *string) *Application
 	return auo
 }
 
+// SetVersionKey sets the "version_key" field.
+func (auo *ApplicationUpdateOne) SetVersionKey(s string) *ApplicationUpdateOne {
+	auo.mutation.SetVersionKey(s)
+	return auo
+}
+
+// SetNillableVersionKey sets the "version_key" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableVersionKey(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetVersionKey(*s)
+	}
+	return auo
+}
+
+// ClearVersionKey clears the value of the "version_key" field.
+func (auo *ApplicationUpdateOne) ClearVersionKey() *ApplicationUpdateOne {
+	auo.mutation.ClearVersionKey()
+	return auo
+}
+
+// SetChartVersionKey sets the "chart_version_key" field.
+func (auo *ApplicationUpdateOne) SetChartVersionKey(s string) *ApplicationUpdateOne {
+	auo.mutation.SetChartVersionKey(s)
+	return auo
+}
+
+// SetNillableChartVersionKey sets the "chart_version_key" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableChartVersionKey(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetChartVersionKey(*s)
+	}
+	return auo
+}
+
+// ClearChartVersionKey clears the value of the "chart_version_key" field.
+func (auo *ApplicationUpdateOne) ClearChartVersionKey() *ApplicationUpdateOne {
+	auo.mutation.ClearChartVersionKey()
+	return auo
+}
+
 // SetKind sets the "kind" field.
 func (auo *ApplicationUpdateOne) SetKind(s string) *ApplicationUpdateOne {
 	auo.mutation.SetKind(s)
@@ -1027,6 +1309,112 @@ func (auo *ApplicationUpdateOne) ClearKind() *ApplicationUpdateOne {
 	return auo
 }
 
+// SetSearchText sets the "search_text" field.
+func (auo *ApplicationUpdateOne) SetSearchText(s string) *ApplicationUpdateOne {
+	auo.mutation.SetSearchText(s)
+	return auo
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableSearchText(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetSearchText(*s)
+	}
+	return auo
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (auo *ApplicationUpdateOne) ClearSearchText() *ApplicationUpdateOne {
+	auo.mutation.ClearSearchText()
+	return auo
+}
+
+// SetLabels sets the "labels" field.
+func (auo *ApplicationUpdateOne) SetLabels(m map[string]string) *ApplicationUpdateOne {
+	auo.mutation.SetLabels(m)
+	return auo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (auo *ApplicationUpdateOne) ClearLabels() *ApplicationUpdateOne {
+	auo.mutation.ClearLabels()
+	return auo
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (auo *ApplicationUpdateOne) SetDeprecated(b bool) *ApplicationUpdateOne {
+	auo.mutation.SetDeprecated(b)
+	return auo
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableDeprecated(b *bool) *ApplicationUpdateOne {
+	if b != nil {
+		auo.SetDeprecated(*b)
+	}
+	return auo
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (auo *ApplicationUpdateOne) SetDeprecationMessage(s string) *ApplicationUpdateOne {
+	auo.mutation.SetDeprecationMessage(s)
+	return auo
+}
+
+// SetNillableDeprecationMessage sets the "deprecation_message" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableDeprecationMessage(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetDeprecationMessage(*s)
+	}
+	return auo
+}
+
+// ClearDeprecationMessage clears the value of the "deprecation_message" field.
+func (auo *ApplicationUpdateOne) ClearDeprecationMessage() *ApplicationUpdateOne {
+	auo.mutation.ClearDeprecationMessage()
+	return auo
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (auo *ApplicationUpdateOne) SetReplacementVersion(s string) *ApplicationUpdateOne {
+	auo.mutation.SetReplacementVersion(s)
+	return auo
+}
+
+// SetNillableReplacementVersion sets the "replacement_version" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableReplacementVersion(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetReplacementVersion(*s)
+	}
+	return auo
+}
+
+// ClearReplacementVersion clears the value of the "replacement_version" field.
+func (auo *ApplicationUpdateOne) ClearReplacementVersion() *ApplicationUpdateOne {
+	auo.mutation.ClearReplacementVersion()
+	return auo
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (auo *ApplicationUpdateOne) SetDeprecateTime(t time.Time) *ApplicationUpdateOne {
+	auo.mutation.SetDeprecateTime(t)
+	return auo
+}
+
+// SetNillableDeprecateTime sets the "deprecate_time" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableDeprecateTime(t *time.Time) *ApplicationUpdateOne {
+	if t != nil {
+		auo.SetDeprecateTime(*t)
+	}
+	return auo
+}
+
+// ClearDeprecateTime clears the value of the "deprecate_time" field.
+func (auo *ApplicationUpdateOne) ClearDeprecateTime() *ApplicationUpdateOne {
+	auo.mutation.ClearDeprecateTime()
+	return auo
+}
+
 // AddProfileIDs adds the "profiles" edge to the Profile entity by IDs.
 func (auo *ApplicationUpdateOne) AddProfileIDs(ids ...uint64) *ApplicationUpdateOne {
 	auo.mutation.AddProfileIDs(ids...)
@@ -1326,6 +1714,10 @@ func (auo *ApplicationUpdateOne) defaults() {
 		v := application.UpdateDefaultUpdateTime()
 		auo.mutation.SetUpdateTime(v)
 	}
+	if _, ok := auo.mutation.Etag(); !ok && !auo.mutation.EtagCleared() {
+		v := application.UpdateDefaultEtag()
+		auo.mutation.SetEtag(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -1389,6 +1781,15 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 	if value, ok := auo.mutation.UpdateTime(); ok {
 		_spec.SetField(application.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.Etag(); ok {
+		_spec.SetField(application.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedEtag(); ok {
+		_spec.AddField(application.FieldEtag, field.TypeInt64, value)
+	}
+	if auo.mutation.EtagCleared() {
+		_spec.ClearField(application.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := auo.mutation.ProjectUUID(); ok {
 		_spec.SetField(application.FieldProjectUUID, field.TypeString, value)
 	}
@@ -1401,12 +1802,57 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 	if value, ok := auo.mutation.ChartVersion(); ok {
 		_spec.SetField(application.FieldChartVersion, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.VersionKey(); ok {
+		_spec.SetField(application.FieldVersionKey, field.TypeString, value)
+	}
+	if auo.mutation.VersionKeyCleared() {
+		_spec.ClearField(application.FieldVersionKey, field.TypeString)
+	}
+	if value, ok := auo.mutation.ChartVersionKey(); ok {
+		_spec.SetField(application.FieldChartVersionKey, field.TypeString, value)
+	}
+	if auo.mutation.ChartVersionKeyCleared() {
+		_spec.ClearField(application.FieldChartVersionKey, field.TypeString)
+	}
 	if value, ok := auo.mutation.Kind(); ok {
 		_spec.SetField(application.FieldKind, field.TypeString, value)
 	}
 	if auo.mutation.KindCleared() {
 		_spec.ClearField(application.FieldKind, field.TypeString)
 	}
+	if value, ok := auo.mutation.SearchText(); ok {
+		_spec.SetField(application.FieldSearchText, field.TypeString, value)
+	}
+	if auo.mutation.SearchTextCleared() {
+		_spec.ClearField(application.FieldSearchText, field.TypeString)
+	}
+	if value, ok := auo.mutation.Labels(); ok {
+		_spec.SetField(application.FieldLabels, field.TypeJSON, value)
+	}
+	if auo.mutation.LabelsCleared() {
+		_spec.ClearField(application.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.Deprecated(); ok {
+		_spec.SetField(application.FieldDeprecated, field.TypeBool, value)
+	}
+	if value, ok := auo.mutation.DeprecationMessage(); ok {
+		_spec.SetField(application.FieldDeprecationMessage, field.TypeString, value)
+	}
+	if auo.mutation.DeprecationMessageCleared() {
+		_spec.ClearField(application.FieldDeprecationMessage, field.TypeString)
+	}
+	if value, ok := auo.mutation.ReplacementVersion(); ok {
+		_spec.SetField(application.FieldReplacementVersion, field.TypeString, value)
+	}
+	if auo.mutation.ReplacementVersionCleared() {
+		_spec.ClearField(application.FieldReplacementVersion, field.TypeString)
+	}
+	if value, ok := auo.mutation.DeprecateTime(); ok {
+		_spec.SetField(application.FieldDeprecateTime, field.TypeTime, value)
+	}
+	if auo.mutation.DeprecateTimeCleared() {
+		_spec.ClearField(application.FieldDeprecateTime, field.TypeTime)
+	}
 	if auo.mutation.ProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
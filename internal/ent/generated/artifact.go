@@ -29,6 +29,8 @@ type Artifact struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// The last update timestamp.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Opaque entity tag; changes whenever the entity is updated.
+	Etag int64 `json:"etag,omitempty"`
 	// UUID of the owner project.
 	ProjectUUID string `json:"project_uuid,omitempty"`
 	// MIME type of artifact.
@@ -77,7 +79,7 @@ func (*Artifact) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case artifact.FieldArtifact:
 			values[i] = new([]byte)
-		case artifact.FieldID:
+		case artifact.FieldID, artifact.FieldEtag:
 			values[i] = new(sql.NullInt64)
 		case artifact.FieldName, artifact.FieldDisplayName, artifact.FieldDisplayNameLc, artifact.FieldDescription, artifact.FieldProjectUUID, artifact.FieldMimeType:
 			values[i] = new(sql.NullString)
@@ -140,6 +142,12 @@ func (a *Artifact) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdateTime = value.Time
 			}
+		case artifact.FieldEtag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				a.Etag = value.Int64
+			}
 		case artifact.FieldProjectUUID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
@@ -222,6 +230,9 @@ func (a *Artifact) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(fmt.Sprintf("%v", a.Etag))
+	builder.WriteString(", ")
 	builder.WriteString("project_uuid=")
 	builder.WriteString(a.ProjectUUID)
 	builder.WriteString(", ")
@@ -26,6 +26,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
 	// FieldProjectUUID holds the string denoting the project_uuid field in the database.
 	FieldProjectUUID = "project_uuid"
 	// FieldMimeType holds the string denoting the mime_type field in the database.
@@ -59,6 +61,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldEtag,
 	FieldProjectUUID,
 	FieldMimeType,
 	FieldArtifact,
@@ -90,6 +93,10 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultEtag holds the default value on creation for the "etag" field.
+	DefaultEtag func() int64
+	// UpdateDefaultEtag holds the default value on update for the "etag" field.
+	UpdateDefaultEtag func() int64
 	// DefaultProjectUUID holds the default value on creation for the "project_uuid" field.
 	DefaultProjectUUID string
 )
@@ -132,6 +139,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByEtag orders the results by the etag field.
+func ByEtag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEtag, opts...).ToFunc()
+}
+
 // ByProjectUUID orders the results by the project_uuid field.
 func ByProjectUUID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectUUID, opts...).ToFunc()
@@ -85,6 +85,11 @@ func UpdateTime(v time.Time) predicate.Artifact {
 	return predicate.Artifact(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldEQ(FieldEtag, v))
+}
+
 // ProjectUUID applies equality check predicate on the "project_uuid" field. It's identical to ProjectUUIDEQ.
 func ProjectUUID(v string) predicate.Artifact {
 	return predicate.Artifact(sql.FieldEQ(FieldProjectUUID, v))
@@ -470,6 +475,56 @@ func UpdateTimeLTE(v time.Time) predicate.Artifact {
 	return predicate.Artifact(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v int64) predicate.Artifact {
+	return predicate.Artifact(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.Artifact {
+	return predicate.Artifact(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.Artifact {
+	return predicate.Artifact(sql.FieldNotNull(FieldEtag))
+}
+
 // ProjectUUIDEQ applies the EQ predicate on the "project_uuid" field.
 func ProjectUUIDEQ(v string) predicate.Artifact {
 	return predicate.Artifact(sql.FieldEQ(FieldProjectUUID, v))
@@ -97,6 +97,20 @@ func (ac *ArtifactCreate) SetNillableUpdateTime(t *time.Time) *ArtifactCreate {
 	return ac
 }
 
+// SetEtag sets the "etag" field.
+func (ac *ArtifactCreate) SetEtag(i int64) *ArtifactCreate {
+	ac.mutation.SetEtag(i)
+	return ac
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (ac *ArtifactCreate) SetNillableEtag(i *int64) *ArtifactCreate {
+	if i != nil {
+		ac.SetEtag(*i)
+	}
+	return ac
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (ac *ArtifactCreate) SetProjectUUID(s string) *ArtifactCreate {
 	ac.mutation.SetProjectUUID(s)
@@ -196,6 +210,10 @@ func (ac *ArtifactCreate) defaults() {
 		v := artifact.DefaultUpdateTime()
 		ac.mutation.SetUpdateTime(v)
 	}
+	if _, ok := ac.mutation.Etag(); !ok {
+		v := artifact.DefaultEtag()
+		ac.mutation.SetEtag(v)
+	}
 	if _, ok := ac.mutation.ProjectUUID(); !ok {
 		v := artifact.DefaultProjectUUID
 		ac.mutation.SetProjectUUID(v)
@@ -272,6 +290,10 @@ func (ac *ArtifactCreate) createSpec() (*Artifact, *sqlgraph.CreateSpec) {
 		_spec.SetField(artifact.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := ac.mutation.Etag(); ok {
+		_spec.SetField(artifact.FieldEtag, field.TypeInt64, value)
+		_node.Etag = value
+	}
 	if value, ok := ac.mutation.ProjectUUID(); ok {
 		_spec.SetField(artifact.FieldProjectUUID, field.TypeString, value)
 		_node.ProjectUUID = value
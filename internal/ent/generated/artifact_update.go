@@ -109,6 +109,25 @@ func (au *ArtifactUpdate) SetUpdateTime(t time.Time) *ArtifactUpdate {
 	return au
 }
 
+// SetEtag sets the "etag" field.
+func (au *ArtifactUpdate) SetEtag(i int64) *ArtifactUpdate {
+	au.mutation.ResetEtag()
+	au.mutation.SetEtag(i)
+	return au
+}
+
+// AddEtag adds i to the "etag" field.
+func (au *ArtifactUpdate) AddEtag(i int64) *ArtifactUpdate {
+	au.mutation.AddEtag(i)
+	return au
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (au *ArtifactUpdate) ClearEtag() *ArtifactUpdate {
+	au.mutation.ClearEtag()
+	return au
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (au *ArtifactUpdate) SetProjectUUID(s string) *ArtifactUpdate {
 	au.mutation.SetProjectUUID(s)
@@ -254,6 +273,10 @@ func (au *ArtifactUpdate) defaults() {
 		v := artifact.UpdateDefaultUpdateTime()
 		au.mutation.SetUpdateTime(v)
 	}
+	if _, ok := au.mutation.Etag(); !ok && !au.mutation.EtagCleared() {
+		v := artifact.UpdateDefaultEtag()
+		au.mutation.SetEtag(v)
+	}
 }
 
 func (au *ArtifactUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -289,6 +312,15 @@ func (au *ArtifactUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.UpdateTime(); ok {
 		_spec.SetField(artifact.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.Etag(); ok {
+		_spec.SetField(artifact.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedEtag(); ok {
+		_spec.AddField(artifact.FieldEtag, field.TypeInt64, value)
+	}
+	if au.mutation.EtagCleared() {
+		_spec.ClearField(artifact.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := au.mutation.ProjectUUID(); ok {
 		_spec.SetField(artifact.FieldProjectUUID, field.TypeString, value)
 	}
@@ -488,6 +520,25 @@ func (auo *ArtifactUpdateOne) SetUpdateTime(t time.Time) *ArtifactUpdateOne {
 	return auo
 }
 
+// SetEtag sets the "etag" field.
+func (auo *ArtifactUpdateOne) SetEtag(i int64) *ArtifactUpdateOne {
+	auo.mutation.ResetEtag()
+	auo.mutation.SetEtag(i)
+	return auo
+}
+
+// AddEtag adds i to the "etag" field.
+func (auo *ArtifactUpdateOne) AddEtag(i int64) *ArtifactUpdateOne {
+	auo.mutation.AddEtag(i)
+	return auo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (auo *ArtifactUpdateOne) ClearEtag() *ArtifactUpdateOne {
+	auo.mutation.ClearEtag()
+	return auo
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (auo *ArtifactUpdateOne) SetProjectUUID(s string) *ArtifactUpdateOne {
 	auo.mutation.SetProjectUUID(s)
@@ -646,6 +697,10 @@ func (auo *ArtifactUpdateOne) defaults() {
 		v := artifact.UpdateDefaultUpdateTime()
 		auo.mutation.SetUpdateTime(v)
 	}
+	if _, ok := auo.mutation.Etag(); !ok && !auo.mutation.EtagCleared() {
+		v := artifact.UpdateDefaultEtag()
+		auo.mutation.SetEtag(v)
+	}
 }
 
 func (auo *ArtifactUpdateOne) sqlSave(ctx context.Context) (_node *Artifact, err error) {
@@ -698,6 +753,15 @@ func (auo *ArtifactUpdateOne) sqlSave(ctx context.Context) (_node *Artifact, err
 	if value, ok := auo.mutation.UpdateTime(); ok {
 		_spec.SetField(artifact.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.Etag(); ok {
+		_spec.SetField(artifact.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedEtag(); ok {
+		_spec.AddField(artifact.FieldEtag, field.TypeInt64, value)
+	}
+	if auo.mutation.EtagCleared() {
+		_spec.ClearField(artifact.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := auo.mutation.ProjectUUID(); ok {
 		_spec.SetField(artifact.FieldProjectUUID, field.TypeString, value)
 	}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
+)
+
+// AuditEvent is the model entity for the AuditEvent schema.
+type AuditEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UUID of the project to which the changed entity belongs.
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// Name of the user who made the change.
+	UserName string `json:"user_name,omitempty"`
+	// Client through which the change was made.
+	Client string `json:"client,omitempty"`
+	// Name of the RPC that made the change.
+	Method string `json:"method,omitempty"`
+	// Type of the changed entity, e.g. registry or deployment-package.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Name of the changed entity.
+	ResourceName string `json:"resource_name,omitempty"`
+	// Version of the changed entity, if it is versioned.
+	ResourceVersion string `json:"resource_version,omitempty"`
+	// The change made, i.e. created, updated or deleted.
+	Action string `json:"action,omitempty"`
+	// Names of the fields that changed.
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	// State of the entity before the change, as JSON.
+	Before string `json:"before,omitempty"`
+	// State of the entity after the change, as JSON.
+	After string `json:"after,omitempty"`
+	// The time of the change.
+	CreateTime   time.Time `json:"create_time,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldChangedFields:
+			values[i] = new([]byte)
+		case auditevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case auditevent.FieldProjectUUID, auditevent.FieldUserName, auditevent.FieldClient, auditevent.FieldMethod, auditevent.FieldResourceType, auditevent.FieldResourceName, auditevent.FieldResourceVersion, auditevent.FieldAction, auditevent.FieldBefore, auditevent.FieldAfter:
+			values[i] = new(sql.NullString)
+		case auditevent.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEvent fields.
+func (ae *AuditEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = uint64(value.Int64)
+		case auditevent.FieldProjectUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
+			} else if value.Valid {
+				ae.ProjectUUID = value.String
+			}
+		case auditevent.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				ae.UserName = value.String
+			}
+		case auditevent.FieldClient:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client", values[i])
+			} else if value.Valid {
+				ae.Client = value.String
+			}
+		case auditevent.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				ae.Method = value.String
+			}
+		case auditevent.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				ae.ResourceType = value.String
+			}
+		case auditevent.FieldResourceName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_name", values[i])
+			} else if value.Valid {
+				ae.ResourceName = value.String
+			}
+		case auditevent.FieldResourceVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_version", values[i])
+			} else if value.Valid {
+				ae.ResourceVersion = value.String
+			}
+		case auditevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ae.Action = value.String
+			}
+		case auditevent.FieldChangedFields:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_fields", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.ChangedFields); err != nil {
+					return fmt.Errorf("unmarshal field changed_fields: %w", err)
+				}
+			}
+		case auditevent.FieldBefore:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value.Valid {
+				ae.Before = value.String
+			}
+		case auditevent.FieldAfter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value.Valid {
+				ae.After = value.String
+			}
+		case auditevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ae.CreateTime = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEvent.
+// This includes values selected through modifiers, order, etc.
+func (ae *AuditEvent) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditEvent.
+// Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEvent) Update() *AuditEventUpdateOne {
+	return NewAuditEventClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEvent) Unwrap() *AuditEvent {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AuditEvent is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("project_uuid=")
+	builder.WriteString(ae.ProjectUUID)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(ae.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("client=")
+	builder.WriteString(ae.Client)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(ae.Method)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(ae.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("resource_name=")
+	builder.WriteString(ae.ResourceName)
+	builder.WriteString(", ")
+	builder.WriteString("resource_version=")
+	builder.WriteString(ae.ResourceVersion)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(ae.Action)
+	builder.WriteString(", ")
+	builder.WriteString("changed_fields=")
+	builder.WriteString(fmt.Sprintf("%v", ae.ChangedFields))
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(ae.Before)
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(ae.After)
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(ae.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEvents is a parsable slice of AuditEvent.
+type AuditEvents []*AuditEvent
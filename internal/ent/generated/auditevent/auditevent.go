@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditevent type in the database.
+	Label = "audit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectUUID holds the string denoting the project_uuid field in the database.
+	FieldProjectUUID = "project_uuid"
+	// FieldUserName holds the string denoting the user_name field in the database.
+	FieldUserName = "user_name"
+	// FieldClient holds the string denoting the client field in the database.
+	FieldClient = "client"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldResourceType holds the string denoting the resource_type field in the database.
+	FieldResourceType = "resource_type"
+	// FieldResourceName holds the string denoting the resource_name field in the database.
+	FieldResourceName = "resource_name"
+	// FieldResourceVersion holds the string denoting the resource_version field in the database.
+	FieldResourceVersion = "resource_version"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldChangedFields holds the string denoting the changed_fields field in the database.
+	FieldChangedFields = "changed_fields"
+	// FieldBefore holds the string denoting the before field in the database.
+	FieldBefore = "before"
+	// FieldAfter holds the string denoting the after field in the database.
+	FieldAfter = "after"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// Table holds the table name of the auditevent in the database.
+	Table = "audit_events"
+)
+
+// Columns holds all SQL columns for auditevent fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectUUID,
+	FieldUserName,
+	FieldClient,
+	FieldMethod,
+	FieldResourceType,
+	FieldResourceName,
+	FieldResourceVersion,
+	FieldAction,
+	FieldChangedFields,
+	FieldBefore,
+	FieldAfter,
+	FieldCreateTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUserName holds the default value on creation for the "user_name" field.
+	DefaultUserName string
+	// DefaultClient holds the default value on creation for the "client" field.
+	DefaultClient string
+	// DefaultMethod holds the default value on creation for the "method" field.
+	DefaultMethod string
+	// DefaultResourceVersion holds the default value on creation for the "resource_version" field.
+	DefaultResourceVersion string
+	// DefaultBefore holds the default value on creation for the "before" field.
+	DefaultBefore string
+	// DefaultAfter holds the default value on creation for the "after" field.
+	DefaultAfter string
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the AuditEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectUUID orders the results by the project_uuid field.
+func ByProjectUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectUUID, opts...).ToFunc()
+}
+
+// ByUserName orders the results by the user_name field.
+func ByUserName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserName, opts...).ToFunc()
+}
+
+// ByClient orders the results by the client field.
+func ByClient(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClient, opts...).ToFunc()
+}
+
+// ByMethod orders the results by the method field.
+func ByMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMethod, opts...).ToFunc()
+}
+
+// ByResourceType orders the results by the resource_type field.
+func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
+}
+
+// ByResourceName orders the results by the resource_name field.
+func ByResourceName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceName, opts...).ToFunc()
+}
+
+// ByResourceVersion orders the results by the resource_version field.
+func ByResourceVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceVersion, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByBefore orders the results by the before field.
+func ByBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBefore, opts...).ToFunc()
+}
+
+// ByAfter orders the results by the after field.
+func ByAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAfter, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
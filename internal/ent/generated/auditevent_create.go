@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (aec *AuditEventCreate) SetProjectUUID(s string) *AuditEventCreate {
+	aec.mutation.SetProjectUUID(s)
+	return aec
+}
+
+// SetUserName sets the "user_name" field.
+func (aec *AuditEventCreate) SetUserName(s string) *AuditEventCreate {
+	aec.mutation.SetUserName(s)
+	return aec
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableUserName(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetUserName(*s)
+	}
+	return aec
+}
+
+// SetClient sets the "client" field.
+func (aec *AuditEventCreate) SetClient(s string) *AuditEventCreate {
+	aec.mutation.SetClient(s)
+	return aec
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableClient(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetClient(*s)
+	}
+	return aec
+}
+
+// SetMethod sets the "method" field.
+func (aec *AuditEventCreate) SetMethod(s string) *AuditEventCreate {
+	aec.mutation.SetMethod(s)
+	return aec
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableMethod(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetMethod(*s)
+	}
+	return aec
+}
+
+// SetResourceType sets the "resource_type" field.
+func (aec *AuditEventCreate) SetResourceType(s string) *AuditEventCreate {
+	aec.mutation.SetResourceType(s)
+	return aec
+}
+
+// SetResourceName sets the "resource_name" field.
+func (aec *AuditEventCreate) SetResourceName(s string) *AuditEventCreate {
+	aec.mutation.SetResourceName(s)
+	return aec
+}
+
+// SetResourceVersion sets the "resource_version" field.
+func (aec *AuditEventCreate) SetResourceVersion(s string) *AuditEventCreate {
+	aec.mutation.SetResourceVersion(s)
+	return aec
+}
+
+// SetNillableResourceVersion sets the "resource_version" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableResourceVersion(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetResourceVersion(*s)
+	}
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEventCreate) SetAction(s string) *AuditEventCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (aec *AuditEventCreate) SetChangedFields(s []string) *AuditEventCreate {
+	aec.mutation.SetChangedFields(s)
+	return aec
+}
+
+// SetBefore sets the "before" field.
+func (aec *AuditEventCreate) SetBefore(s string) *AuditEventCreate {
+	aec.mutation.SetBefore(s)
+	return aec
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableBefore(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetBefore(*s)
+	}
+	return aec
+}
+
+// SetAfter sets the "after" field.
+func (aec *AuditEventCreate) SetAfter(s string) *AuditEventCreate {
+	aec.mutation.SetAfter(s)
+	return aec
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableAfter(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetAfter(*s)
+	}
+	return aec
+}
+
+// SetCreateTime sets the "create_time" field.
+func (aec *AuditEventCreate) SetCreateTime(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreateTime(t)
+	return aec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreateTime(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreateTime(*t)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.UserName(); !ok {
+		v := auditevent.DefaultUserName
+		aec.mutation.SetUserName(v)
+	}
+	if _, ok := aec.mutation.GetClient(); !ok {
+		v := auditevent.DefaultClient
+		aec.mutation.SetClient(v)
+	}
+	if _, ok := aec.mutation.Method(); !ok {
+		v := auditevent.DefaultMethod
+		aec.mutation.SetMethod(v)
+	}
+	if _, ok := aec.mutation.ResourceVersion(); !ok {
+		v := auditevent.DefaultResourceVersion
+		aec.mutation.SetResourceVersion(v)
+	}
+	if _, ok := aec.mutation.Before(); !ok {
+		v := auditevent.DefaultBefore
+		aec.mutation.SetBefore(v)
+	}
+	if _, ok := aec.mutation.After(); !ok {
+		v := auditevent.DefaultAfter
+		aec.mutation.SetAfter(v)
+	}
+	if _, ok := aec.mutation.CreateTime(); !ok {
+		v := auditevent.DefaultCreateTime()
+		aec.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.ProjectUUID(); !ok {
+		return &ValidationError{Name: "project_uuid", err: errors.New(`generated: missing required field "AuditEvent.project_uuid"`)}
+	}
+	if _, ok := aec.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`generated: missing required field "AuditEvent.user_name"`)}
+	}
+	if _, ok := aec.mutation.GetClient(); !ok {
+		return &ValidationError{Name: "client", err: errors.New(`generated: missing required field "AuditEvent.client"`)}
+	}
+	if _, ok := aec.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`generated: missing required field "AuditEvent.method"`)}
+	}
+	if _, ok := aec.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`generated: missing required field "AuditEvent.resource_type"`)}
+	}
+	if _, ok := aec.mutation.ResourceName(); !ok {
+		return &ValidationError{Name: "resource_name", err: errors.New(`generated: missing required field "AuditEvent.resource_name"`)}
+	}
+	if _, ok := aec.mutation.ResourceVersion(); !ok {
+		return &ValidationError{Name: "resource_version", err: errors.New(`generated: missing required field "AuditEvent.resource_version"`)}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`generated: missing required field "AuditEvent.action"`)}
+	}
+	if _, ok := aec.mutation.Before(); !ok {
+		return &ValidationError{Name: "before", err: errors.New(`generated: missing required field "AuditEvent.before"`)}
+	}
+	if _, ok := aec.mutation.After(); !ok {
+		return &ValidationError{Name: "after", err: errors.New(`generated: missing required field "AuditEvent.after"`)}
+	}
+	if _, ok := aec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`generated: missing required field "AuditEvent.create_time"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = uint64(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditevent.Table, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUint64))
+	)
+	if value, ok := aec.mutation.ProjectUUID(); ok {
+		_spec.SetField(auditevent.FieldProjectUUID, field.TypeString, value)
+		_node.ProjectUUID = value
+	}
+	if value, ok := aec.mutation.UserName(); ok {
+		_spec.SetField(auditevent.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := aec.mutation.GetClient(); ok {
+		_spec.SetField(auditevent.FieldClient, field.TypeString, value)
+		_node.Client = value
+	}
+	if value, ok := aec.mutation.Method(); ok {
+		_spec.SetField(auditevent.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := aec.mutation.ResourceType(); ok {
+		_spec.SetField(auditevent.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := aec.mutation.ResourceName(); ok {
+		_spec.SetField(auditevent.FieldResourceName, field.TypeString, value)
+		_node.ResourceName = value
+	}
+	if value, ok := aec.mutation.ResourceVersion(); ok {
+		_spec.SetField(auditevent.FieldResourceVersion, field.TypeString, value)
+		_node.ResourceVersion = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.ChangedFields(); ok {
+		_spec.SetField(auditevent.FieldChangedFields, field.TypeJSON, value)
+		_node.ChangedFields = value
+	}
+	if value, ok := aec.mutation.Before(); ok {
+		_spec.SetField(auditevent.FieldBefore, field.TypeString, value)
+		_node.Before = value
+	}
+	if value, ok := aec.mutation.After(); ok {
+		_spec.SetField(auditevent.FieldAfter, field.TypeString, value)
+		_node.After = value
+	}
+	if value, ok := aec.mutation.CreateTime(); ok {
+		_spec.SetField(auditevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
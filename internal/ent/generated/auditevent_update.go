@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeu *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (aeu *AuditEventUpdate) SetProjectUUID(s string) *AuditEventUpdate {
+	aeu.mutation.SetProjectUUID(s)
+	return aeu
+}
+
+// SetNillableProjectUUID sets the "project_uuid" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableProjectUUID(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetProjectUUID(*s)
+	}
+	return aeu
+}
+
+// SetUserName sets the "user_name" field.
+func (aeu *AuditEventUpdate) SetUserName(s string) *AuditEventUpdate {
+	aeu.mutation.SetUserName(s)
+	return aeu
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableUserName(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetUserName(*s)
+	}
+	return aeu
+}
+
+// SetClient sets the "client" field.
+func (aeu *AuditEventUpdate) SetClient(s string) *AuditEventUpdate {
+	aeu.mutation.SetClient(s)
+	return aeu
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableClient(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetClient(*s)
+	}
+	return aeu
+}
+
+// SetMethod sets the "method" field.
+func (aeu *AuditEventUpdate) SetMethod(s string) *AuditEventUpdate {
+	aeu.mutation.SetMethod(s)
+	return aeu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableMethod(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetMethod(*s)
+	}
+	return aeu
+}
+
+// SetResourceType sets the "resource_type" field.
+func (aeu *AuditEventUpdate) SetResourceType(s string) *AuditEventUpdate {
+	aeu.mutation.SetResourceType(s)
+	return aeu
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableResourceType(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetResourceType(*s)
+	}
+	return aeu
+}
+
+// SetResourceName sets the "resource_name" field.
+func (aeu *AuditEventUpdate) SetResourceName(s string) *AuditEventUpdate {
+	aeu.mutation.SetResourceName(s)
+	return aeu
+}
+
+// SetNillableResourceName sets the "resource_name" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableResourceName(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetResourceName(*s)
+	}
+	return aeu
+}
+
+// SetResourceVersion sets the "resource_version" field.
+func (aeu *AuditEventUpdate) SetResourceVersion(s string) *AuditEventUpdate {
+	aeu.mutation.SetResourceVersion(s)
+	return aeu
+}
+
+// SetNillableResourceVersion sets the "resource_version" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableResourceVersion(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetResourceVersion(*s)
+	}
+	return aeu
+}
+
+// SetAction sets the "action" field.
+func (aeu *AuditEventUpdate) SetAction(s string) *AuditEventUpdate {
+	aeu.mutation.SetAction(s)
+	return aeu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableAction(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetAction(*s)
+	}
+	return aeu
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (aeu *AuditEventUpdate) SetChangedFields(s []string) *AuditEventUpdate {
+	aeu.mutation.SetChangedFields(s)
+	return aeu
+}
+
+// AppendChangedFields appends s to the "changed_fields" field.
+func (aeu *AuditEventUpdate) AppendChangedFields(s []string) *AuditEventUpdate {
+	aeu.mutation.AppendChangedFields(s)
+	return aeu
+}
+
+// ClearChangedFields clears the value of the "changed_fields" field.
+func (aeu *AuditEventUpdate) ClearChangedFields() *AuditEventUpdate {
+	aeu.mutation.ClearChangedFields()
+	return aeu
+}
+
+// SetBefore sets the "before" field.
+func (aeu *AuditEventUpdate) SetBefore(s string) *AuditEventUpdate {
+	aeu.mutation.SetBefore(s)
+	return aeu
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableBefore(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetBefore(*s)
+	}
+	return aeu
+}
+
+// SetAfter sets the "after" field.
+func (aeu *AuditEventUpdate) SetAfter(s string) *AuditEventUpdate {
+	aeu.mutation.SetAfter(s)
+	return aeu
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableAfter(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetAfter(*s)
+	}
+	return aeu
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeu *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aeu *AuditEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUint64))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.ProjectUUID(); ok {
+		_spec.SetField(auditevent.FieldProjectUUID, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.UserName(); ok {
+		_spec.SetField(auditevent.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.GetClient(); ok {
+		_spec.SetField(auditevent.FieldClient, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Method(); ok {
+		_spec.SetField(auditevent.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.ResourceType(); ok {
+		_spec.SetField(auditevent.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.ResourceName(); ok {
+		_spec.SetField(auditevent.FieldResourceName, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.ResourceVersion(); ok {
+		_spec.SetField(auditevent.FieldResourceVersion, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.ChangedFields(); ok {
+		_spec.SetField(auditevent.FieldChangedFields, field.TypeJSON, value)
+	}
+	if value, ok := aeu.mutation.AppendedChangedFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldChangedFields, value)
+		})
+	}
+	if aeu.mutation.ChangedFieldsCleared() {
+		_spec.ClearField(auditevent.FieldChangedFields, field.TypeJSON)
+	}
+	if value, ok := aeu.mutation.Before(); ok {
+		_spec.SetField(auditevent.FieldBefore, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.After(); ok {
+		_spec.SetField(auditevent.FieldAfter, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (aeuo *AuditEventUpdateOne) SetProjectUUID(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetProjectUUID(s)
+	return aeuo
+}
+
+// SetNillableProjectUUID sets the "project_uuid" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableProjectUUID(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetProjectUUID(*s)
+	}
+	return aeuo
+}
+
+// SetUserName sets the "user_name" field.
+func (aeuo *AuditEventUpdateOne) SetUserName(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetUserName(s)
+	return aeuo
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableUserName(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetUserName(*s)
+	}
+	return aeuo
+}
+
+// SetClient sets the "client" field.
+func (aeuo *AuditEventUpdateOne) SetClient(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetClient(s)
+	return aeuo
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableClient(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetClient(*s)
+	}
+	return aeuo
+}
+
+// SetMethod sets the "method" field.
+func (aeuo *AuditEventUpdateOne) SetMethod(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetMethod(s)
+	return aeuo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableMethod(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetMethod(*s)
+	}
+	return aeuo
+}
+
+// SetResourceType sets the "resource_type" field.
+func (aeuo *AuditEventUpdateOne) SetResourceType(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetResourceType(s)
+	return aeuo
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableResourceType(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetResourceType(*s)
+	}
+	return aeuo
+}
+
+// SetResourceName sets the "resource_name" field.
+func (aeuo *AuditEventUpdateOne) SetResourceName(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetResourceName(s)
+	return aeuo
+}
+
+// SetNillableResourceName sets the "resource_name" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableResourceName(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetResourceName(*s)
+	}
+	return aeuo
+}
+
+// SetResourceVersion sets the "resource_version" field.
+func (aeuo *AuditEventUpdateOne) SetResourceVersion(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetResourceVersion(s)
+	return aeuo
+}
+
+// SetNillableResourceVersion sets the "resource_version" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableResourceVersion(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetResourceVersion(*s)
+	}
+	return aeuo
+}
+
+// SetAction sets the "action" field.
+func (aeuo *AuditEventUpdateOne) SetAction(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetAction(s)
+	return aeuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableAction(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetAction(*s)
+	}
+	return aeuo
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (aeuo *AuditEventUpdateOne) SetChangedFields(s []string) *AuditEventUpdateOne {
+	aeuo.mutation.SetChangedFields(s)
+	return aeuo
+}
+
+// AppendChangedFields appends s to the "changed_fields" field.
+func (aeuo *AuditEventUpdateOne) AppendChangedFields(s []string) *AuditEventUpdateOne {
+	aeuo.mutation.AppendChangedFields(s)
+	return aeuo
+}
+
+// ClearChangedFields clears the value of the "changed_fields" field.
+func (aeuo *AuditEventUpdateOne) ClearChangedFields() *AuditEventUpdateOne {
+	aeuo.mutation.ClearChangedFields()
+	return aeuo
+}
+
+// SetBefore sets the "before" field.
+func (aeuo *AuditEventUpdateOne) SetBefore(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetBefore(s)
+	return aeuo
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableBefore(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetBefore(*s)
+	}
+	return aeuo
+}
+
+// SetAfter sets the "after" field.
+func (aeuo *AuditEventUpdateOne) SetAfter(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetAfter(s)
+	return aeuo
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableAfter(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetAfter(*s)
+	}
+	return aeuo
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeuo *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeuo *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (aeuo *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aeuo *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUint64))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.ProjectUUID(); ok {
+		_spec.SetField(auditevent.FieldProjectUUID, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.UserName(); ok {
+		_spec.SetField(auditevent.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.GetClient(); ok {
+		_spec.SetField(auditevent.FieldClient, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Method(); ok {
+		_spec.SetField(auditevent.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.ResourceType(); ok {
+		_spec.SetField(auditevent.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.ResourceName(); ok {
+		_spec.SetField(auditevent.FieldResourceName, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.ResourceVersion(); ok {
+		_spec.SetField(auditevent.FieldResourceVersion, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.ChangedFields(); ok {
+		_spec.SetField(auditevent.FieldChangedFields, field.TypeJSON, value)
+	}
+	if value, ok := aeuo.mutation.AppendedChangedFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldChangedFields, value)
+		})
+	}
+	if aeuo.mutation.ChangedFieldsCleared() {
+		_spec.ClearField(auditevent.FieldChangedFields, field.TypeJSON)
+	}
+	if value, ok := aeuo.mutation.Before(); ok {
+		_spec.SetField(auditevent.FieldBefore, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.After(); ok {
+		_spec.SetField(auditevent.FieldAfter, field.TypeString, value)
+	}
+	_node = &AuditEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,10 +31,14 @@ type DeploymentPackage struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// The last update timestamp.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Opaque entity tag; changes whenever the entity is updated.
+	Etag int64 `json:"etag,omitempty"`
 	// UUID of the owner project.
 	ProjectUUID string `json:"project_uuid,omitempty"`
 	// Version of the Deployment Package. Used in combination with the name to identify a unique Deployment Package within the catalog.
 	Version string `json:"version,omitempty"`
+	// Form of the version that orders semantic versions by precedence when compared bytewise.
+	VersionKey string `json:"version_key,omitempty"`
 	// Indicates whether Deployment Package is deployed and available. Cannot be deleted while true
 	IsDeployed bool `json:"is_deployed,omitempty"`
 	// Indicates whether Deployment Package should be seen by user. Should not be deployed while false
@@ -42,6 +47,22 @@ type DeploymentPackage struct {
 	AllowsMultipleDeployments bool `json:"allows_multiple_deployments,omitempty"`
 	// Deployment package kind; normal, addon, extension
 	Kind string `json:"kind,omitempty"`
+	// Version constraints of the referenced applications, such as latest or ~1.4, keyed by application name.
+	ApplicationConstraints map[string]string `json:"application_constraints,omitempty"`
+	// Labels of the UI extensions, by which the Deployment Package is searched along with its own columns.
+	SearchText string `json:"search_text,omitempty"`
+	// Application versions and deployment requirements the constraints resolved to when the Deployment Package was deployed, as JSON.
+	PinnedResolution string `json:"pinned_resolution,omitempty"`
+	// Labels of the Deployment Package, by which it is selected.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Indicates whether the Deployment Package version is deprecated.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Message explaining why the Deployment Package version is deprecated.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+	// Version suggested to be used in place of the deprecated one.
+	ReplacementVersion string `json:"replacement_version,omitempty"`
+	// The time at which the Deployment Package version was deprecated.
+	DeprecateTime *time.Time `json:"deprecate_time,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeploymentPackageQuery when eager-loading is set.
 	Edges                              DeploymentPackageEdges `json:"edges"`
@@ -175,13 +196,15 @@ func (*DeploymentPackage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deploymentpackage.FieldIsDeployed, deploymentpackage.FieldIsVisible, deploymentpackage.FieldAllowsMultipleDeployments:
+		case deploymentpackage.FieldApplicationConstraints, deploymentpackage.FieldLabels:
+			values[i] = new([]byte)
+		case deploymentpackage.FieldIsDeployed, deploymentpackage.FieldIsVisible, deploymentpackage.FieldAllowsMultipleDeployments, deploymentpackage.FieldDeprecated:
 			values[i] = new(sql.NullBool)
-		case deploymentpackage.FieldID:
+		case deploymentpackage.FieldID, deploymentpackage.FieldEtag:
 			values[i] = new(sql.NullInt64)
-		case deploymentpackage.FieldName, deploymentpackage.FieldDisplayName, deploymentpackage.FieldDisplayNameLc, deploymentpackage.FieldDescription, deploymentpackage.FieldProjectUUID, deploymentpackage.FieldVersion, deploymentpackage.FieldKind:
+		case deploymentpackage.FieldName, deploymentpackage.FieldDisplayName, deploymentpackage.FieldDisplayNameLc, deploymentpackage.FieldDescription, deploymentpackage.FieldProjectUUID, deploymentpackage.FieldVersion, deploymentpackage.FieldVersionKey, deploymentpackage.FieldKind, deploymentpackage.FieldSearchText, deploymentpackage.FieldPinnedResolution, deploymentpackage.FieldDeprecationMessage, deploymentpackage.FieldReplacementVersion:
 			values[i] = new(sql.NullString)
-		case deploymentpackage.FieldCreateTime, deploymentpackage.FieldUpdateTime:
+		case deploymentpackage.FieldCreateTime, deploymentpackage.FieldUpdateTime, deploymentpackage.FieldDeprecateTime:
 			values[i] = new(sql.NullTime)
 		case deploymentpackage.ForeignKeys[0]: // deployment_package_default_profile
 			values[i] = new(sql.NullInt64)
@@ -242,6 +265,12 @@ func (dp *DeploymentPackage) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				dp.UpdateTime = value.Time
 			}
+		case deploymentpackage.FieldEtag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				dp.Etag = value.Int64
+			}
 		case deploymentpackage.FieldProjectUUID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
@@ -254,6 +283,12 @@ func (dp *DeploymentPackage) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				dp.Version = value.String
 			}
+		case deploymentpackage.FieldVersionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_key", values[i])
+			} else if value.Valid {
+				dp.VersionKey = value.String
+			}
 		case deploymentpackage.FieldIsDeployed:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_deployed", values[i])
@@ -278,6 +313,59 @@ func (dp *DeploymentPackage) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				dp.Kind = value.String
 			}
+		case deploymentpackage.FieldApplicationConstraints:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field application_constraints", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dp.ApplicationConstraints); err != nil {
+					return fmt.Errorf("unmarshal field application_constraints: %w", err)
+				}
+			}
+		case deploymentpackage.FieldSearchText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_text", values[i])
+			} else if value.Valid {
+				dp.SearchText = value.String
+			}
+		case deploymentpackage.FieldPinnedResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_resolution", values[i])
+			} else if value.Valid {
+				dp.PinnedResolution = value.String
+			}
+		case deploymentpackage.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dp.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case deploymentpackage.FieldDeprecated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecated", values[i])
+			} else if value.Valid {
+				dp.Deprecated = value.Bool
+			}
+		case deploymentpackage.FieldDeprecationMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecation_message", values[i])
+			} else if value.Valid {
+				dp.DeprecationMessage = value.String
+			}
+		case deploymentpackage.FieldReplacementVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement_version", values[i])
+			} else if value.Valid {
+				dp.ReplacementVersion = value.String
+			}
+		case deploymentpackage.FieldDeprecateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecate_time", values[i])
+			} else if value.Valid {
+				dp.DeprecateTime = new(time.Time)
+				*dp.DeprecateTime = value.Time
+			}
 		case deploymentpackage.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field deployment_package_default_profile", value)
@@ -389,12 +477,18 @@ func (dp *DeploymentPackage) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(dp.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(fmt.Sprintf("%v", dp.Etag))
+	builder.WriteString(", ")
 	builder.WriteString("project_uuid=")
 	builder.WriteString(dp.ProjectUUID)
 	builder.WriteString(", ")
 	builder.WriteString("version=")
 	builder.WriteString(dp.Version)
 	builder.WriteString(", ")
+	builder.WriteString("version_key=")
+	builder.WriteString(dp.VersionKey)
+	builder.WriteString(", ")
 	builder.WriteString("is_deployed=")
 	builder.WriteString(fmt.Sprintf("%v", dp.IsDeployed))
 	builder.WriteString(", ")
@@ -406,6 +500,32 @@ func (dp *DeploymentPackage) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("kind=")
 	builder.WriteString(dp.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("application_constraints=")
+	builder.WriteString(fmt.Sprintf("%v", dp.ApplicationConstraints))
+	builder.WriteString(", ")
+	builder.WriteString("search_text=")
+	builder.WriteString(dp.SearchText)
+	builder.WriteString(", ")
+	builder.WriteString("pinned_resolution=")
+	builder.WriteString(dp.PinnedResolution)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", dp.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("deprecated=")
+	builder.WriteString(fmt.Sprintf("%v", dp.Deprecated))
+	builder.WriteString(", ")
+	builder.WriteString("deprecation_message=")
+	builder.WriteString(dp.DeprecationMessage)
+	builder.WriteString(", ")
+	builder.WriteString("replacement_version=")
+	builder.WriteString(dp.ReplacementVersion)
+	builder.WriteString(", ")
+	if v := dp.DeprecateTime; v != nil {
+		builder.WriteString("deprecate_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,10 +26,14 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
 	// FieldProjectUUID holds the string denoting the project_uuid field in the database.
 	FieldProjectUUID = "project_uuid"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
+	// FieldVersionKey holds the string denoting the version_key field in the database.
+	FieldVersionKey = "version_key"
 	// FieldIsDeployed holds the string denoting the is_deployed field in the database.
 	FieldIsDeployed = "is_deployed"
 	// FieldIsVisible holds the string denoting the is_visible field in the database.
@@ -38,6 +42,22 @@ const (
 	FieldAllowsMultipleDeployments = "allows_multiple_deployments"
 	// FieldKind holds the string denoting the kind field in the database.
 	FieldKind = "kind"
+	// FieldApplicationConstraints holds the string denoting the application_constraints field in the database.
+	FieldApplicationConstraints = "application_constraints"
+	// FieldSearchText holds the string denoting the search_text field in the database.
+	FieldSearchText = "search_text"
+	// FieldPinnedResolution holds the string denoting the pinned_resolution field in the database.
+	FieldPinnedResolution = "pinned_resolution"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldDeprecated holds the string denoting the deprecated field in the database.
+	FieldDeprecated = "deprecated"
+	// FieldDeprecationMessage holds the string denoting the deprecation_message field in the database.
+	FieldDeprecationMessage = "deprecation_message"
+	// FieldReplacementVersion holds the string denoting the replacement_version field in the database.
+	FieldReplacementVersion = "replacement_version"
+	// FieldDeprecateTime holds the string denoting the deprecate_time field in the database.
+	FieldDeprecateTime = "deprecate_time"
 	// EdgeDeploymentProfiles holds the string denoting the deployment_profiles edge name in mutations.
 	EdgeDeploymentProfiles = "deployment_profiles"
 	// EdgeApplications holds the string denoting the applications edge name in mutations.
@@ -135,12 +155,22 @@ var Columns = []string{
 	FieldDescription,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldEtag,
 	FieldProjectUUID,
 	FieldVersion,
+	FieldVersionKey,
 	FieldIsDeployed,
 	FieldIsVisible,
 	FieldAllowsMultipleDeployments,
 	FieldKind,
+	FieldApplicationConstraints,
+	FieldSearchText,
+	FieldPinnedResolution,
+	FieldLabels,
+	FieldDeprecated,
+	FieldDeprecationMessage,
+	FieldReplacementVersion,
+	FieldDeprecateTime,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "deployment_packages"
@@ -183,8 +213,14 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultEtag holds the default value on creation for the "etag" field.
+	DefaultEtag func() int64
+	// UpdateDefaultEtag holds the default value on update for the "etag" field.
+	UpdateDefaultEtag func() int64
 	// DefaultProjectUUID holds the default value on creation for the "project_uuid" field.
 	DefaultProjectUUID string
+	// DefaultDeprecated holds the default value on creation for the "deprecated" field.
+	DefaultDeprecated bool
 )
 
 // OrderOption defines the ordering options for the DeploymentPackage queries.
@@ -225,6 +261,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByEtag orders the results by the etag field.
+func ByEtag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEtag, opts...).ToFunc()
+}
+
 // ByProjectUUID orders the results by the project_uuid field.
 func ByProjectUUID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectUUID, opts...).ToFunc()
@@ -235,6 +276,11 @@ func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
+// ByVersionKey orders the results by the version_key field.
+func ByVersionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionKey, opts...).ToFunc()
+}
+
 // ByIsDeployed orders the results by the is_deployed field.
 func ByIsDeployed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDeployed, opts...).ToFunc()
@@ -255,6 +301,36 @@ func ByKind(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKind, opts...).ToFunc()
 }
 
+// BySearchText orders the results by the search_text field.
+func BySearchText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchText, opts...).ToFunc()
+}
+
+// ByPinnedResolution orders the results by the pinned_resolution field.
+func ByPinnedResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedResolution, opts...).ToFunc()
+}
+
+// ByDeprecated orders the results by the deprecated field.
+func ByDeprecated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecated, opts...).ToFunc()
+}
+
+// ByDeprecationMessage orders the results by the deprecation_message field.
+func ByDeprecationMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecationMessage, opts...).ToFunc()
+}
+
+// ByReplacementVersion orders the results by the replacement_version field.
+func ByReplacementVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacementVersion, opts...).ToFunc()
+}
+
+// ByDeprecateTime orders the results by the deprecate_time field.
+func ByDeprecateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecateTime, opts...).ToFunc()
+}
+
 // ByDeploymentProfilesCount orders the results by deployment_profiles count.
 func ByDeploymentProfilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -85,6 +85,11 @@ func UpdateTime(v time.Time) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldEtag, v))
+}
+
 // ProjectUUID applies equality check predicate on the "project_uuid" field. It's identical to ProjectUUIDEQ.
 func ProjectUUID(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldProjectUUID, v))
@@ -95,6 +100,11 @@ func Version(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldVersion, v))
 }
 
+// VersionKey applies equality check predicate on the "version_key" field. It's identical to VersionKeyEQ.
+func VersionKey(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldVersionKey, v))
+}
+
 // IsDeployed applies equality check predicate on the "is_deployed" field. It's identical to IsDeployedEQ.
 func IsDeployed(v bool) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldIsDeployed, v))
@@ -115,6 +125,36 @@ func Kind(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldKind, v))
 }
 
+// SearchText applies equality check predicate on the "search_text" field. It's identical to SearchTextEQ.
+func SearchText(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldSearchText, v))
+}
+
+// PinnedResolution applies equality check predicate on the "pinned_resolution" field. It's identical to PinnedResolutionEQ.
+func PinnedResolution(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldPinnedResolution, v))
+}
+
+// Deprecated applies equality check predicate on the "deprecated" field. It's identical to DeprecatedEQ.
+func Deprecated(v bool) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// DeprecationMessage applies equality check predicate on the "deprecation_message" field. It's identical to DeprecationMessageEQ.
+func DeprecationMessage(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldDeprecationMessage, v))
+}
+
+// ReplacementVersion applies equality check predicate on the "replacement_version" field. It's identical to ReplacementVersionEQ.
+func ReplacementVersion(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldReplacementVersion, v))
+}
+
+// DeprecateTime applies equality check predicate on the "deprecate_time" field. It's identical to DeprecateTimeEQ.
+func DeprecateTime(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldDeprecateTime, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldName, v))
@@ -485,6 +525,56 @@ func UpdateTimeLTE(v time.Time) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v int64) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldEtag))
+}
+
 // ProjectUUIDEQ applies the EQ predicate on the "project_uuid" field.
 func ProjectUUIDEQ(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldProjectUUID, v))
@@ -615,6 +705,81 @@ func VersionContainsFold(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldVersion, v))
 }
 
+// VersionKeyEQ applies the EQ predicate on the "version_key" field.
+func VersionKeyEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldVersionKey, v))
+}
+
+// VersionKeyNEQ applies the NEQ predicate on the "version_key" field.
+func VersionKeyNEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldVersionKey, v))
+}
+
+// VersionKeyIn applies the In predicate on the "version_key" field.
+func VersionKeyIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldVersionKey, vs...))
+}
+
+// VersionKeyNotIn applies the NotIn predicate on the "version_key" field.
+func VersionKeyNotIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldVersionKey, vs...))
+}
+
+// VersionKeyGT applies the GT predicate on the "version_key" field.
+func VersionKeyGT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldVersionKey, v))
+}
+
+// VersionKeyGTE applies the GTE predicate on the "version_key" field.
+func VersionKeyGTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldVersionKey, v))
+}
+
+// VersionKeyLT applies the LT predicate on the "version_key" field.
+func VersionKeyLT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldVersionKey, v))
+}
+
+// VersionKeyLTE applies the LTE predicate on the "version_key" field.
+func VersionKeyLTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldVersionKey, v))
+}
+
+// VersionKeyContains applies the Contains predicate on the "version_key" field.
+func VersionKeyContains(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContains(FieldVersionKey, v))
+}
+
+// VersionKeyHasPrefix applies the HasPrefix predicate on the "version_key" field.
+func VersionKeyHasPrefix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasPrefix(FieldVersionKey, v))
+}
+
+// VersionKeyHasSuffix applies the HasSuffix predicate on the "version_key" field.
+func VersionKeyHasSuffix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasSuffix(FieldVersionKey, v))
+}
+
+// VersionKeyIsNil applies the IsNil predicate on the "version_key" field.
+func VersionKeyIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldVersionKey))
+}
+
+// VersionKeyNotNil applies the NotNil predicate on the "version_key" field.
+func VersionKeyNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldVersionKey))
+}
+
+// VersionKeyEqualFold applies the EqualFold predicate on the "version_key" field.
+func VersionKeyEqualFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEqualFold(FieldVersionKey, v))
+}
+
+// VersionKeyContainsFold applies the ContainsFold predicate on the "version_key" field.
+func VersionKeyContainsFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldVersionKey, v))
+}
+
 // IsDeployedEQ applies the EQ predicate on the "is_deployed" field.
 func IsDeployedEQ(v bool) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldEQ(FieldIsDeployed, v))
@@ -750,6 +915,386 @@ func KindContainsFold(v string) predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldKind, v))
 }
 
+// ApplicationConstraintsIsNil applies the IsNil predicate on the "application_constraints" field.
+func ApplicationConstraintsIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldApplicationConstraints))
+}
+
+// ApplicationConstraintsNotNil applies the NotNil predicate on the "application_constraints" field.
+func ApplicationConstraintsNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldApplicationConstraints))
+}
+
+// SearchTextEQ applies the EQ predicate on the "search_text" field.
+func SearchTextEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldSearchText, v))
+}
+
+// SearchTextNEQ applies the NEQ predicate on the "search_text" field.
+func SearchTextNEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldSearchText, v))
+}
+
+// SearchTextIn applies the In predicate on the "search_text" field.
+func SearchTextIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldSearchText, vs...))
+}
+
+// SearchTextNotIn applies the NotIn predicate on the "search_text" field.
+func SearchTextNotIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldSearchText, vs...))
+}
+
+// SearchTextGT applies the GT predicate on the "search_text" field.
+func SearchTextGT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldSearchText, v))
+}
+
+// SearchTextGTE applies the GTE predicate on the "search_text" field.
+func SearchTextGTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldSearchText, v))
+}
+
+// SearchTextLT applies the LT predicate on the "search_text" field.
+func SearchTextLT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldSearchText, v))
+}
+
+// SearchTextLTE applies the LTE predicate on the "search_text" field.
+func SearchTextLTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldSearchText, v))
+}
+
+// SearchTextContains applies the Contains predicate on the "search_text" field.
+func SearchTextContains(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContains(FieldSearchText, v))
+}
+
+// SearchTextHasPrefix applies the HasPrefix predicate on the "search_text" field.
+func SearchTextHasPrefix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasPrefix(FieldSearchText, v))
+}
+
+// SearchTextHasSuffix applies the HasSuffix predicate on the "search_text" field.
+func SearchTextHasSuffix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasSuffix(FieldSearchText, v))
+}
+
+// SearchTextIsNil applies the IsNil predicate on the "search_text" field.
+func SearchTextIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldSearchText))
+}
+
+// SearchTextNotNil applies the NotNil predicate on the "search_text" field.
+func SearchTextNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldSearchText))
+}
+
+// SearchTextEqualFold applies the EqualFold predicate on the "search_text" field.
+func SearchTextEqualFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEqualFold(FieldSearchText, v))
+}
+
+// SearchTextContainsFold applies the ContainsFold predicate on the "search_text" field.
+func SearchTextContainsFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldSearchText, v))
+}
+
+// PinnedResolutionEQ applies the EQ predicate on the "pinned_resolution" field.
+func PinnedResolutionEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionNEQ applies the NEQ predicate on the "pinned_resolution" field.
+func PinnedResolutionNEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionIn applies the In predicate on the "pinned_resolution" field.
+func PinnedResolutionIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldPinnedResolution, vs...))
+}
+
+// PinnedResolutionNotIn applies the NotIn predicate on the "pinned_resolution" field.
+func PinnedResolutionNotIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldPinnedResolution, vs...))
+}
+
+// PinnedResolutionGT applies the GT predicate on the "pinned_resolution" field.
+func PinnedResolutionGT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionGTE applies the GTE predicate on the "pinned_resolution" field.
+func PinnedResolutionGTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionLT applies the LT predicate on the "pinned_resolution" field.
+func PinnedResolutionLT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionLTE applies the LTE predicate on the "pinned_resolution" field.
+func PinnedResolutionLTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionContains applies the Contains predicate on the "pinned_resolution" field.
+func PinnedResolutionContains(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContains(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionHasPrefix applies the HasPrefix predicate on the "pinned_resolution" field.
+func PinnedResolutionHasPrefix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasPrefix(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionHasSuffix applies the HasSuffix predicate on the "pinned_resolution" field.
+func PinnedResolutionHasSuffix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasSuffix(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionIsNil applies the IsNil predicate on the "pinned_resolution" field.
+func PinnedResolutionIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldPinnedResolution))
+}
+
+// PinnedResolutionNotNil applies the NotNil predicate on the "pinned_resolution" field.
+func PinnedResolutionNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldPinnedResolution))
+}
+
+// PinnedResolutionEqualFold applies the EqualFold predicate on the "pinned_resolution" field.
+func PinnedResolutionEqualFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEqualFold(FieldPinnedResolution, v))
+}
+
+// PinnedResolutionContainsFold applies the ContainsFold predicate on the "pinned_resolution" field.
+func PinnedResolutionContainsFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldPinnedResolution, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldLabels))
+}
+
+// DeprecatedEQ applies the EQ predicate on the "deprecated" field.
+func DeprecatedEQ(v bool) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// DeprecatedNEQ applies the NEQ predicate on the "deprecated" field.
+func DeprecatedNEQ(v bool) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldDeprecated, v))
+}
+
+// DeprecationMessageEQ applies the EQ predicate on the "deprecation_message" field.
+func DeprecationMessageEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageNEQ applies the NEQ predicate on the "deprecation_message" field.
+func DeprecationMessageNEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageIn applies the In predicate on the "deprecation_message" field.
+func DeprecationMessageIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldDeprecationMessage, vs...))
+}
+
+// DeprecationMessageNotIn applies the NotIn predicate on the "deprecation_message" field.
+func DeprecationMessageNotIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldDeprecationMessage, vs...))
+}
+
+// DeprecationMessageGT applies the GT predicate on the "deprecation_message" field.
+func DeprecationMessageGT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageGTE applies the GTE predicate on the "deprecation_message" field.
+func DeprecationMessageGTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageLT applies the LT predicate on the "deprecation_message" field.
+func DeprecationMessageLT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageLTE applies the LTE predicate on the "deprecation_message" field.
+func DeprecationMessageLTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageContains applies the Contains predicate on the "deprecation_message" field.
+func DeprecationMessageContains(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContains(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageHasPrefix applies the HasPrefix predicate on the "deprecation_message" field.
+func DeprecationMessageHasPrefix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasPrefix(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageHasSuffix applies the HasSuffix predicate on the "deprecation_message" field.
+func DeprecationMessageHasSuffix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasSuffix(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageIsNil applies the IsNil predicate on the "deprecation_message" field.
+func DeprecationMessageIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldDeprecationMessage))
+}
+
+// DeprecationMessageNotNil applies the NotNil predicate on the "deprecation_message" field.
+func DeprecationMessageNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldDeprecationMessage))
+}
+
+// DeprecationMessageEqualFold applies the EqualFold predicate on the "deprecation_message" field.
+func DeprecationMessageEqualFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEqualFold(FieldDeprecationMessage, v))
+}
+
+// DeprecationMessageContainsFold applies the ContainsFold predicate on the "deprecation_message" field.
+func DeprecationMessageContainsFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldDeprecationMessage, v))
+}
+
+// ReplacementVersionEQ applies the EQ predicate on the "replacement_version" field.
+func ReplacementVersionEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionNEQ applies the NEQ predicate on the "replacement_version" field.
+func ReplacementVersionNEQ(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionIn applies the In predicate on the "replacement_version" field.
+func ReplacementVersionIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldReplacementVersion, vs...))
+}
+
+// ReplacementVersionNotIn applies the NotIn predicate on the "replacement_version" field.
+func ReplacementVersionNotIn(vs ...string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldReplacementVersion, vs...))
+}
+
+// ReplacementVersionGT applies the GT predicate on the "replacement_version" field.
+func ReplacementVersionGT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionGTE applies the GTE predicate on the "replacement_version" field.
+func ReplacementVersionGTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionLT applies the LT predicate on the "replacement_version" field.
+func ReplacementVersionLT(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionLTE applies the LTE predicate on the "replacement_version" field.
+func ReplacementVersionLTE(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionContains applies the Contains predicate on the "replacement_version" field.
+func ReplacementVersionContains(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContains(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionHasPrefix applies the HasPrefix predicate on the "replacement_version" field.
+func ReplacementVersionHasPrefix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasPrefix(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionHasSuffix applies the HasSuffix predicate on the "replacement_version" field.
+func ReplacementVersionHasSuffix(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldHasSuffix(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionIsNil applies the IsNil predicate on the "replacement_version" field.
+func ReplacementVersionIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldReplacementVersion))
+}
+
+// ReplacementVersionNotNil applies the NotNil predicate on the "replacement_version" field.
+func ReplacementVersionNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldReplacementVersion))
+}
+
+// ReplacementVersionEqualFold applies the EqualFold predicate on the "replacement_version" field.
+func ReplacementVersionEqualFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEqualFold(FieldReplacementVersion, v))
+}
+
+// ReplacementVersionContainsFold applies the ContainsFold predicate on the "replacement_version" field.
+func ReplacementVersionContainsFold(v string) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldContainsFold(FieldReplacementVersion, v))
+}
+
+// DeprecateTimeEQ applies the EQ predicate on the "deprecate_time" field.
+func DeprecateTimeEQ(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldEQ(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeNEQ applies the NEQ predicate on the "deprecate_time" field.
+func DeprecateTimeNEQ(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNEQ(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeIn applies the In predicate on the "deprecate_time" field.
+func DeprecateTimeIn(vs ...time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIn(FieldDeprecateTime, vs...))
+}
+
+// DeprecateTimeNotIn applies the NotIn predicate on the "deprecate_time" field.
+func DeprecateTimeNotIn(vs ...time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotIn(FieldDeprecateTime, vs...))
+}
+
+// DeprecateTimeGT applies the GT predicate on the "deprecate_time" field.
+func DeprecateTimeGT(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGT(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeGTE applies the GTE predicate on the "deprecate_time" field.
+func DeprecateTimeGTE(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldGTE(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeLT applies the LT predicate on the "deprecate_time" field.
+func DeprecateTimeLT(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLT(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeLTE applies the LTE predicate on the "deprecate_time" field.
+func DeprecateTimeLTE(v time.Time) predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldLTE(FieldDeprecateTime, v))
+}
+
+// DeprecateTimeIsNil applies the IsNil predicate on the "deprecate_time" field.
+func DeprecateTimeIsNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldIsNull(FieldDeprecateTime))
+}
+
+// DeprecateTimeNotNil applies the NotNil predicate on the "deprecate_time" field.
+func DeprecateTimeNotNil() predicate.DeploymentPackage {
+	return predicate.DeploymentPackage(sql.FieldNotNull(FieldDeprecateTime))
+}
+
 // HasDeploymentProfiles applies the HasEdge predicate on the "deployment_profiles" edge.
 func HasDeploymentProfiles() predicate.DeploymentPackage {
 	return predicate.DeploymentPackage(func(s *sql.Selector) {
@@ -104,6 +104,20 @@ func (dpc *DeploymentPackageCreate) SetNillableUpdateTime(t *time.Time) *Deploym
 	return dpc
 }
 
+// SetEtag sets the "etag" field.
+func (dpc *DeploymentPackageCreate) SetEtag(i int64) *DeploymentPackageCreate {
+	dpc.mutation.SetEtag(i)
+	return dpc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableEtag(i *int64) *DeploymentPackageCreate {
+	if i != nil {
+		dpc.SetEtag(*i)
+	}
+	return dpc
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (dpc *DeploymentPackageCreate) SetProjectUUID(s string) *DeploymentPackageCreate {
 	dpc.mutation.SetProjectUUID(s)
@@ -124,6 +138,20 @@ func (dpc *DeploymentPackageCreate) SetVersion(s string) *DeploymentPackageCreat
 	return dpc
 }
 
+// SetVersionKey sets the "version_key" field.
+func (dpc *DeploymentPackageCreate) SetVersionKey(s string) *DeploymentPackageCreate {
+	dpc.mutation.SetVersionKey(s)
+	return dpc
+}
+
+// SetNillableVersionKey sets the "version_key" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableVersionKey(s *string) *DeploymentPackageCreate {
+	if s != nil {
+		dpc.SetVersionKey(*s)
+	}
+	return dpc
+}
+
 // SetIsDeployed sets the "is_deployed" field.
 func (dpc *DeploymentPackageCreate) SetIsDeployed(b bool) *DeploymentPackageCreate {
 	dpc.mutation.SetIsDeployed(b)
@@ -180,6 +208,102 @@ func (dpc *DeploymentPackageCreate) SetNillableKind(s *string) *DeploymentPackag
 	return dpc
 }
 
+// SetApplicationConstraints sets the "application_constraints" field.
+func (dpc *DeploymentPackageCreate) SetApplicationConstraints(m map[string]string) *DeploymentPackageCreate {
+	dpc.mutation.SetApplicationConstraints(m)
+	return dpc
+}
+
+// SetSearchText sets the "search_text" field.
+func (dpc *DeploymentPackageCreate) SetSearchText(s string) *DeploymentPackageCreate {
+	dpc.mutation.SetSearchText(s)
+	return dpc
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableSearchText(s *string) *DeploymentPackageCreate {
+	if s != nil {
+		dpc.SetSearchText(*s)
+	}
+	return dpc
+}
+
+// SetPinnedResolution sets the "pinned_resolution" field.
+func (dpc *DeploymentPackageCreate) SetPinnedResolution(s string) *DeploymentPackageCreate {
+	dpc.mutation.SetPinnedResolution(s)
+	return dpc
+}
+
+// SetNillablePinnedResolution sets the "pinned_resolution" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillablePinnedResolution(s *string) *DeploymentPackageCreate {
+	if s != nil {
+		dpc.SetPinnedResolution(*s)
+	}
+	return dpc
+}
+
+// SetLabels sets the "labels" field.
+func (dpc *DeploymentPackageCreate) SetLabels(m map[string]string) *DeploymentPackageCreate {
+	dpc.mutation.SetLabels(m)
+	return dpc
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (dpc *DeploymentPackageCreate) SetDeprecated(b bool) *DeploymentPackageCreate {
+	dpc.mutation.SetDeprecated(b)
+	return dpc
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableDeprecated(b *bool) *DeploymentPackageCreate {
+	if b != nil {
+		dpc.SetDeprecated(*b)
+	}
+	return dpc
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (dpc *DeploymentPackageCreate) SetDeprecationMessage(s string) *DeploymentPackageCreate {
+	dpc.mutation.SetDeprecationMessage(s)
+	return dpc
+}
+
+// SetNillableDeprecationMessage sets the "deprecation_message" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableDeprecationMessage(s *string) *DeploymentPackageCreate {
+	if s != nil {
+		dpc.SetDeprecationMessage(*s)
+	}
+	return dpc
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (dpc *DeploymentPackageCreate) SetReplacementVersion(s string) *DeploymentPackageCreate {
+	dpc.mutation.SetReplacementVersion(s)
+	return dpc
+}
+
+// SetNillableReplacementVersion sets the "replacement_version" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableReplacementVersion(s *string) *DeploymentPackageCreate {
+	if s != nil {
+		dpc.SetReplacementVersion(*s)
+	}
+	return dpc
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (dpc *DeploymentPackageCreate) SetDeprecateTime(t time.Time) *DeploymentPackageCreate {
+	dpc.mutation.SetDeprecateTime(t)
+	return dpc
+}
+
+// SetNillableDeprecateTime sets the "deprecate_time" field if the given value is not nil.
+func (dpc *DeploymentPackageCreate) SetNillableDeprecateTime(t *time.Time) *DeploymentPackageCreate {
+	if t != nil {
+		dpc.SetDeprecateTime(*t)
+	}
+	return dpc
+}
+
 // AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
 func (dpc *DeploymentPackageCreate) AddDeploymentProfileIDs(ids ...uint64) *DeploymentPackageCreate {
 	dpc.mutation.AddDeploymentProfileIDs(ids...)
@@ -377,10 +501,18 @@ func (dpc *DeploymentPackageCreate) defaults() {
 		v := deploymentpackage.DefaultUpdateTime()
 		dpc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := dpc.mutation.Etag(); !ok {
+		v := deploymentpackage.DefaultEtag()
+		dpc.mutation.SetEtag(v)
+	}
 	if _, ok := dpc.mutation.ProjectUUID(); !ok {
 		v := deploymentpackage.DefaultProjectUUID
 		dpc.mutation.SetProjectUUID(v)
 	}
+	if _, ok := dpc.mutation.Deprecated(); !ok {
+		v := deploymentpackage.DefaultDeprecated
+		dpc.mutation.SetDeprecated(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -400,6 +532,9 @@ func (dpc *DeploymentPackageCreate) check() error {
 	if _, ok := dpc.mutation.Version(); !ok {
 		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "DeploymentPackage.version"`)}
 	}
+	if _, ok := dpc.mutation.Deprecated(); !ok {
+		return &ValidationError{Name: "deprecated", err: errors.New(`generated: missing required field "DeploymentPackage.deprecated"`)}
+	}
 	return nil
 }
 
@@ -450,6 +585,10 @@ func (dpc *DeploymentPackageCreate) createSpec() (*DeploymentPackage, *sqlgraph.
 		_spec.SetField(deploymentpackage.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := dpc.mutation.Etag(); ok {
+		_spec.SetField(deploymentpackage.FieldEtag, field.TypeInt64, value)
+		_node.Etag = value
+	}
 	if value, ok := dpc.mutation.ProjectUUID(); ok {
 		_spec.SetField(deploymentpackage.FieldProjectUUID, field.TypeString, value)
 		_node.ProjectUUID = value
@@ -458,6 +597,10 @@ func (dpc *DeploymentPackageCreate) createSpec() (*DeploymentPackage, *sqlgraph.
 		_spec.SetField(deploymentpackage.FieldVersion, field.TypeString, value)
 		_node.Version = value
 	}
+	if value, ok := dpc.mutation.VersionKey(); ok {
+		_spec.SetField(deploymentpackage.FieldVersionKey, field.TypeString, value)
+		_node.VersionKey = value
+	}
 	if value, ok := dpc.mutation.IsDeployed(); ok {
 		_spec.SetField(deploymentpackage.FieldIsDeployed, field.TypeBool, value)
 		_node.IsDeployed = value
@@ -474,6 +617,38 @@ func (dpc *DeploymentPackageCreate) createSpec() (*DeploymentPackage, *sqlgraph.
 		_spec.SetField(deploymentpackage.FieldKind, field.TypeString, value)
 		_node.Kind = value
 	}
+	if value, ok := dpc.mutation.ApplicationConstraints(); ok {
+		_spec.SetField(deploymentpackage.FieldApplicationConstraints, field.TypeJSON, value)
+		_node.ApplicationConstraints = value
+	}
+	if value, ok := dpc.mutation.SearchText(); ok {
+		_spec.SetField(deploymentpackage.FieldSearchText, field.TypeString, value)
+		_node.SearchText = value
+	}
+	if value, ok := dpc.mutation.PinnedResolution(); ok {
+		_spec.SetField(deploymentpackage.FieldPinnedResolution, field.TypeString, value)
+		_node.PinnedResolution = value
+	}
+	if value, ok := dpc.mutation.Labels(); ok {
+		_spec.SetField(deploymentpackage.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := dpc.mutation.Deprecated(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecated, field.TypeBool, value)
+		_node.Deprecated = value
+	}
+	if value, ok := dpc.mutation.DeprecationMessage(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecationMessage, field.TypeString, value)
+		_node.DeprecationMessage = value
+	}
+	if value, ok := dpc.mutation.ReplacementVersion(); ok {
+		_spec.SetField(deploymentpackage.FieldReplacementVersion, field.TypeString, value)
+		_node.ReplacementVersion = value
+	}
+	if value, ok := dpc.mutation.DeprecateTime(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecateTime, field.TypeTime, value)
+		_node.DeprecateTime = &value
+	}
 	if nodes := dpc.mutation.DeploymentProfilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
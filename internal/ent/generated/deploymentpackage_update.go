@@ -116,6 +116,25 @@ func (dpu *DeploymentPackageUpdate) SetUpdateTime(t time.Time) *DeploymentPackag
 	return dpu
 }
 
+// SetEtag sets the "etag" field.
+func (dpu *DeploymentPackageUpdate) SetEtag(i int64) *DeploymentPackageUpdate {
+	dpu.mutation.ResetEtag()
+	dpu.mutation.SetEtag(i)
+	return dpu
+}
+
+// AddEtag adds i to the "etag" field.
+func (dpu *DeploymentPackageUpdate) AddEtag(i int64) *DeploymentPackageUpdate {
+	dpu.mutation.AddEtag(i)
+	return dpu
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (dpu *DeploymentPackageUpdate) ClearEtag() *DeploymentPackageUpdate {
+	dpu.mutation.ClearEtag()
+	return dpu
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (dpu *DeploymentPackageUpdate) SetProjectUUID(s string) *DeploymentPackageUpdate {
 	dpu.mutation.SetProjectUUID(s)
@@ -144,6 +163,26 @@ func (dpu *DeploymentPackageUpdate) SetNillableVersion(s *string) *DeploymentPac
 	return dpu
 }
 
+// SetVersionKey sets the "version_key" field.
+func (dpu *DeploymentPackageUpdate) SetVersionKey(s string) *DeploymentPackageUpdate {
+	dpu.mutation.SetVersionKey(s)
+	return dpu
+}
+
+// SetNillableVersionKey sets the "version_key" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillableVersionKey(s *string) *DeploymentPackageUpdate {
+	if s != nil {
+		dpu.SetVersionKey(*s)
+	}
+	return dpu
+}
+
+// ClearVersionKey clears the value of the "version_key" field.
+func (dpu *DeploymentPackageUpdate) ClearVersionKey() *DeploymentPackageUpdate {
+	dpu.mutation.ClearVersionKey()
+	return dpu
+}
+
 // SetIsDeployed sets the "is_deployed" field.
 func (dpu *DeploymentPackageUpdate) SetIsDeployed(b bool) *DeploymentPackageUpdate {
 	dpu.mutation.SetIsDeployed(b)
@@ -224,6 +263,144 @@ func (dpu *DeploymentPackageUpdate) ClearKind() *DeploymentPackageUpdate {
 	return dpu
 }
 
+// SetApplicationConstraints sets the "application_constraints" field.
+func (dpu *DeploymentPackageUpdate) SetApplicationConstraints(m map[string]string) *DeploymentPackageUpdate {
+	dpu.mutation.SetApplicationConstraints(m)
+	return dpu
+}
+
+// ClearApplicationConstraints clears the value of the "application_constraints" field.
+func (dpu *DeploymentPackageUpdate) ClearApplicationConstraints() *DeploymentPackageUpdate {
+	dpu.mutation.ClearApplicationConstraints()
+	return dpu
+}
+
+// SetSearchText sets the "search_text" field.
+func (dpu *DeploymentPackageUpdate) SetSearchText(s string) *DeploymentPackageUpdate {
+	dpu.mutation.SetSearchText(s)
+	return dpu
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillableSearchText(s *string) *DeploymentPackageUpdate {
+	if s != nil {
+		dpu.SetSearchText(*s)
+	}
+	return dpu
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (dpu *DeploymentPackageUpdate) ClearSearchText() *DeploymentPackageUpdate {
+	dpu.mutation.ClearSearchText()
+	return dpu
+}
+
+// SetPinnedResolution sets the "pinned_resolution" field.
+func (dpu *DeploymentPackageUpdate) SetPinnedResolution(s string) *DeploymentPackageUpdate {
+	dpu.mutation.SetPinnedResolution(s)
+	return dpu
+}
+
+// SetNillablePinnedResolution sets the "pinned_resolution" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillablePinnedResolution(s *string) *DeploymentPackageUpdate {
+	if s != nil {
+		dpu.SetPinnedResolution(*s)
+	}
+	return dpu
+}
+
+// ClearPinnedResolution clears the value of the "pinned_resolution" field.
+func (dpu *DeploymentPackageUpdate) ClearPinnedResolution() *DeploymentPackageUpdate {
+	dpu.mutation.ClearPinnedResolution()
+	return dpu
+}
+
+// SetLabels sets the "labels" field.
+func (dpu *DeploymentPackageUpdate) SetLabels(m map[string]string) *DeploymentPackageUpdate {
+	dpu.mutation.SetLabels(m)
+	return dpu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (dpu *DeploymentPackageUpdate) ClearLabels() *DeploymentPackageUpdate {
+	dpu.mutation.ClearLabels()
+	return dpu
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (dpu *DeploymentPackageUpdate) SetDeprecated(b bool) *DeploymentPackageUpdate {
+	dpu.mutation.SetDeprecated(b)
+	return dpu
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillableDeprecated(b *bool) *DeploymentPackageUpdate {
+	if b != nil {
+		dpu.SetDeprecated(*b)
+	}
+	return dpu
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (dpu *DeploymentPackageUpdate) SetDeprecationMessage(s string) *DeploymentPackageUpdate {
+	dpu.mutation.SetDeprecationMessage(s)
+	return dpu
+}
+
+// SetNillableDeprecationMessage sets the "deprecation_message" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillableDeprecationMessage(s *string) *DeploymentPackageUpdate {
+	if s != nil {
+		dpu.SetDeprecationMessage(*s)
+	}
+	return dpu
+}
+
+// ClearDeprecationMessage clears the value of the "deprecation_message" field.
+func (dpu *DeploymentPackageUpdate) ClearDeprecationMessage() *DeploymentPackageUpdate {
+	dpu.mutation.ClearDeprecationMessage()
+	return dpu
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (dpu *DeploymentPackageUpdate) SetReplacementVersion(s string) *DeploymentPackageUpdate {
+	dpu.mutation.SetReplacementVersion(s)
+	return dpu
+}
+
+// SetNillableReplacementVersion sets the "replacement_version" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillableReplacementVersion(s *string) *DeploymentPackageUpdate {
+	if s != nil {
+		dpu.SetReplacementVersion(*s)
+	}
+	return dpu
+}
+
+// ClearReplacementVersion clears the value of the "replacement_version" field.
+func (dpu *DeploymentPackageUpdate) ClearReplacementVersion() *DeploymentPackageUpdate {
+	dpu.mutation.ClearReplacementVersion()
+	return dpu
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (dpu *DeploymentPackageUpdate) SetDeprecateTime(t time.Time) *DeploymentPackageUpdate {
+	dpu.mutation.SetDeprecateTime(t)
+	return dpu
+}
+
+// SetNillableDeprecateTime sets the "deprecate_time" field if the given value is not nil.
+func (dpu *DeploymentPackageUpdate) SetNillableDeprecateTime(t *time.Time) *DeploymentPackageUpdate {
+	if t != nil {
+		dpu.SetDeprecateTime(*t)
+	}
+	return dpu
+}
+
+// ClearDeprecateTime clears the value of the "deprecate_time" field.
+func (dpu *DeploymentPackageUpdate) ClearDeprecateTime() *DeploymentPackageUpdate {
+	dpu.mutation.ClearDeprecateTime()
+	return dpu
+}
+
 // AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
 func (dpu *DeploymentPackageUpdate) AddDeploymentProfileIDs(ids ...uint64) *DeploymentPackageUpdate {
 	dpu.mutation.AddDeploymentProfileIDs(ids...)
@@ -612,6 +789,10 @@ func (dpu *DeploymentPackageUpdate) defaults() {
 		v := deploymentpackage.UpdateDefaultUpdateTime()
 		dpu.mutation.SetUpdateTime(v)
 	}
+	if _, ok := dpu.mutation.Etag(); !ok && !dpu.mutation.EtagCleared() {
+		v := deploymentpackage.UpdateDefaultEtag()
+		dpu.mutation.SetEtag(v)
+	}
 }
 
 func (dpu *DeploymentPackageUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -647,12 +828,27 @@ func (dpu *DeploymentPackageUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := dpu.mutation.UpdateTime(); ok {
 		_spec.SetField(deploymentpackage.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := dpu.mutation.Etag(); ok {
+		_spec.SetField(deploymentpackage.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := dpu.mutation.AddedEtag(); ok {
+		_spec.AddField(deploymentpackage.FieldEtag, field.TypeInt64, value)
+	}
+	if dpu.mutation.EtagCleared() {
+		_spec.ClearField(deploymentpackage.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := dpu.mutation.ProjectUUID(); ok {
 		_spec.SetField(deploymentpackage.FieldProjectUUID, field.TypeString, value)
 	}
 	if value, ok := dpu.mutation.Version(); ok {
 		_spec.SetField(deploymentpackage.FieldVersion, field.TypeString, value)
 	}
+	if value, ok := dpu.mutation.VersionKey(); ok {
+		_spec.SetField(deploymentpackage.FieldVersionKey, field.TypeString, value)
+	}
+	if dpu.mutation.VersionKeyCleared() {
+		_spec.ClearField(deploymentpackage.FieldVersionKey, field.TypeString)
+	}
 	if value, ok := dpu.mutation.IsDeployed(); ok {
 		_spec.SetField(deploymentpackage.FieldIsDeployed, field.TypeBool, value)
 	}
@@ -677,6 +873,51 @@ func (dpu *DeploymentPackageUpdate) sqlSave(ctx context.Context) (n int, err err
 	if dpu.mutation.KindCleared() {
 		_spec.ClearField(deploymentpackage.FieldKind, field.TypeString)
 	}
+	if value, ok := dpu.mutation.ApplicationConstraints(); ok {
+		_spec.SetField(deploymentpackage.FieldApplicationConstraints, field.TypeJSON, value)
+	}
+	if dpu.mutation.ApplicationConstraintsCleared() {
+		_spec.ClearField(deploymentpackage.FieldApplicationConstraints, field.TypeJSON)
+	}
+	if value, ok := dpu.mutation.SearchText(); ok {
+		_spec.SetField(deploymentpackage.FieldSearchText, field.TypeString, value)
+	}
+	if dpu.mutation.SearchTextCleared() {
+		_spec.ClearField(deploymentpackage.FieldSearchText, field.TypeString)
+	}
+	if value, ok := dpu.mutation.PinnedResolution(); ok {
+		_spec.SetField(deploymentpackage.FieldPinnedResolution, field.TypeString, value)
+	}
+	if dpu.mutation.PinnedResolutionCleared() {
+		_spec.ClearField(deploymentpackage.FieldPinnedResolution, field.TypeString)
+	}
+	if value, ok := dpu.mutation.Labels(); ok {
+		_spec.SetField(deploymentpackage.FieldLabels, field.TypeJSON, value)
+	}
+	if dpu.mutation.LabelsCleared() {
+		_spec.ClearField(deploymentpackage.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := dpu.mutation.Deprecated(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecated, field.TypeBool, value)
+	}
+	if value, ok := dpu.mutation.DeprecationMessage(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecationMessage, field.TypeString, value)
+	}
+	if dpu.mutation.DeprecationMessageCleared() {
+		_spec.ClearField(deploymentpackage.FieldDeprecationMessage, field.TypeString)
+	}
+	if value, ok := dpu.mutation.ReplacementVersion(); ok {
+		_spec.SetField(deploymentpackage.FieldReplacementVersion, field.TypeString, value)
+	}
+	if dpu.mutation.ReplacementVersionCleared() {
+		_spec.ClearField(deploymentpackage.FieldReplacementVersion, field.TypeString)
+	}
+	if value, ok := dpu.mutation.DeprecateTime(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecateTime, field.TypeTime, value)
+	}
+	if dpu.mutation.DeprecateTimeCleared() {
+		_spec.ClearField(deploymentpackage.FieldDeprecateTime, field.TypeTime)
+	}
 	if dpu.mutation.DeploymentProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1211,6 +1452,25 @@ func (dpuo *DeploymentPackageUpdateOne) SetUpdateTime(t time.Time) *DeploymentPa
 	return dpuo
 }
 
+// SetEtag sets the "etag" field.
+func (dpuo *DeploymentPackageUpdateOne) SetEtag(i int64) *DeploymentPackageUpdateOne {
+	dpuo.mutation.ResetEtag()
+	dpuo.mutation.SetEtag(i)
+	return dpuo
+}
+
+// AddEtag adds i to the "etag" field.
+func (dpuo *DeploymentPackageUpdateOne) AddEtag(i int64) *DeploymentPackageUpdateOne {
+	dpuo.mutation.AddEtag(i)
+	return dpuo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearEtag() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearEtag()
+	return dpuo
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (dpuo *DeploymentPackageUpdateOne) SetProjectUUID(s string) *DeploymentPackageUpdateOne {
 	dpuo.mutation.SetProjectUUID(s)
@@ -1239,6 +1499,26 @@ func (dpuo *DeploymentPackageUpdateOne) SetNillableVersion(s *string) *Deploymen
 	return dpuo
 }
 
+// SetVersionKey sets the "version_key" field.
+func (dpuo *DeploymentPackageUpdateOne) SetVersionKey(s string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetVersionKey(s)
+	return dpuo
+}
+
+// SetNillableVersionKey sets the "version_key" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillableVersionKey(s *string) *DeploymentPackageUpdateOne {
+	if s != nil {
+		dpuo.SetVersionKey(*s)
+	}
+	return dpuo
+}
+
+// ClearVersionKey clears the value of the "version_key" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearVersionKey() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearVersionKey()
+	return dpuo
+}
+
 // SetIsDeployed sets the "is_deployed" field.
 func (dpuo *DeploymentPackageUpdateOne) SetIsDeployed(b bool) *DeploymentPackageUpdateOne {
 	dpuo.mutation.SetIsDeployed(b)
@@ -1319,6 +1599,144 @@ func (dpuo *DeploymentPackageUpdateOne) ClearKind() *DeploymentPackageUpdateOne
 	return dpuo
 }
 
+// SetApplicationConstraints sets the "application_constraints" field.
+func (dpuo *DeploymentPackageUpdateOne) SetApplicationConstraints(m map[string]string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetApplicationConstraints(m)
+	return dpuo
+}
+
+// ClearApplicationConstraints clears the value of the "application_constraints" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearApplicationConstraints() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearApplicationConstraints()
+	return dpuo
+}
+
+// SetSearchText sets the "search_text" field.
+func (dpuo *DeploymentPackageUpdateOne) SetSearchText(s string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetSearchText(s)
+	return dpuo
+}
+
+// SetNillableSearchText sets the "search_text" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillableSearchText(s *string) *DeploymentPackageUpdateOne {
+	if s != nil {
+		dpuo.SetSearchText(*s)
+	}
+	return dpuo
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearSearchText() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearSearchText()
+	return dpuo
+}
+
+// SetPinnedResolution sets the "pinned_resolution" field.
+func (dpuo *DeploymentPackageUpdateOne) SetPinnedResolution(s string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetPinnedResolution(s)
+	return dpuo
+}
+
+// SetNillablePinnedResolution sets the "pinned_resolution" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillablePinnedResolution(s *string) *DeploymentPackageUpdateOne {
+	if s != nil {
+		dpuo.SetPinnedResolution(*s)
+	}
+	return dpuo
+}
+
+// ClearPinnedResolution clears the value of the "pinned_resolution" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearPinnedResolution() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearPinnedResolution()
+	return dpuo
+}
+
+// SetLabels sets the "labels" field.
+func (dpuo *DeploymentPackageUpdateOne) SetLabels(m map[string]string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetLabels(m)
+	return dpuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearLabels() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearLabels()
+	return dpuo
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (dpuo *DeploymentPackageUpdateOne) SetDeprecated(b bool) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetDeprecated(b)
+	return dpuo
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillableDeprecated(b *bool) *DeploymentPackageUpdateOne {
+	if b != nil {
+		dpuo.SetDeprecated(*b)
+	}
+	return dpuo
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (dpuo *DeploymentPackageUpdateOne) SetDeprecationMessage(s string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetDeprecationMessage(s)
+	return dpuo
+}
+
+// SetNillableDeprecationMessage sets the "deprecation_message" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillableDeprecationMessage(s *string) *DeploymentPackageUpdateOne {
+	if s != nil {
+		dpuo.SetDeprecationMessage(*s)
+	}
+	return dpuo
+}
+
+// ClearDeprecationMessage clears the value of the "deprecation_message" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearDeprecationMessage() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearDeprecationMessage()
+	return dpuo
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (dpuo *DeploymentPackageUpdateOne) SetReplacementVersion(s string) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetReplacementVersion(s)
+	return dpuo
+}
+
+// SetNillableReplacementVersion sets the "replacement_version" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillableReplacementVersion(s *string) *DeploymentPackageUpdateOne {
+	if s != nil {
+		dpuo.SetReplacementVersion(*s)
+	}
+	return dpuo
+}
+
+// ClearReplacementVersion clears the value of the "replacement_version" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearReplacementVersion() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearReplacementVersion()
+	return dpuo
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (dpuo *DeploymentPackageUpdateOne) SetDeprecateTime(t time.Time) *DeploymentPackageUpdateOne {
+	dpuo.mutation.SetDeprecateTime(t)
+	return dpuo
+}
+
+// SetNillableDeprecateTime sets the "deprecate_time" field if the given value is not nil.
+func (dpuo *DeploymentPackageUpdateOne) SetNillableDeprecateTime(t *time.Time) *DeploymentPackageUpdateOne {
+	if t != nil {
+		dpuo.SetDeprecateTime(*t)
+	}
+	return dpuo
+}
+
+// ClearDeprecateTime clears the value of the "deprecate_time" field.
+func (dpuo *DeploymentPackageUpdateOne) ClearDeprecateTime() *DeploymentPackageUpdateOne {
+	dpuo.mutation.ClearDeprecateTime()
+	return dpuo
+}
+
 // AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
 func (dpuo *DeploymentPackageUpdateOne) AddDeploymentProfileIDs(ids ...uint64) *DeploymentPackageUpdateOne {
 	dpuo.mutation.AddDeploymentProfileIDs(ids...)
@@ -1720,6 +2138,10 @@ func (dpuo *DeploymentPackageUpdateOne) defaults() {
 		v := deploymentpackage.UpdateDefaultUpdateTime()
 		dpuo.mutation.SetUpdateTime(v)
 	}
+	if _, ok := dpuo.mutation.Etag(); !ok && !dpuo.mutation.EtagCleared() {
+		v := deploymentpackage.UpdateDefaultEtag()
+		dpuo.mutation.SetEtag(v)
+	}
 }
 
 func (dpuo *DeploymentPackageUpdateOne) sqlSave(ctx context.Context) (_node *DeploymentPackage, err error) {
@@ -1772,12 +2194,27 @@ func (dpuo *DeploymentPackageUpdateOne) sqlSave(ctx context.Context) (_node *Dep
 	if value, ok := dpuo.mutation.UpdateTime(); ok {
 		_spec.SetField(deploymentpackage.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := dpuo.mutation.Etag(); ok {
+		_spec.SetField(deploymentpackage.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := dpuo.mutation.AddedEtag(); ok {
+		_spec.AddField(deploymentpackage.FieldEtag, field.TypeInt64, value)
+	}
+	if dpuo.mutation.EtagCleared() {
+		_spec.ClearField(deploymentpackage.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := dpuo.mutation.ProjectUUID(); ok {
 		_spec.SetField(deploymentpackage.FieldProjectUUID, field.TypeString, value)
 	}
 	if value, ok := dpuo.mutation.Version(); ok {
 		_spec.SetField(deploymentpackage.FieldVersion, field.TypeString, value)
 	}
+	if value, ok := dpuo.mutation.VersionKey(); ok {
+		_spec.SetField(deploymentpackage.FieldVersionKey, field.TypeString, value)
+	}
+	if dpuo.mutation.VersionKeyCleared() {
+		_spec.ClearField(deploymentpackage.FieldVersionKey, field.TypeString)
+	}
 	if value, ok := dpuo.mutation.IsDeployed(); ok {
 		_spec.SetField(deploymentpackage.FieldIsDeployed, field.TypeBool, value)
 	}
@@ -1802,6 +2239,51 @@ func (dpuo *DeploymentPackageUpdateOne) sqlSave(ctx context.Context) (_node *Dep
 	if dpuo.mutation.KindCleared() {
 		_spec.ClearField(deploymentpackage.FieldKind, field.TypeString)
 	}
+	if value, ok := dpuo.mutation.ApplicationConstraints(); ok {
+		_spec.SetField(deploymentpackage.FieldApplicationConstraints, field.TypeJSON, value)
+	}
+	if dpuo.mutation.ApplicationConstraintsCleared() {
+		_spec.ClearField(deploymentpackage.FieldApplicationConstraints, field.TypeJSON)
+	}
+	if value, ok := dpuo.mutation.SearchText(); ok {
+		_spec.SetField(deploymentpackage.FieldSearchText, field.TypeString, value)
+	}
+	if dpuo.mutation.SearchTextCleared() {
+		_spec.ClearField(deploymentpackage.FieldSearchText, field.TypeString)
+	}
+	if value, ok := dpuo.mutation.PinnedResolution(); ok {
+		_spec.SetField(deploymentpackage.FieldPinnedResolution, field.TypeString, value)
+	}
+	if dpuo.mutation.PinnedResolutionCleared() {
+		_spec.ClearField(deploymentpackage.FieldPinnedResolution, field.TypeString)
+	}
+	if value, ok := dpuo.mutation.Labels(); ok {
+		_spec.SetField(deploymentpackage.FieldLabels, field.TypeJSON, value)
+	}
+	if dpuo.mutation.LabelsCleared() {
+		_spec.ClearField(deploymentpackage.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := dpuo.mutation.Deprecated(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecated, field.TypeBool, value)
+	}
+	if value, ok := dpuo.mutation.DeprecationMessage(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecationMessage, field.TypeString, value)
+	}
+	if dpuo.mutation.DeprecationMessageCleared() {
+		_spec.ClearField(deploymentpackage.FieldDeprecationMessage, field.TypeString)
+	}
+	if value, ok := dpuo.mutation.ReplacementVersion(); ok {
+		_spec.SetField(deploymentpackage.FieldReplacementVersion, field.TypeString, value)
+	}
+	if dpuo.mutation.ReplacementVersionCleared() {
+		_spec.ClearField(deploymentpackage.FieldReplacementVersion, field.TypeString)
+	}
+	if value, ok := dpuo.mutation.DeprecateTime(); ok {
+		_spec.SetField(deploymentpackage.FieldDeprecateTime, field.TypeTime, value)
+	}
+	if dpuo.mutation.DeprecateTimeCleared() {
+		_spec.ClearField(deploymentpackage.FieldDeprecateTime, field.TypeTime)
+	}
 	if dpuo.mutation.DeploymentProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -16,9 +16,11 @@ import (
 
 // DeploymentRequirement is the model entity for the DeploymentRequirement schema.
 type DeploymentRequirement struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// Version constraint of the required Deployment Package, such as latest or ^2.0, if not an exact version.
+	VersionConstraint string `json:"version_constraint,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeploymentRequirementQuery when eager-loading is set.
 	Edges                                        DeploymentRequirementEdges `json:"edges"`
@@ -87,6 +89,8 @@ func (*DeploymentRequirement) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case deploymentrequirement.FieldID:
 			values[i] = new(sql.NullInt64)
+		case deploymentrequirement.FieldVersionConstraint:
+			values[i] = new(sql.NullString)
 		case deploymentrequirement.ForeignKeys[0]: // deployment_requirement_deployment_package_fk
 			values[i] = new(sql.NullInt64)
 		case deploymentrequirement.ForeignKeys[1]: // deployment_requirement_deployment_profile_fk
@@ -114,6 +118,12 @@ func (dr *DeploymentRequirement) assignValues(columns []string, values []any) er
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			dr.ID = uint64(value.Int64)
+		case deploymentrequirement.FieldVersionConstraint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_constraint", values[i])
+			} else if value.Valid {
+				dr.VersionConstraint = value.String
+			}
 		case deploymentrequirement.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field deployment_requirement_deployment_package_fk", value)
@@ -185,7 +195,9 @@ func (dr *DeploymentRequirement) Unwrap() *DeploymentRequirement {
 func (dr *DeploymentRequirement) String() string {
 	var builder strings.Builder
 	builder.WriteString("DeploymentRequirement(")
-	builder.WriteString(fmt.Sprintf("id=%v", dr.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", dr.ID))
+	builder.WriteString("version_constraint=")
+	builder.WriteString(dr.VersionConstraint)
 	builder.WriteByte(')')
 	return builder.String()
 }
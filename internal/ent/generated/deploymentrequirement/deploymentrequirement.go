@@ -12,6 +12,8 @@ const (
 	Label = "deployment_requirement"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldVersionConstraint holds the string denoting the version_constraint field in the database.
+	FieldVersionConstraint = "version_constraint"
 	// EdgeProfileFk holds the string denoting the profile_fk edge name in mutations.
 	EdgeProfileFk = "profile_fk"
 	// EdgeDeploymentPackageFk holds the string denoting the deployment_package_fk edge name in mutations.
@@ -46,6 +48,7 @@ const (
 // Columns holds all SQL columns for deploymentrequirement fields.
 var Columns = []string{
 	FieldID,
+	FieldVersionConstraint,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "deployment_requirements"
@@ -79,6 +82,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByVersionConstraint orders the results by the version_constraint field.
+func ByVersionConstraint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionConstraint, opts...).ToFunc()
+}
+
 // ByProfileFkField orders the results by profile_fk field.
 func ByProfileFkField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
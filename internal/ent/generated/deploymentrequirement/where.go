@@ -53,6 +53,86 @@ func IDLTE(id uint64) predicate.DeploymentRequirement {
 	return predicate.DeploymentRequirement(sql.FieldLTE(FieldID, id))
 }
 
+// VersionConstraint applies equality check predicate on the "version_constraint" field. It's identical to VersionConstraintEQ.
+func VersionConstraint(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldEQ(FieldVersionConstraint, v))
+}
+
+// VersionConstraintEQ applies the EQ predicate on the "version_constraint" field.
+func VersionConstraintEQ(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldEQ(FieldVersionConstraint, v))
+}
+
+// VersionConstraintNEQ applies the NEQ predicate on the "version_constraint" field.
+func VersionConstraintNEQ(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldNEQ(FieldVersionConstraint, v))
+}
+
+// VersionConstraintIn applies the In predicate on the "version_constraint" field.
+func VersionConstraintIn(vs ...string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldIn(FieldVersionConstraint, vs...))
+}
+
+// VersionConstraintNotIn applies the NotIn predicate on the "version_constraint" field.
+func VersionConstraintNotIn(vs ...string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldNotIn(FieldVersionConstraint, vs...))
+}
+
+// VersionConstraintGT applies the GT predicate on the "version_constraint" field.
+func VersionConstraintGT(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldGT(FieldVersionConstraint, v))
+}
+
+// VersionConstraintGTE applies the GTE predicate on the "version_constraint" field.
+func VersionConstraintGTE(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldGTE(FieldVersionConstraint, v))
+}
+
+// VersionConstraintLT applies the LT predicate on the "version_constraint" field.
+func VersionConstraintLT(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldLT(FieldVersionConstraint, v))
+}
+
+// VersionConstraintLTE applies the LTE predicate on the "version_constraint" field.
+func VersionConstraintLTE(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldLTE(FieldVersionConstraint, v))
+}
+
+// VersionConstraintContains applies the Contains predicate on the "version_constraint" field.
+func VersionConstraintContains(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldContains(FieldVersionConstraint, v))
+}
+
+// VersionConstraintHasPrefix applies the HasPrefix predicate on the "version_constraint" field.
+func VersionConstraintHasPrefix(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldHasPrefix(FieldVersionConstraint, v))
+}
+
+// VersionConstraintHasSuffix applies the HasSuffix predicate on the "version_constraint" field.
+func VersionConstraintHasSuffix(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldHasSuffix(FieldVersionConstraint, v))
+}
+
+// VersionConstraintIsNil applies the IsNil predicate on the "version_constraint" field.
+func VersionConstraintIsNil() predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldIsNull(FieldVersionConstraint))
+}
+
+// VersionConstraintNotNil applies the NotNil predicate on the "version_constraint" field.
+func VersionConstraintNotNil() predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldNotNull(FieldVersionConstraint))
+}
+
+// VersionConstraintEqualFold applies the EqualFold predicate on the "version_constraint" field.
+func VersionConstraintEqualFold(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldEqualFold(FieldVersionConstraint, v))
+}
+
+// VersionConstraintContainsFold applies the ContainsFold predicate on the "version_constraint" field.
+func VersionConstraintContainsFold(v string) predicate.DeploymentRequirement {
+	return predicate.DeploymentRequirement(sql.FieldContainsFold(FieldVersionConstraint, v))
+}
+
 // HasProfileFk applies the HasEdge predicate on the "profile_fk" edge.
 func HasProfileFk() predicate.DeploymentRequirement {
 	return predicate.DeploymentRequirement(func(s *sql.Selector) {
@@ -22,6 +22,20 @@ type DeploymentRequirementCreate struct {
 	hooks    []Hook
 }
 
+// SetVersionConstraint sets the "version_constraint" field.
+func (drc *DeploymentRequirementCreate) SetVersionConstraint(s string) *DeploymentRequirementCreate {
+	drc.mutation.SetVersionConstraint(s)
+	return drc
+}
+
+// SetNillableVersionConstraint sets the "version_constraint" field if the given value is not nil.
+func (drc *DeploymentRequirementCreate) SetNillableVersionConstraint(s *string) *DeploymentRequirementCreate {
+	if s != nil {
+		drc.SetVersionConstraint(*s)
+	}
+	return drc
+}
+
 // SetProfileFkID sets the "profile_fk" edge to the Profile entity by ID.
 func (drc *DeploymentRequirementCreate) SetProfileFkID(id uint64) *DeploymentRequirementCreate {
 	drc.mutation.SetProfileFkID(id)
@@ -129,6 +143,10 @@ func (drc *DeploymentRequirementCreate) createSpec() (*DeploymentRequirement, *s
 		_node = &DeploymentRequirement{config: drc.config}
 		_spec = sqlgraph.NewCreateSpec(deploymentrequirement.Table, sqlgraph.NewFieldSpec(deploymentrequirement.FieldID, field.TypeUint64))
 	)
+	if value, ok := drc.mutation.VersionConstraint(); ok {
+		_spec.SetField(deploymentrequirement.FieldVersionConstraint, field.TypeString, value)
+		_node.VersionConstraint = value
+	}
 	if nodes := drc.mutation.ProfileFkIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
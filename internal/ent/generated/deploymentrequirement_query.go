@@ -366,6 +366,18 @@ func (drq *DeploymentRequirementQuery) WithDeploymentProfileFk(opts ...func(*Dep
 
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VersionConstraint string `json:"version_constraint,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeploymentRequirement.Query().
+//		GroupBy(deploymentrequirement.FieldVersionConstraint).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
 func (drq *DeploymentRequirementQuery) GroupBy(field string, fields ...string) *DeploymentRequirementGroupBy {
 	drq.ctx.Fields = append([]string{field}, fields...)
 	grbuild := &DeploymentRequirementGroupBy{build: drq}
@@ -377,6 +389,16 @@ func (drq *DeploymentRequirementQuery) GroupBy(field string, fields ...string) *
 
 // Select allows the selection one or more fields/columns for the given query,
 // instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VersionConstraint string `json:"version_constraint,omitempty"`
+//	}
+//
+//	client.DeploymentRequirement.Query().
+//		Select(deploymentrequirement.FieldVersionConstraint).
+//		Scan(ctx, &v)
 func (drq *DeploymentRequirementQuery) Select(fields ...string) *DeploymentRequirementSelect {
 	drq.ctx.Fields = append(drq.ctx.Fields, fields...)
 	sbuild := &DeploymentRequirementSelect{DeploymentRequirementQuery: drq}
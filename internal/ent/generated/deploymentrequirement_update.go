@@ -30,6 +30,26 @@ func (dru *DeploymentRequirementUpdate) Where(ps ...predicate.DeploymentRequirem
 	return dru
 }
 
+// SetVersionConstraint sets the "version_constraint" field.
+func (dru *DeploymentRequirementUpdate) SetVersionConstraint(s string) *DeploymentRequirementUpdate {
+	dru.mutation.SetVersionConstraint(s)
+	return dru
+}
+
+// SetNillableVersionConstraint sets the "version_constraint" field if the given value is not nil.
+func (dru *DeploymentRequirementUpdate) SetNillableVersionConstraint(s *string) *DeploymentRequirementUpdate {
+	if s != nil {
+		dru.SetVersionConstraint(*s)
+	}
+	return dru
+}
+
+// ClearVersionConstraint clears the value of the "version_constraint" field.
+func (dru *DeploymentRequirementUpdate) ClearVersionConstraint() *DeploymentRequirementUpdate {
+	dru.mutation.ClearVersionConstraint()
+	return dru
+}
+
 // SetProfileFkID sets the "profile_fk" edge to the Profile entity by ID.
 func (dru *DeploymentRequirementUpdate) SetProfileFkID(id uint64) *DeploymentRequirementUpdate {
 	dru.mutation.SetProfileFkID(id)
@@ -144,6 +164,12 @@ func (dru *DeploymentRequirementUpdate) sqlSave(ctx context.Context) (n int, err
 			}
 		}
 	}
+	if value, ok := dru.mutation.VersionConstraint(); ok {
+		_spec.SetField(deploymentrequirement.FieldVersionConstraint, field.TypeString, value)
+	}
+	if dru.mutation.VersionConstraintCleared() {
+		_spec.ClearField(deploymentrequirement.FieldVersionConstraint, field.TypeString)
+	}
 	if dru.mutation.ProfileFkCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -251,6 +277,26 @@ type DeploymentRequirementUpdateOne struct {
 	mutation *DeploymentRequirementMutation
 }
 
+// SetVersionConstraint sets the "version_constraint" field.
+func (druo *DeploymentRequirementUpdateOne) SetVersionConstraint(s string) *DeploymentRequirementUpdateOne {
+	druo.mutation.SetVersionConstraint(s)
+	return druo
+}
+
+// SetNillableVersionConstraint sets the "version_constraint" field if the given value is not nil.
+func (druo *DeploymentRequirementUpdateOne) SetNillableVersionConstraint(s *string) *DeploymentRequirementUpdateOne {
+	if s != nil {
+		druo.SetVersionConstraint(*s)
+	}
+	return druo
+}
+
+// ClearVersionConstraint clears the value of the "version_constraint" field.
+func (druo *DeploymentRequirementUpdateOne) ClearVersionConstraint() *DeploymentRequirementUpdateOne {
+	druo.mutation.ClearVersionConstraint()
+	return druo
+}
+
 // SetProfileFkID sets the "profile_fk" edge to the Profile entity by ID.
 func (druo *DeploymentRequirementUpdateOne) SetProfileFkID(id uint64) *DeploymentRequirementUpdateOne {
 	druo.mutation.SetProfileFkID(id)
@@ -395,6 +441,12 @@ func (druo *DeploymentRequirementUpdateOne) sqlSave(ctx context.Context) (_node
 			}
 		}
 	}
+	if value, ok := druo.mutation.VersionConstraint(); ok {
+		_spec.SetField(deploymentrequirement.FieldVersionConstraint, field.TypeString, value)
+	}
+	if druo.mutation.VersionConstraintCleared() {
+		_spec.ClearField(deploymentrequirement.FieldVersionConstraint, field.TypeString)
+	}
 	if druo.mutation.ProfileFkCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -17,6 +17,7 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/applicationnamespace"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifactreference"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/commonmixin"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentprofile"
@@ -26,9 +27,14 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/ignoredresource"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/namespace"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/namespaceadornment"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/parametertemplate"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/profile"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhook"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -94,6 +100,7 @@ func checkColumn(table, column string) error {
 			applicationnamespace.Table:  applicationnamespace.ValidColumn,
 			artifact.Table:              artifact.ValidColumn,
 			artifactreference.Table:     artifactreference.ValidColumn,
+			auditevent.Table:            auditevent.ValidColumn,
 			commonmixin.Table:           commonmixin.ValidColumn,
 			deploymentpackage.Table:     deploymentpackage.ValidColumn,
 			deploymentprofile.Table:     deploymentprofile.ValidColumn,
@@ -103,9 +110,14 @@ func checkColumn(table, column string) error {
 			ignoredresource.Table:       ignoredresource.ValidColumn,
 			namespace.Table:             namespace.ValidColumn,
 			namespaceadornment.Table:    namespaceadornment.ValidColumn,
+			outboxevent.Table:           outboxevent.ValidColumn,
 			parametertemplate.Table:     parametertemplate.ValidColumn,
 			profile.Table:               profile.ValidColumn,
 			registry.Table:              registry.ValidColumn,
+			revision.Table:              revision.ValidColumn,
+			trashedentity.Table:         trashedentity.ValidColumn,
+			webhook.Table:               webhook.ValidColumn,
+			webhookdelivery.Table:       webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -17,11 +18,20 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "etag", Type: field.TypeInt64, Nullable: true},
 		{Name: "project_uuid", Type: field.TypeString, Default: "default"},
 		{Name: "version", Type: field.TypeString},
 		{Name: "chart_name", Type: field.TypeString},
 		{Name: "chart_version", Type: field.TypeString},
+		{Name: "version_key", Type: field.TypeString, Nullable: true, Collation: "C"},
+		{Name: "chart_version_key", Type: field.TypeString, Nullable: true, Collation: "C"},
 		{Name: "kind", Type: field.TypeString, Nullable: true},
+		{Name: "search_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "deprecated", Type: field.TypeBool, Default: false},
+		{Name: "deprecation_message", Type: field.TypeString, Nullable: true},
+		{Name: "replacement_version", Type: field.TypeString, Nullable: true},
+		{Name: "deprecate_time", Type: field.TypeTime, Nullable: true},
 		{Name: "application_default_profile", Type: field.TypeUint64, Nullable: true},
 		{Name: "registry_applications", Type: field.TypeUint64},
 		{Name: "registry_application_images", Type: field.TypeUint64, Nullable: true},
@@ -34,19 +44,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "applications_profiles_default_profile",
-				Columns:    []*schema.Column{ApplicationsColumns[12]},
+				Columns:    []*schema.Column{ApplicationsColumns[21]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "applications_registries_applications",
-				Columns:    []*schema.Column{ApplicationsColumns[13]},
+				Columns:    []*schema.Column{ApplicationsColumns[22]},
 				RefColumns: []*schema.Column{RegistriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "applications_registries_application_images",
-				Columns:    []*schema.Column{ApplicationsColumns[14]},
+				Columns:    []*schema.Column{ApplicationsColumns[23]},
 				RefColumns: []*schema.Column{RegistriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -55,7 +65,17 @@ var (
 			{
 				Name:    "application_project_uuid_name_version",
 				Unique:  true,
-				Columns: []*schema.Column{ApplicationsColumns[7], ApplicationsColumns[1], ApplicationsColumns[8]},
+				Columns: []*schema.Column{ApplicationsColumns[8], ApplicationsColumns[1], ApplicationsColumns[9]},
+			},
+			{
+				Name:    "application_labels",
+				Unique:  false,
+				Columns: []*schema.Column{ApplicationsColumns[16]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
 			},
 		},
 	}
@@ -128,6 +148,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "etag", Type: field.TypeInt64, Nullable: true},
 		{Name: "project_uuid", Type: field.TypeString, Default: "default"},
 		{Name: "mime_type", Type: field.TypeString},
 		{Name: "artifact", Type: field.TypeBytes},
@@ -141,7 +162,7 @@ var (
 			{
 				Name:    "artifact_project_uuid_name",
 				Unique:  true,
-				Columns: []*schema.Column{ArtifactsColumns[7], ArtifactsColumns[1]},
+				Columns: []*schema.Column{ArtifactsColumns[8], ArtifactsColumns[1]},
 			},
 		},
 	}
@@ -172,6 +193,40 @@ var (
 			},
 		},
 	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "project_uuid", Type: field.TypeString},
+		{Name: "user_name", Type: field.TypeString, Default: ""},
+		{Name: "client", Type: field.TypeString, Default: ""},
+		{Name: "method", Type: field.TypeString, Default: ""},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "resource_name", Type: field.TypeString},
+		{Name: "resource_version", Type: field.TypeString, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "changed_fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "before", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "after", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_project_uuid_resource_type_resource_name",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[5], AuditEventsColumns[6]},
+			},
+			{
+				Name:    "auditevent_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[12]},
+			},
+		},
+	}
 	// CommonMixinsColumns holds the columns for the "common_mixins" table.
 	CommonMixinsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -197,12 +252,22 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "etag", Type: field.TypeInt64, Nullable: true},
 		{Name: "project_uuid", Type: field.TypeString, Default: "default"},
 		{Name: "version", Type: field.TypeString},
+		{Name: "version_key", Type: field.TypeString, Nullable: true, Collation: "C"},
 		{Name: "is_deployed", Type: field.TypeBool, Nullable: true},
 		{Name: "is_visible", Type: field.TypeBool, Nullable: true},
 		{Name: "allows_multiple_deployments", Type: field.TypeBool, Nullable: true},
 		{Name: "kind", Type: field.TypeString, Nullable: true},
+		{Name: "application_constraints", Type: field.TypeJSON, Nullable: true},
+		{Name: "search_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "pinned_resolution", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "deprecated", Type: field.TypeBool, Default: false},
+		{Name: "deprecation_message", Type: field.TypeString, Nullable: true},
+		{Name: "replacement_version", Type: field.TypeString, Nullable: true},
+		{Name: "deprecate_time", Type: field.TypeTime, Nullable: true},
 		{Name: "deployment_package_default_profile", Type: field.TypeUint64, Nullable: true},
 	}
 	// DeploymentPackagesTable holds the schema information for the "deployment_packages" table.
@@ -213,7 +278,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deployment_packages_deployment_profiles_default_profile",
-				Columns:    []*schema.Column{DeploymentPackagesColumns[13]},
+				Columns:    []*schema.Column{DeploymentPackagesColumns[23]},
 				RefColumns: []*schema.Column{DeploymentProfilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -222,7 +287,17 @@ var (
 			{
 				Name:    "deploymentpackage_project_uuid_name_version",
 				Unique:  true,
-				Columns: []*schema.Column{DeploymentPackagesColumns[7], DeploymentPackagesColumns[1], DeploymentPackagesColumns[8]},
+				Columns: []*schema.Column{DeploymentPackagesColumns[8], DeploymentPackagesColumns[1], DeploymentPackagesColumns[9]},
+			},
+			{
+				Name:    "deploymentpackage_labels",
+				Unique:  false,
+				Columns: []*schema.Column{DeploymentPackagesColumns[18]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
 			},
 		},
 	}
@@ -261,6 +336,7 @@ var (
 	// DeploymentRequirementsColumns holds the columns for the "deployment_requirements" table.
 	DeploymentRequirementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "version_constraint", Type: field.TypeString, Nullable: true},
 		{Name: "deployment_requirement_deployment_package_fk", Type: field.TypeUint64},
 		{Name: "deployment_requirement_deployment_profile_fk", Type: field.TypeUint64, Nullable: true},
 		{Name: "profile_deployment_requirements", Type: field.TypeUint64},
@@ -273,19 +349,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deployment_requirements_deployment_packages_deployment_package_fk",
-				Columns:    []*schema.Column{DeploymentRequirementsColumns[1]},
+				Columns:    []*schema.Column{DeploymentRequirementsColumns[2]},
 				RefColumns: []*schema.Column{DeploymentPackagesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "deployment_requirements_deployment_profiles_deployment_profile_fk",
-				Columns:    []*schema.Column{DeploymentRequirementsColumns[2]},
+				Columns:    []*schema.Column{DeploymentRequirementsColumns[3]},
 				RefColumns: []*schema.Column{DeploymentProfilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "deployment_requirements_profiles_deployment_requirements",
-				Columns:    []*schema.Column{DeploymentRequirementsColumns[3]},
+				Columns:    []*schema.Column{DeploymentRequirementsColumns[4]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -431,6 +507,36 @@ var (
 			},
 		},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "project_uuid", Type: field.TypeString},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "origin", Type: field.TypeString, Default: ""},
+		{Name: "tx_id", Type: field.TypeUint64, Nullable: true, Default: map[string]schema.Expr{"postgres": "pg_current_xact_id()::text::bigint"}},
+		{Name: "tx_xmin", Type: field.TypeUint64, Nullable: true, Default: map[string]schema.Expr{"postgres": "pg_snapshot_xmin(pg_current_snapshot())::text::bigint"}},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_resource_type_project_uuid",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[2], OutboxEventsColumns[1]},
+			},
+			{
+				Name:    "outboxevent_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[6]},
+			},
+		},
+	}
 	// ParameterTemplatesColumns holds the columns for the "parameter_templates" table.
 	ParameterTemplatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -508,10 +614,12 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "etag", Type: field.TypeInt64, Nullable: true},
 		{Name: "project_uuid", Type: field.TypeString, Default: "default"},
 		{Name: "auth_token", Type: field.TypeString, Nullable: true},
 		{Name: "type", Type: field.TypeString},
 		{Name: "api_type", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
 	}
 	// RegistriesTable holds the schema information for the "registries" table.
 	RegistriesTable = &schema.Table{
@@ -522,7 +630,135 @@ var (
 			{
 				Name:    "registry_project_uuid_name",
 				Unique:  true,
-				Columns: []*schema.Column{RegistriesColumns[7], RegistriesColumns[1]},
+				Columns: []*schema.Column{RegistriesColumns[8], RegistriesColumns[1]},
+			},
+			{
+				Name:    "registry_labels",
+				Unique:  false,
+				Columns: []*schema.Column{RegistriesColumns[12]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// RevisionsColumns holds the columns for the "revisions" table.
+	RevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "project_uuid", Type: field.TypeString},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString},
+		{Name: "revision", Type: field.TypeUint32},
+		{Name: "user_name", Type: field.TypeString, Default: ""},
+		{Name: "snapshot", Type: field.TypeString, Size: 2147483647},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// RevisionsTable holds the schema information for the "revisions" table.
+	RevisionsTable = &schema.Table{
+		Name:       "revisions",
+		Columns:    RevisionsColumns,
+		PrimaryKey: []*schema.Column{RevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revision_project_uuid_resource_type_name_version_revision",
+				Unique:  true,
+				Columns: []*schema.Column{RevisionsColumns[1], RevisionsColumns[2], RevisionsColumns[3], RevisionsColumns[4], RevisionsColumns[5]},
+			},
+		},
+	}
+	// TrashedEntitiesColumns holds the columns for the "trashed_entities" table.
+	TrashedEntitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "project_uuid", Type: field.TypeString},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString, Default: ""},
+		{Name: "user_name", Type: field.TypeString, Default: ""},
+		{Name: "snapshot", Type: field.TypeString, Size: 2147483647},
+		{Name: "secret", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "delete_time", Type: field.TypeTime},
+	}
+	// TrashedEntitiesTable holds the schema information for the "trashed_entities" table.
+	TrashedEntitiesTable = &schema.Table{
+		Name:       "trashed_entities",
+		Columns:    TrashedEntitiesColumns,
+		PrimaryKey: []*schema.Column{TrashedEntitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trashedentity_project_uuid_resource_type_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{TrashedEntitiesColumns[1], TrashedEntitiesColumns[2], TrashedEntitiesColumns[3], TrashedEntitiesColumns[4]},
+			},
+			{
+				Name:    "trashedentity_delete_time",
+				Unique:  false,
+				Columns: []*schema.Column{TrashedEntitiesColumns[8]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "project_uuid", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "resource_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_project_uuid_name",
+				Unique:  true,
+				Columns: []*schema.Column{WebhooksColumns[1], WebhooksColumns[2]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "revision", Type: field.TypeUint64},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "resource_name", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "state", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_code", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "webhook_deliveries", Type: field.TypeUint64},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_revision_webhook_deliveries",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[12]},
 			},
 		},
 	}
@@ -633,6 +869,7 @@ var (
 		ApplicationNamespacesTable,
 		ArtifactsTable,
 		ArtifactReferencesTable,
+		AuditEventsTable,
 		CommonMixinsTable,
 		DeploymentPackagesTable,
 		DeploymentProfilesTable,
@@ -642,9 +879,14 @@ var (
 		IgnoredResourcesTable,
 		NamespacesTable,
 		NamespaceAdornmentsTable,
+		OutboxEventsTable,
 		ParameterTemplatesTable,
 		ProfilesTable,
 		RegistriesTable,
+		RevisionsTable,
+		TrashedEntitiesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		DeploymentPackageApplicationsTable,
 		DeploymentPackageIconTable,
 		DeploymentPackageThumbnailTable,
@@ -676,6 +918,7 @@ func init() {
 	ParameterTemplatesTable.ForeignKeys[0].RefTable = ProfilesTable
 	ProfilesTable.ForeignKeys[0].RefTable = ApplicationsTable
 	ProfilesTable.ForeignKeys[1].RefTable = ParameterTemplatesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	DeploymentPackageApplicationsTable.ForeignKeys[0].RefTable = DeploymentPackagesTable
 	DeploymentPackageApplicationsTable.ForeignKeys[1].RefTable = ApplicationsTable
 	DeploymentPackageIconTable.ForeignKeys[0].RefTable = DeploymentPackagesTable
@@ -16,6 +16,7 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/applicationnamespace"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifactreference"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/commonmixin"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentprofile"
@@ -25,10 +26,15 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/ignoredresource"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/namespace"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/namespaceadornment"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/parametertemplate"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/profile"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhook"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhookdelivery"
 )
 
 const (
@@ -45,6 +51,7 @@ const (
 	TypeApplicationNamespace  = "ApplicationNamespace"
 	TypeArtifact              = "Artifact"
 	TypeArtifactReference     = "ArtifactReference"
+	TypeAuditEvent            = "AuditEvent"
 	TypeCommonMixin           = "CommonMixin"
 	TypeDeploymentPackage     = "DeploymentPackage"
 	TypeDeploymentProfile     = "DeploymentProfile"
@@ -54,9 +61,14 @@ const (
 	TypeIgnoredResource       = "IgnoredResource"
 	TypeNamespace             = "Namespace"
 	TypeNamespaceAdornment    = "NamespaceAdornment"
+	TypeOutboxEvent           = "OutboxEvent"
 	TypeParameterTemplate     = "ParameterTemplate"
 	TypeProfile               = "Profile"
 	TypeRegistry              = "Registry"
+	TypeRevision              = "Revision"
+	TypeTrashedEntity         = "TrashedEntity"
+	TypeWebhook               = "Webhook"
+	TypeWebhookDelivery       = "WebhookDelivery"
 )
 
 // ApplicationMutation represents an operation that mutates the Application nodes in the graph.
@@ -71,11 +83,21 @@ type ApplicationMutation struct {
 	description                  *string
 	create_time                  *time.Time
 	update_time                  *time.Time
+	etag                         *int64
+	addetag                      *int64
 	project_uuid                 *string
 	version                      *string
 	chart_name                   *string
 	chart_version                *string
+	version_key                  *string
+	chart_version_key            *string
 	kind                         *string
+	search_text                  *string
+	labels                       *map[string]string
+	deprecated                   *bool
+	deprecation_message          *string
+	replacement_version          *string
+	deprecate_time               *time.Time
 	clearedFields                map[string]struct{}
 	profiles                     map[uint64]struct{}
 	removedprofiles              map[uint64]struct{}
@@ -456,6 +478,76 @@ func (m *ApplicationMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
+// SetEtag sets the "etag" field.
+func (m *ApplicationMutation) SetEtag(i int64) {
+	m.etag = &i
+	m.addetag = nil
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *ApplicationMutation) Etag() (r int64, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldEtag(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// AddEtag adds i to the "etag" field.
+func (m *ApplicationMutation) AddEtag(i int64) {
+	if m.addetag != nil {
+		*m.addetag += i
+	} else {
+		m.addetag = &i
+	}
+}
+
+// AddedEtag returns the value that was added to the "etag" field in this mutation.
+func (m *ApplicationMutation) AddedEtag() (r int64, exists bool) {
+	v := m.addetag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (m *ApplicationMutation) ClearEtag() {
+	m.etag = nil
+	m.addetag = nil
+	m.clearedFields[application.FieldEtag] = struct{}{}
+}
+
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *ApplicationMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[application.FieldEtag]
+	return ok
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *ApplicationMutation) ResetEtag() {
+	m.etag = nil
+	m.addetag = nil
+	delete(m.clearedFields, application.FieldEtag)
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (m *ApplicationMutation) SetProjectUUID(s string) {
 	m.project_uuid = &s
@@ -600,6 +692,104 @@ func (m *ApplicationMutation) ResetChartVersion() {
 	m.chart_version = nil
 }
 
+// SetVersionKey sets the "version_key" field.
+func (m *ApplicationMutation) SetVersionKey(s string) {
+	m.version_key = &s
+}
+
+// VersionKey returns the value of the "version_key" field in the mutation.
+func (m *ApplicationMutation) VersionKey() (r string, exists bool) {
+	v := m.version_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionKey returns the old "version_key" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldVersionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionKey: %w", err)
+	}
+	return oldValue.VersionKey, nil
+}
+
+// ClearVersionKey clears the value of the "version_key" field.
+func (m *ApplicationMutation) ClearVersionKey() {
+	m.version_key = nil
+	m.clearedFields[application.FieldVersionKey] = struct{}{}
+}
+
+// VersionKeyCleared returns if the "version_key" field was cleared in this mutation.
+func (m *ApplicationMutation) VersionKeyCleared() bool {
+	_, ok := m.clearedFields[application.FieldVersionKey]
+	return ok
+}
+
+// ResetVersionKey resets all changes to the "version_key" field.
+func (m *ApplicationMutation) ResetVersionKey() {
+	m.version_key = nil
+	delete(m.clearedFields, application.FieldVersionKey)
+}
+
+// SetChartVersionKey sets the "chart_version_key" field.
+func (m *ApplicationMutation) SetChartVersionKey(s string) {
+	m.chart_version_key = &s
+}
+
+// ChartVersionKey returns the value of the "chart_version_key" field in the mutation.
+func (m *ApplicationMutation) ChartVersionKey() (r string, exists bool) {
+	v := m.chart_version_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChartVersionKey returns the old "chart_version_key" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldChartVersionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChartVersionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChartVersionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChartVersionKey: %w", err)
+	}
+	return oldValue.ChartVersionKey, nil
+}
+
+// ClearChartVersionKey clears the value of the "chart_version_key" field.
+func (m *ApplicationMutation) ClearChartVersionKey() {
+	m.chart_version_key = nil
+	m.clearedFields[application.FieldChartVersionKey] = struct{}{}
+}
+
+// ChartVersionKeyCleared returns if the "chart_version_key" field was cleared in this mutation.
+func (m *ApplicationMutation) ChartVersionKeyCleared() bool {
+	_, ok := m.clearedFields[application.FieldChartVersionKey]
+	return ok
+}
+
+// ResetChartVersionKey resets all changes to the "chart_version_key" field.
+func (m *ApplicationMutation) ResetChartVersionKey() {
+	m.chart_version_key = nil
+	delete(m.clearedFields, application.FieldChartVersionKey)
+}
+
 // SetKind sets the "kind" field.
 func (m *ApplicationMutation) SetKind(s string) {
 	m.kind = &s
@@ -649,6 +839,287 @@ func (m *ApplicationMutation) ResetKind() {
 	delete(m.clearedFields, application.FieldKind)
 }
 
+// SetSearchText sets the "search_text" field.
+func (m *ApplicationMutation) SetSearchText(s string) {
+	m.search_text = &s
+}
+
+// SearchText returns the value of the "search_text" field in the mutation.
+func (m *ApplicationMutation) SearchText() (r string, exists bool) {
+	v := m.search_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchText returns the old "search_text" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldSearchText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchText: %w", err)
+	}
+	return oldValue.SearchText, nil
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (m *ApplicationMutation) ClearSearchText() {
+	m.search_text = nil
+	m.clearedFields[application.FieldSearchText] = struct{}{}
+}
+
+// SearchTextCleared returns if the "search_text" field was cleared in this mutation.
+func (m *ApplicationMutation) SearchTextCleared() bool {
+	_, ok := m.clearedFields[application.FieldSearchText]
+	return ok
+}
+
+// ResetSearchText resets all changes to the "search_text" field.
+func (m *ApplicationMutation) ResetSearchText() {
+	m.search_text = nil
+	delete(m.clearedFields, application.FieldSearchText)
+}
+
+// SetLabels sets the "labels" field.
+func (m *ApplicationMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *ApplicationMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *ApplicationMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[application.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *ApplicationMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[application.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *ApplicationMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, application.FieldLabels)
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (m *ApplicationMutation) SetDeprecated(b bool) {
+	m.deprecated = &b
+}
+
+// Deprecated returns the value of the "deprecated" field in the mutation.
+func (m *ApplicationMutation) Deprecated() (r bool, exists bool) {
+	v := m.deprecated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecated returns the old "deprecated" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldDeprecated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecated: %w", err)
+	}
+	return oldValue.Deprecated, nil
+}
+
+// ResetDeprecated resets all changes to the "deprecated" field.
+func (m *ApplicationMutation) ResetDeprecated() {
+	m.deprecated = nil
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (m *ApplicationMutation) SetDeprecationMessage(s string) {
+	m.deprecation_message = &s
+}
+
+// DeprecationMessage returns the value of the "deprecation_message" field in the mutation.
+func (m *ApplicationMutation) DeprecationMessage() (r string, exists bool) {
+	v := m.deprecation_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecationMessage returns the old "deprecation_message" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldDeprecationMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecationMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecationMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecationMessage: %w", err)
+	}
+	return oldValue.DeprecationMessage, nil
+}
+
+// ClearDeprecationMessage clears the value of the "deprecation_message" field.
+func (m *ApplicationMutation) ClearDeprecationMessage() {
+	m.deprecation_message = nil
+	m.clearedFields[application.FieldDeprecationMessage] = struct{}{}
+}
+
+// DeprecationMessageCleared returns if the "deprecation_message" field was cleared in this mutation.
+func (m *ApplicationMutation) DeprecationMessageCleared() bool {
+	_, ok := m.clearedFields[application.FieldDeprecationMessage]
+	return ok
+}
+
+// ResetDeprecationMessage resets all changes to the "deprecation_message" field.
+func (m *ApplicationMutation) ResetDeprecationMessage() {
+	m.deprecation_message = nil
+	delete(m.clearedFields, application.FieldDeprecationMessage)
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (m *ApplicationMutation) SetReplacementVersion(s string) {
+	m.replacement_version = &s
+}
+
+// ReplacementVersion returns the value of the "replacement_version" field in the mutation.
+func (m *ApplicationMutation) ReplacementVersion() (r string, exists bool) {
+	v := m.replacement_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacementVersion returns the old "replacement_version" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldReplacementVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacementVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacementVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacementVersion: %w", err)
+	}
+	return oldValue.ReplacementVersion, nil
+}
+
+// ClearReplacementVersion clears the value of the "replacement_version" field.
+func (m *ApplicationMutation) ClearReplacementVersion() {
+	m.replacement_version = nil
+	m.clearedFields[application.FieldReplacementVersion] = struct{}{}
+}
+
+// ReplacementVersionCleared returns if the "replacement_version" field was cleared in this mutation.
+func (m *ApplicationMutation) ReplacementVersionCleared() bool {
+	_, ok := m.clearedFields[application.FieldReplacementVersion]
+	return ok
+}
+
+// ResetReplacementVersion resets all changes to the "replacement_version" field.
+func (m *ApplicationMutation) ResetReplacementVersion() {
+	m.replacement_version = nil
+	delete(m.clearedFields, application.FieldReplacementVersion)
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (m *ApplicationMutation) SetDeprecateTime(t time.Time) {
+	m.deprecate_time = &t
+}
+
+// DeprecateTime returns the value of the "deprecate_time" field in the mutation.
+func (m *ApplicationMutation) DeprecateTime() (r time.Time, exists bool) {
+	v := m.deprecate_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecateTime returns the old "deprecate_time" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldDeprecateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecateTime: %w", err)
+	}
+	return oldValue.DeprecateTime, nil
+}
+
+// ClearDeprecateTime clears the value of the "deprecate_time" field.
+func (m *ApplicationMutation) ClearDeprecateTime() {
+	m.deprecate_time = nil
+	m.clearedFields[application.FieldDeprecateTime] = struct{}{}
+}
+
+// DeprecateTimeCleared returns if the "deprecate_time" field was cleared in this mutation.
+func (m *ApplicationMutation) DeprecateTimeCleared() bool {
+	_, ok := m.clearedFields[application.FieldDeprecateTime]
+	return ok
+}
+
+// ResetDeprecateTime resets all changes to the "deprecate_time" field.
+func (m *ApplicationMutation) ResetDeprecateTime() {
+	m.deprecate_time = nil
+	delete(m.clearedFields, application.FieldDeprecateTime)
+}
+
 // AddProfileIDs adds the "profiles" edge to the Profile entity by ids.
 func (m *ApplicationMutation) AddProfileIDs(ids ...uint64) {
 	if m.profiles == nil {
@@ -1070,7 +1541,7 @@ func (m *ApplicationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ApplicationMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 20)
 	if m.name != nil {
 		fields = append(fields, application.FieldName)
 	}
@@ -1089,6 +1560,9 @@ func (m *ApplicationMutation) Fields() []string {
 	if m.update_time != nil {
 		fields = append(fields, application.FieldUpdateTime)
 	}
+	if m.etag != nil {
+		fields = append(fields, application.FieldEtag)
+	}
 	if m.project_uuid != nil {
 		fields = append(fields, application.FieldProjectUUID)
 	}
@@ -1101,9 +1575,33 @@ func (m *ApplicationMutation) Fields() []string {
 	if m.chart_version != nil {
 		fields = append(fields, application.FieldChartVersion)
 	}
+	if m.version_key != nil {
+		fields = append(fields, application.FieldVersionKey)
+	}
+	if m.chart_version_key != nil {
+		fields = append(fields, application.FieldChartVersionKey)
+	}
 	if m.kind != nil {
 		fields = append(fields, application.FieldKind)
 	}
+	if m.search_text != nil {
+		fields = append(fields, application.FieldSearchText)
+	}
+	if m.labels != nil {
+		fields = append(fields, application.FieldLabels)
+	}
+	if m.deprecated != nil {
+		fields = append(fields, application.FieldDeprecated)
+	}
+	if m.deprecation_message != nil {
+		fields = append(fields, application.FieldDeprecationMessage)
+	}
+	if m.replacement_version != nil {
+		fields = append(fields, application.FieldReplacementVersion)
+	}
+	if m.deprecate_time != nil {
+		fields = append(fields, application.FieldDeprecateTime)
+	}
 	return fields
 }
 
@@ -1124,6 +1622,8 @@ func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 		return m.CreateTime()
 	case application.FieldUpdateTime:
 		return m.UpdateTime()
+	case application.FieldEtag:
+		return m.Etag()
 	case application.FieldProjectUUID:
 		return m.ProjectUUID()
 	case application.FieldVersion:
@@ -1132,8 +1632,24 @@ func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 		return m.ChartName()
 	case application.FieldChartVersion:
 		return m.ChartVersion()
+	case application.FieldVersionKey:
+		return m.VersionKey()
+	case application.FieldChartVersionKey:
+		return m.ChartVersionKey()
 	case application.FieldKind:
 		return m.Kind()
+	case application.FieldSearchText:
+		return m.SearchText()
+	case application.FieldLabels:
+		return m.Labels()
+	case application.FieldDeprecated:
+		return m.Deprecated()
+	case application.FieldDeprecationMessage:
+		return m.DeprecationMessage()
+	case application.FieldReplacementVersion:
+		return m.ReplacementVersion()
+	case application.FieldDeprecateTime:
+		return m.DeprecateTime()
 	}
 	return nil, false
 }
@@ -1155,6 +1671,8 @@ func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldCreateTime(ctx)
 	case application.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
+	case application.FieldEtag:
+		return m.OldEtag(ctx)
 	case application.FieldProjectUUID:
 		return m.OldProjectUUID(ctx)
 	case application.FieldVersion:
@@ -1163,8 +1681,24 @@ func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldChartName(ctx)
 	case application.FieldChartVersion:
 		return m.OldChartVersion(ctx)
+	case application.FieldVersionKey:
+		return m.OldVersionKey(ctx)
+	case application.FieldChartVersionKey:
+		return m.OldChartVersionKey(ctx)
 	case application.FieldKind:
 		return m.OldKind(ctx)
+	case application.FieldSearchText:
+		return m.OldSearchText(ctx)
+	case application.FieldLabels:
+		return m.OldLabels(ctx)
+	case application.FieldDeprecated:
+		return m.OldDeprecated(ctx)
+	case application.FieldDeprecationMessage:
+		return m.OldDeprecationMessage(ctx)
+	case application.FieldReplacementVersion:
+		return m.OldReplacementVersion(ctx)
+	case application.FieldDeprecateTime:
+		return m.OldDeprecateTime(ctx)
 	}
 	return nil, fmt.Errorf("unknown Application field %s", name)
 }
@@ -1216,6 +1750,13 @@ func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdateTime(v)
 		return nil
+	case application.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
 	case application.FieldProjectUUID:
 		v, ok := value.(string)
 		if !ok {
@@ -1244,27 +1785,91 @@ func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetChartVersion(v)
 		return nil
-	case application.FieldKind:
+	case application.FieldVersionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKind(v)
+		m.SetVersionKey(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Application field %s", name)
+	case application.FieldChartVersionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChartVersionKey(v)
+		return nil
+	case application.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case application.FieldSearchText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchText(v)
+		return nil
+	case application.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case application.FieldDeprecated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecated(v)
+		return nil
+	case application.FieldDeprecationMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecationMessage(v)
+		return nil
+	case application.FieldReplacementVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacementVersion(v)
+		return nil
+	case application.FieldDeprecateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Application field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ApplicationMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addetag != nil {
+		fields = append(fields, application.FieldEtag)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case application.FieldEtag:
+		return m.AddedEtag()
+	}
 	return nil, false
 }
 
@@ -1273,6 +1878,13 @@ func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ApplicationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case application.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEtag(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Application numeric field %s", name)
 }
@@ -1290,9 +1902,33 @@ func (m *ApplicationMutation) ClearedFields() []string {
 	if m.FieldCleared(application.FieldDescription) {
 		fields = append(fields, application.FieldDescription)
 	}
+	if m.FieldCleared(application.FieldEtag) {
+		fields = append(fields, application.FieldEtag)
+	}
+	if m.FieldCleared(application.FieldVersionKey) {
+		fields = append(fields, application.FieldVersionKey)
+	}
+	if m.FieldCleared(application.FieldChartVersionKey) {
+		fields = append(fields, application.FieldChartVersionKey)
+	}
 	if m.FieldCleared(application.FieldKind) {
 		fields = append(fields, application.FieldKind)
 	}
+	if m.FieldCleared(application.FieldSearchText) {
+		fields = append(fields, application.FieldSearchText)
+	}
+	if m.FieldCleared(application.FieldLabels) {
+		fields = append(fields, application.FieldLabels)
+	}
+	if m.FieldCleared(application.FieldDeprecationMessage) {
+		fields = append(fields, application.FieldDeprecationMessage)
+	}
+	if m.FieldCleared(application.FieldReplacementVersion) {
+		fields = append(fields, application.FieldReplacementVersion)
+	}
+	if m.FieldCleared(application.FieldDeprecateTime) {
+		fields = append(fields, application.FieldDeprecateTime)
+	}
 	return fields
 }
 
@@ -1316,9 +1952,33 @@ func (m *ApplicationMutation) ClearField(name string) error {
 	case application.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case application.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case application.FieldVersionKey:
+		m.ClearVersionKey()
+		return nil
+	case application.FieldChartVersionKey:
+		m.ClearChartVersionKey()
+		return nil
 	case application.FieldKind:
 		m.ClearKind()
 		return nil
+	case application.FieldSearchText:
+		m.ClearSearchText()
+		return nil
+	case application.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case application.FieldDeprecationMessage:
+		m.ClearDeprecationMessage()
+		return nil
+	case application.FieldReplacementVersion:
+		m.ClearReplacementVersion()
+		return nil
+	case application.FieldDeprecateTime:
+		m.ClearDeprecateTime()
+		return nil
 	}
 	return fmt.Errorf("unknown Application nullable field %s", name)
 }
@@ -1345,6 +2005,9 @@ func (m *ApplicationMutation) ResetField(name string) error {
 	case application.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
+	case application.FieldEtag:
+		m.ResetEtag()
+		return nil
 	case application.FieldProjectUUID:
 		m.ResetProjectUUID()
 		return nil
@@ -1357,9 +2020,33 @@ func (m *ApplicationMutation) ResetField(name string) error {
 	case application.FieldChartVersion:
 		m.ResetChartVersion()
 		return nil
+	case application.FieldVersionKey:
+		m.ResetVersionKey()
+		return nil
+	case application.FieldChartVersionKey:
+		m.ResetChartVersionKey()
+		return nil
 	case application.FieldKind:
 		m.ResetKind()
 		return nil
+	case application.FieldSearchText:
+		m.ResetSearchText()
+		return nil
+	case application.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case application.FieldDeprecated:
+		m.ResetDeprecated()
+		return nil
+	case application.FieldDeprecationMessage:
+		m.ResetDeprecationMessage()
+		return nil
+	case application.FieldReplacementVersion:
+		m.ResetReplacementVersion()
+		return nil
+	case application.FieldDeprecateTime:
+		m.ResetDeprecateTime()
+		return nil
 	}
 	return fmt.Errorf("unknown Application field %s", name)
 }
@@ -2519,6 +3206,8 @@ type ArtifactMutation struct {
 	description            *string
 	create_time            *time.Time
 	update_time            *time.Time
+	etag                   *int64
+	addetag                *int64
 	project_uuid           *string
 	mime_type              *string
 	artifact               *[]byte
@@ -2887,6 +3576,76 @@ func (m *ArtifactMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
+// SetEtag sets the "etag" field.
+func (m *ArtifactMutation) SetEtag(i int64) {
+	m.etag = &i
+	m.addetag = nil
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *ArtifactMutation) Etag() (r int64, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the Artifact entity.
+// If the Artifact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtifactMutation) OldEtag(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// AddEtag adds i to the "etag" field.
+func (m *ArtifactMutation) AddEtag(i int64) {
+	if m.addetag != nil {
+		*m.addetag += i
+	} else {
+		m.addetag = &i
+	}
+}
+
+// AddedEtag returns the value that was added to the "etag" field in this mutation.
+func (m *ArtifactMutation) AddedEtag() (r int64, exists bool) {
+	v := m.addetag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (m *ArtifactMutation) ClearEtag() {
+	m.etag = nil
+	m.addetag = nil
+	m.clearedFields[artifact.FieldEtag] = struct{}{}
+}
+
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *ArtifactMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[artifact.FieldEtag]
+	return ok
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *ArtifactMutation) ResetEtag() {
+	m.etag = nil
+	m.addetag = nil
+	delete(m.clearedFields, artifact.FieldEtag)
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (m *ArtifactMutation) SetProjectUUID(s string) {
 	m.project_uuid = &s
@@ -3137,7 +3896,7 @@ func (m *ArtifactMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ArtifactMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.name != nil {
 		fields = append(fields, artifact.FieldName)
 	}
@@ -3156,6 +3915,9 @@ func (m *ArtifactMutation) Fields() []string {
 	if m.update_time != nil {
 		fields = append(fields, artifact.FieldUpdateTime)
 	}
+	if m.etag != nil {
+		fields = append(fields, artifact.FieldEtag)
+	}
 	if m.project_uuid != nil {
 		fields = append(fields, artifact.FieldProjectUUID)
 	}
@@ -3185,6 +3947,8 @@ func (m *ArtifactMutation) Field(name string) (ent.Value, bool) {
 		return m.CreateTime()
 	case artifact.FieldUpdateTime:
 		return m.UpdateTime()
+	case artifact.FieldEtag:
+		return m.Etag()
 	case artifact.FieldProjectUUID:
 		return m.ProjectUUID()
 	case artifact.FieldMimeType:
@@ -3212,6 +3976,8 @@ func (m *ArtifactMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCreateTime(ctx)
 	case artifact.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
+	case artifact.FieldEtag:
+		return m.OldEtag(ctx)
 	case artifact.FieldProjectUUID:
 		return m.OldProjectUUID(ctx)
 	case artifact.FieldMimeType:
@@ -3269,6 +4035,13 @@ func (m *ArtifactMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdateTime(v)
 		return nil
+	case artifact.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
 	case artifact.FieldProjectUUID:
 		v, ok := value.(string)
 		if !ok {
@@ -3297,13 +4070,21 @@ func (m *ArtifactMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ArtifactMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addetag != nil {
+		fields = append(fields, artifact.FieldEtag)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ArtifactMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case artifact.FieldEtag:
+		return m.AddedEtag()
+	}
 	return nil, false
 }
 
@@ -3312,6 +4093,13 @@ func (m *ArtifactMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ArtifactMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case artifact.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEtag(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Artifact numeric field %s", name)
 }
@@ -3329,6 +4117,9 @@ func (m *ArtifactMutation) ClearedFields() []string {
 	if m.FieldCleared(artifact.FieldDescription) {
 		fields = append(fields, artifact.FieldDescription)
 	}
+	if m.FieldCleared(artifact.FieldEtag) {
+		fields = append(fields, artifact.FieldEtag)
+	}
 	return fields
 }
 
@@ -3352,6 +4143,9 @@ func (m *ArtifactMutation) ClearField(name string) error {
 	case artifact.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case artifact.FieldEtag:
+		m.ClearEtag()
+		return nil
 	}
 	return fmt.Errorf("unknown Artifact nullable field %s", name)
 }
@@ -3378,6 +4172,9 @@ func (m *ArtifactMutation) ResetField(name string) error {
 	case artifact.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
+	case artifact.FieldEtag:
+		m.ResetEtag()
+		return nil
 	case artifact.FieldProjectUUID:
 		m.ResetProjectUUID()
 		return nil
@@ -3953,35 +4750,42 @@ func (m *ArtifactReferenceMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ArtifactReference edge %s", name)
 }
 
-// CommonMixinMutation represents an operation that mutates the CommonMixin nodes in the graph.
-type CommonMixinMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uint64
-	name            *string
-	display_name    *string
-	display_name_lc *string
-	description     *string
-	create_time     *time.Time
-	update_time     *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*CommonMixin, error)
-	predicates      []predicate.CommonMixin
-}
-
-var _ ent.Mutation = (*CommonMixinMutation)(nil)
-
-// commonmixinOption allows management of the mutation configuration using functional options.
-type commonmixinOption func(*CommonMixinMutation)
-
-// newCommonMixinMutation creates new mutation for the CommonMixin entity.
-func newCommonMixinMutation(c config, op Op, opts ...commonmixinOption) *CommonMixinMutation {
-	m := &CommonMixinMutation{
+	op                   Op
+	typ                  string
+	id                   *uint64
+	project_uuid         *string
+	user_name            *string
+	client               *string
+	method               *string
+	resource_type        *string
+	resource_name        *string
+	resource_version     *string
+	action               *string
+	changed_fields       *[]string
+	appendchanged_fields []string
+	before               *string
+	after                *string
+	create_time          *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*AuditEvent, error)
+	predicates           []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCommonMixin,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3990,20 +4794,20 @@ func newCommonMixinMutation(c config, op Op, opts ...commonmixinOption) *CommonM
 	return m
 }
 
-// withCommonMixinID sets the ID field of the mutation.
-func withCommonMixinID(id uint64) commonmixinOption {
-	return func(m *CommonMixinMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id uint64) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CommonMixin
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*CommonMixin, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CommonMixin.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4012,10 +4816,10 @@ func withCommonMixinID(id uint64) commonmixinOption {
 	}
 }
 
-// withCommonMixin sets the old CommonMixin of the mutation.
-func withCommonMixin(node *CommonMixin) commonmixinOption {
-	return func(m *CommonMixinMutation) {
-		m.oldValue = func(context.Context) (*CommonMixin, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4024,7 +4828,7 @@ func withCommonMixin(node *CommonMixin) commonmixinOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommonMixinMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4032,7 +4836,7 @@ func (m CommonMixinMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommonMixinMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -4043,7 +4847,7 @@ func (m CommonMixinMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommonMixinMutation) ID() (id uint64, exists bool) {
+func (m *AuditEventMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4054,7 +4858,7 @@ func (m *CommonMixinMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommonMixinMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4063,276 +4867,482 @@ func (m *CommonMixinMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CommonMixin.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *CommonMixinMutation) SetName(s string) {
-	m.name = &s
+// SetProjectUUID sets the "project_uuid" field.
+func (m *AuditEventMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CommonMixinMutation) Name() (r string, exists bool) {
-	v := m.name
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *AuditEventMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the CommonMixin entity.
-// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectUUID returns the old "project_uuid" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommonMixinMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ProjectUUID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CommonMixinMutation) ResetName() {
-	m.name = nil
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *AuditEventMutation) ResetProjectUUID() {
+	m.project_uuid = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *CommonMixinMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetUserName sets the "user_name" field.
+func (m *AuditEventMutation) SetUserName(s string) {
+	m.user_name = &s
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *CommonMixinMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *AuditEventMutation) UserName() (r string, exists bool) {
+	v := m.user_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the CommonMixin entity.
-// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
+// OldUserName returns the old "user_name" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommonMixinMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldUserName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldUserName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
 	}
-	return oldValue.DisplayName, nil
-}
-
-// ClearDisplayName clears the value of the "display_name" field.
-func (m *CommonMixinMutation) ClearDisplayName() {
-	m.display_name = nil
-	m.clearedFields[commonmixin.FieldDisplayName] = struct{}{}
-}
-
-// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *CommonMixinMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[commonmixin.FieldDisplayName]
-	return ok
+	return oldValue.UserName, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *CommonMixinMutation) ResetDisplayName() {
-	m.display_name = nil
-	delete(m.clearedFields, commonmixin.FieldDisplayName)
+// ResetUserName resets all changes to the "user_name" field.
+func (m *AuditEventMutation) ResetUserName() {
+	m.user_name = nil
 }
 
-// SetDisplayNameLc sets the "display_name_lc" field.
-func (m *CommonMixinMutation) SetDisplayNameLc(s string) {
-	m.display_name_lc = &s
+// SetClient sets the "client" field.
+func (m *AuditEventMutation) SetClient(s string) {
+	m.client = &s
 }
 
-// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *CommonMixinMutation) DisplayNameLc() (r string, exists bool) {
-	v := m.display_name_lc
+// GetClient returns the value of the "client" field in the mutation.
+func (m *AuditEventMutation) GetClient() (r string, exists bool) {
+	v := m.client
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the CommonMixin entity.
-// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
+// OldClient returns the old "client" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommonMixinMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldClient(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+		return v, errors.New("OldClient is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+		return v, errors.New("OldClient requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+		return v, fmt.Errorf("querying old value for OldClient: %w", err)
 	}
-	return oldValue.DisplayNameLc, nil
-}
-
-// ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *CommonMixinMutation) ClearDisplayNameLc() {
-	m.display_name_lc = nil
-	m.clearedFields[commonmixin.FieldDisplayNameLc] = struct{}{}
+	return oldValue.Client, nil
 }
 
-// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *CommonMixinMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[commonmixin.FieldDisplayNameLc]
-	return ok
+// ResetClient resets all changes to the "client" field.
+func (m *AuditEventMutation) ResetClient() {
+	m.client = nil
 }
 
-// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *CommonMixinMutation) ResetDisplayNameLc() {
-	m.display_name_lc = nil
-	delete(m.clearedFields, commonmixin.FieldDisplayNameLc)
+// SetMethod sets the "method" field.
+func (m *AuditEventMutation) SetMethod(s string) {
+	m.method = &s
 }
 
-// SetDescription sets the "description" field.
-func (m *CommonMixinMutation) SetDescription(s string) {
-	m.description = &s
+// Method returns the value of the "method" field in the mutation.
+func (m *AuditEventMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *CommonMixinMutation) Description() (r string, exists bool) {
-	v := m.description
+// OldMethod returns the old "method" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *AuditEventMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *AuditEventMutation) SetResourceType(s string) {
+	m.resource_type = &s
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *AuditEventMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the CommonMixin entity.
-// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommonMixinMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldResourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ResourceType, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *CommonMixinMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[commonmixin.FieldDescription] = struct{}{}
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *AuditEventMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *CommonMixinMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[commonmixin.FieldDescription]
+// SetResourceName sets the "resource_name" field.
+func (m *AuditEventMutation) SetResourceName(s string) {
+	m.resource_name = &s
+}
+
+// ResourceName returns the value of the "resource_name" field in the mutation.
+func (m *AuditEventMutation) ResourceName() (r string, exists bool) {
+	v := m.resource_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceName returns the old "resource_name" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldResourceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceName: %w", err)
+	}
+	return oldValue.ResourceName, nil
+}
+
+// ResetResourceName resets all changes to the "resource_name" field.
+func (m *AuditEventMutation) ResetResourceName() {
+	m.resource_name = nil
+}
+
+// SetResourceVersion sets the "resource_version" field.
+func (m *AuditEventMutation) SetResourceVersion(s string) {
+	m.resource_version = &s
+}
+
+// ResourceVersion returns the value of the "resource_version" field in the mutation.
+func (m *AuditEventMutation) ResourceVersion() (r string, exists bool) {
+	v := m.resource_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceVersion returns the old "resource_version" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldResourceVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceVersion: %w", err)
+	}
+	return oldValue.ResourceVersion, nil
+}
+
+// ResetResourceVersion resets all changes to the "resource_version" field.
+func (m *AuditEventMutation) ResetResourceVersion() {
+	m.resource_version = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (m *AuditEventMutation) SetChangedFields(s []string) {
+	m.changed_fields = &s
+	m.appendchanged_fields = nil
+}
+
+// ChangedFields returns the value of the "changed_fields" field in the mutation.
+func (m *AuditEventMutation) ChangedFields() (r []string, exists bool) {
+	v := m.changed_fields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedFields returns the old "changed_fields" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldChangedFields(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedFields: %w", err)
+	}
+	return oldValue.ChangedFields, nil
+}
+
+// AppendChangedFields adds s to the "changed_fields" field.
+func (m *AuditEventMutation) AppendChangedFields(s []string) {
+	m.appendchanged_fields = append(m.appendchanged_fields, s...)
+}
+
+// AppendedChangedFields returns the list of values that were appended to the "changed_fields" field in this mutation.
+func (m *AuditEventMutation) AppendedChangedFields() ([]string, bool) {
+	if len(m.appendchanged_fields) == 0 {
+		return nil, false
+	}
+	return m.appendchanged_fields, true
+}
+
+// ClearChangedFields clears the value of the "changed_fields" field.
+func (m *AuditEventMutation) ClearChangedFields() {
+	m.changed_fields = nil
+	m.appendchanged_fields = nil
+	m.clearedFields[auditevent.FieldChangedFields] = struct{}{}
+}
+
+// ChangedFieldsCleared returns if the "changed_fields" field was cleared in this mutation.
+func (m *AuditEventMutation) ChangedFieldsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldChangedFields]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *CommonMixinMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, commonmixin.FieldDescription)
+// ResetChangedFields resets all changes to the "changed_fields" field.
+func (m *AuditEventMutation) ResetChangedFields() {
+	m.changed_fields = nil
+	m.appendchanged_fields = nil
+	delete(m.clearedFields, auditevent.FieldChangedFields)
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *CommonMixinMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetBefore sets the "before" field.
+func (m *AuditEventMutation) SetBefore(s string) {
+	m.before = &s
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *CommonMixinMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// Before returns the value of the "before" field in the mutation.
+func (m *AuditEventMutation) Before() (r string, exists bool) {
+	v := m.before
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the CommonMixin entity.
-// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
+// OldBefore returns the old "before" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommonMixinMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldBefore(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldBefore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.Before, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *CommonMixinMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetBefore resets all changes to the "before" field.
+func (m *AuditEventMutation) ResetBefore() {
+	m.before = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *CommonMixinMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetAfter sets the "after" field.
+func (m *AuditEventMutation) SetAfter(s string) {
+	m.after = &s
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *CommonMixinMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// After returns the value of the "after" field in the mutation.
+func (m *AuditEventMutation) After() (r string, exists bool) {
+	v := m.after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the CommonMixin entity.
-// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
+// OldAfter returns the old "after" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommonMixinMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldAfter(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.After, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *CommonMixinMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetAfter resets all changes to the "after" field.
+func (m *AuditEventMutation) ResetAfter() {
+	m.after = nil
 }
 
-// Where appends a list predicates to the CommonMixinMutation builder.
-func (m *CommonMixinMutation) Where(ps ...predicate.CommonMixin) {
+// SetCreateTime sets the "create_time" field.
+func (m *AuditEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *AuditEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *AuditEventMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CommonMixinMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommonMixinMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CommonMixin, len(ps))
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4340,42 +5350,60 @@ func (m *CommonMixinMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CommonMixinMutation) Op() Op {
+func (m *AuditEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CommonMixinMutation) SetOp(op Op) {
+func (m *AuditEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CommonMixin).
-func (m *CommonMixinMutation) Type() string {
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CommonMixinMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, commonmixin.FieldName)
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.project_uuid != nil {
+		fields = append(fields, auditevent.FieldProjectUUID)
 	}
-	if m.display_name != nil {
-		fields = append(fields, commonmixin.FieldDisplayName)
+	if m.user_name != nil {
+		fields = append(fields, auditevent.FieldUserName)
 	}
-	if m.display_name_lc != nil {
-		fields = append(fields, commonmixin.FieldDisplayNameLc)
+	if m.client != nil {
+		fields = append(fields, auditevent.FieldClient)
 	}
-	if m.description != nil {
-		fields = append(fields, commonmixin.FieldDescription)
+	if m.method != nil {
+		fields = append(fields, auditevent.FieldMethod)
 	}
-	if m.create_time != nil {
-		fields = append(fields, commonmixin.FieldCreateTime)
+	if m.resource_type != nil {
+		fields = append(fields, auditevent.FieldResourceType)
 	}
-	if m.update_time != nil {
-		fields = append(fields, commonmixin.FieldUpdateTime)
+	if m.resource_name != nil {
+		fields = append(fields, auditevent.FieldResourceName)
+	}
+	if m.resource_version != nil {
+		fields = append(fields, auditevent.FieldResourceVersion)
+	}
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
+	}
+	if m.changed_fields != nil {
+		fields = append(fields, auditevent.FieldChangedFields)
+	}
+	if m.before != nil {
+		fields = append(fields, auditevent.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, auditevent.FieldAfter)
+	}
+	if m.create_time != nil {
+		fields = append(fields, auditevent.FieldCreateTime)
 	}
 	return fields
 }
@@ -4383,20 +5411,32 @@ func (m *CommonMixinMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommonMixinMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case commonmixin.FieldName:
-		return m.Name()
-	case commonmixin.FieldDisplayName:
-		return m.DisplayName()
-	case commonmixin.FieldDisplayNameLc:
-		return m.DisplayNameLc()
-	case commonmixin.FieldDescription:
-		return m.Description()
-	case commonmixin.FieldCreateTime:
+	case auditevent.FieldProjectUUID:
+		return m.ProjectUUID()
+	case auditevent.FieldUserName:
+		return m.UserName()
+	case auditevent.FieldClient:
+		return m.GetClient()
+	case auditevent.FieldMethod:
+		return m.Method()
+	case auditevent.FieldResourceType:
+		return m.ResourceType()
+	case auditevent.FieldResourceName:
+		return m.ResourceName()
+	case auditevent.FieldResourceVersion:
+		return m.ResourceVersion()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldChangedFields:
+		return m.ChangedFields()
+	case auditevent.FieldBefore:
+		return m.Before()
+	case auditevent.FieldAfter:
+		return m.After()
+	case auditevent.FieldCreateTime:
 		return m.CreateTime()
-	case commonmixin.FieldUpdateTime:
-		return m.UpdateTime()
 	}
 	return nil, false
 }
@@ -4404,275 +5444,300 @@ func (m *CommonMixinMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommonMixinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case commonmixin.FieldName:
-		return m.OldName(ctx)
-	case commonmixin.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case commonmixin.FieldDisplayNameLc:
-		return m.OldDisplayNameLc(ctx)
-	case commonmixin.FieldDescription:
-		return m.OldDescription(ctx)
-	case commonmixin.FieldCreateTime:
+	case auditevent.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case auditevent.FieldUserName:
+		return m.OldUserName(ctx)
+	case auditevent.FieldClient:
+		return m.OldClient(ctx)
+	case auditevent.FieldMethod:
+		return m.OldMethod(ctx)
+	case auditevent.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case auditevent.FieldResourceName:
+		return m.OldResourceName(ctx)
+	case auditevent.FieldResourceVersion:
+		return m.OldResourceVersion(ctx)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldChangedFields:
+		return m.OldChangedFields(ctx)
+	case auditevent.FieldBefore:
+		return m.OldBefore(ctx)
+	case auditevent.FieldAfter:
+		return m.OldAfter(ctx)
+	case auditevent.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case commonmixin.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown CommonMixin field %s", name)
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommonMixinMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case commonmixin.FieldName:
+	case auditevent.FieldProjectUUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProjectUUID(v)
 		return nil
-	case commonmixin.FieldDisplayName:
+	case auditevent.FieldUserName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetUserName(v)
 		return nil
-	case commonmixin.FieldDisplayNameLc:
+	case auditevent.FieldClient:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayNameLc(v)
+		m.SetClient(v)
 		return nil
-	case commonmixin.FieldDescription:
+	case auditevent.FieldMethod:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetMethod(v)
 		return nil
-	case commonmixin.FieldCreateTime:
-		v, ok := value.(time.Time)
+	case auditevent.FieldResourceType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetResourceType(v)
 		return nil
-	case commonmixin.FieldUpdateTime:
+	case auditevent.FieldResourceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceName(v)
+		return nil
+	case auditevent.FieldResourceVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceVersion(v)
+		return nil
+	case auditevent.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditevent.FieldChangedFields:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedFields(v)
+		return nil
+	case auditevent.FieldBefore:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBefore(v)
+		return nil
+	case auditevent.FieldAfter:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfter(v)
+		return nil
+	case auditevent.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetCreateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CommonMixin field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommonMixinMutation) AddedFields() []string {
+func (m *AuditEventMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommonMixinMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommonMixinMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown CommonMixin numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommonMixinMutation) ClearedFields() []string {
+func (m *AuditEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(commonmixin.FieldDisplayName) {
-		fields = append(fields, commonmixin.FieldDisplayName)
-	}
-	if m.FieldCleared(commonmixin.FieldDisplayNameLc) {
-		fields = append(fields, commonmixin.FieldDisplayNameLc)
-	}
-	if m.FieldCleared(commonmixin.FieldDescription) {
-		fields = append(fields, commonmixin.FieldDescription)
+	if m.FieldCleared(auditevent.FieldChangedFields) {
+		fields = append(fields, auditevent.FieldChangedFields)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommonMixinMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommonMixinMutation) ClearField(name string) error {
+func (m *AuditEventMutation) ClearField(name string) error {
 	switch name {
-	case commonmixin.FieldDisplayName:
-		m.ClearDisplayName()
-		return nil
-	case commonmixin.FieldDisplayNameLc:
-		m.ClearDisplayNameLc()
-		return nil
-	case commonmixin.FieldDescription:
-		m.ClearDescription()
+	case auditevent.FieldChangedFields:
+		m.ClearChangedFields()
 		return nil
 	}
-	return fmt.Errorf("unknown CommonMixin nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CommonMixinMutation) ResetField(name string) error {
+func (m *AuditEventMutation) ResetField(name string) error {
 	switch name {
-	case commonmixin.FieldName:
-		m.ResetName()
+	case auditevent.FieldProjectUUID:
+		m.ResetProjectUUID()
 		return nil
-	case commonmixin.FieldDisplayName:
-		m.ResetDisplayName()
+	case auditevent.FieldUserName:
+		m.ResetUserName()
 		return nil
-	case commonmixin.FieldDisplayNameLc:
-		m.ResetDisplayNameLc()
+	case auditevent.FieldClient:
+		m.ResetClient()
 		return nil
-	case commonmixin.FieldDescription:
-		m.ResetDescription()
+	case auditevent.FieldMethod:
+		m.ResetMethod()
 		return nil
-	case commonmixin.FieldCreateTime:
-		m.ResetCreateTime()
+	case auditevent.FieldResourceType:
+		m.ResetResourceType()
 		return nil
-	case commonmixin.FieldUpdateTime:
-		m.ResetUpdateTime()
+	case auditevent.FieldResourceName:
+		m.ResetResourceName()
+		return nil
+	case auditevent.FieldResourceVersion:
+		m.ResetResourceVersion()
+		return nil
+	case auditevent.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditevent.FieldChangedFields:
+		m.ResetChangedFields()
+		return nil
+	case auditevent.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case auditevent.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case auditevent.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown CommonMixin field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommonMixinMutation) AddedEdges() []string {
+func (m *AuditEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommonMixinMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommonMixinMutation) RemovedEdges() []string {
+func (m *AuditEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommonMixinMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommonMixinMutation) ClearedEdges() []string {
+func (m *AuditEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommonMixinMutation) EdgeCleared(name string) bool {
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommonMixinMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CommonMixin unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommonMixinMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CommonMixin edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// DeploymentPackageMutation represents an operation that mutates the DeploymentPackage nodes in the graph.
-type DeploymentPackageMutation struct {
+// CommonMixinMutation represents an operation that mutates the CommonMixin nodes in the graph.
+type CommonMixinMutation struct {
 	config
-	op                              Op
-	typ                             string
-	id                              *uint64
-	name                            *string
-	display_name                    *string
-	display_name_lc                 *string
-	description                     *string
-	create_time                     *time.Time
-	update_time                     *time.Time
-	project_uuid                    *string
-	version                         *string
-	is_deployed                     *bool
-	is_visible                      *bool
-	allows_multiple_deployments     *bool
-	kind                            *string
-	clearedFields                   map[string]struct{}
-	deployment_profiles             map[uint64]struct{}
-	removeddeployment_profiles      map[uint64]struct{}
-	cleareddeployment_profiles      bool
-	applications                    map[uint64]struct{}
-	removedapplications             map[uint64]struct{}
-	clearedapplications             bool
-	icon                            map[uint64]struct{}
-	removedicon                     map[uint64]struct{}
-	clearedicon                     bool
-	thumbnail                       map[uint64]struct{}
-	removedthumbnail                map[uint64]struct{}
-	clearedthumbnail                bool
-	default_profile                 *uint64
-	cleareddefault_profile          bool
-	application_dependencies        map[uint64]struct{}
-	removedapplication_dependencies map[uint64]struct{}
-	clearedapplication_dependencies bool
-	application_namespaces          map[uint64]struct{}
-	removedapplication_namespaces   map[uint64]struct{}
-	clearedapplication_namespaces   bool
-	namespaces                      map[uint64]struct{}
-	removednamespaces               map[uint64]struct{}
-	clearednamespaces               bool
-	extensions                      map[uint64]struct{}
-	removedextensions               map[uint64]struct{}
-	clearedextensions               bool
-	artifacts                       map[uint64]struct{}
-	removedartifacts                map[uint64]struct{}
-	clearedartifacts                bool
-	done                            bool
-	oldValue                        func(context.Context) (*DeploymentPackage, error)
-	predicates                      []predicate.DeploymentPackage
+	op              Op
+	typ             string
+	id              *uint64
+	name            *string
+	display_name    *string
+	display_name_lc *string
+	description     *string
+	create_time     *time.Time
+	update_time     *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*CommonMixin, error)
+	predicates      []predicate.CommonMixin
 }
 
-var _ ent.Mutation = (*DeploymentPackageMutation)(nil)
+var _ ent.Mutation = (*CommonMixinMutation)(nil)
 
-// deploymentpackageOption allows management of the mutation configuration using functional options.
-type deploymentpackageOption func(*DeploymentPackageMutation)
+// commonmixinOption allows management of the mutation configuration using functional options.
+type commonmixinOption func(*CommonMixinMutation)
 
-// newDeploymentPackageMutation creates new mutation for the DeploymentPackage entity.
-func newDeploymentPackageMutation(c config, op Op, opts ...deploymentpackageOption) *DeploymentPackageMutation {
-	m := &DeploymentPackageMutation{
+// newCommonMixinMutation creates new mutation for the CommonMixin entity.
+func newCommonMixinMutation(c config, op Op, opts ...commonmixinOption) *CommonMixinMutation {
+	m := &CommonMixinMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentPackage,
+		typ:           TypeCommonMixin,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4681,20 +5746,20 @@ func newDeploymentPackageMutation(c config, op Op, opts ...deploymentpackageOpti
 	return m
 }
 
-// withDeploymentPackageID sets the ID field of the mutation.
-func withDeploymentPackageID(id uint64) deploymentpackageOption {
-	return func(m *DeploymentPackageMutation) {
+// withCommonMixinID sets the ID field of the mutation.
+func withCommonMixinID(id uint64) commonmixinOption {
+	return func(m *CommonMixinMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentPackage
+			value *CommonMixin
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentPackage, error) {
+		m.oldValue = func(ctx context.Context) (*CommonMixin, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentPackage.Get(ctx, id)
+					value, err = m.Client().CommonMixin.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4703,10 +5768,10 @@ func withDeploymentPackageID(id uint64) deploymentpackageOption {
 	}
 }
 
-// withDeploymentPackage sets the old DeploymentPackage of the mutation.
-func withDeploymentPackage(node *DeploymentPackage) deploymentpackageOption {
-	return func(m *DeploymentPackageMutation) {
-		m.oldValue = func(context.Context) (*DeploymentPackage, error) {
+// withCommonMixin sets the old CommonMixin of the mutation.
+func withCommonMixin(node *CommonMixin) commonmixinOption {
+	return func(m *CommonMixinMutation) {
+		m.oldValue = func(context.Context) (*CommonMixin, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4715,7 +5780,7 @@ func withDeploymentPackage(node *DeploymentPackage) deploymentpackageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentPackageMutation) Client() *Client {
+func (m CommonMixinMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4723,7 +5788,7 @@ func (m DeploymentPackageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentPackageMutation) Tx() (*Tx, error) {
+func (m CommonMixinMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -4734,7 +5799,7 @@ func (m DeploymentPackageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentPackageMutation) ID() (id uint64, exists bool) {
+func (m *CommonMixinMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4745,7 +5810,7 @@ func (m *DeploymentPackageMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentPackageMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *CommonMixinMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4754,19 +5819,19 @@ func (m *DeploymentPackageMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentPackage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CommonMixin.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *DeploymentPackageMutation) SetName(s string) {
+func (m *CommonMixinMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *DeploymentPackageMutation) Name() (r string, exists bool) {
+func (m *CommonMixinMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -4774,10 +5839,10 @@ func (m *DeploymentPackageMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the CommonMixin entity.
+// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CommonMixinMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -4792,17 +5857,17 @@ func (m *DeploymentPackageMutation) OldName(ctx context.Context) (v string, err
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *DeploymentPackageMutation) ResetName() {
+func (m *CommonMixinMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDisplayName sets the "display_name" field.
-func (m *DeploymentPackageMutation) SetDisplayName(s string) {
+func (m *CommonMixinMutation) SetDisplayName(s string) {
 	m.display_name = &s
 }
 
 // DisplayName returns the value of the "display_name" field in the mutation.
-func (m *DeploymentPackageMutation) DisplayName() (r string, exists bool) {
+func (m *CommonMixinMutation) DisplayName() (r string, exists bool) {
 	v := m.display_name
 	if v == nil {
 		return
@@ -4810,10 +5875,10 @@ func (m *DeploymentPackageMutation) DisplayName() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayName returns the old "display_name" field's value of the CommonMixin entity.
+// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *CommonMixinMutation) OldDisplayName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
@@ -4828,30 +5893,30 @@ func (m *DeploymentPackageMutation) OldDisplayName(ctx context.Context) (v strin
 }
 
 // ClearDisplayName clears the value of the "display_name" field.
-func (m *DeploymentPackageMutation) ClearDisplayName() {
+func (m *CommonMixinMutation) ClearDisplayName() {
 	m.display_name = nil
-	m.clearedFields[deploymentpackage.FieldDisplayName] = struct{}{}
+	m.clearedFields[commonmixin.FieldDisplayName] = struct{}{}
 }
 
 // DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldDisplayName]
+func (m *CommonMixinMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[commonmixin.FieldDisplayName]
 	return ok
 }
 
 // ResetDisplayName resets all changes to the "display_name" field.
-func (m *DeploymentPackageMutation) ResetDisplayName() {
+func (m *CommonMixinMutation) ResetDisplayName() {
 	m.display_name = nil
-	delete(m.clearedFields, deploymentpackage.FieldDisplayName)
+	delete(m.clearedFields, commonmixin.FieldDisplayName)
 }
 
 // SetDisplayNameLc sets the "display_name_lc" field.
-func (m *DeploymentPackageMutation) SetDisplayNameLc(s string) {
+func (m *CommonMixinMutation) SetDisplayNameLc(s string) {
 	m.display_name_lc = &s
 }
 
 // DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *DeploymentPackageMutation) DisplayNameLc() (r string, exists bool) {
+func (m *CommonMixinMutation) DisplayNameLc() (r string, exists bool) {
 	v := m.display_name_lc
 	if v == nil {
 		return
@@ -4859,10 +5924,10 @@ func (m *DeploymentPackageMutation) DisplayNameLc() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the CommonMixin entity.
+// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+func (m *CommonMixinMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
 	}
@@ -4877,30 +5942,30 @@ func (m *DeploymentPackageMutation) OldDisplayNameLc(ctx context.Context) (v str
 }
 
 // ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *DeploymentPackageMutation) ClearDisplayNameLc() {
+func (m *CommonMixinMutation) ClearDisplayNameLc() {
 	m.display_name_lc = nil
-	m.clearedFields[deploymentpackage.FieldDisplayNameLc] = struct{}{}
+	m.clearedFields[commonmixin.FieldDisplayNameLc] = struct{}{}
 }
 
 // DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldDisplayNameLc]
+func (m *CommonMixinMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[commonmixin.FieldDisplayNameLc]
 	return ok
 }
 
 // ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *DeploymentPackageMutation) ResetDisplayNameLc() {
+func (m *CommonMixinMutation) ResetDisplayNameLc() {
 	m.display_name_lc = nil
-	delete(m.clearedFields, deploymentpackage.FieldDisplayNameLc)
+	delete(m.clearedFields, commonmixin.FieldDisplayNameLc)
 }
 
 // SetDescription sets the "description" field.
-func (m *DeploymentPackageMutation) SetDescription(s string) {
+func (m *CommonMixinMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *DeploymentPackageMutation) Description() (r string, exists bool) {
+func (m *CommonMixinMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -4908,10 +5973,10 @@ func (m *DeploymentPackageMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the CommonMixin entity.
+// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *CommonMixinMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -4926,30 +5991,30 @@ func (m *DeploymentPackageMutation) OldDescription(ctx context.Context) (v strin
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *DeploymentPackageMutation) ClearDescription() {
+func (m *CommonMixinMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[deploymentpackage.FieldDescription] = struct{}{}
+	m.clearedFields[commonmixin.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldDescription]
+func (m *CommonMixinMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[commonmixin.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *DeploymentPackageMutation) ResetDescription() {
+func (m *CommonMixinMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, deploymentpackage.FieldDescription)
+	delete(m.clearedFields, commonmixin.FieldDescription)
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DeploymentPackageMutation) SetCreateTime(t time.Time) {
+func (m *CommonMixinMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DeploymentPackageMutation) CreateTime() (r time.Time, exists bool) {
+func (m *CommonMixinMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -4957,10 +6022,10 @@ func (m *DeploymentPackageMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the CommonMixin entity.
+// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *CommonMixinMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -4975,17 +6040,17 @@ func (m *DeploymentPackageMutation) OldCreateTime(ctx context.Context) (v time.T
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DeploymentPackageMutation) ResetCreateTime() {
+func (m *CommonMixinMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DeploymentPackageMutation) SetUpdateTime(t time.Time) {
+func (m *CommonMixinMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DeploymentPackageMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *CommonMixinMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -4993,10 +6058,10 @@ func (m *DeploymentPackageMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the CommonMixin entity.
+// If the CommonMixin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *CommonMixinMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -5011,873 +6076,5740 @@ func (m *DeploymentPackageMutation) OldUpdateTime(ctx context.Context) (v time.T
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DeploymentPackageMutation) ResetUpdateTime() {
+func (m *CommonMixinMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetProjectUUID sets the "project_uuid" field.
-func (m *DeploymentPackageMutation) SetProjectUUID(s string) {
-	m.project_uuid = &s
-}
-
-// ProjectUUID returns the value of the "project_uuid" field in the mutation.
-func (m *DeploymentPackageMutation) ProjectUUID() (r string, exists bool) {
-	v := m.project_uuid
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the CommonMixinMutation builder.
+func (m *CommonMixinMutation) Where(ps ...predicate.CommonMixin) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldProjectUUID returns the old "project_uuid" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
+// WhereP appends storage-level predicates to the CommonMixinMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommonMixinMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommonMixin, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.ProjectUUID, nil
+	m.Where(p...)
 }
 
-// ResetProjectUUID resets all changes to the "project_uuid" field.
-func (m *DeploymentPackageMutation) ResetProjectUUID() {
-	m.project_uuid = nil
+// Op returns the operation name.
+func (m *CommonMixinMutation) Op() Op {
+	return m.op
 }
 
-// SetVersion sets the "version" field.
-func (m *DeploymentPackageMutation) SetVersion(s string) {
-	m.version = &s
+// SetOp allows setting the mutation operation.
+func (m *CommonMixinMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *DeploymentPackageMutation) Version() (r string, exists bool) {
-	v := m.version
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (CommonMixin).
+func (m *CommonMixinMutation) Type() string {
+	return m.typ
 }
 
-// OldVersion returns the old "version" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommonMixinMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, commonmixin.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	if m.display_name != nil {
+		fields = append(fields, commonmixin.FieldDisplayName)
 	}
-	return oldValue.Version, nil
-}
-
-// ResetVersion resets all changes to the "version" field.
-func (m *DeploymentPackageMutation) ResetVersion() {
-	m.version = nil
-}
-
-// SetIsDeployed sets the "is_deployed" field.
-func (m *DeploymentPackageMutation) SetIsDeployed(b bool) {
-	m.is_deployed = &b
-}
-
-// IsDeployed returns the value of the "is_deployed" field in the mutation.
-func (m *DeploymentPackageMutation) IsDeployed() (r bool, exists bool) {
-	v := m.is_deployed
-	if v == nil {
-		return
+	if m.display_name_lc != nil {
+		fields = append(fields, commonmixin.FieldDisplayNameLc)
 	}
-	return *v, true
-}
-
-// OldIsDeployed returns the old "is_deployed" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldIsDeployed(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDeployed is only allowed on UpdateOne operations")
+	if m.description != nil {
+		fields = append(fields, commonmixin.FieldDescription)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDeployed requires an ID field in the mutation")
+	if m.create_time != nil {
+		fields = append(fields, commonmixin.FieldCreateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDeployed: %w", err)
+	if m.update_time != nil {
+		fields = append(fields, commonmixin.FieldUpdateTime)
 	}
-	return oldValue.IsDeployed, nil
-}
-
-// ClearIsDeployed clears the value of the "is_deployed" field.
-func (m *DeploymentPackageMutation) ClearIsDeployed() {
-	m.is_deployed = nil
-	m.clearedFields[deploymentpackage.FieldIsDeployed] = struct{}{}
-}
-
-// IsDeployedCleared returns if the "is_deployed" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) IsDeployedCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldIsDeployed]
-	return ok
-}
-
-// ResetIsDeployed resets all changes to the "is_deployed" field.
-func (m *DeploymentPackageMutation) ResetIsDeployed() {
-	m.is_deployed = nil
-	delete(m.clearedFields, deploymentpackage.FieldIsDeployed)
-}
-
-// SetIsVisible sets the "is_visible" field.
-func (m *DeploymentPackageMutation) SetIsVisible(b bool) {
-	m.is_visible = &b
+	return fields
 }
 
-// IsVisible returns the value of the "is_visible" field in the mutation.
-func (m *DeploymentPackageMutation) IsVisible() (r bool, exists bool) {
-	v := m.is_visible
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommonMixinMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commonmixin.FieldName:
+		return m.Name()
+	case commonmixin.FieldDisplayName:
+		return m.DisplayName()
+	case commonmixin.FieldDisplayNameLc:
+		return m.DisplayNameLc()
+	case commonmixin.FieldDescription:
+		return m.Description()
+	case commonmixin.FieldCreateTime:
+		return m.CreateTime()
+	case commonmixin.FieldUpdateTime:
+		return m.UpdateTime()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldIsVisible returns the old "is_visible" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldIsVisible(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisible is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisible requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisible: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommonMixinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commonmixin.FieldName:
+		return m.OldName(ctx)
+	case commonmixin.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case commonmixin.FieldDisplayNameLc:
+		return m.OldDisplayNameLc(ctx)
+	case commonmixin.FieldDescription:
+		return m.OldDescription(ctx)
+	case commonmixin.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case commonmixin.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
 	}
-	return oldValue.IsVisible, nil
+	return nil, fmt.Errorf("unknown CommonMixin field %s", name)
 }
 
-// ClearIsVisible clears the value of the "is_visible" field.
-func (m *DeploymentPackageMutation) ClearIsVisible() {
-	m.is_visible = nil
-	m.clearedFields[deploymentpackage.FieldIsVisible] = struct{}{}
-}
-
-// IsVisibleCleared returns if the "is_visible" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) IsVisibleCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldIsVisible]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommonMixinMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commonmixin.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case commonmixin.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case commonmixin.FieldDisplayNameLc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayNameLc(v)
+		return nil
+	case commonmixin.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case commonmixin.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case commonmixin.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommonMixin field %s", name)
 }
 
-// ResetIsVisible resets all changes to the "is_visible" field.
-func (m *DeploymentPackageMutation) ResetIsVisible() {
-	m.is_visible = nil
-	delete(m.clearedFields, deploymentpackage.FieldIsVisible)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommonMixinMutation) AddedFields() []string {
+	return nil
 }
 
-// SetAllowsMultipleDeployments sets the "allows_multiple_deployments" field.
-func (m *DeploymentPackageMutation) SetAllowsMultipleDeployments(b bool) {
-	m.allows_multiple_deployments = &b
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommonMixinMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AllowsMultipleDeployments returns the value of the "allows_multiple_deployments" field in the mutation.
-func (m *DeploymentPackageMutation) AllowsMultipleDeployments() (r bool, exists bool) {
-	v := m.allows_multiple_deployments
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommonMixinMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown CommonMixin numeric field %s", name)
 }
 
-// OldAllowsMultipleDeployments returns the old "allows_multiple_deployments" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldAllowsMultipleDeployments(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAllowsMultipleDeployments is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommonMixinMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(commonmixin.FieldDisplayName) {
+		fields = append(fields, commonmixin.FieldDisplayName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAllowsMultipleDeployments requires an ID field in the mutation")
+	if m.FieldCleared(commonmixin.FieldDisplayNameLc) {
+		fields = append(fields, commonmixin.FieldDisplayNameLc)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAllowsMultipleDeployments: %w", err)
+	if m.FieldCleared(commonmixin.FieldDescription) {
+		fields = append(fields, commonmixin.FieldDescription)
 	}
-	return oldValue.AllowsMultipleDeployments, nil
-}
-
-// ClearAllowsMultipleDeployments clears the value of the "allows_multiple_deployments" field.
-func (m *DeploymentPackageMutation) ClearAllowsMultipleDeployments() {
-	m.allows_multiple_deployments = nil
-	m.clearedFields[deploymentpackage.FieldAllowsMultipleDeployments] = struct{}{}
+	return fields
 }
 
-// AllowsMultipleDeploymentsCleared returns if the "allows_multiple_deployments" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) AllowsMultipleDeploymentsCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldAllowsMultipleDeployments]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommonMixinMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetAllowsMultipleDeployments resets all changes to the "allows_multiple_deployments" field.
-func (m *DeploymentPackageMutation) ResetAllowsMultipleDeployments() {
-	m.allows_multiple_deployments = nil
-	delete(m.clearedFields, deploymentpackage.FieldAllowsMultipleDeployments)
-}
-
-// SetKind sets the "kind" field.
-func (m *DeploymentPackageMutation) SetKind(s string) {
-	m.kind = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommonMixinMutation) ClearField(name string) error {
+	switch name {
+	case commonmixin.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	case commonmixin.FieldDisplayNameLc:
+		m.ClearDisplayNameLc()
+		return nil
+	case commonmixin.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown CommonMixin nullable field %s", name)
 }
 
-// Kind returns the value of the "kind" field in the mutation.
-func (m *DeploymentPackageMutation) Kind() (r string, exists bool) {
-	v := m.kind
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommonMixinMutation) ResetField(name string) error {
+	switch name {
+	case commonmixin.FieldName:
+		m.ResetName()
+		return nil
+	case commonmixin.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case commonmixin.FieldDisplayNameLc:
+		m.ResetDisplayNameLc()
+		return nil
+	case commonmixin.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case commonmixin.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case commonmixin.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown CommonMixin field %s", name)
 }
 
-// OldKind returns the old "kind" field's value of the DeploymentPackage entity.
-// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentPackageMutation) OldKind(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKind is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKind requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKind: %w", err)
-	}
-	return oldValue.Kind, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommonMixinMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearKind clears the value of the "kind" field.
-func (m *DeploymentPackageMutation) ClearKind() {
-	m.kind = nil
-	m.clearedFields[deploymentpackage.FieldKind] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommonMixinMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// KindCleared returns if the "kind" field was cleared in this mutation.
-func (m *DeploymentPackageMutation) KindCleared() bool {
-	_, ok := m.clearedFields[deploymentpackage.FieldKind]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommonMixinMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetKind resets all changes to the "kind" field.
-func (m *DeploymentPackageMutation) ResetKind() {
-	m.kind = nil
-	delete(m.clearedFields, deploymentpackage.FieldKind)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommonMixinMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by ids.
-func (m *DeploymentPackageMutation) AddDeploymentProfileIDs(ids ...uint64) {
-	if m.deployment_profiles == nil {
-		m.deployment_profiles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.deployment_profiles[ids[i]] = struct{}{}
-	}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommonMixinMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDeploymentProfiles clears the "deployment_profiles" edge to the DeploymentProfile entity.
-func (m *DeploymentPackageMutation) ClearDeploymentProfiles() {
-	m.cleareddeployment_profiles = true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommonMixinMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// DeploymentProfilesCleared reports if the "deployment_profiles" edge to the DeploymentProfile entity was cleared.
-func (m *DeploymentPackageMutation) DeploymentProfilesCleared() bool {
-	return m.cleareddeployment_profiles
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommonMixinMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CommonMixin unique edge %s", name)
 }
 
-// RemoveDeploymentProfileIDs removes the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
-func (m *DeploymentPackageMutation) RemoveDeploymentProfileIDs(ids ...uint64) {
-	if m.removeddeployment_profiles == nil {
-		m.removeddeployment_profiles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.deployment_profiles, ids[i])
-		m.removeddeployment_profiles[ids[i]] = struct{}{}
-	}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommonMixinMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CommonMixin edge %s", name)
 }
 
-// RemovedDeploymentProfiles returns the removed IDs of the "deployment_profiles" edge to the DeploymentProfile entity.
-func (m *DeploymentPackageMutation) RemovedDeploymentProfilesIDs() (ids []uint64) {
-	for id := range m.removeddeployment_profiles {
-		ids = append(ids, id)
-	}
-	return
+// DeploymentPackageMutation represents an operation that mutates the DeploymentPackage nodes in the graph.
+type DeploymentPackageMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *uint64
+	name                            *string
+	display_name                    *string
+	display_name_lc                 *string
+	description                     *string
+	create_time                     *time.Time
+	update_time                     *time.Time
+	etag                            *int64
+	addetag                         *int64
+	project_uuid                    *string
+	version                         *string
+	version_key                     *string
+	is_deployed                     *bool
+	is_visible                      *bool
+	allows_multiple_deployments     *bool
+	kind                            *string
+	application_constraints         *map[string]string
+	search_text                     *string
+	pinned_resolution               *string
+	labels                          *map[string]string
+	deprecated                      *bool
+	deprecation_message             *string
+	replacement_version             *string
+	deprecate_time                  *time.Time
+	clearedFields                   map[string]struct{}
+	deployment_profiles             map[uint64]struct{}
+	removeddeployment_profiles      map[uint64]struct{}
+	cleareddeployment_profiles      bool
+	applications                    map[uint64]struct{}
+	removedapplications             map[uint64]struct{}
+	clearedapplications             bool
+	icon                            map[uint64]struct{}
+	removedicon                     map[uint64]struct{}
+	clearedicon                     bool
+	thumbnail                       map[uint64]struct{}
+	removedthumbnail                map[uint64]struct{}
+	clearedthumbnail                bool
+	default_profile                 *uint64
+	cleareddefault_profile          bool
+	application_dependencies        map[uint64]struct{}
+	removedapplication_dependencies map[uint64]struct{}
+	clearedapplication_dependencies bool
+	application_namespaces          map[uint64]struct{}
+	removedapplication_namespaces   map[uint64]struct{}
+	clearedapplication_namespaces   bool
+	namespaces                      map[uint64]struct{}
+	removednamespaces               map[uint64]struct{}
+	clearednamespaces               bool
+	extensions                      map[uint64]struct{}
+	removedextensions               map[uint64]struct{}
+	clearedextensions               bool
+	artifacts                       map[uint64]struct{}
+	removedartifacts                map[uint64]struct{}
+	clearedartifacts                bool
+	done                            bool
+	oldValue                        func(context.Context) (*DeploymentPackage, error)
+	predicates                      []predicate.DeploymentPackage
 }
 
-// DeploymentProfilesIDs returns the "deployment_profiles" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) DeploymentProfilesIDs() (ids []uint64) {
-	for id := range m.deployment_profiles {
-		ids = append(ids, id)
+var _ ent.Mutation = (*DeploymentPackageMutation)(nil)
+
+// deploymentpackageOption allows management of the mutation configuration using functional options.
+type deploymentpackageOption func(*DeploymentPackageMutation)
+
+// newDeploymentPackageMutation creates new mutation for the DeploymentPackage entity.
+func newDeploymentPackageMutation(c config, op Op, opts ...deploymentpackageOption) *DeploymentPackageMutation {
+	m := &DeploymentPackageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentPackage,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetDeploymentProfiles resets all changes to the "deployment_profiles" edge.
-func (m *DeploymentPackageMutation) ResetDeploymentProfiles() {
-	m.deployment_profiles = nil
-	m.cleareddeployment_profiles = false
-	m.removeddeployment_profiles = nil
+// withDeploymentPackageID sets the ID field of the mutation.
+func withDeploymentPackageID(id uint64) deploymentpackageOption {
+	return func(m *DeploymentPackageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentPackage
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentPackage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentPackage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
-func (m *DeploymentPackageMutation) AddApplicationIDs(ids ...uint64) {
-	if m.applications == nil {
-		m.applications = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.applications[ids[i]] = struct{}{}
+// withDeploymentPackage sets the old DeploymentPackage of the mutation.
+func withDeploymentPackage(node *DeploymentPackage) deploymentpackageOption {
+	return func(m *DeploymentPackageMutation) {
+		m.oldValue = func(context.Context) (*DeploymentPackage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// ClearApplications clears the "applications" edge to the Application entity.
-func (m *DeploymentPackageMutation) ClearApplications() {
-	m.clearedapplications = true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentPackageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
-func (m *DeploymentPackageMutation) ApplicationsCleared() bool {
-	return m.clearedapplications
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentPackageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
-func (m *DeploymentPackageMutation) RemoveApplicationIDs(ids ...uint64) {
-	if m.removedapplications == nil {
-		m.removedapplications = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.applications, ids[i])
-		m.removedapplications[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentPackageMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
-func (m *DeploymentPackageMutation) RemovedApplicationsIDs() (ids []uint64) {
-	for id := range m.removedapplications {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentPackageMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentPackage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ApplicationsIDs returns the "applications" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) ApplicationsIDs() (ids []uint64) {
-	for id := range m.applications {
-		ids = append(ids, id)
-	}
-	return
+// SetName sets the "name" field.
+func (m *DeploymentPackageMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetApplications resets all changes to the "applications" edge.
-func (m *DeploymentPackageMutation) ResetApplications() {
-	m.applications = nil
-	m.clearedapplications = false
-	m.removedapplications = nil
+// Name returns the value of the "name" field in the mutation.
+func (m *DeploymentPackageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddIconIDs adds the "icon" edge to the Artifact entity by ids.
-func (m *DeploymentPackageMutation) AddIconIDs(ids ...uint64) {
-	if m.icon == nil {
-		m.icon = make(map[uint64]struct{})
+// OldName returns the old "name" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.icon[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearIcon clears the "icon" edge to the Artifact entity.
-func (m *DeploymentPackageMutation) ClearIcon() {
-	m.clearedicon = true
+// ResetName resets all changes to the "name" field.
+func (m *DeploymentPackageMutation) ResetName() {
+	m.name = nil
 }
 
-// IconCleared reports if the "icon" edge to the Artifact entity was cleared.
-func (m *DeploymentPackageMutation) IconCleared() bool {
-	return m.clearedicon
+// SetDisplayName sets the "display_name" field.
+func (m *DeploymentPackageMutation) SetDisplayName(s string) {
+	m.display_name = &s
 }
 
-// RemoveIconIDs removes the "icon" edge to the Artifact entity by IDs.
-func (m *DeploymentPackageMutation) RemoveIconIDs(ids ...uint64) {
-	if m.removedicon == nil {
-		m.removedicon = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.icon, ids[i])
-		m.removedicon[ids[i]] = struct{}{}
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *DeploymentPackageMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedIcon returns the removed IDs of the "icon" edge to the Artifact entity.
-func (m *DeploymentPackageMutation) RemovedIconIDs() (ids []uint64) {
-	for id := range m.removedicon {
-		ids = append(ids, id)
+// OldDisplayName returns the old "display_name" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
 }
 
-// IconIDs returns the "icon" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) IconIDs() (ids []uint64) {
-	for id := range m.icon {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetIcon resets all changes to the "icon" edge.
-func (m *DeploymentPackageMutation) ResetIcon() {
-	m.icon = nil
-	m.clearedicon = false
-	m.removedicon = nil
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *DeploymentPackageMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[deploymentpackage.FieldDisplayName] = struct{}{}
 }
 
-// AddThumbnailIDs adds the "thumbnail" edge to the Artifact entity by ids.
-func (m *DeploymentPackageMutation) AddThumbnailIDs(ids ...uint64) {
-	if m.thumbnail == nil {
-		m.thumbnail = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.thumbnail[ids[i]] = struct{}{}
-	}
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldDisplayName]
+	return ok
 }
 
-// ClearThumbnail clears the "thumbnail" edge to the Artifact entity.
-func (m *DeploymentPackageMutation) ClearThumbnail() {
-	m.clearedthumbnail = true
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *DeploymentPackageMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, deploymentpackage.FieldDisplayName)
 }
 
-// ThumbnailCleared reports if the "thumbnail" edge to the Artifact entity was cleared.
-func (m *DeploymentPackageMutation) ThumbnailCleared() bool {
-	return m.clearedthumbnail
+// SetDisplayNameLc sets the "display_name_lc" field.
+func (m *DeploymentPackageMutation) SetDisplayNameLc(s string) {
+	m.display_name_lc = &s
 }
 
-// RemoveThumbnailIDs removes the "thumbnail" edge to the Artifact entity by IDs.
-func (m *DeploymentPackageMutation) RemoveThumbnailIDs(ids ...uint64) {
-	if m.removedthumbnail == nil {
-		m.removedthumbnail = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.thumbnail, ids[i])
-		m.removedthumbnail[ids[i]] = struct{}{}
+// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
+func (m *DeploymentPackageMutation) DisplayNameLc() (r string, exists bool) {
+	v := m.display_name_lc
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedThumbnail returns the removed IDs of the "thumbnail" edge to the Artifact entity.
-func (m *DeploymentPackageMutation) RemovedThumbnailIDs() (ids []uint64) {
-	for id := range m.removedthumbnail {
-		ids = append(ids, id)
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ThumbnailIDs returns the "thumbnail" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) ThumbnailIDs() (ids []uint64) {
-	for id := range m.thumbnail {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+	}
+	return oldValue.DisplayNameLc, nil
 }
 
-// ResetThumbnail resets all changes to the "thumbnail" edge.
-func (m *DeploymentPackageMutation) ResetThumbnail() {
-	m.thumbnail = nil
-	m.clearedthumbnail = false
-	m.removedthumbnail = nil
+// ClearDisplayNameLc clears the value of the "display_name_lc" field.
+func (m *DeploymentPackageMutation) ClearDisplayNameLc() {
+	m.display_name_lc = nil
+	m.clearedFields[deploymentpackage.FieldDisplayNameLc] = struct{}{}
 }
 
-// SetDefaultProfileID sets the "default_profile" edge to the DeploymentProfile entity by id.
-func (m *DeploymentPackageMutation) SetDefaultProfileID(id uint64) {
-	m.default_profile = &id
+// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldDisplayNameLc]
+	return ok
 }
 
-// ClearDefaultProfile clears the "default_profile" edge to the DeploymentProfile entity.
-func (m *DeploymentPackageMutation) ClearDefaultProfile() {
-	m.cleareddefault_profile = true
+// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
+func (m *DeploymentPackageMutation) ResetDisplayNameLc() {
+	m.display_name_lc = nil
+	delete(m.clearedFields, deploymentpackage.FieldDisplayNameLc)
 }
 
-// DefaultProfileCleared reports if the "default_profile" edge to the DeploymentProfile entity was cleared.
-func (m *DeploymentPackageMutation) DefaultProfileCleared() bool {
-	return m.cleareddefault_profile
+// SetDescription sets the "description" field.
+func (m *DeploymentPackageMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// DefaultProfileID returns the "default_profile" edge ID in the mutation.
-func (m *DeploymentPackageMutation) DefaultProfileID() (id uint64, exists bool) {
-	if m.default_profile != nil {
-		return *m.default_profile, true
+// Description returns the value of the "description" field in the mutation.
+func (m *DeploymentPackageMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// DefaultProfileIDs returns the "default_profile" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DefaultProfileID instead. It exists only for internal usage by the builders.
-func (m *DeploymentPackageMutation) DefaultProfileIDs() (ids []uint64) {
-	if id := m.default_profile; id != nil {
-		ids = append(ids, *id)
+// OldDescription returns the old "description" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetDefaultProfile resets all changes to the "default_profile" edge.
-func (m *DeploymentPackageMutation) ResetDefaultProfile() {
-	m.default_profile = nil
-	m.cleareddefault_profile = false
-}
-
-// AddApplicationDependencyIDs adds the "application_dependencies" edge to the ApplicationDependency entity by ids.
-func (m *DeploymentPackageMutation) AddApplicationDependencyIDs(ids ...uint64) {
-	if m.application_dependencies == nil {
-		m.application_dependencies = make(map[uint64]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.application_dependencies[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
+	return oldValue.Description, nil
 }
 
-// ClearApplicationDependencies clears the "application_dependencies" edge to the ApplicationDependency entity.
-func (m *DeploymentPackageMutation) ClearApplicationDependencies() {
-	m.clearedapplication_dependencies = true
+// ClearDescription clears the value of the "description" field.
+func (m *DeploymentPackageMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[deploymentpackage.FieldDescription] = struct{}{}
 }
 
-// ApplicationDependenciesCleared reports if the "application_dependencies" edge to the ApplicationDependency entity was cleared.
-func (m *DeploymentPackageMutation) ApplicationDependenciesCleared() bool {
-	return m.clearedapplication_dependencies
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldDescription]
+	return ok
 }
 
-// RemoveApplicationDependencyIDs removes the "application_dependencies" edge to the ApplicationDependency entity by IDs.
-func (m *DeploymentPackageMutation) RemoveApplicationDependencyIDs(ids ...uint64) {
-	if m.removedapplication_dependencies == nil {
-		m.removedapplication_dependencies = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.application_dependencies, ids[i])
-		m.removedapplication_dependencies[ids[i]] = struct{}{}
-	}
+// ResetDescription resets all changes to the "description" field.
+func (m *DeploymentPackageMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, deploymentpackage.FieldDescription)
 }
 
-// RemovedApplicationDependencies returns the removed IDs of the "application_dependencies" edge to the ApplicationDependency entity.
-func (m *DeploymentPackageMutation) RemovedApplicationDependenciesIDs() (ids []uint64) {
-	for id := range m.removedapplication_dependencies {
-		ids = append(ids, id)
-	}
-	return
+// SetCreateTime sets the "create_time" field.
+func (m *DeploymentPackageMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// ApplicationDependenciesIDs returns the "application_dependencies" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) ApplicationDependenciesIDs() (ids []uint64) {
-	for id := range m.application_dependencies {
-		ids = append(ids, id)
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeploymentPackageMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetApplicationDependencies resets all changes to the "application_dependencies" edge.
-func (m *DeploymentPackageMutation) ResetApplicationDependencies() {
-	m.application_dependencies = nil
-	m.clearedapplication_dependencies = false
-	m.removedapplication_dependencies = nil
+	return *v, true
 }
 
-// AddApplicationNamespaceIDs adds the "application_namespaces" edge to the ApplicationNamespace entity by ids.
-func (m *DeploymentPackageMutation) AddApplicationNamespaceIDs(ids ...uint64) {
-	if m.application_namespaces == nil {
-		m.application_namespaces = make(map[uint64]struct{})
+// OldCreateTime returns the old "create_time" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.application_namespaces[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
+	return oldValue.CreateTime, nil
 }
 
-// ClearApplicationNamespaces clears the "application_namespaces" edge to the ApplicationNamespace entity.
-func (m *DeploymentPackageMutation) ClearApplicationNamespaces() {
-	m.clearedapplication_namespaces = true
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeploymentPackageMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// ApplicationNamespacesCleared reports if the "application_namespaces" edge to the ApplicationNamespace entity was cleared.
-func (m *DeploymentPackageMutation) ApplicationNamespacesCleared() bool {
-	return m.clearedapplication_namespaces
+// SetUpdateTime sets the "update_time" field.
+func (m *DeploymentPackageMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// RemoveApplicationNamespaceIDs removes the "application_namespaces" edge to the ApplicationNamespace entity by IDs.
-func (m *DeploymentPackageMutation) RemoveApplicationNamespaceIDs(ids ...uint64) {
-	if m.removedapplication_namespaces == nil {
-		m.removedapplication_namespaces = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.application_namespaces, ids[i])
-		m.removedapplication_namespaces[ids[i]] = struct{}{}
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeploymentPackageMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedApplicationNamespaces returns the removed IDs of the "application_namespaces" edge to the ApplicationNamespace entity.
-func (m *DeploymentPackageMutation) RemovedApplicationNamespacesIDs() (ids []uint64) {
-	for id := range m.removedapplication_namespaces {
-		ids = append(ids, id)
+// OldUpdateTime returns the old "update_time" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ApplicationNamespacesIDs returns the "application_namespaces" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) ApplicationNamespacesIDs() (ids []uint64) {
-	for id := range m.application_namespaces {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetApplicationNamespaces resets all changes to the "application_namespaces" edge.
-func (m *DeploymentPackageMutation) ResetApplicationNamespaces() {
-	m.application_namespaces = nil
-	m.clearedapplication_namespaces = false
-	m.removedapplication_namespaces = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// AddNamespaceIDs adds the "namespaces" edge to the Namespace entity by ids.
-func (m *DeploymentPackageMutation) AddNamespaceIDs(ids ...uint64) {
-	if m.namespaces == nil {
-		m.namespaces = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.namespaces[ids[i]] = struct{}{}
-	}
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeploymentPackageMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// ClearNamespaces clears the "namespaces" edge to the Namespace entity.
-func (m *DeploymentPackageMutation) ClearNamespaces() {
-	m.clearednamespaces = true
+// SetEtag sets the "etag" field.
+func (m *DeploymentPackageMutation) SetEtag(i int64) {
+	m.etag = &i
+	m.addetag = nil
 }
 
-// NamespacesCleared reports if the "namespaces" edge to the Namespace entity was cleared.
-func (m *DeploymentPackageMutation) NamespacesCleared() bool {
-	return m.clearednamespaces
+// Etag returns the value of the "etag" field in the mutation.
+func (m *DeploymentPackageMutation) Etag() (r int64, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveNamespaceIDs removes the "namespaces" edge to the Namespace entity by IDs.
-func (m *DeploymentPackageMutation) RemoveNamespaceIDs(ids ...uint64) {
-	if m.removednamespaces == nil {
-		m.removednamespaces = make(map[uint64]struct{})
+// OldEtag returns the old "etag" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldEtag(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.namespaces, ids[i])
-		m.removednamespaces[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
 	}
+	return oldValue.Etag, nil
 }
 
-// RemovedNamespaces returns the removed IDs of the "namespaces" edge to the Namespace entity.
-func (m *DeploymentPackageMutation) RemovedNamespacesIDs() (ids []uint64) {
-	for id := range m.removednamespaces {
-		ids = append(ids, id)
+// AddEtag adds i to the "etag" field.
+func (m *DeploymentPackageMutation) AddEtag(i int64) {
+	if m.addetag != nil {
+		*m.addetag += i
+	} else {
+		m.addetag = &i
 	}
-	return
 }
 
-// NamespacesIDs returns the "namespaces" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) NamespacesIDs() (ids []uint64) {
-	for id := range m.namespaces {
-		ids = append(ids, id)
+// AddedEtag returns the value that was added to the "etag" field in this mutation.
+func (m *DeploymentPackageMutation) AddedEtag() (r int64, exists bool) {
+	v := m.addetag
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetNamespaces resets all changes to the "namespaces" edge.
-func (m *DeploymentPackageMutation) ResetNamespaces() {
-	m.namespaces = nil
-	m.clearednamespaces = false
-	m.removednamespaces = nil
+// ClearEtag clears the value of the "etag" field.
+func (m *DeploymentPackageMutation) ClearEtag() {
+	m.etag = nil
+	m.addetag = nil
+	m.clearedFields[deploymentpackage.FieldEtag] = struct{}{}
 }
 
-// AddExtensionIDs adds the "extensions" edge to the Extension entity by ids.
-func (m *DeploymentPackageMutation) AddExtensionIDs(ids ...uint64) {
-	if m.extensions == nil {
-		m.extensions = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.extensions[ids[i]] = struct{}{}
-	}
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldEtag]
+	return ok
 }
 
-// ClearExtensions clears the "extensions" edge to the Extension entity.
-func (m *DeploymentPackageMutation) ClearExtensions() {
-	m.clearedextensions = true
+// ResetEtag resets all changes to the "etag" field.
+func (m *DeploymentPackageMutation) ResetEtag() {
+	m.etag = nil
+	m.addetag = nil
+	delete(m.clearedFields, deploymentpackage.FieldEtag)
 }
 
-// ExtensionsCleared reports if the "extensions" edge to the Extension entity was cleared.
-func (m *DeploymentPackageMutation) ExtensionsCleared() bool {
-	return m.clearedextensions
+// SetProjectUUID sets the "project_uuid" field.
+func (m *DeploymentPackageMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
 }
 
-// RemoveExtensionIDs removes the "extensions" edge to the Extension entity by IDs.
-func (m *DeploymentPackageMutation) RemoveExtensionIDs(ids ...uint64) {
-	if m.removedextensions == nil {
-		m.removedextensions = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.extensions, ids[i])
-		m.removedextensions[ids[i]] = struct{}{}
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *DeploymentPackageMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedExtensions returns the removed IDs of the "extensions" edge to the Extension entity.
-func (m *DeploymentPackageMutation) RemovedExtensionsIDs() (ids []uint64) {
-	for id := range m.removedextensions {
-		ids = append(ids, id)
+// OldProjectUUID returns the old "project_uuid" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
+	}
+	return oldValue.ProjectUUID, nil
 }
 
-// ExtensionsIDs returns the "extensions" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) ExtensionsIDs() (ids []uint64) {
-	for id := range m.extensions {
-		ids = append(ids, id)
-	}
-	return
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *DeploymentPackageMutation) ResetProjectUUID() {
+	m.project_uuid = nil
 }
 
-// ResetExtensions resets all changes to the "extensions" edge.
-func (m *DeploymentPackageMutation) ResetExtensions() {
-	m.extensions = nil
-	m.clearedextensions = false
-	m.removedextensions = nil
+// SetVersion sets the "version" field.
+func (m *DeploymentPackageMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// AddArtifactIDs adds the "artifacts" edge to the ArtifactReference entity by ids.
-func (m *DeploymentPackageMutation) AddArtifactIDs(ids ...uint64) {
-	if m.artifacts == nil {
-		m.artifacts = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.artifacts[ids[i]] = struct{}{}
+// Version returns the value of the "version" field in the mutation.
+func (m *DeploymentPackageMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearArtifacts clears the "artifacts" edge to the ArtifactReference entity.
-func (m *DeploymentPackageMutation) ClearArtifacts() {
-	m.clearedartifacts = true
+// OldVersion returns the old "version" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// ArtifactsCleared reports if the "artifacts" edge to the ArtifactReference entity was cleared.
-func (m *DeploymentPackageMutation) ArtifactsCleared() bool {
-	return m.clearedartifacts
+// ResetVersion resets all changes to the "version" field.
+func (m *DeploymentPackageMutation) ResetVersion() {
+	m.version = nil
 }
 
-// RemoveArtifactIDs removes the "artifacts" edge to the ArtifactReference entity by IDs.
-func (m *DeploymentPackageMutation) RemoveArtifactIDs(ids ...uint64) {
-	if m.removedartifacts == nil {
-		m.removedartifacts = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.artifacts, ids[i])
-		m.removedartifacts[ids[i]] = struct{}{}
-	}
+// SetVersionKey sets the "version_key" field.
+func (m *DeploymentPackageMutation) SetVersionKey(s string) {
+	m.version_key = &s
 }
 
-// RemovedArtifacts returns the removed IDs of the "artifacts" edge to the ArtifactReference entity.
-func (m *DeploymentPackageMutation) RemovedArtifactsIDs() (ids []uint64) {
-	for id := range m.removedartifacts {
-		ids = append(ids, id)
+// VersionKey returns the value of the "version_key" field in the mutation.
+func (m *DeploymentPackageMutation) VersionKey() (r string, exists bool) {
+	v := m.version_key
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ArtifactsIDs returns the "artifacts" edge IDs in the mutation.
-func (m *DeploymentPackageMutation) ArtifactsIDs() (ids []uint64) {
-	for id := range m.artifacts {
-		ids = append(ids, id)
+// OldVersionKey returns the old "version_key" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldVersionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionKey is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetArtifacts resets all changes to the "artifacts" edge.
-func (m *DeploymentPackageMutation) ResetArtifacts() {
-	m.artifacts = nil
-	m.clearedartifacts = false
-	m.removedartifacts = nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionKey: %w", err)
+	}
+	return oldValue.VersionKey, nil
 }
 
-// Where appends a list predicates to the DeploymentPackageMutation builder.
-func (m *DeploymentPackageMutation) Where(ps ...predicate.DeploymentPackage) {
-	m.predicates = append(m.predicates, ps...)
+// ClearVersionKey clears the value of the "version_key" field.
+func (m *DeploymentPackageMutation) ClearVersionKey() {
+	m.version_key = nil
+	m.clearedFields[deploymentpackage.FieldVersionKey] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the DeploymentPackageMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentPackageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentPackage, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// VersionKeyCleared returns if the "version_key" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) VersionKeyCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldVersionKey]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *DeploymentPackageMutation) Op() Op {
-	return m.op
+// ResetVersionKey resets all changes to the "version_key" field.
+func (m *DeploymentPackageMutation) ResetVersionKey() {
+	m.version_key = nil
+	delete(m.clearedFields, deploymentpackage.FieldVersionKey)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DeploymentPackageMutation) SetOp(op Op) {
-	m.op = op
+// SetIsDeployed sets the "is_deployed" field.
+func (m *DeploymentPackageMutation) SetIsDeployed(b bool) {
+	m.is_deployed = &b
 }
 
-// Type returns the node type of this mutation (DeploymentPackage).
-func (m *DeploymentPackageMutation) Type() string {
-	return m.typ
+// IsDeployed returns the value of the "is_deployed" field in the mutation.
+func (m *DeploymentPackageMutation) IsDeployed() (r bool, exists bool) {
+	v := m.is_deployed
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
+// OldIsDeployed returns the old "is_deployed" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldIsDeployed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDeployed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDeployed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDeployed: %w", err)
+	}
+	return oldValue.IsDeployed, nil
+}
+
+// ClearIsDeployed clears the value of the "is_deployed" field.
+func (m *DeploymentPackageMutation) ClearIsDeployed() {
+	m.is_deployed = nil
+	m.clearedFields[deploymentpackage.FieldIsDeployed] = struct{}{}
+}
+
+// IsDeployedCleared returns if the "is_deployed" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) IsDeployedCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldIsDeployed]
+	return ok
+}
+
+// ResetIsDeployed resets all changes to the "is_deployed" field.
+func (m *DeploymentPackageMutation) ResetIsDeployed() {
+	m.is_deployed = nil
+	delete(m.clearedFields, deploymentpackage.FieldIsDeployed)
+}
+
+// SetIsVisible sets the "is_visible" field.
+func (m *DeploymentPackageMutation) SetIsVisible(b bool) {
+	m.is_visible = &b
+}
+
+// IsVisible returns the value of the "is_visible" field in the mutation.
+func (m *DeploymentPackageMutation) IsVisible() (r bool, exists bool) {
+	v := m.is_visible
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisible returns the old "is_visible" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldIsVisible(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisible is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisible requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisible: %w", err)
+	}
+	return oldValue.IsVisible, nil
+}
+
+// ClearIsVisible clears the value of the "is_visible" field.
+func (m *DeploymentPackageMutation) ClearIsVisible() {
+	m.is_visible = nil
+	m.clearedFields[deploymentpackage.FieldIsVisible] = struct{}{}
+}
+
+// IsVisibleCleared returns if the "is_visible" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) IsVisibleCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldIsVisible]
+	return ok
+}
+
+// ResetIsVisible resets all changes to the "is_visible" field.
+func (m *DeploymentPackageMutation) ResetIsVisible() {
+	m.is_visible = nil
+	delete(m.clearedFields, deploymentpackage.FieldIsVisible)
+}
+
+// SetAllowsMultipleDeployments sets the "allows_multiple_deployments" field.
+func (m *DeploymentPackageMutation) SetAllowsMultipleDeployments(b bool) {
+	m.allows_multiple_deployments = &b
+}
+
+// AllowsMultipleDeployments returns the value of the "allows_multiple_deployments" field in the mutation.
+func (m *DeploymentPackageMutation) AllowsMultipleDeployments() (r bool, exists bool) {
+	v := m.allows_multiple_deployments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowsMultipleDeployments returns the old "allows_multiple_deployments" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldAllowsMultipleDeployments(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowsMultipleDeployments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowsMultipleDeployments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowsMultipleDeployments: %w", err)
+	}
+	return oldValue.AllowsMultipleDeployments, nil
+}
+
+// ClearAllowsMultipleDeployments clears the value of the "allows_multiple_deployments" field.
+func (m *DeploymentPackageMutation) ClearAllowsMultipleDeployments() {
+	m.allows_multiple_deployments = nil
+	m.clearedFields[deploymentpackage.FieldAllowsMultipleDeployments] = struct{}{}
+}
+
+// AllowsMultipleDeploymentsCleared returns if the "allows_multiple_deployments" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) AllowsMultipleDeploymentsCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldAllowsMultipleDeployments]
+	return ok
+}
+
+// ResetAllowsMultipleDeployments resets all changes to the "allows_multiple_deployments" field.
+func (m *DeploymentPackageMutation) ResetAllowsMultipleDeployments() {
+	m.allows_multiple_deployments = nil
+	delete(m.clearedFields, deploymentpackage.FieldAllowsMultipleDeployments)
+}
+
+// SetKind sets the "kind" field.
+func (m *DeploymentPackageMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *DeploymentPackageMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ClearKind clears the value of the "kind" field.
+func (m *DeploymentPackageMutation) ClearKind() {
+	m.kind = nil
+	m.clearedFields[deploymentpackage.FieldKind] = struct{}{}
+}
+
+// KindCleared returns if the "kind" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) KindCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldKind]
+	return ok
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *DeploymentPackageMutation) ResetKind() {
+	m.kind = nil
+	delete(m.clearedFields, deploymentpackage.FieldKind)
+}
+
+// SetApplicationConstraints sets the "application_constraints" field.
+func (m *DeploymentPackageMutation) SetApplicationConstraints(value map[string]string) {
+	m.application_constraints = &value
+}
+
+// ApplicationConstraints returns the value of the "application_constraints" field in the mutation.
+func (m *DeploymentPackageMutation) ApplicationConstraints() (r map[string]string, exists bool) {
+	v := m.application_constraints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApplicationConstraints returns the old "application_constraints" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldApplicationConstraints(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApplicationConstraints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApplicationConstraints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationConstraints: %w", err)
+	}
+	return oldValue.ApplicationConstraints, nil
+}
+
+// ClearApplicationConstraints clears the value of the "application_constraints" field.
+func (m *DeploymentPackageMutation) ClearApplicationConstraints() {
+	m.application_constraints = nil
+	m.clearedFields[deploymentpackage.FieldApplicationConstraints] = struct{}{}
+}
+
+// ApplicationConstraintsCleared returns if the "application_constraints" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) ApplicationConstraintsCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldApplicationConstraints]
+	return ok
+}
+
+// ResetApplicationConstraints resets all changes to the "application_constraints" field.
+func (m *DeploymentPackageMutation) ResetApplicationConstraints() {
+	m.application_constraints = nil
+	delete(m.clearedFields, deploymentpackage.FieldApplicationConstraints)
+}
+
+// SetSearchText sets the "search_text" field.
+func (m *DeploymentPackageMutation) SetSearchText(s string) {
+	m.search_text = &s
+}
+
+// SearchText returns the value of the "search_text" field in the mutation.
+func (m *DeploymentPackageMutation) SearchText() (r string, exists bool) {
+	v := m.search_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchText returns the old "search_text" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldSearchText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchText: %w", err)
+	}
+	return oldValue.SearchText, nil
+}
+
+// ClearSearchText clears the value of the "search_text" field.
+func (m *DeploymentPackageMutation) ClearSearchText() {
+	m.search_text = nil
+	m.clearedFields[deploymentpackage.FieldSearchText] = struct{}{}
+}
+
+// SearchTextCleared returns if the "search_text" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) SearchTextCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldSearchText]
+	return ok
+}
+
+// ResetSearchText resets all changes to the "search_text" field.
+func (m *DeploymentPackageMutation) ResetSearchText() {
+	m.search_text = nil
+	delete(m.clearedFields, deploymentpackage.FieldSearchText)
+}
+
+// SetPinnedResolution sets the "pinned_resolution" field.
+func (m *DeploymentPackageMutation) SetPinnedResolution(s string) {
+	m.pinned_resolution = &s
+}
+
+// PinnedResolution returns the value of the "pinned_resolution" field in the mutation.
+func (m *DeploymentPackageMutation) PinnedResolution() (r string, exists bool) {
+	v := m.pinned_resolution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedResolution returns the old "pinned_resolution" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldPinnedResolution(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedResolution is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedResolution requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedResolution: %w", err)
+	}
+	return oldValue.PinnedResolution, nil
+}
+
+// ClearPinnedResolution clears the value of the "pinned_resolution" field.
+func (m *DeploymentPackageMutation) ClearPinnedResolution() {
+	m.pinned_resolution = nil
+	m.clearedFields[deploymentpackage.FieldPinnedResolution] = struct{}{}
+}
+
+// PinnedResolutionCleared returns if the "pinned_resolution" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) PinnedResolutionCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldPinnedResolution]
+	return ok
+}
+
+// ResetPinnedResolution resets all changes to the "pinned_resolution" field.
+func (m *DeploymentPackageMutation) ResetPinnedResolution() {
+	m.pinned_resolution = nil
+	delete(m.clearedFields, deploymentpackage.FieldPinnedResolution)
+}
+
+// SetLabels sets the "labels" field.
+func (m *DeploymentPackageMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *DeploymentPackageMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *DeploymentPackageMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[deploymentpackage.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *DeploymentPackageMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, deploymentpackage.FieldLabels)
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (m *DeploymentPackageMutation) SetDeprecated(b bool) {
+	m.deprecated = &b
+}
+
+// Deprecated returns the value of the "deprecated" field in the mutation.
+func (m *DeploymentPackageMutation) Deprecated() (r bool, exists bool) {
+	v := m.deprecated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecated returns the old "deprecated" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldDeprecated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecated: %w", err)
+	}
+	return oldValue.Deprecated, nil
+}
+
+// ResetDeprecated resets all changes to the "deprecated" field.
+func (m *DeploymentPackageMutation) ResetDeprecated() {
+	m.deprecated = nil
+}
+
+// SetDeprecationMessage sets the "deprecation_message" field.
+func (m *DeploymentPackageMutation) SetDeprecationMessage(s string) {
+	m.deprecation_message = &s
+}
+
+// DeprecationMessage returns the value of the "deprecation_message" field in the mutation.
+func (m *DeploymentPackageMutation) DeprecationMessage() (r string, exists bool) {
+	v := m.deprecation_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecationMessage returns the old "deprecation_message" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldDeprecationMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecationMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecationMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecationMessage: %w", err)
+	}
+	return oldValue.DeprecationMessage, nil
+}
+
+// ClearDeprecationMessage clears the value of the "deprecation_message" field.
+func (m *DeploymentPackageMutation) ClearDeprecationMessage() {
+	m.deprecation_message = nil
+	m.clearedFields[deploymentpackage.FieldDeprecationMessage] = struct{}{}
+}
+
+// DeprecationMessageCleared returns if the "deprecation_message" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) DeprecationMessageCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldDeprecationMessage]
+	return ok
+}
+
+// ResetDeprecationMessage resets all changes to the "deprecation_message" field.
+func (m *DeploymentPackageMutation) ResetDeprecationMessage() {
+	m.deprecation_message = nil
+	delete(m.clearedFields, deploymentpackage.FieldDeprecationMessage)
+}
+
+// SetReplacementVersion sets the "replacement_version" field.
+func (m *DeploymentPackageMutation) SetReplacementVersion(s string) {
+	m.replacement_version = &s
+}
+
+// ReplacementVersion returns the value of the "replacement_version" field in the mutation.
+func (m *DeploymentPackageMutation) ReplacementVersion() (r string, exists bool) {
+	v := m.replacement_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacementVersion returns the old "replacement_version" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldReplacementVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacementVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacementVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacementVersion: %w", err)
+	}
+	return oldValue.ReplacementVersion, nil
+}
+
+// ClearReplacementVersion clears the value of the "replacement_version" field.
+func (m *DeploymentPackageMutation) ClearReplacementVersion() {
+	m.replacement_version = nil
+	m.clearedFields[deploymentpackage.FieldReplacementVersion] = struct{}{}
+}
+
+// ReplacementVersionCleared returns if the "replacement_version" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) ReplacementVersionCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldReplacementVersion]
+	return ok
+}
+
+// ResetReplacementVersion resets all changes to the "replacement_version" field.
+func (m *DeploymentPackageMutation) ResetReplacementVersion() {
+	m.replacement_version = nil
+	delete(m.clearedFields, deploymentpackage.FieldReplacementVersion)
+}
+
+// SetDeprecateTime sets the "deprecate_time" field.
+func (m *DeploymentPackageMutation) SetDeprecateTime(t time.Time) {
+	m.deprecate_time = &t
+}
+
+// DeprecateTime returns the value of the "deprecate_time" field in the mutation.
+func (m *DeploymentPackageMutation) DeprecateTime() (r time.Time, exists bool) {
+	v := m.deprecate_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecateTime returns the old "deprecate_time" field's value of the DeploymentPackage entity.
+// If the DeploymentPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentPackageMutation) OldDeprecateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecateTime: %w", err)
+	}
+	return oldValue.DeprecateTime, nil
+}
+
+// ClearDeprecateTime clears the value of the "deprecate_time" field.
+func (m *DeploymentPackageMutation) ClearDeprecateTime() {
+	m.deprecate_time = nil
+	m.clearedFields[deploymentpackage.FieldDeprecateTime] = struct{}{}
+}
+
+// DeprecateTimeCleared returns if the "deprecate_time" field was cleared in this mutation.
+func (m *DeploymentPackageMutation) DeprecateTimeCleared() bool {
+	_, ok := m.clearedFields[deploymentpackage.FieldDeprecateTime]
+	return ok
+}
+
+// ResetDeprecateTime resets all changes to the "deprecate_time" field.
+func (m *DeploymentPackageMutation) ResetDeprecateTime() {
+	m.deprecate_time = nil
+	delete(m.clearedFields, deploymentpackage.FieldDeprecateTime)
+}
+
+// AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by ids.
+func (m *DeploymentPackageMutation) AddDeploymentProfileIDs(ids ...uint64) {
+	if m.deployment_profiles == nil {
+		m.deployment_profiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.deployment_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeploymentProfiles clears the "deployment_profiles" edge to the DeploymentProfile entity.
+func (m *DeploymentPackageMutation) ClearDeploymentProfiles() {
+	m.cleareddeployment_profiles = true
+}
+
+// DeploymentProfilesCleared reports if the "deployment_profiles" edge to the DeploymentProfile entity was cleared.
+func (m *DeploymentPackageMutation) DeploymentProfilesCleared() bool {
+	return m.cleareddeployment_profiles
+}
+
+// RemoveDeploymentProfileIDs removes the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
+func (m *DeploymentPackageMutation) RemoveDeploymentProfileIDs(ids ...uint64) {
+	if m.removeddeployment_profiles == nil {
+		m.removeddeployment_profiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.deployment_profiles, ids[i])
+		m.removeddeployment_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeploymentProfiles returns the removed IDs of the "deployment_profiles" edge to the DeploymentProfile entity.
+func (m *DeploymentPackageMutation) RemovedDeploymentProfilesIDs() (ids []uint64) {
+	for id := range m.removeddeployment_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeploymentProfilesIDs returns the "deployment_profiles" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) DeploymentProfilesIDs() (ids []uint64) {
+	for id := range m.deployment_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeploymentProfiles resets all changes to the "deployment_profiles" edge.
+func (m *DeploymentPackageMutation) ResetDeploymentProfiles() {
+	m.deployment_profiles = nil
+	m.cleareddeployment_profiles = false
+	m.removeddeployment_profiles = nil
+}
+
+// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
+func (m *DeploymentPackageMutation) AddApplicationIDs(ids ...uint64) {
+	if m.applications == nil {
+		m.applications = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.applications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplications clears the "applications" edge to the Application entity.
+func (m *DeploymentPackageMutation) ClearApplications() {
+	m.clearedapplications = true
+}
+
+// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
+func (m *DeploymentPackageMutation) ApplicationsCleared() bool {
+	return m.clearedapplications
+}
+
+// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
+func (m *DeploymentPackageMutation) RemoveApplicationIDs(ids ...uint64) {
+	if m.removedapplications == nil {
+		m.removedapplications = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.applications, ids[i])
+		m.removedapplications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
+func (m *DeploymentPackageMutation) RemovedApplicationsIDs() (ids []uint64) {
+	for id := range m.removedapplications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplicationsIDs returns the "applications" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) ApplicationsIDs() (ids []uint64) {
+	for id := range m.applications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplications resets all changes to the "applications" edge.
+func (m *DeploymentPackageMutation) ResetApplications() {
+	m.applications = nil
+	m.clearedapplications = false
+	m.removedapplications = nil
+}
+
+// AddIconIDs adds the "icon" edge to the Artifact entity by ids.
+func (m *DeploymentPackageMutation) AddIconIDs(ids ...uint64) {
+	if m.icon == nil {
+		m.icon = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.icon[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIcon clears the "icon" edge to the Artifact entity.
+func (m *DeploymentPackageMutation) ClearIcon() {
+	m.clearedicon = true
+}
+
+// IconCleared reports if the "icon" edge to the Artifact entity was cleared.
+func (m *DeploymentPackageMutation) IconCleared() bool {
+	return m.clearedicon
+}
+
+// RemoveIconIDs removes the "icon" edge to the Artifact entity by IDs.
+func (m *DeploymentPackageMutation) RemoveIconIDs(ids ...uint64) {
+	if m.removedicon == nil {
+		m.removedicon = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.icon, ids[i])
+		m.removedicon[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIcon returns the removed IDs of the "icon" edge to the Artifact entity.
+func (m *DeploymentPackageMutation) RemovedIconIDs() (ids []uint64) {
+	for id := range m.removedicon {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IconIDs returns the "icon" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) IconIDs() (ids []uint64) {
+	for id := range m.icon {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIcon resets all changes to the "icon" edge.
+func (m *DeploymentPackageMutation) ResetIcon() {
+	m.icon = nil
+	m.clearedicon = false
+	m.removedicon = nil
+}
+
+// AddThumbnailIDs adds the "thumbnail" edge to the Artifact entity by ids.
+func (m *DeploymentPackageMutation) AddThumbnailIDs(ids ...uint64) {
+	if m.thumbnail == nil {
+		m.thumbnail = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.thumbnail[ids[i]] = struct{}{}
+	}
+}
+
+// ClearThumbnail clears the "thumbnail" edge to the Artifact entity.
+func (m *DeploymentPackageMutation) ClearThumbnail() {
+	m.clearedthumbnail = true
+}
+
+// ThumbnailCleared reports if the "thumbnail" edge to the Artifact entity was cleared.
+func (m *DeploymentPackageMutation) ThumbnailCleared() bool {
+	return m.clearedthumbnail
+}
+
+// RemoveThumbnailIDs removes the "thumbnail" edge to the Artifact entity by IDs.
+func (m *DeploymentPackageMutation) RemoveThumbnailIDs(ids ...uint64) {
+	if m.removedthumbnail == nil {
+		m.removedthumbnail = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.thumbnail, ids[i])
+		m.removedthumbnail[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedThumbnail returns the removed IDs of the "thumbnail" edge to the Artifact entity.
+func (m *DeploymentPackageMutation) RemovedThumbnailIDs() (ids []uint64) {
+	for id := range m.removedthumbnail {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ThumbnailIDs returns the "thumbnail" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) ThumbnailIDs() (ids []uint64) {
+	for id := range m.thumbnail {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetThumbnail resets all changes to the "thumbnail" edge.
+func (m *DeploymentPackageMutation) ResetThumbnail() {
+	m.thumbnail = nil
+	m.clearedthumbnail = false
+	m.removedthumbnail = nil
+}
+
+// SetDefaultProfileID sets the "default_profile" edge to the DeploymentProfile entity by id.
+func (m *DeploymentPackageMutation) SetDefaultProfileID(id uint64) {
+	m.default_profile = &id
+}
+
+// ClearDefaultProfile clears the "default_profile" edge to the DeploymentProfile entity.
+func (m *DeploymentPackageMutation) ClearDefaultProfile() {
+	m.cleareddefault_profile = true
+}
+
+// DefaultProfileCleared reports if the "default_profile" edge to the DeploymentProfile entity was cleared.
+func (m *DeploymentPackageMutation) DefaultProfileCleared() bool {
+	return m.cleareddefault_profile
+}
+
+// DefaultProfileID returns the "default_profile" edge ID in the mutation.
+func (m *DeploymentPackageMutation) DefaultProfileID() (id uint64, exists bool) {
+	if m.default_profile != nil {
+		return *m.default_profile, true
+	}
+	return
+}
+
+// DefaultProfileIDs returns the "default_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefaultProfileID instead. It exists only for internal usage by the builders.
+func (m *DeploymentPackageMutation) DefaultProfileIDs() (ids []uint64) {
+	if id := m.default_profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefaultProfile resets all changes to the "default_profile" edge.
+func (m *DeploymentPackageMutation) ResetDefaultProfile() {
+	m.default_profile = nil
+	m.cleareddefault_profile = false
+}
+
+// AddApplicationDependencyIDs adds the "application_dependencies" edge to the ApplicationDependency entity by ids.
+func (m *DeploymentPackageMutation) AddApplicationDependencyIDs(ids ...uint64) {
+	if m.application_dependencies == nil {
+		m.application_dependencies = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.application_dependencies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplicationDependencies clears the "application_dependencies" edge to the ApplicationDependency entity.
+func (m *DeploymentPackageMutation) ClearApplicationDependencies() {
+	m.clearedapplication_dependencies = true
+}
+
+// ApplicationDependenciesCleared reports if the "application_dependencies" edge to the ApplicationDependency entity was cleared.
+func (m *DeploymentPackageMutation) ApplicationDependenciesCleared() bool {
+	return m.clearedapplication_dependencies
+}
+
+// RemoveApplicationDependencyIDs removes the "application_dependencies" edge to the ApplicationDependency entity by IDs.
+func (m *DeploymentPackageMutation) RemoveApplicationDependencyIDs(ids ...uint64) {
+	if m.removedapplication_dependencies == nil {
+		m.removedapplication_dependencies = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.application_dependencies, ids[i])
+		m.removedapplication_dependencies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplicationDependencies returns the removed IDs of the "application_dependencies" edge to the ApplicationDependency entity.
+func (m *DeploymentPackageMutation) RemovedApplicationDependenciesIDs() (ids []uint64) {
+	for id := range m.removedapplication_dependencies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplicationDependenciesIDs returns the "application_dependencies" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) ApplicationDependenciesIDs() (ids []uint64) {
+	for id := range m.application_dependencies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplicationDependencies resets all changes to the "application_dependencies" edge.
+func (m *DeploymentPackageMutation) ResetApplicationDependencies() {
+	m.application_dependencies = nil
+	m.clearedapplication_dependencies = false
+	m.removedapplication_dependencies = nil
+}
+
+// AddApplicationNamespaceIDs adds the "application_namespaces" edge to the ApplicationNamespace entity by ids.
+func (m *DeploymentPackageMutation) AddApplicationNamespaceIDs(ids ...uint64) {
+	if m.application_namespaces == nil {
+		m.application_namespaces = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.application_namespaces[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplicationNamespaces clears the "application_namespaces" edge to the ApplicationNamespace entity.
+func (m *DeploymentPackageMutation) ClearApplicationNamespaces() {
+	m.clearedapplication_namespaces = true
+}
+
+// ApplicationNamespacesCleared reports if the "application_namespaces" edge to the ApplicationNamespace entity was cleared.
+func (m *DeploymentPackageMutation) ApplicationNamespacesCleared() bool {
+	return m.clearedapplication_namespaces
+}
+
+// RemoveApplicationNamespaceIDs removes the "application_namespaces" edge to the ApplicationNamespace entity by IDs.
+func (m *DeploymentPackageMutation) RemoveApplicationNamespaceIDs(ids ...uint64) {
+	if m.removedapplication_namespaces == nil {
+		m.removedapplication_namespaces = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.application_namespaces, ids[i])
+		m.removedapplication_namespaces[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplicationNamespaces returns the removed IDs of the "application_namespaces" edge to the ApplicationNamespace entity.
+func (m *DeploymentPackageMutation) RemovedApplicationNamespacesIDs() (ids []uint64) {
+	for id := range m.removedapplication_namespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplicationNamespacesIDs returns the "application_namespaces" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) ApplicationNamespacesIDs() (ids []uint64) {
+	for id := range m.application_namespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplicationNamespaces resets all changes to the "application_namespaces" edge.
+func (m *DeploymentPackageMutation) ResetApplicationNamespaces() {
+	m.application_namespaces = nil
+	m.clearedapplication_namespaces = false
+	m.removedapplication_namespaces = nil
+}
+
+// AddNamespaceIDs adds the "namespaces" edge to the Namespace entity by ids.
+func (m *DeploymentPackageMutation) AddNamespaceIDs(ids ...uint64) {
+	if m.namespaces == nil {
+		m.namespaces = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.namespaces[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNamespaces clears the "namespaces" edge to the Namespace entity.
+func (m *DeploymentPackageMutation) ClearNamespaces() {
+	m.clearednamespaces = true
+}
+
+// NamespacesCleared reports if the "namespaces" edge to the Namespace entity was cleared.
+func (m *DeploymentPackageMutation) NamespacesCleared() bool {
+	return m.clearednamespaces
+}
+
+// RemoveNamespaceIDs removes the "namespaces" edge to the Namespace entity by IDs.
+func (m *DeploymentPackageMutation) RemoveNamespaceIDs(ids ...uint64) {
+	if m.removednamespaces == nil {
+		m.removednamespaces = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.namespaces, ids[i])
+		m.removednamespaces[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNamespaces returns the removed IDs of the "namespaces" edge to the Namespace entity.
+func (m *DeploymentPackageMutation) RemovedNamespacesIDs() (ids []uint64) {
+	for id := range m.removednamespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NamespacesIDs returns the "namespaces" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) NamespacesIDs() (ids []uint64) {
+	for id := range m.namespaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNamespaces resets all changes to the "namespaces" edge.
+func (m *DeploymentPackageMutation) ResetNamespaces() {
+	m.namespaces = nil
+	m.clearednamespaces = false
+	m.removednamespaces = nil
+}
+
+// AddExtensionIDs adds the "extensions" edge to the Extension entity by ids.
+func (m *DeploymentPackageMutation) AddExtensionIDs(ids ...uint64) {
+	if m.extensions == nil {
+		m.extensions = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.extensions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExtensions clears the "extensions" edge to the Extension entity.
+func (m *DeploymentPackageMutation) ClearExtensions() {
+	m.clearedextensions = true
+}
+
+// ExtensionsCleared reports if the "extensions" edge to the Extension entity was cleared.
+func (m *DeploymentPackageMutation) ExtensionsCleared() bool {
+	return m.clearedextensions
+}
+
+// RemoveExtensionIDs removes the "extensions" edge to the Extension entity by IDs.
+func (m *DeploymentPackageMutation) RemoveExtensionIDs(ids ...uint64) {
+	if m.removedextensions == nil {
+		m.removedextensions = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.extensions, ids[i])
+		m.removedextensions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExtensions returns the removed IDs of the "extensions" edge to the Extension entity.
+func (m *DeploymentPackageMutation) RemovedExtensionsIDs() (ids []uint64) {
+	for id := range m.removedextensions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExtensionsIDs returns the "extensions" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) ExtensionsIDs() (ids []uint64) {
+	for id := range m.extensions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExtensions resets all changes to the "extensions" edge.
+func (m *DeploymentPackageMutation) ResetExtensions() {
+	m.extensions = nil
+	m.clearedextensions = false
+	m.removedextensions = nil
+}
+
+// AddArtifactIDs adds the "artifacts" edge to the ArtifactReference entity by ids.
+func (m *DeploymentPackageMutation) AddArtifactIDs(ids ...uint64) {
+	if m.artifacts == nil {
+		m.artifacts = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.artifacts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArtifacts clears the "artifacts" edge to the ArtifactReference entity.
+func (m *DeploymentPackageMutation) ClearArtifacts() {
+	m.clearedartifacts = true
+}
+
+// ArtifactsCleared reports if the "artifacts" edge to the ArtifactReference entity was cleared.
+func (m *DeploymentPackageMutation) ArtifactsCleared() bool {
+	return m.clearedartifacts
+}
+
+// RemoveArtifactIDs removes the "artifacts" edge to the ArtifactReference entity by IDs.
+func (m *DeploymentPackageMutation) RemoveArtifactIDs(ids ...uint64) {
+	if m.removedartifacts == nil {
+		m.removedartifacts = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.artifacts, ids[i])
+		m.removedartifacts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArtifacts returns the removed IDs of the "artifacts" edge to the ArtifactReference entity.
+func (m *DeploymentPackageMutation) RemovedArtifactsIDs() (ids []uint64) {
+	for id := range m.removedartifacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArtifactsIDs returns the "artifacts" edge IDs in the mutation.
+func (m *DeploymentPackageMutation) ArtifactsIDs() (ids []uint64) {
+	for id := range m.artifacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArtifacts resets all changes to the "artifacts" edge.
+func (m *DeploymentPackageMutation) ResetArtifacts() {
+	m.artifacts = nil
+	m.clearedartifacts = false
+	m.removedartifacts = nil
+}
+
+// Where appends a list predicates to the DeploymentPackageMutation builder.
+func (m *DeploymentPackageMutation) Where(ps ...predicate.DeploymentPackage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentPackageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentPackage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentPackageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentPackageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentPackage).
+func (m *DeploymentPackageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentPackageMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.name != nil {
+		fields = append(fields, deploymentpackage.FieldName)
+	}
+	if m.display_name != nil {
+		fields = append(fields, deploymentpackage.FieldDisplayName)
+	}
+	if m.display_name_lc != nil {
+		fields = append(fields, deploymentpackage.FieldDisplayNameLc)
+	}
+	if m.description != nil {
+		fields = append(fields, deploymentpackage.FieldDescription)
+	}
+	if m.create_time != nil {
+		fields = append(fields, deploymentpackage.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deploymentpackage.FieldUpdateTime)
+	}
+	if m.etag != nil {
+		fields = append(fields, deploymentpackage.FieldEtag)
+	}
+	if m.project_uuid != nil {
+		fields = append(fields, deploymentpackage.FieldProjectUUID)
+	}
+	if m.version != nil {
+		fields = append(fields, deploymentpackage.FieldVersion)
+	}
+	if m.version_key != nil {
+		fields = append(fields, deploymentpackage.FieldVersionKey)
+	}
+	if m.is_deployed != nil {
+		fields = append(fields, deploymentpackage.FieldIsDeployed)
+	}
+	if m.is_visible != nil {
+		fields = append(fields, deploymentpackage.FieldIsVisible)
+	}
+	if m.allows_multiple_deployments != nil {
+		fields = append(fields, deploymentpackage.FieldAllowsMultipleDeployments)
+	}
+	if m.kind != nil {
+		fields = append(fields, deploymentpackage.FieldKind)
+	}
+	if m.application_constraints != nil {
+		fields = append(fields, deploymentpackage.FieldApplicationConstraints)
+	}
+	if m.search_text != nil {
+		fields = append(fields, deploymentpackage.FieldSearchText)
+	}
+	if m.pinned_resolution != nil {
+		fields = append(fields, deploymentpackage.FieldPinnedResolution)
+	}
+	if m.labels != nil {
+		fields = append(fields, deploymentpackage.FieldLabels)
+	}
+	if m.deprecated != nil {
+		fields = append(fields, deploymentpackage.FieldDeprecated)
+	}
+	if m.deprecation_message != nil {
+		fields = append(fields, deploymentpackage.FieldDeprecationMessage)
+	}
+	if m.replacement_version != nil {
+		fields = append(fields, deploymentpackage.FieldReplacementVersion)
+	}
+	if m.deprecate_time != nil {
+		fields = append(fields, deploymentpackage.FieldDeprecateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentPackageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentpackage.FieldName:
+		return m.Name()
+	case deploymentpackage.FieldDisplayName:
+		return m.DisplayName()
+	case deploymentpackage.FieldDisplayNameLc:
+		return m.DisplayNameLc()
+	case deploymentpackage.FieldDescription:
+		return m.Description()
+	case deploymentpackage.FieldCreateTime:
+		return m.CreateTime()
+	case deploymentpackage.FieldUpdateTime:
+		return m.UpdateTime()
+	case deploymentpackage.FieldEtag:
+		return m.Etag()
+	case deploymentpackage.FieldProjectUUID:
+		return m.ProjectUUID()
+	case deploymentpackage.FieldVersion:
+		return m.Version()
+	case deploymentpackage.FieldVersionKey:
+		return m.VersionKey()
+	case deploymentpackage.FieldIsDeployed:
+		return m.IsDeployed()
+	case deploymentpackage.FieldIsVisible:
+		return m.IsVisible()
+	case deploymentpackage.FieldAllowsMultipleDeployments:
+		return m.AllowsMultipleDeployments()
+	case deploymentpackage.FieldKind:
+		return m.Kind()
+	case deploymentpackage.FieldApplicationConstraints:
+		return m.ApplicationConstraints()
+	case deploymentpackage.FieldSearchText:
+		return m.SearchText()
+	case deploymentpackage.FieldPinnedResolution:
+		return m.PinnedResolution()
+	case deploymentpackage.FieldLabels:
+		return m.Labels()
+	case deploymentpackage.FieldDeprecated:
+		return m.Deprecated()
+	case deploymentpackage.FieldDeprecationMessage:
+		return m.DeprecationMessage()
+	case deploymentpackage.FieldReplacementVersion:
+		return m.ReplacementVersion()
+	case deploymentpackage.FieldDeprecateTime:
+		return m.DeprecateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentpackage.FieldName:
+		return m.OldName(ctx)
+	case deploymentpackage.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case deploymentpackage.FieldDisplayNameLc:
+		return m.OldDisplayNameLc(ctx)
+	case deploymentpackage.FieldDescription:
+		return m.OldDescription(ctx)
+	case deploymentpackage.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deploymentpackage.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case deploymentpackage.FieldEtag:
+		return m.OldEtag(ctx)
+	case deploymentpackage.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case deploymentpackage.FieldVersion:
+		return m.OldVersion(ctx)
+	case deploymentpackage.FieldVersionKey:
+		return m.OldVersionKey(ctx)
+	case deploymentpackage.FieldIsDeployed:
+		return m.OldIsDeployed(ctx)
+	case deploymentpackage.FieldIsVisible:
+		return m.OldIsVisible(ctx)
+	case deploymentpackage.FieldAllowsMultipleDeployments:
+		return m.OldAllowsMultipleDeployments(ctx)
+	case deploymentpackage.FieldKind:
+		return m.OldKind(ctx)
+	case deploymentpackage.FieldApplicationConstraints:
+		return m.OldApplicationConstraints(ctx)
+	case deploymentpackage.FieldSearchText:
+		return m.OldSearchText(ctx)
+	case deploymentpackage.FieldPinnedResolution:
+		return m.OldPinnedResolution(ctx)
+	case deploymentpackage.FieldLabels:
+		return m.OldLabels(ctx)
+	case deploymentpackage.FieldDeprecated:
+		return m.OldDeprecated(ctx)
+	case deploymentpackage.FieldDeprecationMessage:
+		return m.OldDeprecationMessage(ctx)
+	case deploymentpackage.FieldReplacementVersion:
+		return m.OldReplacementVersion(ctx)
+	case deploymentpackage.FieldDeprecateTime:
+		return m.OldDeprecateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentPackage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentPackageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentpackage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case deploymentpackage.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case deploymentpackage.FieldDisplayNameLc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayNameLc(v)
+		return nil
+	case deploymentpackage.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case deploymentpackage.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deploymentpackage.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case deploymentpackage.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case deploymentpackage.FieldProjectUUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectUUID(v)
+		return nil
+	case deploymentpackage.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case deploymentpackage.FieldVersionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionKey(v)
+		return nil
+	case deploymentpackage.FieldIsDeployed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDeployed(v)
+		return nil
+	case deploymentpackage.FieldIsVisible:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisible(v)
+		return nil
+	case deploymentpackage.FieldAllowsMultipleDeployments:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowsMultipleDeployments(v)
+		return nil
+	case deploymentpackage.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case deploymentpackage.FieldApplicationConstraints:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationConstraints(v)
+		return nil
+	case deploymentpackage.FieldSearchText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchText(v)
+		return nil
+	case deploymentpackage.FieldPinnedResolution:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedResolution(v)
+		return nil
+	case deploymentpackage.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case deploymentpackage.FieldDeprecated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecated(v)
+		return nil
+	case deploymentpackage.FieldDeprecationMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecationMessage(v)
+		return nil
+	case deploymentpackage.FieldReplacementVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacementVersion(v)
+		return nil
+	case deploymentpackage.FieldDeprecateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentPackage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentPackageMutation) AddedFields() []string {
+	var fields []string
+	if m.addetag != nil {
+		fields = append(fields, deploymentpackage.FieldEtag)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentPackageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentpackage.FieldEtag:
+		return m.AddedEtag()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentPackageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deploymentpackage.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEtag(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentPackage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentPackageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentpackage.FieldDisplayName) {
+		fields = append(fields, deploymentpackage.FieldDisplayName)
+	}
+	if m.FieldCleared(deploymentpackage.FieldDisplayNameLc) {
+		fields = append(fields, deploymentpackage.FieldDisplayNameLc)
+	}
+	if m.FieldCleared(deploymentpackage.FieldDescription) {
+		fields = append(fields, deploymentpackage.FieldDescription)
+	}
+	if m.FieldCleared(deploymentpackage.FieldEtag) {
+		fields = append(fields, deploymentpackage.FieldEtag)
+	}
+	if m.FieldCleared(deploymentpackage.FieldVersionKey) {
+		fields = append(fields, deploymentpackage.FieldVersionKey)
+	}
+	if m.FieldCleared(deploymentpackage.FieldIsDeployed) {
+		fields = append(fields, deploymentpackage.FieldIsDeployed)
+	}
+	if m.FieldCleared(deploymentpackage.FieldIsVisible) {
+		fields = append(fields, deploymentpackage.FieldIsVisible)
+	}
+	if m.FieldCleared(deploymentpackage.FieldAllowsMultipleDeployments) {
+		fields = append(fields, deploymentpackage.FieldAllowsMultipleDeployments)
+	}
+	if m.FieldCleared(deploymentpackage.FieldKind) {
+		fields = append(fields, deploymentpackage.FieldKind)
+	}
+	if m.FieldCleared(deploymentpackage.FieldApplicationConstraints) {
+		fields = append(fields, deploymentpackage.FieldApplicationConstraints)
+	}
+	if m.FieldCleared(deploymentpackage.FieldSearchText) {
+		fields = append(fields, deploymentpackage.FieldSearchText)
+	}
+	if m.FieldCleared(deploymentpackage.FieldPinnedResolution) {
+		fields = append(fields, deploymentpackage.FieldPinnedResolution)
+	}
+	if m.FieldCleared(deploymentpackage.FieldLabels) {
+		fields = append(fields, deploymentpackage.FieldLabels)
+	}
+	if m.FieldCleared(deploymentpackage.FieldDeprecationMessage) {
+		fields = append(fields, deploymentpackage.FieldDeprecationMessage)
+	}
+	if m.FieldCleared(deploymentpackage.FieldReplacementVersion) {
+		fields = append(fields, deploymentpackage.FieldReplacementVersion)
+	}
+	if m.FieldCleared(deploymentpackage.FieldDeprecateTime) {
+		fields = append(fields, deploymentpackage.FieldDeprecateTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentPackageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentPackageMutation) ClearField(name string) error {
+	switch name {
+	case deploymentpackage.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	case deploymentpackage.FieldDisplayNameLc:
+		m.ClearDisplayNameLc()
+		return nil
+	case deploymentpackage.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case deploymentpackage.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case deploymentpackage.FieldVersionKey:
+		m.ClearVersionKey()
+		return nil
+	case deploymentpackage.FieldIsDeployed:
+		m.ClearIsDeployed()
+		return nil
+	case deploymentpackage.FieldIsVisible:
+		m.ClearIsVisible()
+		return nil
+	case deploymentpackage.FieldAllowsMultipleDeployments:
+		m.ClearAllowsMultipleDeployments()
+		return nil
+	case deploymentpackage.FieldKind:
+		m.ClearKind()
+		return nil
+	case deploymentpackage.FieldApplicationConstraints:
+		m.ClearApplicationConstraints()
+		return nil
+	case deploymentpackage.FieldSearchText:
+		m.ClearSearchText()
+		return nil
+	case deploymentpackage.FieldPinnedResolution:
+		m.ClearPinnedResolution()
+		return nil
+	case deploymentpackage.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case deploymentpackage.FieldDeprecationMessage:
+		m.ClearDeprecationMessage()
+		return nil
+	case deploymentpackage.FieldReplacementVersion:
+		m.ClearReplacementVersion()
+		return nil
+	case deploymentpackage.FieldDeprecateTime:
+		m.ClearDeprecateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentPackage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentPackageMutation) ResetField(name string) error {
+	switch name {
+	case deploymentpackage.FieldName:
+		m.ResetName()
+		return nil
+	case deploymentpackage.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case deploymentpackage.FieldDisplayNameLc:
+		m.ResetDisplayNameLc()
+		return nil
+	case deploymentpackage.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case deploymentpackage.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deploymentpackage.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case deploymentpackage.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case deploymentpackage.FieldProjectUUID:
+		m.ResetProjectUUID()
+		return nil
+	case deploymentpackage.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case deploymentpackage.FieldVersionKey:
+		m.ResetVersionKey()
+		return nil
+	case deploymentpackage.FieldIsDeployed:
+		m.ResetIsDeployed()
+		return nil
+	case deploymentpackage.FieldIsVisible:
+		m.ResetIsVisible()
+		return nil
+	case deploymentpackage.FieldAllowsMultipleDeployments:
+		m.ResetAllowsMultipleDeployments()
+		return nil
+	case deploymentpackage.FieldKind:
+		m.ResetKind()
+		return nil
+	case deploymentpackage.FieldApplicationConstraints:
+		m.ResetApplicationConstraints()
+		return nil
+	case deploymentpackage.FieldSearchText:
+		m.ResetSearchText()
+		return nil
+	case deploymentpackage.FieldPinnedResolution:
+		m.ResetPinnedResolution()
+		return nil
+	case deploymentpackage.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case deploymentpackage.FieldDeprecated:
+		m.ResetDeprecated()
+		return nil
+	case deploymentpackage.FieldDeprecationMessage:
+		m.ResetDeprecationMessage()
+		return nil
+	case deploymentpackage.FieldReplacementVersion:
+		m.ResetReplacementVersion()
+		return nil
+	case deploymentpackage.FieldDeprecateTime:
+		m.ResetDeprecateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentPackage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentPackageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.deployment_profiles != nil {
+		edges = append(edges, deploymentpackage.EdgeDeploymentProfiles)
+	}
+	if m.applications != nil {
+		edges = append(edges, deploymentpackage.EdgeApplications)
+	}
+	if m.icon != nil {
+		edges = append(edges, deploymentpackage.EdgeIcon)
+	}
+	if m.thumbnail != nil {
+		edges = append(edges, deploymentpackage.EdgeThumbnail)
+	}
+	if m.default_profile != nil {
+		edges = append(edges, deploymentpackage.EdgeDefaultProfile)
+	}
+	if m.application_dependencies != nil {
+		edges = append(edges, deploymentpackage.EdgeApplicationDependencies)
+	}
+	if m.application_namespaces != nil {
+		edges = append(edges, deploymentpackage.EdgeApplicationNamespaces)
+	}
+	if m.namespaces != nil {
+		edges = append(edges, deploymentpackage.EdgeNamespaces)
+	}
+	if m.extensions != nil {
+		edges = append(edges, deploymentpackage.EdgeExtensions)
+	}
+	if m.artifacts != nil {
+		edges = append(edges, deploymentpackage.EdgeArtifacts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentPackageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentpackage.EdgeDeploymentProfiles:
+		ids := make([]ent.Value, 0, len(m.deployment_profiles))
+		for id := range m.deployment_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.applications))
+		for id := range m.applications {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeIcon:
+		ids := make([]ent.Value, 0, len(m.icon))
+		for id := range m.icon {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeThumbnail:
+		ids := make([]ent.Value, 0, len(m.thumbnail))
+		for id := range m.thumbnail {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeDefaultProfile:
+		if id := m.default_profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case deploymentpackage.EdgeApplicationDependencies:
+		ids := make([]ent.Value, 0, len(m.application_dependencies))
+		for id := range m.application_dependencies {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeApplicationNamespaces:
+		ids := make([]ent.Value, 0, len(m.application_namespaces))
+		for id := range m.application_namespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeNamespaces:
+		ids := make([]ent.Value, 0, len(m.namespaces))
+		for id := range m.namespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeExtensions:
+		ids := make([]ent.Value, 0, len(m.extensions))
+		for id := range m.extensions {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeArtifacts:
+		ids := make([]ent.Value, 0, len(m.artifacts))
+		for id := range m.artifacts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentPackageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removeddeployment_profiles != nil {
+		edges = append(edges, deploymentpackage.EdgeDeploymentProfiles)
+	}
+	if m.removedapplications != nil {
+		edges = append(edges, deploymentpackage.EdgeApplications)
+	}
+	if m.removedicon != nil {
+		edges = append(edges, deploymentpackage.EdgeIcon)
+	}
+	if m.removedthumbnail != nil {
+		edges = append(edges, deploymentpackage.EdgeThumbnail)
+	}
+	if m.removedapplication_dependencies != nil {
+		edges = append(edges, deploymentpackage.EdgeApplicationDependencies)
+	}
+	if m.removedapplication_namespaces != nil {
+		edges = append(edges, deploymentpackage.EdgeApplicationNamespaces)
+	}
+	if m.removednamespaces != nil {
+		edges = append(edges, deploymentpackage.EdgeNamespaces)
+	}
+	if m.removedextensions != nil {
+		edges = append(edges, deploymentpackage.EdgeExtensions)
+	}
+	if m.removedartifacts != nil {
+		edges = append(edges, deploymentpackage.EdgeArtifacts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentPackageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentpackage.EdgeDeploymentProfiles:
+		ids := make([]ent.Value, 0, len(m.removeddeployment_profiles))
+		for id := range m.removeddeployment_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.removedapplications))
+		for id := range m.removedapplications {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeIcon:
+		ids := make([]ent.Value, 0, len(m.removedicon))
+		for id := range m.removedicon {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeThumbnail:
+		ids := make([]ent.Value, 0, len(m.removedthumbnail))
+		for id := range m.removedthumbnail {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeApplicationDependencies:
+		ids := make([]ent.Value, 0, len(m.removedapplication_dependencies))
+		for id := range m.removedapplication_dependencies {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeApplicationNamespaces:
+		ids := make([]ent.Value, 0, len(m.removedapplication_namespaces))
+		for id := range m.removedapplication_namespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeNamespaces:
+		ids := make([]ent.Value, 0, len(m.removednamespaces))
+		for id := range m.removednamespaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeExtensions:
+		ids := make([]ent.Value, 0, len(m.removedextensions))
+		for id := range m.removedextensions {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentpackage.EdgeArtifacts:
+		ids := make([]ent.Value, 0, len(m.removedartifacts))
+		for id := range m.removedartifacts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentPackageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.cleareddeployment_profiles {
+		edges = append(edges, deploymentpackage.EdgeDeploymentProfiles)
+	}
+	if m.clearedapplications {
+		edges = append(edges, deploymentpackage.EdgeApplications)
+	}
+	if m.clearedicon {
+		edges = append(edges, deploymentpackage.EdgeIcon)
+	}
+	if m.clearedthumbnail {
+		edges = append(edges, deploymentpackage.EdgeThumbnail)
+	}
+	if m.cleareddefault_profile {
+		edges = append(edges, deploymentpackage.EdgeDefaultProfile)
+	}
+	if m.clearedapplication_dependencies {
+		edges = append(edges, deploymentpackage.EdgeApplicationDependencies)
+	}
+	if m.clearedapplication_namespaces {
+		edges = append(edges, deploymentpackage.EdgeApplicationNamespaces)
+	}
+	if m.clearednamespaces {
+		edges = append(edges, deploymentpackage.EdgeNamespaces)
+	}
+	if m.clearedextensions {
+		edges = append(edges, deploymentpackage.EdgeExtensions)
+	}
+	if m.clearedartifacts {
+		edges = append(edges, deploymentpackage.EdgeArtifacts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentPackageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentpackage.EdgeDeploymentProfiles:
+		return m.cleareddeployment_profiles
+	case deploymentpackage.EdgeApplications:
+		return m.clearedapplications
+	case deploymentpackage.EdgeIcon:
+		return m.clearedicon
+	case deploymentpackage.EdgeThumbnail:
+		return m.clearedthumbnail
+	case deploymentpackage.EdgeDefaultProfile:
+		return m.cleareddefault_profile
+	case deploymentpackage.EdgeApplicationDependencies:
+		return m.clearedapplication_dependencies
+	case deploymentpackage.EdgeApplicationNamespaces:
+		return m.clearedapplication_namespaces
+	case deploymentpackage.EdgeNamespaces:
+		return m.clearednamespaces
+	case deploymentpackage.EdgeExtensions:
+		return m.clearedextensions
+	case deploymentpackage.EdgeArtifacts:
+		return m.clearedartifacts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentPackageMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentpackage.EdgeDefaultProfile:
+		m.ClearDefaultProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentPackage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentPackageMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentpackage.EdgeDeploymentProfiles:
+		m.ResetDeploymentProfiles()
+		return nil
+	case deploymentpackage.EdgeApplications:
+		m.ResetApplications()
+		return nil
+	case deploymentpackage.EdgeIcon:
+		m.ResetIcon()
+		return nil
+	case deploymentpackage.EdgeThumbnail:
+		m.ResetThumbnail()
+		return nil
+	case deploymentpackage.EdgeDefaultProfile:
+		m.ResetDefaultProfile()
+		return nil
+	case deploymentpackage.EdgeApplicationDependencies:
+		m.ResetApplicationDependencies()
+		return nil
+	case deploymentpackage.EdgeApplicationNamespaces:
+		m.ResetApplicationNamespaces()
+		return nil
+	case deploymentpackage.EdgeNamespaces:
+		m.ResetNamespaces()
+		return nil
+	case deploymentpackage.EdgeExtensions:
+		m.ResetExtensions()
+		return nil
+	case deploymentpackage.EdgeArtifacts:
+		m.ResetArtifacts()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentPackage edge %s", name)
+}
+
+// DeploymentProfileMutation represents an operation that mutates the DeploymentProfile nodes in the graph.
+type DeploymentProfileMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uint64
+	name                         *string
+	display_name                 *string
+	display_name_lc              *string
+	description                  *string
+	create_time                  *time.Time
+	update_time                  *time.Time
+	clearedFields                map[string]struct{}
+	profiles                     map[uint64]struct{}
+	removedprofiles              map[uint64]struct{}
+	clearedprofiles              bool
+	deployment_package_fk        *uint64
+	cleareddeployment_package_fk bool
+	done                         bool
+	oldValue                     func(context.Context) (*DeploymentProfile, error)
+	predicates                   []predicate.DeploymentProfile
+}
+
+var _ ent.Mutation = (*DeploymentProfileMutation)(nil)
+
+// deploymentprofileOption allows management of the mutation configuration using functional options.
+type deploymentprofileOption func(*DeploymentProfileMutation)
+
+// newDeploymentProfileMutation creates new mutation for the DeploymentProfile entity.
+func newDeploymentProfileMutation(c config, op Op, opts ...deploymentprofileOption) *DeploymentProfileMutation {
+	m := &DeploymentProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentProfileID sets the ID field of the mutation.
+func withDeploymentProfileID(id uint64) deploymentprofileOption {
+	return func(m *DeploymentProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentProfile
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentProfile sets the old DeploymentProfile of the mutation.
+func withDeploymentProfile(node *DeploymentProfile) deploymentprofileOption {
+	return func(m *DeploymentProfileMutation) {
+		m.oldValue = func(context.Context) (*DeploymentProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentProfileMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentProfileMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DeploymentProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DeploymentProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DeploymentProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *DeploymentProfileMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *DeploymentProfileMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *DeploymentProfileMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[deploymentprofile.FieldDisplayName] = struct{}{}
+}
+
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldDisplayName]
+	return ok
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *DeploymentProfileMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, deploymentprofile.FieldDisplayName)
+}
+
+// SetDisplayNameLc sets the "display_name_lc" field.
+func (m *DeploymentProfileMutation) SetDisplayNameLc(s string) {
+	m.display_name_lc = &s
+}
+
+// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
+func (m *DeploymentProfileMutation) DisplayNameLc() (r string, exists bool) {
+	v := m.display_name_lc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+	}
+	return oldValue.DisplayNameLc, nil
+}
+
+// ClearDisplayNameLc clears the value of the "display_name_lc" field.
+func (m *DeploymentProfileMutation) ClearDisplayNameLc() {
+	m.display_name_lc = nil
+	m.clearedFields[deploymentprofile.FieldDisplayNameLc] = struct{}{}
+}
+
+// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldDisplayNameLc]
+	return ok
+}
+
+// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
+func (m *DeploymentProfileMutation) ResetDisplayNameLc() {
+	m.display_name_lc = nil
+	delete(m.clearedFields, deploymentprofile.FieldDisplayNameLc)
+}
+
+// SetDescription sets the "description" field.
+func (m *DeploymentProfileMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DeploymentProfileMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DeploymentProfileMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[deploymentprofile.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DeploymentProfileMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[deploymentprofile.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DeploymentProfileMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, deploymentprofile.FieldDescription)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeploymentProfileMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeploymentProfileMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeploymentProfileMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DeploymentProfileMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DeploymentProfileMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DeploymentProfile entity.
+// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentProfileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DeploymentProfileMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// AddProfileIDs adds the "profiles" edge to the Profile entity by ids.
+func (m *DeploymentProfileMutation) AddProfileIDs(ids ...uint64) {
+	if m.profiles == nil {
+		m.profiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfiles clears the "profiles" edge to the Profile entity.
+func (m *DeploymentProfileMutation) ClearProfiles() {
+	m.clearedprofiles = true
+}
+
+// ProfilesCleared reports if the "profiles" edge to the Profile entity was cleared.
+func (m *DeploymentProfileMutation) ProfilesCleared() bool {
+	return m.clearedprofiles
+}
+
+// RemoveProfileIDs removes the "profiles" edge to the Profile entity by IDs.
+func (m *DeploymentProfileMutation) RemoveProfileIDs(ids ...uint64) {
+	if m.removedprofiles == nil {
+		m.removedprofiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.profiles, ids[i])
+		m.removedprofiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfiles returns the removed IDs of the "profiles" edge to the Profile entity.
+func (m *DeploymentProfileMutation) RemovedProfilesIDs() (ids []uint64) {
+	for id := range m.removedprofiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfilesIDs returns the "profiles" edge IDs in the mutation.
+func (m *DeploymentProfileMutation) ProfilesIDs() (ids []uint64) {
+	for id := range m.profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfiles resets all changes to the "profiles" edge.
+func (m *DeploymentProfileMutation) ResetProfiles() {
+	m.profiles = nil
+	m.clearedprofiles = false
+	m.removedprofiles = nil
+}
+
+// SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
+func (m *DeploymentProfileMutation) SetDeploymentPackageFkID(id uint64) {
+	m.deployment_package_fk = &id
+}
+
+// ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
+func (m *DeploymentProfileMutation) ClearDeploymentPackageFk() {
+	m.cleareddeployment_package_fk = true
+}
+
+// DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
+func (m *DeploymentProfileMutation) DeploymentPackageFkCleared() bool {
+	return m.cleareddeployment_package_fk
+}
+
+// DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
+func (m *DeploymentProfileMutation) DeploymentPackageFkID() (id uint64, exists bool) {
+	if m.deployment_package_fk != nil {
+		return *m.deployment_package_fk, true
+	}
+	return
+}
+
+// DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentPackageFkID instead. It exists only for internal usage by the builders.
+func (m *DeploymentProfileMutation) DeploymentPackageFkIDs() (ids []uint64) {
+	if id := m.deployment_package_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
+func (m *DeploymentProfileMutation) ResetDeploymentPackageFk() {
+	m.deployment_package_fk = nil
+	m.cleareddeployment_package_fk = false
+}
+
+// Where appends a list predicates to the DeploymentProfileMutation builder.
+func (m *DeploymentProfileMutation) Where(ps ...predicate.DeploymentProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentProfile).
+func (m *DeploymentProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentProfileMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, deploymentprofile.FieldName)
+	}
+	if m.display_name != nil {
+		fields = append(fields, deploymentprofile.FieldDisplayName)
+	}
+	if m.display_name_lc != nil {
+		fields = append(fields, deploymentprofile.FieldDisplayNameLc)
+	}
+	if m.description != nil {
+		fields = append(fields, deploymentprofile.FieldDescription)
+	}
+	if m.create_time != nil {
+		fields = append(fields, deploymentprofile.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, deploymentprofile.FieldUpdateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentprofile.FieldName:
+		return m.Name()
+	case deploymentprofile.FieldDisplayName:
+		return m.DisplayName()
+	case deploymentprofile.FieldDisplayNameLc:
+		return m.DisplayNameLc()
+	case deploymentprofile.FieldDescription:
+		return m.Description()
+	case deploymentprofile.FieldCreateTime:
+		return m.CreateTime()
+	case deploymentprofile.FieldUpdateTime:
+		return m.UpdateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentprofile.FieldName:
+		return m.OldName(ctx)
+	case deploymentprofile.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case deploymentprofile.FieldDisplayNameLc:
+		return m.OldDisplayNameLc(ctx)
+	case deploymentprofile.FieldDescription:
+		return m.OldDescription(ctx)
+	case deploymentprofile.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case deploymentprofile.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case deploymentprofile.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case deploymentprofile.FieldDisplayNameLc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayNameLc(v)
+		return nil
+	case deploymentprofile.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case deploymentprofile.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case deploymentprofile.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentProfileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentProfileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeploymentProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentprofile.FieldDisplayName) {
+		fields = append(fields, deploymentprofile.FieldDisplayName)
+	}
+	if m.FieldCleared(deploymentprofile.FieldDisplayNameLc) {
+		fields = append(fields, deploymentprofile.FieldDisplayNameLc)
+	}
+	if m.FieldCleared(deploymentprofile.FieldDescription) {
+		fields = append(fields, deploymentprofile.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentProfileMutation) ClearField(name string) error {
+	switch name {
+	case deploymentprofile.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	case deploymentprofile.FieldDisplayNameLc:
+		m.ClearDisplayNameLc()
+		return nil
+	case deploymentprofile.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentProfileMutation) ResetField(name string) error {
+	switch name {
+	case deploymentprofile.FieldName:
+		m.ResetName()
+		return nil
+	case deploymentprofile.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case deploymentprofile.FieldDisplayNameLc:
+		m.ResetDisplayNameLc()
+		return nil
+	case deploymentprofile.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case deploymentprofile.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case deploymentprofile.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profiles != nil {
+		edges = append(edges, deploymentprofile.EdgeProfiles)
+	}
+	if m.deployment_package_fk != nil {
+		edges = append(edges, deploymentprofile.EdgeDeploymentPackageFk)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentprofile.EdgeProfiles:
+		ids := make([]ent.Value, 0, len(m.profiles))
+		for id := range m.profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case deploymentprofile.EdgeDeploymentPackageFk:
+		if id := m.deployment_package_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprofiles != nil {
+		edges = append(edges, deploymentprofile.EdgeProfiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentprofile.EdgeProfiles:
+		ids := make([]ent.Value, 0, len(m.removedprofiles))
+		for id := range m.removedprofiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofiles {
+		edges = append(edges, deploymentprofile.EdgeProfiles)
+	}
+	if m.cleareddeployment_package_fk {
+		edges = append(edges, deploymentprofile.EdgeDeploymentPackageFk)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentprofile.EdgeProfiles:
+		return m.clearedprofiles
+	case deploymentprofile.EdgeDeploymentPackageFk:
+		return m.cleareddeployment_package_fk
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentProfileMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentprofile.EdgeDeploymentPackageFk:
+		m.ClearDeploymentPackageFk()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentprofile.EdgeProfiles:
+		m.ResetProfiles()
+		return nil
+	case deploymentprofile.EdgeDeploymentPackageFk:
+		m.ResetDeploymentPackageFk()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentProfile edge %s", name)
+}
+
+// DeploymentRequirementMutation represents an operation that mutates the DeploymentRequirement nodes in the graph.
+type DeploymentRequirementMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uint64
+	version_constraint           *string
+	clearedFields                map[string]struct{}
+	profile_fk                   *uint64
+	clearedprofile_fk            bool
+	deployment_package_fk        *uint64
+	cleareddeployment_package_fk bool
+	deployment_profile_fk        *uint64
+	cleareddeployment_profile_fk bool
+	done                         bool
+	oldValue                     func(context.Context) (*DeploymentRequirement, error)
+	predicates                   []predicate.DeploymentRequirement
+}
+
+var _ ent.Mutation = (*DeploymentRequirementMutation)(nil)
+
+// deploymentrequirementOption allows management of the mutation configuration using functional options.
+type deploymentrequirementOption func(*DeploymentRequirementMutation)
+
+// newDeploymentRequirementMutation creates new mutation for the DeploymentRequirement entity.
+func newDeploymentRequirementMutation(c config, op Op, opts ...deploymentrequirementOption) *DeploymentRequirementMutation {
+	m := &DeploymentRequirementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeploymentRequirement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentRequirementID sets the ID field of the mutation.
+func withDeploymentRequirementID(id uint64) deploymentrequirementOption {
+	return func(m *DeploymentRequirementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeploymentRequirement
+		)
+		m.oldValue = func(ctx context.Context) (*DeploymentRequirement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeploymentRequirement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeploymentRequirement sets the old DeploymentRequirement of the mutation.
+func withDeploymentRequirement(node *DeploymentRequirement) deploymentrequirementOption {
+	return func(m *DeploymentRequirementMutation) {
+		m.oldValue = func(context.Context) (*DeploymentRequirement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentRequirementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentRequirementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentRequirementMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentRequirementMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeploymentRequirement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVersionConstraint sets the "version_constraint" field.
+func (m *DeploymentRequirementMutation) SetVersionConstraint(s string) {
+	m.version_constraint = &s
+}
+
+// VersionConstraint returns the value of the "version_constraint" field in the mutation.
+func (m *DeploymentRequirementMutation) VersionConstraint() (r string, exists bool) {
+	v := m.version_constraint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionConstraint returns the old "version_constraint" field's value of the DeploymentRequirement entity.
+// If the DeploymentRequirement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentRequirementMutation) OldVersionConstraint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionConstraint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionConstraint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionConstraint: %w", err)
+	}
+	return oldValue.VersionConstraint, nil
+}
+
+// ClearVersionConstraint clears the value of the "version_constraint" field.
+func (m *DeploymentRequirementMutation) ClearVersionConstraint() {
+	m.version_constraint = nil
+	m.clearedFields[deploymentrequirement.FieldVersionConstraint] = struct{}{}
+}
+
+// VersionConstraintCleared returns if the "version_constraint" field was cleared in this mutation.
+func (m *DeploymentRequirementMutation) VersionConstraintCleared() bool {
+	_, ok := m.clearedFields[deploymentrequirement.FieldVersionConstraint]
+	return ok
+}
+
+// ResetVersionConstraint resets all changes to the "version_constraint" field.
+func (m *DeploymentRequirementMutation) ResetVersionConstraint() {
+	m.version_constraint = nil
+	delete(m.clearedFields, deploymentrequirement.FieldVersionConstraint)
+}
+
+// SetProfileFkID sets the "profile_fk" edge to the Profile entity by id.
+func (m *DeploymentRequirementMutation) SetProfileFkID(id uint64) {
+	m.profile_fk = &id
+}
+
+// ClearProfileFk clears the "profile_fk" edge to the Profile entity.
+func (m *DeploymentRequirementMutation) ClearProfileFk() {
+	m.clearedprofile_fk = true
+}
+
+// ProfileFkCleared reports if the "profile_fk" edge to the Profile entity was cleared.
+func (m *DeploymentRequirementMutation) ProfileFkCleared() bool {
+	return m.clearedprofile_fk
+}
+
+// ProfileFkID returns the "profile_fk" edge ID in the mutation.
+func (m *DeploymentRequirementMutation) ProfileFkID() (id uint64, exists bool) {
+	if m.profile_fk != nil {
+		return *m.profile_fk, true
+	}
+	return
+}
+
+// ProfileFkIDs returns the "profile_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileFkID instead. It exists only for internal usage by the builders.
+func (m *DeploymentRequirementMutation) ProfileFkIDs() (ids []uint64) {
+	if id := m.profile_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfileFk resets all changes to the "profile_fk" edge.
+func (m *DeploymentRequirementMutation) ResetProfileFk() {
+	m.profile_fk = nil
+	m.clearedprofile_fk = false
+}
+
+// SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
+func (m *DeploymentRequirementMutation) SetDeploymentPackageFkID(id uint64) {
+	m.deployment_package_fk = &id
+}
+
+// ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
+func (m *DeploymentRequirementMutation) ClearDeploymentPackageFk() {
+	m.cleareddeployment_package_fk = true
+}
+
+// DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
+func (m *DeploymentRequirementMutation) DeploymentPackageFkCleared() bool {
+	return m.cleareddeployment_package_fk
+}
+
+// DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
+func (m *DeploymentRequirementMutation) DeploymentPackageFkID() (id uint64, exists bool) {
+	if m.deployment_package_fk != nil {
+		return *m.deployment_package_fk, true
+	}
+	return
+}
+
+// DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentPackageFkID instead. It exists only for internal usage by the builders.
+func (m *DeploymentRequirementMutation) DeploymentPackageFkIDs() (ids []uint64) {
+	if id := m.deployment_package_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
+func (m *DeploymentRequirementMutation) ResetDeploymentPackageFk() {
+	m.deployment_package_fk = nil
+	m.cleareddeployment_package_fk = false
+}
+
+// SetDeploymentProfileFkID sets the "deployment_profile_fk" edge to the DeploymentProfile entity by id.
+func (m *DeploymentRequirementMutation) SetDeploymentProfileFkID(id uint64) {
+	m.deployment_profile_fk = &id
+}
+
+// ClearDeploymentProfileFk clears the "deployment_profile_fk" edge to the DeploymentProfile entity.
+func (m *DeploymentRequirementMutation) ClearDeploymentProfileFk() {
+	m.cleareddeployment_profile_fk = true
+}
+
+// DeploymentProfileFkCleared reports if the "deployment_profile_fk" edge to the DeploymentProfile entity was cleared.
+func (m *DeploymentRequirementMutation) DeploymentProfileFkCleared() bool {
+	return m.cleareddeployment_profile_fk
+}
+
+// DeploymentProfileFkID returns the "deployment_profile_fk" edge ID in the mutation.
+func (m *DeploymentRequirementMutation) DeploymentProfileFkID() (id uint64, exists bool) {
+	if m.deployment_profile_fk != nil {
+		return *m.deployment_profile_fk, true
+	}
+	return
+}
+
+// DeploymentProfileFkIDs returns the "deployment_profile_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentProfileFkID instead. It exists only for internal usage by the builders.
+func (m *DeploymentRequirementMutation) DeploymentProfileFkIDs() (ids []uint64) {
+	if id := m.deployment_profile_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeploymentProfileFk resets all changes to the "deployment_profile_fk" edge.
+func (m *DeploymentRequirementMutation) ResetDeploymentProfileFk() {
+	m.deployment_profile_fk = nil
+	m.cleareddeployment_profile_fk = false
+}
+
+// Where appends a list predicates to the DeploymentRequirementMutation builder.
+func (m *DeploymentRequirementMutation) Where(ps ...predicate.DeploymentRequirement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentRequirementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentRequirementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeploymentRequirement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentRequirementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentRequirementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeploymentRequirement).
+func (m *DeploymentRequirementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentRequirementMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.version_constraint != nil {
+		fields = append(fields, deploymentrequirement.FieldVersionConstraint)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentRequirementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deploymentrequirement.FieldVersionConstraint:
+		return m.VersionConstraint()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentRequirementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deploymentrequirement.FieldVersionConstraint:
+		return m.OldVersionConstraint(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeploymentRequirement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentRequirementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deploymentrequirement.FieldVersionConstraint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionConstraint(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRequirement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentRequirementMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentRequirementMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentRequirementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeploymentRequirement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentRequirementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deploymentrequirement.FieldVersionConstraint) {
+		fields = append(fields, deploymentrequirement.FieldVersionConstraint)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentRequirementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentRequirementMutation) ClearField(name string) error {
+	switch name {
+	case deploymentrequirement.FieldVersionConstraint:
+		m.ClearVersionConstraint()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRequirement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentRequirementMutation) ResetField(name string) error {
+	switch name {
+	case deploymentrequirement.FieldVersionConstraint:
+		m.ResetVersionConstraint()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRequirement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentRequirementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.profile_fk != nil {
+		edges = append(edges, deploymentrequirement.EdgeProfileFk)
+	}
+	if m.deployment_package_fk != nil {
+		edges = append(edges, deploymentrequirement.EdgeDeploymentPackageFk)
+	}
+	if m.deployment_profile_fk != nil {
+		edges = append(edges, deploymentrequirement.EdgeDeploymentProfileFk)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentRequirementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deploymentrequirement.EdgeProfileFk:
+		if id := m.profile_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	case deploymentrequirement.EdgeDeploymentPackageFk:
+		if id := m.deployment_package_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	case deploymentrequirement.EdgeDeploymentProfileFk:
+		if id := m.deployment_profile_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentRequirementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentRequirementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentRequirementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedprofile_fk {
+		edges = append(edges, deploymentrequirement.EdgeProfileFk)
+	}
+	if m.cleareddeployment_package_fk {
+		edges = append(edges, deploymentrequirement.EdgeDeploymentPackageFk)
+	}
+	if m.cleareddeployment_profile_fk {
+		edges = append(edges, deploymentrequirement.EdgeDeploymentProfileFk)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentRequirementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deploymentrequirement.EdgeProfileFk:
+		return m.clearedprofile_fk
+	case deploymentrequirement.EdgeDeploymentPackageFk:
+		return m.cleareddeployment_package_fk
+	case deploymentrequirement.EdgeDeploymentProfileFk:
+		return m.cleareddeployment_profile_fk
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentRequirementMutation) ClearEdge(name string) error {
+	switch name {
+	case deploymentrequirement.EdgeProfileFk:
+		m.ClearProfileFk()
+		return nil
+	case deploymentrequirement.EdgeDeploymentPackageFk:
+		m.ClearDeploymentPackageFk()
+		return nil
+	case deploymentrequirement.EdgeDeploymentProfileFk:
+		m.ClearDeploymentProfileFk()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRequirement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentRequirementMutation) ResetEdge(name string) error {
+	switch name {
+	case deploymentrequirement.EdgeProfileFk:
+		m.ResetProfileFk()
+		return nil
+	case deploymentrequirement.EdgeDeploymentPackageFk:
+		m.ResetDeploymentPackageFk()
+		return nil
+	case deploymentrequirement.EdgeDeploymentProfileFk:
+		m.ResetDeploymentProfileFk()
+		return nil
+	}
+	return fmt.Errorf("unknown DeploymentRequirement edge %s", name)
+}
+
+// EndpointMutation represents an operation that mutates the Endpoint nodes in the graph.
+type EndpointMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uint64
+	service_name        *string
+	external_path       *string
+	internal_path       *string
+	scheme              *string
+	auth_type           *string
+	app_name            *string
+	clearedFields       map[string]struct{}
+	extension_fk        *uint64
+	clearedextension_fk bool
+	done                bool
+	oldValue            func(context.Context) (*Endpoint, error)
+	predicates          []predicate.Endpoint
+}
+
+var _ ent.Mutation = (*EndpointMutation)(nil)
+
+// endpointOption allows management of the mutation configuration using functional options.
+type endpointOption func(*EndpointMutation)
+
+// newEndpointMutation creates new mutation for the Endpoint entity.
+func newEndpointMutation(c config, op Op, opts ...endpointOption) *EndpointMutation {
+	m := &EndpointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEndpoint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEndpointID sets the ID field of the mutation.
+func withEndpointID(id uint64) endpointOption {
+	return func(m *EndpointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Endpoint
+		)
+		m.oldValue = func(ctx context.Context) (*Endpoint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Endpoint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEndpoint sets the old Endpoint of the mutation.
+func withEndpoint(node *Endpoint) endpointOption {
+	return func(m *EndpointMutation) {
+		m.oldValue = func(context.Context) (*Endpoint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EndpointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EndpointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EndpointMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EndpointMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Endpoint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetServiceName sets the "service_name" field.
+func (m *EndpointMutation) SetServiceName(s string) {
+	m.service_name = &s
+}
+
+// ServiceName returns the value of the "service_name" field in the mutation.
+func (m *EndpointMutation) ServiceName() (r string, exists bool) {
+	v := m.service_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceName returns the old "service_name" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldServiceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+	}
+	return oldValue.ServiceName, nil
+}
+
+// ResetServiceName resets all changes to the "service_name" field.
+func (m *EndpointMutation) ResetServiceName() {
+	m.service_name = nil
+}
+
+// SetExternalPath sets the "external_path" field.
+func (m *EndpointMutation) SetExternalPath(s string) {
+	m.external_path = &s
+}
+
+// ExternalPath returns the value of the "external_path" field in the mutation.
+func (m *EndpointMutation) ExternalPath() (r string, exists bool) {
+	v := m.external_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalPath returns the old "external_path" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldExternalPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalPath: %w", err)
+	}
+	return oldValue.ExternalPath, nil
+}
+
+// ResetExternalPath resets all changes to the "external_path" field.
+func (m *EndpointMutation) ResetExternalPath() {
+	m.external_path = nil
+}
+
+// SetInternalPath sets the "internal_path" field.
+func (m *EndpointMutation) SetInternalPath(s string) {
+	m.internal_path = &s
+}
+
+// InternalPath returns the value of the "internal_path" field in the mutation.
+func (m *EndpointMutation) InternalPath() (r string, exists bool) {
+	v := m.internal_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternalPath returns the old "internal_path" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldInternalPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalPath: %w", err)
+	}
+	return oldValue.InternalPath, nil
+}
+
+// ResetInternalPath resets all changes to the "internal_path" field.
+func (m *EndpointMutation) ResetInternalPath() {
+	m.internal_path = nil
+}
+
+// SetScheme sets the "scheme" field.
+func (m *EndpointMutation) SetScheme(s string) {
+	m.scheme = &s
+}
+
+// Scheme returns the value of the "scheme" field in the mutation.
+func (m *EndpointMutation) Scheme() (r string, exists bool) {
+	v := m.scheme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheme returns the old "scheme" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldScheme(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheme: %w", err)
+	}
+	return oldValue.Scheme, nil
+}
+
+// ResetScheme resets all changes to the "scheme" field.
+func (m *EndpointMutation) ResetScheme() {
+	m.scheme = nil
+}
+
+// SetAuthType sets the "auth_type" field.
+func (m *EndpointMutation) SetAuthType(s string) {
+	m.auth_type = &s
+}
+
+// AuthType returns the value of the "auth_type" field in the mutation.
+func (m *EndpointMutation) AuthType() (r string, exists bool) {
+	v := m.auth_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthType returns the old "auth_type" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldAuthType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthType: %w", err)
+	}
+	return oldValue.AuthType, nil
+}
+
+// ResetAuthType resets all changes to the "auth_type" field.
+func (m *EndpointMutation) ResetAuthType() {
+	m.auth_type = nil
+}
+
+// SetAppName sets the "app_name" field.
+func (m *EndpointMutation) SetAppName(s string) {
+	m.app_name = &s
+}
+
+// AppName returns the value of the "app_name" field in the mutation.
+func (m *EndpointMutation) AppName() (r string, exists bool) {
+	v := m.app_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppName returns the old "app_name" field's value of the Endpoint entity.
+// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EndpointMutation) OldAppName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppName: %w", err)
+	}
+	return oldValue.AppName, nil
+}
+
+// ClearAppName clears the value of the "app_name" field.
+func (m *EndpointMutation) ClearAppName() {
+	m.app_name = nil
+	m.clearedFields[endpoint.FieldAppName] = struct{}{}
+}
+
+// AppNameCleared returns if the "app_name" field was cleared in this mutation.
+func (m *EndpointMutation) AppNameCleared() bool {
+	_, ok := m.clearedFields[endpoint.FieldAppName]
+	return ok
+}
+
+// ResetAppName resets all changes to the "app_name" field.
+func (m *EndpointMutation) ResetAppName() {
+	m.app_name = nil
+	delete(m.clearedFields, endpoint.FieldAppName)
+}
+
+// SetExtensionFkID sets the "extension_fk" edge to the Extension entity by id.
+func (m *EndpointMutation) SetExtensionFkID(id uint64) {
+	m.extension_fk = &id
+}
+
+// ClearExtensionFk clears the "extension_fk" edge to the Extension entity.
+func (m *EndpointMutation) ClearExtensionFk() {
+	m.clearedextension_fk = true
+}
+
+// ExtensionFkCleared reports if the "extension_fk" edge to the Extension entity was cleared.
+func (m *EndpointMutation) ExtensionFkCleared() bool {
+	return m.clearedextension_fk
+}
+
+// ExtensionFkID returns the "extension_fk" edge ID in the mutation.
+func (m *EndpointMutation) ExtensionFkID() (id uint64, exists bool) {
+	if m.extension_fk != nil {
+		return *m.extension_fk, true
+	}
+	return
+}
+
+// ExtensionFkIDs returns the "extension_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExtensionFkID instead. It exists only for internal usage by the builders.
+func (m *EndpointMutation) ExtensionFkIDs() (ids []uint64) {
+	if id := m.extension_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExtensionFk resets all changes to the "extension_fk" edge.
+func (m *EndpointMutation) ResetExtensionFk() {
+	m.extension_fk = nil
+	m.clearedextension_fk = false
+}
+
+// Where appends a list predicates to the EndpointMutation builder.
+func (m *EndpointMutation) Where(ps ...predicate.Endpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EndpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EndpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Endpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EndpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EndpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Endpoint).
+func (m *EndpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EndpointMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.service_name != nil {
+		fields = append(fields, endpoint.FieldServiceName)
+	}
+	if m.external_path != nil {
+		fields = append(fields, endpoint.FieldExternalPath)
+	}
+	if m.internal_path != nil {
+		fields = append(fields, endpoint.FieldInternalPath)
+	}
+	if m.scheme != nil {
+		fields = append(fields, endpoint.FieldScheme)
+	}
+	if m.auth_type != nil {
+		fields = append(fields, endpoint.FieldAuthType)
+	}
+	if m.app_name != nil {
+		fields = append(fields, endpoint.FieldAppName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EndpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case endpoint.FieldServiceName:
+		return m.ServiceName()
+	case endpoint.FieldExternalPath:
+		return m.ExternalPath()
+	case endpoint.FieldInternalPath:
+		return m.InternalPath()
+	case endpoint.FieldScheme:
+		return m.Scheme()
+	case endpoint.FieldAuthType:
+		return m.AuthType()
+	case endpoint.FieldAppName:
+		return m.AppName()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case endpoint.FieldServiceName:
+		return m.OldServiceName(ctx)
+	case endpoint.FieldExternalPath:
+		return m.OldExternalPath(ctx)
+	case endpoint.FieldInternalPath:
+		return m.OldInternalPath(ctx)
+	case endpoint.FieldScheme:
+		return m.OldScheme(ctx)
+	case endpoint.FieldAuthType:
+		return m.OldAuthType(ctx)
+	case endpoint.FieldAppName:
+		return m.OldAppName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Endpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EndpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case endpoint.FieldServiceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceName(v)
+		return nil
+	case endpoint.FieldExternalPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalPath(v)
+		return nil
+	case endpoint.FieldInternalPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternalPath(v)
+		return nil
+	case endpoint.FieldScheme:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheme(v)
+		return nil
+	case endpoint.FieldAuthType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthType(v)
+		return nil
+	case endpoint.FieldAppName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EndpointMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EndpointMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EndpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Endpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EndpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(endpoint.FieldAppName) {
+		fields = append(fields, endpoint.FieldAppName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EndpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EndpointMutation) ClearField(name string) error {
+	switch name {
+	case endpoint.FieldAppName:
+		m.ClearAppName()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EndpointMutation) ResetField(name string) error {
+	switch name {
+	case endpoint.FieldServiceName:
+		m.ResetServiceName()
+		return nil
+	case endpoint.FieldExternalPath:
+		m.ResetExternalPath()
+		return nil
+	case endpoint.FieldInternalPath:
+		m.ResetInternalPath()
+		return nil
+	case endpoint.FieldScheme:
+		m.ResetScheme()
+		return nil
+	case endpoint.FieldAuthType:
+		m.ResetAuthType()
+		return nil
+	case endpoint.FieldAppName:
+		m.ResetAppName()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EndpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.extension_fk != nil {
+		edges = append(edges, endpoint.EdgeExtensionFk)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EndpointMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case endpoint.EdgeExtensionFk:
+		if id := m.extension_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EndpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EndpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EndpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedextension_fk {
+		edges = append(edges, endpoint.EdgeExtensionFk)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EndpointMutation) EdgeCleared(name string) bool {
+	switch name {
+	case endpoint.EdgeExtensionFk:
+		return m.clearedextension_fk
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EndpointMutation) ClearEdge(name string) error {
+	switch name {
+	case endpoint.EdgeExtensionFk:
+		m.ClearExtensionFk()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EndpointMutation) ResetEdge(name string) error {
+	switch name {
+	case endpoint.EdgeExtensionFk:
+		m.ResetExtensionFk()
+		return nil
+	}
+	return fmt.Errorf("unknown Endpoint edge %s", name)
+}
+
+// ExtensionMutation represents an operation that mutates the Extension nodes in the graph.
+type ExtensionMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uint64
+	name                         *string
+	version                      *string
+	display_name                 *string
+	display_name_lc              *string
+	description                  *string
+	ui_label                     *string
+	ui_service_name              *string
+	ui_description               *string
+	ui_file_name                 *string
+	ui_app_name                  *string
+	ui_module_name               *string
+	clearedFields                map[string]struct{}
+	endpoints                    map[uint64]struct{}
+	removedendpoints             map[uint64]struct{}
+	clearedendpoints             bool
+	deployment_package_fk        *uint64
+	cleareddeployment_package_fk bool
+	done                         bool
+	oldValue                     func(context.Context) (*Extension, error)
+	predicates                   []predicate.Extension
+}
+
+var _ ent.Mutation = (*ExtensionMutation)(nil)
+
+// extensionOption allows management of the mutation configuration using functional options.
+type extensionOption func(*ExtensionMutation)
+
+// newExtensionMutation creates new mutation for the Extension entity.
+func newExtensionMutation(c config, op Op, opts ...extensionOption) *ExtensionMutation {
+	m := &ExtensionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtension,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtensionID sets the ID field of the mutation.
+func withExtensionID(id uint64) extensionOption {
+	return func(m *ExtensionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Extension
+		)
+		m.oldValue = func(ctx context.Context) (*Extension, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Extension.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtension sets the old Extension of the mutation.
+func withExtension(node *Extension) extensionOption {
+	return func(m *ExtensionMutation) {
+		m.oldValue = func(context.Context) (*Extension, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtensionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtensionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtensionMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtensionMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Extension.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ExtensionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ExtensionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ExtensionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ExtensionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ExtensionMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ExtensionMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *ExtensionMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *ExtensionMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *ExtensionMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[extension.FieldDisplayName] = struct{}{}
+}
+
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *ExtensionMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[extension.FieldDisplayName]
+	return ok
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *ExtensionMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, extension.FieldDisplayName)
+}
+
+// SetDisplayNameLc sets the "display_name_lc" field.
+func (m *ExtensionMutation) SetDisplayNameLc(s string) {
+	m.display_name_lc = &s
+}
+
+// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
+func (m *ExtensionMutation) DisplayNameLc() (r string, exists bool) {
+	v := m.display_name_lc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+	}
+	return oldValue.DisplayNameLc, nil
+}
+
+// ClearDisplayNameLc clears the value of the "display_name_lc" field.
+func (m *ExtensionMutation) ClearDisplayNameLc() {
+	m.display_name_lc = nil
+	m.clearedFields[extension.FieldDisplayNameLc] = struct{}{}
+}
+
+// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
+func (m *ExtensionMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[extension.FieldDisplayNameLc]
+	return ok
+}
+
+// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
+func (m *ExtensionMutation) ResetDisplayNameLc() {
+	m.display_name_lc = nil
+	delete(m.clearedFields, extension.FieldDisplayNameLc)
+}
+
+// SetDescription sets the "description" field.
+func (m *ExtensionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ExtensionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ExtensionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[extension.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ExtensionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[extension.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ExtensionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, extension.FieldDescription)
+}
+
+// SetUILabel sets the "ui_label" field.
+func (m *ExtensionMutation) SetUILabel(s string) {
+	m.ui_label = &s
+}
+
+// UILabel returns the value of the "ui_label" field in the mutation.
+func (m *ExtensionMutation) UILabel() (r string, exists bool) {
+	v := m.ui_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUILabel returns the old "ui_label" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldUILabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUILabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUILabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUILabel: %w", err)
+	}
+	return oldValue.UILabel, nil
+}
+
+// ClearUILabel clears the value of the "ui_label" field.
+func (m *ExtensionMutation) ClearUILabel() {
+	m.ui_label = nil
+	m.clearedFields[extension.FieldUILabel] = struct{}{}
+}
+
+// UILabelCleared returns if the "ui_label" field was cleared in this mutation.
+func (m *ExtensionMutation) UILabelCleared() bool {
+	_, ok := m.clearedFields[extension.FieldUILabel]
+	return ok
+}
+
+// ResetUILabel resets all changes to the "ui_label" field.
+func (m *ExtensionMutation) ResetUILabel() {
+	m.ui_label = nil
+	delete(m.clearedFields, extension.FieldUILabel)
+}
+
+// SetUIServiceName sets the "ui_service_name" field.
+func (m *ExtensionMutation) SetUIServiceName(s string) {
+	m.ui_service_name = &s
+}
+
+// UIServiceName returns the value of the "ui_service_name" field in the mutation.
+func (m *ExtensionMutation) UIServiceName() (r string, exists bool) {
+	v := m.ui_service_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUIServiceName returns the old "ui_service_name" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldUIServiceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUIServiceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUIServiceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUIServiceName: %w", err)
+	}
+	return oldValue.UIServiceName, nil
+}
+
+// ClearUIServiceName clears the value of the "ui_service_name" field.
+func (m *ExtensionMutation) ClearUIServiceName() {
+	m.ui_service_name = nil
+	m.clearedFields[extension.FieldUIServiceName] = struct{}{}
+}
+
+// UIServiceNameCleared returns if the "ui_service_name" field was cleared in this mutation.
+func (m *ExtensionMutation) UIServiceNameCleared() bool {
+	_, ok := m.clearedFields[extension.FieldUIServiceName]
+	return ok
+}
+
+// ResetUIServiceName resets all changes to the "ui_service_name" field.
+func (m *ExtensionMutation) ResetUIServiceName() {
+	m.ui_service_name = nil
+	delete(m.clearedFields, extension.FieldUIServiceName)
+}
+
+// SetUIDescription sets the "ui_description" field.
+func (m *ExtensionMutation) SetUIDescription(s string) {
+	m.ui_description = &s
+}
+
+// UIDescription returns the value of the "ui_description" field in the mutation.
+func (m *ExtensionMutation) UIDescription() (r string, exists bool) {
+	v := m.ui_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUIDescription returns the old "ui_description" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldUIDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUIDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUIDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUIDescription: %w", err)
+	}
+	return oldValue.UIDescription, nil
+}
+
+// ClearUIDescription clears the value of the "ui_description" field.
+func (m *ExtensionMutation) ClearUIDescription() {
+	m.ui_description = nil
+	m.clearedFields[extension.FieldUIDescription] = struct{}{}
+}
+
+// UIDescriptionCleared returns if the "ui_description" field was cleared in this mutation.
+func (m *ExtensionMutation) UIDescriptionCleared() bool {
+	_, ok := m.clearedFields[extension.FieldUIDescription]
+	return ok
+}
+
+// ResetUIDescription resets all changes to the "ui_description" field.
+func (m *ExtensionMutation) ResetUIDescription() {
+	m.ui_description = nil
+	delete(m.clearedFields, extension.FieldUIDescription)
+}
+
+// SetUIFileName sets the "ui_file_name" field.
+func (m *ExtensionMutation) SetUIFileName(s string) {
+	m.ui_file_name = &s
+}
+
+// UIFileName returns the value of the "ui_file_name" field in the mutation.
+func (m *ExtensionMutation) UIFileName() (r string, exists bool) {
+	v := m.ui_file_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUIFileName returns the old "ui_file_name" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldUIFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUIFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUIFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUIFileName: %w", err)
+	}
+	return oldValue.UIFileName, nil
+}
+
+// ClearUIFileName clears the value of the "ui_file_name" field.
+func (m *ExtensionMutation) ClearUIFileName() {
+	m.ui_file_name = nil
+	m.clearedFields[extension.FieldUIFileName] = struct{}{}
+}
+
+// UIFileNameCleared returns if the "ui_file_name" field was cleared in this mutation.
+func (m *ExtensionMutation) UIFileNameCleared() bool {
+	_, ok := m.clearedFields[extension.FieldUIFileName]
+	return ok
+}
+
+// ResetUIFileName resets all changes to the "ui_file_name" field.
+func (m *ExtensionMutation) ResetUIFileName() {
+	m.ui_file_name = nil
+	delete(m.clearedFields, extension.FieldUIFileName)
+}
+
+// SetUIAppName sets the "ui_app_name" field.
+func (m *ExtensionMutation) SetUIAppName(s string) {
+	m.ui_app_name = &s
+}
+
+// UIAppName returns the value of the "ui_app_name" field in the mutation.
+func (m *ExtensionMutation) UIAppName() (r string, exists bool) {
+	v := m.ui_app_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUIAppName returns the old "ui_app_name" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldUIAppName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUIAppName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUIAppName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUIAppName: %w", err)
+	}
+	return oldValue.UIAppName, nil
+}
+
+// ClearUIAppName clears the value of the "ui_app_name" field.
+func (m *ExtensionMutation) ClearUIAppName() {
+	m.ui_app_name = nil
+	m.clearedFields[extension.FieldUIAppName] = struct{}{}
+}
+
+// UIAppNameCleared returns if the "ui_app_name" field was cleared in this mutation.
+func (m *ExtensionMutation) UIAppNameCleared() bool {
+	_, ok := m.clearedFields[extension.FieldUIAppName]
+	return ok
+}
+
+// ResetUIAppName resets all changes to the "ui_app_name" field.
+func (m *ExtensionMutation) ResetUIAppName() {
+	m.ui_app_name = nil
+	delete(m.clearedFields, extension.FieldUIAppName)
+}
+
+// SetUIModuleName sets the "ui_module_name" field.
+func (m *ExtensionMutation) SetUIModuleName(s string) {
+	m.ui_module_name = &s
+}
+
+// UIModuleName returns the value of the "ui_module_name" field in the mutation.
+func (m *ExtensionMutation) UIModuleName() (r string, exists bool) {
+	v := m.ui_module_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUIModuleName returns the old "ui_module_name" field's value of the Extension entity.
+// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtensionMutation) OldUIModuleName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUIModuleName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUIModuleName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUIModuleName: %w", err)
+	}
+	return oldValue.UIModuleName, nil
+}
+
+// ClearUIModuleName clears the value of the "ui_module_name" field.
+func (m *ExtensionMutation) ClearUIModuleName() {
+	m.ui_module_name = nil
+	m.clearedFields[extension.FieldUIModuleName] = struct{}{}
+}
+
+// UIModuleNameCleared returns if the "ui_module_name" field was cleared in this mutation.
+func (m *ExtensionMutation) UIModuleNameCleared() bool {
+	_, ok := m.clearedFields[extension.FieldUIModuleName]
+	return ok
+}
+
+// ResetUIModuleName resets all changes to the "ui_module_name" field.
+func (m *ExtensionMutation) ResetUIModuleName() {
+	m.ui_module_name = nil
+	delete(m.clearedFields, extension.FieldUIModuleName)
+}
+
+// AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by ids.
+func (m *ExtensionMutation) AddEndpointIDs(ids ...uint64) {
+	if m.endpoints == nil {
+		m.endpoints = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.endpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEndpoints clears the "endpoints" edge to the Endpoint entity.
+func (m *ExtensionMutation) ClearEndpoints() {
+	m.clearedendpoints = true
+}
+
+// EndpointsCleared reports if the "endpoints" edge to the Endpoint entity was cleared.
+func (m *ExtensionMutation) EndpointsCleared() bool {
+	return m.clearedendpoints
+}
+
+// RemoveEndpointIDs removes the "endpoints" edge to the Endpoint entity by IDs.
+func (m *ExtensionMutation) RemoveEndpointIDs(ids ...uint64) {
+	if m.removedendpoints == nil {
+		m.removedendpoints = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.endpoints, ids[i])
+		m.removedendpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEndpoints returns the removed IDs of the "endpoints" edge to the Endpoint entity.
+func (m *ExtensionMutation) RemovedEndpointsIDs() (ids []uint64) {
+	for id := range m.removedendpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EndpointsIDs returns the "endpoints" edge IDs in the mutation.
+func (m *ExtensionMutation) EndpointsIDs() (ids []uint64) {
+	for id := range m.endpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEndpoints resets all changes to the "endpoints" edge.
+func (m *ExtensionMutation) ResetEndpoints() {
+	m.endpoints = nil
+	m.clearedendpoints = false
+	m.removedendpoints = nil
+}
+
+// SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
+func (m *ExtensionMutation) SetDeploymentPackageFkID(id uint64) {
+	m.deployment_package_fk = &id
+}
+
+// ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
+func (m *ExtensionMutation) ClearDeploymentPackageFk() {
+	m.cleareddeployment_package_fk = true
+}
+
+// DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
+func (m *ExtensionMutation) DeploymentPackageFkCleared() bool {
+	return m.cleareddeployment_package_fk
+}
+
+// DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
+func (m *ExtensionMutation) DeploymentPackageFkID() (id uint64, exists bool) {
+	if m.deployment_package_fk != nil {
+		return *m.deployment_package_fk, true
+	}
+	return
+}
+
+// DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentPackageFkID instead. It exists only for internal usage by the builders.
+func (m *ExtensionMutation) DeploymentPackageFkIDs() (ids []uint64) {
+	if id := m.deployment_package_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
+func (m *ExtensionMutation) ResetDeploymentPackageFk() {
+	m.deployment_package_fk = nil
+	m.cleareddeployment_package_fk = false
+}
+
+// Where appends a list predicates to the ExtensionMutation builder.
+func (m *ExtensionMutation) Where(ps ...predicate.Extension) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtensionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtensionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Extension, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtensionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtensionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Extension).
+func (m *ExtensionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentPackageMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *ExtensionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.name != nil {
-		fields = append(fields, deploymentpackage.FieldName)
+		fields = append(fields, extension.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, extension.FieldVersion)
 	}
 	if m.display_name != nil {
-		fields = append(fields, deploymentpackage.FieldDisplayName)
+		fields = append(fields, extension.FieldDisplayName)
 	}
 	if m.display_name_lc != nil {
-		fields = append(fields, deploymentpackage.FieldDisplayNameLc)
-	}
-	if m.description != nil {
-		fields = append(fields, deploymentpackage.FieldDescription)
-	}
-	if m.create_time != nil {
-		fields = append(fields, deploymentpackage.FieldCreateTime)
+		fields = append(fields, extension.FieldDisplayNameLc)
 	}
-	if m.update_time != nil {
-		fields = append(fields, deploymentpackage.FieldUpdateTime)
+	if m.description != nil {
+		fields = append(fields, extension.FieldDescription)
 	}
-	if m.project_uuid != nil {
-		fields = append(fields, deploymentpackage.FieldProjectUUID)
+	if m.ui_label != nil {
+		fields = append(fields, extension.FieldUILabel)
 	}
-	if m.version != nil {
-		fields = append(fields, deploymentpackage.FieldVersion)
+	if m.ui_service_name != nil {
+		fields = append(fields, extension.FieldUIServiceName)
 	}
-	if m.is_deployed != nil {
-		fields = append(fields, deploymentpackage.FieldIsDeployed)
+	if m.ui_description != nil {
+		fields = append(fields, extension.FieldUIDescription)
 	}
-	if m.is_visible != nil {
-		fields = append(fields, deploymentpackage.FieldIsVisible)
+	if m.ui_file_name != nil {
+		fields = append(fields, extension.FieldUIFileName)
 	}
-	if m.allows_multiple_deployments != nil {
-		fields = append(fields, deploymentpackage.FieldAllowsMultipleDeployments)
+	if m.ui_app_name != nil {
+		fields = append(fields, extension.FieldUIAppName)
 	}
-	if m.kind != nil {
-		fields = append(fields, deploymentpackage.FieldKind)
+	if m.ui_module_name != nil {
+		fields = append(fields, extension.FieldUIModuleName)
 	}
 	return fields
 }
@@ -5885,32 +11817,30 @@ func (m *DeploymentPackageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentPackageMutation) Field(name string) (ent.Value, bool) {
+func (m *ExtensionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentpackage.FieldName:
+	case extension.FieldName:
 		return m.Name()
-	case deploymentpackage.FieldDisplayName:
+	case extension.FieldVersion:
+		return m.Version()
+	case extension.FieldDisplayName:
 		return m.DisplayName()
-	case deploymentpackage.FieldDisplayNameLc:
+	case extension.FieldDisplayNameLc:
 		return m.DisplayNameLc()
-	case deploymentpackage.FieldDescription:
+	case extension.FieldDescription:
 		return m.Description()
-	case deploymentpackage.FieldCreateTime:
-		return m.CreateTime()
-	case deploymentpackage.FieldUpdateTime:
-		return m.UpdateTime()
-	case deploymentpackage.FieldProjectUUID:
-		return m.ProjectUUID()
-	case deploymentpackage.FieldVersion:
-		return m.Version()
-	case deploymentpackage.FieldIsDeployed:
-		return m.IsDeployed()
-	case deploymentpackage.FieldIsVisible:
-		return m.IsVisible()
-	case deploymentpackage.FieldAllowsMultipleDeployments:
-		return m.AllowsMultipleDeployments()
-	case deploymentpackage.FieldKind:
-		return m.Kind()
+	case extension.FieldUILabel:
+		return m.UILabel()
+	case extension.FieldUIServiceName:
+		return m.UIServiceName()
+	case extension.FieldUIDescription:
+		return m.UIDescription()
+	case extension.FieldUIFileName:
+		return m.UIFileName()
+	case extension.FieldUIAppName:
+		return m.UIAppName()
+	case extension.FieldUIModuleName:
+		return m.UIModuleName()
 	}
 	return nil, false
 }
@@ -5918,603 +11848,389 @@ func (m *DeploymentPackageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExtensionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymentpackage.FieldName:
+	case extension.FieldName:
 		return m.OldName(ctx)
-	case deploymentpackage.FieldDisplayName:
+	case extension.FieldVersion:
+		return m.OldVersion(ctx)
+	case extension.FieldDisplayName:
 		return m.OldDisplayName(ctx)
-	case deploymentpackage.FieldDisplayNameLc:
+	case extension.FieldDisplayNameLc:
 		return m.OldDisplayNameLc(ctx)
-	case deploymentpackage.FieldDescription:
+	case extension.FieldDescription:
 		return m.OldDescription(ctx)
-	case deploymentpackage.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case deploymentpackage.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case deploymentpackage.FieldProjectUUID:
-		return m.OldProjectUUID(ctx)
-	case deploymentpackage.FieldVersion:
-		return m.OldVersion(ctx)
-	case deploymentpackage.FieldIsDeployed:
-		return m.OldIsDeployed(ctx)
-	case deploymentpackage.FieldIsVisible:
-		return m.OldIsVisible(ctx)
-	case deploymentpackage.FieldAllowsMultipleDeployments:
-		return m.OldAllowsMultipleDeployments(ctx)
-	case deploymentpackage.FieldKind:
-		return m.OldKind(ctx)
+	case extension.FieldUILabel:
+		return m.OldUILabel(ctx)
+	case extension.FieldUIServiceName:
+		return m.OldUIServiceName(ctx)
+	case extension.FieldUIDescription:
+		return m.OldUIDescription(ctx)
+	case extension.FieldUIFileName:
+		return m.OldUIFileName(ctx)
+	case extension.FieldUIAppName:
+		return m.OldUIAppName(ctx)
+	case extension.FieldUIModuleName:
+		return m.OldUIModuleName(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeploymentPackage field %s", name)
+	return nil, fmt.Errorf("unknown Extension field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentPackageMutation) SetField(name string, value ent.Value) error {
+func (m *ExtensionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymentpackage.FieldName:
+	case extension.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case deploymentpackage.FieldDisplayName:
+	case extension.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetVersion(v)
 		return nil
-	case deploymentpackage.FieldDisplayNameLc:
+	case extension.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayNameLc(v)
+		m.SetDisplayName(v)
 		return nil
-	case deploymentpackage.FieldDescription:
+	case extension.FieldDisplayNameLc:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case deploymentpackage.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
+		m.SetDisplayNameLc(v)
 		return nil
-	case deploymentpackage.FieldUpdateTime:
-		v, ok := value.(time.Time)
+	case extension.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetDescription(v)
 		return nil
-	case deploymentpackage.FieldProjectUUID:
+	case extension.FieldUILabel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProjectUUID(v)
+		m.SetUILabel(v)
 		return nil
-	case deploymentpackage.FieldVersion:
+	case extension.FieldUIServiceName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetUIServiceName(v)
 		return nil
-	case deploymentpackage.FieldIsDeployed:
-		v, ok := value.(bool)
+	case extension.FieldUIDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDeployed(v)
+		m.SetUIDescription(v)
 		return nil
-	case deploymentpackage.FieldIsVisible:
-		v, ok := value.(bool)
+	case extension.FieldUIFileName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsVisible(v)
+		m.SetUIFileName(v)
 		return nil
-	case deploymentpackage.FieldAllowsMultipleDeployments:
-		v, ok := value.(bool)
+	case extension.FieldUIAppName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAllowsMultipleDeployments(v)
+		m.SetUIAppName(v)
 		return nil
-	case deploymentpackage.FieldKind:
+	case extension.FieldUIModuleName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKind(v)
+		m.SetUIModuleName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentPackage field %s", name)
+	return fmt.Errorf("unknown Extension field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentPackageMutation) AddedFields() []string {
+func (m *ExtensionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentPackageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExtensionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentPackageMutation) AddField(name string, value ent.Value) error {
+func (m *ExtensionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeploymentPackage numeric field %s", name)
+	return fmt.Errorf("unknown Extension numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentPackageMutation) ClearedFields() []string {
+func (m *ExtensionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(deploymentpackage.FieldDisplayName) {
-		fields = append(fields, deploymentpackage.FieldDisplayName)
+	if m.FieldCleared(extension.FieldDisplayName) {
+		fields = append(fields, extension.FieldDisplayName)
 	}
-	if m.FieldCleared(deploymentpackage.FieldDisplayNameLc) {
-		fields = append(fields, deploymentpackage.FieldDisplayNameLc)
+	if m.FieldCleared(extension.FieldDisplayNameLc) {
+		fields = append(fields, extension.FieldDisplayNameLc)
 	}
-	if m.FieldCleared(deploymentpackage.FieldDescription) {
-		fields = append(fields, deploymentpackage.FieldDescription)
+	if m.FieldCleared(extension.FieldDescription) {
+		fields = append(fields, extension.FieldDescription)
 	}
-	if m.FieldCleared(deploymentpackage.FieldIsDeployed) {
-		fields = append(fields, deploymentpackage.FieldIsDeployed)
+	if m.FieldCleared(extension.FieldUILabel) {
+		fields = append(fields, extension.FieldUILabel)
 	}
-	if m.FieldCleared(deploymentpackage.FieldIsVisible) {
-		fields = append(fields, deploymentpackage.FieldIsVisible)
+	if m.FieldCleared(extension.FieldUIServiceName) {
+		fields = append(fields, extension.FieldUIServiceName)
 	}
-	if m.FieldCleared(deploymentpackage.FieldAllowsMultipleDeployments) {
-		fields = append(fields, deploymentpackage.FieldAllowsMultipleDeployments)
+	if m.FieldCleared(extension.FieldUIDescription) {
+		fields = append(fields, extension.FieldUIDescription)
 	}
-	if m.FieldCleared(deploymentpackage.FieldKind) {
-		fields = append(fields, deploymentpackage.FieldKind)
+	if m.FieldCleared(extension.FieldUIFileName) {
+		fields = append(fields, extension.FieldUIFileName)
+	}
+	if m.FieldCleared(extension.FieldUIAppName) {
+		fields = append(fields, extension.FieldUIAppName)
+	}
+	if m.FieldCleared(extension.FieldUIModuleName) {
+		fields = append(fields, extension.FieldUIModuleName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeploymentPackageMutation) FieldCleared(name string) bool {
+func (m *ExtensionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeploymentPackageMutation) ClearField(name string) error {
+func (m *ExtensionMutation) ClearField(name string) error {
 	switch name {
-	case deploymentpackage.FieldDisplayName:
+	case extension.FieldDisplayName:
 		m.ClearDisplayName()
 		return nil
-	case deploymentpackage.FieldDisplayNameLc:
+	case extension.FieldDisplayNameLc:
 		m.ClearDisplayNameLc()
 		return nil
-	case deploymentpackage.FieldDescription:
+	case extension.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case deploymentpackage.FieldIsDeployed:
-		m.ClearIsDeployed()
+	case extension.FieldUILabel:
+		m.ClearUILabel()
 		return nil
-	case deploymentpackage.FieldIsVisible:
-		m.ClearIsVisible()
+	case extension.FieldUIServiceName:
+		m.ClearUIServiceName()
 		return nil
-	case deploymentpackage.FieldAllowsMultipleDeployments:
-		m.ClearAllowsMultipleDeployments()
+	case extension.FieldUIDescription:
+		m.ClearUIDescription()
 		return nil
-	case deploymentpackage.FieldKind:
-		m.ClearKind()
+	case extension.FieldUIFileName:
+		m.ClearUIFileName()
+		return nil
+	case extension.FieldUIAppName:
+		m.ClearUIAppName()
+		return nil
+	case extension.FieldUIModuleName:
+		m.ClearUIModuleName()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentPackage nullable field %s", name)
+	return fmt.Errorf("unknown Extension nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentPackageMutation) ResetField(name string) error {
+func (m *ExtensionMutation) ResetField(name string) error {
 	switch name {
-	case deploymentpackage.FieldName:
+	case extension.FieldName:
 		m.ResetName()
 		return nil
-	case deploymentpackage.FieldDisplayName:
+	case extension.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case extension.FieldDisplayName:
 		m.ResetDisplayName()
 		return nil
-	case deploymentpackage.FieldDisplayNameLc:
+	case extension.FieldDisplayNameLc:
 		m.ResetDisplayNameLc()
 		return nil
-	case deploymentpackage.FieldDescription:
+	case extension.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case deploymentpackage.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case deploymentpackage.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case deploymentpackage.FieldProjectUUID:
-		m.ResetProjectUUID()
+	case extension.FieldUILabel:
+		m.ResetUILabel()
 		return nil
-	case deploymentpackage.FieldVersion:
-		m.ResetVersion()
+	case extension.FieldUIServiceName:
+		m.ResetUIServiceName()
 		return nil
-	case deploymentpackage.FieldIsDeployed:
-		m.ResetIsDeployed()
+	case extension.FieldUIDescription:
+		m.ResetUIDescription()
 		return nil
-	case deploymentpackage.FieldIsVisible:
-		m.ResetIsVisible()
+	case extension.FieldUIFileName:
+		m.ResetUIFileName()
 		return nil
-	case deploymentpackage.FieldAllowsMultipleDeployments:
-		m.ResetAllowsMultipleDeployments()
+	case extension.FieldUIAppName:
+		m.ResetUIAppName()
 		return nil
-	case deploymentpackage.FieldKind:
-		m.ResetKind()
+	case extension.FieldUIModuleName:
+		m.ResetUIModuleName()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentPackage field %s", name)
+	return fmt.Errorf("unknown Extension field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentPackageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.deployment_profiles != nil {
-		edges = append(edges, deploymentpackage.EdgeDeploymentProfiles)
-	}
-	if m.applications != nil {
-		edges = append(edges, deploymentpackage.EdgeApplications)
-	}
-	if m.icon != nil {
-		edges = append(edges, deploymentpackage.EdgeIcon)
-	}
-	if m.thumbnail != nil {
-		edges = append(edges, deploymentpackage.EdgeThumbnail)
-	}
-	if m.default_profile != nil {
-		edges = append(edges, deploymentpackage.EdgeDefaultProfile)
-	}
-	if m.application_dependencies != nil {
-		edges = append(edges, deploymentpackage.EdgeApplicationDependencies)
-	}
-	if m.application_namespaces != nil {
-		edges = append(edges, deploymentpackage.EdgeApplicationNamespaces)
-	}
-	if m.namespaces != nil {
-		edges = append(edges, deploymentpackage.EdgeNamespaces)
-	}
-	if m.extensions != nil {
-		edges = append(edges, deploymentpackage.EdgeExtensions)
+func (m *ExtensionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.endpoints != nil {
+		edges = append(edges, extension.EdgeEndpoints)
 	}
-	if m.artifacts != nil {
-		edges = append(edges, deploymentpackage.EdgeArtifacts)
+	if m.deployment_package_fk != nil {
+		edges = append(edges, extension.EdgeDeploymentPackageFk)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentPackageMutation) AddedIDs(name string) []ent.Value {
+func (m *ExtensionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentpackage.EdgeDeploymentProfiles:
-		ids := make([]ent.Value, 0, len(m.deployment_profiles))
-		for id := range m.deployment_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.applications))
-		for id := range m.applications {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeIcon:
-		ids := make([]ent.Value, 0, len(m.icon))
-		for id := range m.icon {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeThumbnail:
-		ids := make([]ent.Value, 0, len(m.thumbnail))
-		for id := range m.thumbnail {
+	case extension.EdgeEndpoints:
+		ids := make([]ent.Value, 0, len(m.endpoints))
+		for id := range m.endpoints {
 			ids = append(ids, id)
 		}
 		return ids
-	case deploymentpackage.EdgeDefaultProfile:
-		if id := m.default_profile; id != nil {
+	case extension.EdgeDeploymentPackageFk:
+		if id := m.deployment_package_fk; id != nil {
 			return []ent.Value{*id}
 		}
-	case deploymentpackage.EdgeApplicationDependencies:
-		ids := make([]ent.Value, 0, len(m.application_dependencies))
-		for id := range m.application_dependencies {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeApplicationNamespaces:
-		ids := make([]ent.Value, 0, len(m.application_namespaces))
-		for id := range m.application_namespaces {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeNamespaces:
-		ids := make([]ent.Value, 0, len(m.namespaces))
-		for id := range m.namespaces {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeExtensions:
-		ids := make([]ent.Value, 0, len(m.extensions))
-		for id := range m.extensions {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeArtifacts:
-		ids := make([]ent.Value, 0, len(m.artifacts))
-		for id := range m.artifacts {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentPackageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.removeddeployment_profiles != nil {
-		edges = append(edges, deploymentpackage.EdgeDeploymentProfiles)
-	}
-	if m.removedapplications != nil {
-		edges = append(edges, deploymentpackage.EdgeApplications)
-	}
-	if m.removedicon != nil {
-		edges = append(edges, deploymentpackage.EdgeIcon)
-	}
-	if m.removedthumbnail != nil {
-		edges = append(edges, deploymentpackage.EdgeThumbnail)
-	}
-	if m.removedapplication_dependencies != nil {
-		edges = append(edges, deploymentpackage.EdgeApplicationDependencies)
-	}
-	if m.removedapplication_namespaces != nil {
-		edges = append(edges, deploymentpackage.EdgeApplicationNamespaces)
-	}
-	if m.removednamespaces != nil {
-		edges = append(edges, deploymentpackage.EdgeNamespaces)
-	}
-	if m.removedextensions != nil {
-		edges = append(edges, deploymentpackage.EdgeExtensions)
-	}
-	if m.removedartifacts != nil {
-		edges = append(edges, deploymentpackage.EdgeArtifacts)
+func (m *ExtensionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedendpoints != nil {
+		edges = append(edges, extension.EdgeEndpoints)
 	}
 	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DeploymentPackageMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case deploymentpackage.EdgeDeploymentProfiles:
-		ids := make([]ent.Value, 0, len(m.removeddeployment_profiles))
-		for id := range m.removeddeployment_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.removedapplications))
-		for id := range m.removedapplications {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeIcon:
-		ids := make([]ent.Value, 0, len(m.removedicon))
-		for id := range m.removedicon {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeThumbnail:
-		ids := make([]ent.Value, 0, len(m.removedthumbnail))
-		for id := range m.removedthumbnail {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeApplicationDependencies:
-		ids := make([]ent.Value, 0, len(m.removedapplication_dependencies))
-		for id := range m.removedapplication_dependencies {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeApplicationNamespaces:
-		ids := make([]ent.Value, 0, len(m.removedapplication_namespaces))
-		for id := range m.removedapplication_namespaces {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeNamespaces:
-		ids := make([]ent.Value, 0, len(m.removednamespaces))
-		for id := range m.removednamespaces {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeExtensions:
-		ids := make([]ent.Value, 0, len(m.removedextensions))
-		for id := range m.removedextensions {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentpackage.EdgeArtifacts:
-		ids := make([]ent.Value, 0, len(m.removedartifacts))
-		for id := range m.removedartifacts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentPackageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.cleareddeployment_profiles {
-		edges = append(edges, deploymentpackage.EdgeDeploymentProfiles)
-	}
-	if m.clearedapplications {
-		edges = append(edges, deploymentpackage.EdgeApplications)
-	}
-	if m.clearedicon {
-		edges = append(edges, deploymentpackage.EdgeIcon)
-	}
-	if m.clearedthumbnail {
-		edges = append(edges, deploymentpackage.EdgeThumbnail)
-	}
-	if m.cleareddefault_profile {
-		edges = append(edges, deploymentpackage.EdgeDefaultProfile)
-	}
-	if m.clearedapplication_dependencies {
-		edges = append(edges, deploymentpackage.EdgeApplicationDependencies)
-	}
-	if m.clearedapplication_namespaces {
-		edges = append(edges, deploymentpackage.EdgeApplicationNamespaces)
-	}
-	if m.clearednamespaces {
-		edges = append(edges, deploymentpackage.EdgeNamespaces)
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtensionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case extension.EdgeEndpoints:
+		ids := make([]ent.Value, 0, len(m.removedendpoints))
+		for id := range m.removedendpoints {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	if m.clearedextensions {
-		edges = append(edges, deploymentpackage.EdgeExtensions)
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtensionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedendpoints {
+		edges = append(edges, extension.EdgeEndpoints)
 	}
-	if m.clearedartifacts {
-		edges = append(edges, deploymentpackage.EdgeArtifacts)
+	if m.cleareddeployment_package_fk {
+		edges = append(edges, extension.EdgeDeploymentPackageFk)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentPackageMutation) EdgeCleared(name string) bool {
+func (m *ExtensionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deploymentpackage.EdgeDeploymentProfiles:
-		return m.cleareddeployment_profiles
-	case deploymentpackage.EdgeApplications:
-		return m.clearedapplications
-	case deploymentpackage.EdgeIcon:
-		return m.clearedicon
-	case deploymentpackage.EdgeThumbnail:
-		return m.clearedthumbnail
-	case deploymentpackage.EdgeDefaultProfile:
-		return m.cleareddefault_profile
-	case deploymentpackage.EdgeApplicationDependencies:
-		return m.clearedapplication_dependencies
-	case deploymentpackage.EdgeApplicationNamespaces:
-		return m.clearedapplication_namespaces
-	case deploymentpackage.EdgeNamespaces:
-		return m.clearednamespaces
-	case deploymentpackage.EdgeExtensions:
-		return m.clearedextensions
-	case deploymentpackage.EdgeArtifacts:
-		return m.clearedartifacts
+	case extension.EdgeEndpoints:
+		return m.clearedendpoints
+	case extension.EdgeDeploymentPackageFk:
+		return m.cleareddeployment_package_fk
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentPackageMutation) ClearEdge(name string) error {
+func (m *ExtensionMutation) ClearEdge(name string) error {
 	switch name {
-	case deploymentpackage.EdgeDefaultProfile:
-		m.ClearDefaultProfile()
+	case extension.EdgeDeploymentPackageFk:
+		m.ClearDeploymentPackageFk()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentPackage unique edge %s", name)
+	return fmt.Errorf("unknown Extension unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentPackageMutation) ResetEdge(name string) error {
+func (m *ExtensionMutation) ResetEdge(name string) error {
 	switch name {
-	case deploymentpackage.EdgeDeploymentProfiles:
-		m.ResetDeploymentProfiles()
-		return nil
-	case deploymentpackage.EdgeApplications:
-		m.ResetApplications()
-		return nil
-	case deploymentpackage.EdgeIcon:
-		m.ResetIcon()
-		return nil
-	case deploymentpackage.EdgeThumbnail:
-		m.ResetThumbnail()
-		return nil
-	case deploymentpackage.EdgeDefaultProfile:
-		m.ResetDefaultProfile()
-		return nil
-	case deploymentpackage.EdgeApplicationDependencies:
-		m.ResetApplicationDependencies()
-		return nil
-	case deploymentpackage.EdgeApplicationNamespaces:
-		m.ResetApplicationNamespaces()
-		return nil
-	case deploymentpackage.EdgeNamespaces:
-		m.ResetNamespaces()
-		return nil
-	case deploymentpackage.EdgeExtensions:
-		m.ResetExtensions()
+	case extension.EdgeEndpoints:
+		m.ResetEndpoints()
 		return nil
-	case deploymentpackage.EdgeArtifacts:
-		m.ResetArtifacts()
+	case extension.EdgeDeploymentPackageFk:
+		m.ResetDeploymentPackageFk()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentPackage edge %s", name)
+	return fmt.Errorf("unknown Extension edge %s", name)
 }
 
-// DeploymentProfileMutation represents an operation that mutates the DeploymentProfile nodes in the graph.
-type DeploymentProfileMutation struct {
+// IgnoredResourceMutation represents an operation that mutates the IgnoredResource nodes in the graph.
+type IgnoredResourceMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uint64
-	name                         *string
-	display_name                 *string
-	display_name_lc              *string
-	description                  *string
-	create_time                  *time.Time
-	update_time                  *time.Time
-	clearedFields                map[string]struct{}
-	profiles                     map[uint64]struct{}
-	removedprofiles              map[uint64]struct{}
-	clearedprofiles              bool
-	deployment_package_fk        *uint64
-	cleareddeployment_package_fk bool
-	done                         bool
-	oldValue                     func(context.Context) (*DeploymentProfile, error)
-	predicates                   []predicate.DeploymentProfile
+	op                    Op
+	typ                   string
+	id                    *uint64
+	name                  *string
+	kind                  *string
+	namespace             *string
+	clearedFields         map[string]struct{}
+	application_fk        *uint64
+	clearedapplication_fk bool
+	done                  bool
+	oldValue              func(context.Context) (*IgnoredResource, error)
+	predicates            []predicate.IgnoredResource
 }
 
-var _ ent.Mutation = (*DeploymentProfileMutation)(nil)
+var _ ent.Mutation = (*IgnoredResourceMutation)(nil)
 
-// deploymentprofileOption allows management of the mutation configuration using functional options.
-type deploymentprofileOption func(*DeploymentProfileMutation)
+// ignoredresourceOption allows management of the mutation configuration using functional options.
+type ignoredresourceOption func(*IgnoredResourceMutation)
 
-// newDeploymentProfileMutation creates new mutation for the DeploymentProfile entity.
-func newDeploymentProfileMutation(c config, op Op, opts ...deploymentprofileOption) *DeploymentProfileMutation {
-	m := &DeploymentProfileMutation{
+// newIgnoredResourceMutation creates new mutation for the IgnoredResource entity.
+func newIgnoredResourceMutation(c config, op Op, opts ...ignoredresourceOption) *IgnoredResourceMutation {
+	m := &IgnoredResourceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentProfile,
+		typ:           TypeIgnoredResource,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6523,20 +12239,20 @@ func newDeploymentProfileMutation(c config, op Op, opts ...deploymentprofileOpti
 	return m
 }
 
-// withDeploymentProfileID sets the ID field of the mutation.
-func withDeploymentProfileID(id uint64) deploymentprofileOption {
-	return func(m *DeploymentProfileMutation) {
+// withIgnoredResourceID sets the ID field of the mutation.
+func withIgnoredResourceID(id uint64) ignoredresourceOption {
+	return func(m *IgnoredResourceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentProfile
+			value *IgnoredResource
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentProfile, error) {
+		m.oldValue = func(ctx context.Context) (*IgnoredResource, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentProfile.Get(ctx, id)
+					value, err = m.Client().IgnoredResource.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6545,10 +12261,10 @@ func withDeploymentProfileID(id uint64) deploymentprofileOption {
 	}
 }
 
-// withDeploymentProfile sets the old DeploymentProfile of the mutation.
-func withDeploymentProfile(node *DeploymentProfile) deploymentprofileOption {
-	return func(m *DeploymentProfileMutation) {
-		m.oldValue = func(context.Context) (*DeploymentProfile, error) {
+// withIgnoredResource sets the old IgnoredResource of the mutation.
+func withIgnoredResource(node *IgnoredResource) ignoredresourceOption {
+	return func(m *IgnoredResourceMutation) {
+		m.oldValue = func(context.Context) (*IgnoredResource, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6557,7 +12273,7 @@ func withDeploymentProfile(node *DeploymentProfile) deploymentprofileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentProfileMutation) Client() *Client {
+func (m IgnoredResourceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6565,7 +12281,7 @@ func (m DeploymentProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentProfileMutation) Tx() (*Tx, error) {
+func (m IgnoredResourceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -6576,7 +12292,7 @@ func (m DeploymentProfileMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentProfileMutation) ID() (id uint64, exists bool) {
+func (m *IgnoredResourceMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6587,7 +12303,7 @@ func (m *DeploymentProfileMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentProfileMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *IgnoredResourceMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6596,19 +12312,19 @@ func (m *DeploymentProfileMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentProfile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().IgnoredResource.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *DeploymentProfileMutation) SetName(s string) {
+func (m *IgnoredResourceMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *DeploymentProfileMutation) Name() (r string, exists bool) {
+func (m *IgnoredResourceMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -6616,318 +12332,547 @@ func (m *DeploymentProfileMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the IgnoredResource entity.
+// If the IgnoredResource object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *IgnoredResourceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *IgnoredResourceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *IgnoredResourceMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *IgnoredResourceMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the IgnoredResource entity.
+// If the IgnoredResource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IgnoredResourceMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *IgnoredResourceMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *IgnoredResourceMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *IgnoredResourceMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the IgnoredResource entity.
+// If the IgnoredResource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IgnoredResourceMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Namespace, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DeploymentProfileMutation) ResetName() {
-	m.name = nil
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *IgnoredResourceMutation) ResetNamespace() {
+	m.namespace = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *DeploymentProfileMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetApplicationFkID sets the "application_fk" edge to the Application entity by id.
+func (m *IgnoredResourceMutation) SetApplicationFkID(id uint64) {
+	m.application_fk = &id
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *DeploymentProfileMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearApplicationFk clears the "application_fk" edge to the Application entity.
+func (m *IgnoredResourceMutation) ClearApplicationFk() {
+	m.clearedapplication_fk = true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldDisplayName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+// ApplicationFkCleared reports if the "application_fk" edge to the Application entity was cleared.
+func (m *IgnoredResourceMutation) ApplicationFkCleared() bool {
+	return m.clearedapplication_fk
+}
+
+// ApplicationFkID returns the "application_fk" edge ID in the mutation.
+func (m *IgnoredResourceMutation) ApplicationFkID() (id uint64, exists bool) {
+	if m.application_fk != nil {
+		return *m.application_fk, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	return
+}
+
+// ApplicationFkIDs returns the "application_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApplicationFkID instead. It exists only for internal usage by the builders.
+func (m *IgnoredResourceMutation) ApplicationFkIDs() (ids []uint64) {
+	if id := m.application_fk; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.DisplayName, nil
+	return
 }
 
-// ClearDisplayName clears the value of the "display_name" field.
-func (m *DeploymentProfileMutation) ClearDisplayName() {
-	m.display_name = nil
-	m.clearedFields[deploymentprofile.FieldDisplayName] = struct{}{}
+// ResetApplicationFk resets all changes to the "application_fk" edge.
+func (m *IgnoredResourceMutation) ResetApplicationFk() {
+	m.application_fk = nil
+	m.clearedapplication_fk = false
 }
 
-// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldDisplayName]
-	return ok
+// Where appends a list predicates to the IgnoredResourceMutation builder.
+func (m *IgnoredResourceMutation) Where(ps ...predicate.IgnoredResource) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *DeploymentProfileMutation) ResetDisplayName() {
-	m.display_name = nil
-	delete(m.clearedFields, deploymentprofile.FieldDisplayName)
+// WhereP appends storage-level predicates to the IgnoredResourceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IgnoredResourceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IgnoredResource, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetDisplayNameLc sets the "display_name_lc" field.
-func (m *DeploymentProfileMutation) SetDisplayNameLc(s string) {
-	m.display_name_lc = &s
+// Op returns the operation name.
+func (m *IgnoredResourceMutation) Op() Op {
+	return m.op
 }
 
-// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *DeploymentProfileMutation) DisplayNameLc() (r string, exists bool) {
-	v := m.display_name_lc
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *IgnoredResourceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IgnoredResource).
+func (m *IgnoredResourceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IgnoredResourceMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, ignoredresource.FieldName)
 	}
-	return *v, true
+	if m.kind != nil {
+		fields = append(fields, ignoredresource.FieldKind)
+	}
+	if m.namespace != nil {
+		fields = append(fields, ignoredresource.FieldNamespace)
+	}
+	return fields
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IgnoredResourceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ignoredresource.FieldName:
+		return m.Name()
+	case ignoredresource.FieldKind:
+		return m.Kind()
+	case ignoredresource.FieldNamespace:
+		return m.Namespace()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IgnoredResourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ignoredresource.FieldName:
+		return m.OldName(ctx)
+	case ignoredresource.FieldKind:
+		return m.OldKind(ctx)
+	case ignoredresource.FieldNamespace:
+		return m.OldNamespace(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+	return nil, fmt.Errorf("unknown IgnoredResource field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IgnoredResourceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ignoredresource.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ignoredresource.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ignoredresource.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
 	}
-	return oldValue.DisplayNameLc, nil
+	return fmt.Errorf("unknown IgnoredResource field %s", name)
 }
 
-// ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *DeploymentProfileMutation) ClearDisplayNameLc() {
-	m.display_name_lc = nil
-	m.clearedFields[deploymentprofile.FieldDisplayNameLc] = struct{}{}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IgnoredResourceMutation) AddedFields() []string {
+	return nil
 }
 
-// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldDisplayNameLc]
-	return ok
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IgnoredResourceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *DeploymentProfileMutation) ResetDisplayNameLc() {
-	m.display_name_lc = nil
-	delete(m.clearedFields, deploymentprofile.FieldDisplayNameLc)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IgnoredResourceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IgnoredResource numeric field %s", name)
 }
 
-// SetDescription sets the "description" field.
-func (m *DeploymentProfileMutation) SetDescription(s string) {
-	m.description = &s
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IgnoredResourceMutation) ClearedFields() []string {
+	return nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DeploymentProfileMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IgnoredResourceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IgnoredResourceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IgnoredResource nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IgnoredResourceMutation) ResetField(name string) error {
+	switch name {
+	case ignoredresource.FieldName:
+		m.ResetName()
+		return nil
+	case ignoredresource.FieldKind:
+		m.ResetKind()
+		return nil
+	case ignoredresource.FieldNamespace:
+		m.ResetNamespace()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown IgnoredResource field %s", name)
 }
 
-// OldDescription returns the old "description" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IgnoredResourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.application_fk != nil {
+		edges = append(edges, ignoredresource.EdgeApplicationFk)
 	}
-	return oldValue.Description, nil
+	return edges
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *DeploymentProfileMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[deploymentprofile.FieldDescription] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IgnoredResourceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ignoredresource.EdgeApplicationFk:
+		if id := m.application_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *DeploymentProfileMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[deploymentprofile.FieldDescription]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IgnoredResourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DeploymentProfileMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, deploymentprofile.FieldDescription)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IgnoredResourceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *DeploymentProfileMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IgnoredResourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapplication_fk {
+		edges = append(edges, ignoredresource.EdgeApplicationFk)
+	}
+	return edges
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DeploymentProfileMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IgnoredResourceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ignoredresource.EdgeApplicationFk:
+		return m.clearedapplication_fk
 	}
-	return *v, true
+	return false
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IgnoredResourceMutation) ClearEdge(name string) error {
+	switch name {
+	case ignoredresource.EdgeApplicationFk:
+		m.ClearApplicationFk()
+		return nil
 	}
-	return oldValue.CreateTime, nil
+	return fmt.Errorf("unknown IgnoredResource unique edge %s", name)
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *DeploymentProfileMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IgnoredResourceMutation) ResetEdge(name string) error {
+	switch name {
+	case ignoredresource.EdgeApplicationFk:
+		m.ResetApplicationFk()
+		return nil
+	}
+	return fmt.Errorf("unknown IgnoredResource edge %s", name)
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *DeploymentProfileMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// NamespaceMutation represents an operation that mutates the Namespace nodes in the graph.
+type NamespaceMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uint64
+	name                         *string
+	clearedFields                map[string]struct{}
+	deployment_package_fk        *uint64
+	cleareddeployment_package_fk bool
+	adornments                   map[uint64]struct{}
+	removedadornments            map[uint64]struct{}
+	clearedadornments            bool
+	done                         bool
+	oldValue                     func(context.Context) (*Namespace, error)
+	predicates                   []predicate.Namespace
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DeploymentProfileMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*NamespaceMutation)(nil)
 
-// OldUpdateTime returns the old "update_time" field's value of the DeploymentProfile entity.
-// If the DeploymentProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeploymentProfileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+// namespaceOption allows management of the mutation configuration using functional options.
+type namespaceOption func(*NamespaceMutation)
+
+// newNamespaceMutation creates new mutation for the Namespace entity.
+func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMutation {
+	m := &NamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNamespace,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.UpdateTime, nil
+	return m
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DeploymentProfileMutation) ResetUpdateTime() {
-	m.update_time = nil
+// withNamespaceID sets the ID field of the mutation.
+func withNamespaceID(id uint64) namespaceOption {
+	return func(m *NamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Namespace
+		)
+		m.oldValue = func(ctx context.Context) (*Namespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Namespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// AddProfileIDs adds the "profiles" edge to the Profile entity by ids.
-func (m *DeploymentProfileMutation) AddProfileIDs(ids ...uint64) {
-	if m.profiles == nil {
-		m.profiles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.profiles[ids[i]] = struct{}{}
+// withNamespace sets the old Namespace of the mutation.
+func withNamespace(node *Namespace) namespaceOption {
+	return func(m *NamespaceMutation) {
+		m.oldValue = func(context.Context) (*Namespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// ClearProfiles clears the "profiles" edge to the Profile entity.
-func (m *DeploymentProfileMutation) ClearProfiles() {
-	m.clearedprofiles = true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ProfilesCleared reports if the "profiles" edge to the Profile entity was cleared.
-func (m *DeploymentProfileMutation) ProfilesCleared() bool {
-	return m.clearedprofiles
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// RemoveProfileIDs removes the "profiles" edge to the Profile entity by IDs.
-func (m *DeploymentProfileMutation) RemoveProfileIDs(ids ...uint64) {
-	if m.removedprofiles == nil {
-		m.removedprofiles = make(map[uint64]struct{})
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NamespaceMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.profiles, ids[i])
-		m.removedprofiles[ids[i]] = struct{}{}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NamespaceMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Namespace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// RemovedProfiles returns the removed IDs of the "profiles" edge to the Profile entity.
-func (m *DeploymentProfileMutation) RemovedProfilesIDs() (ids []uint64) {
-	for id := range m.removedprofiles {
-		ids = append(ids, id)
+// SetName sets the "name" field.
+func (m *NamespaceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NamespaceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ProfilesIDs returns the "profiles" edge IDs in the mutation.
-func (m *DeploymentProfileMutation) ProfilesIDs() (ids []uint64) {
-	for id := range m.profiles {
-		ids = append(ids, id)
+// OldName returns the old "name" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ResetProfiles resets all changes to the "profiles" edge.
-func (m *DeploymentProfileMutation) ResetProfiles() {
-	m.profiles = nil
-	m.clearedprofiles = false
-	m.removedprofiles = nil
+// ResetName resets all changes to the "name" field.
+func (m *NamespaceMutation) ResetName() {
+	m.name = nil
 }
 
 // SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
-func (m *DeploymentProfileMutation) SetDeploymentPackageFkID(id uint64) {
+func (m *NamespaceMutation) SetDeploymentPackageFkID(id uint64) {
 	m.deployment_package_fk = &id
 }
 
 // ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
-func (m *DeploymentProfileMutation) ClearDeploymentPackageFk() {
+func (m *NamespaceMutation) ClearDeploymentPackageFk() {
 	m.cleareddeployment_package_fk = true
 }
 
 // DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
-func (m *DeploymentProfileMutation) DeploymentPackageFkCleared() bool {
+func (m *NamespaceMutation) DeploymentPackageFkCleared() bool {
 	return m.cleareddeployment_package_fk
 }
 
 // DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
-func (m *DeploymentProfileMutation) DeploymentPackageFkID() (id uint64, exists bool) {
+func (m *NamespaceMutation) DeploymentPackageFkID() (id uint64, exists bool) {
 	if m.deployment_package_fk != nil {
 		return *m.deployment_package_fk, true
 	}
@@ -6937,7 +12882,7 @@ func (m *DeploymentProfileMutation) DeploymentPackageFkID() (id uint64, exists b
 // DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // DeploymentPackageFkID instead. It exists only for internal usage by the builders.
-func (m *DeploymentProfileMutation) DeploymentPackageFkIDs() (ids []uint64) {
+func (m *NamespaceMutation) DeploymentPackageFkIDs() (ids []uint64) {
 	if id := m.deployment_package_fk; id != nil {
 		ids = append(ids, *id)
 	}
@@ -6945,20 +12890,74 @@ func (m *DeploymentProfileMutation) DeploymentPackageFkIDs() (ids []uint64) {
 }
 
 // ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
-func (m *DeploymentProfileMutation) ResetDeploymentPackageFk() {
+func (m *NamespaceMutation) ResetDeploymentPackageFk() {
 	m.deployment_package_fk = nil
 	m.cleareddeployment_package_fk = false
 }
 
-// Where appends a list predicates to the DeploymentProfileMutation builder.
-func (m *DeploymentProfileMutation) Where(ps ...predicate.DeploymentProfile) {
+// AddAdornmentIDs adds the "adornments" edge to the NamespaceAdornment entity by ids.
+func (m *NamespaceMutation) AddAdornmentIDs(ids ...uint64) {
+	if m.adornments == nil {
+		m.adornments = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.adornments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAdornments clears the "adornments" edge to the NamespaceAdornment entity.
+func (m *NamespaceMutation) ClearAdornments() {
+	m.clearedadornments = true
+}
+
+// AdornmentsCleared reports if the "adornments" edge to the NamespaceAdornment entity was cleared.
+func (m *NamespaceMutation) AdornmentsCleared() bool {
+	return m.clearedadornments
+}
+
+// RemoveAdornmentIDs removes the "adornments" edge to the NamespaceAdornment entity by IDs.
+func (m *NamespaceMutation) RemoveAdornmentIDs(ids ...uint64) {
+	if m.removedadornments == nil {
+		m.removedadornments = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.adornments, ids[i])
+		m.removedadornments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAdornments returns the removed IDs of the "adornments" edge to the NamespaceAdornment entity.
+func (m *NamespaceMutation) RemovedAdornmentsIDs() (ids []uint64) {
+	for id := range m.removedadornments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AdornmentsIDs returns the "adornments" edge IDs in the mutation.
+func (m *NamespaceMutation) AdornmentsIDs() (ids []uint64) {
+	for id := range m.adornments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAdornments resets all changes to the "adornments" edge.
+func (m *NamespaceMutation) ResetAdornments() {
+	m.adornments = nil
+	m.clearedadornments = false
+	m.removedadornments = nil
+}
+
+// Where appends a list predicates to the NamespaceMutation builder.
+func (m *NamespaceMutation) Where(ps ...predicate.Namespace) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NamespaceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentProfile, len(ps))
+func (m *NamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Namespace, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6966,42 +12965,27 @@ func (m *DeploymentProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentProfileMutation) Op() Op {
+func (m *NamespaceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentProfileMutation) SetOp(op Op) {
+func (m *NamespaceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentProfile).
-func (m *DeploymentProfileMutation) Type() string {
+// Type returns the node type of this mutation (Namespace).
+func (m *NamespaceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentProfileMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *NamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 1)
 	if m.name != nil {
-		fields = append(fields, deploymentprofile.FieldName)
-	}
-	if m.display_name != nil {
-		fields = append(fields, deploymentprofile.FieldDisplayName)
-	}
-	if m.display_name_lc != nil {
-		fields = append(fields, deploymentprofile.FieldDisplayNameLc)
-	}
-	if m.description != nil {
-		fields = append(fields, deploymentprofile.FieldDescription)
-	}
-	if m.create_time != nil {
-		fields = append(fields, deploymentprofile.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, deploymentprofile.FieldUpdateTime)
+		fields = append(fields, namespace.FieldName)
 	}
 	return fields
 }
@@ -7009,20 +12993,10 @@ func (m *DeploymentProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deploymentprofile.FieldName:
+	case namespace.FieldName:
 		return m.Name()
-	case deploymentprofile.FieldDisplayName:
-		return m.DisplayName()
-	case deploymentprofile.FieldDisplayNameLc:
-		return m.DisplayNameLc()
-	case deploymentprofile.FieldDescription:
-		return m.Description()
-	case deploymentprofile.FieldCreateTime:
-		return m.CreateTime()
-	case deploymentprofile.FieldUpdateTime:
-		return m.UpdateTime()
 	}
 	return nil, false
 }
@@ -7030,209 +13004,128 @@ func (m *DeploymentProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deploymentprofile.FieldName:
+	case namespace.FieldName:
 		return m.OldName(ctx)
-	case deploymentprofile.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case deploymentprofile.FieldDisplayNameLc:
-		return m.OldDisplayNameLc(ctx)
-	case deploymentprofile.FieldDescription:
-		return m.OldDescription(ctx)
-	case deploymentprofile.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case deploymentprofile.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeploymentProfile field %s", name)
+	return nil, fmt.Errorf("unknown Namespace field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentProfileMutation) SetField(name string, value ent.Value) error {
+func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deploymentprofile.FieldName:
+	case namespace.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case deploymentprofile.FieldDisplayName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayName(v)
-		return nil
-	case deploymentprofile.FieldDisplayNameLc:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayNameLc(v)
-		return nil
-	case deploymentprofile.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case deploymentprofile.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case deploymentprofile.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+	return fmt.Errorf("unknown Namespace field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentProfileMutation) AddedFields() []string {
+func (m *NamespaceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NamespaceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentProfileMutation) AddField(name string, value ent.Value) error {
+func (m *NamespaceMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeploymentProfile numeric field %s", name)
+	return fmt.Errorf("unknown Namespace numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentProfileMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(deploymentprofile.FieldDisplayName) {
-		fields = append(fields, deploymentprofile.FieldDisplayName)
-	}
-	if m.FieldCleared(deploymentprofile.FieldDisplayNameLc) {
-		fields = append(fields, deploymentprofile.FieldDisplayNameLc)
-	}
-	if m.FieldCleared(deploymentprofile.FieldDescription) {
-		fields = append(fields, deploymentprofile.FieldDescription)
-	}
-	return fields
+func (m *NamespaceMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeploymentProfileMutation) FieldCleared(name string) bool {
+func (m *NamespaceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeploymentProfileMutation) ClearField(name string) error {
-	switch name {
-	case deploymentprofile.FieldDisplayName:
-		m.ClearDisplayName()
-		return nil
-	case deploymentprofile.FieldDisplayNameLc:
-		m.ClearDisplayNameLc()
-		return nil
-	case deploymentprofile.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown DeploymentProfile nullable field %s", name)
+func (m *NamespaceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Namespace nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentProfileMutation) ResetField(name string) error {
+func (m *NamespaceMutation) ResetField(name string) error {
 	switch name {
-	case deploymentprofile.FieldName:
+	case namespace.FieldName:
 		m.ResetName()
 		return nil
-	case deploymentprofile.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case deploymentprofile.FieldDisplayNameLc:
-		m.ResetDisplayNameLc()
-		return nil
-	case deploymentprofile.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case deploymentprofile.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case deploymentprofile.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile field %s", name)
+	return fmt.Errorf("unknown Namespace field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentProfileMutation) AddedEdges() []string {
+func (m *NamespaceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.profiles != nil {
-		edges = append(edges, deploymentprofile.EdgeProfiles)
-	}
 	if m.deployment_package_fk != nil {
-		edges = append(edges, deploymentprofile.EdgeDeploymentPackageFk)
+		edges = append(edges, namespace.EdgeDeploymentPackageFk)
+	}
+	if m.adornments != nil {
+		edges = append(edges, namespace.EdgeAdornments)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *NamespaceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentprofile.EdgeProfiles:
-		ids := make([]ent.Value, 0, len(m.profiles))
-		for id := range m.profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case deploymentprofile.EdgeDeploymentPackageFk:
+	case namespace.EdgeDeploymentPackageFk:
 		if id := m.deployment_package_fk; id != nil {
 			return []ent.Value{*id}
 		}
+	case namespace.EdgeAdornments:
+		ids := make([]ent.Value, 0, len(m.adornments))
+		for id := range m.adornments {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentProfileMutation) RemovedEdges() []string {
+func (m *NamespaceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedprofiles != nil {
-		edges = append(edges, deploymentprofile.EdgeProfiles)
+	if m.removedadornments != nil {
+		edges = append(edges, namespace.EdgeAdornments)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *NamespaceMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentprofile.EdgeProfiles:
-		ids := make([]ent.Value, 0, len(m.removedprofiles))
-		for id := range m.removedprofiles {
+	case namespace.EdgeAdornments:
+		ids := make([]ent.Value, 0, len(m.removedadornments))
+		for id := range m.removedadornments {
 			ids = append(ids, id)
 		}
 		return ids
@@ -7241,83 +13134,82 @@ func (m *DeploymentProfileMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentProfileMutation) ClearedEdges() []string {
+func (m *NamespaceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedprofiles {
-		edges = append(edges, deploymentprofile.EdgeProfiles)
-	}
 	if m.cleareddeployment_package_fk {
-		edges = append(edges, deploymentprofile.EdgeDeploymentPackageFk)
+		edges = append(edges, namespace.EdgeDeploymentPackageFk)
+	}
+	if m.clearedadornments {
+		edges = append(edges, namespace.EdgeAdornments)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentProfileMutation) EdgeCleared(name string) bool {
+func (m *NamespaceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deploymentprofile.EdgeProfiles:
-		return m.clearedprofiles
-	case deploymentprofile.EdgeDeploymentPackageFk:
+	case namespace.EdgeDeploymentPackageFk:
 		return m.cleareddeployment_package_fk
+	case namespace.EdgeAdornments:
+		return m.clearedadornments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentProfileMutation) ClearEdge(name string) error {
+func (m *NamespaceMutation) ClearEdge(name string) error {
 	switch name {
-	case deploymentprofile.EdgeDeploymentPackageFk:
+	case namespace.EdgeDeploymentPackageFk:
 		m.ClearDeploymentPackageFk()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile unique edge %s", name)
+	return fmt.Errorf("unknown Namespace unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentProfileMutation) ResetEdge(name string) error {
+func (m *NamespaceMutation) ResetEdge(name string) error {
 	switch name {
-	case deploymentprofile.EdgeProfiles:
-		m.ResetProfiles()
-		return nil
-	case deploymentprofile.EdgeDeploymentPackageFk:
+	case namespace.EdgeDeploymentPackageFk:
 		m.ResetDeploymentPackageFk()
 		return nil
+	case namespace.EdgeAdornments:
+		m.ResetAdornments()
+		return nil
 	}
-	return fmt.Errorf("unknown DeploymentProfile edge %s", name)
+	return fmt.Errorf("unknown Namespace edge %s", name)
 }
 
-// DeploymentRequirementMutation represents an operation that mutates the DeploymentRequirement nodes in the graph.
-type DeploymentRequirementMutation struct {
+// NamespaceAdornmentMutation represents an operation that mutates the NamespaceAdornment nodes in the graph.
+type NamespaceAdornmentMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uint64
-	clearedFields                map[string]struct{}
-	profile_fk                   *uint64
-	clearedprofile_fk            bool
-	deployment_package_fk        *uint64
-	cleareddeployment_package_fk bool
-	deployment_profile_fk        *uint64
-	cleareddeployment_profile_fk bool
-	done                         bool
-	oldValue                     func(context.Context) (*DeploymentRequirement, error)
-	predicates                   []predicate.DeploymentRequirement
+	op                  Op
+	typ                 string
+	id                  *uint64
+	_type               *string
+	key                 *string
+	value               *string
+	clearedFields       map[string]struct{}
+	namespace_fk        *uint64
+	clearednamespace_fk bool
+	done                bool
+	oldValue            func(context.Context) (*NamespaceAdornment, error)
+	predicates          []predicate.NamespaceAdornment
 }
 
-var _ ent.Mutation = (*DeploymentRequirementMutation)(nil)
+var _ ent.Mutation = (*NamespaceAdornmentMutation)(nil)
 
-// deploymentrequirementOption allows management of the mutation configuration using functional options.
-type deploymentrequirementOption func(*DeploymentRequirementMutation)
+// namespaceadornmentOption allows management of the mutation configuration using functional options.
+type namespaceadornmentOption func(*NamespaceAdornmentMutation)
 
-// newDeploymentRequirementMutation creates new mutation for the DeploymentRequirement entity.
-func newDeploymentRequirementMutation(c config, op Op, opts ...deploymentrequirementOption) *DeploymentRequirementMutation {
-	m := &DeploymentRequirementMutation{
+// newNamespaceAdornmentMutation creates new mutation for the NamespaceAdornment entity.
+func newNamespaceAdornmentMutation(c config, op Op, opts ...namespaceadornmentOption) *NamespaceAdornmentMutation {
+	m := &NamespaceAdornmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeploymentRequirement,
+		typ:           TypeNamespaceAdornment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7326,20 +13218,20 @@ func newDeploymentRequirementMutation(c config, op Op, opts ...deploymentrequire
 	return m
 }
 
-// withDeploymentRequirementID sets the ID field of the mutation.
-func withDeploymentRequirementID(id uint64) deploymentrequirementOption {
-	return func(m *DeploymentRequirementMutation) {
+// withNamespaceAdornmentID sets the ID field of the mutation.
+func withNamespaceAdornmentID(id uint64) namespaceadornmentOption {
+	return func(m *NamespaceAdornmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeploymentRequirement
+			value *NamespaceAdornment
 		)
-		m.oldValue = func(ctx context.Context) (*DeploymentRequirement, error) {
+		m.oldValue = func(ctx context.Context) (*NamespaceAdornment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeploymentRequirement.Get(ctx, id)
+					value, err = m.Client().NamespaceAdornment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7348,10 +13240,10 @@ func withDeploymentRequirementID(id uint64) deploymentrequirementOption {
 	}
 }
 
-// withDeploymentRequirement sets the old DeploymentRequirement of the mutation.
-func withDeploymentRequirement(node *DeploymentRequirement) deploymentrequirementOption {
-	return func(m *DeploymentRequirementMutation) {
-		m.oldValue = func(context.Context) (*DeploymentRequirement, error) {
+// withNamespaceAdornment sets the old NamespaceAdornment of the mutation.
+func withNamespaceAdornment(node *NamespaceAdornment) namespaceadornmentOption {
+	return func(m *NamespaceAdornmentMutation) {
+		m.oldValue = func(context.Context) (*NamespaceAdornment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7360,7 +13252,7 @@ func withDeploymentRequirement(node *DeploymentRequirement) deploymentrequiremen
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeploymentRequirementMutation) Client() *Client {
+func (m NamespaceAdornmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7368,7 +13260,7 @@ func (m DeploymentRequirementMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeploymentRequirementMutation) Tx() (*Tx, error) {
+func (m NamespaceAdornmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -7379,7 +13271,7 @@ func (m DeploymentRequirementMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeploymentRequirementMutation) ID() (id uint64, exists bool) {
+func (m *NamespaceAdornmentMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7390,7 +13282,7 @@ func (m *DeploymentRequirementMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeploymentRequirementMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *NamespaceAdornmentMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7399,138 +13291,181 @@ func (m *DeploymentRequirementMutation) IDs(ctx context.Context) ([]uint64, erro
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeploymentRequirement.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NamespaceAdornment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProfileFkID sets the "profile_fk" edge to the Profile entity by id.
-func (m *DeploymentRequirementMutation) SetProfileFkID(id uint64) {
-	m.profile_fk = &id
+// SetType sets the "type" field.
+func (m *NamespaceAdornmentMutation) SetType(s string) {
+	m._type = &s
 }
 
-// ClearProfileFk clears the "profile_fk" edge to the Profile entity.
-func (m *DeploymentRequirementMutation) ClearProfileFk() {
-	m.clearedprofile_fk = true
+// GetType returns the value of the "type" field in the mutation.
+func (m *NamespaceAdornmentMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProfileFkCleared reports if the "profile_fk" edge to the Profile entity was cleared.
-func (m *DeploymentRequirementMutation) ProfileFkCleared() bool {
-	return m.clearedprofile_fk
+// OldType returns the old "type" field's value of the NamespaceAdornment entity.
+// If the NamespaceAdornment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceAdornmentMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
 }
 
-// ProfileFkID returns the "profile_fk" edge ID in the mutation.
-func (m *DeploymentRequirementMutation) ProfileFkID() (id uint64, exists bool) {
-	if m.profile_fk != nil {
-		return *m.profile_fk, true
-	}
-	return
+// ResetType resets all changes to the "type" field.
+func (m *NamespaceAdornmentMutation) ResetType() {
+	m._type = nil
 }
 
-// ProfileFkIDs returns the "profile_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProfileFkID instead. It exists only for internal usage by the builders.
-func (m *DeploymentRequirementMutation) ProfileFkIDs() (ids []uint64) {
-	if id := m.profile_fk; id != nil {
-		ids = append(ids, *id)
+// SetKey sets the "key" field.
+func (m *NamespaceAdornmentMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *NamespaceAdornmentMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetProfileFk resets all changes to the "profile_fk" edge.
-func (m *DeploymentRequirementMutation) ResetProfileFk() {
-	m.profile_fk = nil
-	m.clearedprofile_fk = false
+// OldKey returns the old "key" field's value of the NamespaceAdornment entity.
+// If the NamespaceAdornment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceAdornmentMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
 }
 
-// SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
-func (m *DeploymentRequirementMutation) SetDeploymentPackageFkID(id uint64) {
-	m.deployment_package_fk = &id
+// ResetKey resets all changes to the "key" field.
+func (m *NamespaceAdornmentMutation) ResetKey() {
+	m.key = nil
 }
 
-// ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
-func (m *DeploymentRequirementMutation) ClearDeploymentPackageFk() {
-	m.cleareddeployment_package_fk = true
+// SetValue sets the "value" field.
+func (m *NamespaceAdornmentMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
-func (m *DeploymentRequirementMutation) DeploymentPackageFkCleared() bool {
-	return m.cleareddeployment_package_fk
+// Value returns the value of the "value" field in the mutation.
+func (m *NamespaceAdornmentMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
-func (m *DeploymentRequirementMutation) DeploymentPackageFkID() (id uint64, exists bool) {
-	if m.deployment_package_fk != nil {
-		return *m.deployment_package_fk, true
+// OldValue returns the old "value" field's value of the NamespaceAdornment entity.
+// If the NamespaceAdornment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceAdornmentMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
 }
 
-// DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentPackageFkID instead. It exists only for internal usage by the builders.
-func (m *DeploymentRequirementMutation) DeploymentPackageFkIDs() (ids []uint64) {
-	if id := m.deployment_package_fk; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearValue clears the value of the "value" field.
+func (m *NamespaceAdornmentMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[namespaceadornment.FieldValue] = struct{}{}
 }
 
-// ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
-func (m *DeploymentRequirementMutation) ResetDeploymentPackageFk() {
-	m.deployment_package_fk = nil
-	m.cleareddeployment_package_fk = false
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *NamespaceAdornmentMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[namespaceadornment.FieldValue]
+	return ok
 }
 
-// SetDeploymentProfileFkID sets the "deployment_profile_fk" edge to the DeploymentProfile entity by id.
-func (m *DeploymentRequirementMutation) SetDeploymentProfileFkID(id uint64) {
-	m.deployment_profile_fk = &id
+// ResetValue resets all changes to the "value" field.
+func (m *NamespaceAdornmentMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, namespaceadornment.FieldValue)
 }
 
-// ClearDeploymentProfileFk clears the "deployment_profile_fk" edge to the DeploymentProfile entity.
-func (m *DeploymentRequirementMutation) ClearDeploymentProfileFk() {
-	m.cleareddeployment_profile_fk = true
+// SetNamespaceFkID sets the "namespace_fk" edge to the Namespace entity by id.
+func (m *NamespaceAdornmentMutation) SetNamespaceFkID(id uint64) {
+	m.namespace_fk = &id
 }
 
-// DeploymentProfileFkCleared reports if the "deployment_profile_fk" edge to the DeploymentProfile entity was cleared.
-func (m *DeploymentRequirementMutation) DeploymentProfileFkCleared() bool {
-	return m.cleareddeployment_profile_fk
+// ClearNamespaceFk clears the "namespace_fk" edge to the Namespace entity.
+func (m *NamespaceAdornmentMutation) ClearNamespaceFk() {
+	m.clearednamespace_fk = true
 }
 
-// DeploymentProfileFkID returns the "deployment_profile_fk" edge ID in the mutation.
-func (m *DeploymentRequirementMutation) DeploymentProfileFkID() (id uint64, exists bool) {
-	if m.deployment_profile_fk != nil {
-		return *m.deployment_profile_fk, true
+// NamespaceFkCleared reports if the "namespace_fk" edge to the Namespace entity was cleared.
+func (m *NamespaceAdornmentMutation) NamespaceFkCleared() bool {
+	return m.clearednamespace_fk
+}
+
+// NamespaceFkID returns the "namespace_fk" edge ID in the mutation.
+func (m *NamespaceAdornmentMutation) NamespaceFkID() (id uint64, exists bool) {
+	if m.namespace_fk != nil {
+		return *m.namespace_fk, true
 	}
 	return
 }
 
-// DeploymentProfileFkIDs returns the "deployment_profile_fk" edge IDs in the mutation.
+// NamespaceFkIDs returns the "namespace_fk" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentProfileFkID instead. It exists only for internal usage by the builders.
-func (m *DeploymentRequirementMutation) DeploymentProfileFkIDs() (ids []uint64) {
-	if id := m.deployment_profile_fk; id != nil {
+// NamespaceFkID instead. It exists only for internal usage by the builders.
+func (m *NamespaceAdornmentMutation) NamespaceFkIDs() (ids []uint64) {
+	if id := m.namespace_fk; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDeploymentProfileFk resets all changes to the "deployment_profile_fk" edge.
-func (m *DeploymentRequirementMutation) ResetDeploymentProfileFk() {
-	m.deployment_profile_fk = nil
-	m.cleareddeployment_profile_fk = false
+// ResetNamespaceFk resets all changes to the "namespace_fk" edge.
+func (m *NamespaceAdornmentMutation) ResetNamespaceFk() {
+	m.namespace_fk = nil
+	m.clearednamespace_fk = false
 }
 
-// Where appends a list predicates to the DeploymentRequirementMutation builder.
-func (m *DeploymentRequirementMutation) Where(ps ...predicate.DeploymentRequirement) {
+// Where appends a list predicates to the NamespaceAdornmentMutation builder.
+func (m *NamespaceAdornmentMutation) Where(ps ...predicate.NamespaceAdornment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeploymentRequirementMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NamespaceAdornmentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentRequirementMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeploymentRequirement, len(ps))
+func (m *NamespaceAdornmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NamespaceAdornment, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7538,125 +13473,179 @@ func (m *DeploymentRequirementMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeploymentRequirementMutation) Op() Op {
+func (m *NamespaceAdornmentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeploymentRequirementMutation) SetOp(op Op) {
+func (m *NamespaceAdornmentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeploymentRequirement).
-func (m *DeploymentRequirementMutation) Type() string {
+// Type returns the node type of this mutation (NamespaceAdornment).
+func (m *NamespaceAdornmentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeploymentRequirementMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *NamespaceAdornmentMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._type != nil {
+		fields = append(fields, namespaceadornment.FieldType)
+	}
+	if m.key != nil {
+		fields = append(fields, namespaceadornment.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, namespaceadornment.FieldValue)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentRequirementMutation) Field(name string) (ent.Value, bool) {
+func (m *NamespaceAdornmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case namespaceadornment.FieldType:
+		return m.GetType()
+	case namespaceadornment.FieldKey:
+		return m.Key()
+	case namespaceadornment.FieldValue:
+		return m.Value()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentRequirementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown DeploymentRequirement field %s", name)
+func (m *NamespaceAdornmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case namespaceadornment.FieldType:
+		return m.OldType(ctx)
+	case namespaceadornment.FieldKey:
+		return m.OldKey(ctx)
+	case namespaceadornment.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown NamespaceAdornment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentRequirementMutation) SetField(name string, value ent.Value) error {
+func (m *NamespaceAdornmentMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case namespaceadornment.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case namespaceadornment.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case namespaceadornment.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DeploymentRequirement field %s", name)
+	return fmt.Errorf("unknown NamespaceAdornment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentRequirementMutation) AddedFields() []string {
+func (m *NamespaceAdornmentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentRequirementMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NamespaceAdornmentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentRequirementMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown DeploymentRequirement numeric field %s", name)
+func (m *NamespaceAdornmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NamespaceAdornment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentRequirementMutation) ClearedFields() []string {
-	return nil
+func (m *NamespaceAdornmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(namespaceadornment.FieldValue) {
+		fields = append(fields, namespaceadornment.FieldValue)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeploymentRequirementMutation) FieldCleared(name string) bool {
+func (m *NamespaceAdornmentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeploymentRequirementMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DeploymentRequirement nullable field %s", name)
+func (m *NamespaceAdornmentMutation) ClearField(name string) error {
+	switch name {
+	case namespaceadornment.FieldValue:
+		m.ClearValue()
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceAdornment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentRequirementMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown DeploymentRequirement field %s", name)
+func (m *NamespaceAdornmentMutation) ResetField(name string) error {
+	switch name {
+	case namespaceadornment.FieldType:
+		m.ResetType()
+		return nil
+	case namespaceadornment.FieldKey:
+		m.ResetKey()
+		return nil
+	case namespaceadornment.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceAdornment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentRequirementMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.profile_fk != nil {
-		edges = append(edges, deploymentrequirement.EdgeProfileFk)
-	}
-	if m.deployment_package_fk != nil {
-		edges = append(edges, deploymentrequirement.EdgeDeploymentPackageFk)
-	}
-	if m.deployment_profile_fk != nil {
-		edges = append(edges, deploymentrequirement.EdgeDeploymentProfileFk)
+func (m *NamespaceAdornmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.namespace_fk != nil {
+		edges = append(edges, namespaceadornment.EdgeNamespaceFk)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentRequirementMutation) AddedIDs(name string) []ent.Value {
+func (m *NamespaceAdornmentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deploymentrequirement.EdgeProfileFk:
-		if id := m.profile_fk; id != nil {
-			return []ent.Value{*id}
-		}
-	case deploymentrequirement.EdgeDeploymentPackageFk:
-		if id := m.deployment_package_fk; id != nil {
-			return []ent.Value{*id}
-		}
-	case deploymentrequirement.EdgeDeploymentProfileFk:
-		if id := m.deployment_profile_fk; id != nil {
+	case namespaceadornment.EdgeNamespaceFk:
+		if id := m.namespace_fk; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7664,111 +13653,91 @@ func (m *DeploymentRequirementMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentRequirementMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *NamespaceAdornmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentRequirementMutation) RemovedIDs(name string) []ent.Value {
+func (m *NamespaceAdornmentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentRequirementMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedprofile_fk {
-		edges = append(edges, deploymentrequirement.EdgeProfileFk)
-	}
-	if m.cleareddeployment_package_fk {
-		edges = append(edges, deploymentrequirement.EdgeDeploymentPackageFk)
-	}
-	if m.cleareddeployment_profile_fk {
-		edges = append(edges, deploymentrequirement.EdgeDeploymentProfileFk)
+func (m *NamespaceAdornmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednamespace_fk {
+		edges = append(edges, namespaceadornment.EdgeNamespaceFk)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentRequirementMutation) EdgeCleared(name string) bool {
+func (m *NamespaceAdornmentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deploymentrequirement.EdgeProfileFk:
-		return m.clearedprofile_fk
-	case deploymentrequirement.EdgeDeploymentPackageFk:
-		return m.cleareddeployment_package_fk
-	case deploymentrequirement.EdgeDeploymentProfileFk:
-		return m.cleareddeployment_profile_fk
+	case namespaceadornment.EdgeNamespaceFk:
+		return m.clearednamespace_fk
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentRequirementMutation) ClearEdge(name string) error {
+func (m *NamespaceAdornmentMutation) ClearEdge(name string) error {
 	switch name {
-	case deploymentrequirement.EdgeProfileFk:
-		m.ClearProfileFk()
-		return nil
-	case deploymentrequirement.EdgeDeploymentPackageFk:
-		m.ClearDeploymentPackageFk()
-		return nil
-	case deploymentrequirement.EdgeDeploymentProfileFk:
-		m.ClearDeploymentProfileFk()
+	case namespaceadornment.EdgeNamespaceFk:
+		m.ClearNamespaceFk()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentRequirement unique edge %s", name)
+	return fmt.Errorf("unknown NamespaceAdornment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentRequirementMutation) ResetEdge(name string) error {
+func (m *NamespaceAdornmentMutation) ResetEdge(name string) error {
 	switch name {
-	case deploymentrequirement.EdgeProfileFk:
-		m.ResetProfileFk()
-		return nil
-	case deploymentrequirement.EdgeDeploymentPackageFk:
-		m.ResetDeploymentPackageFk()
-		return nil
-	case deploymentrequirement.EdgeDeploymentProfileFk:
-		m.ResetDeploymentProfileFk()
+	case namespaceadornment.EdgeNamespaceFk:
+		m.ResetNamespaceFk()
 		return nil
 	}
-	return fmt.Errorf("unknown DeploymentRequirement edge %s", name)
+	return fmt.Errorf("unknown NamespaceAdornment edge %s", name)
 }
 
-// EndpointMutation represents an operation that mutates the Endpoint nodes in the graph.
-type EndpointMutation struct {
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uint64
-	service_name        *string
-	external_path       *string
-	internal_path       *string
-	scheme              *string
-	auth_type           *string
-	app_name            *string
-	clearedFields       map[string]struct{}
-	extension_fk        *uint64
-	clearedextension_fk bool
-	done                bool
-	oldValue            func(context.Context) (*Endpoint, error)
-	predicates          []predicate.Endpoint
-}
-
-var _ ent.Mutation = (*EndpointMutation)(nil)
-
-// endpointOption allows management of the mutation configuration using functional options.
-type endpointOption func(*EndpointMutation)
-
-// newEndpointMutation creates new mutation for the Endpoint entity.
-func newEndpointMutation(c config, op Op, opts ...endpointOption) *EndpointMutation {
-	m := &EndpointMutation{
+	op            Op
+	typ           string
+	id            *uint64
+	project_uuid  *string
+	resource_type *string
+	event_type    *string
+	payload       *[]byte
+	origin        *string
+	tx_id         *uint64
+	addtx_id      *int64
+	tx_xmin       *uint64
+	addtx_xmin    *int64
+	create_time   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*OutboxEvent, error)
+	predicates    []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEndpoint,
+		typ:           TypeOutboxEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7777,20 +13746,20 @@ func newEndpointMutation(c config, op Op, opts ...endpointOption) *EndpointMutat
 	return m
 }
 
-// withEndpointID sets the ID field of the mutation.
-func withEndpointID(id uint64) endpointOption {
-	return func(m *EndpointMutation) {
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id uint64) outboxeventOption {
+	return func(m *OutboxEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Endpoint
+			value *OutboxEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Endpoint, error) {
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Endpoint.Get(ctx, id)
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7799,10 +13768,10 @@ func withEndpointID(id uint64) endpointOption {
 	}
 }
 
-// withEndpoint sets the old Endpoint of the mutation.
-func withEndpoint(node *Endpoint) endpointOption {
-	return func(m *EndpointMutation) {
-		m.oldValue = func(context.Context) (*Endpoint, error) {
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7811,7 +13780,7 @@ func withEndpoint(node *Endpoint) endpointOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EndpointMutation) Client() *Client {
+func (m OutboxEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7819,7 +13788,7 @@ func (m EndpointMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EndpointMutation) Tx() (*Tx, error) {
+func (m OutboxEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -7830,7 +13799,7 @@ func (m EndpointMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EndpointMutation) ID() (id uint64, exists bool) {
+func (m *OutboxEventMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7841,7 +13810,7 @@ func (m *EndpointMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EndpointMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7850,289 +13819,377 @@ func (m *EndpointMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Endpoint.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetServiceName sets the "service_name" field.
-func (m *EndpointMutation) SetServiceName(s string) {
-	m.service_name = &s
+// SetProjectUUID sets the "project_uuid" field.
+func (m *OutboxEventMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
 }
 
-// ServiceName returns the value of the "service_name" field in the mutation.
-func (m *EndpointMutation) ServiceName() (r string, exists bool) {
-	v := m.service_name
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *OutboxEventMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServiceName returns the old "service_name" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectUUID returns the old "project_uuid" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldServiceName(ctx context.Context) (v string, err error) {
+func (m *OutboxEventMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServiceName requires an ID field in the mutation")
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
 	}
-	return oldValue.ServiceName, nil
+	return oldValue.ProjectUUID, nil
 }
 
-// ResetServiceName resets all changes to the "service_name" field.
-func (m *EndpointMutation) ResetServiceName() {
-	m.service_name = nil
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *OutboxEventMutation) ResetProjectUUID() {
+	m.project_uuid = nil
 }
 
-// SetExternalPath sets the "external_path" field.
-func (m *EndpointMutation) SetExternalPath(s string) {
-	m.external_path = &s
+// SetResourceType sets the "resource_type" field.
+func (m *OutboxEventMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// ExternalPath returns the value of the "external_path" field in the mutation.
-func (m *EndpointMutation) ExternalPath() (r string, exists bool) {
-	v := m.external_path
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *OutboxEventMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExternalPath returns the old "external_path" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldExternalPath(ctx context.Context) (v string, err error) {
+func (m *OutboxEventMutation) OldResourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExternalPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExternalPath requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExternalPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.ExternalPath, nil
+	return oldValue.ResourceType, nil
 }
 
-// ResetExternalPath resets all changes to the "external_path" field.
-func (m *EndpointMutation) ResetExternalPath() {
-	m.external_path = nil
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *OutboxEventMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// SetInternalPath sets the "internal_path" field.
-func (m *EndpointMutation) SetInternalPath(s string) {
-	m.internal_path = &s
+// SetEventType sets the "event_type" field.
+func (m *OutboxEventMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// InternalPath returns the value of the "internal_path" field in the mutation.
-func (m *EndpointMutation) InternalPath() (r string, exists bool) {
-	v := m.internal_path
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *OutboxEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInternalPath returns the old "internal_path" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldInternalPath(ctx context.Context) (v string, err error) {
+func (m *OutboxEventMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInternalPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInternalPath requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInternalPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.InternalPath, nil
+	return oldValue.EventType, nil
 }
 
-// ResetInternalPath resets all changes to the "internal_path" field.
-func (m *EndpointMutation) ResetInternalPath() {
-	m.internal_path = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *OutboxEventMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetScheme sets the "scheme" field.
-func (m *EndpointMutation) SetScheme(s string) {
-	m.scheme = &s
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(b []byte) {
+	m.payload = &b
 }
 
-// Scheme returns the value of the "scheme" field in the mutation.
-func (m *EndpointMutation) Scheme() (r string, exists bool) {
-	v := m.scheme
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheme returns the old "scheme" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldScheme(ctx context.Context) (v string, err error) {
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheme is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheme requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheme: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Scheme, nil
+	return oldValue.Payload, nil
 }
 
-// ResetScheme resets all changes to the "scheme" field.
-func (m *EndpointMutation) ResetScheme() {
-	m.scheme = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetAuthType sets the "auth_type" field.
-func (m *EndpointMutation) SetAuthType(s string) {
-	m.auth_type = &s
+// SetOrigin sets the "origin" field.
+func (m *OutboxEventMutation) SetOrigin(s string) {
+	m.origin = &s
 }
 
-// AuthType returns the value of the "auth_type" field in the mutation.
-func (m *EndpointMutation) AuthType() (r string, exists bool) {
-	v := m.auth_type
+// Origin returns the value of the "origin" field in the mutation.
+func (m *OutboxEventMutation) Origin() (r string, exists bool) {
+	v := m.origin
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthType returns the old "auth_type" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldOrigin returns the old "origin" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldAuthType(ctx context.Context) (v string, err error) {
+func (m *OutboxEventMutation) OldOrigin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthType is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthType requires an ID field in the mutation")
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthType: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
 	}
-	return oldValue.AuthType, nil
+	return oldValue.Origin, nil
 }
 
-// ResetAuthType resets all changes to the "auth_type" field.
-func (m *EndpointMutation) ResetAuthType() {
-	m.auth_type = nil
+// ResetOrigin resets all changes to the "origin" field.
+func (m *OutboxEventMutation) ResetOrigin() {
+	m.origin = nil
 }
 
-// SetAppName sets the "app_name" field.
-func (m *EndpointMutation) SetAppName(s string) {
-	m.app_name = &s
+// SetTxID sets the "tx_id" field.
+func (m *OutboxEventMutation) SetTxID(u uint64) {
+	m.tx_id = &u
+	m.addtx_id = nil
 }
 
-// AppName returns the value of the "app_name" field in the mutation.
-func (m *EndpointMutation) AppName() (r string, exists bool) {
-	v := m.app_name
+// TxID returns the value of the "tx_id" field in the mutation.
+func (m *OutboxEventMutation) TxID() (r uint64, exists bool) {
+	v := m.tx_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppName returns the old "app_name" field's value of the Endpoint entity.
-// If the Endpoint object wasn't provided to the builder, the object is fetched from the database.
+// OldTxID returns the old "tx_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EndpointMutation) OldAppName(ctx context.Context) (v string, err error) {
+func (m *OutboxEventMutation) OldTxID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppName requires an ID field in the mutation")
+		return v, errors.New("OldTxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxID: %w", err)
 	}
-	return oldValue.AppName, nil
+	return oldValue.TxID, nil
 }
 
-// ClearAppName clears the value of the "app_name" field.
-func (m *EndpointMutation) ClearAppName() {
-	m.app_name = nil
-	m.clearedFields[endpoint.FieldAppName] = struct{}{}
+// AddTxID adds u to the "tx_id" field.
+func (m *OutboxEventMutation) AddTxID(u int64) {
+	if m.addtx_id != nil {
+		*m.addtx_id += u
+	} else {
+		m.addtx_id = &u
+	}
 }
 
-// AppNameCleared returns if the "app_name" field was cleared in this mutation.
-func (m *EndpointMutation) AppNameCleared() bool {
-	_, ok := m.clearedFields[endpoint.FieldAppName]
+// AddedTxID returns the value that was added to the "tx_id" field in this mutation.
+func (m *OutboxEventMutation) AddedTxID() (r int64, exists bool) {
+	v := m.addtx_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTxID clears the value of the "tx_id" field.
+func (m *OutboxEventMutation) ClearTxID() {
+	m.tx_id = nil
+	m.addtx_id = nil
+	m.clearedFields[outboxevent.FieldTxID] = struct{}{}
+}
+
+// TxIDCleared returns if the "tx_id" field was cleared in this mutation.
+func (m *OutboxEventMutation) TxIDCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldTxID]
 	return ok
 }
 
-// ResetAppName resets all changes to the "app_name" field.
-func (m *EndpointMutation) ResetAppName() {
-	m.app_name = nil
-	delete(m.clearedFields, endpoint.FieldAppName)
+// ResetTxID resets all changes to the "tx_id" field.
+func (m *OutboxEventMutation) ResetTxID() {
+	m.tx_id = nil
+	m.addtx_id = nil
+	delete(m.clearedFields, outboxevent.FieldTxID)
 }
 
-// SetExtensionFkID sets the "extension_fk" edge to the Extension entity by id.
-func (m *EndpointMutation) SetExtensionFkID(id uint64) {
-	m.extension_fk = &id
+// SetTxXmin sets the "tx_xmin" field.
+func (m *OutboxEventMutation) SetTxXmin(u uint64) {
+	m.tx_xmin = &u
+	m.addtx_xmin = nil
 }
 
-// ClearExtensionFk clears the "extension_fk" edge to the Extension entity.
-func (m *EndpointMutation) ClearExtensionFk() {
-	m.clearedextension_fk = true
+// TxXmin returns the value of the "tx_xmin" field in the mutation.
+func (m *OutboxEventMutation) TxXmin() (r uint64, exists bool) {
+	v := m.tx_xmin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxXmin returns the old "tx_xmin" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldTxXmin(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxXmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxXmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxXmin: %w", err)
+	}
+	return oldValue.TxXmin, nil
+}
+
+// AddTxXmin adds u to the "tx_xmin" field.
+func (m *OutboxEventMutation) AddTxXmin(u int64) {
+	if m.addtx_xmin != nil {
+		*m.addtx_xmin += u
+	} else {
+		m.addtx_xmin = &u
+	}
+}
+
+// AddedTxXmin returns the value that was added to the "tx_xmin" field in this mutation.
+func (m *OutboxEventMutation) AddedTxXmin() (r int64, exists bool) {
+	v := m.addtx_xmin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTxXmin clears the value of the "tx_xmin" field.
+func (m *OutboxEventMutation) ClearTxXmin() {
+	m.tx_xmin = nil
+	m.addtx_xmin = nil
+	m.clearedFields[outboxevent.FieldTxXmin] = struct{}{}
+}
+
+// TxXminCleared returns if the "tx_xmin" field was cleared in this mutation.
+func (m *OutboxEventMutation) TxXminCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldTxXmin]
+	return ok
+}
+
+// ResetTxXmin resets all changes to the "tx_xmin" field.
+func (m *OutboxEventMutation) ResetTxXmin() {
+	m.tx_xmin = nil
+	m.addtx_xmin = nil
+	delete(m.clearedFields, outboxevent.FieldTxXmin)
 }
 
-// ExtensionFkCleared reports if the "extension_fk" edge to the Extension entity was cleared.
-func (m *EndpointMutation) ExtensionFkCleared() bool {
-	return m.clearedextension_fk
+// SetCreateTime sets the "create_time" field.
+func (m *OutboxEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// ExtensionFkID returns the "extension_fk" edge ID in the mutation.
-func (m *EndpointMutation) ExtensionFkID() (id uint64, exists bool) {
-	if m.extension_fk != nil {
-		return *m.extension_fk, true
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OutboxEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ExtensionFkIDs returns the "extension_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ExtensionFkID instead. It exists only for internal usage by the builders.
-func (m *EndpointMutation) ExtensionFkIDs() (ids []uint64) {
-	if id := m.extension_fk; id != nil {
-		ids = append(ids, *id)
+// OldCreateTime returns the old "create_time" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// ResetExtensionFk resets all changes to the "extension_fk" edge.
-func (m *EndpointMutation) ResetExtensionFk() {
-	m.extension_fk = nil
-	m.clearedextension_fk = false
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OutboxEventMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// Where appends a list predicates to the EndpointMutation builder.
-func (m *EndpointMutation) Where(ps ...predicate.Endpoint) {
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EndpointMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EndpointMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Endpoint, len(ps))
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8140,42 +14197,48 @@ func (m *EndpointMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EndpointMutation) Op() Op {
+func (m *OutboxEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EndpointMutation) SetOp(op Op) {
+func (m *OutboxEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Endpoint).
-func (m *EndpointMutation) Type() string {
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EndpointMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.service_name != nil {
-		fields = append(fields, endpoint.FieldServiceName)
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.project_uuid != nil {
+		fields = append(fields, outboxevent.FieldProjectUUID)
 	}
-	if m.external_path != nil {
-		fields = append(fields, endpoint.FieldExternalPath)
+	if m.resource_type != nil {
+		fields = append(fields, outboxevent.FieldResourceType)
 	}
-	if m.internal_path != nil {
-		fields = append(fields, endpoint.FieldInternalPath)
+	if m.event_type != nil {
+		fields = append(fields, outboxevent.FieldEventType)
 	}
-	if m.scheme != nil {
-		fields = append(fields, endpoint.FieldScheme)
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
 	}
-	if m.auth_type != nil {
-		fields = append(fields, endpoint.FieldAuthType)
+	if m.origin != nil {
+		fields = append(fields, outboxevent.FieldOrigin)
 	}
-	if m.app_name != nil {
-		fields = append(fields, endpoint.FieldAppName)
+	if m.tx_id != nil {
+		fields = append(fields, outboxevent.FieldTxID)
+	}
+	if m.tx_xmin != nil {
+		fields = append(fields, outboxevent.FieldTxXmin)
+	}
+	if m.create_time != nil {
+		fields = append(fields, outboxevent.FieldCreateTime)
 	}
 	return fields
 }
@@ -8183,20 +14246,24 @@ func (m *EndpointMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EndpointMutation) Field(name string) (ent.Value, bool) {
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case endpoint.FieldServiceName:
-		return m.ServiceName()
-	case endpoint.FieldExternalPath:
-		return m.ExternalPath()
-	case endpoint.FieldInternalPath:
-		return m.InternalPath()
-	case endpoint.FieldScheme:
-		return m.Scheme()
-	case endpoint.FieldAuthType:
-		return m.AuthType()
-	case endpoint.FieldAppName:
-		return m.AppName()
+	case outboxevent.FieldProjectUUID:
+		return m.ProjectUUID()
+	case outboxevent.FieldResourceType:
+		return m.ResourceType()
+	case outboxevent.FieldEventType:
+		return m.EventType()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldOrigin:
+		return m.Origin()
+	case outboxevent.FieldTxID:
+		return m.TxID()
+	case outboxevent.FieldTxXmin:
+		return m.TxXmin()
+	case outboxevent.FieldCreateTime:
+		return m.CreateTime()
 	}
 	return nil, false
 }
@@ -8204,264 +14271,294 @@ func (m *EndpointMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case endpoint.FieldServiceName:
-		return m.OldServiceName(ctx)
-	case endpoint.FieldExternalPath:
-		return m.OldExternalPath(ctx)
-	case endpoint.FieldInternalPath:
-		return m.OldInternalPath(ctx)
-	case endpoint.FieldScheme:
-		return m.OldScheme(ctx)
-	case endpoint.FieldAuthType:
-		return m.OldAuthType(ctx)
-	case endpoint.FieldAppName:
-		return m.OldAppName(ctx)
+	case outboxevent.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case outboxevent.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case outboxevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case outboxevent.FieldTxID:
+		return m.OldTxID(ctx)
+	case outboxevent.FieldTxXmin:
+		return m.OldTxXmin(ctx)
+	case outboxevent.FieldCreateTime:
+		return m.OldCreateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Endpoint field %s", name)
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EndpointMutation) SetField(name string, value ent.Value) error {
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case endpoint.FieldServiceName:
+	case outboxevent.FieldProjectUUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetServiceName(v)
+		m.SetProjectUUID(v)
 		return nil
-	case endpoint.FieldExternalPath:
+	case outboxevent.FieldResourceType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExternalPath(v)
+		m.SetResourceType(v)
 		return nil
-	case endpoint.FieldInternalPath:
+	case outboxevent.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInternalPath(v)
+		m.SetEventType(v)
 		return nil
-	case endpoint.FieldScheme:
-		v, ok := value.(string)
+	case outboxevent.FieldPayload:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScheme(v)
+		m.SetPayload(v)
 		return nil
-	case endpoint.FieldAuthType:
+	case outboxevent.FieldOrigin:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthType(v)
+		m.SetOrigin(v)
 		return nil
-	case endpoint.FieldAppName:
-		v, ok := value.(string)
+	case outboxevent.FieldTxID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppName(v)
+		m.SetTxID(v)
+		return nil
+	case outboxevent.FieldTxXmin:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxXmin(v)
+		return nil
+	case outboxevent.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Endpoint field %s", name)
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EndpointMutation) AddedFields() []string {
-	return nil
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtx_id != nil {
+		fields = append(fields, outboxevent.FieldTxID)
+	}
+	if m.addtx_xmin != nil {
+		fields = append(fields, outboxevent.FieldTxXmin)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EndpointMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldTxID:
+		return m.AddedTxID()
+	case outboxevent.FieldTxXmin:
+		return m.AddedTxXmin()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EndpointMutation) AddField(name string, value ent.Value) error {
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case outboxevent.FieldTxID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxID(v)
+		return nil
+	case outboxevent.FieldTxXmin:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxXmin(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Endpoint numeric field %s", name)
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EndpointMutation) ClearedFields() []string {
+func (m *OutboxEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(endpoint.FieldAppName) {
-		fields = append(fields, endpoint.FieldAppName)
+	if m.FieldCleared(outboxevent.FieldTxID) {
+		fields = append(fields, outboxevent.FieldTxID)
+	}
+	if m.FieldCleared(outboxevent.FieldTxXmin) {
+		fields = append(fields, outboxevent.FieldTxXmin)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EndpointMutation) FieldCleared(name string) bool {
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EndpointMutation) ClearField(name string) error {
+func (m *OutboxEventMutation) ClearField(name string) error {
 	switch name {
-	case endpoint.FieldAppName:
-		m.ClearAppName()
+	case outboxevent.FieldTxID:
+		m.ClearTxID()
+		return nil
+	case outboxevent.FieldTxXmin:
+		m.ClearTxXmin()
 		return nil
 	}
-	return fmt.Errorf("unknown Endpoint nullable field %s", name)
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EndpointMutation) ResetField(name string) error {
+func (m *OutboxEventMutation) ResetField(name string) error {
 	switch name {
-	case endpoint.FieldServiceName:
-		m.ResetServiceName()
+	case outboxevent.FieldProjectUUID:
+		m.ResetProjectUUID()
 		return nil
-	case endpoint.FieldExternalPath:
-		m.ResetExternalPath()
+	case outboxevent.FieldResourceType:
+		m.ResetResourceType()
 		return nil
-	case endpoint.FieldInternalPath:
-		m.ResetInternalPath()
+	case outboxevent.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case endpoint.FieldScheme:
-		m.ResetScheme()
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case endpoint.FieldAuthType:
-		m.ResetAuthType()
+	case outboxevent.FieldOrigin:
+		m.ResetOrigin()
 		return nil
-	case endpoint.FieldAppName:
-		m.ResetAppName()
+	case outboxevent.FieldTxID:
+		m.ResetTxID()
+		return nil
+	case outboxevent.FieldTxXmin:
+		m.ResetTxXmin()
+		return nil
+	case outboxevent.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Endpoint field %s", name)
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EndpointMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.extension_fk != nil {
-		edges = append(edges, endpoint.EdgeExtensionFk)
-	}
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EndpointMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case endpoint.EdgeExtensionFk:
-		if id := m.extension_fk; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EndpointMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EndpointMutation) RemovedIDs(name string) []ent.Value {
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EndpointMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedextension_fk {
-		edges = append(edges, endpoint.EdgeExtensionFk)
-	}
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EndpointMutation) EdgeCleared(name string) bool {
-	switch name {
-	case endpoint.EdgeExtensionFk:
-		return m.clearedextension_fk
-	}
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EndpointMutation) ClearEdge(name string) error {
-	switch name {
-	case endpoint.EdgeExtensionFk:
-		m.ClearExtensionFk()
-		return nil
-	}
-	return fmt.Errorf("unknown Endpoint unique edge %s", name)
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EndpointMutation) ResetEdge(name string) error {
-	switch name {
-	case endpoint.EdgeExtensionFk:
-		m.ResetExtensionFk()
-		return nil
-	}
-	return fmt.Errorf("unknown Endpoint edge %s", name)
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
 }
 
-// ExtensionMutation represents an operation that mutates the Extension nodes in the graph.
-type ExtensionMutation struct {
+// ParameterTemplateMutation represents an operation that mutates the ParameterTemplate nodes in the graph.
+type ParameterTemplateMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uint64
-	name                         *string
-	version                      *string
-	display_name                 *string
-	display_name_lc              *string
-	description                  *string
-	ui_label                     *string
-	ui_service_name              *string
-	ui_description               *string
-	ui_file_name                 *string
-	ui_app_name                  *string
-	ui_module_name               *string
-	clearedFields                map[string]struct{}
-	endpoints                    map[uint64]struct{}
-	removedendpoints             map[uint64]struct{}
-	clearedendpoints             bool
-	deployment_package_fk        *uint64
-	cleareddeployment_package_fk bool
-	done                         bool
-	oldValue                     func(context.Context) (*Extension, error)
-	predicates                   []predicate.Extension
+	op                     Op
+	typ                    string
+	id                     *uint64
+	name                   *string
+	display_name           *string
+	display_name_lc        *string
+	_default               *string
+	_type                  *string
+	validator              *string
+	suggested_values       *[]string
+	appendsuggested_values []string
+	mandatory              *bool
+	secret                 *bool
+	clearedFields          map[string]struct{}
+	profile_fk             *uint64
+	clearedprofile_fk      bool
+	profiles               map[uint64]struct{}
+	removedprofiles        map[uint64]struct{}
+	clearedprofiles        bool
+	done                   bool
+	oldValue               func(context.Context) (*ParameterTemplate, error)
+	predicates             []predicate.ParameterTemplate
 }
 
-var _ ent.Mutation = (*ExtensionMutation)(nil)
+var _ ent.Mutation = (*ParameterTemplateMutation)(nil)
 
-// extensionOption allows management of the mutation configuration using functional options.
-type extensionOption func(*ExtensionMutation)
+// parametertemplateOption allows management of the mutation configuration using functional options.
+type parametertemplateOption func(*ParameterTemplateMutation)
 
-// newExtensionMutation creates new mutation for the Extension entity.
-func newExtensionMutation(c config, op Op, opts ...extensionOption) *ExtensionMutation {
-	m := &ExtensionMutation{
+// newParameterTemplateMutation creates new mutation for the ParameterTemplate entity.
+func newParameterTemplateMutation(c config, op Op, opts ...parametertemplateOption) *ParameterTemplateMutation {
+	m := &ParameterTemplateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExtension,
+		typ:           TypeParameterTemplate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8470,20 +14567,20 @@ func newExtensionMutation(c config, op Op, opts ...extensionOption) *ExtensionMu
 	return m
 }
 
-// withExtensionID sets the ID field of the mutation.
-func withExtensionID(id uint64) extensionOption {
-	return func(m *ExtensionMutation) {
+// withParameterTemplateID sets the ID field of the mutation.
+func withParameterTemplateID(id uint64) parametertemplateOption {
+	return func(m *ParameterTemplateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Extension
+			value *ParameterTemplate
 		)
-		m.oldValue = func(ctx context.Context) (*Extension, error) {
+		m.oldValue = func(ctx context.Context) (*ParameterTemplate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Extension.Get(ctx, id)
+					value, err = m.Client().ParameterTemplate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8492,10 +14589,10 @@ func withExtensionID(id uint64) extensionOption {
 	}
 }
 
-// withExtension sets the old Extension of the mutation.
-func withExtension(node *Extension) extensionOption {
-	return func(m *ExtensionMutation) {
-		m.oldValue = func(context.Context) (*Extension, error) {
+// withParameterTemplate sets the old ParameterTemplate of the mutation.
+func withParameterTemplate(node *ParameterTemplate) parametertemplateOption {
+	return func(m *ParameterTemplateMutation) {
+		m.oldValue = func(context.Context) (*ParameterTemplate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8504,7 +14601,7 @@ func withExtension(node *Extension) extensionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExtensionMutation) Client() *Client {
+func (m ParameterTemplateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8512,7 +14609,7 @@ func (m ExtensionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExtensionMutation) Tx() (*Tx, error) {
+func (m ParameterTemplateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -8523,7 +14620,7 @@ func (m ExtensionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExtensionMutation) ID() (id uint64, exists bool) {
+func (m *ParameterTemplateMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8534,7 +14631,7 @@ func (m *ExtensionMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExtensionMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *ParameterTemplateMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8543,19 +14640,19 @@ func (m *ExtensionMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Extension.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ParameterTemplate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ExtensionMutation) SetName(s string) {
+func (m *ParameterTemplateMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ExtensionMutation) Name() (r string, exists bool) {
+func (m *ParameterTemplateMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -8563,10 +14660,10 @@ func (m *ExtensionMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -8581,53 +14678,17 @@ func (m *ExtensionMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ExtensionMutation) ResetName() {
+func (m *ParameterTemplateMutation) ResetName() {
 	m.name = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *ExtensionMutation) SetVersion(s string) {
-	m.version = &s
-}
-
-// Version returns the value of the "version" field in the mutation.
-func (m *ExtensionMutation) Version() (r string, exists bool) {
-	v := m.version
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldVersion returns the old "version" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
-	}
-	return oldValue.Version, nil
-}
-
-// ResetVersion resets all changes to the "version" field.
-func (m *ExtensionMutation) ResetVersion() {
-	m.version = nil
-}
-
 // SetDisplayName sets the "display_name" field.
-func (m *ExtensionMutation) SetDisplayName(s string) {
+func (m *ParameterTemplateMutation) SetDisplayName(s string) {
 	m.display_name = &s
 }
 
 // DisplayName returns the value of the "display_name" field in the mutation.
-func (m *ExtensionMutation) DisplayName() (r string, exists bool) {
+func (m *ParameterTemplateMutation) DisplayName() (r string, exists bool) {
 	v := m.display_name
 	if v == nil {
 		return
@@ -8635,10 +14696,10 @@ func (m *ExtensionMutation) DisplayName() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayName returns the old "display_name" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldDisplayName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
@@ -8653,30 +14714,30 @@ func (m *ExtensionMutation) OldDisplayName(ctx context.Context) (v string, err e
 }
 
 // ClearDisplayName clears the value of the "display_name" field.
-func (m *ExtensionMutation) ClearDisplayName() {
+func (m *ParameterTemplateMutation) ClearDisplayName() {
 	m.display_name = nil
-	m.clearedFields[extension.FieldDisplayName] = struct{}{}
+	m.clearedFields[parametertemplate.FieldDisplayName] = struct{}{}
 }
 
 // DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *ExtensionMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[extension.FieldDisplayName]
+func (m *ParameterTemplateMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldDisplayName]
 	return ok
 }
 
 // ResetDisplayName resets all changes to the "display_name" field.
-func (m *ExtensionMutation) ResetDisplayName() {
+func (m *ParameterTemplateMutation) ResetDisplayName() {
 	m.display_name = nil
-	delete(m.clearedFields, extension.FieldDisplayName)
+	delete(m.clearedFields, parametertemplate.FieldDisplayName)
 }
 
 // SetDisplayNameLc sets the "display_name_lc" field.
-func (m *ExtensionMutation) SetDisplayNameLc(s string) {
+func (m *ParameterTemplateMutation) SetDisplayNameLc(s string) {
 	m.display_name_lc = &s
 }
 
 // DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *ExtensionMutation) DisplayNameLc() (r string, exists bool) {
+func (m *ParameterTemplateMutation) DisplayNameLc() (r string, exists bool) {
 	v := m.display_name_lc
 	if v == nil {
 		return
@@ -8684,10 +14745,10 @@ func (m *ExtensionMutation) DisplayNameLc() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
 	}
@@ -8702,468 +14763,435 @@ func (m *ExtensionMutation) OldDisplayNameLc(ctx context.Context) (v string, err
 }
 
 // ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *ExtensionMutation) ClearDisplayNameLc() {
+func (m *ParameterTemplateMutation) ClearDisplayNameLc() {
 	m.display_name_lc = nil
-	m.clearedFields[extension.FieldDisplayNameLc] = struct{}{}
+	m.clearedFields[parametertemplate.FieldDisplayNameLc] = struct{}{}
 }
 
 // DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *ExtensionMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[extension.FieldDisplayNameLc]
+func (m *ParameterTemplateMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldDisplayNameLc]
 	return ok
 }
 
 // ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *ExtensionMutation) ResetDisplayNameLc() {
+func (m *ParameterTemplateMutation) ResetDisplayNameLc() {
 	m.display_name_lc = nil
-	delete(m.clearedFields, extension.FieldDisplayNameLc)
-}
-
-// SetDescription sets the "description" field.
-func (m *ExtensionMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *ExtensionMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ExtensionMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[extension.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ExtensionMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[extension.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ExtensionMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, extension.FieldDescription)
+	delete(m.clearedFields, parametertemplate.FieldDisplayNameLc)
 }
 
-// SetUILabel sets the "ui_label" field.
-func (m *ExtensionMutation) SetUILabel(s string) {
-	m.ui_label = &s
+// SetDefault sets the "default" field.
+func (m *ParameterTemplateMutation) SetDefault(s string) {
+	m._default = &s
 }
 
-// UILabel returns the value of the "ui_label" field in the mutation.
-func (m *ExtensionMutation) UILabel() (r string, exists bool) {
-	v := m.ui_label
+// Default returns the value of the "default" field in the mutation.
+func (m *ParameterTemplateMutation) Default() (r string, exists bool) {
+	v := m._default
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUILabel returns the old "ui_label" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldDefault returns the old "default" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldUILabel(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldDefault(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUILabel is only allowed on UpdateOne operations")
+		return v, errors.New("OldDefault is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUILabel requires an ID field in the mutation")
+		return v, errors.New("OldDefault requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUILabel: %w", err)
+		return v, fmt.Errorf("querying old value for OldDefault: %w", err)
 	}
-	return oldValue.UILabel, nil
+	return oldValue.Default, nil
 }
 
-// ClearUILabel clears the value of the "ui_label" field.
-func (m *ExtensionMutation) ClearUILabel() {
-	m.ui_label = nil
-	m.clearedFields[extension.FieldUILabel] = struct{}{}
+// ClearDefault clears the value of the "default" field.
+func (m *ParameterTemplateMutation) ClearDefault() {
+	m._default = nil
+	m.clearedFields[parametertemplate.FieldDefault] = struct{}{}
 }
 
-// UILabelCleared returns if the "ui_label" field was cleared in this mutation.
-func (m *ExtensionMutation) UILabelCleared() bool {
-	_, ok := m.clearedFields[extension.FieldUILabel]
+// DefaultCleared returns if the "default" field was cleared in this mutation.
+func (m *ParameterTemplateMutation) DefaultCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldDefault]
 	return ok
 }
 
-// ResetUILabel resets all changes to the "ui_label" field.
-func (m *ExtensionMutation) ResetUILabel() {
-	m.ui_label = nil
-	delete(m.clearedFields, extension.FieldUILabel)
+// ResetDefault resets all changes to the "default" field.
+func (m *ParameterTemplateMutation) ResetDefault() {
+	m._default = nil
+	delete(m.clearedFields, parametertemplate.FieldDefault)
 }
 
-// SetUIServiceName sets the "ui_service_name" field.
-func (m *ExtensionMutation) SetUIServiceName(s string) {
-	m.ui_service_name = &s
+// SetType sets the "type" field.
+func (m *ParameterTemplateMutation) SetType(s string) {
+	m._type = &s
 }
 
-// UIServiceName returns the value of the "ui_service_name" field in the mutation.
-func (m *ExtensionMutation) UIServiceName() (r string, exists bool) {
-	v := m.ui_service_name
+// GetType returns the value of the "type" field in the mutation.
+func (m *ParameterTemplateMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUIServiceName returns the old "ui_service_name" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldUIServiceName(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUIServiceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUIServiceName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUIServiceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.UIServiceName, nil
+	return oldValue.Type, nil
 }
 
-// ClearUIServiceName clears the value of the "ui_service_name" field.
-func (m *ExtensionMutation) ClearUIServiceName() {
-	m.ui_service_name = nil
-	m.clearedFields[extension.FieldUIServiceName] = struct{}{}
+// ClearType clears the value of the "type" field.
+func (m *ParameterTemplateMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[parametertemplate.FieldType] = struct{}{}
 }
 
-// UIServiceNameCleared returns if the "ui_service_name" field was cleared in this mutation.
-func (m *ExtensionMutation) UIServiceNameCleared() bool {
-	_, ok := m.clearedFields[extension.FieldUIServiceName]
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *ParameterTemplateMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldType]
 	return ok
 }
 
-// ResetUIServiceName resets all changes to the "ui_service_name" field.
-func (m *ExtensionMutation) ResetUIServiceName() {
-	m.ui_service_name = nil
-	delete(m.clearedFields, extension.FieldUIServiceName)
+// ResetType resets all changes to the "type" field.
+func (m *ParameterTemplateMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, parametertemplate.FieldType)
 }
 
-// SetUIDescription sets the "ui_description" field.
-func (m *ExtensionMutation) SetUIDescription(s string) {
-	m.ui_description = &s
+// SetValidator sets the "validator" field.
+func (m *ParameterTemplateMutation) SetValidator(s string) {
+	m.validator = &s
 }
 
-// UIDescription returns the value of the "ui_description" field in the mutation.
-func (m *ExtensionMutation) UIDescription() (r string, exists bool) {
-	v := m.ui_description
+// Validator returns the value of the "validator" field in the mutation.
+func (m *ParameterTemplateMutation) Validator() (r string, exists bool) {
+	v := m.validator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUIDescription returns the old "ui_description" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldValidator returns the old "validator" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldUIDescription(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldValidator(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUIDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldValidator is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUIDescription requires an ID field in the mutation")
+		return v, errors.New("OldValidator requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUIDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldValidator: %w", err)
 	}
-	return oldValue.UIDescription, nil
+	return oldValue.Validator, nil
 }
 
-// ClearUIDescription clears the value of the "ui_description" field.
-func (m *ExtensionMutation) ClearUIDescription() {
-	m.ui_description = nil
-	m.clearedFields[extension.FieldUIDescription] = struct{}{}
+// ClearValidator clears the value of the "validator" field.
+func (m *ParameterTemplateMutation) ClearValidator() {
+	m.validator = nil
+	m.clearedFields[parametertemplate.FieldValidator] = struct{}{}
 }
 
-// UIDescriptionCleared returns if the "ui_description" field was cleared in this mutation.
-func (m *ExtensionMutation) UIDescriptionCleared() bool {
-	_, ok := m.clearedFields[extension.FieldUIDescription]
+// ValidatorCleared returns if the "validator" field was cleared in this mutation.
+func (m *ParameterTemplateMutation) ValidatorCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldValidator]
 	return ok
 }
 
-// ResetUIDescription resets all changes to the "ui_description" field.
-func (m *ExtensionMutation) ResetUIDescription() {
-	m.ui_description = nil
-	delete(m.clearedFields, extension.FieldUIDescription)
+// ResetValidator resets all changes to the "validator" field.
+func (m *ParameterTemplateMutation) ResetValidator() {
+	m.validator = nil
+	delete(m.clearedFields, parametertemplate.FieldValidator)
 }
 
-// SetUIFileName sets the "ui_file_name" field.
-func (m *ExtensionMutation) SetUIFileName(s string) {
-	m.ui_file_name = &s
+// SetSuggestedValues sets the "suggested_values" field.
+func (m *ParameterTemplateMutation) SetSuggestedValues(s []string) {
+	m.suggested_values = &s
+	m.appendsuggested_values = nil
 }
 
-// UIFileName returns the value of the "ui_file_name" field in the mutation.
-func (m *ExtensionMutation) UIFileName() (r string, exists bool) {
-	v := m.ui_file_name
+// SuggestedValues returns the value of the "suggested_values" field in the mutation.
+func (m *ParameterTemplateMutation) SuggestedValues() (r []string, exists bool) {
+	v := m.suggested_values
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUIFileName returns the old "ui_file_name" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldSuggestedValues returns the old "suggested_values" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldUIFileName(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldSuggestedValues(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUIFileName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuggestedValues is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUIFileName requires an ID field in the mutation")
+		return v, errors.New("OldSuggestedValues requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUIFileName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuggestedValues: %w", err)
 	}
-	return oldValue.UIFileName, nil
+	return oldValue.SuggestedValues, nil
 }
 
-// ClearUIFileName clears the value of the "ui_file_name" field.
-func (m *ExtensionMutation) ClearUIFileName() {
-	m.ui_file_name = nil
-	m.clearedFields[extension.FieldUIFileName] = struct{}{}
+// AppendSuggestedValues adds s to the "suggested_values" field.
+func (m *ParameterTemplateMutation) AppendSuggestedValues(s []string) {
+	m.appendsuggested_values = append(m.appendsuggested_values, s...)
 }
 
-// UIFileNameCleared returns if the "ui_file_name" field was cleared in this mutation.
-func (m *ExtensionMutation) UIFileNameCleared() bool {
-	_, ok := m.clearedFields[extension.FieldUIFileName]
+// AppendedSuggestedValues returns the list of values that were appended to the "suggested_values" field in this mutation.
+func (m *ParameterTemplateMutation) AppendedSuggestedValues() ([]string, bool) {
+	if len(m.appendsuggested_values) == 0 {
+		return nil, false
+	}
+	return m.appendsuggested_values, true
+}
+
+// ClearSuggestedValues clears the value of the "suggested_values" field.
+func (m *ParameterTemplateMutation) ClearSuggestedValues() {
+	m.suggested_values = nil
+	m.appendsuggested_values = nil
+	m.clearedFields[parametertemplate.FieldSuggestedValues] = struct{}{}
+}
+
+// SuggestedValuesCleared returns if the "suggested_values" field was cleared in this mutation.
+func (m *ParameterTemplateMutation) SuggestedValuesCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldSuggestedValues]
 	return ok
 }
 
-// ResetUIFileName resets all changes to the "ui_file_name" field.
-func (m *ExtensionMutation) ResetUIFileName() {
-	m.ui_file_name = nil
-	delete(m.clearedFields, extension.FieldUIFileName)
+// ResetSuggestedValues resets all changes to the "suggested_values" field.
+func (m *ParameterTemplateMutation) ResetSuggestedValues() {
+	m.suggested_values = nil
+	m.appendsuggested_values = nil
+	delete(m.clearedFields, parametertemplate.FieldSuggestedValues)
 }
 
-// SetUIAppName sets the "ui_app_name" field.
-func (m *ExtensionMutation) SetUIAppName(s string) {
-	m.ui_app_name = &s
+// SetMandatory sets the "mandatory" field.
+func (m *ParameterTemplateMutation) SetMandatory(b bool) {
+	m.mandatory = &b
 }
 
-// UIAppName returns the value of the "ui_app_name" field in the mutation.
-func (m *ExtensionMutation) UIAppName() (r string, exists bool) {
-	v := m.ui_app_name
+// Mandatory returns the value of the "mandatory" field in the mutation.
+func (m *ParameterTemplateMutation) Mandatory() (r bool, exists bool) {
+	v := m.mandatory
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUIAppName returns the old "ui_app_name" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldMandatory returns the old "mandatory" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldUIAppName(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldMandatory(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUIAppName is only allowed on UpdateOne operations")
+		return v, errors.New("OldMandatory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUIAppName requires an ID field in the mutation")
+		return v, errors.New("OldMandatory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUIAppName: %w", err)
+		return v, fmt.Errorf("querying old value for OldMandatory: %w", err)
 	}
-	return oldValue.UIAppName, nil
+	return oldValue.Mandatory, nil
 }
 
-// ClearUIAppName clears the value of the "ui_app_name" field.
-func (m *ExtensionMutation) ClearUIAppName() {
-	m.ui_app_name = nil
-	m.clearedFields[extension.FieldUIAppName] = struct{}{}
+// ClearMandatory clears the value of the "mandatory" field.
+func (m *ParameterTemplateMutation) ClearMandatory() {
+	m.mandatory = nil
+	m.clearedFields[parametertemplate.FieldMandatory] = struct{}{}
 }
 
-// UIAppNameCleared returns if the "ui_app_name" field was cleared in this mutation.
-func (m *ExtensionMutation) UIAppNameCleared() bool {
-	_, ok := m.clearedFields[extension.FieldUIAppName]
+// MandatoryCleared returns if the "mandatory" field was cleared in this mutation.
+func (m *ParameterTemplateMutation) MandatoryCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldMandatory]
 	return ok
 }
 
-// ResetUIAppName resets all changes to the "ui_app_name" field.
-func (m *ExtensionMutation) ResetUIAppName() {
-	m.ui_app_name = nil
-	delete(m.clearedFields, extension.FieldUIAppName)
+// ResetMandatory resets all changes to the "mandatory" field.
+func (m *ParameterTemplateMutation) ResetMandatory() {
+	m.mandatory = nil
+	delete(m.clearedFields, parametertemplate.FieldMandatory)
 }
 
-// SetUIModuleName sets the "ui_module_name" field.
-func (m *ExtensionMutation) SetUIModuleName(s string) {
-	m.ui_module_name = &s
+// SetSecret sets the "secret" field.
+func (m *ParameterTemplateMutation) SetSecret(b bool) {
+	m.secret = &b
 }
 
-// UIModuleName returns the value of the "ui_module_name" field in the mutation.
-func (m *ExtensionMutation) UIModuleName() (r string, exists bool) {
-	v := m.ui_module_name
+// Secret returns the value of the "secret" field in the mutation.
+func (m *ParameterTemplateMutation) Secret() (r bool, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUIModuleName returns the old "ui_module_name" field's value of the Extension entity.
-// If the Extension object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the ParameterTemplate entity.
+// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtensionMutation) OldUIModuleName(ctx context.Context) (v string, err error) {
+func (m *ParameterTemplateMutation) OldSecret(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUIModuleName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUIModuleName requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUIModuleName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.UIModuleName, nil
+	return oldValue.Secret, nil
 }
 
-// ClearUIModuleName clears the value of the "ui_module_name" field.
-func (m *ExtensionMutation) ClearUIModuleName() {
-	m.ui_module_name = nil
-	m.clearedFields[extension.FieldUIModuleName] = struct{}{}
+// ClearSecret clears the value of the "secret" field.
+func (m *ParameterTemplateMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[parametertemplate.FieldSecret] = struct{}{}
 }
 
-// UIModuleNameCleared returns if the "ui_module_name" field was cleared in this mutation.
-func (m *ExtensionMutation) UIModuleNameCleared() bool {
-	_, ok := m.clearedFields[extension.FieldUIModuleName]
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *ParameterTemplateMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[parametertemplate.FieldSecret]
 	return ok
 }
 
-// ResetUIModuleName resets all changes to the "ui_module_name" field.
-func (m *ExtensionMutation) ResetUIModuleName() {
-	m.ui_module_name = nil
-	delete(m.clearedFields, extension.FieldUIModuleName)
+// ResetSecret resets all changes to the "secret" field.
+func (m *ParameterTemplateMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, parametertemplate.FieldSecret)
 }
 
-// AddEndpointIDs adds the "endpoints" edge to the Endpoint entity by ids.
-func (m *ExtensionMutation) AddEndpointIDs(ids ...uint64) {
-	if m.endpoints == nil {
-		m.endpoints = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.endpoints[ids[i]] = struct{}{}
-	}
+// SetProfileFkID sets the "profile_fk" edge to the Profile entity by id.
+func (m *ParameterTemplateMutation) SetProfileFkID(id uint64) {
+	m.profile_fk = &id
 }
 
-// ClearEndpoints clears the "endpoints" edge to the Endpoint entity.
-func (m *ExtensionMutation) ClearEndpoints() {
-	m.clearedendpoints = true
+// ClearProfileFk clears the "profile_fk" edge to the Profile entity.
+func (m *ParameterTemplateMutation) ClearProfileFk() {
+	m.clearedprofile_fk = true
 }
 
-// EndpointsCleared reports if the "endpoints" edge to the Endpoint entity was cleared.
-func (m *ExtensionMutation) EndpointsCleared() bool {
-	return m.clearedendpoints
+// ProfileFkCleared reports if the "profile_fk" edge to the Profile entity was cleared.
+func (m *ParameterTemplateMutation) ProfileFkCleared() bool {
+	return m.clearedprofile_fk
 }
 
-// RemoveEndpointIDs removes the "endpoints" edge to the Endpoint entity by IDs.
-func (m *ExtensionMutation) RemoveEndpointIDs(ids ...uint64) {
-	if m.removedendpoints == nil {
-		m.removedendpoints = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.endpoints, ids[i])
-		m.removedendpoints[ids[i]] = struct{}{}
+// ProfileFkID returns the "profile_fk" edge ID in the mutation.
+func (m *ParameterTemplateMutation) ProfileFkID() (id uint64, exists bool) {
+	if m.profile_fk != nil {
+		return *m.profile_fk, true
 	}
+	return
 }
 
-// RemovedEndpoints returns the removed IDs of the "endpoints" edge to the Endpoint entity.
-func (m *ExtensionMutation) RemovedEndpointsIDs() (ids []uint64) {
-	for id := range m.removedendpoints {
-		ids = append(ids, id)
+// ProfileFkIDs returns the "profile_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileFkID instead. It exists only for internal usage by the builders.
+func (m *ParameterTemplateMutation) ProfileFkIDs() (ids []uint64) {
+	if id := m.profile_fk; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// EndpointsIDs returns the "endpoints" edge IDs in the mutation.
-func (m *ExtensionMutation) EndpointsIDs() (ids []uint64) {
-	for id := range m.endpoints {
-		ids = append(ids, id)
-	}
-	return
+// ResetProfileFk resets all changes to the "profile_fk" edge.
+func (m *ParameterTemplateMutation) ResetProfileFk() {
+	m.profile_fk = nil
+	m.clearedprofile_fk = false
 }
 
-// ResetEndpoints resets all changes to the "endpoints" edge.
-func (m *ExtensionMutation) ResetEndpoints() {
-	m.endpoints = nil
-	m.clearedendpoints = false
-	m.removedendpoints = nil
+// AddProfileIDs adds the "profiles" edge to the Profile entity by ids.
+func (m *ParameterTemplateMutation) AddProfileIDs(ids ...uint64) {
+	if m.profiles == nil {
+		m.profiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.profiles[ids[i]] = struct{}{}
+	}
 }
 
-// SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
-func (m *ExtensionMutation) SetDeploymentPackageFkID(id uint64) {
-	m.deployment_package_fk = &id
+// ClearProfiles clears the "profiles" edge to the Profile entity.
+func (m *ParameterTemplateMutation) ClearProfiles() {
+	m.clearedprofiles = true
 }
 
-// ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
-func (m *ExtensionMutation) ClearDeploymentPackageFk() {
-	m.cleareddeployment_package_fk = true
+// ProfilesCleared reports if the "profiles" edge to the Profile entity was cleared.
+func (m *ParameterTemplateMutation) ProfilesCleared() bool {
+	return m.clearedprofiles
 }
 
-// DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
-func (m *ExtensionMutation) DeploymentPackageFkCleared() bool {
-	return m.cleareddeployment_package_fk
+// RemoveProfileIDs removes the "profiles" edge to the Profile entity by IDs.
+func (m *ParameterTemplateMutation) RemoveProfileIDs(ids ...uint64) {
+	if m.removedprofiles == nil {
+		m.removedprofiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.profiles, ids[i])
+		m.removedprofiles[ids[i]] = struct{}{}
+	}
 }
 
-// DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
-func (m *ExtensionMutation) DeploymentPackageFkID() (id uint64, exists bool) {
-	if m.deployment_package_fk != nil {
-		return *m.deployment_package_fk, true
+// RemovedProfiles returns the removed IDs of the "profiles" edge to the Profile entity.
+func (m *ParameterTemplateMutation) RemovedProfilesIDs() (ids []uint64) {
+	for id := range m.removedprofiles {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentPackageFkID instead. It exists only for internal usage by the builders.
-func (m *ExtensionMutation) DeploymentPackageFkIDs() (ids []uint64) {
-	if id := m.deployment_package_fk; id != nil {
-		ids = append(ids, *id)
+// ProfilesIDs returns the "profiles" edge IDs in the mutation.
+func (m *ParameterTemplateMutation) ProfilesIDs() (ids []uint64) {
+	for id := range m.profiles {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
-func (m *ExtensionMutation) ResetDeploymentPackageFk() {
-	m.deployment_package_fk = nil
-	m.cleareddeployment_package_fk = false
+// ResetProfiles resets all changes to the "profiles" edge.
+func (m *ParameterTemplateMutation) ResetProfiles() {
+	m.profiles = nil
+	m.clearedprofiles = false
+	m.removedprofiles = nil
 }
 
-// Where appends a list predicates to the ExtensionMutation builder.
-func (m *ExtensionMutation) Where(ps ...predicate.Extension) {
+// Where appends a list predicates to the ParameterTemplateMutation builder.
+func (m *ParameterTemplateMutation) Where(ps ...predicate.ParameterTemplate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ExtensionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ParameterTemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExtensionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Extension, len(ps))
+func (m *ParameterTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ParameterTemplate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9171,57 +15199,51 @@ func (m *ExtensionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ExtensionMutation) Op() Op {
+func (m *ParameterTemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ExtensionMutation) SetOp(op Op) {
+func (m *ParameterTemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Extension).
-func (m *ExtensionMutation) Type() string {
+// Type returns the node type of this mutation (ParameterTemplate).
+func (m *ParameterTemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ExtensionMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *ParameterTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.name != nil {
-		fields = append(fields, extension.FieldName)
-	}
-	if m.version != nil {
-		fields = append(fields, extension.FieldVersion)
+		fields = append(fields, parametertemplate.FieldName)
 	}
 	if m.display_name != nil {
-		fields = append(fields, extension.FieldDisplayName)
+		fields = append(fields, parametertemplate.FieldDisplayName)
 	}
 	if m.display_name_lc != nil {
-		fields = append(fields, extension.FieldDisplayNameLc)
-	}
-	if m.description != nil {
-		fields = append(fields, extension.FieldDescription)
+		fields = append(fields, parametertemplate.FieldDisplayNameLc)
 	}
-	if m.ui_label != nil {
-		fields = append(fields, extension.FieldUILabel)
+	if m._default != nil {
+		fields = append(fields, parametertemplate.FieldDefault)
 	}
-	if m.ui_service_name != nil {
-		fields = append(fields, extension.FieldUIServiceName)
+	if m._type != nil {
+		fields = append(fields, parametertemplate.FieldType)
 	}
-	if m.ui_description != nil {
-		fields = append(fields, extension.FieldUIDescription)
+	if m.validator != nil {
+		fields = append(fields, parametertemplate.FieldValidator)
 	}
-	if m.ui_file_name != nil {
-		fields = append(fields, extension.FieldUIFileName)
+	if m.suggested_values != nil {
+		fields = append(fields, parametertemplate.FieldSuggestedValues)
 	}
-	if m.ui_app_name != nil {
-		fields = append(fields, extension.FieldUIAppName)
+	if m.mandatory != nil {
+		fields = append(fields, parametertemplate.FieldMandatory)
 	}
-	if m.ui_module_name != nil {
-		fields = append(fields, extension.FieldUIModuleName)
+	if m.secret != nil {
+		fields = append(fields, parametertemplate.FieldSecret)
 	}
 	return fields
 }
@@ -9229,30 +15251,26 @@ func (m *ExtensionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExtensionMutation) Field(name string) (ent.Value, bool) {
+func (m *ParameterTemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case extension.FieldName:
+	case parametertemplate.FieldName:
 		return m.Name()
-	case extension.FieldVersion:
-		return m.Version()
-	case extension.FieldDisplayName:
+	case parametertemplate.FieldDisplayName:
 		return m.DisplayName()
-	case extension.FieldDisplayNameLc:
+	case parametertemplate.FieldDisplayNameLc:
 		return m.DisplayNameLc()
-	case extension.FieldDescription:
-		return m.Description()
-	case extension.FieldUILabel:
-		return m.UILabel()
-	case extension.FieldUIServiceName:
-		return m.UIServiceName()
-	case extension.FieldUIDescription:
-		return m.UIDescription()
-	case extension.FieldUIFileName:
-		return m.UIFileName()
-	case extension.FieldUIAppName:
-		return m.UIAppName()
-	case extension.FieldUIModuleName:
-		return m.UIModuleName()
+	case parametertemplate.FieldDefault:
+		return m.Default()
+	case parametertemplate.FieldType:
+		return m.GetType()
+	case parametertemplate.FieldValidator:
+		return m.Validator()
+	case parametertemplate.FieldSuggestedValues:
+		return m.SuggestedValues()
+	case parametertemplate.FieldMandatory:
+		return m.Mandatory()
+	case parametertemplate.FieldSecret:
+		return m.Secret()
 	}
 	return nil, false
 }
@@ -9260,305 +15278,275 @@ func (m *ExtensionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExtensionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ParameterTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case extension.FieldName:
+	case parametertemplate.FieldName:
 		return m.OldName(ctx)
-	case extension.FieldVersion:
-		return m.OldVersion(ctx)
-	case extension.FieldDisplayName:
+	case parametertemplate.FieldDisplayName:
 		return m.OldDisplayName(ctx)
-	case extension.FieldDisplayNameLc:
+	case parametertemplate.FieldDisplayNameLc:
 		return m.OldDisplayNameLc(ctx)
-	case extension.FieldDescription:
-		return m.OldDescription(ctx)
-	case extension.FieldUILabel:
-		return m.OldUILabel(ctx)
-	case extension.FieldUIServiceName:
-		return m.OldUIServiceName(ctx)
-	case extension.FieldUIDescription:
-		return m.OldUIDescription(ctx)
-	case extension.FieldUIFileName:
-		return m.OldUIFileName(ctx)
-	case extension.FieldUIAppName:
-		return m.OldUIAppName(ctx)
-	case extension.FieldUIModuleName:
-		return m.OldUIModuleName(ctx)
+	case parametertemplate.FieldDefault:
+		return m.OldDefault(ctx)
+	case parametertemplate.FieldType:
+		return m.OldType(ctx)
+	case parametertemplate.FieldValidator:
+		return m.OldValidator(ctx)
+	case parametertemplate.FieldSuggestedValues:
+		return m.OldSuggestedValues(ctx)
+	case parametertemplate.FieldMandatory:
+		return m.OldMandatory(ctx)
+	case parametertemplate.FieldSecret:
+		return m.OldSecret(ctx)
 	}
-	return nil, fmt.Errorf("unknown Extension field %s", name)
+	return nil, fmt.Errorf("unknown ParameterTemplate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtensionMutation) SetField(name string, value ent.Value) error {
+func (m *ParameterTemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case extension.FieldName:
+	case parametertemplate.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case extension.FieldVersion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVersion(v)
-		return nil
-	case extension.FieldDisplayName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayName(v)
-		return nil
-	case extension.FieldDisplayNameLc:
+	case parametertemplate.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayNameLc(v)
+		m.SetDisplayName(v)
 		return nil
-	case extension.FieldDescription:
+	case parametertemplate.FieldDisplayNameLc:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetDisplayNameLc(v)
 		return nil
-	case extension.FieldUILabel:
+	case parametertemplate.FieldDefault:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUILabel(v)
+		m.SetDefault(v)
 		return nil
-	case extension.FieldUIServiceName:
+	case parametertemplate.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUIServiceName(v)
+		m.SetType(v)
 		return nil
-	case extension.FieldUIDescription:
+	case parametertemplate.FieldValidator:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUIDescription(v)
+		m.SetValidator(v)
 		return nil
-	case extension.FieldUIFileName:
-		v, ok := value.(string)
+	case parametertemplate.FieldSuggestedValues:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUIFileName(v)
+		m.SetSuggestedValues(v)
 		return nil
-	case extension.FieldUIAppName:
-		v, ok := value.(string)
+	case parametertemplate.FieldMandatory:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUIAppName(v)
+		m.SetMandatory(v)
 		return nil
-	case extension.FieldUIModuleName:
-		v, ok := value.(string)
+	case parametertemplate.FieldSecret:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUIModuleName(v)
+		m.SetSecret(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Extension field %s", name)
+	return fmt.Errorf("unknown ParameterTemplate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExtensionMutation) AddedFields() []string {
+func (m *ParameterTemplateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExtensionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ParameterTemplateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtensionMutation) AddField(name string, value ent.Value) error {
+func (m *ParameterTemplateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Extension numeric field %s", name)
+	return fmt.Errorf("unknown ParameterTemplate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExtensionMutation) ClearedFields() []string {
+func (m *ParameterTemplateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(extension.FieldDisplayName) {
-		fields = append(fields, extension.FieldDisplayName)
-	}
-	if m.FieldCleared(extension.FieldDisplayNameLc) {
-		fields = append(fields, extension.FieldDisplayNameLc)
+	if m.FieldCleared(parametertemplate.FieldDisplayName) {
+		fields = append(fields, parametertemplate.FieldDisplayName)
 	}
-	if m.FieldCleared(extension.FieldDescription) {
-		fields = append(fields, extension.FieldDescription)
+	if m.FieldCleared(parametertemplate.FieldDisplayNameLc) {
+		fields = append(fields, parametertemplate.FieldDisplayNameLc)
 	}
-	if m.FieldCleared(extension.FieldUILabel) {
-		fields = append(fields, extension.FieldUILabel)
+	if m.FieldCleared(parametertemplate.FieldDefault) {
+		fields = append(fields, parametertemplate.FieldDefault)
 	}
-	if m.FieldCleared(extension.FieldUIServiceName) {
-		fields = append(fields, extension.FieldUIServiceName)
+	if m.FieldCleared(parametertemplate.FieldType) {
+		fields = append(fields, parametertemplate.FieldType)
 	}
-	if m.FieldCleared(extension.FieldUIDescription) {
-		fields = append(fields, extension.FieldUIDescription)
+	if m.FieldCleared(parametertemplate.FieldValidator) {
+		fields = append(fields, parametertemplate.FieldValidator)
 	}
-	if m.FieldCleared(extension.FieldUIFileName) {
-		fields = append(fields, extension.FieldUIFileName)
+	if m.FieldCleared(parametertemplate.FieldSuggestedValues) {
+		fields = append(fields, parametertemplate.FieldSuggestedValues)
 	}
-	if m.FieldCleared(extension.FieldUIAppName) {
-		fields = append(fields, extension.FieldUIAppName)
+	if m.FieldCleared(parametertemplate.FieldMandatory) {
+		fields = append(fields, parametertemplate.FieldMandatory)
 	}
-	if m.FieldCleared(extension.FieldUIModuleName) {
-		fields = append(fields, extension.FieldUIModuleName)
+	if m.FieldCleared(parametertemplate.FieldSecret) {
+		fields = append(fields, parametertemplate.FieldSecret)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExtensionMutation) FieldCleared(name string) bool {
+func (m *ParameterTemplateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExtensionMutation) ClearField(name string) error {
+func (m *ParameterTemplateMutation) ClearField(name string) error {
 	switch name {
-	case extension.FieldDisplayName:
+	case parametertemplate.FieldDisplayName:
 		m.ClearDisplayName()
 		return nil
-	case extension.FieldDisplayNameLc:
+	case parametertemplate.FieldDisplayNameLc:
 		m.ClearDisplayNameLc()
 		return nil
-	case extension.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case extension.FieldUILabel:
-		m.ClearUILabel()
+	case parametertemplate.FieldDefault:
+		m.ClearDefault()
 		return nil
-	case extension.FieldUIServiceName:
-		m.ClearUIServiceName()
+	case parametertemplate.FieldType:
+		m.ClearType()
 		return nil
-	case extension.FieldUIDescription:
-		m.ClearUIDescription()
+	case parametertemplate.FieldValidator:
+		m.ClearValidator()
 		return nil
-	case extension.FieldUIFileName:
-		m.ClearUIFileName()
+	case parametertemplate.FieldSuggestedValues:
+		m.ClearSuggestedValues()
 		return nil
-	case extension.FieldUIAppName:
-		m.ClearUIAppName()
+	case parametertemplate.FieldMandatory:
+		m.ClearMandatory()
 		return nil
-	case extension.FieldUIModuleName:
-		m.ClearUIModuleName()
+	case parametertemplate.FieldSecret:
+		m.ClearSecret()
 		return nil
 	}
-	return fmt.Errorf("unknown Extension nullable field %s", name)
+	return fmt.Errorf("unknown ParameterTemplate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExtensionMutation) ResetField(name string) error {
+func (m *ParameterTemplateMutation) ResetField(name string) error {
 	switch name {
-	case extension.FieldName:
+	case parametertemplate.FieldName:
 		m.ResetName()
 		return nil
-	case extension.FieldVersion:
-		m.ResetVersion()
-		return nil
-	case extension.FieldDisplayName:
+	case parametertemplate.FieldDisplayName:
 		m.ResetDisplayName()
 		return nil
-	case extension.FieldDisplayNameLc:
+	case parametertemplate.FieldDisplayNameLc:
 		m.ResetDisplayNameLc()
 		return nil
-	case extension.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case extension.FieldUILabel:
-		m.ResetUILabel()
+	case parametertemplate.FieldDefault:
+		m.ResetDefault()
 		return nil
-	case extension.FieldUIServiceName:
-		m.ResetUIServiceName()
+	case parametertemplate.FieldType:
+		m.ResetType()
 		return nil
-	case extension.FieldUIDescription:
-		m.ResetUIDescription()
+	case parametertemplate.FieldValidator:
+		m.ResetValidator()
 		return nil
-	case extension.FieldUIFileName:
-		m.ResetUIFileName()
+	case parametertemplate.FieldSuggestedValues:
+		m.ResetSuggestedValues()
 		return nil
-	case extension.FieldUIAppName:
-		m.ResetUIAppName()
+	case parametertemplate.FieldMandatory:
+		m.ResetMandatory()
 		return nil
-	case extension.FieldUIModuleName:
-		m.ResetUIModuleName()
+	case parametertemplate.FieldSecret:
+		m.ResetSecret()
 		return nil
 	}
-	return fmt.Errorf("unknown Extension field %s", name)
+	return fmt.Errorf("unknown ParameterTemplate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExtensionMutation) AddedEdges() []string {
+func (m *ParameterTemplateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.endpoints != nil {
-		edges = append(edges, extension.EdgeEndpoints)
+	if m.profile_fk != nil {
+		edges = append(edges, parametertemplate.EdgeProfileFk)
 	}
-	if m.deployment_package_fk != nil {
-		edges = append(edges, extension.EdgeDeploymentPackageFk)
+	if m.profiles != nil {
+		edges = append(edges, parametertemplate.EdgeProfiles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExtensionMutation) AddedIDs(name string) []ent.Value {
+func (m *ParameterTemplateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case extension.EdgeEndpoints:
-		ids := make([]ent.Value, 0, len(m.endpoints))
-		for id := range m.endpoints {
+	case parametertemplate.EdgeProfileFk:
+		if id := m.profile_fk; id != nil {
+			return []ent.Value{*id}
+		}
+	case parametertemplate.EdgeProfiles:
+		ids := make([]ent.Value, 0, len(m.profiles))
+		for id := range m.profiles {
 			ids = append(ids, id)
 		}
 		return ids
-	case extension.EdgeDeploymentPackageFk:
-		if id := m.deployment_package_fk; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExtensionMutation) RemovedEdges() []string {
+func (m *ParameterTemplateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedendpoints != nil {
-		edges = append(edges, extension.EdgeEndpoints)
+	if m.removedprofiles != nil {
+		edges = append(edges, parametertemplate.EdgeProfiles)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExtensionMutation) RemovedIDs(name string) []ent.Value {
+func (m *ParameterTemplateMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case extension.EdgeEndpoints:
-		ids := make([]ent.Value, 0, len(m.removedendpoints))
-		for id := range m.removedendpoints {
+	case parametertemplate.EdgeProfiles:
+		ids := make([]ent.Value, 0, len(m.removedprofiles))
+		for id := range m.removedprofiles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9567,82 +15555,95 @@ func (m *ExtensionMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExtensionMutation) ClearedEdges() []string {
+func (m *ParameterTemplateMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedendpoints {
-		edges = append(edges, extension.EdgeEndpoints)
+	if m.clearedprofile_fk {
+		edges = append(edges, parametertemplate.EdgeProfileFk)
 	}
-	if m.cleareddeployment_package_fk {
-		edges = append(edges, extension.EdgeDeploymentPackageFk)
+	if m.clearedprofiles {
+		edges = append(edges, parametertemplate.EdgeProfiles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExtensionMutation) EdgeCleared(name string) bool {
+func (m *ParameterTemplateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case extension.EdgeEndpoints:
-		return m.clearedendpoints
-	case extension.EdgeDeploymentPackageFk:
-		return m.cleareddeployment_package_fk
+	case parametertemplate.EdgeProfileFk:
+		return m.clearedprofile_fk
+	case parametertemplate.EdgeProfiles:
+		return m.clearedprofiles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExtensionMutation) ClearEdge(name string) error {
+func (m *ParameterTemplateMutation) ClearEdge(name string) error {
 	switch name {
-	case extension.EdgeDeploymentPackageFk:
-		m.ClearDeploymentPackageFk()
+	case parametertemplate.EdgeProfileFk:
+		m.ClearProfileFk()
 		return nil
 	}
-	return fmt.Errorf("unknown Extension unique edge %s", name)
+	return fmt.Errorf("unknown ParameterTemplate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExtensionMutation) ResetEdge(name string) error {
+func (m *ParameterTemplateMutation) ResetEdge(name string) error {
 	switch name {
-	case extension.EdgeEndpoints:
-		m.ResetEndpoints()
+	case parametertemplate.EdgeProfileFk:
+		m.ResetProfileFk()
 		return nil
-	case extension.EdgeDeploymentPackageFk:
-		m.ResetDeploymentPackageFk()
+	case parametertemplate.EdgeProfiles:
+		m.ResetProfiles()
 		return nil
 	}
-	return fmt.Errorf("unknown Extension edge %s", name)
+	return fmt.Errorf("unknown ParameterTemplate edge %s", name)
 }
 
-// IgnoredResourceMutation represents an operation that mutates the IgnoredResource nodes in the graph.
-type IgnoredResourceMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *uint64
-	name                  *string
-	kind                  *string
-	namespace             *string
-	clearedFields         map[string]struct{}
-	application_fk        *uint64
-	clearedapplication_fk bool
-	done                  bool
-	oldValue              func(context.Context) (*IgnoredResource, error)
-	predicates            []predicate.IgnoredResource
+// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
+type ProfileMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uint64
+	name                           *string
+	display_name                   *string
+	display_name_lc                *string
+	description                    *string
+	create_time                    *time.Time
+	update_time                    *time.Time
+	chart_values                   *string
+	clearedFields                  map[string]struct{}
+	application_fk                 *uint64
+	clearedapplication_fk          bool
+	deployment_profiles            map[uint64]struct{}
+	removeddeployment_profiles     map[uint64]struct{}
+	cleareddeployment_profiles     bool
+	parameter_templates            map[uint64]struct{}
+	removedparameter_templates     map[uint64]struct{}
+	clearedparameter_templates     bool
+	deployment_requirements        map[uint64]struct{}
+	removeddeployment_requirements map[uint64]struct{}
+	cleareddeployment_requirements bool
+	done                           bool
+	oldValue                       func(context.Context) (*Profile, error)
+	predicates                     []predicate.Profile
 }
 
-var _ ent.Mutation = (*IgnoredResourceMutation)(nil)
+var _ ent.Mutation = (*ProfileMutation)(nil)
 
-// ignoredresourceOption allows management of the mutation configuration using functional options.
-type ignoredresourceOption func(*IgnoredResourceMutation)
+// profileOption allows management of the mutation configuration using functional options.
+type profileOption func(*ProfileMutation)
 
-// newIgnoredResourceMutation creates new mutation for the IgnoredResource entity.
-func newIgnoredResourceMutation(c config, op Op, opts ...ignoredresourceOption) *IgnoredResourceMutation {
-	m := &IgnoredResourceMutation{
+// newProfileMutation creates new mutation for the Profile entity.
+func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
+	m := &ProfileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIgnoredResource,
+		typ:           TypeProfile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9651,20 +15652,20 @@ func newIgnoredResourceMutation(c config, op Op, opts ...ignoredresourceOption)
 	return m
 }
 
-// withIgnoredResourceID sets the ID field of the mutation.
-func withIgnoredResourceID(id uint64) ignoredresourceOption {
-	return func(m *IgnoredResourceMutation) {
+// withProfileID sets the ID field of the mutation.
+func withProfileID(id uint64) profileOption {
+	return func(m *ProfileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *IgnoredResource
+			value *Profile
 		)
-		m.oldValue = func(ctx context.Context) (*IgnoredResource, error) {
+		m.oldValue = func(ctx context.Context) (*Profile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().IgnoredResource.Get(ctx, id)
+					value, err = m.Client().Profile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9673,10 +15674,10 @@ func withIgnoredResourceID(id uint64) ignoredresourceOption {
 	}
 }
 
-// withIgnoredResource sets the old IgnoredResource of the mutation.
-func withIgnoredResource(node *IgnoredResource) ignoredresourceOption {
-	return func(m *IgnoredResourceMutation) {
-		m.oldValue = func(context.Context) (*IgnoredResource, error) {
+// withProfile sets the old Profile of the mutation.
+func withProfile(node *Profile) profileOption {
+	return func(m *ProfileMutation) {
+		m.oldValue = func(context.Context) (*Profile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9685,7 +15686,7 @@ func withIgnoredResource(node *IgnoredResource) ignoredresourceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IgnoredResourceMutation) Client() *Client {
+func (m ProfileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9693,7 +15694,7 @@ func (m IgnoredResourceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IgnoredResourceMutation) Tx() (*Tx, error) {
+func (m ProfileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -9704,7 +15705,7 @@ func (m IgnoredResourceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IgnoredResourceMutation) ID() (id uint64, exists bool) {
+func (m *ProfileMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9715,7 +15716,7 @@ func (m *IgnoredResourceMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IgnoredResourceMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *ProfileMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9724,168 +15725,513 @@ func (m *IgnoredResourceMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().IgnoredResource.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *IgnoredResourceMutation) SetName(s string) {
+func (m *ProfileMutation) SetName(s string) {
 	m.name = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *IgnoredResourceMutation) Name() (r string, exists bool) {
-	v := m.name
+// Name returns the value of the "name" field in the mutation.
+func (m *ProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *ProfileMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *ProfileMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *ProfileMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[profile.FieldDisplayName] = struct{}{}
+}
+
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *ProfileMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[profile.FieldDisplayName]
+	return ok
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *ProfileMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, profile.FieldDisplayName)
+}
+
+// SetDisplayNameLc sets the "display_name_lc" field.
+func (m *ProfileMutation) SetDisplayNameLc(s string) {
+	m.display_name_lc = &s
+}
+
+// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
+func (m *ProfileMutation) DisplayNameLc() (r string, exists bool) {
+	v := m.display_name_lc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+	}
+	return oldValue.DisplayNameLc, nil
+}
+
+// ClearDisplayNameLc clears the value of the "display_name_lc" field.
+func (m *ProfileMutation) ClearDisplayNameLc() {
+	m.display_name_lc = nil
+	m.clearedFields[profile.FieldDisplayNameLc] = struct{}{}
+}
+
+// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
+func (m *ProfileMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[profile.FieldDisplayNameLc]
+	return ok
+}
+
+// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
+func (m *ProfileMutation) ResetDisplayNameLc() {
+	m.display_name_lc = nil
+	delete(m.clearedFields, profile.FieldDisplayNameLc)
+}
+
+// SetDescription sets the "description" field.
+func (m *ProfileMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProfileMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProfileMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[profile.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProfileMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[profile.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProfileMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, profile.FieldDescription)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ProfileMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ProfileMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the IgnoredResource entity.
-// If the IgnoredResource object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IgnoredResourceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *IgnoredResourceMutation) ResetName() {
-	m.name = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ProfileMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetKind sets the "kind" field.
-func (m *IgnoredResourceMutation) SetKind(s string) {
-	m.kind = &s
+// SetUpdateTime sets the "update_time" field.
+func (m *ProfileMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// Kind returns the value of the "kind" field in the mutation.
-func (m *IgnoredResourceMutation) Kind() (r string, exists bool) {
-	v := m.kind
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ProfileMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKind returns the old "kind" field's value of the IgnoredResource entity.
-// If the IgnoredResource object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IgnoredResourceMutation) OldKind(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKind requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.Kind, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetKind resets all changes to the "kind" field.
-func (m *IgnoredResourceMutation) ResetKind() {
-	m.kind = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ProfileMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetNamespace sets the "namespace" field.
-func (m *IgnoredResourceMutation) SetNamespace(s string) {
-	m.namespace = &s
+// SetChartValues sets the "chart_values" field.
+func (m *ProfileMutation) SetChartValues(s string) {
+	m.chart_values = &s
 }
 
-// Namespace returns the value of the "namespace" field in the mutation.
-func (m *IgnoredResourceMutation) Namespace() (r string, exists bool) {
-	v := m.namespace
+// ChartValues returns the value of the "chart_values" field in the mutation.
+func (m *ProfileMutation) ChartValues() (r string, exists bool) {
+	v := m.chart_values
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNamespace returns the old "namespace" field's value of the IgnoredResource entity.
-// If the IgnoredResource object wasn't provided to the builder, the object is fetched from the database.
+// OldChartValues returns the old "chart_values" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IgnoredResourceMutation) OldNamespace(ctx context.Context) (v string, err error) {
+func (m *ProfileMutation) OldChartValues(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+		return v, errors.New("OldChartValues is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNamespace requires an ID field in the mutation")
+		return v, errors.New("OldChartValues requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+		return v, fmt.Errorf("querying old value for OldChartValues: %w", err)
 	}
-	return oldValue.Namespace, nil
+	return oldValue.ChartValues, nil
 }
 
-// ResetNamespace resets all changes to the "namespace" field.
-func (m *IgnoredResourceMutation) ResetNamespace() {
-	m.namespace = nil
+// ResetChartValues resets all changes to the "chart_values" field.
+func (m *ProfileMutation) ResetChartValues() {
+	m.chart_values = nil
 }
 
 // SetApplicationFkID sets the "application_fk" edge to the Application entity by id.
-func (m *IgnoredResourceMutation) SetApplicationFkID(id uint64) {
+func (m *ProfileMutation) SetApplicationFkID(id uint64) {
 	m.application_fk = &id
 }
 
 // ClearApplicationFk clears the "application_fk" edge to the Application entity.
-func (m *IgnoredResourceMutation) ClearApplicationFk() {
+func (m *ProfileMutation) ClearApplicationFk() {
 	m.clearedapplication_fk = true
 }
 
 // ApplicationFkCleared reports if the "application_fk" edge to the Application entity was cleared.
-func (m *IgnoredResourceMutation) ApplicationFkCleared() bool {
+func (m *ProfileMutation) ApplicationFkCleared() bool {
 	return m.clearedapplication_fk
 }
 
-// ApplicationFkID returns the "application_fk" edge ID in the mutation.
-func (m *IgnoredResourceMutation) ApplicationFkID() (id uint64, exists bool) {
-	if m.application_fk != nil {
-		return *m.application_fk, true
+// ApplicationFkID returns the "application_fk" edge ID in the mutation.
+func (m *ProfileMutation) ApplicationFkID() (id uint64, exists bool) {
+	if m.application_fk != nil {
+		return *m.application_fk, true
+	}
+	return
+}
+
+// ApplicationFkIDs returns the "application_fk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApplicationFkID instead. It exists only for internal usage by the builders.
+func (m *ProfileMutation) ApplicationFkIDs() (ids []uint64) {
+	if id := m.application_fk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplicationFk resets all changes to the "application_fk" edge.
+func (m *ProfileMutation) ResetApplicationFk() {
+	m.application_fk = nil
+	m.clearedapplication_fk = false
+}
+
+// AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by ids.
+func (m *ProfileMutation) AddDeploymentProfileIDs(ids ...uint64) {
+	if m.deployment_profiles == nil {
+		m.deployment_profiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.deployment_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeploymentProfiles clears the "deployment_profiles" edge to the DeploymentProfile entity.
+func (m *ProfileMutation) ClearDeploymentProfiles() {
+	m.cleareddeployment_profiles = true
+}
+
+// DeploymentProfilesCleared reports if the "deployment_profiles" edge to the DeploymentProfile entity was cleared.
+func (m *ProfileMutation) DeploymentProfilesCleared() bool {
+	return m.cleareddeployment_profiles
+}
+
+// RemoveDeploymentProfileIDs removes the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
+func (m *ProfileMutation) RemoveDeploymentProfileIDs(ids ...uint64) {
+	if m.removeddeployment_profiles == nil {
+		m.removeddeployment_profiles = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.deployment_profiles, ids[i])
+		m.removeddeployment_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeploymentProfiles returns the removed IDs of the "deployment_profiles" edge to the DeploymentProfile entity.
+func (m *ProfileMutation) RemovedDeploymentProfilesIDs() (ids []uint64) {
+	for id := range m.removeddeployment_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeploymentProfilesIDs returns the "deployment_profiles" edge IDs in the mutation.
+func (m *ProfileMutation) DeploymentProfilesIDs() (ids []uint64) {
+	for id := range m.deployment_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeploymentProfiles resets all changes to the "deployment_profiles" edge.
+func (m *ProfileMutation) ResetDeploymentProfiles() {
+	m.deployment_profiles = nil
+	m.cleareddeployment_profiles = false
+	m.removeddeployment_profiles = nil
+}
+
+// AddParameterTemplateIDs adds the "parameter_templates" edge to the ParameterTemplate entity by ids.
+func (m *ProfileMutation) AddParameterTemplateIDs(ids ...uint64) {
+	if m.parameter_templates == nil {
+		m.parameter_templates = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.parameter_templates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParameterTemplates clears the "parameter_templates" edge to the ParameterTemplate entity.
+func (m *ProfileMutation) ClearParameterTemplates() {
+	m.clearedparameter_templates = true
+}
+
+// ParameterTemplatesCleared reports if the "parameter_templates" edge to the ParameterTemplate entity was cleared.
+func (m *ProfileMutation) ParameterTemplatesCleared() bool {
+	return m.clearedparameter_templates
+}
+
+// RemoveParameterTemplateIDs removes the "parameter_templates" edge to the ParameterTemplate entity by IDs.
+func (m *ProfileMutation) RemoveParameterTemplateIDs(ids ...uint64) {
+	if m.removedparameter_templates == nil {
+		m.removedparameter_templates = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.parameter_templates, ids[i])
+		m.removedparameter_templates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParameterTemplates returns the removed IDs of the "parameter_templates" edge to the ParameterTemplate entity.
+func (m *ProfileMutation) RemovedParameterTemplatesIDs() (ids []uint64) {
+	for id := range m.removedparameter_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParameterTemplatesIDs returns the "parameter_templates" edge IDs in the mutation.
+func (m *ProfileMutation) ParameterTemplatesIDs() (ids []uint64) {
+	for id := range m.parameter_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParameterTemplates resets all changes to the "parameter_templates" edge.
+func (m *ProfileMutation) ResetParameterTemplates() {
+	m.parameter_templates = nil
+	m.clearedparameter_templates = false
+	m.removedparameter_templates = nil
+}
+
+// AddDeploymentRequirementIDs adds the "deployment_requirements" edge to the DeploymentRequirement entity by ids.
+func (m *ProfileMutation) AddDeploymentRequirementIDs(ids ...uint64) {
+	if m.deployment_requirements == nil {
+		m.deployment_requirements = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.deployment_requirements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeploymentRequirements clears the "deployment_requirements" edge to the DeploymentRequirement entity.
+func (m *ProfileMutation) ClearDeploymentRequirements() {
+	m.cleareddeployment_requirements = true
+}
+
+// DeploymentRequirementsCleared reports if the "deployment_requirements" edge to the DeploymentRequirement entity was cleared.
+func (m *ProfileMutation) DeploymentRequirementsCleared() bool {
+	return m.cleareddeployment_requirements
+}
+
+// RemoveDeploymentRequirementIDs removes the "deployment_requirements" edge to the DeploymentRequirement entity by IDs.
+func (m *ProfileMutation) RemoveDeploymentRequirementIDs(ids ...uint64) {
+	if m.removeddeployment_requirements == nil {
+		m.removeddeployment_requirements = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.deployment_requirements, ids[i])
+		m.removeddeployment_requirements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeploymentRequirements returns the removed IDs of the "deployment_requirements" edge to the DeploymentRequirement entity.
+func (m *ProfileMutation) RemovedDeploymentRequirementsIDs() (ids []uint64) {
+	for id := range m.removeddeployment_requirements {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ApplicationFkIDs returns the "application_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ApplicationFkID instead. It exists only for internal usage by the builders.
-func (m *IgnoredResourceMutation) ApplicationFkIDs() (ids []uint64) {
-	if id := m.application_fk; id != nil {
-		ids = append(ids, *id)
+// DeploymentRequirementsIDs returns the "deployment_requirements" edge IDs in the mutation.
+func (m *ProfileMutation) DeploymentRequirementsIDs() (ids []uint64) {
+	for id := range m.deployment_requirements {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetApplicationFk resets all changes to the "application_fk" edge.
-func (m *IgnoredResourceMutation) ResetApplicationFk() {
-	m.application_fk = nil
-	m.clearedapplication_fk = false
+// ResetDeploymentRequirements resets all changes to the "deployment_requirements" edge.
+func (m *ProfileMutation) ResetDeploymentRequirements() {
+	m.deployment_requirements = nil
+	m.cleareddeployment_requirements = false
+	m.removeddeployment_requirements = nil
 }
 
-// Where appends a list predicates to the IgnoredResourceMutation builder.
-func (m *IgnoredResourceMutation) Where(ps ...predicate.IgnoredResource) {
+// Where appends a list predicates to the ProfileMutation builder.
+func (m *ProfileMutation) Where(ps ...predicate.Profile) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IgnoredResourceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IgnoredResourceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.IgnoredResource, len(ps))
+func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Profile, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9893,33 +16239,45 @@ func (m *IgnoredResourceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IgnoredResourceMutation) Op() Op {
+func (m *ProfileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IgnoredResourceMutation) SetOp(op Op) {
+func (m *ProfileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (IgnoredResource).
-func (m *IgnoredResourceMutation) Type() string {
+// Type returns the node type of this mutation (Profile).
+func (m *ProfileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IgnoredResourceMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *ProfileMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.name != nil {
-		fields = append(fields, ignoredresource.FieldName)
+		fields = append(fields, profile.FieldName)
 	}
-	if m.kind != nil {
-		fields = append(fields, ignoredresource.FieldKind)
+	if m.display_name != nil {
+		fields = append(fields, profile.FieldDisplayName)
 	}
-	if m.namespace != nil {
-		fields = append(fields, ignoredresource.FieldNamespace)
+	if m.display_name_lc != nil {
+		fields = append(fields, profile.FieldDisplayNameLc)
+	}
+	if m.description != nil {
+		fields = append(fields, profile.FieldDescription)
+	}
+	if m.create_time != nil {
+		fields = append(fields, profile.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, profile.FieldUpdateTime)
+	}
+	if m.chart_values != nil {
+		fields = append(fields, profile.FieldChartValues)
 	}
 	return fields
 }
@@ -9927,14 +16285,22 @@ func (m *IgnoredResourceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IgnoredResourceMutation) Field(name string) (ent.Value, bool) {
+func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ignoredresource.FieldName:
+	case profile.FieldName:
 		return m.Name()
-	case ignoredresource.FieldKind:
-		return m.Kind()
-	case ignoredresource.FieldNamespace:
-		return m.Namespace()
+	case profile.FieldDisplayName:
+		return m.DisplayName()
+	case profile.FieldDisplayNameLc:
+		return m.DisplayNameLc()
+	case profile.FieldDescription:
+		return m.Description()
+	case profile.FieldCreateTime:
+		return m.CreateTime()
+	case profile.FieldUpdateTime:
+		return m.UpdateTime()
+	case profile.FieldChartValues:
+		return m.ChartValues()
 	}
 	return nil, false
 }
@@ -9942,209 +16308,371 @@ func (m *IgnoredResourceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IgnoredResourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ignoredresource.FieldName:
+	case profile.FieldName:
 		return m.OldName(ctx)
-	case ignoredresource.FieldKind:
-		return m.OldKind(ctx)
-	case ignoredresource.FieldNamespace:
-		return m.OldNamespace(ctx)
+	case profile.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case profile.FieldDisplayNameLc:
+		return m.OldDisplayNameLc(ctx)
+	case profile.FieldDescription:
+		return m.OldDescription(ctx)
+	case profile.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case profile.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case profile.FieldChartValues:
+		return m.OldChartValues(ctx)
 	}
-	return nil, fmt.Errorf("unknown IgnoredResource field %s", name)
+	return nil, fmt.Errorf("unknown Profile field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IgnoredResourceMutation) SetField(name string, value ent.Value) error {
+func (m *ProfileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ignoredresource.FieldName:
+	case profile.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case ignoredresource.FieldKind:
+	case profile.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKind(v)
+		m.SetDisplayName(v)
 		return nil
-	case ignoredresource.FieldNamespace:
+	case profile.FieldDisplayNameLc:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNamespace(v)
+		m.SetDisplayNameLc(v)
+		return nil
+	case profile.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case profile.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case profile.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case profile.FieldChartValues:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChartValues(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IgnoredResource field %s", name)
+	return fmt.Errorf("unknown Profile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IgnoredResourceMutation) AddedFields() []string {
+func (m *ProfileMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IgnoredResourceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IgnoredResourceMutation) AddField(name string, value ent.Value) error {
+func (m *ProfileMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown IgnoredResource numeric field %s", name)
+	return fmt.Errorf("unknown Profile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IgnoredResourceMutation) ClearedFields() []string {
-	return nil
+func (m *ProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profile.FieldDisplayName) {
+		fields = append(fields, profile.FieldDisplayName)
+	}
+	if m.FieldCleared(profile.FieldDisplayNameLc) {
+		fields = append(fields, profile.FieldDisplayNameLc)
+	}
+	if m.FieldCleared(profile.FieldDescription) {
+		fields = append(fields, profile.FieldDescription)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IgnoredResourceMutation) FieldCleared(name string) bool {
+func (m *ProfileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IgnoredResourceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown IgnoredResource nullable field %s", name)
+func (m *ProfileMutation) ClearField(name string) error {
+	switch name {
+	case profile.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	case profile.FieldDisplayNameLc:
+		m.ClearDisplayNameLc()
+		return nil
+	case profile.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IgnoredResourceMutation) ResetField(name string) error {
+func (m *ProfileMutation) ResetField(name string) error {
 	switch name {
-	case ignoredresource.FieldName:
+	case profile.FieldName:
 		m.ResetName()
 		return nil
-	case ignoredresource.FieldKind:
-		m.ResetKind()
+	case profile.FieldDisplayName:
+		m.ResetDisplayName()
 		return nil
-	case ignoredresource.FieldNamespace:
-		m.ResetNamespace()
+	case profile.FieldDisplayNameLc:
+		m.ResetDisplayNameLc()
+		return nil
+	case profile.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case profile.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case profile.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case profile.FieldChartValues:
+		m.ResetChartValues()
 		return nil
 	}
-	return fmt.Errorf("unknown IgnoredResource field %s", name)
+	return fmt.Errorf("unknown Profile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IgnoredResourceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.application_fk != nil {
-		edges = append(edges, ignoredresource.EdgeApplicationFk)
+		edges = append(edges, profile.EdgeApplicationFk)
+	}
+	if m.deployment_profiles != nil {
+		edges = append(edges, profile.EdgeDeploymentProfiles)
+	}
+	if m.parameter_templates != nil {
+		edges = append(edges, profile.EdgeParameterTemplates)
+	}
+	if m.deployment_requirements != nil {
+		edges = append(edges, profile.EdgeDeploymentRequirements)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IgnoredResourceMutation) AddedIDs(name string) []ent.Value {
+func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case ignoredresource.EdgeApplicationFk:
+	case profile.EdgeApplicationFk:
 		if id := m.application_fk; id != nil {
 			return []ent.Value{*id}
 		}
+	case profile.EdgeDeploymentProfiles:
+		ids := make([]ent.Value, 0, len(m.deployment_profiles))
+		for id := range m.deployment_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeParameterTemplates:
+		ids := make([]ent.Value, 0, len(m.parameter_templates))
+		for id := range m.parameter_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeDeploymentRequirements:
+		ids := make([]ent.Value, 0, len(m.deployment_requirements))
+		for id := range m.deployment_requirements {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IgnoredResourceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removeddeployment_profiles != nil {
+		edges = append(edges, profile.EdgeDeploymentProfiles)
+	}
+	if m.removedparameter_templates != nil {
+		edges = append(edges, profile.EdgeParameterTemplates)
+	}
+	if m.removeddeployment_requirements != nil {
+		edges = append(edges, profile.EdgeDeploymentRequirements)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IgnoredResourceMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeDeploymentProfiles:
+		ids := make([]ent.Value, 0, len(m.removeddeployment_profiles))
+		for id := range m.removeddeployment_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeParameterTemplates:
+		ids := make([]ent.Value, 0, len(m.removedparameter_templates))
+		for id := range m.removedparameter_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeDeploymentRequirements:
+		ids := make([]ent.Value, 0, len(m.removeddeployment_requirements))
+		for id := range m.removeddeployment_requirements {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IgnoredResourceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.clearedapplication_fk {
-		edges = append(edges, ignoredresource.EdgeApplicationFk)
+		edges = append(edges, profile.EdgeApplicationFk)
+	}
+	if m.cleareddeployment_profiles {
+		edges = append(edges, profile.EdgeDeploymentProfiles)
+	}
+	if m.clearedparameter_templates {
+		edges = append(edges, profile.EdgeParameterTemplates)
+	}
+	if m.cleareddeployment_requirements {
+		edges = append(edges, profile.EdgeDeploymentRequirements)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IgnoredResourceMutation) EdgeCleared(name string) bool {
+func (m *ProfileMutation) EdgeCleared(name string) bool {
 	switch name {
-	case ignoredresource.EdgeApplicationFk:
+	case profile.EdgeApplicationFk:
 		return m.clearedapplication_fk
+	case profile.EdgeDeploymentProfiles:
+		return m.cleareddeployment_profiles
+	case profile.EdgeParameterTemplates:
+		return m.clearedparameter_templates
+	case profile.EdgeDeploymentRequirements:
+		return m.cleareddeployment_requirements
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IgnoredResourceMutation) ClearEdge(name string) error {
+func (m *ProfileMutation) ClearEdge(name string) error {
 	switch name {
-	case ignoredresource.EdgeApplicationFk:
+	case profile.EdgeApplicationFk:
 		m.ClearApplicationFk()
 		return nil
 	}
-	return fmt.Errorf("unknown IgnoredResource unique edge %s", name)
+	return fmt.Errorf("unknown Profile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IgnoredResourceMutation) ResetEdge(name string) error {
+func (m *ProfileMutation) ResetEdge(name string) error {
 	switch name {
-	case ignoredresource.EdgeApplicationFk:
+	case profile.EdgeApplicationFk:
 		m.ResetApplicationFk()
 		return nil
+	case profile.EdgeDeploymentProfiles:
+		m.ResetDeploymentProfiles()
+		return nil
+	case profile.EdgeParameterTemplates:
+		m.ResetParameterTemplates()
+		return nil
+	case profile.EdgeDeploymentRequirements:
+		m.ResetDeploymentRequirements()
+		return nil
 	}
-	return fmt.Errorf("unknown IgnoredResource edge %s", name)
+	return fmt.Errorf("unknown Profile edge %s", name)
 }
 
-// NamespaceMutation represents an operation that mutates the Namespace nodes in the graph.
-type NamespaceMutation struct {
+// RegistryMutation represents an operation that mutates the Registry nodes in the graph.
+type RegistryMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uint64
-	name                         *string
-	clearedFields                map[string]struct{}
-	deployment_package_fk        *uint64
-	cleareddeployment_package_fk bool
-	adornments                   map[uint64]struct{}
-	removedadornments            map[uint64]struct{}
-	clearedadornments            bool
-	done                         bool
-	oldValue                     func(context.Context) (*Namespace, error)
-	predicates                   []predicate.Namespace
+	op                        Op
+	typ                       string
+	id                        *uint64
+	name                      *string
+	display_name              *string
+	display_name_lc           *string
+	description               *string
+	create_time               *time.Time
+	update_time               *time.Time
+	etag                      *int64
+	addetag                   *int64
+	project_uuid              *string
+	auth_token                *string
+	_type                     *string
+	api_type                  *string
+	labels                    *map[string]string
+	clearedFields             map[string]struct{}
+	applications              map[uint64]struct{}
+	removedapplications       map[uint64]struct{}
+	clearedapplications       bool
+	application_images        map[uint64]struct{}
+	removedapplication_images map[uint64]struct{}
+	clearedapplication_images bool
+	done                      bool
+	oldValue                  func(context.Context) (*Registry, error)
+	predicates                []predicate.Registry
 }
 
-var _ ent.Mutation = (*NamespaceMutation)(nil)
+var _ ent.Mutation = (*RegistryMutation)(nil)
 
-// namespaceOption allows management of the mutation configuration using functional options.
-type namespaceOption func(*NamespaceMutation)
+// registryOption allows management of the mutation configuration using functional options.
+type registryOption func(*RegistryMutation)
 
-// newNamespaceMutation creates new mutation for the Namespace entity.
-func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMutation {
-	m := &NamespaceMutation{
+// newRegistryMutation creates new mutation for the Registry entity.
+func newRegistryMutation(c config, op Op, opts ...registryOption) *RegistryMutation {
+	m := &RegistryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNamespace,
+		typ:           TypeRegistry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10153,20 +16681,20 @@ func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMu
 	return m
 }
 
-// withNamespaceID sets the ID field of the mutation.
-func withNamespaceID(id uint64) namespaceOption {
-	return func(m *NamespaceMutation) {
+// withRegistryID sets the ID field of the mutation.
+func withRegistryID(id uint64) registryOption {
+	return func(m *RegistryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Namespace
+			value *Registry
 		)
-		m.oldValue = func(ctx context.Context) (*Namespace, error) {
+		m.oldValue = func(ctx context.Context) (*Registry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Namespace.Get(ctx, id)
+					value, err = m.Client().Registry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10175,10 +16703,10 @@ func withNamespaceID(id uint64) namespaceOption {
 	}
 }
 
-// withNamespace sets the old Namespace of the mutation.
-func withNamespace(node *Namespace) namespaceOption {
-	return func(m *NamespaceMutation) {
-		m.oldValue = func(context.Context) (*Namespace, error) {
+// withRegistry sets the old Registry of the mutation.
+func withRegistry(node *Registry) registryOption {
+	return func(m *RegistryMutation) {
+		m.oldValue = func(context.Context) (*Registry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10187,7 +16715,7 @@ func withNamespace(node *Namespace) namespaceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NamespaceMutation) Client() *Client {
+func (m RegistryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10195,7 +16723,7 @@ func (m NamespaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NamespaceMutation) Tx() (*Tx, error) {
+func (m RegistryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -10206,7 +16734,7 @@ func (m NamespaceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NamespaceMutation) ID() (id uint64, exists bool) {
+func (m *RegistryMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10217,7 +16745,7 @@ func (m *NamespaceMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NamespaceMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *RegistryMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10226,19 +16754,19 @@ func (m *NamespaceMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Namespace.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Registry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *NamespaceMutation) SetName(s string) {
+func (m *RegistryMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *NamespaceMutation) Name() (r string, exists bool) {
+func (m *RegistryMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -10246,10 +16774,10 @@ func (m *NamespaceMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Namespace entity.
-// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RegistryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -10264,458 +16792,391 @@ func (m *NamespaceMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *NamespaceMutation) ResetName() {
+func (m *RegistryMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDeploymentPackageFkID sets the "deployment_package_fk" edge to the DeploymentPackage entity by id.
-func (m *NamespaceMutation) SetDeploymentPackageFkID(id uint64) {
-	m.deployment_package_fk = &id
-}
-
-// ClearDeploymentPackageFk clears the "deployment_package_fk" edge to the DeploymentPackage entity.
-func (m *NamespaceMutation) ClearDeploymentPackageFk() {
-	m.cleareddeployment_package_fk = true
-}
-
-// DeploymentPackageFkCleared reports if the "deployment_package_fk" edge to the DeploymentPackage entity was cleared.
-func (m *NamespaceMutation) DeploymentPackageFkCleared() bool {
-	return m.cleareddeployment_package_fk
-}
-
-// DeploymentPackageFkID returns the "deployment_package_fk" edge ID in the mutation.
-func (m *NamespaceMutation) DeploymentPackageFkID() (id uint64, exists bool) {
-	if m.deployment_package_fk != nil {
-		return *m.deployment_package_fk, true
-	}
-	return
+// SetDisplayName sets the "display_name" field.
+func (m *RegistryMutation) SetDisplayName(s string) {
+	m.display_name = &s
 }
 
-// DeploymentPackageFkIDs returns the "deployment_package_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeploymentPackageFkID instead. It exists only for internal usage by the builders.
-func (m *NamespaceMutation) DeploymentPackageFkIDs() (ids []uint64) {
-	if id := m.deployment_package_fk; id != nil {
-		ids = append(ids, *id)
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *RegistryMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetDeploymentPackageFk resets all changes to the "deployment_package_fk" edge.
-func (m *NamespaceMutation) ResetDeploymentPackageFk() {
-	m.deployment_package_fk = nil
-	m.cleareddeployment_package_fk = false
+	return *v, true
 }
 
-// AddAdornmentIDs adds the "adornments" edge to the NamespaceAdornment entity by ids.
-func (m *NamespaceMutation) AddAdornmentIDs(ids ...uint64) {
-	if m.adornments == nil {
-		m.adornments = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.adornments[ids[i]] = struct{}{}
+// OldDisplayName returns the old "display_name" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearAdornments clears the "adornments" edge to the NamespaceAdornment entity.
-func (m *NamespaceMutation) ClearAdornments() {
-	m.clearedadornments = true
-}
-
-// AdornmentsCleared reports if the "adornments" edge to the NamespaceAdornment entity was cleared.
-func (m *NamespaceMutation) AdornmentsCleared() bool {
-	return m.clearedadornments
-}
-
-// RemoveAdornmentIDs removes the "adornments" edge to the NamespaceAdornment entity by IDs.
-func (m *NamespaceMutation) RemoveAdornmentIDs(ids ...uint64) {
-	if m.removedadornments == nil {
-		m.removedadornments = make(map[uint64]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.adornments, ids[i])
-		m.removedadornments[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
 	}
+	return oldValue.DisplayName, nil
 }
 
-// RemovedAdornments returns the removed IDs of the "adornments" edge to the NamespaceAdornment entity.
-func (m *NamespaceMutation) RemovedAdornmentsIDs() (ids []uint64) {
-	for id := range m.removedadornments {
-		ids = append(ids, id)
-	}
-	return
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *RegistryMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[registry.FieldDisplayName] = struct{}{}
 }
 
-// AdornmentsIDs returns the "adornments" edge IDs in the mutation.
-func (m *NamespaceMutation) AdornmentsIDs() (ids []uint64) {
-	for id := range m.adornments {
-		ids = append(ids, id)
-	}
-	return
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *RegistryMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[registry.FieldDisplayName]
+	return ok
 }
 
-// ResetAdornments resets all changes to the "adornments" edge.
-func (m *NamespaceMutation) ResetAdornments() {
-	m.adornments = nil
-	m.clearedadornments = false
-	m.removedadornments = nil
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *RegistryMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, registry.FieldDisplayName)
 }
 
-// Where appends a list predicates to the NamespaceMutation builder.
-func (m *NamespaceMutation) Where(ps ...predicate.Namespace) {
-	m.predicates = append(m.predicates, ps...)
+// SetDisplayNameLc sets the "display_name_lc" field.
+func (m *RegistryMutation) SetDisplayNameLc(s string) {
+	m.display_name_lc = &s
 }
 
-// WhereP appends storage-level predicates to the NamespaceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Namespace, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
+func (m *RegistryMutation) DisplayNameLc() (r string, exists bool) {
+	v := m.display_name_lc
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NamespaceMutation) Op() Op {
-	return m.op
+// OldDisplayNameLc returns the old "display_name_lc" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+	}
+	return oldValue.DisplayNameLc, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NamespaceMutation) SetOp(op Op) {
-	m.op = op
+// ClearDisplayNameLc clears the value of the "display_name_lc" field.
+func (m *RegistryMutation) ClearDisplayNameLc() {
+	m.display_name_lc = nil
+	m.clearedFields[registry.FieldDisplayNameLc] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Namespace).
-func (m *NamespaceMutation) Type() string {
-	return m.typ
+// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
+func (m *RegistryMutation) DisplayNameLcCleared() bool {
+	_, ok := m.clearedFields[registry.FieldDisplayNameLc]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NamespaceMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, namespace.FieldName)
-	}
-	return fields
+// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
+func (m *RegistryMutation) ResetDisplayNameLc() {
+	m.display_name_lc = nil
+	delete(m.clearedFields, registry.FieldDisplayNameLc)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case namespace.FieldName:
-		return m.Name()
-	}
-	return nil, false
+// SetDescription sets the "description" field.
+func (m *RegistryMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case namespace.FieldName:
-		return m.OldName(ctx)
+// Description returns the value of the "description" field in the mutation.
+func (m *RegistryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Namespace field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case namespace.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
+// OldDescription returns the old "description" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Namespace field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NamespaceMutation) AddedFields() []string {
-	return nil
+// ClearDescription clears the value of the "description" field.
+func (m *RegistryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[registry.FieldDescription] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NamespaceMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RegistryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[registry.FieldDescription]
+	return ok
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NamespaceMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ResetDescription resets all changes to the "description" field.
+func (m *RegistryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, registry.FieldDescription)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RegistryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RegistryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Namespace numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NamespaceMutation) ClearedFields() []string {
-	return nil
+// OldCreateTime returns the old "create_time" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NamespaceMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RegistryMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NamespaceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Namespace nullable field %s", name)
+// SetUpdateTime sets the "update_time" field.
+func (m *RegistryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NamespaceMutation) ResetField(name string) error {
-	switch name {
-	case namespace.FieldName:
-		m.ResetName()
-		return nil
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RegistryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Namespace field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NamespaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.deployment_package_fk != nil {
-		edges = append(edges, namespace.EdgeDeploymentPackageFk)
+// OldUpdateTime returns the old "update_time" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	if m.adornments != nil {
-		edges = append(edges, namespace.EdgeAdornments)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NamespaceMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case namespace.EdgeDeploymentPackageFk:
-		if id := m.deployment_package_fk; id != nil {
-			return []ent.Value{*id}
-		}
-	case namespace.EdgeAdornments:
-		ids := make([]ent.Value, 0, len(m.adornments))
-		for id := range m.adornments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RegistryMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NamespaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedadornments != nil {
-		edges = append(edges, namespace.EdgeAdornments)
-	}
-	return edges
+// SetEtag sets the "etag" field.
+func (m *RegistryMutation) SetEtag(i int64) {
+	m.etag = &i
+	m.addetag = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NamespaceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case namespace.EdgeAdornments:
-		ids := make([]ent.Value, 0, len(m.removedadornments))
-		for id := range m.removedadornments {
-			ids = append(ids, id)
-		}
-		return ids
+// Etag returns the value of the "etag" field in the mutation.
+func (m *RegistryMutation) Etag() (r int64, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NamespaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareddeployment_package_fk {
-		edges = append(edges, namespace.EdgeDeploymentPackageFk)
+// OldEtag returns the old "etag" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldEtag(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
 	}
-	if m.clearedadornments {
-		edges = append(edges, namespace.EdgeAdornments)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NamespaceMutation) EdgeCleared(name string) bool {
-	switch name {
-	case namespace.EdgeDeploymentPackageFk:
-		return m.cleareddeployment_package_fk
-	case namespace.EdgeAdornments:
-		return m.clearedadornments
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
 	}
-	return false
+	return oldValue.Etag, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NamespaceMutation) ClearEdge(name string) error {
-	switch name {
-	case namespace.EdgeDeploymentPackageFk:
-		m.ClearDeploymentPackageFk()
-		return nil
+// AddEtag adds i to the "etag" field.
+func (m *RegistryMutation) AddEtag(i int64) {
+	if m.addetag != nil {
+		*m.addetag += i
+	} else {
+		m.addetag = &i
 	}
-	return fmt.Errorf("unknown Namespace unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NamespaceMutation) ResetEdge(name string) error {
-	switch name {
-	case namespace.EdgeDeploymentPackageFk:
-		m.ResetDeploymentPackageFk()
-		return nil
-	case namespace.EdgeAdornments:
-		m.ResetAdornments()
-		return nil
+// AddedEtag returns the value that was added to the "etag" field in this mutation.
+func (m *RegistryMutation) AddedEtag() (r int64, exists bool) {
+	v := m.addetag
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Namespace edge %s", name)
+	return *v, true
 }
 
-// NamespaceAdornmentMutation represents an operation that mutates the NamespaceAdornment nodes in the graph.
-type NamespaceAdornmentMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *uint64
-	_type               *string
-	key                 *string
-	value               *string
-	clearedFields       map[string]struct{}
-	namespace_fk        *uint64
-	clearednamespace_fk bool
-	done                bool
-	oldValue            func(context.Context) (*NamespaceAdornment, error)
-	predicates          []predicate.NamespaceAdornment
+// ClearEtag clears the value of the "etag" field.
+func (m *RegistryMutation) ClearEtag() {
+	m.etag = nil
+	m.addetag = nil
+	m.clearedFields[registry.FieldEtag] = struct{}{}
 }
 
-var _ ent.Mutation = (*NamespaceAdornmentMutation)(nil)
+// EtagCleared returns if the "etag" field was cleared in this mutation.
+func (m *RegistryMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[registry.FieldEtag]
+	return ok
+}
 
-// namespaceadornmentOption allows management of the mutation configuration using functional options.
-type namespaceadornmentOption func(*NamespaceAdornmentMutation)
+// ResetEtag resets all changes to the "etag" field.
+func (m *RegistryMutation) ResetEtag() {
+	m.etag = nil
+	m.addetag = nil
+	delete(m.clearedFields, registry.FieldEtag)
+}
 
-// newNamespaceAdornmentMutation creates new mutation for the NamespaceAdornment entity.
-func newNamespaceAdornmentMutation(c config, op Op, opts ...namespaceadornmentOption) *NamespaceAdornmentMutation {
-	m := &NamespaceAdornmentMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeNamespaceAdornment,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetProjectUUID sets the "project_uuid" field.
+func (m *RegistryMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
 }
 
-// withNamespaceAdornmentID sets the ID field of the mutation.
-func withNamespaceAdornmentID(id uint64) namespaceadornmentOption {
-	return func(m *NamespaceAdornmentMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *NamespaceAdornment
-		)
-		m.oldValue = func(ctx context.Context) (*NamespaceAdornment, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().NamespaceAdornment.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *RegistryMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withNamespaceAdornment sets the old NamespaceAdornment of the mutation.
-func withNamespaceAdornment(node *NamespaceAdornment) namespaceadornmentOption {
-	return func(m *NamespaceAdornmentMutation) {
-		m.oldValue = func(context.Context) (*NamespaceAdornment, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldProjectUUID returns the old "project_uuid" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
 	}
+	return oldValue.ProjectUUID, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NamespaceAdornmentMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *RegistryMutation) ResetProjectUUID() {
+	m.project_uuid = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m NamespaceAdornmentMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("generated: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetAuthToken sets the "auth_token" field.
+func (m *RegistryMutation) SetAuthToken(s string) {
+	m.auth_token = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *NamespaceAdornmentMutation) ID() (id uint64, exists bool) {
-	if m.id == nil {
+// AuthToken returns the value of the "auth_token" field in the mutation.
+func (m *RegistryMutation) AuthToken() (r string, exists bool) {
+	v := m.auth_token
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *NamespaceAdornmentMutation) IDs(ctx context.Context) ([]uint64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NamespaceAdornment.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldAuthToken returns the old "auth_token" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldAuthToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthToken: %w", err)
 	}
+	return oldValue.AuthToken, nil
+}
+
+// ClearAuthToken clears the value of the "auth_token" field.
+func (m *RegistryMutation) ClearAuthToken() {
+	m.auth_token = nil
+	m.clearedFields[registry.FieldAuthToken] = struct{}{}
+}
+
+// AuthTokenCleared returns if the "auth_token" field was cleared in this mutation.
+func (m *RegistryMutation) AuthTokenCleared() bool {
+	_, ok := m.clearedFields[registry.FieldAuthToken]
+	return ok
+}
+
+// ResetAuthToken resets all changes to the "auth_token" field.
+func (m *RegistryMutation) ResetAuthToken() {
+	m.auth_token = nil
+	delete(m.clearedFields, registry.FieldAuthToken)
 }
 
 // SetType sets the "type" field.
-func (m *NamespaceAdornmentMutation) SetType(s string) {
+func (m *RegistryMutation) SetType(s string) {
 	m._type = &s
 }
 
 // GetType returns the value of the "type" field in the mutation.
-func (m *NamespaceAdornmentMutation) GetType() (r string, exists bool) {
+func (m *RegistryMutation) GetType() (r string, exists bool) {
 	v := m._type
 	if v == nil {
 		return
@@ -10723,10 +17184,10 @@ func (m *NamespaceAdornmentMutation) GetType() (r string, exists bool) {
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the NamespaceAdornment entity.
-// If the NamespaceAdornment object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceAdornmentMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *RegistryMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
@@ -10741,143 +17202,225 @@ func (m *NamespaceAdornmentMutation) OldType(ctx context.Context) (v string, err
 }
 
 // ResetType resets all changes to the "type" field.
-func (m *NamespaceAdornmentMutation) ResetType() {
+func (m *RegistryMutation) ResetType() {
 	m._type = nil
 }
 
-// SetKey sets the "key" field.
-func (m *NamespaceAdornmentMutation) SetKey(s string) {
-	m.key = &s
+// SetAPIType sets the "api_type" field.
+func (m *RegistryMutation) SetAPIType(s string) {
+	m.api_type = &s
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *NamespaceAdornmentMutation) Key() (r string, exists bool) {
-	v := m.key
+// APIType returns the value of the "api_type" field in the mutation.
+func (m *RegistryMutation) APIType() (r string, exists bool) {
+	v := m.api_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the NamespaceAdornment entity.
-// If the NamespaceAdornment object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIType returns the old "api_type" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceAdornmentMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *RegistryMutation) OldAPIType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldAPIType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIType: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.APIType, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *NamespaceAdornmentMutation) ResetKey() {
-	m.key = nil
+// ClearAPIType clears the value of the "api_type" field.
+func (m *RegistryMutation) ClearAPIType() {
+	m.api_type = nil
+	m.clearedFields[registry.FieldAPIType] = struct{}{}
 }
 
-// SetValue sets the "value" field.
-func (m *NamespaceAdornmentMutation) SetValue(s string) {
-	m.value = &s
+// APITypeCleared returns if the "api_type" field was cleared in this mutation.
+func (m *RegistryMutation) APITypeCleared() bool {
+	_, ok := m.clearedFields[registry.FieldAPIType]
+	return ok
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *NamespaceAdornmentMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetAPIType resets all changes to the "api_type" field.
+func (m *RegistryMutation) ResetAPIType() {
+	m.api_type = nil
+	delete(m.clearedFields, registry.FieldAPIType)
+}
+
+// SetLabels sets the "labels" field.
+func (m *RegistryMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *RegistryMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the NamespaceAdornment entity.
-// If the NamespaceAdornment object wasn't provided to the builder, the object is fetched from the database.
+// OldLabels returns the old "labels" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceAdornmentMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *RegistryMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldLabels requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Labels, nil
 }
 
-// ClearValue clears the value of the "value" field.
-func (m *NamespaceAdornmentMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[namespaceadornment.FieldValue] = struct{}{}
+// ClearLabels clears the value of the "labels" field.
+func (m *RegistryMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[registry.FieldLabels] = struct{}{}
 }
 
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *NamespaceAdornmentMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[namespaceadornment.FieldValue]
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *RegistryMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[registry.FieldLabels]
 	return ok
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *NamespaceAdornmentMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, namespaceadornment.FieldValue)
+// ResetLabels resets all changes to the "labels" field.
+func (m *RegistryMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, registry.FieldLabels)
 }
 
-// SetNamespaceFkID sets the "namespace_fk" edge to the Namespace entity by id.
-func (m *NamespaceAdornmentMutation) SetNamespaceFkID(id uint64) {
-	m.namespace_fk = &id
+// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
+func (m *RegistryMutation) AddApplicationIDs(ids ...uint64) {
+	if m.applications == nil {
+		m.applications = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.applications[ids[i]] = struct{}{}
+	}
 }
 
-// ClearNamespaceFk clears the "namespace_fk" edge to the Namespace entity.
-func (m *NamespaceAdornmentMutation) ClearNamespaceFk() {
-	m.clearednamespace_fk = true
+// ClearApplications clears the "applications" edge to the Application entity.
+func (m *RegistryMutation) ClearApplications() {
+	m.clearedapplications = true
 }
 
-// NamespaceFkCleared reports if the "namespace_fk" edge to the Namespace entity was cleared.
-func (m *NamespaceAdornmentMutation) NamespaceFkCleared() bool {
-	return m.clearednamespace_fk
+// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
+func (m *RegistryMutation) ApplicationsCleared() bool {
+	return m.clearedapplications
 }
 
-// NamespaceFkID returns the "namespace_fk" edge ID in the mutation.
-func (m *NamespaceAdornmentMutation) NamespaceFkID() (id uint64, exists bool) {
-	if m.namespace_fk != nil {
-		return *m.namespace_fk, true
+// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
+func (m *RegistryMutation) RemoveApplicationIDs(ids ...uint64) {
+	if m.removedapplications == nil {
+		m.removedapplications = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.applications, ids[i])
+		m.removedapplications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
+func (m *RegistryMutation) RemovedApplicationsIDs() (ids []uint64) {
+	for id := range m.removedapplications {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// NamespaceFkIDs returns the "namespace_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NamespaceFkID instead. It exists only for internal usage by the builders.
-func (m *NamespaceAdornmentMutation) NamespaceFkIDs() (ids []uint64) {
-	if id := m.namespace_fk; id != nil {
-		ids = append(ids, *id)
+// ApplicationsIDs returns the "applications" edge IDs in the mutation.
+func (m *RegistryMutation) ApplicationsIDs() (ids []uint64) {
+	for id := range m.applications {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNamespaceFk resets all changes to the "namespace_fk" edge.
-func (m *NamespaceAdornmentMutation) ResetNamespaceFk() {
-	m.namespace_fk = nil
-	m.clearednamespace_fk = false
+// ResetApplications resets all changes to the "applications" edge.
+func (m *RegistryMutation) ResetApplications() {
+	m.applications = nil
+	m.clearedapplications = false
+	m.removedapplications = nil
 }
 
-// Where appends a list predicates to the NamespaceAdornmentMutation builder.
-func (m *NamespaceAdornmentMutation) Where(ps ...predicate.NamespaceAdornment) {
+// AddApplicationImageIDs adds the "application_images" edge to the Application entity by ids.
+func (m *RegistryMutation) AddApplicationImageIDs(ids ...uint64) {
+	if m.application_images == nil {
+		m.application_images = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.application_images[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplicationImages clears the "application_images" edge to the Application entity.
+func (m *RegistryMutation) ClearApplicationImages() {
+	m.clearedapplication_images = true
+}
+
+// ApplicationImagesCleared reports if the "application_images" edge to the Application entity was cleared.
+func (m *RegistryMutation) ApplicationImagesCleared() bool {
+	return m.clearedapplication_images
+}
+
+// RemoveApplicationImageIDs removes the "application_images" edge to the Application entity by IDs.
+func (m *RegistryMutation) RemoveApplicationImageIDs(ids ...uint64) {
+	if m.removedapplication_images == nil {
+		m.removedapplication_images = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.application_images, ids[i])
+		m.removedapplication_images[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplicationImages returns the removed IDs of the "application_images" edge to the Application entity.
+func (m *RegistryMutation) RemovedApplicationImagesIDs() (ids []uint64) {
+	for id := range m.removedapplication_images {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplicationImagesIDs returns the "application_images" edge IDs in the mutation.
+func (m *RegistryMutation) ApplicationImagesIDs() (ids []uint64) {
+	for id := range m.application_images {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplicationImages resets all changes to the "application_images" edge.
+func (m *RegistryMutation) ResetApplicationImages() {
+	m.application_images = nil
+	m.clearedapplication_images = false
+	m.removedapplication_images = nil
+}
+
+// Where appends a list predicates to the RegistryMutation builder.
+func (m *RegistryMutation) Where(ps ...predicate.Registry) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NamespaceAdornmentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RegistryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NamespaceAdornmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NamespaceAdornment, len(ps))
+func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Registry, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10885,33 +17428,60 @@ func (m *NamespaceAdornmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NamespaceAdornmentMutation) Op() Op {
+func (m *RegistryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NamespaceAdornmentMutation) SetOp(op Op) {
+func (m *RegistryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NamespaceAdornment).
-func (m *NamespaceAdornmentMutation) Type() string {
+// Type returns the node type of this mutation (Registry).
+func (m *RegistryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NamespaceAdornmentMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *RegistryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, registry.FieldName)
+	}
+	if m.display_name != nil {
+		fields = append(fields, registry.FieldDisplayName)
+	}
+	if m.display_name_lc != nil {
+		fields = append(fields, registry.FieldDisplayNameLc)
+	}
+	if m.description != nil {
+		fields = append(fields, registry.FieldDescription)
+	}
+	if m.create_time != nil {
+		fields = append(fields, registry.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, registry.FieldUpdateTime)
+	}
+	if m.etag != nil {
+		fields = append(fields, registry.FieldEtag)
+	}
+	if m.project_uuid != nil {
+		fields = append(fields, registry.FieldProjectUUID)
+	}
+	if m.auth_token != nil {
+		fields = append(fields, registry.FieldAuthToken)
+	}
 	if m._type != nil {
-		fields = append(fields, namespaceadornment.FieldType)
+		fields = append(fields, registry.FieldType)
 	}
-	if m.key != nil {
-		fields = append(fields, namespaceadornment.FieldKey)
+	if m.api_type != nil {
+		fields = append(fields, registry.FieldAPIType)
 	}
-	if m.value != nil {
-		fields = append(fields, namespaceadornment.FieldValue)
+	if m.labels != nil {
+		fields = append(fields, registry.FieldLabels)
 	}
 	return fields
 }
@@ -10919,14 +17489,32 @@ func (m *NamespaceAdornmentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NamespaceAdornmentMutation) Field(name string) (ent.Value, bool) {
+func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case namespaceadornment.FieldType:
+	case registry.FieldName:
+		return m.Name()
+	case registry.FieldDisplayName:
+		return m.DisplayName()
+	case registry.FieldDisplayNameLc:
+		return m.DisplayNameLc()
+	case registry.FieldDescription:
+		return m.Description()
+	case registry.FieldCreateTime:
+		return m.CreateTime()
+	case registry.FieldUpdateTime:
+		return m.UpdateTime()
+	case registry.FieldEtag:
+		return m.Etag()
+	case registry.FieldProjectUUID:
+		return m.ProjectUUID()
+	case registry.FieldAuthToken:
+		return m.AuthToken()
+	case registry.FieldType:
 		return m.GetType()
-	case namespaceadornment.FieldKey:
-		return m.Key()
-	case namespaceadornment.FieldValue:
-		return m.Value()
+	case registry.FieldAPIType:
+		return m.APIType()
+	case registry.FieldLabels:
+		return m.Labels()
 	}
 	return nil, false
 }
@@ -10934,227 +17522,416 @@ func (m *NamespaceAdornmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NamespaceAdornmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RegistryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case namespaceadornment.FieldType:
+	case registry.FieldName:
+		return m.OldName(ctx)
+	case registry.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case registry.FieldDisplayNameLc:
+		return m.OldDisplayNameLc(ctx)
+	case registry.FieldDescription:
+		return m.OldDescription(ctx)
+	case registry.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case registry.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case registry.FieldEtag:
+		return m.OldEtag(ctx)
+	case registry.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case registry.FieldAuthToken:
+		return m.OldAuthToken(ctx)
+	case registry.FieldType:
 		return m.OldType(ctx)
-	case namespaceadornment.FieldKey:
-		return m.OldKey(ctx)
-	case namespaceadornment.FieldValue:
-		return m.OldValue(ctx)
+	case registry.FieldAPIType:
+		return m.OldAPIType(ctx)
+	case registry.FieldLabels:
+		return m.OldLabels(ctx)
 	}
-	return nil, fmt.Errorf("unknown NamespaceAdornment field %s", name)
+	return nil, fmt.Errorf("unknown Registry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NamespaceAdornmentMutation) SetField(name string, value ent.Value) error {
+func (m *RegistryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case namespaceadornment.FieldType:
+	case registry.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetName(v)
 		return nil
-	case namespaceadornment.FieldKey:
+	case registry.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetDisplayName(v)
 		return nil
-	case namespaceadornment.FieldValue:
+	case registry.FieldDisplayNameLc:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetDisplayNameLc(v)
+		return nil
+	case registry.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case registry.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case registry.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case registry.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case registry.FieldProjectUUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectUUID(v)
+		return nil
+	case registry.FieldAuthToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthToken(v)
+		return nil
+	case registry.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case registry.FieldAPIType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIType(v)
+		return nil
+	case registry.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NamespaceAdornment field %s", name)
+	return fmt.Errorf("unknown Registry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NamespaceAdornmentMutation) AddedFields() []string {
-	return nil
+func (m *RegistryMutation) AddedFields() []string {
+	var fields []string
+	if m.addetag != nil {
+		fields = append(fields, registry.FieldEtag)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NamespaceAdornmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RegistryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case registry.FieldEtag:
+		return m.AddedEtag()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NamespaceAdornmentMutation) AddField(name string, value ent.Value) error {
+func (m *RegistryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case registry.FieldEtag:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEtag(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NamespaceAdornment numeric field %s", name)
+	return fmt.Errorf("unknown Registry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NamespaceAdornmentMutation) ClearedFields() []string {
+func (m *RegistryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(namespaceadornment.FieldValue) {
-		fields = append(fields, namespaceadornment.FieldValue)
+	if m.FieldCleared(registry.FieldDisplayName) {
+		fields = append(fields, registry.FieldDisplayName)
+	}
+	if m.FieldCleared(registry.FieldDisplayNameLc) {
+		fields = append(fields, registry.FieldDisplayNameLc)
+	}
+	if m.FieldCleared(registry.FieldDescription) {
+		fields = append(fields, registry.FieldDescription)
+	}
+	if m.FieldCleared(registry.FieldEtag) {
+		fields = append(fields, registry.FieldEtag)
+	}
+	if m.FieldCleared(registry.FieldAuthToken) {
+		fields = append(fields, registry.FieldAuthToken)
+	}
+	if m.FieldCleared(registry.FieldAPIType) {
+		fields = append(fields, registry.FieldAPIType)
+	}
+	if m.FieldCleared(registry.FieldLabels) {
+		fields = append(fields, registry.FieldLabels)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NamespaceAdornmentMutation) FieldCleared(name string) bool {
+func (m *RegistryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NamespaceAdornmentMutation) ClearField(name string) error {
+func (m *RegistryMutation) ClearField(name string) error {
 	switch name {
-	case namespaceadornment.FieldValue:
-		m.ClearValue()
+	case registry.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	case registry.FieldDisplayNameLc:
+		m.ClearDisplayNameLc()
+		return nil
+	case registry.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case registry.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case registry.FieldAuthToken:
+		m.ClearAuthToken()
+		return nil
+	case registry.FieldAPIType:
+		m.ClearAPIType()
+		return nil
+	case registry.FieldLabels:
+		m.ClearLabels()
 		return nil
 	}
-	return fmt.Errorf("unknown NamespaceAdornment nullable field %s", name)
+	return fmt.Errorf("unknown Registry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NamespaceAdornmentMutation) ResetField(name string) error {
+func (m *RegistryMutation) ResetField(name string) error {
 	switch name {
-	case namespaceadornment.FieldType:
+	case registry.FieldName:
+		m.ResetName()
+		return nil
+	case registry.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case registry.FieldDisplayNameLc:
+		m.ResetDisplayNameLc()
+		return nil
+	case registry.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case registry.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case registry.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case registry.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case registry.FieldProjectUUID:
+		m.ResetProjectUUID()
+		return nil
+	case registry.FieldAuthToken:
+		m.ResetAuthToken()
+		return nil
+	case registry.FieldType:
 		m.ResetType()
 		return nil
-	case namespaceadornment.FieldKey:
-		m.ResetKey()
+	case registry.FieldAPIType:
+		m.ResetAPIType()
 		return nil
-	case namespaceadornment.FieldValue:
-		m.ResetValue()
+	case registry.FieldLabels:
+		m.ResetLabels()
 		return nil
 	}
-	return fmt.Errorf("unknown NamespaceAdornment field %s", name)
+	return fmt.Errorf("unknown Registry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NamespaceAdornmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.namespace_fk != nil {
-		edges = append(edges, namespaceadornment.EdgeNamespaceFk)
+func (m *RegistryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.applications != nil {
+		edges = append(edges, registry.EdgeApplications)
+	}
+	if m.application_images != nil {
+		edges = append(edges, registry.EdgeApplicationImages)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NamespaceAdornmentMutation) AddedIDs(name string) []ent.Value {
+func (m *RegistryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case namespaceadornment.EdgeNamespaceFk:
-		if id := m.namespace_fk; id != nil {
-			return []ent.Value{*id}
+	case registry.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.applications))
+		for id := range m.applications {
+			ids = append(ids, id)
 		}
+		return ids
+	case registry.EdgeApplicationImages:
+		ids := make([]ent.Value, 0, len(m.application_images))
+		for id := range m.application_images {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegistryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedapplications != nil {
+		edges = append(edges, registry.EdgeApplications)
+	}
+	if m.removedapplication_images != nil {
+		edges = append(edges, registry.EdgeApplicationImages)
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NamespaceAdornmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NamespaceAdornmentMutation) RemovedIDs(name string) []ent.Value {
+func (m *RegistryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case registry.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.removedapplications))
+		for id := range m.removedapplications {
+			ids = append(ids, id)
+		}
+		return ids
+	case registry.EdgeApplicationImages:
+		ids := make([]ent.Value, 0, len(m.removedapplication_images))
+		for id := range m.removedapplication_images {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NamespaceAdornmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearednamespace_fk {
-		edges = append(edges, namespaceadornment.EdgeNamespaceFk)
+func (m *RegistryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedapplications {
+		edges = append(edges, registry.EdgeApplications)
+	}
+	if m.clearedapplication_images {
+		edges = append(edges, registry.EdgeApplicationImages)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NamespaceAdornmentMutation) EdgeCleared(name string) bool {
+func (m *RegistryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case namespaceadornment.EdgeNamespaceFk:
-		return m.clearednamespace_fk
+	case registry.EdgeApplications:
+		return m.clearedapplications
+	case registry.EdgeApplicationImages:
+		return m.clearedapplication_images
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NamespaceAdornmentMutation) ClearEdge(name string) error {
+func (m *RegistryMutation) ClearEdge(name string) error {
 	switch name {
-	case namespaceadornment.EdgeNamespaceFk:
-		m.ClearNamespaceFk()
-		return nil
 	}
-	return fmt.Errorf("unknown NamespaceAdornment unique edge %s", name)
+	return fmt.Errorf("unknown Registry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NamespaceAdornmentMutation) ResetEdge(name string) error {
+func (m *RegistryMutation) ResetEdge(name string) error {
 	switch name {
-	case namespaceadornment.EdgeNamespaceFk:
-		m.ResetNamespaceFk()
+	case registry.EdgeApplications:
+		m.ResetApplications()
+		return nil
+	case registry.EdgeApplicationImages:
+		m.ResetApplicationImages()
 		return nil
 	}
-	return fmt.Errorf("unknown NamespaceAdornment edge %s", name)
+	return fmt.Errorf("unknown Registry edge %s", name)
 }
 
-// ParameterTemplateMutation represents an operation that mutates the ParameterTemplate nodes in the graph.
-type ParameterTemplateMutation struct {
+// RevisionMutation represents an operation that mutates the Revision nodes in the graph.
+type RevisionMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uint64
-	name                   *string
-	display_name           *string
-	display_name_lc        *string
-	_default               *string
-	_type                  *string
-	validator              *string
-	suggested_values       *[]string
-	appendsuggested_values []string
-	mandatory              *bool
-	secret                 *bool
-	clearedFields          map[string]struct{}
-	profile_fk             *uint64
-	clearedprofile_fk      bool
-	profiles               map[uint64]struct{}
-	removedprofiles        map[uint64]struct{}
-	clearedprofiles        bool
-	done                   bool
-	oldValue               func(context.Context) (*ParameterTemplate, error)
-	predicates             []predicate.ParameterTemplate
-}
-
-var _ ent.Mutation = (*ParameterTemplateMutation)(nil)
-
-// parametertemplateOption allows management of the mutation configuration using functional options.
-type parametertemplateOption func(*ParameterTemplateMutation)
-
-// newParameterTemplateMutation creates new mutation for the ParameterTemplate entity.
-func newParameterTemplateMutation(c config, op Op, opts ...parametertemplateOption) *ParameterTemplateMutation {
-	m := &ParameterTemplateMutation{
+	op            Op
+	typ           string
+	id            *uint64
+	project_uuid  *string
+	resource_type *string
+	name          *string
+	version       *string
+	revision      *uint32
+	addrevision   *int32
+	user_name     *string
+	snapshot      *string
+	create_time   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Revision, error)
+	predicates    []predicate.Revision
+}
+
+var _ ent.Mutation = (*RevisionMutation)(nil)
+
+// revisionOption allows management of the mutation configuration using functional options.
+type revisionOption func(*RevisionMutation)
+
+// newRevisionMutation creates new mutation for the Revision entity.
+func newRevisionMutation(c config, op Op, opts ...revisionOption) *RevisionMutation {
+	m := &RevisionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeParameterTemplate,
+		typ:           TypeRevision,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11163,20 +17940,20 @@ func newParameterTemplateMutation(c config, op Op, opts ...parametertemplateOpti
 	return m
 }
 
-// withParameterTemplateID sets the ID field of the mutation.
-func withParameterTemplateID(id uint64) parametertemplateOption {
-	return func(m *ParameterTemplateMutation) {
+// withRevisionID sets the ID field of the mutation.
+func withRevisionID(id uint64) revisionOption {
+	return func(m *RevisionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ParameterTemplate
+			value *Revision
 		)
-		m.oldValue = func(ctx context.Context) (*ParameterTemplate, error) {
+		m.oldValue = func(ctx context.Context) (*Revision, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ParameterTemplate.Get(ctx, id)
+					value, err = m.Client().Revision.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11185,10 +17962,10 @@ func withParameterTemplateID(id uint64) parametertemplateOption {
 	}
 }
 
-// withParameterTemplate sets the old ParameterTemplate of the mutation.
-func withParameterTemplate(node *ParameterTemplate) parametertemplateOption {
-	return func(m *ParameterTemplateMutation) {
-		m.oldValue = func(context.Context) (*ParameterTemplate, error) {
+// withRevision sets the old Revision of the mutation.
+func withRevision(node *Revision) revisionOption {
+	return func(m *RevisionMutation) {
+		m.oldValue = func(context.Context) (*Revision, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11197,7 +17974,7 @@ func withParameterTemplate(node *ParameterTemplate) parametertemplateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ParameterTemplateMutation) Client() *Client {
+func (m RevisionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11205,7 +17982,7 @@ func (m ParameterTemplateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ParameterTemplateMutation) Tx() (*Tx, error) {
+func (m RevisionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -11216,7 +17993,7 @@ func (m ParameterTemplateMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ParameterTemplateMutation) ID() (id uint64, exists bool) {
+func (m *RevisionMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11227,7 +18004,7 @@ func (m *ParameterTemplateMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ParameterTemplateMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *RevisionMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11236,414 +18013,974 @@ func (m *ParameterTemplateMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ParameterTemplate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Revision.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ParameterTemplateMutation) SetName(s string) {
-	m.name = &s
+// SetProjectUUID sets the "project_uuid" field.
+func (m *RevisionMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
+}
+
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *RevisionMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectUUID returns the old "project_uuid" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
+	}
+	return oldValue.ProjectUUID, nil
+}
+
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *RevisionMutation) ResetProjectUUID() {
+	m.project_uuid = nil
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *RevisionMutation) SetResourceType(s string) {
+	m.resource_type = &s
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *RevisionMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldResourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *RevisionMutation) ResetResourceType() {
+	m.resource_type = nil
+}
+
+// SetName sets the "name" field.
+func (m *RevisionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RevisionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RevisionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *RevisionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *RevisionMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *RevisionMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *RevisionMutation) SetRevision(u uint32) {
+	m.revision = &u
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *RevisionMutation) Revision() (r uint32, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldRevision(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds u to the "revision" field.
+func (m *RevisionMutation) AddRevision(u int32) {
+	if m.addrevision != nil {
+		*m.addrevision += u
+	} else {
+		m.addrevision = &u
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *RevisionMutation) AddedRevision() (r int32, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *RevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetUserName sets the "user_name" field.
+func (m *RevisionMutation) SetUserName(s string) {
+	m.user_name = &s
+}
+
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *RevisionMutation) UserName() (r string, exists bool) {
+	v := m.user_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserName returns the old "user_name" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldUserName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+	}
+	return oldValue.UserName, nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ParameterTemplateMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetUserName resets all changes to the "user_name" field.
+func (m *RevisionMutation) ResetUserName() {
+	m.user_name = nil
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (m *RevisionMutation) SetSnapshot(s string) {
+	m.snapshot = &s
+}
+
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *RevisionMutation) Snapshot() (r string, exists bool) {
+	v := m.snapshot
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldSnapshot returns the old "snapshot" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RevisionMutation) OldSnapshot(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Snapshot, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ParameterTemplateMutation) ResetName() {
-	m.name = nil
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *RevisionMutation) ResetSnapshot() {
+	m.snapshot = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *ParameterTemplateMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetCreateTime sets the "create_time" field.
+func (m *RevisionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *ParameterTemplateMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RevisionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *RevisionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearDisplayName clears the value of the "display_name" field.
-func (m *ParameterTemplateMutation) ClearDisplayName() {
-	m.display_name = nil
-	m.clearedFields[parametertemplate.FieldDisplayName] = struct{}{}
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RevisionMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldDisplayName]
-	return ok
+// Where appends a list predicates to the RevisionMutation builder.
+func (m *RevisionMutation) Where(ps ...predicate.Revision) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *ParameterTemplateMutation) ResetDisplayName() {
-	m.display_name = nil
-	delete(m.clearedFields, parametertemplate.FieldDisplayName)
+// WhereP appends storage-level predicates to the RevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Revision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetDisplayNameLc sets the "display_name_lc" field.
-func (m *ParameterTemplateMutation) SetDisplayNameLc(s string) {
-	m.display_name_lc = &s
+// Op returns the operation name.
+func (m *RevisionMutation) Op() Op {
+	return m.op
 }
 
-// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *ParameterTemplateMutation) DisplayNameLc() (r string, exists bool) {
-	v := m.display_name_lc
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *RevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Revision).
+func (m *RevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RevisionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.project_uuid != nil {
+		fields = append(fields, revision.FieldProjectUUID)
 	}
-	return *v, true
+	if m.resource_type != nil {
+		fields = append(fields, revision.FieldResourceType)
+	}
+	if m.name != nil {
+		fields = append(fields, revision.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, revision.FieldVersion)
+	}
+	if m.revision != nil {
+		fields = append(fields, revision.FieldRevision)
+	}
+	if m.user_name != nil {
+		fields = append(fields, revision.FieldUserName)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, revision.FieldSnapshot)
+	}
+	if m.create_time != nil {
+		fields = append(fields, revision.FieldCreateTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case revision.FieldProjectUUID:
+		return m.ProjectUUID()
+	case revision.FieldResourceType:
+		return m.ResourceType()
+	case revision.FieldName:
+		return m.Name()
+	case revision.FieldVersion:
+		return m.Version()
+	case revision.FieldRevision:
+		return m.Revision()
+	case revision.FieldUserName:
+		return m.UserName()
+	case revision.FieldSnapshot:
+		return m.Snapshot()
+	case revision.FieldCreateTime:
+		return m.CreateTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case revision.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case revision.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case revision.FieldName:
+		return m.OldName(ctx)
+	case revision.FieldVersion:
+		return m.OldVersion(ctx)
+	case revision.FieldRevision:
+		return m.OldRevision(ctx)
+	case revision.FieldUserName:
+		return m.OldUserName(ctx)
+	case revision.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case revision.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Revision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case revision.FieldProjectUUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectUUID(v)
+		return nil
+	case revision.FieldResourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case revision.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case revision.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case revision.FieldRevision:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case revision.FieldUserName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserName(v)
+		return nil
+	case revision.FieldSnapshot:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case revision.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Revision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, revision.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case revision.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case revision.FieldRevision:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Revision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Revision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RevisionMutation) ResetField(name string) error {
+	switch name {
+	case revision.FieldProjectUUID:
+		m.ResetProjectUUID()
+		return nil
+	case revision.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case revision.FieldName:
+		m.ResetName()
+		return nil
+	case revision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case revision.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case revision.FieldUserName:
+		m.ResetUserName()
+		return nil
+	case revision.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case revision.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Revision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RevisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
-	}
-	return oldValue.DisplayNameLc, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *ParameterTemplateMutation) ClearDisplayNameLc() {
-	m.display_name_lc = nil
-	m.clearedFields[parametertemplate.FieldDisplayNameLc] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RevisionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldDisplayNameLc]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RevisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Revision unique edge %s", name)
 }
 
-// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *ParameterTemplateMutation) ResetDisplayNameLc() {
-	m.display_name_lc = nil
-	delete(m.clearedFields, parametertemplate.FieldDisplayNameLc)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RevisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Revision edge %s", name)
 }
 
-// SetDefault sets the "default" field.
-func (m *ParameterTemplateMutation) SetDefault(s string) {
-	m._default = &s
+// TrashedEntityMutation represents an operation that mutates the TrashedEntity nodes in the graph.
+type TrashedEntityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	project_uuid  *string
+	resource_type *string
+	name          *string
+	version       *string
+	user_name     *string
+	snapshot      *string
+	secret        *string
+	delete_time   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TrashedEntity, error)
+	predicates    []predicate.TrashedEntity
+}
+
+var _ ent.Mutation = (*TrashedEntityMutation)(nil)
+
+// trashedentityOption allows management of the mutation configuration using functional options.
+type trashedentityOption func(*TrashedEntityMutation)
+
+// newTrashedEntityMutation creates new mutation for the TrashedEntity entity.
+func newTrashedEntityMutation(c config, op Op, opts ...trashedentityOption) *TrashedEntityMutation {
+	m := &TrashedEntityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTrashedEntity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Default returns the value of the "default" field in the mutation.
-func (m *ParameterTemplateMutation) Default() (r string, exists bool) {
-	v := m._default
-	if v == nil {
-		return
+// withTrashedEntityID sets the ID field of the mutation.
+func withTrashedEntityID(id uint64) trashedentityOption {
+	return func(m *TrashedEntityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TrashedEntity
+		)
+		m.oldValue = func(ctx context.Context) (*TrashedEntity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TrashedEntity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldDefault returns the old "default" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldDefault(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefault is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefault requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefault: %w", err)
+// withTrashedEntity sets the old TrashedEntity of the mutation.
+func withTrashedEntity(node *TrashedEntity) trashedentityOption {
+	return func(m *TrashedEntityMutation) {
+		m.oldValue = func(context.Context) (*TrashedEntity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Default, nil
 }
 
-// ClearDefault clears the value of the "default" field.
-func (m *ParameterTemplateMutation) ClearDefault() {
-	m._default = nil
-	m.clearedFields[parametertemplate.FieldDefault] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TrashedEntityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// DefaultCleared returns if the "default" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) DefaultCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldDefault]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TrashedEntityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetDefault resets all changes to the "default" field.
-func (m *ParameterTemplateMutation) ResetDefault() {
-	m._default = nil
-	delete(m.clearedFields, parametertemplate.FieldDefault)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TrashedEntityMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetType sets the "type" field.
-func (m *ParameterTemplateMutation) SetType(s string) {
-	m._type = &s
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TrashedEntityMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TrashedEntity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ParameterTemplateMutation) GetType() (r string, exists bool) {
-	v := m._type
+// SetProjectUUID sets the "project_uuid" field.
+func (m *TrashedEntityMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
+}
+
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *TrashedEntityMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectUUID returns the old "project_uuid" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *TrashedEntityMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ClearType clears the value of the "type" field.
-func (m *ParameterTemplateMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[parametertemplate.FieldType] = struct{}{}
-}
-
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldType]
-	return ok
+	return oldValue.ProjectUUID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ParameterTemplateMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, parametertemplate.FieldType)
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *TrashedEntityMutation) ResetProjectUUID() {
+	m.project_uuid = nil
 }
 
-// SetValidator sets the "validator" field.
-func (m *ParameterTemplateMutation) SetValidator(s string) {
-	m.validator = &s
+// SetResourceType sets the "resource_type" field.
+func (m *TrashedEntityMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// Validator returns the value of the "validator" field in the mutation.
-func (m *ParameterTemplateMutation) Validator() (r string, exists bool) {
-	v := m.validator
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *TrashedEntityMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValidator returns the old "validator" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldValidator(ctx context.Context) (v string, err error) {
+func (m *TrashedEntityMutation) OldResourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValidator is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValidator requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValidator: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.Validator, nil
-}
-
-// ClearValidator clears the value of the "validator" field.
-func (m *ParameterTemplateMutation) ClearValidator() {
-	m.validator = nil
-	m.clearedFields[parametertemplate.FieldValidator] = struct{}{}
+	return oldValue.ResourceType, nil
 }
 
-// ValidatorCleared returns if the "validator" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) ValidatorCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldValidator]
-	return ok
-}
-
-// ResetValidator resets all changes to the "validator" field.
-func (m *ParameterTemplateMutation) ResetValidator() {
-	m.validator = nil
-	delete(m.clearedFields, parametertemplate.FieldValidator)
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *TrashedEntityMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// SetSuggestedValues sets the "suggested_values" field.
-func (m *ParameterTemplateMutation) SetSuggestedValues(s []string) {
-	m.suggested_values = &s
-	m.appendsuggested_values = nil
+// SetName sets the "name" field.
+func (m *TrashedEntityMutation) SetName(s string) {
+	m.name = &s
 }
 
-// SuggestedValues returns the value of the "suggested_values" field in the mutation.
-func (m *ParameterTemplateMutation) SuggestedValues() (r []string, exists bool) {
-	v := m.suggested_values
+// Name returns the value of the "name" field in the mutation.
+func (m *TrashedEntityMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuggestedValues returns the old "suggested_values" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldSuggestedValues(ctx context.Context) (v []string, err error) {
+func (m *TrashedEntityMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuggestedValues is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuggestedValues requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuggestedValues: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.SuggestedValues, nil
+	return oldValue.Name, nil
 }
 
-// AppendSuggestedValues adds s to the "suggested_values" field.
-func (m *ParameterTemplateMutation) AppendSuggestedValues(s []string) {
-	m.appendsuggested_values = append(m.appendsuggested_values, s...)
+// ResetName resets all changes to the "name" field.
+func (m *TrashedEntityMutation) ResetName() {
+	m.name = nil
 }
 
-// AppendedSuggestedValues returns the list of values that were appended to the "suggested_values" field in this mutation.
-func (m *ParameterTemplateMutation) AppendedSuggestedValues() ([]string, bool) {
-	if len(m.appendsuggested_values) == 0 {
-		return nil, false
-	}
-	return m.appendsuggested_values, true
+// SetVersion sets the "version" field.
+func (m *TrashedEntityMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// ClearSuggestedValues clears the value of the "suggested_values" field.
-func (m *ParameterTemplateMutation) ClearSuggestedValues() {
-	m.suggested_values = nil
-	m.appendsuggested_values = nil
-	m.clearedFields[parametertemplate.FieldSuggestedValues] = struct{}{}
+// Version returns the value of the "version" field in the mutation.
+func (m *TrashedEntityMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SuggestedValuesCleared returns if the "suggested_values" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) SuggestedValuesCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldSuggestedValues]
-	return ok
+// OldVersion returns the old "version" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrashedEntityMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// ResetSuggestedValues resets all changes to the "suggested_values" field.
-func (m *ParameterTemplateMutation) ResetSuggestedValues() {
-	m.suggested_values = nil
-	m.appendsuggested_values = nil
-	delete(m.clearedFields, parametertemplate.FieldSuggestedValues)
+// ResetVersion resets all changes to the "version" field.
+func (m *TrashedEntityMutation) ResetVersion() {
+	m.version = nil
 }
 
-// SetMandatory sets the "mandatory" field.
-func (m *ParameterTemplateMutation) SetMandatory(b bool) {
-	m.mandatory = &b
+// SetUserName sets the "user_name" field.
+func (m *TrashedEntityMutation) SetUserName(s string) {
+	m.user_name = &s
 }
 
-// Mandatory returns the value of the "mandatory" field in the mutation.
-func (m *ParameterTemplateMutation) Mandatory() (r bool, exists bool) {
-	v := m.mandatory
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *TrashedEntityMutation) UserName() (r string, exists bool) {
+	v := m.user_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMandatory returns the old "mandatory" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldUserName returns the old "user_name" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldMandatory(ctx context.Context) (v bool, err error) {
+func (m *TrashedEntityMutation) OldUserName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMandatory is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMandatory requires an ID field in the mutation")
+		return v, errors.New("OldUserName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMandatory: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
 	}
-	return oldValue.Mandatory, nil
+	return oldValue.UserName, nil
 }
 
-// ClearMandatory clears the value of the "mandatory" field.
-func (m *ParameterTemplateMutation) ClearMandatory() {
-	m.mandatory = nil
-	m.clearedFields[parametertemplate.FieldMandatory] = struct{}{}
+// ResetUserName resets all changes to the "user_name" field.
+func (m *TrashedEntityMutation) ResetUserName() {
+	m.user_name = nil
 }
 
-// MandatoryCleared returns if the "mandatory" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) MandatoryCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldMandatory]
-	return ok
+// SetSnapshot sets the "snapshot" field.
+func (m *TrashedEntityMutation) SetSnapshot(s string) {
+	m.snapshot = &s
 }
 
-// ResetMandatory resets all changes to the "mandatory" field.
-func (m *ParameterTemplateMutation) ResetMandatory() {
-	m.mandatory = nil
-	delete(m.clearedFields, parametertemplate.FieldMandatory)
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *TrashedEntityMutation) Snapshot() (r string, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshot returns the old "snapshot" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrashedEntityMutation) OldSnapshot(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
+}
+
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *TrashedEntityMutation) ResetSnapshot() {
+	m.snapshot = nil
 }
 
 // SetSecret sets the "secret" field.
-func (m *ParameterTemplateMutation) SetSecret(b bool) {
-	m.secret = &b
+func (m *TrashedEntityMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
 // Secret returns the value of the "secret" field in the mutation.
-func (m *ParameterTemplateMutation) Secret() (r bool, exists bool) {
+func (m *TrashedEntityMutation) Secret() (r string, exists bool) {
 	v := m.secret
 	if v == nil {
 		return
@@ -11651,10 +18988,10 @@ func (m *ParameterTemplateMutation) Secret() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the ParameterTemplate entity.
-// If the ParameterTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ParameterTemplateMutation) OldSecret(ctx context.Context) (v bool, err error) {
+func (m *TrashedEntityMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
@@ -11669,125 +19006,68 @@ func (m *ParameterTemplateMutation) OldSecret(ctx context.Context) (v bool, err
 }
 
 // ClearSecret clears the value of the "secret" field.
-func (m *ParameterTemplateMutation) ClearSecret() {
+func (m *TrashedEntityMutation) ClearSecret() {
 	m.secret = nil
-	m.clearedFields[parametertemplate.FieldSecret] = struct{}{}
+	m.clearedFields[trashedentity.FieldSecret] = struct{}{}
 }
 
 // SecretCleared returns if the "secret" field was cleared in this mutation.
-func (m *ParameterTemplateMutation) SecretCleared() bool {
-	_, ok := m.clearedFields[parametertemplate.FieldSecret]
+func (m *TrashedEntityMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[trashedentity.FieldSecret]
 	return ok
 }
 
 // ResetSecret resets all changes to the "secret" field.
-func (m *ParameterTemplateMutation) ResetSecret() {
+func (m *TrashedEntityMutation) ResetSecret() {
 	m.secret = nil
-	delete(m.clearedFields, parametertemplate.FieldSecret)
-}
-
-// SetProfileFkID sets the "profile_fk" edge to the Profile entity by id.
-func (m *ParameterTemplateMutation) SetProfileFkID(id uint64) {
-	m.profile_fk = &id
-}
-
-// ClearProfileFk clears the "profile_fk" edge to the Profile entity.
-func (m *ParameterTemplateMutation) ClearProfileFk() {
-	m.clearedprofile_fk = true
-}
-
-// ProfileFkCleared reports if the "profile_fk" edge to the Profile entity was cleared.
-func (m *ParameterTemplateMutation) ProfileFkCleared() bool {
-	return m.clearedprofile_fk
-}
-
-// ProfileFkID returns the "profile_fk" edge ID in the mutation.
-func (m *ParameterTemplateMutation) ProfileFkID() (id uint64, exists bool) {
-	if m.profile_fk != nil {
-		return *m.profile_fk, true
-	}
-	return
-}
-
-// ProfileFkIDs returns the "profile_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProfileFkID instead. It exists only for internal usage by the builders.
-func (m *ParameterTemplateMutation) ProfileFkIDs() (ids []uint64) {
-	if id := m.profile_fk; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+	delete(m.clearedFields, trashedentity.FieldSecret)
 }
 
-// ResetProfileFk resets all changes to the "profile_fk" edge.
-func (m *ParameterTemplateMutation) ResetProfileFk() {
-	m.profile_fk = nil
-	m.clearedprofile_fk = false
+// SetDeleteTime sets the "delete_time" field.
+func (m *TrashedEntityMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
 }
 
-// AddProfileIDs adds the "profiles" edge to the Profile entity by ids.
-func (m *ParameterTemplateMutation) AddProfileIDs(ids ...uint64) {
-	if m.profiles == nil {
-		m.profiles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.profiles[ids[i]] = struct{}{}
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *TrashedEntityMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearProfiles clears the "profiles" edge to the Profile entity.
-func (m *ParameterTemplateMutation) ClearProfiles() {
-	m.clearedprofiles = true
-}
-
-// ProfilesCleared reports if the "profiles" edge to the Profile entity was cleared.
-func (m *ParameterTemplateMutation) ProfilesCleared() bool {
-	return m.clearedprofiles
-}
-
-// RemoveProfileIDs removes the "profiles" edge to the Profile entity by IDs.
-func (m *ParameterTemplateMutation) RemoveProfileIDs(ids ...uint64) {
-	if m.removedprofiles == nil {
-		m.removedprofiles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.profiles, ids[i])
-		m.removedprofiles[ids[i]] = struct{}{}
+// OldDeleteTime returns the old "delete_time" field's value of the TrashedEntity entity.
+// If the TrashedEntity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrashedEntityMutation) OldDeleteTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedProfiles returns the removed IDs of the "profiles" edge to the Profile entity.
-func (m *ParameterTemplateMutation) RemovedProfilesIDs() (ids []uint64) {
-	for id := range m.removedprofiles {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
 	}
-	return
-}
-
-// ProfilesIDs returns the "profiles" edge IDs in the mutation.
-func (m *ParameterTemplateMutation) ProfilesIDs() (ids []uint64) {
-	for id := range m.profiles {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
 	}
-	return
+	return oldValue.DeleteTime, nil
 }
 
-// ResetProfiles resets all changes to the "profiles" edge.
-func (m *ParameterTemplateMutation) ResetProfiles() {
-	m.profiles = nil
-	m.clearedprofiles = false
-	m.removedprofiles = nil
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *TrashedEntityMutation) ResetDeleteTime() {
+	m.delete_time = nil
 }
 
-// Where appends a list predicates to the ParameterTemplateMutation builder.
-func (m *ParameterTemplateMutation) Where(ps ...predicate.ParameterTemplate) {
+// Where appends a list predicates to the TrashedEntityMutation builder.
+func (m *TrashedEntityMutation) Where(ps ...predicate.TrashedEntity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ParameterTemplateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TrashedEntityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ParameterTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ParameterTemplate, len(ps))
+func (m *TrashedEntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TrashedEntity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11795,51 +19075,48 @@ func (m *ParameterTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ParameterTemplateMutation) Op() Op {
+func (m *TrashedEntityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ParameterTemplateMutation) SetOp(op Op) {
+func (m *TrashedEntityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ParameterTemplate).
-func (m *ParameterTemplateMutation) Type() string {
+// Type returns the node type of this mutation (TrashedEntity).
+func (m *TrashedEntityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ParameterTemplateMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.name != nil {
-		fields = append(fields, parametertemplate.FieldName)
-	}
-	if m.display_name != nil {
-		fields = append(fields, parametertemplate.FieldDisplayName)
-	}
-	if m.display_name_lc != nil {
-		fields = append(fields, parametertemplate.FieldDisplayNameLc)
+func (m *TrashedEntityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.project_uuid != nil {
+		fields = append(fields, trashedentity.FieldProjectUUID)
 	}
-	if m._default != nil {
-		fields = append(fields, parametertemplate.FieldDefault)
+	if m.resource_type != nil {
+		fields = append(fields, trashedentity.FieldResourceType)
 	}
-	if m._type != nil {
-		fields = append(fields, parametertemplate.FieldType)
+	if m.name != nil {
+		fields = append(fields, trashedentity.FieldName)
 	}
-	if m.validator != nil {
-		fields = append(fields, parametertemplate.FieldValidator)
+	if m.version != nil {
+		fields = append(fields, trashedentity.FieldVersion)
 	}
-	if m.suggested_values != nil {
-		fields = append(fields, parametertemplate.FieldSuggestedValues)
+	if m.user_name != nil {
+		fields = append(fields, trashedentity.FieldUserName)
 	}
-	if m.mandatory != nil {
-		fields = append(fields, parametertemplate.FieldMandatory)
+	if m.snapshot != nil {
+		fields = append(fields, trashedentity.FieldSnapshot)
 	}
 	if m.secret != nil {
-		fields = append(fields, parametertemplate.FieldSecret)
+		fields = append(fields, trashedentity.FieldSecret)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, trashedentity.FieldDeleteTime)
 	}
 	return fields
 }
@@ -11847,26 +19124,24 @@ func (m *ParameterTemplateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ParameterTemplateMutation) Field(name string) (ent.Value, bool) {
+func (m *TrashedEntityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case parametertemplate.FieldName:
+	case trashedentity.FieldProjectUUID:
+		return m.ProjectUUID()
+	case trashedentity.FieldResourceType:
+		return m.ResourceType()
+	case trashedentity.FieldName:
 		return m.Name()
-	case parametertemplate.FieldDisplayName:
-		return m.DisplayName()
-	case parametertemplate.FieldDisplayNameLc:
-		return m.DisplayNameLc()
-	case parametertemplate.FieldDefault:
-		return m.Default()
-	case parametertemplate.FieldType:
-		return m.GetType()
-	case parametertemplate.FieldValidator:
-		return m.Validator()
-	case parametertemplate.FieldSuggestedValues:
-		return m.SuggestedValues()
-	case parametertemplate.FieldMandatory:
-		return m.Mandatory()
-	case parametertemplate.FieldSecret:
+	case trashedentity.FieldVersion:
+		return m.Version()
+	case trashedentity.FieldUserName:
+		return m.UserName()
+	case trashedentity.FieldSnapshot:
+		return m.Snapshot()
+	case trashedentity.FieldSecret:
 		return m.Secret()
+	case trashedentity.FieldDeleteTime:
+		return m.DeleteTime()
 	}
 	return nil, false
 }
@@ -11874,372 +19149,260 @@ func (m *ParameterTemplateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ParameterTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TrashedEntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case parametertemplate.FieldName:
+	case trashedentity.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case trashedentity.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case trashedentity.FieldName:
 		return m.OldName(ctx)
-	case parametertemplate.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case parametertemplate.FieldDisplayNameLc:
-		return m.OldDisplayNameLc(ctx)
-	case parametertemplate.FieldDefault:
-		return m.OldDefault(ctx)
-	case parametertemplate.FieldType:
-		return m.OldType(ctx)
-	case parametertemplate.FieldValidator:
-		return m.OldValidator(ctx)
-	case parametertemplate.FieldSuggestedValues:
-		return m.OldSuggestedValues(ctx)
-	case parametertemplate.FieldMandatory:
-		return m.OldMandatory(ctx)
-	case parametertemplate.FieldSecret:
+	case trashedentity.FieldVersion:
+		return m.OldVersion(ctx)
+	case trashedentity.FieldUserName:
+		return m.OldUserName(ctx)
+	case trashedentity.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case trashedentity.FieldSecret:
 		return m.OldSecret(ctx)
+	case trashedentity.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown ParameterTemplate field %s", name)
+	return nil, fmt.Errorf("unknown TrashedEntity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ParameterTemplateMutation) SetField(name string, value ent.Value) error {
+func (m *TrashedEntityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case parametertemplate.FieldName:
+	case trashedentity.FieldProjectUUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProjectUUID(v)
 		return nil
-	case parametertemplate.FieldDisplayName:
+	case trashedentity.FieldResourceType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetResourceType(v)
 		return nil
-	case parametertemplate.FieldDisplayNameLc:
+	case trashedentity.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayNameLc(v)
+		m.SetName(v)
 		return nil
-	case parametertemplate.FieldDefault:
+	case trashedentity.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefault(v)
+		m.SetVersion(v)
 		return nil
-	case parametertemplate.FieldType:
+	case trashedentity.FieldUserName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUserName(v)
 		return nil
-	case parametertemplate.FieldValidator:
+	case trashedentity.FieldSnapshot:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValidator(v)
-		return nil
-	case parametertemplate.FieldSuggestedValues:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuggestedValues(v)
-		return nil
-	case parametertemplate.FieldMandatory:
-		v, ok := value.(bool)
+		m.SetSnapshot(v)
+		return nil
+	case trashedentity.FieldSecret:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMandatory(v)
+		m.SetSecret(v)
 		return nil
-	case parametertemplate.FieldSecret:
-		v, ok := value.(bool)
+	case trashedentity.FieldDeleteTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecret(v)
+		m.SetDeleteTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ParameterTemplate field %s", name)
+	return fmt.Errorf("unknown TrashedEntity field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ParameterTemplateMutation) AddedFields() []string {
+func (m *TrashedEntityMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ParameterTemplateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TrashedEntityMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ParameterTemplateMutation) AddField(name string, value ent.Value) error {
+func (m *TrashedEntityMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ParameterTemplate numeric field %s", name)
+	return fmt.Errorf("unknown TrashedEntity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ParameterTemplateMutation) ClearedFields() []string {
+func (m *TrashedEntityMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(parametertemplate.FieldDisplayName) {
-		fields = append(fields, parametertemplate.FieldDisplayName)
-	}
-	if m.FieldCleared(parametertemplate.FieldDisplayNameLc) {
-		fields = append(fields, parametertemplate.FieldDisplayNameLc)
-	}
-	if m.FieldCleared(parametertemplate.FieldDefault) {
-		fields = append(fields, parametertemplate.FieldDefault)
-	}
-	if m.FieldCleared(parametertemplate.FieldType) {
-		fields = append(fields, parametertemplate.FieldType)
-	}
-	if m.FieldCleared(parametertemplate.FieldValidator) {
-		fields = append(fields, parametertemplate.FieldValidator)
-	}
-	if m.FieldCleared(parametertemplate.FieldSuggestedValues) {
-		fields = append(fields, parametertemplate.FieldSuggestedValues)
-	}
-	if m.FieldCleared(parametertemplate.FieldMandatory) {
-		fields = append(fields, parametertemplate.FieldMandatory)
-	}
-	if m.FieldCleared(parametertemplate.FieldSecret) {
-		fields = append(fields, parametertemplate.FieldSecret)
+	if m.FieldCleared(trashedentity.FieldSecret) {
+		fields = append(fields, trashedentity.FieldSecret)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ParameterTemplateMutation) FieldCleared(name string) bool {
+func (m *TrashedEntityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ParameterTemplateMutation) ClearField(name string) error {
+func (m *TrashedEntityMutation) ClearField(name string) error {
 	switch name {
-	case parametertemplate.FieldDisplayName:
-		m.ClearDisplayName()
-		return nil
-	case parametertemplate.FieldDisplayNameLc:
-		m.ClearDisplayNameLc()
-		return nil
-	case parametertemplate.FieldDefault:
-		m.ClearDefault()
-		return nil
-	case parametertemplate.FieldType:
-		m.ClearType()
-		return nil
-	case parametertemplate.FieldValidator:
-		m.ClearValidator()
-		return nil
-	case parametertemplate.FieldSuggestedValues:
-		m.ClearSuggestedValues()
-		return nil
-	case parametertemplate.FieldMandatory:
-		m.ClearMandatory()
-		return nil
-	case parametertemplate.FieldSecret:
+	case trashedentity.FieldSecret:
 		m.ClearSecret()
 		return nil
 	}
-	return fmt.Errorf("unknown ParameterTemplate nullable field %s", name)
+	return fmt.Errorf("unknown TrashedEntity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ParameterTemplateMutation) ResetField(name string) error {
+func (m *TrashedEntityMutation) ResetField(name string) error {
 	switch name {
-	case parametertemplate.FieldName:
-		m.ResetName()
-		return nil
-	case parametertemplate.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case parametertemplate.FieldDisplayNameLc:
-		m.ResetDisplayNameLc()
+	case trashedentity.FieldProjectUUID:
+		m.ResetProjectUUID()
 		return nil
-	case parametertemplate.FieldDefault:
-		m.ResetDefault()
+	case trashedentity.FieldResourceType:
+		m.ResetResourceType()
 		return nil
-	case parametertemplate.FieldType:
-		m.ResetType()
+	case trashedentity.FieldName:
+		m.ResetName()
 		return nil
-	case parametertemplate.FieldValidator:
-		m.ResetValidator()
+	case trashedentity.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case parametertemplate.FieldSuggestedValues:
-		m.ResetSuggestedValues()
+	case trashedentity.FieldUserName:
+		m.ResetUserName()
 		return nil
-	case parametertemplate.FieldMandatory:
-		m.ResetMandatory()
+	case trashedentity.FieldSnapshot:
+		m.ResetSnapshot()
 		return nil
-	case parametertemplate.FieldSecret:
+	case trashedentity.FieldSecret:
 		m.ResetSecret()
 		return nil
+	case trashedentity.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
 	}
-	return fmt.Errorf("unknown ParameterTemplate field %s", name)
+	return fmt.Errorf("unknown TrashedEntity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ParameterTemplateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.profile_fk != nil {
-		edges = append(edges, parametertemplate.EdgeProfileFk)
-	}
-	if m.profiles != nil {
-		edges = append(edges, parametertemplate.EdgeProfiles)
-	}
+func (m *TrashedEntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ParameterTemplateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case parametertemplate.EdgeProfileFk:
-		if id := m.profile_fk; id != nil {
-			return []ent.Value{*id}
-		}
-	case parametertemplate.EdgeProfiles:
-		ids := make([]ent.Value, 0, len(m.profiles))
-		for id := range m.profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TrashedEntityMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ParameterTemplateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedprofiles != nil {
-		edges = append(edges, parametertemplate.EdgeProfiles)
-	}
+func (m *TrashedEntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ParameterTemplateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case parametertemplate.EdgeProfiles:
-		ids := make([]ent.Value, 0, len(m.removedprofiles))
-		for id := range m.removedprofiles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TrashedEntityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ParameterTemplateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedprofile_fk {
-		edges = append(edges, parametertemplate.EdgeProfileFk)
-	}
-	if m.clearedprofiles {
-		edges = append(edges, parametertemplate.EdgeProfiles)
-	}
+func (m *TrashedEntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ParameterTemplateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case parametertemplate.EdgeProfileFk:
-		return m.clearedprofile_fk
-	case parametertemplate.EdgeProfiles:
-		return m.clearedprofiles
-	}
+func (m *TrashedEntityMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ParameterTemplateMutation) ClearEdge(name string) error {
-	switch name {
-	case parametertemplate.EdgeProfileFk:
-		m.ClearProfileFk()
-		return nil
-	}
-	return fmt.Errorf("unknown ParameterTemplate unique edge %s", name)
+func (m *TrashedEntityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TrashedEntity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ParameterTemplateMutation) ResetEdge(name string) error {
-	switch name {
-	case parametertemplate.EdgeProfileFk:
-		m.ResetProfileFk()
-		return nil
-	case parametertemplate.EdgeProfiles:
-		m.ResetProfiles()
-		return nil
-	}
-	return fmt.Errorf("unknown ParameterTemplate edge %s", name)
+func (m *TrashedEntityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TrashedEntity edge %s", name)
 }
 
-// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
-type ProfileMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op                             Op
-	typ                            string
-	id                             *uint64
-	name                           *string
-	display_name                   *string
-	display_name_lc                *string
-	description                    *string
-	create_time                    *time.Time
-	update_time                    *time.Time
-	chart_values                   *string
-	clearedFields                  map[string]struct{}
-	application_fk                 *uint64
-	clearedapplication_fk          bool
-	deployment_profiles            map[uint64]struct{}
-	removeddeployment_profiles     map[uint64]struct{}
-	cleareddeployment_profiles     bool
-	parameter_templates            map[uint64]struct{}
-	removedparameter_templates     map[uint64]struct{}
-	clearedparameter_templates     bool
-	deployment_requirements        map[uint64]struct{}
-	removeddeployment_requirements map[uint64]struct{}
-	cleareddeployment_requirements bool
-	done                           bool
-	oldValue                       func(context.Context) (*Profile, error)
-	predicates                     []predicate.Profile
-}
-
-var _ ent.Mutation = (*ProfileMutation)(nil)
-
-// profileOption allows management of the mutation configuration using functional options.
-type profileOption func(*ProfileMutation)
-
-// newProfileMutation creates new mutation for the Profile entity.
-func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
-	m := &ProfileMutation{
+	op                   Op
+	typ                  string
+	id                   *uint64
+	project_uuid         *string
+	name                 *string
+	description          *string
+	url                  *string
+	resource_types       *[]string
+	appendresource_types []string
+	event_types          *[]string
+	appendevent_types    []string
+	secret               *string
+	create_time          *time.Time
+	update_time          *time.Time
+	clearedFields        map[string]struct{}
+	deliveries           map[uint64]struct{}
+	removeddeliveries    map[uint64]struct{}
+	cleareddeliveries    bool
+	done                 bool
+	oldValue             func(context.Context) (*Webhook, error)
+	predicates           []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProfile,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12248,20 +19411,20 @@ func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation
 	return m
 }
 
-// withProfileID sets the ID field of the mutation.
-func withProfileID(id uint64) profileOption {
-	return func(m *ProfileMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uint64) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Profile
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Profile, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Profile.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12270,10 +19433,10 @@ func withProfileID(id uint64) profileOption {
 	}
 }
 
-// withProfile sets the old Profile of the mutation.
-func withProfile(node *Profile) profileOption {
-	return func(m *ProfileMutation) {
-		m.oldValue = func(context.Context) (*Profile, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12282,7 +19445,7 @@ func withProfile(node *Profile) profileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfileMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12290,7 +19453,7 @@ func (m ProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProfileMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -12301,7 +19464,7 @@ func (m ProfileMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProfileMutation) ID() (id uint64, exists bool) {
+func (m *WebhookMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12312,7 +19475,7 @@ func (m *ProfileMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProfileMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12321,153 +19484,91 @@ func (m *ProfileMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ProfileMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *ProfileMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *ProfileMutation) ResetName() {
-	m.name = nil
-}
-
-// SetDisplayName sets the "display_name" field.
-func (m *ProfileMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetProjectUUID sets the "project_uuid" field.
+func (m *WebhookMutation) SetProjectUUID(s string) {
+	m.project_uuid = &s
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *ProfileMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// ProjectUUID returns the value of the "project_uuid" field in the mutation.
+func (m *WebhookMutation) ProjectUUID() (r string, exists bool) {
+	v := m.project_uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectUUID returns the old "project_uuid" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
 	}
-	return oldValue.DisplayName, nil
-}
-
-// ClearDisplayName clears the value of the "display_name" field.
-func (m *ProfileMutation) ClearDisplayName() {
-	m.display_name = nil
-	m.clearedFields[profile.FieldDisplayName] = struct{}{}
-}
-
-// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *ProfileMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[profile.FieldDisplayName]
-	return ok
+	return oldValue.ProjectUUID, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *ProfileMutation) ResetDisplayName() {
-	m.display_name = nil
-	delete(m.clearedFields, profile.FieldDisplayName)
+// ResetProjectUUID resets all changes to the "project_uuid" field.
+func (m *WebhookMutation) ResetProjectUUID() {
+	m.project_uuid = nil
 }
 
-// SetDisplayNameLc sets the "display_name_lc" field.
-func (m *ProfileMutation) SetDisplayNameLc(s string) {
-	m.display_name_lc = &s
+// SetName sets the "name" field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *ProfileMutation) DisplayNameLc() (r string, exists bool) {
-	v := m.display_name_lc
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DisplayNameLc, nil
-}
-
-// ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *ProfileMutation) ClearDisplayNameLc() {
-	m.display_name_lc = nil
-	m.clearedFields[profile.FieldDisplayNameLc] = struct{}{}
-}
-
-// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *ProfileMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[profile.FieldDisplayNameLc]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *ProfileMutation) ResetDisplayNameLc() {
-	m.display_name_lc = nil
-	delete(m.clearedFields, profile.FieldDisplayNameLc)
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *ProfileMutation) SetDescription(s string) {
+func (m *WebhookMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *ProfileMutation) Description() (r string, exists bool) {
+func (m *WebhookMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -12475,10 +19576,10 @@ func (m *ProfileMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -12493,341 +19594,360 @@ func (m *ProfileMutation) OldDescription(ctx context.Context) (v string, err err
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *ProfileMutation) ClearDescription() {
+func (m *WebhookMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[profile.FieldDescription] = struct{}{}
+	m.clearedFields[webhook.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProfileMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[profile.FieldDescription]
+func (m *WebhookMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *ProfileMutation) ResetDescription() {
+func (m *WebhookMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, profile.FieldDescription)
+	delete(m.clearedFields, webhook.FieldDescription)
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *ProfileMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ProfileMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.URL, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *ProfileMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *ProfileMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetResourceTypes sets the "resource_types" field.
+func (m *WebhookMutation) SetResourceTypes(s []string) {
+	m.resource_types = &s
+	m.appendresource_types = nil
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ProfileMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// ResourceTypes returns the value of the "resource_types" field in the mutation.
+func (m *WebhookMutation) ResourceTypes() (r []string, exists bool) {
+	v := m.resource_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceTypes returns the old "resource_types" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldResourceTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldResourceTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceTypes: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.ResourceTypes, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ProfileMutation) ResetUpdateTime() {
-	m.update_time = nil
+// AppendResourceTypes adds s to the "resource_types" field.
+func (m *WebhookMutation) AppendResourceTypes(s []string) {
+	m.appendresource_types = append(m.appendresource_types, s...)
 }
 
-// SetChartValues sets the "chart_values" field.
-func (m *ProfileMutation) SetChartValues(s string) {
-	m.chart_values = &s
+// AppendedResourceTypes returns the list of values that were appended to the "resource_types" field in this mutation.
+func (m *WebhookMutation) AppendedResourceTypes() ([]string, bool) {
+	if len(m.appendresource_types) == 0 {
+		return nil, false
+	}
+	return m.appendresource_types, true
 }
 
-// ChartValues returns the value of the "chart_values" field in the mutation.
-func (m *ProfileMutation) ChartValues() (r string, exists bool) {
-	v := m.chart_values
+// ClearResourceTypes clears the value of the "resource_types" field.
+func (m *WebhookMutation) ClearResourceTypes() {
+	m.resource_types = nil
+	m.appendresource_types = nil
+	m.clearedFields[webhook.FieldResourceTypes] = struct{}{}
+}
+
+// ResourceTypesCleared returns if the "resource_types" field was cleared in this mutation.
+func (m *WebhookMutation) ResourceTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldResourceTypes]
+	return ok
+}
+
+// ResetResourceTypes resets all changes to the "resource_types" field.
+func (m *WebhookMutation) ResetResourceTypes() {
+	m.resource_types = nil
+	m.appendresource_types = nil
+	delete(m.clearedFields, webhook.FieldResourceTypes)
+}
+
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
+}
+
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChartValues returns the old "chart_values" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldEventTypes returns the old "event_types" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldChartValues(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChartValues is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChartValues requires an ID field in the mutation")
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChartValues: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return oldValue.ChartValues, nil
-}
-
-// ResetChartValues resets all changes to the "chart_values" field.
-func (m *ProfileMutation) ResetChartValues() {
-	m.chart_values = nil
-}
-
-// SetApplicationFkID sets the "application_fk" edge to the Application entity by id.
-func (m *ProfileMutation) SetApplicationFkID(id uint64) {
-	m.application_fk = &id
-}
-
-// ClearApplicationFk clears the "application_fk" edge to the Application entity.
-func (m *ProfileMutation) ClearApplicationFk() {
-	m.clearedapplication_fk = true
+	return oldValue.EventTypes, nil
 }
 
-// ApplicationFkCleared reports if the "application_fk" edge to the Application entity was cleared.
-func (m *ProfileMutation) ApplicationFkCleared() bool {
-	return m.clearedapplication_fk
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// ApplicationFkID returns the "application_fk" edge ID in the mutation.
-func (m *ProfileMutation) ApplicationFkID() (id uint64, exists bool) {
-	if m.application_fk != nil {
-		return *m.application_fk, true
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendevent_types, true
 }
 
-// ApplicationFkIDs returns the "application_fk" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ApplicationFkID instead. It exists only for internal usage by the builders.
-func (m *ProfileMutation) ApplicationFkIDs() (ids []uint64) {
-	if id := m.application_fk; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearEventTypes clears the value of the "event_types" field.
+func (m *WebhookMutation) ClearEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	m.clearedFields[webhook.FieldEventTypes] = struct{}{}
 }
 
-// ResetApplicationFk resets all changes to the "application_fk" edge.
-func (m *ProfileMutation) ResetApplicationFk() {
-	m.application_fk = nil
-	m.clearedapplication_fk = false
+// EventTypesCleared returns if the "event_types" field was cleared in this mutation.
+func (m *WebhookMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEventTypes]
+	return ok
 }
 
-// AddDeploymentProfileIDs adds the "deployment_profiles" edge to the DeploymentProfile entity by ids.
-func (m *ProfileMutation) AddDeploymentProfileIDs(ids ...uint64) {
-	if m.deployment_profiles == nil {
-		m.deployment_profiles = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.deployment_profiles[ids[i]] = struct{}{}
-	}
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	delete(m.clearedFields, webhook.FieldEventTypes)
 }
 
-// ClearDeploymentProfiles clears the "deployment_profiles" edge to the DeploymentProfile entity.
-func (m *ProfileMutation) ClearDeploymentProfiles() {
-	m.cleareddeployment_profiles = true
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// DeploymentProfilesCleared reports if the "deployment_profiles" edge to the DeploymentProfile entity was cleared.
-func (m *ProfileMutation) DeploymentProfilesCleared() bool {
-	return m.cleareddeployment_profiles
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveDeploymentProfileIDs removes the "deployment_profiles" edge to the DeploymentProfile entity by IDs.
-func (m *ProfileMutation) RemoveDeploymentProfileIDs(ids ...uint64) {
-	if m.removeddeployment_profiles == nil {
-		m.removeddeployment_profiles = make(map[uint64]struct{})
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.deployment_profiles, ids[i])
-		m.removeddeployment_profiles[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
-}
-
-// RemovedDeploymentProfiles returns the removed IDs of the "deployment_profiles" edge to the DeploymentProfile entity.
-func (m *ProfileMutation) RemovedDeploymentProfilesIDs() (ids []uint64) {
-	for id := range m.removeddeployment_profiles {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return
+	return oldValue.Secret, nil
 }
 
-// DeploymentProfilesIDs returns the "deployment_profiles" edge IDs in the mutation.
-func (m *ProfileMutation) DeploymentProfilesIDs() (ids []uint64) {
-	for id := range m.deployment_profiles {
-		ids = append(ids, id)
-	}
-	return
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// ResetDeploymentProfiles resets all changes to the "deployment_profiles" edge.
-func (m *ProfileMutation) ResetDeploymentProfiles() {
-	m.deployment_profiles = nil
-	m.cleareddeployment_profiles = false
-	m.removeddeployment_profiles = nil
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// AddParameterTemplateIDs adds the "parameter_templates" edge to the ParameterTemplate entity by ids.
-func (m *ProfileMutation) AddParameterTemplateIDs(ids ...uint64) {
-	if m.parameter_templates == nil {
-		m.parameter_templates = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.parameter_templates[ids[i]] = struct{}{}
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearParameterTemplates clears the "parameter_templates" edge to the ParameterTemplate entity.
-func (m *ProfileMutation) ClearParameterTemplates() {
-	m.clearedparameter_templates = true
+// OldCreateTime returns the old "create_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// ParameterTemplatesCleared reports if the "parameter_templates" edge to the ParameterTemplate entity was cleared.
-func (m *ProfileMutation) ParameterTemplatesCleared() bool {
-	return m.clearedparameter_templates
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// RemoveParameterTemplateIDs removes the "parameter_templates" edge to the ParameterTemplate entity by IDs.
-func (m *ProfileMutation) RemoveParameterTemplateIDs(ids ...uint64) {
-	if m.removedparameter_templates == nil {
-		m.removedparameter_templates = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.parameter_templates, ids[i])
-		m.removedparameter_templates[ids[i]] = struct{}{}
-	}
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// RemovedParameterTemplates returns the removed IDs of the "parameter_templates" edge to the ParameterTemplate entity.
-func (m *ProfileMutation) RemovedParameterTemplatesIDs() (ids []uint64) {
-	for id := range m.removedparameter_templates {
-		ids = append(ids, id)
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ParameterTemplatesIDs returns the "parameter_templates" edge IDs in the mutation.
-func (m *ProfileMutation) ParameterTemplatesIDs() (ids []uint64) {
-	for id := range m.parameter_templates {
-		ids = append(ids, id)
+// OldUpdateTime returns the old "update_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// ResetParameterTemplates resets all changes to the "parameter_templates" edge.
-func (m *ProfileMutation) ResetParameterTemplates() {
-	m.parameter_templates = nil
-	m.clearedparameter_templates = false
-	m.removedparameter_templates = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// AddDeploymentRequirementIDs adds the "deployment_requirements" edge to the DeploymentRequirement entity by ids.
-func (m *ProfileMutation) AddDeploymentRequirementIDs(ids ...uint64) {
-	if m.deployment_requirements == nil {
-		m.deployment_requirements = make(map[uint64]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...uint64) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uint64]struct{})
 	}
 	for i := range ids {
-		m.deployment_requirements[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearDeploymentRequirements clears the "deployment_requirements" edge to the DeploymentRequirement entity.
-func (m *ProfileMutation) ClearDeploymentRequirements() {
-	m.cleareddeployment_requirements = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// DeploymentRequirementsCleared reports if the "deployment_requirements" edge to the DeploymentRequirement entity was cleared.
-func (m *ProfileMutation) DeploymentRequirementsCleared() bool {
-	return m.cleareddeployment_requirements
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveDeploymentRequirementIDs removes the "deployment_requirements" edge to the DeploymentRequirement entity by IDs.
-func (m *ProfileMutation) RemoveDeploymentRequirementIDs(ids ...uint64) {
-	if m.removeddeployment_requirements == nil {
-		m.removeddeployment_requirements = make(map[uint64]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...uint64) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uint64]struct{})
 	}
 	for i := range ids {
-		delete(m.deployment_requirements, ids[i])
-		m.removeddeployment_requirements[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedDeploymentRequirements returns the removed IDs of the "deployment_requirements" edge to the DeploymentRequirement entity.
-func (m *ProfileMutation) RemovedDeploymentRequirementsIDs() (ids []uint64) {
-	for id := range m.removeddeployment_requirements {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []uint64) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// DeploymentRequirementsIDs returns the "deployment_requirements" edge IDs in the mutation.
-func (m *ProfileMutation) DeploymentRequirementsIDs() (ids []uint64) {
-	for id := range m.deployment_requirements {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []uint64) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDeploymentRequirements resets all changes to the "deployment_requirements" edge.
-func (m *ProfileMutation) ResetDeploymentRequirements() {
-	m.deployment_requirements = nil
-	m.cleareddeployment_requirements = false
-	m.removeddeployment_requirements = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the ProfileMutation builder.
-func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Profile, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -12835,45 +19955,51 @@ func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProfileMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProfileMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Profile).
-func (m *ProfileMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProfileMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.project_uuid != nil {
+		fields = append(fields, webhook.FieldProjectUUID)
+	}
 	if m.name != nil {
-		fields = append(fields, profile.FieldName)
+		fields = append(fields, webhook.FieldName)
 	}
-	if m.display_name != nil {
-		fields = append(fields, profile.FieldDisplayName)
+	if m.description != nil {
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.display_name_lc != nil {
-		fields = append(fields, profile.FieldDisplayNameLc)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.description != nil {
-		fields = append(fields, profile.FieldDescription)
+	if m.resource_types != nil {
+		fields = append(fields, webhook.FieldResourceTypes)
+	}
+	if m.event_types != nil {
+		fields = append(fields, webhook.FieldEventTypes)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
 	if m.create_time != nil {
-		fields = append(fields, profile.FieldCreateTime)
+		fields = append(fields, webhook.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, profile.FieldUpdateTime)
-	}
-	if m.chart_values != nil {
-		fields = append(fields, profile.FieldChartValues)
+		fields = append(fields, webhook.FieldUpdateTime)
 	}
 	return fields
 }
@@ -12881,22 +20007,26 @@ func (m *ProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case profile.FieldName:
+	case webhook.FieldProjectUUID:
+		return m.ProjectUUID()
+	case webhook.FieldName:
 		return m.Name()
-	case profile.FieldDisplayName:
-		return m.DisplayName()
-	case profile.FieldDisplayNameLc:
-		return m.DisplayNameLc()
-	case profile.FieldDescription:
+	case webhook.FieldDescription:
 		return m.Description()
-	case profile.FieldCreateTime:
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldResourceTypes:
+		return m.ResourceTypes()
+	case webhook.FieldEventTypes:
+		return m.EventTypes()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldCreateTime:
 		return m.CreateTime()
-	case profile.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		return m.UpdateTime()
-	case profile.FieldChartValues:
-		return m.ChartValues()
 	}
 	return nil, false
 }
@@ -12904,216 +20034,215 @@ func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case profile.FieldName:
+	case webhook.FieldProjectUUID:
+		return m.OldProjectUUID(ctx)
+	case webhook.FieldName:
 		return m.OldName(ctx)
-	case profile.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case profile.FieldDisplayNameLc:
-		return m.OldDisplayNameLc(ctx)
-	case profile.FieldDescription:
+	case webhook.FieldDescription:
 		return m.OldDescription(ctx)
-	case profile.FieldCreateTime:
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldResourceTypes:
+		return m.OldResourceTypes(ctx)
+	case webhook.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case profile.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case profile.FieldChartValues:
-		return m.OldChartValues(ctx)
 	}
-	return nil, fmt.Errorf("unknown Profile field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case profile.FieldName:
+	case webhook.FieldProjectUUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProjectUUID(v)
 		return nil
-	case profile.FieldDisplayName:
+	case webhook.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetName(v)
 		return nil
-	case profile.FieldDisplayNameLc:
+	case webhook.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayNameLc(v)
+		m.SetDescription(v)
 		return nil
-	case profile.FieldDescription:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetURL(v)
 		return nil
-	case profile.FieldCreateTime:
-		v, ok := value.(time.Time)
+	case webhook.FieldResourceTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetResourceTypes(v)
 		return nil
-	case profile.FieldUpdateTime:
-		v, ok := value.(time.Time)
+	case webhook.FieldEventTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetEventTypes(v)
 		return nil
-	case profile.FieldChartValues:
+	case webhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChartValues(v)
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case webhook.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProfileMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Profile numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfileMutation) ClearedFields() []string {
+func (m *WebhookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(profile.FieldDisplayName) {
-		fields = append(fields, profile.FieldDisplayName)
+	if m.FieldCleared(webhook.FieldDescription) {
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.FieldCleared(profile.FieldDisplayNameLc) {
-		fields = append(fields, profile.FieldDisplayNameLc)
+	if m.FieldCleared(webhook.FieldResourceTypes) {
+		fields = append(fields, webhook.FieldResourceTypes)
 	}
-	if m.FieldCleared(profile.FieldDescription) {
-		fields = append(fields, profile.FieldDescription)
+	if m.FieldCleared(webhook.FieldEventTypes) {
+		fields = append(fields, webhook.FieldEventTypes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfileMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfileMutation) ClearField(name string) error {
+func (m *WebhookMutation) ClearField(name string) error {
 	switch name {
-	case profile.FieldDisplayName:
-		m.ClearDisplayName()
+	case webhook.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case profile.FieldDisplayNameLc:
-		m.ClearDisplayNameLc()
+	case webhook.FieldResourceTypes:
+		m.ClearResourceTypes()
 		return nil
-	case profile.FieldDescription:
-		m.ClearDescription()
+	case webhook.FieldEventTypes:
+		m.ClearEventTypes()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile nullable field %s", name)
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfileMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case profile.FieldName:
-		m.ResetName()
-		return nil
-	case profile.FieldDisplayName:
-		m.ResetDisplayName()
+	case webhook.FieldProjectUUID:
+		m.ResetProjectUUID()
 		return nil
-	case profile.FieldDisplayNameLc:
-		m.ResetDisplayNameLc()
+	case webhook.FieldName:
+		m.ResetName()
 		return nil
-	case profile.FieldDescription:
+	case webhook.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case profile.FieldCreateTime:
-		m.ResetCreateTime()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case profile.FieldUpdateTime:
-		m.ResetUpdateTime()
+	case webhook.FieldResourceTypes:
+		m.ResetResourceTypes()
 		return nil
-	case profile.FieldChartValues:
-		m.ResetChartValues()
+	case webhook.FieldEventTypes:
+		m.ResetEventTypes()
 		return nil
-	}
-	return fmt.Errorf("unknown Profile field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.application_fk != nil {
-		edges = append(edges, profile.EdgeApplicationFk)
-	}
-	if m.deployment_profiles != nil {
-		edges = append(edges, profile.EdgeDeploymentProfiles)
-	}
-	if m.parameter_templates != nil {
-		edges = append(edges, profile.EdgeParameterTemplates)
-	}
-	if m.deployment_requirements != nil {
-		edges = append(edges, profile.EdgeDeploymentRequirements)
-	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case profile.EdgeApplicationFk:
-		if id := m.application_fk; id != nil {
-			return []ent.Value{*id}
-		}
-	case profile.EdgeDeploymentProfiles:
-		ids := make([]ent.Value, 0, len(m.deployment_profiles))
-		for id := range m.deployment_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeParameterTemplates:
-		ids := make([]ent.Value, 0, len(m.parameter_templates))
-		for id := range m.parameter_templates {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeDeploymentRequirements:
-		ids := make([]ent.Value, 0, len(m.deployment_requirements))
-		for id := range m.deployment_requirements {
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case webhook.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -13122,39 +20251,21 @@ func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removeddeployment_profiles != nil {
-		edges = append(edges, profile.EdgeDeploymentProfiles)
-	}
-	if m.removedparameter_templates != nil {
-		edges = append(edges, profile.EdgeParameterTemplates)
-	}
-	if m.removeddeployment_requirements != nil {
-		edges = append(edges, profile.EdgeDeploymentRequirements)
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case profile.EdgeDeploymentProfiles:
-		ids := make([]ent.Value, 0, len(m.removeddeployment_profiles))
-		for id := range m.removeddeployment_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeParameterTemplates:
-		ids := make([]ent.Value, 0, len(m.removedparameter_templates))
-		for id := range m.removedparameter_templates {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeDeploymentRequirements:
-		ids := make([]ent.Value, 0, len(m.removeddeployment_requirements))
-		for id := range m.removeddeployment_requirements {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -13163,109 +20274,82 @@ func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedapplication_fk {
-		edges = append(edges, profile.EdgeApplicationFk)
-	}
-	if m.cleareddeployment_profiles {
-		edges = append(edges, profile.EdgeDeploymentProfiles)
-	}
-	if m.clearedparameter_templates {
-		edges = append(edges, profile.EdgeParameterTemplates)
-	}
-	if m.cleareddeployment_requirements {
-		edges = append(edges, profile.EdgeDeploymentRequirements)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfileMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case profile.EdgeApplicationFk:
-		return m.clearedapplication_fk
-	case profile.EdgeDeploymentProfiles:
-		return m.cleareddeployment_profiles
-	case profile.EdgeParameterTemplates:
-		return m.clearedparameter_templates
-	case profile.EdgeDeploymentRequirements:
-		return m.cleareddeployment_requirements
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfileMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case profile.EdgeApplicationFk:
-		m.ClearApplicationFk()
-		return nil
 	}
-	return fmt.Errorf("unknown Profile unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfileMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case profile.EdgeApplicationFk:
-		m.ResetApplicationFk()
-		return nil
-	case profile.EdgeDeploymentProfiles:
-		m.ResetDeploymentProfiles()
-		return nil
-	case profile.EdgeParameterTemplates:
-		m.ResetParameterTemplates()
-		return nil
-	case profile.EdgeDeploymentRequirements:
-		m.ResetDeploymentRequirements()
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile edge %s", name)
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// RegistryMutation represents an operation that mutates the Registry nodes in the graph.
-type RegistryMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *uint64
-	name                      *string
-	display_name              *string
-	display_name_lc           *string
-	description               *string
-	create_time               *time.Time
-	update_time               *time.Time
-	project_uuid              *string
-	auth_token                *string
-	_type                     *string
-	api_type                  *string
-	clearedFields             map[string]struct{}
-	applications              map[uint64]struct{}
-	removedapplications       map[uint64]struct{}
-	clearedapplications       bool
-	application_images        map[uint64]struct{}
-	removedapplication_images map[uint64]struct{}
-	clearedapplication_images bool
-	done                      bool
-	oldValue                  func(context.Context) (*Registry, error)
-	predicates                []predicate.Registry
-}
-
-var _ ent.Mutation = (*RegistryMutation)(nil)
-
-// registryOption allows management of the mutation configuration using functional options.
-type registryOption func(*RegistryMutation)
-
-// newRegistryMutation creates new mutation for the Registry entity.
-func newRegistryMutation(c config, op Op, opts ...registryOption) *RegistryMutation {
-	m := &RegistryMutation{
+	op               Op
+	typ              string
+	id               *uint64
+	revision         *uint64
+	addrevision      *int64
+	resource_type    *string
+	resource_name    *string
+	event_type       *string
+	payload          *[]byte
+	state            *string
+	attempts         *int
+	addattempts      *int
+	response_code    *int
+	addresponse_code *int
+	last_error       *string
+	create_time      *time.Time
+	update_time      *time.Time
+	clearedFields    map[string]struct{}
+	webhook          *uint64
+	clearedwebhook   bool
+	done             bool
+	oldValue         func(context.Context) (*WebhookDelivery, error)
+	predicates       []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRegistry,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -13274,20 +20358,20 @@ func newRegistryMutation(c config, op Op, opts ...registryOption) *RegistryMutat
 	return m
 }
 
-// withRegistryID sets the ID field of the mutation.
-func withRegistryID(id uint64) registryOption {
-	return func(m *RegistryMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uint64) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Registry
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Registry, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Registry.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -13296,10 +20380,10 @@ func withRegistryID(id uint64) registryOption {
 	}
 }
 
-// withRegistry sets the old Registry of the mutation.
-func withRegistry(node *Registry) registryOption {
-	return func(m *RegistryMutation) {
-		m.oldValue = func(context.Context) (*Registry, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -13308,7 +20392,7 @@ func withRegistry(node *Registry) registryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RegistryMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -13316,7 +20400,7 @@ func (m RegistryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RegistryMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -13327,7 +20411,7 @@ func (m RegistryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RegistryMutation) ID() (id uint64, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -13338,7 +20422,7 @@ func (m *RegistryMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RegistryMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -13347,554 +20431,516 @@ func (m *RegistryMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Registry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RegistryMutation) SetName(s string) {
-	m.name = &s
+// SetRevision sets the "revision" field.
+func (m *WebhookDeliveryMutation) SetRevision(u uint64) {
+	m.revision = &u
+	m.addrevision = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RegistryMutation) Name() (r string, exists bool) {
-	v := m.name
+// Revision returns the value of the "revision" field in the mutation.
+func (m *WebhookDeliveryMutation) Revision() (r uint64, exists bool) {
+	v := m.revision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldRevision returns the old "revision" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldRevision(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRevision requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *RegistryMutation) ResetName() {
-	m.name = nil
+	return oldValue.Revision, nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *RegistryMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// AddRevision adds u to the "revision" field.
+func (m *WebhookDeliveryMutation) AddRevision(u int64) {
+	if m.addrevision != nil {
+		*m.addrevision += u
+	} else {
+		m.addrevision = &u
+	}
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *RegistryMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedRevision() (r int64, exists bool) {
+	v := m.addrevision
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldDisplayName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
-	}
-	return oldValue.DisplayName, nil
-}
-
-// ClearDisplayName clears the value of the "display_name" field.
-func (m *RegistryMutation) ClearDisplayName() {
-	m.display_name = nil
-	m.clearedFields[registry.FieldDisplayName] = struct{}{}
-}
-
-// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *RegistryMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[registry.FieldDisplayName]
-	return ok
-}
-
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *RegistryMutation) ResetDisplayName() {
-	m.display_name = nil
-	delete(m.clearedFields, registry.FieldDisplayName)
+// ResetRevision resets all changes to the "revision" field.
+func (m *WebhookDeliveryMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
 }
 
-// SetDisplayNameLc sets the "display_name_lc" field.
-func (m *RegistryMutation) SetDisplayNameLc(s string) {
-	m.display_name_lc = &s
+// SetResourceType sets the "resource_type" field.
+func (m *WebhookDeliveryMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// DisplayNameLc returns the value of the "display_name_lc" field in the mutation.
-func (m *RegistryMutation) DisplayNameLc() (r string, exists bool) {
-	v := m.display_name_lc
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *WebhookDeliveryMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayNameLc returns the old "display_name_lc" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldDisplayNameLc(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldResourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayNameLc is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayNameLc requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayNameLc: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.DisplayNameLc, nil
-}
-
-// ClearDisplayNameLc clears the value of the "display_name_lc" field.
-func (m *RegistryMutation) ClearDisplayNameLc() {
-	m.display_name_lc = nil
-	m.clearedFields[registry.FieldDisplayNameLc] = struct{}{}
-}
-
-// DisplayNameLcCleared returns if the "display_name_lc" field was cleared in this mutation.
-func (m *RegistryMutation) DisplayNameLcCleared() bool {
-	_, ok := m.clearedFields[registry.FieldDisplayNameLc]
-	return ok
+	return oldValue.ResourceType, nil
 }
 
-// ResetDisplayNameLc resets all changes to the "display_name_lc" field.
-func (m *RegistryMutation) ResetDisplayNameLc() {
-	m.display_name_lc = nil
-	delete(m.clearedFields, registry.FieldDisplayNameLc)
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *WebhookDeliveryMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *RegistryMutation) SetDescription(s string) {
-	m.description = &s
+// SetResourceName sets the "resource_name" field.
+func (m *WebhookDeliveryMutation) SetResourceName(s string) {
+	m.resource_name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RegistryMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResourceName returns the value of the "resource_name" field in the mutation.
+func (m *WebhookDeliveryMutation) ResourceName() (r string, exists bool) {
+	v := m.resource_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceName returns the old "resource_name" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldResourceName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldResourceName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceName: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *RegistryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[registry.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RegistryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[registry.FieldDescription]
-	return ok
+	return oldValue.ResourceName, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RegistryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, registry.FieldDescription)
+// ResetResourceName resets all changes to the "resource_name" field.
+func (m *WebhookDeliveryMutation) ResetResourceName() {
+	m.resource_name = nil
 }
-
-// SetCreateTime sets the "create_time" field.
-func (m *RegistryMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *RegistryMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.EventType, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *RegistryMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *RegistryMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(b []byte) {
+	m.payload = &b
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *RegistryMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.Payload, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *RegistryMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetProjectUUID sets the "project_uuid" field.
-func (m *RegistryMutation) SetProjectUUID(s string) {
-	m.project_uuid = &s
+// SetState sets the "state" field.
+func (m *WebhookDeliveryMutation) SetState(s string) {
+	m.state = &s
 }
 
-// ProjectUUID returns the value of the "project_uuid" field in the mutation.
-func (m *RegistryMutation) ProjectUUID() (r string, exists bool) {
-	v := m.project_uuid
+// State returns the value of the "state" field in the mutation.
+func (m *WebhookDeliveryMutation) State() (r string, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProjectUUID returns the old "project_uuid" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldProjectUUID(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldState(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectUUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectUUID requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectUUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.ProjectUUID, nil
+	return oldValue.State, nil
 }
 
-// ResetProjectUUID resets all changes to the "project_uuid" field.
-func (m *RegistryMutation) ResetProjectUUID() {
-	m.project_uuid = nil
+// ResetState resets all changes to the "state" field.
+func (m *WebhookDeliveryMutation) ResetState() {
+	m.state = nil
 }
 
-// SetAuthToken sets the "auth_token" field.
-func (m *RegistryMutation) SetAuthToken(s string) {
-	m.auth_token = &s
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AuthToken returns the value of the "auth_token" field in the mutation.
-func (m *RegistryMutation) AuthToken() (r string, exists bool) {
-	v := m.auth_token
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthToken returns the old "auth_token" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldAuthToken(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthToken requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.AuthToken, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearAuthToken clears the value of the "auth_token" field.
-func (m *RegistryMutation) ClearAuthToken() {
-	m.auth_token = nil
-	m.clearedFields[registry.FieldAuthToken] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// AuthTokenCleared returns if the "auth_token" field was cleared in this mutation.
-func (m *RegistryMutation) AuthTokenCleared() bool {
-	_, ok := m.clearedFields[registry.FieldAuthToken]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAuthToken resets all changes to the "auth_token" field.
-func (m *RegistryMutation) ResetAuthToken() {
-	m.auth_token = nil
-	delete(m.clearedFields, registry.FieldAuthToken)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetType sets the "type" field.
-func (m *RegistryMutation) SetType(s string) {
-	m._type = &s
+// SetResponseCode sets the "response_code" field.
+func (m *WebhookDeliveryMutation) SetResponseCode(i int) {
+	m.response_code = &i
+	m.addresponse_code = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *RegistryMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ResponseCode returns the value of the "response_code" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseCode() (r int, exists bool) {
+	v := m.response_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseCode returns the old "response_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldResponseCode(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldResponseCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseCode: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.ResponseCode, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *RegistryMutation) ResetType() {
-	m._type = nil
+// AddResponseCode adds i to the "response_code" field.
+func (m *WebhookDeliveryMutation) AddResponseCode(i int) {
+	if m.addresponse_code != nil {
+		*m.addresponse_code += i
+	} else {
+		m.addresponse_code = &i
+	}
 }
 
-// SetAPIType sets the "api_type" field.
-func (m *RegistryMutation) SetAPIType(s string) {
-	m.api_type = &s
+// AddedResponseCode returns the value that was added to the "response_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseCode() (r int, exists bool) {
+	v := m.addresponse_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// APIType returns the value of the "api_type" field in the mutation.
-func (m *RegistryMutation) APIType() (r string, exists bool) {
-	v := m.api_type
+// ResetResponseCode resets all changes to the "response_code" field.
+func (m *WebhookDeliveryMutation) ResetResponseCode() {
+	m.response_code = nil
+	m.addresponse_code = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIType returns the old "api_type" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldAPIType(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIType requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.APIType, nil
+	return oldValue.LastError, nil
 }
 
-// ClearAPIType clears the value of the "api_type" field.
-func (m *RegistryMutation) ClearAPIType() {
-	m.api_type = nil
-	m.clearedFields[registry.FieldAPIType] = struct{}{}
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
 }
 
-// APITypeCleared returns if the "api_type" field was cleared in this mutation.
-func (m *RegistryMutation) APITypeCleared() bool {
-	_, ok := m.clearedFields[registry.FieldAPIType]
-	return ok
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// ResetAPIType resets all changes to the "api_type" field.
-func (m *RegistryMutation) ResetAPIType() {
-	m.api_type = nil
-	delete(m.clearedFields, registry.FieldAPIType)
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
-func (m *RegistryMutation) AddApplicationIDs(ids ...uint64) {
-	if m.applications == nil {
-		m.applications = make(map[uint64]struct{})
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.applications[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
+	return oldValue.CreateTime, nil
 }
 
-// ClearApplications clears the "applications" edge to the Application entity.
-func (m *RegistryMutation) ClearApplications() {
-	m.clearedapplications = true
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
-func (m *RegistryMutation) ApplicationsCleared() bool {
-	return m.clearedapplications
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
-func (m *RegistryMutation) RemoveApplicationIDs(ids ...uint64) {
-	if m.removedapplications == nil {
-		m.removedapplications = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.applications, ids[i])
-		m.removedapplications[ids[i]] = struct{}{}
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
-func (m *RegistryMutation) RemovedApplicationsIDs() (ids []uint64) {
-	for id := range m.removedapplications {
-		ids = append(ids, id)
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ApplicationsIDs returns the "applications" edge IDs in the mutation.
-func (m *RegistryMutation) ApplicationsIDs() (ids []uint64) {
-	for id := range m.applications {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetApplications resets all changes to the "applications" edge.
-func (m *RegistryMutation) ResetApplications() {
-	m.applications = nil
-	m.clearedapplications = false
-	m.removedapplications = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// AddApplicationImageIDs adds the "application_images" edge to the Application entity by ids.
-func (m *RegistryMutation) AddApplicationImageIDs(ids ...uint64) {
-	if m.application_images == nil {
-		m.application_images = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.application_images[ids[i]] = struct{}{}
-	}
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// ClearApplicationImages clears the "application_images" edge to the Application entity.
-func (m *RegistryMutation) ClearApplicationImages() {
-	m.clearedapplication_images = true
+// SetWebhookID sets the "webhook" edge to the Webhook entity by id.
+func (m *WebhookDeliveryMutation) SetWebhookID(id uint64) {
+	m.webhook = &id
 }
 
-// ApplicationImagesCleared reports if the "application_images" edge to the Application entity was cleared.
-func (m *RegistryMutation) ApplicationImagesCleared() bool {
-	return m.clearedapplication_images
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
 }
 
-// RemoveApplicationImageIDs removes the "application_images" edge to the Application entity by IDs.
-func (m *RegistryMutation) RemoveApplicationImageIDs(ids ...uint64) {
-	if m.removedapplication_images == nil {
-		m.removedapplication_images = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.application_images, ids[i])
-		m.removedapplication_images[ids[i]] = struct{}{}
-	}
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// RemovedApplicationImages returns the removed IDs of the "application_images" edge to the Application entity.
-func (m *RegistryMutation) RemovedApplicationImagesIDs() (ids []uint64) {
-	for id := range m.removedapplication_images {
-		ids = append(ids, id)
+// WebhookID returns the "webhook" edge ID in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (id uint64, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
 	}
 	return
 }
 
-// ApplicationImagesIDs returns the "application_images" edge IDs in the mutation.
-func (m *RegistryMutation) ApplicationImagesIDs() (ids []uint64) {
-	for id := range m.application_images {
-		ids = append(ids, id)
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uint64) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetApplicationImages resets all changes to the "application_images" edge.
-func (m *RegistryMutation) ResetApplicationImages() {
-	m.application_images = nil
-	m.clearedapplication_images = false
-	m.removedapplication_images = nil
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the RegistryMutation builder.
-func (m *RegistryMutation) Where(ps ...predicate.Registry) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RegistryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Registry, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13902,54 +20948,57 @@ func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RegistryMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RegistryMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Registry).
-func (m *RegistryMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RegistryMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.name != nil {
-		fields = append(fields, registry.FieldName)
+// AddedFields().
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.revision != nil {
+		fields = append(fields, webhookdelivery.FieldRevision)
 	}
-	if m.display_name != nil {
-		fields = append(fields, registry.FieldDisplayName)
+	if m.resource_type != nil {
+		fields = append(fields, webhookdelivery.FieldResourceType)
 	}
-	if m.display_name_lc != nil {
-		fields = append(fields, registry.FieldDisplayNameLc)
+	if m.resource_name != nil {
+		fields = append(fields, webhookdelivery.FieldResourceName)
 	}
-	if m.description != nil {
-		fields = append(fields, registry.FieldDescription)
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m.create_time != nil {
-		fields = append(fields, registry.FieldCreateTime)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.update_time != nil {
-		fields = append(fields, registry.FieldUpdateTime)
+	if m.state != nil {
+		fields = append(fields, webhookdelivery.FieldState)
 	}
-	if m.project_uuid != nil {
-		fields = append(fields, registry.FieldProjectUUID)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.auth_token != nil {
-		fields = append(fields, registry.FieldAuthToken)
+	if m.response_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
 	}
-	if m._type != nil {
-		fields = append(fields, registry.FieldType)
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
-	if m.api_type != nil {
-		fields = append(fields, registry.FieldAPIType)
+	if m.create_time != nil {
+		fields = append(fields, webhookdelivery.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
 	return fields
 }
@@ -13957,28 +21006,30 @@ func (m *RegistryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case registry.FieldName:
-		return m.Name()
-	case registry.FieldDisplayName:
-		return m.DisplayName()
-	case registry.FieldDisplayNameLc:
-		return m.DisplayNameLc()
-	case registry.FieldDescription:
-		return m.Description()
-	case registry.FieldCreateTime:
+	case webhookdelivery.FieldRevision:
+		return m.Revision()
+	case webhookdelivery.FieldResourceType:
+		return m.ResourceType()
+	case webhookdelivery.FieldResourceName:
+		return m.ResourceName()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldState:
+		return m.State()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseCode:
+		return m.ResponseCode()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case registry.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case registry.FieldProjectUUID:
-		return m.ProjectUUID()
-	case registry.FieldAuthToken:
-		return m.AuthToken()
-	case registry.FieldType:
-		return m.GetType()
-	case registry.FieldAPIType:
-		return m.APIType()
 	}
 	return nil, false
 }
@@ -13986,329 +21037,311 @@ func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RegistryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case registry.FieldName:
-		return m.OldName(ctx)
-	case registry.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case registry.FieldDisplayNameLc:
-		return m.OldDisplayNameLc(ctx)
-	case registry.FieldDescription:
-		return m.OldDescription(ctx)
-	case registry.FieldCreateTime:
+	case webhookdelivery.FieldRevision:
+		return m.OldRevision(ctx)
+	case webhookdelivery.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case webhookdelivery.FieldResourceName:
+		return m.OldResourceName(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldState:
+		return m.OldState(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseCode:
+		return m.OldResponseCode(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case registry.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case registry.FieldProjectUUID:
-		return m.OldProjectUUID(ctx)
-	case registry.FieldAuthToken:
-		return m.OldAuthToken(ctx)
-	case registry.FieldType:
-		return m.OldType(ctx)
-	case registry.FieldAPIType:
-		return m.OldAPIType(ctx)
 	}
-	return nil, fmt.Errorf("unknown Registry field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RegistryMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case registry.FieldName:
-		v, ok := value.(string)
+	case webhookdelivery.FieldRevision:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetRevision(v)
 		return nil
-	case registry.FieldDisplayName:
+	case webhookdelivery.FieldResourceType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetResourceType(v)
 		return nil
-	case registry.FieldDisplayNameLc:
+	case webhookdelivery.FieldResourceName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayNameLc(v)
+		m.SetResourceName(v)
 		return nil
-	case registry.FieldDescription:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetEventType(v)
 		return nil
-	case registry.FieldCreateTime:
-		v, ok := value.(time.Time)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetPayload(v)
 		return nil
-	case registry.FieldUpdateTime:
-		v, ok := value.(time.Time)
+	case webhookdelivery.FieldState:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetState(v)
 		return nil
-	case registry.FieldProjectUUID:
-		v, ok := value.(string)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProjectUUID(v)
+		m.SetAttempts(v)
 		return nil
-	case registry.FieldAuthToken:
-		v, ok := value.(string)
+	case webhookdelivery.FieldResponseCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthToken(v)
+		m.SetResponseCode(v)
 		return nil
-	case registry.FieldType:
+	case webhookdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetLastError(v)
 		return nil
-	case registry.FieldAPIType:
-		v, ok := value.(string)
+	case webhookdelivery.FieldCreateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIType(v)
+		m.SetCreateTime(v)
+		return nil
+	case webhookdelivery.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Registry field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RegistryMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, webhookdelivery.FieldRevision)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RegistryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldRevision:
+		return m.AddedRevision()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseCode:
+		return m.AddedResponseCode()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RegistryMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldRevision:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Registry numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RegistryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(registry.FieldDisplayName) {
-		fields = append(fields, registry.FieldDisplayName)
-	}
-	if m.FieldCleared(registry.FieldDisplayNameLc) {
-		fields = append(fields, registry.FieldDisplayNameLc)
-	}
-	if m.FieldCleared(registry.FieldDescription) {
-		fields = append(fields, registry.FieldDescription)
-	}
-	if m.FieldCleared(registry.FieldAuthToken) {
-		fields = append(fields, registry.FieldAuthToken)
-	}
-	if m.FieldCleared(registry.FieldAPIType) {
-		fields = append(fields, registry.FieldAPIType)
-	}
-	return fields
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RegistryMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RegistryMutation) ClearField(name string) error {
-	switch name {
-	case registry.FieldDisplayName:
-		m.ClearDisplayName()
-		return nil
-	case registry.FieldDisplayNameLc:
-		m.ClearDisplayNameLc()
-		return nil
-	case registry.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case registry.FieldAuthToken:
-		m.ClearAuthToken()
-		return nil
-	case registry.FieldAPIType:
-		m.ClearAPIType()
-		return nil
-	}
-	return fmt.Errorf("unknown Registry nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RegistryMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case registry.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldRevision:
+		m.ResetRevision()
 		return nil
-	case registry.FieldDisplayName:
-		m.ResetDisplayName()
+	case webhookdelivery.FieldResourceType:
+		m.ResetResourceType()
 		return nil
-	case registry.FieldDisplayNameLc:
-		m.ResetDisplayNameLc()
+	case webhookdelivery.FieldResourceName:
+		m.ResetResourceName()
 		return nil
-	case registry.FieldDescription:
-		m.ResetDescription()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case registry.FieldCreateTime:
-		m.ResetCreateTime()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case registry.FieldUpdateTime:
-		m.ResetUpdateTime()
+	case webhookdelivery.FieldState:
+		m.ResetState()
 		return nil
-	case registry.FieldProjectUUID:
-		m.ResetProjectUUID()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case registry.FieldAuthToken:
-		m.ResetAuthToken()
+	case webhookdelivery.FieldResponseCode:
+		m.ResetResponseCode()
 		return nil
-	case registry.FieldType:
-		m.ResetType()
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case registry.FieldAPIType:
-		m.ResetAPIType()
+	case webhookdelivery.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case webhookdelivery.FieldUpdateTime:
+		m.ResetUpdateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Registry field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RegistryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.applications != nil {
-		edges = append(edges, registry.EdgeApplications)
-	}
-	if m.application_images != nil {
-		edges = append(edges, registry.EdgeApplicationImages)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RegistryMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case registry.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.applications))
-		for id := range m.applications {
-			ids = append(ids, id)
-		}
-		return ids
-	case registry.EdgeApplicationImages:
-		ids := make([]ent.Value, 0, len(m.application_images))
-		for id := range m.application_images {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RegistryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedapplications != nil {
-		edges = append(edges, registry.EdgeApplications)
-	}
-	if m.removedapplication_images != nil {
-		edges = append(edges, registry.EdgeApplicationImages)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RegistryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case registry.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.removedapplications))
-		for id := range m.removedapplications {
-			ids = append(ids, id)
-		}
-		return ids
-	case registry.EdgeApplicationImages:
-		ids := make([]ent.Value, 0, len(m.removedapplication_images))
-		for id := range m.removedapplication_images {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RegistryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedapplications {
-		edges = append(edges, registry.EdgeApplications)
-	}
-	if m.clearedapplication_images {
-		edges = append(edges, registry.EdgeApplicationImages)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RegistryMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case registry.EdgeApplications:
-		return m.clearedapplications
-	case registry.EdgeApplicationImages:
-		return m.clearedapplication_images
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RegistryMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
 	}
-	return fmt.Errorf("unknown Registry unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RegistryMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case registry.EdgeApplications:
-		m.ResetApplications()
-		return nil
-	case registry.EdgeApplicationImages:
-		m.ResetApplicationImages()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown Registry edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+)
+
+// OutboxEvent is the model entity for the OutboxEvent schema.
+type OutboxEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UUID of the project to which the subject of the event belongs.
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// Type of the subject of the event, e.g. registry or deployment-package.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Type of the event, i.e. created, updated or deleted.
+	EventType string `json:"event_type,omitempty"`
+	// Watch response carrying the event, serialized as a protobuf message.
+	Payload []byte `json:"payload,omitempty"`
+	// ID of the catalog replica that recorded the event.
+	Origin string `json:"origin,omitempty"`
+	// ID of the transaction that recorded the event, assigned by the database.
+	TxID uint64 `json:"tx_id,omitempty"`
+	// ID of the oldest transaction in progress when the event was recorded, assigned by the database.
+	TxXmin uint64 `json:"tx_xmin,omitempty"`
+	// The creation timestamp.
+	CreateTime   time.Time `json:"create_time,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldPayload:
+			values[i] = new([]byte)
+		case outboxevent.FieldID, outboxevent.FieldTxID, outboxevent.FieldTxXmin:
+			values[i] = new(sql.NullInt64)
+		case outboxevent.FieldProjectUUID, outboxevent.FieldResourceType, outboxevent.FieldEventType, outboxevent.FieldOrigin:
+			values[i] = new(sql.NullString)
+		case outboxevent.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxEvent fields.
+func (oe *OutboxEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oe.ID = uint64(value.Int64)
+		case outboxevent.FieldProjectUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
+			} else if value.Valid {
+				oe.ProjectUUID = value.String
+			}
+		case outboxevent.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				oe.ResourceType = value.String
+			}
+		case outboxevent.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				oe.EventType = value.String
+			}
+		case outboxevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				oe.Payload = *value
+			}
+		case outboxevent.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				oe.Origin = value.String
+			}
+		case outboxevent.FieldTxID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_id", values[i])
+			} else if value.Valid {
+				oe.TxID = uint64(value.Int64)
+			}
+		case outboxevent.FieldTxXmin:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_xmin", values[i])
+			} else if value.Valid {
+				oe.TxXmin = uint64(value.Int64)
+			}
+		case outboxevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				oe.CreateTime = value.Time
+			}
+		default:
+			oe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxEvent.
+// This includes values selected through modifiers, order, etc.
+func (oe *OutboxEvent) Value(name string) (ent.Value, error) {
+	return oe.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxEvent.
+// Note that you need to call OutboxEvent.Unwrap() before calling this method if this OutboxEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oe *OutboxEvent) Update() *OutboxEventUpdateOne {
+	return NewOutboxEventClient(oe.config).UpdateOne(oe)
+}
+
+// Unwrap unwraps the OutboxEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oe *OutboxEvent) Unwrap() *OutboxEvent {
+	_tx, ok := oe.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: OutboxEvent is not a transactional entity")
+	}
+	oe.config.driver = _tx.drv
+	return oe
+}
+
+// String implements the fmt.Stringer.
+func (oe *OutboxEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oe.ID))
+	builder.WriteString("project_uuid=")
+	builder.WriteString(oe.ProjectUUID)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(oe.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(oe.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(oe.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("tx_id=")
+	builder.WriteString(fmt.Sprintf("%v", oe.TxID))
+	builder.WriteString(", ")
+	builder.WriteString("tx_xmin=")
+	builder.WriteString(fmt.Sprintf("%v", oe.TxXmin))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(oe.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxEvents is a parsable slice of OutboxEvent.
+type OutboxEvents []*OutboxEvent
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+)
+
+// OutboxEventCreate is the builder for creating a OutboxEvent entity.
+type OutboxEventCreate struct {
+	config
+	mutation *OutboxEventMutation
+	hooks    []Hook
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (oec *OutboxEventCreate) SetProjectUUID(s string) *OutboxEventCreate {
+	oec.mutation.SetProjectUUID(s)
+	return oec
+}
+
+// SetResourceType sets the "resource_type" field.
+func (oec *OutboxEventCreate) SetResourceType(s string) *OutboxEventCreate {
+	oec.mutation.SetResourceType(s)
+	return oec
+}
+
+// SetEventType sets the "event_type" field.
+func (oec *OutboxEventCreate) SetEventType(s string) *OutboxEventCreate {
+	oec.mutation.SetEventType(s)
+	return oec
+}
+
+// SetPayload sets the "payload" field.
+func (oec *OutboxEventCreate) SetPayload(b []byte) *OutboxEventCreate {
+	oec.mutation.SetPayload(b)
+	return oec
+}
+
+// SetOrigin sets the "origin" field.
+func (oec *OutboxEventCreate) SetOrigin(s string) *OutboxEventCreate {
+	oec.mutation.SetOrigin(s)
+	return oec
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableOrigin(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetOrigin(*s)
+	}
+	return oec
+}
+
+// SetTxID sets the "tx_id" field.
+func (oec *OutboxEventCreate) SetTxID(u uint64) *OutboxEventCreate {
+	oec.mutation.SetTxID(u)
+	return oec
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableTxID(u *uint64) *OutboxEventCreate {
+	if u != nil {
+		oec.SetTxID(*u)
+	}
+	return oec
+}
+
+// SetTxXmin sets the "tx_xmin" field.
+func (oec *OutboxEventCreate) SetTxXmin(u uint64) *OutboxEventCreate {
+	oec.mutation.SetTxXmin(u)
+	return oec
+}
+
+// SetNillableTxXmin sets the "tx_xmin" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableTxXmin(u *uint64) *OutboxEventCreate {
+	if u != nil {
+		oec.SetTxXmin(*u)
+	}
+	return oec
+}
+
+// SetCreateTime sets the "create_time" field.
+func (oec *OutboxEventCreate) SetCreateTime(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetCreateTime(t)
+	return oec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableCreateTime(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetCreateTime(*t)
+	}
+	return oec
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oec *OutboxEventCreate) Mutation() *OutboxEventMutation {
+	return oec.mutation
+}
+
+// Save creates the OutboxEvent in the database.
+func (oec *OutboxEventCreate) Save(ctx context.Context) (*OutboxEvent, error) {
+	oec.defaults()
+	return withHooks(ctx, oec.sqlSave, oec.mutation, oec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oec *OutboxEventCreate) SaveX(ctx context.Context) *OutboxEvent {
+	v, err := oec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oec *OutboxEventCreate) Exec(ctx context.Context) error {
+	_, err := oec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oec *OutboxEventCreate) ExecX(ctx context.Context) {
+	if err := oec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oec *OutboxEventCreate) defaults() {
+	if _, ok := oec.mutation.Origin(); !ok {
+		v := outboxevent.DefaultOrigin
+		oec.mutation.SetOrigin(v)
+	}
+	if _, ok := oec.mutation.CreateTime(); !ok {
+		v := outboxevent.DefaultCreateTime()
+		oec.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oec *OutboxEventCreate) check() error {
+	if _, ok := oec.mutation.ProjectUUID(); !ok {
+		return &ValidationError{Name: "project_uuid", err: errors.New(`generated: missing required field "OutboxEvent.project_uuid"`)}
+	}
+	if _, ok := oec.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`generated: missing required field "OutboxEvent.resource_type"`)}
+	}
+	if _, ok := oec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`generated: missing required field "OutboxEvent.event_type"`)}
+	}
+	if _, ok := oec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`generated: missing required field "OutboxEvent.payload"`)}
+	}
+	if _, ok := oec.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`generated: missing required field "OutboxEvent.origin"`)}
+	}
+	if _, ok := oec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`generated: missing required field "OutboxEvent.create_time"`)}
+	}
+	return nil
+}
+
+func (oec *OutboxEventCreate) sqlSave(ctx context.Context) (*OutboxEvent, error) {
+	if err := oec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = uint64(id)
+	oec.mutation.id = &_node.ID
+	oec.mutation.done = true
+	return _node, nil
+}
+
+func (oec *OutboxEventCreate) createSpec() (*OutboxEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxEvent{config: oec.config}
+		_spec = sqlgraph.NewCreateSpec(outboxevent.Table, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeUint64))
+	)
+	if value, ok := oec.mutation.ProjectUUID(); ok {
+		_spec.SetField(outboxevent.FieldProjectUUID, field.TypeString, value)
+		_node.ProjectUUID = value
+	}
+	if value, ok := oec.mutation.ResourceType(); ok {
+		_spec.SetField(outboxevent.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := oec.mutation.EventType(); ok {
+		_spec.SetField(outboxevent.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := oec.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := oec.mutation.Origin(); ok {
+		_spec.SetField(outboxevent.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := oec.mutation.TxID(); ok {
+		_spec.SetField(outboxevent.FieldTxID, field.TypeUint64, value)
+		_node.TxID = value
+	}
+	if value, ok := oec.mutation.TxXmin(); ok {
+		_spec.SetField(outboxevent.FieldTxXmin, field.TypeUint64, value)
+		_node.TxXmin = value
+	}
+	if value, ok := oec.mutation.CreateTime(); ok {
+		_spec.SetField(outboxevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// OutboxEventCreateBulk is the builder for creating many OutboxEvent entities in bulk.
+type OutboxEventCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxEventCreate
+}
+
+// Save creates the OutboxEvent entities in the database.
+func (oecb *OutboxEventCreateBulk) Save(ctx context.Context) ([]*OutboxEvent, error) {
+	if oecb.err != nil {
+		return nil, oecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oecb.builders))
+	nodes := make([]*OutboxEvent, len(oecb.builders))
+	mutators := make([]Mutator, len(oecb.builders))
+	for i := range oecb.builders {
+		func(i int, root context.Context) {
+			builder := oecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) SaveX(ctx context.Context) []*OutboxEvent {
+	v, err := oecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oecb *OutboxEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := oecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) ExecX(ctx context.Context) {
+	if err := oecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (oeu *OutboxEventUpdate) SetProjectUUID(s string) *OutboxEventUpdate {
+	oeu.mutation.SetProjectUUID(s)
+	return oeu
+}
+
+// SetNillableProjectUUID sets the "project_uuid" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableProjectUUID(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetProjectUUID(*s)
+	}
+	return oeu
+}
+
+// SetResourceType sets the "resource_type" field.
+func (oeu *OutboxEventUpdate) SetResourceType(s string) *OutboxEventUpdate {
+	oeu.mutation.SetResourceType(s)
+	return oeu
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableResourceType(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetResourceType(*s)
+	}
+	return oeu
+}
+
+// SetEventType sets the "event_type" field.
+func (oeu *OutboxEventUpdate) SetEventType(s string) *OutboxEventUpdate {
+	oeu.mutation.SetEventType(s)
+	return oeu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableEventType(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetEventType(*s)
+	}
+	return oeu
+}
+
+// SetPayload sets the "payload" field.
+func (oeu *OutboxEventUpdate) SetPayload(b []byte) *OutboxEventUpdate {
+	oeu.mutation.SetPayload(b)
+	return oeu
+}
+
+// SetOrigin sets the "origin" field.
+func (oeu *OutboxEventUpdate) SetOrigin(s string) *OutboxEventUpdate {
+	oeu.mutation.SetOrigin(s)
+	return oeu
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableOrigin(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetOrigin(*s)
+	}
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeUint64))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.ProjectUUID(); ok {
+		_spec.SetField(outboxevent.FieldProjectUUID, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.ResourceType(); ok {
+		_spec.SetField(outboxevent.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.EventType(); ok {
+		_spec.SetField(outboxevent.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeu.mutation.Origin(); ok {
+		_spec.SetField(outboxevent.FieldOrigin, field.TypeString, value)
+	}
+	if oeu.mutation.TxIDCleared() {
+		_spec.ClearField(outboxevent.FieldTxID, field.TypeUint64)
+	}
+	if oeu.mutation.TxXminCleared() {
+		_spec.ClearField(outboxevent.FieldTxXmin, field.TypeUint64)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (oeuo *OutboxEventUpdateOne) SetProjectUUID(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetProjectUUID(s)
+	return oeuo
+}
+
+// SetNillableProjectUUID sets the "project_uuid" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableProjectUUID(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetProjectUUID(*s)
+	}
+	return oeuo
+}
+
+// SetResourceType sets the "resource_type" field.
+func (oeuo *OutboxEventUpdateOne) SetResourceType(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetResourceType(s)
+	return oeuo
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableResourceType(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetResourceType(*s)
+	}
+	return oeuo
+}
+
+// SetEventType sets the "event_type" field.
+func (oeuo *OutboxEventUpdateOne) SetEventType(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetEventType(s)
+	return oeuo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableEventType(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetEventType(*s)
+	}
+	return oeuo
+}
+
+// SetPayload sets the "payload" field.
+func (oeuo *OutboxEventUpdateOne) SetPayload(b []byte) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPayload(b)
+	return oeuo
+}
+
+// SetOrigin sets the "origin" field.
+func (oeuo *OutboxEventUpdateOne) SetOrigin(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetOrigin(s)
+	return oeuo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableOrigin(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetOrigin(*s)
+	}
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeUint64))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.ProjectUUID(); ok {
+		_spec.SetField(outboxevent.FieldProjectUUID, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.ResourceType(); ok {
+		_spec.SetField(outboxevent.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.EventType(); ok {
+		_spec.SetField(outboxevent.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeuo.mutation.Origin(); ok {
+		_spec.SetField(outboxevent.FieldOrigin, field.TypeString, value)
+	}
+	if oeuo.mutation.TxIDCleared() {
+		_spec.ClearField(outboxevent.FieldTxID, field.TypeUint64)
+	}
+	if oeuo.mutation.TxXminCleared() {
+		_spec.ClearField(outboxevent.FieldTxXmin, field.TypeUint64)
+	}
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
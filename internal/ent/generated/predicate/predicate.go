@@ -21,6 +21,9 @@ type Artifact func(*sql.Selector)
 // ArtifactReference is the predicate function for artifactreference builders.
 type ArtifactReference func(*sql.Selector)
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
 // CommonMixin is the predicate function for commonmixin builders.
 type CommonMixin func(*sql.Selector)
 
@@ -48,6 +51,9 @@ type Namespace func(*sql.Selector)
 // NamespaceAdornment is the predicate function for namespaceadornment builders.
 type NamespaceAdornment func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // ParameterTemplate is the predicate function for parametertemplate builders.
 type ParameterTemplate func(*sql.Selector)
 
@@ -56,3 +62,15 @@ type Profile func(*sql.Selector)
 
 // Registry is the predicate function for registry builders.
 type Registry func(*sql.Selector)
+
+// Revision is the predicate function for revision builders.
+type Revision func(*sql.Selector)
+
+// TrashedEntity is the predicate function for trashedentity builders.
+type TrashedEntity func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
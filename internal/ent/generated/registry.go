@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -29,6 +30,8 @@ type Registry struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// The last update timestamp.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Opaque entity tag; changes whenever the entity is updated.
+	Etag int64 `json:"etag,omitempty"`
 	// UUID of the owner project.
 	ProjectUUID string `json:"project_uuid,omitempty"`
 	// A login token for registry access.
@@ -37,6 +40,8 @@ type Registry struct {
 	Type string `json:"type,omitempty"`
 	// Registry API type.
 	APIType string `json:"api_type,omitempty"`
+	// Labels of the Registry, by which it is selected.
+	Labels map[string]string `json:"labels,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RegistryQuery when eager-loading is set.
 	Edges        RegistryEdges `json:"edges"`
@@ -77,7 +82,9 @@ func (*Registry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case registry.FieldID:
+		case registry.FieldLabels:
+			values[i] = new([]byte)
+		case registry.FieldID, registry.FieldEtag:
 			values[i] = new(sql.NullInt64)
 		case registry.FieldName, registry.FieldDisplayName, registry.FieldDisplayNameLc, registry.FieldDescription, registry.FieldProjectUUID, registry.FieldAuthToken, registry.FieldType, registry.FieldAPIType:
 			values[i] = new(sql.NullString)
@@ -140,6 +147,12 @@ func (r *Registry) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.UpdateTime = value.Time
 			}
+		case registry.FieldEtag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				r.Etag = value.Int64
+			}
 		case registry.FieldProjectUUID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
@@ -164,6 +177,14 @@ func (r *Registry) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.APIType = value.String
 			}
+		case registry.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
 		default:
 			r.selectValues.Set(columns[i], values[i])
 		}
@@ -228,6 +249,9 @@ func (r *Registry) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(r.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(fmt.Sprintf("%v", r.Etag))
+	builder.WriteString(", ")
 	builder.WriteString("project_uuid=")
 	builder.WriteString(r.ProjectUUID)
 	builder.WriteString(", ")
@@ -239,6 +263,9 @@ func (r *Registry) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("api_type=")
 	builder.WriteString(r.APIType)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", r.Labels))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,6 +26,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
 	// FieldProjectUUID holds the string denoting the project_uuid field in the database.
 	FieldProjectUUID = "project_uuid"
 	// FieldAuthToken holds the string denoting the auth_token field in the database.
@@ -34,6 +36,8 @@ const (
 	FieldType = "type"
 	// FieldAPIType holds the string denoting the api_type field in the database.
 	FieldAPIType = "api_type"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
 	// EdgeApplications holds the string denoting the applications edge name in mutations.
 	EdgeApplications = "applications"
 	// EdgeApplicationImages holds the string denoting the application_images edge name in mutations.
@@ -65,10 +69,12 @@ var Columns = []string{
 	FieldDescription,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldEtag,
 	FieldProjectUUID,
 	FieldAuthToken,
 	FieldType,
 	FieldAPIType,
+	FieldLabels,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -88,6 +94,10 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultEtag holds the default value on creation for the "etag" field.
+	DefaultEtag func() int64
+	// UpdateDefaultEtag holds the default value on update for the "etag" field.
+	UpdateDefaultEtag func() int64
 	// DefaultProjectUUID holds the default value on creation for the "project_uuid" field.
 	DefaultProjectUUID string
 )
@@ -130,6 +140,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByEtag orders the results by the etag field.
+func ByEtag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEtag, opts...).ToFunc()
+}
+
 // ByProjectUUID orders the results by the project_uuid field.
 func ByProjectUUID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectUUID, opts...).ToFunc()
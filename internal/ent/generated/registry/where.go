@@ -85,6 +85,11 @@ func UpdateTime(v time.Time) predicate.Registry {
 	return predicate.Registry(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldEQ(FieldEtag, v))
+}
+
 // ProjectUUID applies equality check predicate on the "project_uuid" field. It's identical to ProjectUUIDEQ.
 func ProjectUUID(v string) predicate.Registry {
 	return predicate.Registry(sql.FieldEQ(FieldProjectUUID, v))
@@ -475,6 +480,56 @@ func UpdateTimeLTE(v time.Time) predicate.Registry {
 	return predicate.Registry(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...int64) predicate.Registry {
+	return predicate.Registry(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...int64) predicate.Registry {
+	return predicate.Registry(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v int64) predicate.Registry {
+	return predicate.Registry(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.Registry {
+	return predicate.Registry(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.Registry {
+	return predicate.Registry(sql.FieldNotNull(FieldEtag))
+}
+
 // ProjectUUIDEQ applies the EQ predicate on the "project_uuid" field.
 func ProjectUUIDEQ(v string) predicate.Registry {
 	return predicate.Registry(sql.FieldEQ(FieldProjectUUID, v))
@@ -755,6 +810,16 @@ func APITypeContainsFold(v string) predicate.Registry {
 	return predicate.Registry(sql.FieldContainsFold(FieldAPIType, v))
 }
 
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Registry {
+	return predicate.Registry(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Registry {
+	return predicate.Registry(sql.FieldNotNull(FieldLabels))
+}
+
 // HasApplications applies the HasEdge predicate on the "applications" edge.
 func HasApplications() predicate.Registry {
 	return predicate.Registry(func(s *sql.Selector) {
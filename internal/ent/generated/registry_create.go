@@ -97,6 +97,20 @@ func (rc *RegistryCreate) SetNillableUpdateTime(t *time.Time) *RegistryCreate {
 	return rc
 }
 
+// SetEtag sets the "etag" field.
+func (rc *RegistryCreate) SetEtag(i int64) *RegistryCreate {
+	rc.mutation.SetEtag(i)
+	return rc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (rc *RegistryCreate) SetNillableEtag(i *int64) *RegistryCreate {
+	if i != nil {
+		rc.SetEtag(*i)
+	}
+	return rc
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (rc *RegistryCreate) SetProjectUUID(s string) *RegistryCreate {
 	rc.mutation.SetProjectUUID(s)
@@ -145,6 +159,12 @@ func (rc *RegistryCreate) SetNillableAPIType(s *string) *RegistryCreate {
 	return rc
 }
 
+// SetLabels sets the "labels" field.
+func (rc *RegistryCreate) SetLabels(m map[string]string) *RegistryCreate {
+	rc.mutation.SetLabels(m)
+	return rc
+}
+
 // AddApplicationIDs adds the "applications" edge to the Application entity by IDs.
 func (rc *RegistryCreate) AddApplicationIDs(ids ...uint64) *RegistryCreate {
 	rc.mutation.AddApplicationIDs(ids...)
@@ -218,6 +238,10 @@ func (rc *RegistryCreate) defaults() {
 		v := registry.DefaultUpdateTime()
 		rc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := rc.mutation.Etag(); !ok {
+		v := registry.DefaultEtag()
+		rc.mutation.SetEtag(v)
+	}
 	if _, ok := rc.mutation.ProjectUUID(); !ok {
 		v := registry.DefaultProjectUUID
 		rc.mutation.SetProjectUUID(v)
@@ -291,6 +315,10 @@ func (rc *RegistryCreate) createSpec() (*Registry, *sqlgraph.CreateSpec) {
 		_spec.SetField(registry.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := rc.mutation.Etag(); ok {
+		_spec.SetField(registry.FieldEtag, field.TypeInt64, value)
+		_node.Etag = value
+	}
 	if value, ok := rc.mutation.ProjectUUID(); ok {
 		_spec.SetField(registry.FieldProjectUUID, field.TypeString, value)
 		_node.ProjectUUID = value
@@ -307,6 +335,10 @@ func (rc *RegistryCreate) createSpec() (*Registry, *sqlgraph.CreateSpec) {
 		_spec.SetField(registry.FieldAPIType, field.TypeString, value)
 		_node.APIType = value
 	}
+	if value, ok := rc.mutation.Labels(); ok {
+		_spec.SetField(registry.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
 	if nodes := rc.mutation.ApplicationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -109,6 +109,25 @@ func (ru *RegistryUpdate) SetUpdateTime(t time.Time) *RegistryUpdate {
 	return ru
 }
 
+// SetEtag sets the "etag" field.
+func (ru *RegistryUpdate) SetEtag(i int64) *RegistryUpdate {
+	ru.mutation.ResetEtag()
+	ru.mutation.SetEtag(i)
+	return ru
+}
+
+// AddEtag adds i to the "etag" field.
+func (ru *RegistryUpdate) AddEtag(i int64) *RegistryUpdate {
+	ru.mutation.AddEtag(i)
+	return ru
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (ru *RegistryUpdate) ClearEtag() *RegistryUpdate {
+	ru.mutation.ClearEtag()
+	return ru
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (ru *RegistryUpdate) SetProjectUUID(s string) *RegistryUpdate {
 	ru.mutation.SetProjectUUID(s)
@@ -177,6 +196,18 @@ func (ru *RegistryUpdate) ClearAPIType() *RegistryUpdate {
 	return ru
 }
 
+// SetLabels sets the "labels" field.
+func (ru *RegistryUpdate) SetLabels(m map[string]string) *RegistryUpdate {
+	ru.mutation.SetLabels(m)
+	return ru
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (ru *RegistryUpdate) ClearLabels() *RegistryUpdate {
+	ru.mutation.ClearLabels()
+	return ru
+}
+
 // AddApplicationIDs adds the "applications" edge to the Application entity by IDs.
 func (ru *RegistryUpdate) AddApplicationIDs(ids ...uint64) *RegistryUpdate {
 	ru.mutation.AddApplicationIDs(ids...)
@@ -288,6 +319,10 @@ func (ru *RegistryUpdate) defaults() {
 		v := registry.UpdateDefaultUpdateTime()
 		ru.mutation.SetUpdateTime(v)
 	}
+	if _, ok := ru.mutation.Etag(); !ok && !ru.mutation.EtagCleared() {
+		v := registry.UpdateDefaultEtag()
+		ru.mutation.SetEtag(v)
+	}
 }
 
 func (ru *RegistryUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -323,6 +358,15 @@ func (ru *RegistryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.UpdateTime(); ok {
 		_spec.SetField(registry.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := ru.mutation.Etag(); ok {
+		_spec.SetField(registry.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedEtag(); ok {
+		_spec.AddField(registry.FieldEtag, field.TypeInt64, value)
+	}
+	if ru.mutation.EtagCleared() {
+		_spec.ClearField(registry.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := ru.mutation.ProjectUUID(); ok {
 		_spec.SetField(registry.FieldProjectUUID, field.TypeString, value)
 	}
@@ -341,6 +385,12 @@ func (ru *RegistryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.APITypeCleared() {
 		_spec.ClearField(registry.FieldAPIType, field.TypeString)
 	}
+	if value, ok := ru.mutation.Labels(); ok {
+		_spec.SetField(registry.FieldLabels, field.TypeJSON, value)
+	}
+	if ru.mutation.LabelsCleared() {
+		_spec.ClearField(registry.FieldLabels, field.TypeJSON)
+	}
 	if ru.mutation.ApplicationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -531,6 +581,25 @@ func (ruo *RegistryUpdateOne) SetUpdateTime(t time.Time) *RegistryUpdateOne {
 	return ruo
 }
 
+// SetEtag sets the "etag" field.
+func (ruo *RegistryUpdateOne) SetEtag(i int64) *RegistryUpdateOne {
+	ruo.mutation.ResetEtag()
+	ruo.mutation.SetEtag(i)
+	return ruo
+}
+
+// AddEtag adds i to the "etag" field.
+func (ruo *RegistryUpdateOne) AddEtag(i int64) *RegistryUpdateOne {
+	ruo.mutation.AddEtag(i)
+	return ruo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (ruo *RegistryUpdateOne) ClearEtag() *RegistryUpdateOne {
+	ruo.mutation.ClearEtag()
+	return ruo
+}
+
 // SetProjectUUID sets the "project_uuid" field.
 func (ruo *RegistryUpdateOne) SetProjectUUID(s string) *RegistryUpdateOne {
 	ruo.mutation.SetProjectUUID(s)
@@ -599,6 +668,18 @@ func (ruo *RegistryUpdateOne) ClearAPIType() *RegistryUpdateOne {
 	return ruo
 }
 
+// SetLabels sets the "labels" field.
+func (ruo *RegistryUpdateOne) SetLabels(m map[string]string) *RegistryUpdateOne {
+	ruo.mutation.SetLabels(m)
+	return ruo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (ruo *RegistryUpdateOne) ClearLabels() *RegistryUpdateOne {
+	ruo.mutation.ClearLabels()
+	return ruo
+}
+
 // AddApplicationIDs adds the "applications" edge to the Application entity by IDs.
 func (ruo *RegistryUpdateOne) AddApplicationIDs(ids ...uint64) *RegistryUpdateOne {
 	ruo.mutation.AddApplicationIDs(ids...)
@@ -723,6 +804,10 @@ func (ruo *RegistryUpdateOne) defaults() {
 		v := registry.UpdateDefaultUpdateTime()
 		ruo.mutation.SetUpdateTime(v)
 	}
+	if _, ok := ruo.mutation.Etag(); !ok && !ruo.mutation.EtagCleared() {
+		v := registry.UpdateDefaultEtag()
+		ruo.mutation.SetEtag(v)
+	}
 }
 
 func (ruo *RegistryUpdateOne) sqlSave(ctx context.Context) (_node *Registry, err error) {
@@ -775,6 +860,15 @@ func (ruo *RegistryUpdateOne) sqlSave(ctx context.Context) (_node *Registry, err
 	if value, ok := ruo.mutation.UpdateTime(); ok {
 		_spec.SetField(registry.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := ruo.mutation.Etag(); ok {
+		_spec.SetField(registry.FieldEtag, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedEtag(); ok {
+		_spec.AddField(registry.FieldEtag, field.TypeInt64, value)
+	}
+	if ruo.mutation.EtagCleared() {
+		_spec.ClearField(registry.FieldEtag, field.TypeInt64)
+	}
 	if value, ok := ruo.mutation.ProjectUUID(); ok {
 		_spec.SetField(registry.FieldProjectUUID, field.TypeString, value)
 	}
@@ -793,6 +887,12 @@ func (ruo *RegistryUpdateOne) sqlSave(ctx context.Context) (_node *Registry, err
 	if ruo.mutation.APITypeCleared() {
 		_spec.ClearField(registry.FieldAPIType, field.TypeString)
 	}
+	if value, ok := ruo.mutation.Labels(); ok {
+		_spec.SetField(registry.FieldLabels, field.TypeJSON, value)
+	}
+	if ruo.mutation.LabelsCleared() {
+		_spec.ClearField(registry.FieldLabels, field.TypeJSON)
+	}
 	if ruo.mutation.ApplicationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+)
+
+// Revision is the model entity for the Revision schema.
+type Revision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UUID of the project to which the entity belongs.
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// Type of the entity, i.e. application or deployment-package.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Name of the entity.
+	Name string `json:"name,omitempty"`
+	// Version of the entity.
+	Version string `json:"version,omitempty"`
+	// Number of the revision; revisions of each entity are numbered sequentially, starting with 1.
+	Revision uint32 `json:"revision,omitempty"`
+	// Name of the user whose change produced the revision.
+	UserName string `json:"user_name,omitempty"`
+	// State of the entity at the revision, as JSON.
+	Snapshot string `json:"snapshot,omitempty"`
+	// The time the revision was recorded.
+	CreateTime   time.Time `json:"create_time,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Revision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case revision.FieldID, revision.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case revision.FieldProjectUUID, revision.FieldResourceType, revision.FieldName, revision.FieldVersion, revision.FieldUserName, revision.FieldSnapshot:
+			values[i] = new(sql.NullString)
+		case revision.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Revision fields.
+func (r *Revision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case revision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = uint64(value.Int64)
+		case revision.FieldProjectUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
+			} else if value.Valid {
+				r.ProjectUUID = value.String
+			}
+		case revision.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				r.ResourceType = value.String
+			}
+		case revision.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				r.Name = value.String
+			}
+		case revision.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				r.Version = value.String
+			}
+		case revision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				r.Revision = uint32(value.Int64)
+			}
+		case revision.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				r.UserName = value.String
+			}
+		case revision.FieldSnapshot:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value.Valid {
+				r.Snapshot = value.String
+			}
+		case revision.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				r.CreateTime = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Revision.
+// This includes values selected through modifiers, order, etc.
+func (r *Revision) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Revision.
+// Note that you need to call Revision.Unwrap() before calling this method if this Revision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Revision) Update() *RevisionUpdateOne {
+	return NewRevisionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Revision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Revision) Unwrap() *Revision {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Revision is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Revision) String() string {
+	var builder strings.Builder
+	builder.WriteString("Revision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("project_uuid=")
+	builder.WriteString(r.ProjectUUID)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(r.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(r.Version)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", r.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(r.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(r.Snapshot)
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(r.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Revisions is a parsable slice of Revision.
+type Revisions []*Revision
@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package revision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectUUID applies equality check predicate on the "project_uuid" field. It's identical to ProjectUUIDEQ.
+func ProjectUUID(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldProjectUUID, v))
+}
+
+// ResourceType applies equality check predicate on the "resource_type" field. It's identical to ResourceTypeEQ.
+func ResourceType(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldResourceType, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldVersion, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldRevision, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldUserName, v))
+}
+
+// Snapshot applies equality check predicate on the "snapshot" field. It's identical to SnapshotEQ.
+func Snapshot(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldSnapshot, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// ProjectUUIDEQ applies the EQ predicate on the "project_uuid" field.
+func ProjectUUIDEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldProjectUUID, v))
+}
+
+// ProjectUUIDNEQ applies the NEQ predicate on the "project_uuid" field.
+func ProjectUUIDNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldProjectUUID, v))
+}
+
+// ProjectUUIDIn applies the In predicate on the "project_uuid" field.
+func ProjectUUIDIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldProjectUUID, vs...))
+}
+
+// ProjectUUIDNotIn applies the NotIn predicate on the "project_uuid" field.
+func ProjectUUIDNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldProjectUUID, vs...))
+}
+
+// ProjectUUIDGT applies the GT predicate on the "project_uuid" field.
+func ProjectUUIDGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldProjectUUID, v))
+}
+
+// ProjectUUIDGTE applies the GTE predicate on the "project_uuid" field.
+func ProjectUUIDGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldProjectUUID, v))
+}
+
+// ProjectUUIDLT applies the LT predicate on the "project_uuid" field.
+func ProjectUUIDLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldProjectUUID, v))
+}
+
+// ProjectUUIDLTE applies the LTE predicate on the "project_uuid" field.
+func ProjectUUIDLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldProjectUUID, v))
+}
+
+// ProjectUUIDContains applies the Contains predicate on the "project_uuid" field.
+func ProjectUUIDContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldProjectUUID, v))
+}
+
+// ProjectUUIDHasPrefix applies the HasPrefix predicate on the "project_uuid" field.
+func ProjectUUIDHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldProjectUUID, v))
+}
+
+// ProjectUUIDHasSuffix applies the HasSuffix predicate on the "project_uuid" field.
+func ProjectUUIDHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldProjectUUID, v))
+}
+
+// ProjectUUIDEqualFold applies the EqualFold predicate on the "project_uuid" field.
+func ProjectUUIDEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldProjectUUID, v))
+}
+
+// ProjectUUIDContainsFold applies the ContainsFold predicate on the "project_uuid" field.
+func ProjectUUIDContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldProjectUUID, v))
+}
+
+// ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
+func ResourceTypeEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldResourceType, v))
+}
+
+// ResourceTypeNEQ applies the NEQ predicate on the "resource_type" field.
+func ResourceTypeNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldResourceType, v))
+}
+
+// ResourceTypeIn applies the In predicate on the "resource_type" field.
+func ResourceTypeIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeNotIn applies the NotIn predicate on the "resource_type" field.
+func ResourceTypeNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeGT applies the GT predicate on the "resource_type" field.
+func ResourceTypeGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldResourceType, v))
+}
+
+// ResourceTypeGTE applies the GTE predicate on the "resource_type" field.
+func ResourceTypeGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldResourceType, v))
+}
+
+// ResourceTypeLT applies the LT predicate on the "resource_type" field.
+func ResourceTypeLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldResourceType, v))
+}
+
+// ResourceTypeLTE applies the LTE predicate on the "resource_type" field.
+func ResourceTypeLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldResourceType, v))
+}
+
+// ResourceTypeContains applies the Contains predicate on the "resource_type" field.
+func ResourceTypeContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldResourceType, v))
+}
+
+// ResourceTypeHasPrefix applies the HasPrefix predicate on the "resource_type" field.
+func ResourceTypeHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldResourceType, v))
+}
+
+// ResourceTypeHasSuffix applies the HasSuffix predicate on the "resource_type" field.
+func ResourceTypeHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldResourceType, v))
+}
+
+// ResourceTypeEqualFold applies the EqualFold predicate on the "resource_type" field.
+func ResourceTypeEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldResourceType, v))
+}
+
+// ResourceTypeContainsFold applies the ContainsFold predicate on the "resource_type" field.
+func ResourceTypeContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldResourceType, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v uint32) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldRevision, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// SnapshotEQ applies the EQ predicate on the "snapshot" field.
+func SnapshotEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldSnapshot, v))
+}
+
+// SnapshotNEQ applies the NEQ predicate on the "snapshot" field.
+func SnapshotNEQ(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldSnapshot, v))
+}
+
+// SnapshotIn applies the In predicate on the "snapshot" field.
+func SnapshotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldSnapshot, vs...))
+}
+
+// SnapshotNotIn applies the NotIn predicate on the "snapshot" field.
+func SnapshotNotIn(vs ...string) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldSnapshot, vs...))
+}
+
+// SnapshotGT applies the GT predicate on the "snapshot" field.
+func SnapshotGT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldSnapshot, v))
+}
+
+// SnapshotGTE applies the GTE predicate on the "snapshot" field.
+func SnapshotGTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldSnapshot, v))
+}
+
+// SnapshotLT applies the LT predicate on the "snapshot" field.
+func SnapshotLT(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldSnapshot, v))
+}
+
+// SnapshotLTE applies the LTE predicate on the "snapshot" field.
+func SnapshotLTE(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldSnapshot, v))
+}
+
+// SnapshotContains applies the Contains predicate on the "snapshot" field.
+func SnapshotContains(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContains(FieldSnapshot, v))
+}
+
+// SnapshotHasPrefix applies the HasPrefix predicate on the "snapshot" field.
+func SnapshotHasPrefix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefix(FieldSnapshot, v))
+}
+
+// SnapshotHasSuffix applies the HasSuffix predicate on the "snapshot" field.
+func SnapshotHasSuffix(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasSuffix(FieldSnapshot, v))
+}
+
+// SnapshotEqualFold applies the EqualFold predicate on the "snapshot" field.
+func SnapshotEqualFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldEqualFold(FieldSnapshot, v))
+}
+
+// SnapshotContainsFold applies the ContainsFold predicate on the "snapshot" field.
+func SnapshotContainsFold(v string) predicate.Revision {
+	return predicate.Revision(sql.FieldContainsFold(FieldSnapshot, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Revision {
+	return predicate.Revision(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Revision) predicate.Revision {
+	return predicate.Revision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Revision) predicate.Revision {
+	return predicate.Revision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Revision) predicate.Revision {
+	return predicate.Revision(sql.NotPredicates(p))
+}
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+)
+
+// RevisionCreate is the builder for creating a Revision entity.
+type RevisionCreate struct {
+	config
+	mutation *RevisionMutation
+	hooks    []Hook
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (rc *RevisionCreate) SetProjectUUID(s string) *RevisionCreate {
+	rc.mutation.SetProjectUUID(s)
+	return rc
+}
+
+// SetResourceType sets the "resource_type" field.
+func (rc *RevisionCreate) SetResourceType(s string) *RevisionCreate {
+	rc.mutation.SetResourceType(s)
+	return rc
+}
+
+// SetName sets the "name" field.
+func (rc *RevisionCreate) SetName(s string) *RevisionCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetVersion sets the "version" field.
+func (rc *RevisionCreate) SetVersion(s string) *RevisionCreate {
+	rc.mutation.SetVersion(s)
+	return rc
+}
+
+// SetRevision sets the "revision" field.
+func (rc *RevisionCreate) SetRevision(u uint32) *RevisionCreate {
+	rc.mutation.SetRevision(u)
+	return rc
+}
+
+// SetUserName sets the "user_name" field.
+func (rc *RevisionCreate) SetUserName(s string) *RevisionCreate {
+	rc.mutation.SetUserName(s)
+	return rc
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (rc *RevisionCreate) SetNillableUserName(s *string) *RevisionCreate {
+	if s != nil {
+		rc.SetUserName(*s)
+	}
+	return rc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (rc *RevisionCreate) SetSnapshot(s string) *RevisionCreate {
+	rc.mutation.SetSnapshot(s)
+	return rc
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rc *RevisionCreate) SetCreateTime(t time.Time) *RevisionCreate {
+	rc.mutation.SetCreateTime(t)
+	return rc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rc *RevisionCreate) SetNillableCreateTime(t *time.Time) *RevisionCreate {
+	if t != nil {
+		rc.SetCreateTime(*t)
+	}
+	return rc
+}
+
+// Mutation returns the RevisionMutation object of the builder.
+func (rc *RevisionCreate) Mutation() *RevisionMutation {
+	return rc.mutation
+}
+
+// Save creates the Revision in the database.
+func (rc *RevisionCreate) Save(ctx context.Context) (*Revision, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RevisionCreate) SaveX(ctx context.Context) *Revision {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RevisionCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RevisionCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RevisionCreate) defaults() {
+	if _, ok := rc.mutation.UserName(); !ok {
+		v := revision.DefaultUserName
+		rc.mutation.SetUserName(v)
+	}
+	if _, ok := rc.mutation.CreateTime(); !ok {
+		v := revision.DefaultCreateTime()
+		rc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RevisionCreate) check() error {
+	if _, ok := rc.mutation.ProjectUUID(); !ok {
+		return &ValidationError{Name: "project_uuid", err: errors.New(`generated: missing required field "Revision.project_uuid"`)}
+	}
+	if _, ok := rc.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`generated: missing required field "Revision.resource_type"`)}
+	}
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "Revision.name"`)}
+	}
+	if _, ok := rc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Revision.version"`)}
+	}
+	if _, ok := rc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`generated: missing required field "Revision.revision"`)}
+	}
+	if _, ok := rc.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`generated: missing required field "Revision.user_name"`)}
+	}
+	if _, ok := rc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`generated: missing required field "Revision.snapshot"`)}
+	}
+	if _, ok := rc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`generated: missing required field "Revision.create_time"`)}
+	}
+	return nil
+}
+
+func (rc *RevisionCreate) sqlSave(ctx context.Context) (*Revision, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = uint64(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RevisionCreate) createSpec() (*Revision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Revision{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(revision.Table, sqlgraph.NewFieldSpec(revision.FieldID, field.TypeUint64))
+	)
+	if value, ok := rc.mutation.ProjectUUID(); ok {
+		_spec.SetField(revision.FieldProjectUUID, field.TypeString, value)
+		_node.ProjectUUID = value
+	}
+	if value, ok := rc.mutation.ResourceType(); ok {
+		_spec.SetField(revision.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.SetField(revision.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.Version(); ok {
+		_spec.SetField(revision.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := rc.mutation.Revision(); ok {
+		_spec.SetField(revision.FieldRevision, field.TypeUint32, value)
+		_node.Revision = value
+	}
+	if value, ok := rc.mutation.UserName(); ok {
+		_spec.SetField(revision.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := rc.mutation.Snapshot(); ok {
+		_spec.SetField(revision.FieldSnapshot, field.TypeString, value)
+		_node.Snapshot = value
+	}
+	if value, ok := rc.mutation.CreateTime(); ok {
+		_spec.SetField(revision.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	return _node, _spec
+}
+
+// RevisionCreateBulk is the builder for creating many Revision entities in bulk.
+type RevisionCreateBulk struct {
+	config
+	err      error
+	builders []*RevisionCreate
+}
+
+// Save creates the Revision entities in the database.
+func (rcb *RevisionCreateBulk) Save(ctx context.Context) ([]*Revision, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Revision, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RevisionCreateBulk) SaveX(ctx context.Context) []*Revision {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
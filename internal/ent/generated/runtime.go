@@ -7,11 +7,17 @@ import (
 
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/commonmixin"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentprofile"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/profile"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhook"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhookdelivery"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/schema"
 )
 
@@ -22,6 +28,8 @@ func init() {
 	applicationMixin := schema.Application{}.Mixin()
 	applicationMixinFields0 := applicationMixin[0].Fields()
 	_ = applicationMixinFields0
+	applicationMixinFields1 := applicationMixin[1].Fields()
+	_ = applicationMixinFields1
 	applicationFields := schema.Application{}.Fields()
 	_ = applicationFields
 	// applicationDescCreateTime is the schema descriptor for create_time field.
@@ -34,13 +42,25 @@ func init() {
 	application.DefaultUpdateTime = applicationDescUpdateTime.Default.(func() time.Time)
 	// application.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	application.UpdateDefaultUpdateTime = applicationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// applicationDescEtag is the schema descriptor for etag field.
+	applicationDescEtag := applicationMixinFields1[0].Descriptor()
+	// application.DefaultEtag holds the default value on creation for the etag field.
+	application.DefaultEtag = applicationDescEtag.Default.(func() int64)
+	// application.UpdateDefaultEtag holds the default value on update for the etag field.
+	application.UpdateDefaultEtag = applicationDescEtag.UpdateDefault.(func() int64)
 	// applicationDescProjectUUID is the schema descriptor for project_uuid field.
 	applicationDescProjectUUID := applicationFields[0].Descriptor()
 	// application.DefaultProjectUUID holds the default value on creation for the project_uuid field.
 	application.DefaultProjectUUID = applicationDescProjectUUID.Default.(string)
+	// applicationDescDeprecated is the schema descriptor for deprecated field.
+	applicationDescDeprecated := applicationFields[9].Descriptor()
+	// application.DefaultDeprecated holds the default value on creation for the deprecated field.
+	application.DefaultDeprecated = applicationDescDeprecated.Default.(bool)
 	artifactMixin := schema.Artifact{}.Mixin()
 	artifactMixinFields0 := artifactMixin[0].Fields()
 	_ = artifactMixinFields0
+	artifactMixinFields1 := artifactMixin[1].Fields()
+	_ = artifactMixinFields1
 	artifactFields := schema.Artifact{}.Fields()
 	_ = artifactFields
 	// artifactDescCreateTime is the schema descriptor for create_time field.
@@ -53,10 +73,46 @@ func init() {
 	artifact.DefaultUpdateTime = artifactDescUpdateTime.Default.(func() time.Time)
 	// artifact.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	artifact.UpdateDefaultUpdateTime = artifactDescUpdateTime.UpdateDefault.(func() time.Time)
+	// artifactDescEtag is the schema descriptor for etag field.
+	artifactDescEtag := artifactMixinFields1[0].Descriptor()
+	// artifact.DefaultEtag holds the default value on creation for the etag field.
+	artifact.DefaultEtag = artifactDescEtag.Default.(func() int64)
+	// artifact.UpdateDefaultEtag holds the default value on update for the etag field.
+	artifact.UpdateDefaultEtag = artifactDescEtag.UpdateDefault.(func() int64)
 	// artifactDescProjectUUID is the schema descriptor for project_uuid field.
 	artifactDescProjectUUID := artifactFields[0].Descriptor()
 	// artifact.DefaultProjectUUID holds the default value on creation for the project_uuid field.
 	artifact.DefaultProjectUUID = artifactDescProjectUUID.Default.(string)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescUserName is the schema descriptor for user_name field.
+	auditeventDescUserName := auditeventFields[1].Descriptor()
+	// auditevent.DefaultUserName holds the default value on creation for the user_name field.
+	auditevent.DefaultUserName = auditeventDescUserName.Default.(string)
+	// auditeventDescClient is the schema descriptor for client field.
+	auditeventDescClient := auditeventFields[2].Descriptor()
+	// auditevent.DefaultClient holds the default value on creation for the client field.
+	auditevent.DefaultClient = auditeventDescClient.Default.(string)
+	// auditeventDescMethod is the schema descriptor for method field.
+	auditeventDescMethod := auditeventFields[3].Descriptor()
+	// auditevent.DefaultMethod holds the default value on creation for the method field.
+	auditevent.DefaultMethod = auditeventDescMethod.Default.(string)
+	// auditeventDescResourceVersion is the schema descriptor for resource_version field.
+	auditeventDescResourceVersion := auditeventFields[6].Descriptor()
+	// auditevent.DefaultResourceVersion holds the default value on creation for the resource_version field.
+	auditevent.DefaultResourceVersion = auditeventDescResourceVersion.Default.(string)
+	// auditeventDescBefore is the schema descriptor for before field.
+	auditeventDescBefore := auditeventFields[9].Descriptor()
+	// auditevent.DefaultBefore holds the default value on creation for the before field.
+	auditevent.DefaultBefore = auditeventDescBefore.Default.(string)
+	// auditeventDescAfter is the schema descriptor for after field.
+	auditeventDescAfter := auditeventFields[10].Descriptor()
+	// auditevent.DefaultAfter holds the default value on creation for the after field.
+	auditevent.DefaultAfter = auditeventDescAfter.Default.(string)
+	// auditeventDescCreateTime is the schema descriptor for create_time field.
+	auditeventDescCreateTime := auditeventFields[11].Descriptor()
+	// auditevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	auditevent.DefaultCreateTime = auditeventDescCreateTime.Default.(func() time.Time)
 	commonmixinFields := schema.CommonMixin{}.Fields()
 	_ = commonmixinFields
 	// commonmixinDescCreateTime is the schema descriptor for create_time field.
@@ -72,6 +128,8 @@ func init() {
 	deploymentpackageMixin := schema.DeploymentPackage{}.Mixin()
 	deploymentpackageMixinFields0 := deploymentpackageMixin[0].Fields()
 	_ = deploymentpackageMixinFields0
+	deploymentpackageMixinFields1 := deploymentpackageMixin[1].Fields()
+	_ = deploymentpackageMixinFields1
 	deploymentpackageFields := schema.DeploymentPackage{}.Fields()
 	_ = deploymentpackageFields
 	// deploymentpackageDescCreateTime is the schema descriptor for create_time field.
@@ -84,10 +142,20 @@ func init() {
 	deploymentpackage.DefaultUpdateTime = deploymentpackageDescUpdateTime.Default.(func() time.Time)
 	// deploymentpackage.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	deploymentpackage.UpdateDefaultUpdateTime = deploymentpackageDescUpdateTime.UpdateDefault.(func() time.Time)
+	// deploymentpackageDescEtag is the schema descriptor for etag field.
+	deploymentpackageDescEtag := deploymentpackageMixinFields1[0].Descriptor()
+	// deploymentpackage.DefaultEtag holds the default value on creation for the etag field.
+	deploymentpackage.DefaultEtag = deploymentpackageDescEtag.Default.(func() int64)
+	// deploymentpackage.UpdateDefaultEtag holds the default value on update for the etag field.
+	deploymentpackage.UpdateDefaultEtag = deploymentpackageDescEtag.UpdateDefault.(func() int64)
 	// deploymentpackageDescProjectUUID is the schema descriptor for project_uuid field.
 	deploymentpackageDescProjectUUID := deploymentpackageFields[0].Descriptor()
 	// deploymentpackage.DefaultProjectUUID holds the default value on creation for the project_uuid field.
 	deploymentpackage.DefaultProjectUUID = deploymentpackageDescProjectUUID.Default.(string)
+	// deploymentpackageDescDeprecated is the schema descriptor for deprecated field.
+	deploymentpackageDescDeprecated := deploymentpackageFields[11].Descriptor()
+	// deploymentpackage.DefaultDeprecated holds the default value on creation for the deprecated field.
+	deploymentpackage.DefaultDeprecated = deploymentpackageDescDeprecated.Default.(bool)
 	deploymentprofileMixin := schema.DeploymentProfile{}.Mixin()
 	deploymentprofileMixinFields0 := deploymentprofileMixin[0].Fields()
 	_ = deploymentprofileMixinFields0
@@ -103,6 +171,16 @@ func init() {
 	deploymentprofile.DefaultUpdateTime = deploymentprofileDescUpdateTime.Default.(func() time.Time)
 	// deploymentprofile.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	deploymentprofile.UpdateDefaultUpdateTime = deploymentprofileDescUpdateTime.UpdateDefault.(func() time.Time)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescOrigin is the schema descriptor for origin field.
+	outboxeventDescOrigin := outboxeventFields[4].Descriptor()
+	// outboxevent.DefaultOrigin holds the default value on creation for the origin field.
+	outboxevent.DefaultOrigin = outboxeventDescOrigin.Default.(string)
+	// outboxeventDescCreateTime is the schema descriptor for create_time field.
+	outboxeventDescCreateTime := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	outboxevent.DefaultCreateTime = outboxeventDescCreateTime.Default.(func() time.Time)
 	profileMixin := schema.Profile{}.Mixin()
 	profileMixinFields0 := profileMixin[0].Fields()
 	_ = profileMixinFields0
@@ -121,6 +199,8 @@ func init() {
 	registryMixin := schema.Registry{}.Mixin()
 	registryMixinFields0 := registryMixin[0].Fields()
 	_ = registryMixinFields0
+	registryMixinFields1 := registryMixin[1].Fields()
+	_ = registryMixinFields1
 	registryFields := schema.Registry{}.Fields()
 	_ = registryFields
 	// registryDescCreateTime is the schema descriptor for create_time field.
@@ -133,8 +213,78 @@ func init() {
 	registry.DefaultUpdateTime = registryDescUpdateTime.Default.(func() time.Time)
 	// registry.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	registry.UpdateDefaultUpdateTime = registryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// registryDescEtag is the schema descriptor for etag field.
+	registryDescEtag := registryMixinFields1[0].Descriptor()
+	// registry.DefaultEtag holds the default value on creation for the etag field.
+	registry.DefaultEtag = registryDescEtag.Default.(func() int64)
+	// registry.UpdateDefaultEtag holds the default value on update for the etag field.
+	registry.UpdateDefaultEtag = registryDescEtag.UpdateDefault.(func() int64)
 	// registryDescProjectUUID is the schema descriptor for project_uuid field.
 	registryDescProjectUUID := registryFields[0].Descriptor()
 	// registry.DefaultProjectUUID holds the default value on creation for the project_uuid field.
 	registry.DefaultProjectUUID = registryDescProjectUUID.Default.(string)
+	revisionFields := schema.Revision{}.Fields()
+	_ = revisionFields
+	// revisionDescUserName is the schema descriptor for user_name field.
+	revisionDescUserName := revisionFields[5].Descriptor()
+	// revision.DefaultUserName holds the default value on creation for the user_name field.
+	revision.DefaultUserName = revisionDescUserName.Default.(string)
+	// revisionDescCreateTime is the schema descriptor for create_time field.
+	revisionDescCreateTime := revisionFields[7].Descriptor()
+	// revision.DefaultCreateTime holds the default value on creation for the create_time field.
+	revision.DefaultCreateTime = revisionDescCreateTime.Default.(func() time.Time)
+	trashedentityFields := schema.TrashedEntity{}.Fields()
+	_ = trashedentityFields
+	// trashedentityDescVersion is the schema descriptor for version field.
+	trashedentityDescVersion := trashedentityFields[3].Descriptor()
+	// trashedentity.DefaultVersion holds the default value on creation for the version field.
+	trashedentity.DefaultVersion = trashedentityDescVersion.Default.(string)
+	// trashedentityDescUserName is the schema descriptor for user_name field.
+	trashedentityDescUserName := trashedentityFields[4].Descriptor()
+	// trashedentity.DefaultUserName holds the default value on creation for the user_name field.
+	trashedentity.DefaultUserName = trashedentityDescUserName.Default.(string)
+	// trashedentityDescDeleteTime is the schema descriptor for delete_time field.
+	trashedentityDescDeleteTime := trashedentityFields[7].Descriptor()
+	// trashedentity.DefaultDeleteTime holds the default value on creation for the delete_time field.
+	trashedentity.DefaultDeleteTime = trashedentityDescDeleteTime.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreateTime is the schema descriptor for create_time field.
+	webhookDescCreateTime := webhookFields[7].Descriptor()
+	// webhook.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhook.DefaultCreateTime = webhookDescCreateTime.Default.(func() time.Time)
+	// webhookDescUpdateTime is the schema descriptor for update_time field.
+	webhookDescUpdateTime := webhookFields[8].Descriptor()
+	// webhook.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhook.DefaultUpdateTime = webhookDescUpdateTime.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhook.UpdateDefaultUpdateTime = webhookDescUpdateTime.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescState is the schema descriptor for state field.
+	webhookdeliveryDescState := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultState holds the default value on creation for the state field.
+	webhookdelivery.DefaultState = webhookdeliveryDescState.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescResponseCode is the schema descriptor for response_code field.
+	webhookdeliveryDescResponseCode := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultResponseCode holds the default value on creation for the response_code field.
+	webhookdelivery.DefaultResponseCode = webhookdeliveryDescResponseCode.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
 }
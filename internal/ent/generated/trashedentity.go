@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+)
+
+// TrashedEntity is the model entity for the TrashedEntity schema.
+type TrashedEntity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UUID of the project to which the entity belonged.
+	ProjectUUID string `json:"project_uuid,omitempty"`
+	// Type of the entity, i.e. registry, application or deployment-package.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Name of the entity.
+	Name string `json:"name,omitempty"`
+	// Version of the entity; empty for registries.
+	Version string `json:"version,omitempty"`
+	// Name of the user who deleted the entity.
+	UserName string `json:"user_name,omitempty"`
+	// State of the entity when it was deleted, as JSON; registries are redacted.
+	Snapshot string `json:"snapshot,omitempty"`
+	// Encoded, and possibly encrypted, secret data of a registry whose secrets were kept in the database.
+	Secret string `json:"-"`
+	// The time the entity was deleted.
+	DeleteTime   time.Time `json:"delete_time,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrashedEntity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trashedentity.FieldID:
+			values[i] = new(sql.NullInt64)
+		case trashedentity.FieldProjectUUID, trashedentity.FieldResourceType, trashedentity.FieldName, trashedentity.FieldVersion, trashedentity.FieldUserName, trashedentity.FieldSnapshot, trashedentity.FieldSecret:
+			values[i] = new(sql.NullString)
+		case trashedentity.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrashedEntity fields.
+func (te *TrashedEntity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trashedentity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			te.ID = uint64(value.Int64)
+		case trashedentity.FieldProjectUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_uuid", values[i])
+			} else if value.Valid {
+				te.ProjectUUID = value.String
+			}
+		case trashedentity.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				te.ResourceType = value.String
+			}
+		case trashedentity.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				te.Name = value.String
+			}
+		case trashedentity.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				te.Version = value.String
+			}
+		case trashedentity.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				te.UserName = value.String
+			}
+		case trashedentity.FieldSnapshot:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value.Valid {
+				te.Snapshot = value.String
+			}
+		case trashedentity.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				te.Secret = value.String
+			}
+		case trashedentity.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				te.DeleteTime = value.Time
+			}
+		default:
+			te.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrashedEntity.
+// This includes values selected through modifiers, order, etc.
+func (te *TrashedEntity) Value(name string) (ent.Value, error) {
+	return te.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TrashedEntity.
+// Note that you need to call TrashedEntity.Unwrap() before calling this method if this TrashedEntity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TrashedEntity) Update() *TrashedEntityUpdateOne {
+	return NewTrashedEntityClient(te.config).UpdateOne(te)
+}
+
+// Unwrap unwraps the TrashedEntity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TrashedEntity) Unwrap() *TrashedEntity {
+	_tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TrashedEntity is not a transactional entity")
+	}
+	te.config.driver = _tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TrashedEntity) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrashedEntity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", te.ID))
+	builder.WriteString("project_uuid=")
+	builder.WriteString(te.ProjectUUID)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(te.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(te.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(te.Version)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(te.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(te.Snapshot)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("delete_time=")
+	builder.WriteString(te.DeleteTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrashedEntities is a parsable slice of TrashedEntity.
+type TrashedEntities []*TrashedEntity
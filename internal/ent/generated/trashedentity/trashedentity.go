@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trashedentity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the trashedentity type in the database.
+	Label = "trashed_entity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectUUID holds the string denoting the project_uuid field in the database.
+	FieldProjectUUID = "project_uuid"
+	// FieldResourceType holds the string denoting the resource_type field in the database.
+	FieldResourceType = "resource_type"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldUserName holds the string denoting the user_name field in the database.
+	FieldUserName = "user_name"
+	// FieldSnapshot holds the string denoting the snapshot field in the database.
+	FieldSnapshot = "snapshot"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// Table holds the table name of the trashedentity in the database.
+	Table = "trashed_entities"
+)
+
+// Columns holds all SQL columns for trashedentity fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectUUID,
+	FieldResourceType,
+	FieldName,
+	FieldVersion,
+	FieldUserName,
+	FieldSnapshot,
+	FieldSecret,
+	FieldDeleteTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion string
+	// DefaultUserName holds the default value on creation for the "user_name" field.
+	DefaultUserName string
+	// DefaultDeleteTime holds the default value on creation for the "delete_time" field.
+	DefaultDeleteTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the TrashedEntity queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectUUID orders the results by the project_uuid field.
+func ByProjectUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectUUID, opts...).ToFunc()
+}
+
+// ByResourceType orders the results by the resource_type field.
+func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByUserName orders the results by the user_name field.
+func ByUserName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserName, opts...).ToFunc()
+}
+
+// BySnapshot orders the results by the snapshot field.
+func BySnapshot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshot, opts...).ToFunc()
+}
+
+// BySecret orders the results by the secret field.
+func BySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecret, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
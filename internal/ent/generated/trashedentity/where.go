@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trashedentity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectUUID applies equality check predicate on the "project_uuid" field. It's identical to ProjectUUIDEQ.
+func ProjectUUID(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldProjectUUID, v))
+}
+
+// ResourceType applies equality check predicate on the "resource_type" field. It's identical to ResourceTypeEQ.
+func ResourceType(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldResourceType, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldVersion, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldUserName, v))
+}
+
+// Snapshot applies equality check predicate on the "snapshot" field. It's identical to SnapshotEQ.
+func Snapshot(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldSnapshot, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldSecret, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// ProjectUUIDEQ applies the EQ predicate on the "project_uuid" field.
+func ProjectUUIDEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldProjectUUID, v))
+}
+
+// ProjectUUIDNEQ applies the NEQ predicate on the "project_uuid" field.
+func ProjectUUIDNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldProjectUUID, v))
+}
+
+// ProjectUUIDIn applies the In predicate on the "project_uuid" field.
+func ProjectUUIDIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldProjectUUID, vs...))
+}
+
+// ProjectUUIDNotIn applies the NotIn predicate on the "project_uuid" field.
+func ProjectUUIDNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldProjectUUID, vs...))
+}
+
+// ProjectUUIDGT applies the GT predicate on the "project_uuid" field.
+func ProjectUUIDGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldProjectUUID, v))
+}
+
+// ProjectUUIDGTE applies the GTE predicate on the "project_uuid" field.
+func ProjectUUIDGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldProjectUUID, v))
+}
+
+// ProjectUUIDLT applies the LT predicate on the "project_uuid" field.
+func ProjectUUIDLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldProjectUUID, v))
+}
+
+// ProjectUUIDLTE applies the LTE predicate on the "project_uuid" field.
+func ProjectUUIDLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldProjectUUID, v))
+}
+
+// ProjectUUIDContains applies the Contains predicate on the "project_uuid" field.
+func ProjectUUIDContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldProjectUUID, v))
+}
+
+// ProjectUUIDHasPrefix applies the HasPrefix predicate on the "project_uuid" field.
+func ProjectUUIDHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldProjectUUID, v))
+}
+
+// ProjectUUIDHasSuffix applies the HasSuffix predicate on the "project_uuid" field.
+func ProjectUUIDHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldProjectUUID, v))
+}
+
+// ProjectUUIDEqualFold applies the EqualFold predicate on the "project_uuid" field.
+func ProjectUUIDEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldProjectUUID, v))
+}
+
+// ProjectUUIDContainsFold applies the ContainsFold predicate on the "project_uuid" field.
+func ProjectUUIDContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldProjectUUID, v))
+}
+
+// ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
+func ResourceTypeEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldResourceType, v))
+}
+
+// ResourceTypeNEQ applies the NEQ predicate on the "resource_type" field.
+func ResourceTypeNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldResourceType, v))
+}
+
+// ResourceTypeIn applies the In predicate on the "resource_type" field.
+func ResourceTypeIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeNotIn applies the NotIn predicate on the "resource_type" field.
+func ResourceTypeNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeGT applies the GT predicate on the "resource_type" field.
+func ResourceTypeGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldResourceType, v))
+}
+
+// ResourceTypeGTE applies the GTE predicate on the "resource_type" field.
+func ResourceTypeGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldResourceType, v))
+}
+
+// ResourceTypeLT applies the LT predicate on the "resource_type" field.
+func ResourceTypeLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldResourceType, v))
+}
+
+// ResourceTypeLTE applies the LTE predicate on the "resource_type" field.
+func ResourceTypeLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldResourceType, v))
+}
+
+// ResourceTypeContains applies the Contains predicate on the "resource_type" field.
+func ResourceTypeContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldResourceType, v))
+}
+
+// ResourceTypeHasPrefix applies the HasPrefix predicate on the "resource_type" field.
+func ResourceTypeHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldResourceType, v))
+}
+
+// ResourceTypeHasSuffix applies the HasSuffix predicate on the "resource_type" field.
+func ResourceTypeHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldResourceType, v))
+}
+
+// ResourceTypeEqualFold applies the EqualFold predicate on the "resource_type" field.
+func ResourceTypeEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldResourceType, v))
+}
+
+// ResourceTypeContainsFold applies the ContainsFold predicate on the "resource_type" field.
+func ResourceTypeContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldResourceType, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// SnapshotEQ applies the EQ predicate on the "snapshot" field.
+func SnapshotEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldSnapshot, v))
+}
+
+// SnapshotNEQ applies the NEQ predicate on the "snapshot" field.
+func SnapshotNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldSnapshot, v))
+}
+
+// SnapshotIn applies the In predicate on the "snapshot" field.
+func SnapshotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldSnapshot, vs...))
+}
+
+// SnapshotNotIn applies the NotIn predicate on the "snapshot" field.
+func SnapshotNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldSnapshot, vs...))
+}
+
+// SnapshotGT applies the GT predicate on the "snapshot" field.
+func SnapshotGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldSnapshot, v))
+}
+
+// SnapshotGTE applies the GTE predicate on the "snapshot" field.
+func SnapshotGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldSnapshot, v))
+}
+
+// SnapshotLT applies the LT predicate on the "snapshot" field.
+func SnapshotLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldSnapshot, v))
+}
+
+// SnapshotLTE applies the LTE predicate on the "snapshot" field.
+func SnapshotLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldSnapshot, v))
+}
+
+// SnapshotContains applies the Contains predicate on the "snapshot" field.
+func SnapshotContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldSnapshot, v))
+}
+
+// SnapshotHasPrefix applies the HasPrefix predicate on the "snapshot" field.
+func SnapshotHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldSnapshot, v))
+}
+
+// SnapshotHasSuffix applies the HasSuffix predicate on the "snapshot" field.
+func SnapshotHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldSnapshot, v))
+}
+
+// SnapshotEqualFold applies the EqualFold predicate on the "snapshot" field.
+func SnapshotEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldSnapshot, v))
+}
+
+// SnapshotContainsFold applies the ContainsFold predicate on the "snapshot" field.
+func SnapshotContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldSnapshot, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIsNull(FieldSecret))
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotNull(FieldSecret))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TrashedEntity) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TrashedEntity) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TrashedEntity) predicate.TrashedEntity {
+	return predicate.TrashedEntity(sql.NotPredicates(p))
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+)
+
+// TrashedEntityCreate is the builder for creating a TrashedEntity entity.
+type TrashedEntityCreate struct {
+	config
+	mutation *TrashedEntityMutation
+	hooks    []Hook
+}
+
+// SetProjectUUID sets the "project_uuid" field.
+func (tec *TrashedEntityCreate) SetProjectUUID(s string) *TrashedEntityCreate {
+	tec.mutation.SetProjectUUID(s)
+	return tec
+}
+
+// SetResourceType sets the "resource_type" field.
+func (tec *TrashedEntityCreate) SetResourceType(s string) *TrashedEntityCreate {
+	tec.mutation.SetResourceType(s)
+	return tec
+}
+
+// SetName sets the "name" field.
+func (tec *TrashedEntityCreate) SetName(s string) *TrashedEntityCreate {
+	tec.mutation.SetName(s)
+	return tec
+}
+
+// SetVersion sets the "version" field.
+func (tec *TrashedEntityCreate) SetVersion(s string) *TrashedEntityCreate {
+	tec.mutation.SetVersion(s)
+	return tec
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tec *TrashedEntityCreate) SetNillableVersion(s *string) *TrashedEntityCreate {
+	if s != nil {
+		tec.SetVersion(*s)
+	}
+	return tec
+}
+
+// SetUserName sets the "user_name" field.
+func (tec *TrashedEntityCreate) SetUserName(s string) *TrashedEntityCreate {
+	tec.mutation.SetUserName(s)
+	return tec
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (tec *TrashedEntityCreate) SetNillableUserName(s *string) *TrashedEntityCreate {
+	if s != nil {
+		tec.SetUserName(*s)
+	}
+	return tec
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (tec *TrashedEntityCreate) SetSnapshot(s string) *TrashedEntityCreate {
+	tec.mutation.SetSnapshot(s)
+	return tec
+}
+
+// SetSecret sets the "secret" field.
+func (tec *TrashedEntityCreate) SetSecret(s string) *TrashedEntityCreate {
+	tec.mutation.SetSecret(s)
+	return tec
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (tec *TrashedEntityCreate) SetNillableSecret(s *string) *TrashedEntityCreate {
+	if s != nil {
+		tec.SetSecret(*s)
+	}
+	return tec
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (tec *TrashedEntityCreate) SetDeleteTime(t time.Time) *TrashedEntityCreate {
+	tec.mutation.SetDeleteTime(t)
+	return tec
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (tec *TrashedEntityCreate) SetNillableDeleteTime(t *time.Time) *TrashedEntityCreate {
+	if t != nil {
+		tec.SetDeleteTime(*t)
+	}
+	return tec
+}
+
+// Mutation returns the TrashedEntityMutation object of the builder.
+func (tec *TrashedEntityCreate) Mutation() *TrashedEntityMutation {
+	return tec.mutation
+}
+
+// Save creates the TrashedEntity in the database.
+func (tec *TrashedEntityCreate) Save(ctx context.Context) (*TrashedEntity, error) {
+	tec.defaults()
+	return withHooks(ctx, tec.sqlSave, tec.mutation, tec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TrashedEntityCreate) SaveX(ctx context.Context) *TrashedEntity {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TrashedEntityCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TrashedEntityCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tec *TrashedEntityCreate) defaults() {
+	if _, ok := tec.mutation.Version(); !ok {
+		v := trashedentity.DefaultVersion
+		tec.mutation.SetVersion(v)
+	}
+	if _, ok := tec.mutation.UserName(); !ok {
+		v := trashedentity.DefaultUserName
+		tec.mutation.SetUserName(v)
+	}
+	if _, ok := tec.mutation.DeleteTime(); !ok {
+		v := trashedentity.DefaultDeleteTime()
+		tec.mutation.SetDeleteTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TrashedEntityCreate) check() error {
+	if _, ok := tec.mutation.ProjectUUID(); !ok {
+		return &ValidationError{Name: "project_uuid", err: errors.New(`generated: missing required field "TrashedEntity.project_uuid"`)}
+	}
+	if _, ok := tec.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`generated: missing required field "TrashedEntity.resource_type"`)}
+	}
+	if _, ok := tec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "TrashedEntity.name"`)}
+	}
+	if _, ok := tec.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "TrashedEntity.version"`)}
+	}
+	if _, ok := tec.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`generated: missing required field "TrashedEntity.user_name"`)}
+	}
+	if _, ok := tec.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`generated: missing required field "TrashedEntity.snapshot"`)}
+	}
+	if _, ok := tec.mutation.DeleteTime(); !ok {
+		return &ValidationError{Name: "delete_time", err: errors.New(`generated: missing required field "TrashedEntity.delete_time"`)}
+	}
+	return nil
+}
+
+func (tec *TrashedEntityCreate) sqlSave(ctx context.Context) (*TrashedEntity, error) {
+	if err := tec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = uint64(id)
+	tec.mutation.id = &_node.ID
+	tec.mutation.done = true
+	return _node, nil
+}
+
+func (tec *TrashedEntityCreate) createSpec() (*TrashedEntity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrashedEntity{config: tec.config}
+		_spec = sqlgraph.NewCreateSpec(trashedentity.Table, sqlgraph.NewFieldSpec(trashedentity.FieldID, field.TypeUint64))
+	)
+	if value, ok := tec.mutation.ProjectUUID(); ok {
+		_spec.SetField(trashedentity.FieldProjectUUID, field.TypeString, value)
+		_node.ProjectUUID = value
+	}
+	if value, ok := tec.mutation.ResourceType(); ok {
+		_spec.SetField(trashedentity.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := tec.mutation.Name(); ok {
+		_spec.SetField(trashedentity.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tec.mutation.Version(); ok {
+		_spec.SetField(trashedentity.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := tec.mutation.UserName(); ok {
+		_spec.SetField(trashedentity.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := tec.mutation.Snapshot(); ok {
+		_spec.SetField(trashedentity.FieldSnapshot, field.TypeString, value)
+		_node.Snapshot = value
+	}
+	if value, ok := tec.mutation.Secret(); ok {
+		_spec.SetField(trashedentity.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := tec.mutation.DeleteTime(); ok {
+		_spec.SetField(trashedentity.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = value
+	}
+	return _node, _spec
+}
+
+// TrashedEntityCreateBulk is the builder for creating many TrashedEntity entities in bulk.
+type TrashedEntityCreateBulk struct {
+	config
+	err      error
+	builders []*TrashedEntityCreate
+}
+
+// Save creates the TrashedEntity entities in the database.
+func (tecb *TrashedEntityCreateBulk) Save(ctx context.Context) ([]*TrashedEntity, error) {
+	if tecb.err != nil {
+		return nil, tecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TrashedEntity, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrashedEntityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TrashedEntityCreateBulk) SaveX(ctx context.Context) []*TrashedEntity {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TrashedEntityCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TrashedEntityCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
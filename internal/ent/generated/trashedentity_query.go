@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+)
+
+// TrashedEntityQuery is the builder for querying TrashedEntity entities.
+type TrashedEntityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []trashedentity.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TrashedEntity
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TrashedEntityQuery builder.
+func (teq *TrashedEntityQuery) Where(ps ...predicate.TrashedEntity) *TrashedEntityQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit the number of records to be returned by this query.
+func (teq *TrashedEntityQuery) Limit(limit int) *TrashedEntityQuery {
+	teq.ctx.Limit = &limit
+	return teq
+}
+
+// Offset to start from.
+func (teq *TrashedEntityQuery) Offset(offset int) *TrashedEntityQuery {
+	teq.ctx.Offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TrashedEntityQuery) Unique(unique bool) *TrashedEntityQuery {
+	teq.ctx.Unique = &unique
+	return teq
+}
+
+// Order specifies how the records should be ordered.
+func (teq *TrashedEntityQuery) Order(o ...trashedentity.OrderOption) *TrashedEntityQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// First returns the first TrashedEntity entity from the query.
+// Returns a *NotFoundError when no TrashedEntity was found.
+func (teq *TrashedEntityQuery) First(ctx context.Context) (*TrashedEntity, error) {
+	nodes, err := teq.Limit(1).All(setContextOp(ctx, teq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{trashedentity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TrashedEntityQuery) FirstX(ctx context.Context) *TrashedEntity {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TrashedEntity ID from the query.
+// Returns a *NotFoundError when no TrashedEntity ID was found.
+func (teq *TrashedEntityQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = teq.Limit(1).IDs(setContextOp(ctx, teq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{trashedentity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TrashedEntityQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TrashedEntity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TrashedEntity entity is found.
+// Returns a *NotFoundError when no TrashedEntity entities are found.
+func (teq *TrashedEntityQuery) Only(ctx context.Context) (*TrashedEntity, error) {
+	nodes, err := teq.Limit(2).All(setContextOp(ctx, teq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{trashedentity.Label}
+	default:
+		return nil, &NotSingularError{trashedentity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TrashedEntityQuery) OnlyX(ctx context.Context) *TrashedEntity {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TrashedEntity ID in the query.
+// Returns a *NotSingularError when more than one TrashedEntity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TrashedEntityQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = teq.Limit(2).IDs(setContextOp(ctx, teq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{trashedentity.Label}
+	default:
+		err = &NotSingularError{trashedentity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TrashedEntityQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TrashedEntities.
+func (teq *TrashedEntityQuery) All(ctx context.Context) ([]*TrashedEntity, error) {
+	ctx = setContextOp(ctx, teq.ctx, "All")
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TrashedEntity, *TrashedEntityQuery]()
+	return withInterceptors[[]*TrashedEntity](ctx, teq, qr, teq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TrashedEntityQuery) AllX(ctx context.Context) []*TrashedEntity {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TrashedEntity IDs.
+func (teq *TrashedEntityQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if teq.ctx.Unique == nil && teq.path != nil {
+		teq.Unique(true)
+	}
+	ctx = setContextOp(ctx, teq.ctx, "IDs")
+	if err = teq.Select(trashedentity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TrashedEntityQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TrashedEntityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, teq.ctx, "Count")
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, teq, querierCount[*TrashedEntityQuery](), teq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TrashedEntityQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TrashedEntityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, teq.ctx, "Exist")
+	switch _, err := teq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TrashedEntityQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TrashedEntityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TrashedEntityQuery) Clone() *TrashedEntityQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TrashedEntityQuery{
+		config:     teq.config,
+		ctx:        teq.ctx.Clone(),
+		order:      append([]trashedentity.OrderOption{}, teq.order...),
+		inters:     append([]Interceptor{}, teq.inters...),
+		predicates: append([]predicate.TrashedEntity{}, teq.predicates...),
+		// clone intermediate query.
+		sql:  teq.sql.Clone(),
+		path: teq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProjectUUID string `json:"project_uuid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TrashedEntity.Query().
+//		GroupBy(trashedentity.FieldProjectUUID).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (teq *TrashedEntityQuery) GroupBy(field string, fields ...string) *TrashedEntityGroupBy {
+	teq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TrashedEntityGroupBy{build: teq}
+	grbuild.flds = &teq.ctx.Fields
+	grbuild.label = trashedentity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProjectUUID string `json:"project_uuid,omitempty"`
+//	}
+//
+//	client.TrashedEntity.Query().
+//		Select(trashedentity.FieldProjectUUID).
+//		Scan(ctx, &v)
+func (teq *TrashedEntityQuery) Select(fields ...string) *TrashedEntitySelect {
+	teq.ctx.Fields = append(teq.ctx.Fields, fields...)
+	sbuild := &TrashedEntitySelect{TrashedEntityQuery: teq}
+	sbuild.label = trashedentity.Label
+	sbuild.flds, sbuild.scan = &teq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TrashedEntitySelect configured with the given aggregations.
+func (teq *TrashedEntityQuery) Aggregate(fns ...AggregateFunc) *TrashedEntitySelect {
+	return teq.Select().Aggregate(fns...)
+}
+
+func (teq *TrashedEntityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range teq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, teq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range teq.ctx.Fields {
+		if !trashedentity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	return nil
+}
+
+func (teq *TrashedEntityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TrashedEntity, error) {
+	var (
+		nodes = []*TrashedEntity{}
+		_spec = teq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TrashedEntity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TrashedEntity{config: teq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (teq *TrashedEntityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	_spec.Node.Columns = teq.ctx.Fields
+	if len(teq.ctx.Fields) > 0 {
+		_spec.Unique = teq.ctx.Unique != nil && *teq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TrashedEntityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(trashedentity.Table, trashedentity.Columns, sqlgraph.NewFieldSpec(trashedentity.FieldID, field.TypeUint64))
+	_spec.From = teq.sql
+	if unique := teq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if teq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := teq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trashedentity.FieldID)
+		for i := range fields {
+			if fields[i] != trashedentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TrashedEntityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(trashedentity.Table)
+	columns := teq.ctx.Fields
+	if len(columns) == 0 {
+		columns = trashedentity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if teq.ctx.Unique != nil && *teq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TrashedEntityGroupBy is the group-by builder for TrashedEntity entities.
+type TrashedEntityGroupBy struct {
+	selector
+	build *TrashedEntityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TrashedEntityGroupBy) Aggregate(fns ...AggregateFunc) *TrashedEntityGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tegb *TrashedEntityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tegb.build.ctx, "GroupBy")
+	if err := tegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrashedEntityQuery, *TrashedEntityGroupBy](ctx, tegb.build, tegb, tegb.build.inters, v)
+}
+
+func (tegb *TrashedEntityGroupBy) sqlScan(ctx context.Context, root *TrashedEntityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tegb.flds)+len(tegb.fns))
+		for _, f := range *tegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TrashedEntitySelect is the builder for selecting fields of TrashedEntity entities.
+type TrashedEntitySelect struct {
+	*TrashedEntityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tes *TrashedEntitySelect) Aggregate(fns ...AggregateFunc) *TrashedEntitySelect {
+	tes.fns = append(tes.fns, fns...)
+	return tes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TrashedEntitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tes.ctx, "Select")
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrashedEntityQuery, *TrashedEntitySelect](ctx, tes.TrashedEntityQuery, tes, tes.inters, v)
+}
+
+func (tes *TrashedEntitySelect) sqlScan(ctx context.Context, root *TrashedEntityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tes.fns))
+	for _, fn := range tes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+)
+
+// TrashedEntityUpdate is the builder for updating TrashedEntity entities.
+type TrashedEntityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrashedEntityMutation
+}
+
+// Where appends a list predicates to the TrashedEntityUpdate builder.
+func (teu *TrashedEntityUpdate) Where(ps ...predicate.TrashedEntity) *TrashedEntityUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetSecret sets the "secret" field.
+func (teu *TrashedEntityUpdate) SetSecret(s string) *TrashedEntityUpdate {
+	teu.mutation.SetSecret(s)
+	return teu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (teu *TrashedEntityUpdate) SetNillableSecret(s *string) *TrashedEntityUpdate {
+	if s != nil {
+		teu.SetSecret(*s)
+	}
+	return teu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (teu *TrashedEntityUpdate) ClearSecret() *TrashedEntityUpdate {
+	teu.mutation.ClearSecret()
+	return teu
+}
+
+// Mutation returns the TrashedEntityMutation object of the builder.
+func (teu *TrashedEntityUpdate) Mutation() *TrashedEntityMutation {
+	return teu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TrashedEntityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, teu.sqlSave, teu.mutation, teu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TrashedEntityUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TrashedEntityUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TrashedEntityUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (teu *TrashedEntityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(trashedentity.Table, trashedentity.Columns, sqlgraph.NewFieldSpec(trashedentity.FieldID, field.TypeUint64))
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.Secret(); ok {
+		_spec.SetField(trashedentity.FieldSecret, field.TypeString, value)
+	}
+	if teu.mutation.SecretCleared() {
+		_spec.ClearField(trashedentity.FieldSecret, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trashedentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	teu.mutation.done = true
+	return n, nil
+}
+
+// TrashedEntityUpdateOne is the builder for updating a single TrashedEntity entity.
+type TrashedEntityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrashedEntityMutation
+}
+
+// SetSecret sets the "secret" field.
+func (teuo *TrashedEntityUpdateOne) SetSecret(s string) *TrashedEntityUpdateOne {
+	teuo.mutation.SetSecret(s)
+	return teuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (teuo *TrashedEntityUpdateOne) SetNillableSecret(s *string) *TrashedEntityUpdateOne {
+	if s != nil {
+		teuo.SetSecret(*s)
+	}
+	return teuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (teuo *TrashedEntityUpdateOne) ClearSecret() *TrashedEntityUpdateOne {
+	teuo.mutation.ClearSecret()
+	return teuo
+}
+
+// Mutation returns the TrashedEntityMutation object of the builder.
+func (teuo *TrashedEntityUpdateOne) Mutation() *TrashedEntityMutation {
+	return teuo.mutation
+}
+
+// Where appends a list predicates to the TrashedEntityUpdate builder.
+func (teuo *TrashedEntityUpdateOne) Where(ps ...predicate.TrashedEntity) *TrashedEntityUpdateOne {
+	teuo.mutation.Where(ps...)
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TrashedEntityUpdateOne) Select(field string, fields ...string) *TrashedEntityUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TrashedEntity entity.
+func (teuo *TrashedEntityUpdateOne) Save(ctx context.Context) (*TrashedEntity, error) {
+	return withHooks(ctx, teuo.sqlSave, teuo.mutation, teuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TrashedEntityUpdateOne) SaveX(ctx context.Context) *TrashedEntity {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TrashedEntityUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TrashedEntityUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (teuo *TrashedEntityUpdateOne) sqlSave(ctx context.Context) (_node *TrashedEntity, err error) {
+	_spec := sqlgraph.NewUpdateSpec(trashedentity.Table, trashedentity.Columns, sqlgraph.NewFieldSpec(trashedentity.FieldID, field.TypeUint64))
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TrashedEntity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trashedentity.FieldID)
+		for _, f := range fields {
+			if !trashedentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != trashedentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.Secret(); ok {
+		_spec.SetField(trashedentity.FieldSecret, field.TypeString, value)
+	}
+	if teuo.mutation.SecretCleared() {
+		_spec.ClearField(trashedentity.FieldSecret, field.TypeString)
+	}
+	_node = &TrashedEntity{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trashedentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	teuo.mutation.done = true
+	return _node, nil
+}
@@ -22,6 +22,8 @@ type Tx struct {
 	Artifact *ArtifactClient
 	// ArtifactReference is the client for interacting with the ArtifactReference builders.
 	ArtifactReference *ArtifactReferenceClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// CommonMixin is the client for interacting with the CommonMixin builders.
 	CommonMixin *CommonMixinClient
 	// DeploymentPackage is the client for interacting with the DeploymentPackage builders.
@@ -40,12 +42,22 @@ type Tx struct {
 	Namespace *NamespaceClient
 	// NamespaceAdornment is the client for interacting with the NamespaceAdornment builders.
 	NamespaceAdornment *NamespaceAdornmentClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// ParameterTemplate is the client for interacting with the ParameterTemplate builders.
 	ParameterTemplate *ParameterTemplateClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
 	// Registry is the client for interacting with the Registry builders.
 	Registry *RegistryClient
+	// Revision is the client for interacting with the Revision builders.
+	Revision *RevisionClient
+	// TrashedEntity is the client for interacting with the TrashedEntity builders.
+	TrashedEntity *TrashedEntityClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -182,6 +194,7 @@ func (tx *Tx) init() {
 	tx.ApplicationNamespace = NewApplicationNamespaceClient(tx.config)
 	tx.Artifact = NewArtifactClient(tx.config)
 	tx.ArtifactReference = NewArtifactReferenceClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.CommonMixin = NewCommonMixinClient(tx.config)
 	tx.DeploymentPackage = NewDeploymentPackageClient(tx.config)
 	tx.DeploymentProfile = NewDeploymentProfileClient(tx.config)
@@ -191,9 +204,14 @@ func (tx *Tx) init() {
 	tx.IgnoredResource = NewIgnoredResourceClient(tx.config)
 	tx.Namespace = NewNamespaceClient(tx.config)
 	tx.NamespaceAdornment = NewNamespaceAdornmentClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.ParameterTemplate = NewParameterTemplateClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
 	tx.Registry = NewRegistryClient(tx.config)
+	tx.Revision = NewRevisionClient(tx.config)
+	tx.TrashedEntity = NewTrashedEntityClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
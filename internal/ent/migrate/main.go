@@ -11,10 +11,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/migrate"
 
 	atlas "ariga.io/atlas/sql/migrate"
+	atlasschema "ariga.io/atlas/sql/schema"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql/schema"
 	_ "github.com/lib/pq"
@@ -33,6 +36,7 @@ func main() {
 		schema.WithMigrationMode(schema.ModeReplay), // provide migration mode
 		schema.WithDialect(dialect.Postgres),        // Ent dialect to use
 		schema.WithFormatter(atlas.DefaultFormatter),
+		schema.WithDiffHook(keepSearchVectors),
 	}
 	if len(os.Args) != 3 {
 		log.Fatalln("migration name is required. Use: 'go run -mod=mod ent/migrate/main.go <name>'")
@@ -45,3 +49,39 @@ func main() {
 		log.Fatalf("failed generating migration file: %v", err)
 	}
 }
+
+// Name of the search vector columns, which the database generates from the searchable columns of the applications,
+// deployment packages and registries. The ent schema cannot declare generated columns, so they are declared by the
+// migrations alone, along with their GIN indexes.
+const searchVectorColumn = "search_vector"
+
+// Keeps the diff from dropping the search vector columns and their indexes, which are missing from the ent schema.
+func keepSearchVectors(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlasschema.Schema) ([]atlasschema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		kept := make([]atlasschema.Change, 0, len(changes))
+		for _, change := range changes {
+			if modify, ok := change.(*atlasschema.ModifyTable); ok {
+				modify.Changes = slices.DeleteFunc(modify.Changes, isSearchVectorDrop)
+				if len(modify.Changes) == 0 {
+					continue
+				}
+			}
+			kept = append(kept, change)
+		}
+		return kept, nil
+	})
+}
+
+func isSearchVectorDrop(change atlasschema.Change) bool {
+	switch c := change.(type) {
+	case *atlasschema.DropColumn:
+		return c.C.Name == searchVectorColumn
+	case *atlasschema.DropIndex:
+		return strings.HasSuffix(c.I.Name, "_"+searchVectorColumn)
+	}
+	return false
+}
@@ -0,0 +1,4 @@
+-- Create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "project_uuid" character varying NOT NULL, "resource_type" character varying NOT NULL, "event_type" character varying NOT NULL, "payload" bytea NOT NULL, "create_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "outboxevent_resource_type_project_uuid" to table: "outbox_events"
+CREATE INDEX "outboxevent_resource_type_project_uuid" ON "outbox_events" ("resource_type", "project_uuid");
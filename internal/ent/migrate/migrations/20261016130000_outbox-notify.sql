@@ -0,0 +1,11 @@
+-- Modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "origin" character varying NOT NULL DEFAULT '';
+-- Create "notify_outbox_event" function
+CREATE FUNCTION "notify_outbox_event"() RETURNS trigger AS $$
+BEGIN
+  PERFORM pg_notify('catalog_events', NEW."origin");
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- Create trigger "outbox_events_notify" on table: "outbox_events"
+CREATE TRIGGER "outbox_events_notify" AFTER INSERT ON "outbox_events" FOR EACH ROW EXECUTE FUNCTION "notify_outbox_event"();
@@ -0,0 +1,8 @@
+-- Create "webhooks" table
+CREATE TABLE "webhooks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "project_uuid" character varying NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "url" character varying NOT NULL, "resource_types" jsonb NULL, "event_types" jsonb NULL, "secret" character varying NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "webhook_project_uuid_name" to table: "webhooks"
+CREATE UNIQUE INDEX "webhook_project_uuid_name" ON "webhooks" ("project_uuid", "name");
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "revision" bigint NOT NULL, "resource_type" character varying NOT NULL, "resource_name" character varying NOT NULL, "event_type" character varying NOT NULL, "payload" bytea NOT NULL, "state" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "response_code" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "webhook_deliveries" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_deliveries") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "webhookdelivery_revision_webhook_deliveries" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_revision_webhook_deliveries" ON "webhook_deliveries" ("revision", "webhook_deliveries");
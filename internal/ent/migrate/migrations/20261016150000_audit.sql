@@ -0,0 +1,6 @@
+-- Create "audit_events" table
+CREATE TABLE "audit_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "project_uuid" character varying NOT NULL, "user_name" character varying NOT NULL DEFAULT '', "client" character varying NOT NULL DEFAULT '', "method" character varying NOT NULL DEFAULT '', "resource_type" character varying NOT NULL, "resource_name" character varying NOT NULL, "resource_version" character varying NOT NULL DEFAULT '', "action" character varying NOT NULL, "changed_fields" jsonb NULL, "before" text NOT NULL DEFAULT '', "after" text NOT NULL DEFAULT '', "create_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "auditevent_project_uuid_resource_type_resource_name" to table: "audit_events"
+CREATE INDEX "auditevent_project_uuid_resource_type_resource_name" ON "audit_events" ("project_uuid", "resource_type", "resource_name");
+-- Create index "auditevent_create_time" to table: "audit_events"
+CREATE INDEX "auditevent_create_time" ON "audit_events" ("create_time");
@@ -0,0 +1,4 @@
+-- Create "revisions" table
+CREATE TABLE "revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "project_uuid" character varying NOT NULL, "resource_type" character varying NOT NULL, "name" character varying NOT NULL, "version" character varying NOT NULL, "revision" bigint NOT NULL, "user_name" character varying NOT NULL DEFAULT '', "snapshot" text NOT NULL, "create_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "revision_project_uuid_resource_type_name_version_revision" to table: "revisions"
+CREATE UNIQUE INDEX "revision_project_uuid_resource_type_name_version_revision" ON "revisions" ("project_uuid", "resource_type", "name", "version", "revision");
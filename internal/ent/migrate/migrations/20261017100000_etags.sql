@@ -0,0 +1,13 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "etag" bigint NULL;
+-- Modify "artifacts" table
+ALTER TABLE "artifacts" ADD COLUMN "etag" bigint NULL;
+-- Modify "deployment_packages" table
+ALTER TABLE "deployment_packages" ADD COLUMN "etag" bigint NULL;
+-- Modify "registries" table
+ALTER TABLE "registries" ADD COLUMN "etag" bigint NULL;
+-- Assign an entity tag to the existing entities
+UPDATE "applications" SET "etag" = floor(random() * 9223372036854775807)::bigint;
+UPDATE "artifacts" SET "etag" = floor(random() * 9223372036854775807)::bigint;
+UPDATE "deployment_packages" SET "etag" = floor(random() * 9223372036854775807)::bigint;
+UPDATE "registries" SET "etag" = floor(random() * 9223372036854775807)::bigint;
@@ -0,0 +1,4 @@
+-- Modify "applications" table; the version keys are compared bytewise, regardless of the database collation
+ALTER TABLE "applications" ADD COLUMN "version_key" character varying COLLATE "C" NULL, ADD COLUMN "chart_version_key" character varying COLLATE "C" NULL;
+-- Modify "deployment_packages" table
+ALTER TABLE "deployment_packages" ADD COLUMN "version_key" character varying COLLATE "C" NULL;
@@ -0,0 +1,4 @@
+-- Modify "deployment_packages" table
+ALTER TABLE "deployment_packages" ADD COLUMN "application_constraints" jsonb NULL, ADD COLUMN "pinned_resolution" text NULL;
+-- Modify "deployment_requirements" table
+ALTER TABLE "deployment_requirements" ADD COLUMN "version_constraint" character varying NULL;
@@ -0,0 +1,20 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "search_text" text NULL;
+-- Modify "deployment_packages" table
+ALTER TABLE "deployment_packages" ADD COLUMN "search_text" text NULL;
+-- The search vectors are maintained by the database from the searchable columns, weighted by their relevance; they
+-- are not part of the ent schema, which only reads them in search predicates
+ALTER TABLE "applications" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce("name", '') || ' ' || coalesce("display_name", '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce("description", '') || ' ' || coalesce("chart_name", '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce("search_text", '')), 'C')) STORED;
+CREATE INDEX "application_search_vector" ON "applications" USING gin ("search_vector");
+ALTER TABLE "deployment_packages" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce("name", '') || ' ' || coalesce("display_name", '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce("description", '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce("search_text", '')), 'C')) STORED;
+CREATE INDEX "deploymentpackage_search_vector" ON "deployment_packages" USING gin ("search_vector");
+ALTER TABLE "registries" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce("name", '') || ' ' || coalesce("display_name", '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce("description", '')), 'B')) STORED;
+CREATE INDEX "registry_search_vector" ON "registries" USING gin ("search_vector");
@@ -0,0 +1,12 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "labels" jsonb NULL;
+-- Create index "application_labels" to table: "applications"
+CREATE INDEX "application_labels" ON "applications" USING GIN ("labels");
+-- Modify "deployment_packages" table
+ALTER TABLE "deployment_packages" ADD COLUMN "labels" jsonb NULL;
+-- Create index "deploymentpackage_labels" to table: "deployment_packages"
+CREATE INDEX "deploymentpackage_labels" ON "deployment_packages" USING GIN ("labels");
+-- Modify "registries" table
+ALTER TABLE "registries" ADD COLUMN "labels" jsonb NULL;
+-- Create index "registry_labels" to table: "registries"
+CREATE INDEX "registry_labels" ON "registries" USING GIN ("labels");
@@ -0,0 +1,4 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "deprecated" boolean NOT NULL DEFAULT false, ADD COLUMN "deprecation_message" character varying NULL, ADD COLUMN "replacement_version" character varying NULL, ADD COLUMN "deprecate_time" timestamptz NULL;
+-- Modify "deployment_packages" table
+ALTER TABLE "deployment_packages" ADD COLUMN "deprecated" boolean NOT NULL DEFAULT false, ADD COLUMN "deprecation_message" character varying NULL, ADD COLUMN "replacement_version" character varying NULL, ADD COLUMN "deprecate_time" timestamptz NULL;
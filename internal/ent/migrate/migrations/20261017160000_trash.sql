@@ -0,0 +1,6 @@
+-- Create "trashed_entities" table
+CREATE TABLE "trashed_entities" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "project_uuid" character varying NOT NULL, "resource_type" character varying NOT NULL, "name" character varying NOT NULL, "version" character varying NOT NULL DEFAULT '', "user_name" character varying NOT NULL DEFAULT '', "snapshot" text NOT NULL, "secret" text NULL, "delete_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "trashedentity_project_uuid_resource_type_name_version" to table: "trashed_entities"
+CREATE UNIQUE INDEX "trashedentity_project_uuid_resource_type_name_version" ON "trashed_entities" ("project_uuid", "resource_type", "name", "version");
+-- Create index "trashedentity_delete_time" to table: "trashed_entities"
+CREATE INDEX "trashedentity_delete_time" ON "trashed_entities" ("delete_time");
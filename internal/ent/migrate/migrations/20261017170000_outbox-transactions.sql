@@ -0,0 +1,4 @@
+-- Modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "tx_id" bigint NULL DEFAULT pg_current_xact_id()::text::bigint, ADD COLUMN "tx_xmin" bigint NULL DEFAULT pg_snapshot_xmin(pg_current_snapshot())::text::bigint;
+-- Create index "outboxevent_tx_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_tx_id" ON "outbox_events" ("tx_id");
@@ -6,6 +6,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -21,6 +22,7 @@ type Application struct {
 func (Application) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
+		ETagMixin{},
 	}
 }
 
@@ -36,9 +38,36 @@ func (Application) Fields() []ent.Field {
 			Comment("A chart name."),
 		field.String("chart_version").
 			Comment("A chart version."),
+		field.String("version_key").
+			Comment("Form of the version that orders semantic versions by precedence when compared bytewise.").
+			Optional().
+			Annotations(entsql.Annotation{Collation: "C"}),
+		field.String("chart_version_key").
+			Comment("Form of the chart version that orders semantic versions by precedence when compared bytewise.").
+			Optional().
+			Annotations(entsql.Annotation{Collation: "C"}),
 		field.String("kind").
 			Comment("Application kind; normal, addon, extension.").
 			Optional(),
+		field.Text("search_text").
+			Comment("Descriptions of the profiles, by which the application is searched along with its own columns.").
+			Optional(),
+		field.JSON("labels", map[string]string{}).
+			Comment("Labels of the Application, by which it is selected.").
+			Optional(),
+		field.Bool("deprecated").
+			Comment("Indicates whether the Application version is deprecated.").
+			Default(false),
+		field.String("deprecation_message").
+			Comment("Message explaining why the Application version is deprecated.").
+			Optional(),
+		field.String("replacement_version").
+			Comment("Version suggested to be used in place of the deprecated one.").
+			Optional(),
+		field.Time("deprecate_time").
+			Comment("The time at which the Application version was deprecated.").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -82,5 +111,7 @@ func (Application) Edges() []ent.Edge {
 func (Application) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("project_uuid", "name", "version").Unique(),
+		index.Fields("labels").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
 	}
 }
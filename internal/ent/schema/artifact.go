@@ -20,6 +20,7 @@ type Artifact struct {
 func (Artifact) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
+		ETagMixin{},
 	}
 }
 
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent table; records a single change made to a catalog entity.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields audit event columns
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("project_uuid").
+			Comment("UUID of the project to which the changed entity belongs."),
+		field.String("user_name").
+			Default("").
+			Comment("Name of the user who made the change."),
+		field.String("client").
+			Default("").
+			Comment("Client through which the change was made."),
+		field.String("method").
+			Default("").
+			Comment("Name of the RPC that made the change."),
+		field.String("resource_type").
+			Comment("Type of the changed entity, e.g. registry or deployment-package."),
+		field.String("resource_name").
+			Comment("Name of the changed entity."),
+		field.String("resource_version").
+			Default("").
+			Comment("Version of the changed entity, if it is versioned."),
+		field.String("action").
+			Comment("The change made, i.e. created, updated or deleted."),
+		field.Strings("changed_fields").
+			Optional().
+			Comment("Names of the fields that changed."),
+		field.Text("before").
+			Default("").
+			Comment("State of the entity before the change, as JSON."),
+		field.Text("after").
+			Default("").
+			Comment("State of the entity after the change, as JSON."),
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable().
+			Comment("The time of the change."),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_uuid", "resource_type", "resource_name"),
+		index.Fields("create_time"),
+	}
+}
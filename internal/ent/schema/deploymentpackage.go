@@ -6,6 +6,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -21,6 +22,7 @@ type DeploymentPackage struct {
 func (DeploymentPackage) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
+		ETagMixin{},
 	}
 }
 
@@ -32,6 +34,10 @@ func (DeploymentPackage) Fields() []ent.Field {
 			Default("default"),
 		field.String("version").
 			Comment("Version of the Deployment Package. Used in combination with the name to identify a unique Deployment Package within the catalog."),
+		field.String("version_key").
+			Comment("Form of the version that orders semantic versions by precedence when compared bytewise.").
+			Optional().
+			Annotations(entsql.Annotation{Collation: "C"}),
 		field.Bool("is_deployed").
 			Comment("Indicates whether Deployment Package is deployed and available. Cannot be deleted while true").
 			Optional(),
@@ -44,6 +50,31 @@ func (DeploymentPackage) Fields() []ent.Field {
 		field.String("kind").
 			Comment("Deployment package kind; normal, addon, extension").
 			Optional(),
+		field.JSON("application_constraints", map[string]string{}).
+			Comment("Version constraints of the referenced applications, such as latest or ~1.4, keyed by application name.").
+			Optional(),
+		field.Text("search_text").
+			Comment("Labels of the UI extensions, by which the Deployment Package is searched along with its own columns.").
+			Optional(),
+		field.Text("pinned_resolution").
+			Comment("Application versions and deployment requirements the constraints resolved to when the Deployment Package was deployed, as JSON.").
+			Optional(),
+		field.JSON("labels", map[string]string{}).
+			Comment("Labels of the Deployment Package, by which it is selected.").
+			Optional(),
+		field.Bool("deprecated").
+			Comment("Indicates whether the Deployment Package version is deprecated.").
+			Default(false),
+		field.String("deprecation_message").
+			Comment("Message explaining why the Deployment Package version is deprecated.").
+			Optional(),
+		field.String("replacement_version").
+			Comment("Version suggested to be used in place of the deprecated one.").
+			Optional(),
+		field.Time("deprecate_time").
+			Comment("The time at which the Deployment Package version was deprecated.").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -95,5 +126,7 @@ func (DeploymentPackage) Edges() []ent.Edge {
 func (DeploymentPackage) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("project_uuid", "name", "version").Unique(),
+		index.Fields("labels").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
 	}
 }
@@ -7,6 +7,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 )
 
 // DeploymentRequirement table.
@@ -14,6 +15,15 @@ type DeploymentRequirement struct {
 	ent.Schema
 }
 
+// Fields DeploymentRequirement columns
+func (DeploymentRequirement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("version_constraint").
+			Comment("Version constraint of the required Deployment Package, such as latest or ^2.0, if not an exact version.").
+			Optional(),
+	}
+}
+
 // Edges DeploymentRequirement relations
 func (DeploymentRequirement) Edges() []ent.Edge {
 	return []ent.Edge{
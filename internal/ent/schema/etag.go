@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ETagMixin adds an entity tag, which changes whenever the entity is updated, for optimistic concurrency control.
+type ETagMixin struct{ mixin.Schema }
+
+// Fields entity tag column
+func (ETagMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("etag").
+			Optional().
+			DefaultFunc(newETag).
+			UpdateDefault(newETag).
+			Comment("Opaque entity tag; changes whenever the entity is updated."),
+	}
+}
+
+// Returns a new random, non-negative entity tag.
+func newETag() int64 {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return int64(binary.BigEndian.Uint64(b) >> 1)
+}
+
+// Ensure ETagMixin implements the `Mixin` interface.
+var _ ent.Mixin = (*ETagMixin)(nil)
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent table; the ID of each event serves as its revision.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields outbox event columns
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("project_uuid").
+			Comment("UUID of the project to which the subject of the event belongs."),
+		field.String("resource_type").
+			Comment("Type of the subject of the event, e.g. registry or deployment-package."),
+		field.String("event_type").
+			Comment("Type of the event, i.e. created, updated or deleted."),
+		field.Bytes("payload").
+			Comment("Watch response carrying the event, serialized as a protobuf message."),
+		field.String("origin").
+			Comment("ID of the catalog replica that recorded the event.").
+			Default(""),
+		field.Uint64("tx_id").
+			Comment("ID of the transaction that recorded the event, assigned by the database.").
+			Optional().
+			Immutable().
+			Annotations(entsql.DefaultExprs(map[string]string{
+				dialect.Postgres: "pg_current_xact_id()::text::bigint",
+			})),
+		field.Uint64("tx_xmin").
+			Comment("ID of the oldest transaction in progress when the event was recorded, assigned by the database.").
+			Optional().
+			Immutable().
+			Annotations(entsql.DefaultExprs(map[string]string{
+				dialect.Postgres: "pg_snapshot_xmin(pg_current_snapshot())::text::bigint",
+			})),
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable().
+			Comment("The creation timestamp."),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_type", "project_uuid"),
+		index.Fields("tx_id"),
+	}
+}
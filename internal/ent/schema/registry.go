@@ -6,6 +6,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,6 +22,7 @@ type Registry struct {
 func (Registry) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
+		ETagMixin{},
 	}
 }
 
@@ -37,6 +40,9 @@ func (Registry) Fields() []ent.Field {
 		field.String("api_type").
 			Comment("Registry API type.").
 			Optional(),
+		field.JSON("labels", map[string]string{}).
+			Comment("Labels of the Registry, by which it is selected.").
+			Optional(),
 	}
 }
 
@@ -53,5 +59,7 @@ func (Registry) Edges() []ent.Edge {
 func (Registry) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("project_uuid", "name").Unique(),
+		index.Fields("labels").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
 	}
 }
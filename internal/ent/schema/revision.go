@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Revision table; holds an immutable snapshot of an application or a deployment package.
+type Revision struct {
+	ent.Schema
+}
+
+// Fields revision columns
+func (Revision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("project_uuid").
+			Immutable().
+			Comment("UUID of the project to which the entity belongs."),
+		field.String("resource_type").
+			Immutable().
+			Comment("Type of the entity, i.e. application or deployment-package."),
+		field.String("name").
+			Immutable().
+			Comment("Name of the entity."),
+		field.String("version").
+			Immutable().
+			Comment("Version of the entity."),
+		field.Uint32("revision").
+			Immutable().
+			Comment("Number of the revision; revisions of each entity are numbered sequentially, starting with 1."),
+		field.String("user_name").
+			Default("").
+			Immutable().
+			Comment("Name of the user whose change produced the revision."),
+		field.Text("snapshot").
+			Immutable().
+			Comment("State of the entity at the revision, as JSON."),
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the revision was recorded."),
+	}
+}
+
+func (Revision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_uuid", "resource_type", "name", "version", "revision").Unique(),
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TrashedEntity table; holds a snapshot of a deleted registry, application or deployment package until it is
+// restored or purged.
+type TrashedEntity struct {
+	ent.Schema
+}
+
+// Fields trashed entity columns
+func (TrashedEntity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("project_uuid").
+			Immutable().
+			Comment("UUID of the project to which the entity belonged."),
+		field.String("resource_type").
+			Immutable().
+			Comment("Type of the entity, i.e. registry, application or deployment-package."),
+		field.String("name").
+			Immutable().
+			Comment("Name of the entity."),
+		field.String("version").
+			Default("").
+			Immutable().
+			Comment("Version of the entity; empty for registries."),
+		field.String("user_name").
+			Default("").
+			Immutable().
+			Comment("Name of the user who deleted the entity."),
+		field.Text("snapshot").
+			Immutable().
+			Comment("State of the entity when it was deleted, as JSON; registries are redacted."),
+		field.Text("secret").
+			Optional().
+			Sensitive().
+			Comment("Encoded, and possibly encrypted, secret data of a registry whose secrets were kept in the database."),
+		field.Time("delete_time").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the entity was deleted."),
+	}
+}
+
+func (TrashedEntity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_uuid", "resource_type", "name", "version").Unique(),
+		index.Fields("delete_time"),
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Webhook table
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields webhook columns
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("project_uuid").
+			Comment("UUID of the owner project."),
+		field.String("name").
+			Comment("A unique name. Used in HTTP network paths."),
+		field.String("description").
+			Optional().
+			Comment("A description. Displayed on user interfaces."),
+		field.String("url").
+			Comment("URL of the endpoint to which the events are delivered."),
+		field.Strings("resource_types").
+			Optional().
+			Comment("Types of entities whose events are delivered; all types if empty."),
+		field.Strings("event_types").
+			Optional().
+			Comment("Types of events delivered; all types if empty."),
+		field.String("secret").
+			Sensitive().
+			Comment("Secret shared with the endpoint, used for signing the deliveries; sealed in an envelope if secrets are encrypted."),
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable().
+			Comment("The creation timestamp."),
+		field.Time("update_time").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("The last update timestamp."),
+	}
+}
+
+// Edges webhook relations
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}).
+			Comment("Log of the deliveries made to the webhook"),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_uuid", "name").Unique(),
+	}
+}
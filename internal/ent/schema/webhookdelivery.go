@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery table; records the delivery of a single event to a webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields webhook delivery columns
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("revision").
+			Comment("Revision of the delivered event in the outbox."),
+		field.String("resource_type").
+			Comment("Type of the subject of the event, e.g. application or deployment-package."),
+		field.String("resource_name").
+			Comment("Name of the subject of the event."),
+		field.String("event_type").
+			Comment("Type of the event, i.e. created, updated or deleted."),
+		field.Bytes("payload").
+			Comment("Body of the delivery request."),
+		field.String("state").
+			Default("pending").
+			Comment("State of the delivery, i.e. pending, delivered or failed."),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of delivery attempts made so far."),
+		field.Int("response_code").
+			Default(0).
+			Comment("HTTP status code of the response to the last attempt."),
+		field.String("last_error").
+			Default("").
+			Comment("Error encountered by the last attempt."),
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable().
+			Comment("The creation timestamp."),
+		field.Time("update_time").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("The last update timestamp."),
+	}
+}
+
+// Edges webhook delivery relations
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required().
+			Comment("Delivery must belong to a webhook"),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// Each event is delivered to a webhook only once, regardless of how many replicas received it
+		index.Fields("revision").Edges("webhook").Unique(),
+	}
+}
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// Outcomes of a registry connection check.
+const (
+	ConnectionOK          = "OK"
+	ConnectionUnreachable = "UNREACHABLE"
+	ConnectionTLSError    = "TLS_ERROR"
+	ConnectionAuthFailed  = "AUTH_FAILED"
+	ConnectionError       = "ERROR"
+)
+
+// Flavours of the registry API detected by a successful connection check.
+const (
+	APITypeOCI    = "oci"
+	APITypeHarbor = "harbor"
+	APITypeHelm   = "helm"
+)
+
+// Connection describes the outcome of checking the connection to a registry.
+type Connection struct {
+	Status  string /* One of the Connection* outcomes */
+	Message string /* Details of the failure, if any */
+	APIType string /* Flavour of the registry API, if the check succeeded */
+}
+
+// RegistryAccess holds what is needed to reach and authenticate against a registry.
+type RegistryAccess struct {
+	RootURL  string /* Root URL of the registry; https is assumed if it has no scheme */
+	Username string /* Optional username */
+	Password string /* Optional password, or an access token if there is no username */
+	CACerts  string /* Optional PEM encoded CA certificates to trust instead of the system ones */
+
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error) /* Optional dialer, e.g. one refusing some destinations; bypasses proxies */
+}
+
+// CheckChartRegistry checks the connection to an OCI registry holding Helm charts by pinging it through oras. Registries
+// at http:// or https:// URLs which do not implement the OCI distribution API are checked as classic Helm repositories.
+func CheckChartRegistry(ctx context.Context, access RegistryAccess) Connection {
+	endpoint, _, err := parseRegistryURL(access.RootURL)
+	if err != nil {
+		return Connection{Status: ConnectionError, Message: err.Error()}
+	}
+	httpClient, err := newHTTPClient(access)
+	if err != nil {
+		return Connection{Status: ConnectionTLSError, Message: err.Error()}
+	}
+
+	oc, err := newOrasClient(endpoint, httpClient, access)
+	if err != nil {
+		return Connection{Status: ConnectionError, Message: err.Error()}
+	}
+	if err = oc.Ping(ctx); errors.Is(err, errdef.ErrNotFound) && !strings.HasPrefix(access.RootURL, "oci://") {
+		var errResp *errcode.ErrorResponse
+		if _, err = FetchIndex(ctx, access); errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotFound {
+			return Connection{Status: ConnectionError, Message: "registry implements neither the OCI distribution API nor a Helm repository index"}
+		} else if err != nil {
+			return connectionFailure(err)
+		}
+		return Connection{Status: ConnectionOK, APIType: APITypeHelm}
+	} else if err != nil {
+		return connectionFailure(err)
+	}
+	return Connection{Status: ConnectionOK, APIType: detectAPIType(ctx, httpClient, endpoint)}
+}
+
+// CheckIndexRepository checks the connection to a classic Helm repository by fetching its index.
+func CheckIndexRepository(ctx context.Context, access RegistryAccess) Connection {
+	if _, err := FetchIndex(ctx, access); err != nil {
+		return connectionFailure(err)
+	}
+	return Connection{Status: ConnectionOK, APIType: APITypeHelm}
+}
+
+// CheckImageRegistry checks the connection to a registry holding images by probing the /v2/ base endpoint of the
+// OCI distribution API, answering any Basic or Bearer authentication challenge with the given credentials.
+func CheckImageRegistry(ctx context.Context, access RegistryAccess) Connection {
+	endpoint, _, err := parseRegistryURL(access.RootURL)
+	if err != nil {
+		return Connection{Status: ConnectionError, Message: err.Error()}
+	}
+	httpClient, err := newHTTPClient(access)
+	if err != nil {
+		return Connection{Status: ConnectionTLSError, Message: err.Error()}
+	}
+
+	client := newAuthClient(httpClient, endpoint.Host, access)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.JoinPath("v2/").String(), nil)
+	if err != nil {
+		return Connection{Status: ConnectionError, Message: err.Error()}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return connectionFailure(err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return Connection{Status: ConnectionOK, APIType: detectAPIType(ctx, httpClient, endpoint)}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return Connection{Status: ConnectionAuthFailed, Message: fmt.Sprintf("registry responded with %s", resp.Status)}
+	case http.StatusNotFound:
+		return Connection{Status: ConnectionError, Message: "registry does not implement the OCI distribution API"}
+	default:
+		return Connection{Status: ConnectionError, Message: fmt.Sprintf("registry responded with %s", resp.Status)}
+	}
+}
+
+// Returns the scheme and host of the registry root URL, along with its path; oci:// URLs are accessed over https.
+func parseRegistryURL(rootURL string) (*url.URL, string, error) {
+	if !strings.Contains(rootURL, "://") {
+		rootURL = "https://" + rootURL
+	}
+	parsedURL, err := url.Parse(rootURL)
+	if err != nil {
+		return nil, "", &ParseError{URL: rootURL, Msg: "Failed to parse URL", Err: err}
+	}
+	if parsedURL.Host == "" {
+		return nil, "", &ParseError{URL: rootURL, Msg: "Missing host in URL"}
+	}
+	path := strings.Trim(parsedURL.Path, "/")
+	switch parsedURL.Scheme {
+	case "oci", "https":
+		return &url.URL{Scheme: "https", Host: parsedURL.Host}, path, nil
+	case "http":
+		return &url.URL{Scheme: "http", Host: parsedURL.Host}, path, nil
+	default:
+		return nil, "", &ParseError{URL: rootURL, Msg: "Unsupported scheme in URL"}
+	}
+}
+
+func newHTTPClient(access RegistryAccess) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if access.CACerts != "" {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(access.CACerts)) {
+			return nil, fmt.Errorf("no valid PEM encoded CA certificates found")
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if access.DialContext != nil {
+		transport.Proxy = nil
+		transport.DialContext = access.DialContext
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+func credential(access RegistryAccess) auth.Credential {
+	if access.Username != "" {
+		return auth.Credential{Username: access.Username, Password: access.Password}
+	}
+	return auth.Credential{AccessToken: access.Password}
+}
+
+func newAuthClient(httpClient *http.Client, host string, access RegistryAccess) *auth.Client {
+	return &auth.Client{
+		Client:     httpClient,
+		Header:     auth.DefaultClient.Header,
+		Credential: auth.StaticCredential(host, credential(access)),
+	}
+}
+
+// Returns an oras client for the registry at the given endpoint, authenticating with the given credentials.
+func newOrasClient(endpoint *url.URL, httpClient *http.Client, access RegistryAccess) (OrasClientInterface, error) {
+	oc := &OrasClient{}
+	if err := oc.NewRegistry(endpoint.Host); err != nil {
+		return nil, err
+	}
+	oc.SetHTTPClient(httpClient, endpoint.Scheme == "http")
+	if access.Username != "" && access.Password != "" {
+		oc.SetUsernamePassword(access.Username, access.Password)
+	} else if access.Password != "" {
+		oc.SetAccessToken(access.Password)
+	}
+	return oc, nil
+}
+
+// Harbor registries answer the ping of their own API; any other registry which passed the check
+// implements just the OCI distribution API as far as the catalog is concerned.
+func detectAPIType(ctx context.Context, httpClient *http.Client, endpoint *url.URL) string {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.JoinPath("api/v2.0/ping").String(), nil)
+	if err != nil {
+		return APITypeOCI
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return APITypeOCI
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64))
+	if err == nil && resp.StatusCode == http.StatusOK && strings.TrimSpace(string(body)) == "Pong" {
+		return APITypeHarbor
+	}
+	return APITypeOCI
+}
+
+// FailureStatus returns the outcome of a registry connection check failing with the given error, e.g. that of
+// listing the inventory of a registry.
+func FailureStatus(err error) string {
+	return connectionFailure(err).Status
+}
+
+// Classifies the error of a failed connection attempt.
+func connectionFailure(err error) Connection {
+	var (
+		errResp        *errcode.ErrorResponse
+		verifyErr      *tls.CertificateVerificationError
+		unknownAuthErr x509.UnknownAuthorityError
+		hostnameErr    x509.HostnameError
+		invalidErr     x509.CertificateInvalidError
+		recordErr      tls.RecordHeaderError
+		netErr         net.Error
+	)
+	switch {
+	case errors.As(err, &verifyErr), errors.As(err, &unknownAuthErr), errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr), errors.As(err, &recordErr):
+		return Connection{Status: ConnectionTLSError, Message: err.Error()}
+	case errors.As(err, &errResp):
+		if errResp.StatusCode == http.StatusUnauthorized || errResp.StatusCode == http.StatusForbidden {
+			return Connection{Status: ConnectionAuthFailed, Message: err.Error()}
+		}
+		return Connection{Status: ConnectionError, Message: err.Error()}
+	case errors.Is(err, errdef.ErrNotFound):
+		return Connection{Status: ConnectionError, Message: "registry does not implement the OCI distribution API"}
+	case errors.As(err, &netErr), errors.Is(err, context.DeadlineExceeded):
+		return Connection{Status: ConnectionUnreachable, Message: err.Error()}
+	default:
+		return Connection{Status: ConnectionError, Message: err.Error()}
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Starts a TLS registry accepting the given credentials, either directly through Basic authentication
+// or through a Bearer token obtained from its token endpoint.
+func newTestRegistry(t *testing.T, bearer bool, harbor bool) (*httptest.Server, string) {
+	mux := http.NewServeMux()
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token": "granted"}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if bearer && r.Header.Get("Authorization") == "Bearer granted" {
+			return
+		}
+		if user, password, ok := r.BasicAuth(); !bearer && ok && user == "user" && password == "secret" {
+			return
+		}
+		if bearer {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, srv.URL))
+		} else {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	if harbor {
+		mux.HandleFunc("/api/v2.0/ping", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("Pong"))
+		})
+	}
+	cacerts := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	return srv, cacerts
+}
+
+func TestCheckRegistryConnection(t *testing.T) {
+	ctx := context.Background()
+	for _, check := range []func(context.Context, RegistryAccess) Connection{CheckChartRegistry, CheckImageRegistry} {
+		srv, cacerts := newTestRegistry(t, false, false)
+
+		c := check(ctx, RegistryAccess{RootURL: srv.URL + "/charts", Username: "user", Password: "secret", CACerts: cacerts})
+		assert.Equal(t, ConnectionOK, c.Status, c.Message)
+		assert.Equal(t, APITypeOCI, c.APIType)
+
+		c = check(ctx, RegistryAccess{RootURL: srv.URL, Username: "user", Password: "wrong", CACerts: cacerts})
+		assert.Equal(t, ConnectionAuthFailed, c.Status, c.Message)
+		assert.Empty(t, c.APIType)
+
+		c = check(ctx, RegistryAccess{RootURL: srv.URL, Username: "user", Password: "secret"})
+		assert.Equal(t, ConnectionTLSError, c.Status, c.Message)
+
+		c = check(ctx, RegistryAccess{RootURL: srv.URL, CACerts: "not a certificate"})
+		assert.Equal(t, ConnectionTLSError, c.Status, c.Message)
+
+		c = check(ctx, RegistryAccess{RootURL: "ftp://registry.example.com"})
+		assert.Equal(t, ConnectionError, c.Status, c.Message)
+
+		// Bearer token challenges are answered and Harbor is recognized
+		srv, cacerts = newTestRegistry(t, true, true)
+		c = check(ctx, RegistryAccess{RootURL: "oci://" + srv.Listener.Addr().String(), Username: "user", Password: "secret", CACerts: cacerts})
+		assert.Equal(t, ConnectionOK, c.Status, c.Message)
+		assert.Equal(t, APITypeHarbor, c.APIType)
+
+		c = check(ctx, RegistryAccess{RootURL: srv.URL, Username: "user", Password: "wrong", CACerts: cacerts})
+		assert.Equal(t, ConnectionAuthFailed, c.Status, c.Message)
+
+		srv.Close()
+		c = check(ctx, RegistryAccess{RootURL: srv.URL, CACerts: cacerts})
+		assert.Equal(t, ConnectionUnreachable, c.Status, c.Message)
+	}
+}
+
+func TestCheckRegistryConnectionNotOCI(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	c := CheckChartRegistry(context.Background(), RegistryAccess{RootURL: srv.URL})
+	assert.Equal(t, ConnectionError, c.Status)
+	assert.Equal(t, "registry implements neither the OCI distribution API nor a Helm repository index", c.Message)
+	tlsSrv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer tlsSrv.Close()
+	cacerts := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsSrv.Certificate().Raw}))
+	c = CheckChartRegistry(context.Background(), RegistryAccess{RootURL: "oci://" + tlsSrv.Listener.Addr().String(), CACerts: cacerts})
+	assert.Equal(t, ConnectionError, c.Status)
+	assert.Equal(t, "registry does not implement the OCI distribution API", c.Message)
+	c = CheckImageRegistry(context.Background(), RegistryAccess{RootURL: srv.URL})
+	assert.Equal(t, ConnectionError, c.Status)
+	assert.Equal(t, "registry does not implement the OCI distribution API", c.Message)
+}
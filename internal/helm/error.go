@@ -143,3 +143,13 @@ registry such as dockerhub then you may get rate-limited if you do not use -u an
 func (e *FetchError) Unwrap() error {
 	return e.Err
 }
+
+// UnsupportedInventoryError is an error that occurs when no inventory backend supports a registry
+
+type UnsupportedInventoryError struct {
+	Msg string
+}
+
+func (e *UnsupportedInventoryError) Error() string {
+	return e.Msg
+}
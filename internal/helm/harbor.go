@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+const harborPageSize = 100
+
+// Inventory of a Harbor project, e.g. https://harbor.example.com/api/v2.0/projects/catalog-apps, through the Harbor API.
+type harborInventory struct {
+	access     RegistryAccess
+	projectURL *url.URL
+}
+
+type harborRepository struct {
+	Name string `json:"name"`
+}
+
+type harborArtifact struct {
+	Digest     string    `json:"digest"`
+	PushTime   time.Time `json:"push_time"`
+	ExtraAttrs struct {
+		Name        string `json:"name"`
+		Version     string `json:"version"`
+		AppVersion  string `json:"appVersion"`
+		Description string `json:"description"`
+	} `json:"extra_attrs"`
+}
+
+func newHarborInventory(access RegistryAccess) (*harborInventory, error) {
+	projectURL, err := url.Parse(strings.Replace(access.RootURL, "oci://", "https://", 1))
+	if err != nil {
+		return nil, &ParseError{URL: access.RootURL, Msg: "Failed to parse URL", Err: err}
+	}
+	projectURL.Path = strings.TrimSuffix(projectURL.Path, "/")
+	return &harborInventory{access: access, projectURL: projectURL}, nil
+}
+
+// ListCharts lists the repositories of the Harbor project, stripped of the project name.
+func (h *harborInventory) ListCharts(ctx context.Context) ([]string, error) {
+	var charts []string
+	err := h.fetchPages(ctx, h.projectURL.JoinPath("repositories"), func(body []byte) (int, error) {
+		names, err := parseHarborRepositories(body)
+		charts = append(charts, names...)
+		return len(names), err
+	})
+	return charts, err
+}
+
+// ListChartVersions lists the artifacts of the chart repository, which carry the chart metadata.
+func (h *harborInventory) ListChartVersions(ctx context.Context, chartName string) ([]*ChartVersion, error) {
+	var versions []*ChartVersion
+	err := h.fetchPages(ctx, h.projectURL.JoinPath("repositories", chartName, "artifacts"), func(body []byte) (int, error) {
+		page, err := parseHarborArtifacts(body)
+		versions = append(versions, page...)
+		return len(page), err
+	})
+	return versions, err
+}
+
+// DescribeChartVersions does nothing, as the artifacts are listed with their metadata.
+func (h *harborInventory) DescribeChartVersions(_ context.Context, _ string, _ []*ChartVersion) error {
+	return nil
+}
+
+// Fetches the pages of a Harbor list until a partial one is returned.
+func (h *harborInventory) fetchPages(ctx context.Context, listURL *url.URL, parse func(body []byte) (int, error)) error {
+	httpClient, err := newHTTPClient(h.access)
+	if err != nil {
+		return &FetchError{Msg: "Failed to load CA certificates", Err: err, URL: listURL.String()}
+	}
+	for page := 1; ; page++ {
+		query := listURL.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("page_size", strconv.Itoa(harborPageSize))
+		listURL.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL.String(), nil)
+		if err != nil {
+			return &FetchError{Msg: "Failed to create request", Err: err, URL: listURL.String()}
+		}
+		setAuthorization(req, h.access)
+		body, err := doHarborRequest(httpClient, req)
+		if err != nil {
+			return err
+		}
+		count, err := parse(body)
+		if err != nil {
+			return err
+		}
+		if count < harborPageSize {
+			return nil
+		}
+	}
+}
+
+func doHarborRequest(httpClient *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to call Harbor API", Err: err, URL: req.URL.String(), Host: req.URL.Host}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &FetchError{Msg: "Failed to call Harbor API", URL: req.URL.String(), Host: req.URL.Host,
+			Err: &errcode.ErrorResponse{Method: req.Method, URL: req.URL, StatusCode: resp.StatusCode}}
+	}
+	body, err := readLimited(resp.Body, MaxIndexFileSize)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to read Harbor API response", Err: err, URL: req.URL.String(), Host: req.URL.Host}
+	}
+	return body, nil
+}
+
+// Returns the names of the repositories, stripped of the name of their project.
+func parseHarborRepositories(body []byte) ([]string, error) {
+	var records []harborRepository
+	if err := json.Unmarshal(body, &records); err != nil {
+		return nil, &ExtractError{Msg: "Failed to parse Harbor repositories", Err: err}
+	}
+
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		parts := strings.SplitN(record.Name, "/", 2)
+		names = append(names, parts[len(parts)-1])
+	}
+	return names, nil
+}
+
+func parseHarborArtifacts(body []byte) ([]*ChartVersion, error) {
+	var records []harborArtifact
+	if err := json.Unmarshal(body, &records); err != nil {
+		return nil, &ExtractError{Msg: "Failed to parse Harbor artifacts", Err: err}
+	}
+
+	versions := make([]*ChartVersion, 0, len(records))
+	for _, record := range records {
+		versions = append(versions, &ChartVersion{
+			Name:        record.ExtraAttrs.Name,
+			Version:     record.ExtraAttrs.Version,
+			AppVersion:  record.ExtraAttrs.AppVersion,
+			Description: record.ExtraAttrs.Description,
+			Digest:      record.Digest,
+			Created:     record.PushTime,
+		})
+	}
+	return versions, nil
+}
@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/open-edge-platform/app-orch-catalog/internal/shared/verboseerror"
+	"gopkg.in/yaml.v2"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// MaxIndexFileSize limits the size of the index of classic Helm repositories, and of the responses of the Harbor API.
+var MaxIndexFileSize int64 = 64 * 1024 * 1024
+
+// Limits of the cache of indexes, by number and by the total size of their content.
+var (
+	maxCachedIndexes         = 64
+	maxCachedIndexSize int64 = 128 * 1024 * 1024
+)
+
+/* IndexFile is the index.yaml of a classic Helm repository, such as ChartMuseum, listing all versions of its charts. */
+
+type IndexFile struct {
+	APIVersion string                     `yaml:"apiVersion"`
+	Entries    map[string][]*ChartVersion `yaml:"entries"`
+}
+
+/* ChartVersion describes a version of a chart, as listed in the index of a classic Helm repository. */
+
+type ChartVersion struct {
+	Name        string    `yaml:"name" json:"name"`
+	Version     string    `yaml:"version" json:"version"`
+	AppVersion  string    `yaml:"appVersion" json:"appVersion"`
+	Description string    `yaml:"description" json:"description"`
+	Digest      string    `yaml:"digest" json:"-"`
+	Created     time.Time `yaml:"created" json:"-"`
+	URLs        []string  `yaml:"urls" json:"-"`
+}
+
+type cachedIndex struct {
+	etag    string
+	index   *IndexFile
+	size    int64     /* Size of the content of the index */
+	fetched time.Time /* Time at which the index was last fetched or found to be unmodified */
+}
+
+// Indexes are large and rarely change, so they are cached along with their ETag and only fetched again if modified.
+// The cache is keyed by the index URL and the credentials, as each user may be shown a different index. The indexes
+// fetched least recently are evicted to keep the cache within its limits.
+var indexCache = struct {
+	sync.Mutex
+	entries map[string]*cachedIndex
+	size    int64
+}{entries: make(map[string]*cachedIndex)}
+
+// Caches the given index, evicting the indexes fetched least recently as needed to make room for it.
+func cacheIndex(key string, entry *cachedIndex) {
+	indexCache.Lock()
+	defer indexCache.Unlock()
+	if previous, ok := indexCache.entries[key]; ok {
+		indexCache.size -= previous.size
+		delete(indexCache.entries, key)
+	}
+	if entry.size > maxCachedIndexSize {
+		return
+	}
+	for len(indexCache.entries) >= maxCachedIndexes || indexCache.size+entry.size > maxCachedIndexSize {
+		var oldestKey string
+		var oldest *cachedIndex
+		for k, e := range indexCache.entries {
+			if oldest == nil || e.fetched.Before(oldest.fetched) {
+				oldestKey, oldest = k, e
+			}
+		}
+		indexCache.size -= oldest.size
+		delete(indexCache.entries, oldestKey)
+	}
+	indexCache.entries[key] = entry
+	indexCache.size += entry.size
+}
+
+// Returns the cached index under the given key, if any.
+func cachedIndexEntry(key string) (cachedIndex, bool) {
+	indexCache.Lock()
+	defer indexCache.Unlock()
+	entry, ok := indexCache.entries[key]
+	if !ok {
+		return cachedIndex{}, false
+	}
+	return *entry, true
+}
+
+// Records that the cached index under the given key was found to be unmodified.
+func touchCachedIndex(key string) {
+	indexCache.Lock()
+	defer indexCache.Unlock()
+	if entry, ok := indexCache.entries[key]; ok {
+		entry.fetched = time.Now()
+	}
+}
+
+// errTooLarge is the error of reading a response larger than its limit.
+var errTooLarge = errors.New("response exceeds its size limit")
+
+// Reads the given body, failing rather than truncating it if it is larger than the given limit.
+func readLimited(body io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w of %d bytes", errTooLarge, limit)
+	}
+	return data, nil
+}
+
+// FetchIndex fetches the index of the classic Helm repository at the root URL of the registry.
+func FetchIndex(ctx context.Context, access RegistryAccess) (*IndexFile, error) {
+	repoURL, err := parseRepoURL(access.RootURL)
+	if err != nil {
+		return nil, err
+	}
+	indexURL := repoURL.JoinPath("index.yaml").String()
+	httpClient, err := newHTTPClient(access)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to load CA certificates", Err: err, URL: indexURL}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to create request", Err: err, URL: indexURL}
+	}
+	setAuthorization(req, access)
+
+	key := indexCacheKey(indexURL, access)
+	cached, ok := cachedIndexEntry(key)
+	if ok {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to fetch repository index", Err: err, URL: indexURL, Host: repoURL.Host}
+	}
+	defer resp.Body.Close()
+	if ok && resp.StatusCode == http.StatusNotModified {
+		touchCachedIndex(key)
+		return cached.index, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, &FetchError{Msg: "Failed to fetch repository index", URL: indexURL, Host: repoURL.Host,
+			Err: &errcode.ErrorResponse{Method: req.Method, URL: req.URL, StatusCode: resp.StatusCode}}
+	}
+
+	body, err := readLimited(resp.Body, MaxIndexFileSize)
+	if errors.Is(err, errTooLarge) {
+		return nil, &FetchError{Msg: "Repository index is too large", Err: err, URL: indexURL, Host: repoURL.Host}
+	} else if err != nil {
+		return nil, &FetchError{Msg: "Failed to read repository index", Err: err, URL: indexURL, Host: repoURL.Host}
+	}
+	index := &IndexFile{}
+	if err = yaml.Unmarshal(body, index); err != nil {
+		return nil, &ExtractError{Msg: "Failed to parse the repository index", Filename: "index.yaml", Err: err}
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		cacheIndex(key, &cachedIndex{etag: etag, index: index, size: int64(len(body)), fetched: time.Now()})
+	}
+	return index, nil
+}
+
+// Inventory of a classic Helm repository through its index.
+type indexInventory struct {
+	access RegistryAccess
+}
+
+// ListCharts lists the charts of the index.
+func (i *indexInventory) ListCharts(ctx context.Context) ([]string, error) {
+	index, err := FetchIndex(ctx, i.access)
+	if err != nil {
+		return nil, err
+	}
+	charts := make([]string, 0, len(index.Entries))
+	for name := range index.Entries {
+		charts = append(charts, name)
+	}
+	sort.Strings(charts)
+	return charts, nil
+}
+
+// ListChartVersions lists the versions of the chart in the order of the index, along with their metadata.
+func (i *indexInventory) ListChartVersions(ctx context.Context, chartName string) ([]*ChartVersion, error) {
+	index, err := FetchIndex(ctx, i.access)
+	if err != nil {
+		return nil, err
+	}
+	versions, ok := index.Entries[chartName]
+	if !ok {
+		return nil, &FetchError{Msg: "Failed to find chart in repository index", Err: errdef.ErrNotFound, URL: i.access.RootURL, Artifact: chartName}
+	}
+	return versions, nil
+}
+
+// DescribeChartVersions does nothing, as the index holds the metadata of all the versions.
+func (i *indexInventory) DescribeChartVersions(_ context.Context, _ string, _ []*ChartVersion) error {
+	return nil
+}
+
+// FetchHelmChartIndex fetches a Helm Chart from a classic Helm repository and extracts some useful info. The chart
+// is given by its URL within the repository, optionally tagged with its version, e.g.
+// https://charts.example.com/stable/wordpress:1.2.3; the latest version is fetched if there is no tag.
+
+func FetchHelmChartIndex(chartURL string, user string, password string) (HelmInfo, error) {
+	repoURL, chartName, version, err := parseRepoChartURL(chartURL)
+	if err != nil {
+		return HelmInfo{}, err
+	}
+	access := RegistryAccess{RootURL: repoURL.String(), Username: user, Password: password}
+
+	ctx := context.Background()
+	index, err := FetchIndex(ctx, access)
+	if err != nil {
+		return HelmInfo{}, err
+	}
+	cv := findChartVersion(index.Entries[chartName], version)
+	if cv == nil {
+		return HelmInfo{}, &FetchError{Msg: "Failed to find chart in repository index", URL: chartURL, Host: repoURL.Host, Artifact: chartName}
+	}
+
+	verboseerror.Infof("Fetching helm chart %s:%s from %s\n", chartName, cv.Version, repoURL)
+
+	contentReader, err := FetchIndexChart(ctx, access, cv)
+	if err != nil {
+		return HelmInfo{}, err
+	}
+	defer contentReader.Close()
+
+	hi, err := readChartInfo(contentReader)
+	if err != nil {
+		return HelmInfo{}, err
+	}
+	hi.OCIRegistry = repoURL.String()
+	hi.Username = user
+	hi.Password = password
+	return hi, nil
+}
+
+// FetchIndexChart fetches the tarball of a chart version listed in the index of a classic Helm repository. Its URL may
+// be relative to the repository; credentials are passed only to the host of the repository.
+func FetchIndexChart(ctx context.Context, access RegistryAccess, cv *ChartVersion) (io.ReadCloser, error) {
+	repoURL, err := parseRepoURL(access.RootURL)
+	if err != nil {
+		return nil, err
+	}
+	if len(cv.URLs) == 0 {
+		return nil, &FetchError{Msg: "Missing download URL of chart", URL: repoURL.String(), Artifact: cv.Name}
+	}
+	base := *repoURL
+	base.Path += "/"
+	tarballURL, err := base.Parse(cv.URLs[0])
+	if err != nil {
+		return nil, &ParseError{URL: cv.URLs[0], Msg: "Failed to parse URL", Err: err}
+	}
+	httpClient, err := newHTTPClient(access)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to load CA certificates", Err: err, URL: tarballURL.String()}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tarballURL.String(), nil)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to create request", Err: err, URL: tarballURL.String()}
+	}
+	if tarballURL.Host == repoURL.Host {
+		setAuthorization(req, access)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to fetch chart", Err: err, URL: tarballURL.String(), Host: tarballURL.Host, Artifact: cv.Name}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &FetchError{Msg: "Failed to fetch chart", URL: tarballURL.String(), Host: tarballURL.Host, Artifact: cv.Name,
+			Err: &errcode.ErrorResponse{Method: req.Method, URL: req.URL, StatusCode: resp.StatusCode}}
+	}
+	return resp.Body, nil
+}
+
+// Returns the given version of the chart, or its latest version if none is given.
+func findChartVersion(versions []*ChartVersion, version string) *ChartVersion {
+	var latest *ChartVersion
+	var latestVersion semver.Version
+	for _, cv := range versions {
+		if version != "" {
+			if cv.Version == version {
+				return cv
+			}
+			continue
+		}
+		if v, err := semver.ParseTolerant(cv.Version); err == nil && (latest == nil || v.GT(latestVersion)) {
+			latest, latestVersion = cv, v
+		}
+	}
+	return latest
+}
+
+// Basic authentication is used with a username; otherwise a lone token is passed as a bearer token.
+func setAuthorization(req *http.Request, access RegistryAccess) {
+	if access.Username != "" {
+		req.SetBasicAuth(access.Username, access.Password)
+	} else if access.Password != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", access.Password))
+	}
+}
+
+func indexCacheKey(indexURL string, access RegistryAccess) string {
+	sum := sha256.Sum256([]byte(indexURL + "\x00" + access.Username + "\x00" + access.Password + "\x00" + access.CACerts))
+	return hex.EncodeToString(sum[:])
+}
+
+// Returns the URL of the classic Helm repository, without any trailing slash.
+func parseRepoURL(rootURL string) (*url.URL, error) {
+	parsedURL, err := url.Parse(rootURL)
+	if err != nil {
+		return nil, &ParseError{URL: rootURL, Msg: "Failed to parse URL", Err: err}
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, &ParseError{URL: rootURL, Msg: "Scheme is not http:// or https:// in URL"}
+	}
+	if parsedURL.Host == "" {
+		return nil, &ParseError{URL: rootURL, Msg: "Missing host in URL"}
+	}
+	parsedURL.Path = strings.TrimSuffix(parsedURL.Path, "/")
+	parsedURL.RawQuery, parsedURL.Fragment = "", ""
+	return parsedURL, nil
+}
+
+// Splits the URL of a chart, e.g. https://charts.example.com/stable/wordpress:1.2.3, into the URL of its
+// repository, the chart name and the version, if any.
+func parseRepoChartURL(chartURL string) (*url.URL, string, string, error) {
+	parsedURL, err := parseRepoURL(chartURL)
+	if err != nil {
+		return nil, "", "", err
+	}
+	idx := strings.LastIndex(parsedURL.Path, "/")
+	if idx == -1 || idx == len(parsedURL.Path)-1 {
+		return nil, "", "", &ParseError{URL: chartURL, Msg: "Missing chart name in URL"}
+	}
+	chartName, version, _ := strings.Cut(parsedURL.Path[idx+1:], ":")
+	parsedURL.Path = parsedURL.Path[:idx]
+	return parsedURL, chartName, version, nil
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIndex = `apiVersion: v1
+entries:
+  testchart:
+  - name: testchart
+    version: 0.9.0
+    urls:
+    - charts/testchart-0.9.0.tgz
+  - name: testchart
+    version: 1.0.0
+    appVersion: 2.0.0
+    created: "2025-03-01T10:00:00Z"
+    digest: 0123abcd
+    urls:
+    - charts/testchart-1.0.0.tgz
+  other:
+  - name: other
+    version: 0.1.0
+    urls:
+    - https://elsewhere.example.com/other-0.1.0.tgz
+`
+
+// Starts a classic Helm repository at /stable requiring Basic authentication, which counts the index downloads.
+func newTestRepository(t *testing.T) (*httptest.Server, *int) {
+	tarball, err := os.ReadFile("testdata/chart.tgz")
+	require.NoError(t, err)
+
+	downloads := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="ChartMuseum"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/stable/index.yaml":
+			w.Header().Set("ETag", `"v1"`)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			downloads++
+			_, _ = w.Write([]byte(testIndex))
+		case "/stable/charts/testchart-1.0.0.tgz":
+			_, _ = w.Write(tarball)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &downloads
+}
+
+func TestFetchIndex(t *testing.T) {
+	srv, downloads := newTestRepository(t)
+	ctx := context.Background()
+	access := RegistryAccess{RootURL: srv.URL + "/stable/", Username: "user", Password: "secret"}
+
+	index, err := FetchIndex(ctx, access)
+	require.NoError(t, err)
+	if assert.Len(t, index.Entries["testchart"], 2) {
+		cv := index.Entries["testchart"][1]
+		assert.Equal(t, "2.0.0", cv.AppVersion)
+		assert.Equal(t, "0123abcd", cv.Digest)
+		assert.Equal(t, 2025, cv.Created.Year())
+	}
+
+	// The cached index is used as long as it is not modified
+	cached, err := FetchIndex(ctx, access)
+	require.NoError(t, err)
+	assert.Same(t, index, cached)
+	assert.Equal(t, 1, *downloads)
+
+	inventory, err := NewInventory(APITypeHelm, access)
+	require.NoError(t, err)
+	charts, err := inventory.ListCharts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"other", "testchart"}, charts)
+	versions, err := inventory.ListChartVersions(ctx, "testchart")
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 2) {
+		assert.Equal(t, "0.9.0", versions[0].Version)
+		assert.Equal(t, "2.0.0", versions[1].AppVersion)
+	}
+
+	_, err = FetchIndex(ctx, RegistryAccess{RootURL: srv.URL + "/stable", Username: "user", Password: "wrong"})
+	assert.ErrorContains(t, err, "response status code 401")
+	_, err = FetchIndex(ctx, RegistryAccess{RootURL: "oci://" + srv.Listener.Addr().String()})
+	assert.Error(t, err)
+
+	c := CheckChartRegistry(ctx, access)
+	assert.Equal(t, ConnectionOK, c.Status, c.Message)
+	assert.Equal(t, APITypeHelm, c.APIType)
+}
+
+func TestFetchIndexLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(testIndex))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	saveMaxIndexFileSize, saveMaxCachedIndexSize := MaxIndexFileSize, maxCachedIndexSize
+	defer func() { MaxIndexFileSize, maxCachedIndexSize = saveMaxIndexFileSize, saveMaxCachedIndexSize }()
+
+	// Indexes larger than the limit are refused rather than truncated
+	MaxIndexFileSize = int64(len(testIndex)) - 1
+	_, err := FetchIndex(ctx, RegistryAccess{RootURL: srv.URL + "/large"})
+	assert.ErrorContains(t, err, "Repository index is too large")
+	MaxIndexFileSize = saveMaxIndexFileSize
+
+	// The indexes fetched least recently are evicted to keep the cache within its size
+	maxCachedIndexSize = 2 * int64(len(testIndex))
+	for _, repo := range []string{"/a", "/b", "/c"} {
+		_, err = FetchIndex(ctx, RegistryAccess{RootURL: srv.URL + repo})
+		require.NoError(t, err)
+	}
+	indexCache.Lock()
+	defer indexCache.Unlock()
+	assert.LessOrEqual(t, indexCache.size, maxCachedIndexSize)
+	assert.NotContains(t, indexCache.entries, indexCacheKey(srv.URL+"/a/index.yaml", RegistryAccess{}))
+	assert.Contains(t, indexCache.entries, indexCacheKey(srv.URL+"/c/index.yaml", RegistryAccess{}))
+}
+
+func TestFetchHelmChartIndex(t *testing.T) {
+	srv, _ := newTestRepository(t)
+
+	h, err := FetchHelmChart(srv.URL+"/stable/testchart", "user", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, "testchart", h.Name)
+	assert.Equal(t, "1.0.0", h.Version)
+	assert.Equal(t, "This is a test chart", h.Description)
+	assert.Equal(t, srv.URL+"/stable", h.OCIRegistry)
+	assert.Equal(t, "user", h.Username)
+
+	h, err = FetchHelmChartIndex(srv.URL+"/stable/testchart:1.0.0", "user", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", h.Version)
+
+	_, err = FetchHelmChartIndex(srv.URL+"/stable/testchart:0.9.0", "user", "secret")
+	assert.ErrorContains(t, err, "response status code 404")
+	_, err = FetchHelmChartIndex(srv.URL+"/stable/missing", "user", "secret")
+	assert.ErrorContains(t, err, "Failed to find chart in repository index")
+	_, err = FetchHelmChartIndex(srv.URL+"/", "user", "secret")
+	assert.ErrorContains(t, err, "Missing chart name in URL")
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+// Inventory lists the charts hosted by a registry, using the API selected by the API type of the registry.
+type Inventory interface {
+	// ListCharts lists the names of the charts.
+	ListCharts(ctx context.Context) ([]string, error)
+	// ListChartVersions lists the versions of a chart; their metadata may be left to DescribeChartVersions
+	// if it takes a request per version to obtain.
+	ListChartVersions(ctx context.Context, chartName string) ([]*ChartVersion, error)
+	// DescribeChartVersions completes the metadata of the given versions listed by ListChartVersions.
+	DescribeChartVersions(ctx context.Context, chartName string, versions []*ChartVersion) error
+}
+
+// NewInventory returns the inventory of the registry at the given inventory URL. Registries without an API type
+// are presumed to be Harbor registries if their inventory URL points to a Harbor project.
+func NewInventory(apiType string, access RegistryAccess) (Inventory, error) {
+	switch apiType {
+	case APITypeHarbor:
+		return newHarborInventory(access)
+	case APITypeOCI:
+		return newOCIInventory(access)
+	case APITypeHelm:
+		return &indexInventory{access: access}, nil
+	case "":
+		if strings.Contains(access.RootURL, "/api/v2.0/projects/") {
+			return newHarborInventory(access)
+		}
+		return nil, &UnsupportedInventoryError{Msg: "Not supported non-OCI registry inventory url"}
+	default:
+		return nil, &UnsupportedInventoryError{Msg: fmt.Sprintf("Not supported registry API type %s", apiType)}
+	}
+}
+
+// Inventory of a registry through the OCI distribution API. The path of the inventory URL, e.g.
+// oci://registry.example.com/charts, is the namespace of the charts, which is stripped from their names.
+type ociInventory struct {
+	access    RegistryAccess
+	namespace string
+}
+
+func newOCIInventory(access RegistryAccess) (*ociInventory, error) {
+	_, path, err := parseRegistryURL(access.RootURL)
+	if err != nil {
+		return nil, err
+	}
+	namespace := ""
+	if path != "" {
+		namespace = path + "/"
+	}
+	return &ociInventory{access: access, namespace: namespace}, nil
+}
+
+// Returns an oras client for the registry, along with the repository of the given chart if any.
+func (o *ociInventory) client(ctx context.Context, chartName string) (OrasClientInterface, error) {
+	endpoint, _, err := parseRegistryURL(o.access.RootURL)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := newHTTPClient(o.access)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to load CA certificates", Err: err, URL: o.access.RootURL}
+	}
+	oc, err := newOrasClient(endpoint, httpClient, o.access)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to create registry object", Err: err, URL: o.access.RootURL, Host: endpoint.Host}
+	}
+	if chartName != "" {
+		if err = oc.Repository(ctx, o.namespace+chartName); err != nil {
+			return nil, &FetchError{Msg: "Failed to get repository using oras", Err: err, URL: o.access.RootURL, Artifact: chartName}
+		}
+	}
+	return oc, nil
+}
+
+// ListCharts lists the repositories within the namespace through the catalog of the registry.
+func (o *ociInventory) ListCharts(ctx context.Context) ([]string, error) {
+	oc, err := o.client(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	repos, err := oc.GetRepositories(ctx)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to get repositories using oras", Err: err, URL: o.access.RootURL}
+	}
+
+	charts := []string{}
+	for _, repo := range repos {
+		if name, ok := strings.CutPrefix(repo, o.namespace); ok && name != "" {
+			charts = append(charts, name)
+		}
+	}
+	sort.Strings(charts)
+	return charts, nil
+}
+
+// ListChartVersions lists the versions of a chart from the tags of its repository. Tags which are not versions,
+// such as signatures, are skipped, and underscores are translated back into the plus signs of the version build
+// metadata, as Helm does when pushing charts.
+func (o *ociInventory) ListChartVersions(ctx context.Context, chartName string) ([]*ChartVersion, error) {
+	oc, err := o.client(ctx, chartName)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := oc.GetTags(ctx)
+	if err != nil {
+		return nil, &FetchError{Msg: "Failed to get tags using oras", Err: err, URL: o.access.RootURL, Artifact: chartName}
+	}
+
+	versions := []*ChartVersion{}
+	for _, tag := range tags {
+		version := strings.ReplaceAll(tag, "_", "+")
+		if _, err := semver.ParseTolerant(version); err == nil {
+			versions = append(versions, &ChartVersion{Name: chartName, Version: version})
+		}
+	}
+	return versions, nil
+}
+
+// DescribeChartVersions fetches the manifest and the config of each version.
+func (o *ociInventory) DescribeChartVersions(ctx context.Context, chartName string, versions []*ChartVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+	oc, err := o.client(ctx, chartName)
+	if err != nil {
+		return err
+	}
+	for _, cv := range versions {
+		described, err := oc.GetChartVersion(ctx, strings.ReplaceAll(cv.Version, "+", "_"))
+		if err != nil {
+			return &FetchError{Msg: "Failed to describe chart version using oras", Err: err, URL: o.access.RootURL, Artifact: chartName}
+		}
+		cv.AppVersion, cv.Description, cv.Digest, cv.Created = described.AppVersion, described.Description, described.Digest, described.Created
+	}
+	return nil
+}
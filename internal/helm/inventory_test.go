@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+)
+
+// Starts a registry implementing the OCI distribution API, hosting the chart1 chart under the charts namespace.
+func newDistributionRegistry(t *testing.T) *httptest.Server {
+	config := []byte(`{"name":"chart1","version":"2.0.0+build.1","appVersion":"3.1","description":"First chart"}`)
+	configDesc := content.NewDescriptorFromBytes("application/vnd.cncf.helm.config.v1+json", config)
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType:   ocispec.MediaTypeImageManifest,
+		Config:      configDesc,
+		Layers:      []ocispec.Descriptor{},
+		Annotations: map[string]string{ocispec.AnnotationCreated: "2025-03-01T10:00:00Z"},
+	})
+	require.NoError(t, err)
+	manifestDigest := content.NewDescriptorFromBytes(ocispec.MediaTypeImageManifest, manifest).Digest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/_catalog":
+			_, _ = w.Write([]byte(`{"repositories":["charts/chart2","charts/chart1","images/app"]}`))
+		case "/v2/charts/chart1/tags/list":
+			_, _ = w.Write([]byte(`{"name":"charts/chart1","tags":["1.0","2.0.0_build.1","sha256-1234.sig"]}`))
+		case "/v2/charts/chart1/manifests/2.0.0_build.1":
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+			w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+			if r.Method == http.MethodGet {
+				_, _ = w.Write(manifest)
+			}
+		case fmt.Sprintf("/v2/charts/chart1/blobs/%s", configDesc.Digest):
+			_, _ = w.Write(config)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestOCIInventory(t *testing.T) {
+	srv := newDistributionRegistry(t)
+	ctx := context.Background()
+
+	inventory, err := NewInventory(APITypeOCI, RegistryAccess{RootURL: srv.URL + "/charts/"})
+	require.NoError(t, err)
+	charts, err := inventory.ListCharts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"chart1", "chart2"}, charts)
+
+	versions, err := inventory.ListChartVersions(ctx, "chart1")
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "1.0", versions[0].Version)
+	assert.Equal(t, "2.0.0+build.1", versions[1].Version)
+	assert.Empty(t, versions[1].Digest)
+
+	require.NoError(t, inventory.DescribeChartVersions(ctx, "chart1", versions[1:]))
+	assert.Equal(t, "3.1", versions[1].AppVersion)
+	assert.Equal(t, "First chart", versions[1].Description)
+	assert.Contains(t, versions[1].Digest, "sha256:")
+	assert.Equal(t, 2025, versions[1].Created.Year())
+
+	assert.Error(t, inventory.DescribeChartVersions(ctx, "chart1", versions[:1]))
+	_, err = inventory.ListChartVersions(ctx, "missing")
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestHarborInventory(t *testing.T) {
+	repositories, err := os.ReadFile("testdata/repositories.json")
+	require.NoError(t, err)
+	artifacts, err := os.ReadFile("testdata/artifacts.json")
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v2.0/projects/catalog-apps/repositories":
+			_, _ = w.Write(repositories)
+		case "/api/v2.0/projects/catalog-apps/repositories/chart1/artifacts":
+			_, _ = w.Write(artifacts)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	// Registries without an API type are presumed to be Harbor registries given a Harbor project URL
+	inventory, err := NewInventory("", RegistryAccess{RootURL: srv.URL + "/api/v2.0/projects/catalog-apps", Password: "token"})
+	require.NoError(t, err)
+	charts, err := inventory.ListCharts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"chart3", "chart2", "chart1"}, charts)
+
+	versions, err := inventory.ListChartVersions(ctx, "chart1")
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 1) {
+		assert.Equal(t, "0.0.1", versions[0].Version)
+		assert.Equal(t, "VCM App Orch Adapter", versions[0].Description)
+		assert.Equal(t, "sha256:24ebdb7ed8a2138777759a24910f356f18ef84c6547c9a30d9afb0a67d253ec8", versions[0].Digest)
+		assert.Equal(t, 2024, versions[0].Created.Year())
+	}
+
+	inventory, err = NewInventory(APITypeHarbor, RegistryAccess{RootURL: srv.URL + "/api/v2.0/projects/catalog-apps", Password: "wrong"})
+	require.NoError(t, err)
+	_, err = inventory.ListCharts(ctx)
+	assert.ErrorContains(t, err, "401")
+
+	_, err = NewInventory("", RegistryAccess{RootURL: srv.URL})
+	assert.ErrorContains(t, err, "Not supported non-OCI registry inventory url")
+	_, err = NewInventory("native", RegistryAccess{RootURL: srv.URL})
+	assert.ErrorContains(t, err, "Not supported registry API type native")
+}
@@ -29,7 +29,7 @@ type HelmInfo struct { // nolint:revive
 	Name        string /* Name of the Helm Chart */
 	Version     string /* Version of the Helm Chart */
 	Description string /* Description of the Helm Chart, extracted from Chart.yaml */
-	OCIRegistry string /* OCI Registry URL */
+	OCIRegistry string /* OCI Registry URL, or the URL of the classic Helm repository */
 	Username    string /* Username used to fetch chart */
 	Password    string /* Password used to fetch chart */
 }
@@ -162,6 +162,34 @@ func FetchHelmChartOCI(ociurl string, user string, password string) (HelmInfo, e
 		return HelmInfo{}, err
 	}
 
+	hi, err := readChartInfo(contentReader)
+	if err != nil {
+		return HelmInfo{}, err
+	}
+	hi.OCIRegistry = strings.Join([]string{"oci:/", remoteHost, path}, "/")
+
+	if user != "" {
+		hi.Username = user
+	}
+	if password != "" {
+		hi.Password = password
+	}
+
+	return hi, nil
+}
+
+// FetchHelmChart fetches a Helm Chart either from an OCI registry or from a classic Helm repository, depending on
+// the scheme of the URL, and extracts some useful info
+
+func FetchHelmChart(chartURL string, user string, password string) (HelmInfo, error) {
+	if strings.HasPrefix(chartURL, "oci://") {
+		return FetchHelmChartOCI(chartURL, user, password)
+	}
+	return FetchHelmChartIndex(chartURL, user, password)
+}
+
+// Extracts the Chart.yaml file from the chart tarball and parses the chart info from it.
+func readChartInfo(contentReader io.Reader) (HelmInfo, error) {
 	/* From the tarball, we can finally extract the Chart.yaml file */
 
 	chart, err := extractFileFromTGZ(contentReader, "Chart.yaml")
@@ -176,19 +204,12 @@ func FetchHelmChartOCI(ociurl string, user string, password string) (HelmInfo, e
 		return HelmInfo{}, &ExtractError{Msg: "Failed to parse the chart yaml", Err: err}
 	}
 
-	hi := HelmInfo{
-		Name:        chartData["name"].(string),
-		Version:     chartData["version"].(string),
-		Description: chartData["description"].(string),
-		OCIRegistry: strings.Join([]string{"oci:/", remoteHost, path}, "/"),
+	name, _ := chartData["name"].(string)
+	version, _ := chartData["version"].(string)
+	description, _ := chartData["description"].(string)
+	if name == "" || version == "" {
+		return HelmInfo{}, &ExtractError{Msg: "Missing chart name or version in", Filename: "Chart.yaml"}
 	}
 
-	if user != "" {
-		hi.Username = user
-	}
-	if password != "" {
-		hi.Password = password
-	}
-
-	return hi, nil
+	return HelmInfo{Name: name, Version: version, Description: description}, nil
 }
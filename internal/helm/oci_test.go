@@ -8,6 +8,7 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"net/http"
 	"os"
 	"testing"
 )
@@ -35,6 +36,21 @@ func (oc *MockOrasClient) SetAccessToken(password string) {
 	_ = password
 }
 
+func (oc *MockOrasClient) SetHTTPClient(client *http.Client, plainHTTP bool) {
+	_ = client
+	_ = plainHTTP
+}
+
+func (oc *MockOrasClient) Ping(ctx context.Context) error {
+	_ = ctx
+	return nil
+}
+
+func (oc *MockOrasClient) GetRepositories(ctx context.Context) ([]string, error) {
+	_ = ctx
+	return []string{"foo/bar"}, nil
+}
+
 func (oc *MockOrasClient) GetTags(ctx context.Context) ([]string, error) {
 	_ = ctx
 	return []string{"1.0.0", "1.0.1", "1.0.2"}, nil
@@ -50,6 +66,11 @@ func (oc *MockOrasClient) GetTarball(ctx context.Context, tagName string) (io.Re
 	return file, nil
 }
 
+func (oc *MockOrasClient) GetChartVersion(ctx context.Context, tagName string) (*ChartVersion, error) {
+	_ = ctx
+	return &ChartVersion{Name: "bar", Version: tagName}, nil
+}
+
 func TestFetchHelmChartOCI(t *testing.T) {
 	orasClient = &MockOrasClient{}
 
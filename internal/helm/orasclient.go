@@ -9,11 +9,14 @@ import (
 	"encoding/json"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"io"
+	"net/http"
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/memory"
 	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
+	"time"
 )
 
 type OrasClientInterface interface {
@@ -21,8 +24,12 @@ type OrasClientInterface interface {
 	Repository(ctx context.Context, name string) error
 	SetUsernamePassword(username string, password string)
 	SetAccessToken(password string)
+	SetHTTPClient(client *http.Client, plainHTTP bool)
+	Ping(ctx context.Context) error
+	GetRepositories(ctx context.Context) ([]string, error)
 	GetTags(ctx context.Context) ([]string, error)
 	GetTarball(ctx context.Context, tagName string) (io.Reader, error)
+	GetChartVersion(ctx context.Context, tagName string) (*ChartVersion, error)
 }
 
 // Abstract out all Oras client stuff, for easy mocking
@@ -31,6 +38,7 @@ type OrasClient struct {
 	reg        *remote.Registry
 	src        registry.Repository //*remote.Repository
 	remoteHost string
+	httpClient *http.Client
 }
 
 func (oc *OrasClient) NewRegistry(host string) error {
@@ -48,6 +56,7 @@ func (oc *OrasClient) Repository(ctx context.Context, name string) error {
 
 func (oc *OrasClient) SetUsernamePassword(username string, password string) {
 	oc.reg.Client = &auth.Client{
+		Client:     oc.httpClient,
 		Header:     auth.DefaultClient.Header,
 		Credential: auth.StaticCredential(oc.remoteHost, auth.Credential{Username: username, Password: password}),
 	}
@@ -55,11 +64,38 @@ func (oc *OrasClient) SetUsernamePassword(username string, password string) {
 
 func (oc *OrasClient) SetAccessToken(password string) {
 	oc.reg.Client = &auth.Client{
+		Client:     oc.httpClient,
 		Header:     auth.DefaultClient.Header,
 		Credential: auth.StaticCredential(oc.remoteHost, auth.Credential{AccessToken: password}),
 	}
 }
 
+// SetHTTPClient sets the HTTP client used to access the registry, e.g. to trust custom CA certificates, and whether
+// plain HTTP is to be used; it must be called before setting any credentials.
+func (oc *OrasClient) SetHTTPClient(client *http.Client, plainHTTP bool) {
+	oc.httpClient = client
+	oc.reg.PlainHTTP = plainHTTP
+	oc.reg.Client = &auth.Client{
+		Client: client,
+		Header: auth.DefaultClient.Header,
+	}
+}
+
+// Ping checks that the registry implements the OCI distribution API and accepts the credentials.
+func (oc *OrasClient) Ping(ctx context.Context) error {
+	return oc.reg.Ping(ctx)
+}
+
+// GetRepositories lists the repositories of the registry through its catalog.
+func (oc *OrasClient) GetRepositories(ctx context.Context) ([]string, error) {
+	allRepos := []string{}
+	err := oc.reg.Repositories(ctx, "", func(repos []string) error {
+		allRepos = append(allRepos, repos...)
+		return nil
+	})
+	return allRepos, err
+}
+
 func (oc *OrasClient) GetTags(ctx context.Context) ([]string, error) {
 	allTags := []string{}
 	err := oc.src.Tags(ctx, "", func(tags []string) error {
@@ -108,3 +144,32 @@ func (oc *OrasClient) GetTarball(ctx context.Context, tagName string) (io.Reader
 
 	return contentReader, err
 }
+
+// GetChartVersion fetches the metadata of the chart version with the given tag from its manifest and its config,
+// which holds the contents of the Chart.yaml file.
+func (oc *OrasClient) GetChartVersion(ctx context.Context, tagName string) (*ChartVersion, error) {
+	desc, manifestBytes, err := oras.FetchBytes(ctx, oc.src, tagName, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return nil, &ExtractError{Msg: "Failed to fetch manifest", Filename: tagName, Err: err}
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, &ExtractError{Msg: "Failed to unmarshal manifest", Filename: tagName, Err: err}
+	}
+
+	configBytes, err := content.FetchAll(ctx, oc.src, manifest.Config)
+	if err != nil {
+		return nil, &ExtractError{Msg: "Failed to fetch chart config", Filename: tagName, Err: err}
+	}
+
+	cv := &ChartVersion{}
+	if err := json.Unmarshal(configBytes, cv); err != nil {
+		return nil, &ExtractError{Msg: "Failed to unmarshal chart config", Filename: tagName, Err: err}
+	}
+	cv.Digest = desc.Digest.String()
+	if created, ok := manifest.Annotations[ocispec.AnnotationCreated]; ok {
+		cv.Created, _ = time.Parse(time.RFC3339, created)
+	}
+	return cv, nil
+}
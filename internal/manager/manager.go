@@ -10,10 +10,14 @@ import (
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	"github.com/open-edge-platform/orch-library/go/pkg/northbound"
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
 	"os"
+	"time"
 
 	// pq is Postgres driver for the database/sql package
 	_ "github.com/lib/pq"
@@ -50,6 +54,7 @@ type Config struct {
 	DatabaseName             string
 	MigrationsDir            string
 	DefaultProjectUUID       string
+	MetricsPort              int
 }
 
 // NewManager creates a new manager
@@ -62,8 +67,9 @@ func NewManager(config Config) *Manager {
 
 // Manager single point of entry for the application-catalog system.
 type Manager struct {
-	Config   Config
-	dbClient *ent.Client
+	Config         Config
+	dbClient       *ent.Client
+	dataSourceName string
 }
 
 // Run starts a synchronizer based on the devices and the northbound services.
@@ -92,11 +98,11 @@ func (m *Manager) Start() error {
 		log.Fatalf("%s env var is not set", databasePwd)
 	}
 	var err error
-	m.dbClient, err = ent.Open(m.Config.DatabaseDriver,
-		fmt.Sprintf("host=%s port=%d user=%s dbname=%s password=%s sslmode=%s",
-			m.Config.DatabaseHostname, m.Config.DatabasePort,
-			dbUser, m.Config.DatabaseName, dbPwd,
-			sslModeStr))
+	m.dataSourceName = fmt.Sprintf("host=%s port=%d user=%s dbname=%s password=%s sslmode=%s",
+		m.Config.DatabaseHostname, m.Config.DatabasePort,
+		dbUser, m.Config.DatabaseName, dbPwd,
+		sslModeStr)
+	m.dbClient, err = ent.Open(m.Config.DatabaseDriver, m.dataSourceName)
 	if err != nil {
 		log.Fatalf("failed opening connection to %s %s:%d:%s for %s: %v",
 			m.Config.DatabaseDriver, m.Config.DatabaseHostname, m.Config.DatabasePort, m.Config.DatabaseName, dbUser, err)
@@ -117,9 +123,27 @@ func (m *Manager) Start() error {
 				log.Errorf("ATTENTION: failed to migrate project: %v", err)
 			}
 		}
+		if err = backfillVersionKeys(context.Background(), m.dbClient); err != nil {
+			log.Errorf("ATTENTION: failed to assign version keys: %v", err)
+		}
+		if err = backfillSearchText(context.Background(), m.dbClient); err != nil {
+			log.Errorf("ATTENTION: failed to assign search text: %v", err)
+		}
 		log.Infof("Database migration complete")
 	}
 
+	// Keys may be rotated on any restart, whether the database schema is migrated or not
+	if err = reencryptSecrets(context.Background(), m.dbClient); err != nil {
+		log.Errorf("ATTENTION: failed to encrypt registry secrets: %v", err)
+	}
+
+	go purgeExpiredTrash(context.Background(), m.dbClient)
+	go purgeOutbox(context.Background(), m.dbClient)
+
+	if m.Config.MetricsPort != 0 {
+		go m.serveMetrics()
+	}
+
 	err = m.startNorthboundServer()
 	if err != nil {
 		return err
@@ -165,7 +189,12 @@ func (m *Manager) startNorthboundServer() error {
 	}
 
 	// TODO: Determine whether this is required in the future: s.AddService(dazl.Service{})
-	s.AddService(service.NewService(m.dbClient, opaClient))
+	// Relay the changes made through other replicas to the local watchers
+	var broadcaster service.EventBroadcaster
+	if m.Config.DatabaseDriver == "postgres" {
+		broadcaster = service.NewPostgresBroadcaster(m.dbClient, m.dataSourceName)
+	}
+	s.AddService(service.NewService(m.dbClient, opaClient, broadcaster, prometheus.DefaultRegisterer))
 	s.AddService(HealthCheck{})
 
 	doneCh := make(chan error)
@@ -182,6 +211,21 @@ func (m *Manager) startNorthboundServer() error {
 	return <-doneCh
 }
 
+// serveMetrics serves the Prometheus metrics over HTTP
+func (m *Manager) serveMetrics() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", m.Config.MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Infof("Serving metrics on port %d", m.Config.MetricsPort)
+	if err := server.ListenAndServe(); err != nil {
+		log.Errorf("Unable to serve metrics: %v", err)
+	}
+}
+
 // Close kills the channels and manager related objects
 func (m *Manager) Close() {
 	m.dbClient.Close()
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound"
+)
+
+// OutboxPurgeInterval is the interval at which the events kept in the outbox beyond their retention are purged.
+var OutboxPurgeInterval = time.Hour
+
+// Periodically purges the events kept in the outbox for longer than the retention period, until the given context
+// is done. Replicas may purge concurrently, as deleting events that are already gone has no effect.
+func purgeOutbox(ctx context.Context, client *generated.Client) {
+	ticker := time.NewTicker(OutboxPurgeInterval)
+	defer ticker.Stop()
+	for {
+		purged, err := northbound.PurgeOutbox(ctx, client, time.Now().Add(-northbound.OutboxRetention))
+		if err != nil {
+			log.Warnf("Unable to purge the outbox: %v", err)
+		} else if purged > 0 {
+			log.Infof("Purged %d events from the outbox", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
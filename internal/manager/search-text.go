@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound"
+)
+
+// Assigns the search text, composed of the profile descriptions and the UI extension labels, to the applications and
+// deployment packages created before it was introduced. The update and entity tags are retained.
+func backfillSearchText(ctx context.Context, client *generated.Client) error {
+	appsDB, err := client.Application.Query().Where(application.SearchTextIsNil()).WithProfiles().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, appDB := range appsDB {
+		descriptions := make([]string, 0, len(appDB.Edges.Profiles))
+		for _, p := range appDB.Edges.Profiles {
+			descriptions = append(descriptions, p.Description)
+		}
+		err = client.Application.UpdateOne(appDB).
+			SetSearchText(northbound.SearchText(descriptions...)).
+			SetUpdateTime(appDB.UpdateTime).
+			SetEtag(appDB.Etag).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	pkgsDB, err := client.DeploymentPackage.Query().Where(deploymentpackage.SearchTextIsNil()).WithExtensions().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pkgDB := range pkgsDB {
+		labels := make([]string, 0, len(pkgDB.Edges.Extensions))
+		for _, e := range pkgDB.Edges.Extensions {
+			labels = append(labels, e.UILabel)
+		}
+		err = client.DeploymentPackage.UpdateOne(pkgDB).
+			SetSearchText(northbound.SearchText(labels...)).
+			SetUpdateTime(pkgDB.UpdateTime).
+			SetEtag(pkgDB.Etag).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(appsDB) > 0 || len(pkgsDB) > 0 {
+		log.Infof("Assigned search text to %d applications and %d deployment packages", len(appsDB), len(pkgsDB))
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhook"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+)
+
+// Encrypts the registry secret data stored before a secrets key file was configured, and encrypts the data keys of
+// the secret data sealed under a key other than the active one again, so that keys can be rotated. This covers the
+// auth tokens of the registries, the secret data of the registries in the trash, and the secret data held by the
+// secret service, as well as the secrets of the webhooks. The update and entity tags of the registries are retained.
+// Secret data failing to be encrypted is left as is, and reported once all the others have been encrypted.
+func reencryptSecrets(ctx context.Context, client *generated.Client) error {
+	if !northbound.SecretsEncrypted() {
+		return nil
+	}
+	count, failures := 0, 0
+	reencryptWith := func(reencryptData func(string, string) (string, bool, error), what string, encodedData string,
+		additionalData string, update func(string) error) {
+		reencrypted, changed, err := reencryptData(encodedData, additionalData)
+		if err == nil && changed {
+			if err = update(reencrypted); err == nil {
+				count++
+			}
+		}
+		if err != nil {
+			log.Warnf("Unable to encrypt secret data of %s: %v", what, err)
+			failures++
+		}
+	}
+	reencrypt := func(what string, encodedData string, secretPath string, update func(string) error) {
+		reencryptWith(northbound.ReencryptSecretData, what, encodedData, secretPath, update)
+	}
+
+	registriesDB, err := client.Registry.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, registryDB := range registriesDB {
+		what := fmt.Sprintf("registry %s of project %s", registryDB.Name, registryDB.ProjectUUID)
+		reencrypt(what, registryDB.AuthToken, northbound.MakeSecretPath(registryDB.ProjectUUID, registryDB.Name), func(reencrypted string) error {
+			// The conditional update leaves secret data updated concurrently by the northbound alone
+			return client.Registry.Update().
+				Where(registry.ID(registryDB.ID), registry.AuthToken(registryDB.AuthToken)).
+				SetAuthToken(reencrypted).
+				SetUpdateTime(registryDB.UpdateTime).
+				SetEtag(registryDB.Etag).
+				Exec(ctx)
+		})
+	}
+
+	trashedDB, err := client.TrashedEntity.Query().
+		Where(trashedentity.ResourceType(string(errors.RegistryType))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range trashedDB {
+		what := fmt.Sprintf("deleted registry %s of project %s", t.Name, t.ProjectUUID)
+		reencrypt(what, t.Secret, northbound.MakeSecretPath(t.ProjectUUID, t.Name), func(reencrypted string) error {
+			return client.TrashedEntity.Update().
+				Where(trashedentity.ID(t.ID), trashedentity.Secret(t.Secret)).
+				SetSecret(reencrypted).
+				Exec(ctx)
+		})
+	}
+
+	webhooksDB, err := client.Webhook.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, w := range webhooksDB {
+		what := fmt.Sprintf("webhook %s of project %s", w.Name, w.ProjectUUID)
+		reencryptWith(northbound.ReencryptSecret, what, w.Secret, northbound.WebhookSecretContext(w.ProjectUUID, w.Name), func(reencrypted string) error {
+			return client.Webhook.Update().
+				Where(webhook.ID(w.ID), webhook.Secret(w.Secret)).
+				SetSecret(reencrypted).
+				SetUpdateTime(w.UpdateTime).
+				Exec(ctx)
+		})
+	}
+
+	if northbound.UseSecretService {
+		secretService, err := northbound.SecretServiceFactory(ctx)
+		if err != nil {
+			return err
+		}
+		defer secretService.Logout(ctx)
+
+		paths := make(map[string]string)
+		for _, registryDB := range registriesDB {
+			if registryDB.AuthToken == "" {
+				paths[northbound.MakeSecretPath(registryDB.ProjectUUID, registryDB.Name)] =
+					fmt.Sprintf("registry %s of project %s", registryDB.Name, registryDB.ProjectUUID)
+			}
+		}
+		for _, t := range trashedDB {
+			path := northbound.MakeSecretPath(t.ProjectUUID, t.Name)
+			if _, ok := paths[path]; !ok && t.Secret == "" {
+				paths[path] = fmt.Sprintf("deleted registry %s of project %s", t.Name, t.ProjectUUID)
+			}
+		}
+		for path, what := range paths {
+			encodedData, err := secretService.ReadSecret(ctx, path)
+			if err != nil {
+				log.Warnf("Unable to read secret %s of %s: %v", path, what, err)
+				failures++
+				continue
+			}
+			reencrypt(what, encodedData, path, func(reencrypted string) error {
+				return secretService.WriteSecret(ctx, path, reencrypted)
+			})
+		}
+	}
+
+	if count > 0 {
+		log.Infof("Encrypted the secret data of %d registries and webhooks under the active key", count)
+	}
+	if failures > 0 {
+		return fmt.Errorf("unable to encrypt the secret data of %d registries and webhooks", failures)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound"
+)
+
+// TrashPurgeInterval is the interval at which the trash is swept for entities whose retention period has expired.
+var TrashPurgeInterval = time.Hour
+
+// Periodically purges the entities kept in the trash for longer than the retention period, until the given context
+// is done. Replicas may sweep concurrently, as purging an entity that is already gone has no effect.
+func purgeExpiredTrash(ctx context.Context, client *generated.Client) {
+	ticker := time.NewTicker(TrashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		purged, err := northbound.PurgeExpiredTrash(ctx, client, time.Now().Add(-northbound.TrashRetention))
+		if err != nil {
+			log.Warnf("Unable to purge expired trash: %v", err)
+		} else if purged > 0 {
+			log.Infof("Purged %d expired entities from the trash", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound"
+)
+
+// Assigns the version keys, by which the versions are ordered, to the applications and deployment packages created
+// before the keys were introduced. The update and entity tags are retained, as the entities do not change.
+func backfillVersionKeys(ctx context.Context, client *generated.Client) error {
+	appsDB, err := client.Application.Query().
+		Where(application.Or(application.VersionKeyIsNil(), application.ChartVersionKeyIsNil())).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, appDB := range appsDB {
+		err = client.Application.UpdateOne(appDB).
+			SetVersionKey(northbound.VersionKey(appDB.Version)).
+			SetChartVersionKey(northbound.VersionKey(appDB.ChartVersion)).
+			SetUpdateTime(appDB.UpdateTime).
+			SetEtag(appDB.Etag).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	pkgsDB, err := client.DeploymentPackage.Query().Where(deploymentpackage.VersionKeyIsNil()).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pkgDB := range pkgsDB {
+		err = client.DeploymentPackage.UpdateOne(pkgDB).
+			SetVersionKey(northbound.VersionKey(pkgDB.Version)).
+			SetUpdateTime(pkgDB.UpdateTime).
+			SetEtag(pkgDB.Etag).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(appsDB) > 0 || len(pkgsDB) > 0 {
+		log.Infof("Assigned version keys to %d applications and %d deployment packages", len(appsDB), len(pkgsDB))
+	}
+	return nil
+}
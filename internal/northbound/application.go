@@ -8,7 +8,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/ignoredresource"
-	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+	"maps"
 	"strings"
 
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
@@ -20,6 +20,7 @@ import (
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -91,6 +92,18 @@ func (g *Server) CreateApplication(ctx context.Context, req *catalogv3.CreateApp
 		return nil, err
 	}
 
+	// The default profile is set after the row is created, which changes its entity tag
+	etag, _, err := currentETag(ctx, tx, errors.ApplicationType, projectUUID, created.Name, created.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = events.persist(ctx, tx); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	err = g.commitTransaction(tx)
 	if err != nil {
 		return nil, errors.NewDBError(errors.WithError(err))
@@ -113,7 +126,10 @@ func (g *Server) CreateApplication(ctx context.Context, req *catalogv3.CreateApp
 			ChartVersion:       created.ChartVersion,
 			DefaultProfileName: req.Application.DefaultProfileName,
 			Kind:               kindFromDB(created.Kind),
+			Labels:             extractedLabels(created.Labels),
+			Deprecation:        extractedDeprecation(created.Deprecated, created.DeprecationMessage, created.ReplacementVersion, created.DeprecateTime),
 			CreateTime:         timestamppb.New(created.CreateTime),
+			Etag:               formatETag(etag),
 		},
 	}, nil
 }
@@ -132,6 +148,13 @@ func (g *Server) createApplication(ctx context.Context, tx *generated.Tx, projec
 			errors.WithMessage("display name cannot contain leading or trailing spaces"))
 	}
 
+	if err := validateLabels(app.Labels, errors.ApplicationType, app.Name); err != nil {
+		return nil, err
+	} else if err := validateDeprecation(app.Deprecation, errors.ApplicationType, app.Name, app.Version); err != nil {
+		return nil, err
+	}
+	stampDeprecation(app.Deprecation, nil)
+
 	// Make sure that the display name, if specified is unique
 	if err := g.checkApplicationUniqueness(ctx, tx, projectUUID, app); err != nil {
 		return nil, err
@@ -154,9 +177,17 @@ func (g *Server) createApplication(ctx context.Context, tx *generated.Tx, projec
 		SetDisplayNameLc(strings.ToLower(displayName)).
 		SetDescription(app.Description).
 		SetVersion(app.Version).
+		SetVersionKey(VersionKey(app.Version)).
 		SetChartName(app.ChartName).
+		SetSearchText(applicationSearchText(app)).
 		SetChartVersion(app.ChartVersion).
-		SetKind(kindToDB(app.Kind))
+		SetChartVersionKey(VersionKey(app.ChartVersion)).
+		SetKind(kindToDB(app.Kind)).
+		SetLabels(storedLabels(app.Labels)).
+		SetDeprecated(app.Deprecation != nil).
+		SetDeprecationMessage(app.Deprecation.GetMessage()).
+		SetReplacementVersion(app.Deprecation.GetReplacementVersion()).
+		SetNillableDeprecateTime(deprecateTime(app.Deprecation))
 
 	// If image registry has been specified, apply it as well.
 	if len(app.ImageRegistryName) > 0 {
@@ -309,6 +340,7 @@ var applicationColumns = map[string]string{
 	"updateTime":         "update_time",
 	"defaultProfileName": "default_profile_name",
 	"imageRegistryName":  "image_registry_name",
+	"kind":               "kind",
 }
 
 // ListApplications gets a list of all applications through gRPC
@@ -342,8 +374,19 @@ func (g *Server) ListApplications(ctx context.Context, req *catalogv3.ListApplic
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
+	selector, err := parseLabelSelector(req.LabelSelector, errors.ApplicationType)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
 
-	applications, _, totalElements, err := g.getApplications(ctx, tx, projectUUID, req.Kinds, orderBys, filters, req.PageSize, req.Offset)
+	page, err := newListPage(errors.ApplicationType, req.PageSize, req.Offset, req.PageToken, req.OrderBy, req.Filter, req.LabelSelector, req.SkipTotal)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	applications, _, totalElements, nextPageToken, err := g.getApplications(ctx, tx, projectUUID, req.Kinds, req.ExcludeDeprecated, orderBys, filters, selector, page)
 	if err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
@@ -354,54 +397,86 @@ func (g *Server) ListApplications(ctx context.Context, req *catalogv3.ListApplic
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 	logActivity(ctx, "listed", "applications", projectUUID, "Total =>"+fmt.Sprintf("%d", totalElements))
-	return &catalogv3.ListApplicationsResponse{Applications: applications, TotalElements: totalElements}, nil
+	return &catalogv3.ListApplicationsResponse{Applications: applications, TotalElements: totalElements, NextPageToken: nextPageToken}, nil
 }
 
-func (g *Server) getApplications(ctx context.Context, tx *generated.Tx, projectUUID string, kinds []catalogv3.Kind,
-	orderBys []*orderBy, filters []*filter, pageSize int32, offset int32) ([]*catalogv3.Application, []string, int32, error) {
+func (g *Server) getApplications(ctx context.Context, tx *generated.Tx, projectUUID string, kinds []catalogv3.Kind, excludeDeprecated bool,
+	orderBys []*orderBy, filterExpr *filter, selector labels.Selector, page *listPage) ([]*catalogv3.Application, []string, int32, string, error) {
 	var err error
-	var applicationsDB []*generated.Application
 	var orderOptions []application.OrderOption
 	applicationsQuery := tx.Application.Query()
 
-	options, err := orderByOptions(orderBys, applicationColumns, errors.ApplicationType)
+	filterPred, err := filterPredicate(filterExpr, applicationColumns, errors.ApplicationType)
 	if err != nil {
-		return nil, nil, 0, err
-	}
-	for _, pred := range options {
-		orderOptions = append(orderOptions, pred)
+		return nil, nil, 0, "", err
+	} else if filterPred != nil {
+		applicationsQuery = applicationsQuery.Where(filterPred)
 	}
-	applicationsQuery = applicationsQuery.Order(orderOptions...)
 
-	filterPreds, err := filterPredicates(filters, applicationColumns, errors.ApplicationType)
-	if err != nil {
-		return nil, nil, 0, err
+	if selectorPred := labelSelectorPredicate(selector); selectorPred != nil {
+		applicationsQuery = applicationsQuery.Where(selectorPred)
 	}
-	var applicationPreds []predicate.Application
-	for _, pred := range filterPreds {
-		applicationPreds = append(applicationPreds, pred)
-	}
-	applicationsQuery = applicationsQuery.Where(application.Or(applicationPreds...))
 
 	kindFilter := kindPredicate(kinds)
 	if kindFilter != nil {
 		applicationsQuery = applicationsQuery.Where(kindFilter)
 	}
 
-	if projectUUID == "" {
-		applicationsDB, err = applicationsQuery.All(ctx)
-	} else {
-		applicationsDB, err = applicationsQuery.Where(application.ProjectUUID(projectUUID)).All(ctx)
+	if excludeDeprecated {
+		applicationsQuery = applicationsQuery.Where(application.Deprecated(false))
+	}
+
+	if projectUUID != "" {
+		applicationsQuery = applicationsQuery.Where(application.ProjectUUID(projectUUID))
 	}
+
+	var totalElements int32
+	if !page.skipTotal {
+		count, err := applicationsQuery.Clone().Count(ctx)
+		if err != nil {
+			return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
+		}
+		totalElements = int32(count)
+	}
+
+	options, err := page.orderOptions(orderBys, applicationColumns, errors.ApplicationType)
 	if err != nil {
-		return nil, nil, 0, errors.NewDBError(errors.WithError(err))
+		return nil, nil, 0, "", err
 	}
+	for _, pred := range options {
+		orderOptions = append(orderOptions, pred)
+	}
+	applicationsQuery = applicationsQuery.Order(orderOptions...)
 
-	applications, projectUUIDs, totalElements, err := g.applicationsExtract(ctx, applicationsDB, projectUUID, pageSize, offset)
+	after, err := page.afterPredicate(orderBys, applicationColumns, errors.ApplicationType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
+	} else if after != nil {
+		applicationsQuery = applicationsQuery.Where(after)
 	}
-	return applications, projectUUIDs, totalElements, nil
+
+	applicationsDB, err := applicationsQuery.Offset(page.offset).Limit(page.size + 1).All(ctx)
+	if err != nil {
+		return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
+	}
+	applicationsDB, more := pageOf(applicationsDB, page)
+
+	applications := make([]*catalogv3.Application, 0, len(applicationsDB))
+	projectUUIDs := make([]string, 0, len(applicationsDB))
+	for _, appDB := range applicationsDB {
+		application, err := g.applicationExtract(ctx, appDB, projectUUID)
+		if err != nil {
+			return nil, nil, 0, "", err
+		}
+		applications = append(applications, application)
+		projectUUIDs = append(projectUUIDs, appDB.ProjectUUID)
+	}
+
+	var nextPageToken string
+	if more {
+		nextPageToken = page.nextToken(orderBys, applications[len(applications)-1], applicationsDB[len(applicationsDB)-1].ID)
+	}
+	return applications, projectUUIDs, totalElements, nextPageToken, nil
 }
 
 func (g *Server) applicationsExtract(ctx context.Context, appsDB []*generated.Application, publisherName string,
@@ -491,8 +566,11 @@ func (g *Server) applicationExtract(ctx context.Context, appDB *generated.Applic
 		DefaultProfileName: defaultProfileName,
 		IgnoredResources:   ignoredResources,
 		Kind:               kindFromDB(appDB.Kind),
+		Labels:             extractedLabels(appDB.Labels),
+		Deprecation:        extractedDeprecation(appDB.Deprecated, appDB.DeprecationMessage, appDB.ReplacementVersion, appDB.DeprecateTime),
 		CreateTime:         timestamppb.New(appDB.CreateTime),
 		UpdateTime:         timestamppb.New(appDB.UpdateTime),
+		Etag:               formatETag(appDB.Etag),
 	}
 
 	imageRegistry, err := appDB.QueryImageRegistryFk().Only(ctx)
@@ -525,7 +603,9 @@ func (g *Server) GetApplicationVersions(ctx context.Context, req *catalogv3.GetA
 	}
 
 	applicationsDB, err := tx.Application.Query().
-		Where(application.ProjectUUID(projectUUID), application.Name(req.ApplicationName)).All(ctx)
+		Where(application.ProjectUUID(projectUUID), application.Name(req.ApplicationName)).
+		Order(application.ByVersionKey(), application.ByID()).
+		All(ctx)
 	if err != nil {
 		g.rollbackTransaction(tx)
 		return nil, errors.NewDBError(errors.WithError(err))
@@ -601,6 +681,7 @@ func (g *Server) GetApplication(ctx context.Context, req *catalogv3.GetApplicati
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 	logActivity(ctx, "retrieved", "application", projectUUID, req.ApplicationName, req.Version)
+	setETagHeader(ctx, application.Etag)
 	return &catalogv3.GetApplicationResponse{Application: application}, nil
 }
 
@@ -654,6 +735,8 @@ func (g *Server) GetApplicationReferenceCount(ctx context.Context, req *catalogv
 
 type applicationChanges struct {
 	kind             bool
+	labels           bool
+	deprecation      bool
 	rootRecord       bool
 	profiles         bool
 	profile          bool
@@ -667,8 +750,8 @@ func (c *applicationChanges) changed() bool {
 	return c.rootRecord || c.profiles || c.profile
 }
 
-func (c *applicationChanges) changedKind() bool {
-	return c.kind
+func (c *applicationChanges) changedMetadata() bool {
+	return c.kind || c.labels || c.deprecation
 }
 
 // UpdateApplication updates an application through gRPC
@@ -681,20 +764,10 @@ func (g *Server) UpdateApplication(ctx context.Context, req *catalogv3.UpdateApp
 		return nil, errors.NewInvalidArgument(
 			errors.WithResourceType(errors.ApplicationType),
 			errors.WithMessage("incomplete request"))
-	} else if err := req.Application.Validate(); err != nil {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.ApplicationType),
-			errors.WithMessage(err.Error()))
-	} else if req.ApplicationName != req.Application.Name {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.ApplicationType),
-			errors.WithMessage("name cannot be changed %s != %s",
-				req.ApplicationName, req.Application.Name))
-	} else if req.Version != req.Application.Version {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.ApplicationType),
-			errors.WithMessage("version cannot be changed %s != %s",
-				req.Version, req.Application.Version))
+	} else if !isPartialUpdate(req.UpdateMask) {
+		if err := validateApplicationUpdate(req.ApplicationName, req.Version, req.Application); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := g.authCheckAllowed(ctx, req); err != nil {
@@ -706,8 +779,26 @@ func (g *Server) UpdateApplication(ctx context.Context, req *catalogv3.UpdateApp
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.ApplicationType, projectUUID, req.ApplicationName, req.Version, req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	app := req.Application
+	if isPartialUpdate(req.UpdateMask) {
+		if app, err = g.maskedApplication(ctx, tx, projectUUID, req); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, err
+		}
+	}
+
 	events := &ApplicationEvents{}
-	if err = g.updateApplication(ctx, tx, projectUUID, req.Application, events); err != nil {
+	if err = g.updateApplication(ctx, tx, projectUUID, app, events); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = events.persist(ctx, tx); err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
@@ -723,6 +814,24 @@ func (g *Server) UpdateApplication(ctx context.Context, req *catalogv3.UpdateApp
 	return &emptypb.Empty{}, nil
 }
 
+// Validates the application given to an update of the named application version.
+func validateApplicationUpdate(name string, version string, app *catalogv3.Application) error {
+	if err := app.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage(err.Error()))
+	} else if name != app.Name {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage("name cannot be changed %s != %s", name, app.Name))
+	} else if version != app.Version {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage("version cannot be changed %s != %s", version, app.Version))
+	}
+	return nil
+}
+
 func (g *Server) updateApplication(ctx context.Context, tx *generated.Tx, projectUUID string, app *catalogv3.Application, events *ApplicationEvents) error {
 	if len(app.Profiles) > 0 && app.DefaultProfileName == "" {
 		return errors.NewInvalidArgument(
@@ -736,6 +845,10 @@ func (g *Server) updateApplication(ctx context.Context, tx *generated.Tx, projec
 		return errors.NewInvalidArgument(
 			errors.WithResourceType(errors.ApplicationType),
 			errors.WithMessage("display name cannot contain leading or trailing spaces"))
+	} else if err := validateLabels(app.Labels, errors.ApplicationType, app.Name); err != nil {
+		return err
+	} else if err := validateDeprecation(app.Deprecation, errors.ApplicationType, app.Name, app.Version); err != nil {
+		return err
 	}
 	// Get the application so that we can compute any changes
 	appDB, ok, err := g.getApplication(ctx, tx, projectUUID, app.Name, app.Version)
@@ -749,20 +862,35 @@ func (g *Server) updateApplication(ctx context.Context, tx *generated.Tx, projec
 			errors.WithResourceName(app.Name),
 			errors.WithResourceVersion(app.Version))
 	}
+	before, err := g.applicationExtract(ctx, appDB, "")
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return err
+	}
+	events.captureBefore(app.Name, app.Version, before)
+
 	if app.Kind == catalogv3.Kind_KIND_UNSPECIFIED {
 		app.Kind = kindFromDB(appDB.Kind) // keep the existing kind if not specified
 	}
 	app.DisplayName = displayName
+	stampDeprecation(app.Deprecation, appDB.DeprecateTime)
 	changes, err := g.computeApplicationChanges(ctx, tx, projectUUID, app, appDB)
 	if err != nil {
 		return err
 	}
 
 	// Make sure that the application doesn't belong to an already deployed deployment package
-	// Changes to the kind field only are exempt.
-	if changes.changedKind() && !changes.changed() {
-		return g.updateApplicationKind(ctx, tx, projectUUID, app)
-	} else if changes.changedKind() || changes.changed() {
+	// Changes to the kind, labels and deprecation only are exempt.
+	if changes.changedMetadata() && !changes.changed() {
+		if err = g.updateApplicationMetadata(ctx, tx, projectUUID, app); err != nil {
+			return err
+		}
+		if err = g.recordRevision(ctx, tx, projectUUID, errors.ApplicationType, app.Name, app.Version, before); err != nil {
+			return err
+		}
+		events.append(UpdatedEvent, projectUUID, app)
+		return nil
+	} else if changes.changedMetadata() || changes.changed() {
 		if err := g.checkApplicationNotInDeployedPackages(ctx, tx, projectUUID, app.Name, app.Version); err != nil {
 			return err
 		}
@@ -784,9 +912,14 @@ func (g *Server) updateApplication(ctx context.Context, tx *generated.Tx, projec
 		SetDescription(app.Description).
 		SetRegistryFkID(changes.helmRegistry.ID).
 		SetVersion(app.Version).
+		SetVersionKey(VersionKey(app.Version)).
 		SetChartName(app.ChartName).
+		SetSearchText(applicationSearchText(app)).
 		SetChartVersion(app.ChartVersion).
-		SetKind(kindToDB(app.Kind))
+		SetChartVersionKey(VersionKey(app.ChartVersion)).
+		SetKind(kindToDB(app.Kind)).
+		SetLabels(storedLabels(app.Labels))
+	setApplicationDeprecation(stmt, app.Deprecation)
 
 	// If image registry has been changed, apply it as well.
 	if len(app.ImageRegistryName) > 0 {
@@ -836,18 +969,24 @@ func (g *Server) updateApplication(ctx context.Context, tx *generated.Tx, projec
 			return err
 		}
 	}
+
+	if err = g.recordRevision(ctx, tx, projectUUID, errors.ApplicationType, app.Name, app.Version, before); err != nil {
+		return err
+	}
 	events.append(UpdatedEvent, projectUUID, app)
 	return nil
 }
 
-func (g *Server) updateApplicationKind(ctx context.Context, tx *generated.Tx, projectUUID string, app *catalogv3.Application) error {
-	updateCount, err := tx.Application.Update().
+func (g *Server) updateApplicationMetadata(ctx context.Context, tx *generated.Tx, projectUUID string, app *catalogv3.Application) error {
+	stmt := tx.Application.Update().
 		Where(
 			application.ProjectUUID(projectUUID),
 			application.Name(app.Name),
 			application.Version(app.Version),
 		).
-		SetKind(kindToDB(app.Kind)).Save(ctx)
+		SetKind(kindToDB(app.Kind)).
+		SetLabels(storedLabels(app.Labels))
+	updateCount, err := setApplicationDeprecation(stmt, app.Deprecation).Save(ctx)
 	if err != nil {
 		return errors.NewDBError(errors.WithError(err))
 	} else if updateCount == 0 {
@@ -887,6 +1026,8 @@ func (g *Server) computeApplicationChanges(ctx context.Context, tx *generated.Tx
 		changes.imageRegistry = registry
 	}
 	changes.kind = !isSameKind(app.Kind, appDB.Kind)
+	changes.labels = !maps.Equal(app.Labels, appDB.Labels)
+	changes.deprecation = deprecationChanged(app.Deprecation, appDB.Deprecated, appDB.DeprecationMessage, appDB.ReplacementVersion)
 	if changes.rootRecord, err = g.applicationChanged(app, appDB, changes); err != nil {
 		return nil, err
 	}
@@ -997,7 +1138,11 @@ func requirementsAreSame(ctx context.Context, p *catalogv3.Profile, existingProf
 		if err != nil {
 			return false
 		}
-		existingRequirements[fmt.Sprintf("%s:%s", name.Name, name.Version)] = requirementDB
+		version := name.Version
+		if requirementDB.VersionConstraint != "" {
+			version = requirementDB.VersionConstraint
+		}
+		existingRequirements[fmt.Sprintf("%s:%s", name.Name, version)] = requirementDB
 	}
 
 	for _, requirement := range p.DeploymentRequirement {
@@ -1191,6 +1336,11 @@ func (g *Server) DeleteApplication(ctx context.Context, req *catalogv3.DeleteApp
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.ApplicationType, projectUUID, req.ApplicationName, req.Version, req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	// Check to make sure no deployment_packages refer to this application first
 	events := &ApplicationEvents{}
 	count, err := tx.DeploymentPackage.Query().
@@ -1215,6 +1365,13 @@ func (g *Server) DeleteApplication(ctx context.Context, req *catalogv3.DeleteApp
 			errors.WithMessage("cannot delete application that is part of one or more %s", errors.DeploymentPackageType))
 	}
 
+	before, err := g.applicationSnapshot(ctx, tx, projectUUID, req.ApplicationName, req.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	events.captureBefore(req.ApplicationName, req.Version, before)
+
 	deleteCount, err := tx.Application.Delete().
 		Where(
 			application.ProjectUUID(projectUUID),
@@ -1232,10 +1389,20 @@ func (g *Server) DeleteApplication(ctx context.Context, req *catalogv3.DeleteApp
 			errors.WithResourceName(req.ApplicationName),
 			errors.WithResourceVersion(req.Version))
 	}
+	if err = trashEntity(ctx, tx, projectUUID, errors.ApplicationType, req.ApplicationName, req.Version, before, ""); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	if err = deleteRevisions(ctx, tx, projectUUID, errors.ApplicationType, req.ApplicationName, req.Version); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	events.append(DeletedEvent, projectUUID, &catalogv3.Application{Name: req.ApplicationName, Version: req.Version,
+		Labels: snapshotLabels(before)})
+	err = events.persist(ctx, tx)
 	if _, err = g.checkDeleteResult(ctx, tx, err, fmt.Sprintf("application %s:%s", req.ApplicationName, req.Version), projectUUID); err != nil {
 		return nil, err
 	}
-	events.append(DeletedEvent, projectUUID, &catalogv3.Application{Name: req.ApplicationName, Version: req.Version})
 	events.sendToAll(g.listeners)
 	logActivity(ctx, "deleted", "application", projectUUID, req.ApplicationName, req.Version)
 	return &emptypb.Empty{}, nil
@@ -1256,15 +1423,31 @@ func (g *Server) WatchApplications(req *catalogv3.WatchApplicationsRequest, serv
 			errors.WithResourceType(errors.ApplicationType),
 			errors.WithMessage("incomplete request"))
 	}
+	selector, err := parseLabelSelector(req.LabelSelector, errors.ApplicationType)
+	if err != nil {
+		return err
+	}
 
 	if err := g.authCheckAllowed(server.Context(), req); err != nil {
 		return err
 	}
 
-	ch := make(chan *catalogv3.WatchApplicationsResponse)
+	var l *applicationListener
 
-	// If replay requested
-	if !req.NoReplay {
+	// If resuming, replay the events recorded since the given revision and, once the stream is registered,
+	// any events recorded in the meantime
+	var replayed replayedRevisions
+	if req.ResumeFromRevision > 0 {
+		replayed = replayedRevisions{}
+		if err = g.replayApplicationEvents(server, projectUUID, req.Kinds, req.LabelSelector, req.ResumeFromRevision, replayed); err != nil {
+			return err
+		}
+		l = g.listeners.addApplicationListener(server.Context(), req)
+		if err = g.replayApplicationEvents(server, projectUUID, req.Kinds, req.LabelSelector, req.ResumeFromRevision, replayed); err != nil {
+			g.listeners.deleteApplicationListener(l)
+			return err
+		}
+	} else if !req.NoReplay {
 		// Get list of apps
 		ctx := server.Context()
 		tx, err := g.startTransaction(ctx)
@@ -1272,7 +1455,7 @@ func (g *Server) WatchApplications(req *catalogv3.WatchApplicationsRequest, serv
 			return errors.NewDBError(errors.WithError(err))
 		}
 
-		applications, projectUUIDs, _, err := g.getApplications(ctx, tx, projectUUID, req.Kinds, nil, nil, 0, 0)
+		applications, projectUUIDs, _, _, err := g.getApplications(ctx, tx, projectUUID, req.Kinds, false, nil, nil, selector, &listPage{size: DefaultPageSize})
 		if err != nil {
 			g.rollbackTransaction(tx)
 			return err
@@ -1291,7 +1474,7 @@ func (g *Server) WatchApplications(req *catalogv3.WatchApplicationsRequest, serv
 		}
 
 		// Register the stream, so it can start receiving updates
-		g.listeners.addApplicationListener(ch, req)
+		l = g.listeners.addApplicationListener(server.Context(), req)
 
 		err = g.commitTransaction(tx)
 		if err != nil {
@@ -1299,18 +1482,25 @@ func (g *Server) WatchApplications(req *catalogv3.WatchApplicationsRequest, serv
 		}
 	} else {
 		// Register the stream, so it can start receiving updates
-		g.listeners.addApplicationListener(ch, req)
+		l = g.listeners.addApplicationListener(server.Context(), req)
 	}
-	defer g.listeners.deleteApplicationListener(ch)
+	defer g.listeners.deleteApplicationListener(l)
 	logActivity(server.Context(), "watching", "applications", projectUUID)
-	return g.watchApplicationEvents(server, ch)
+	return g.watchApplicationEvents(server, l, replayed)
 }
 
-func (g *Server) watchApplicationEvents(server catalogv3.CatalogService_WatchApplicationsServer, ch chan *catalogv3.WatchApplicationsResponse) error {
-	for e := range ch {
-		if err := server.Send(e); err != nil {
+func (g *Server) watchApplicationEvents(server catalogv3.CatalogService_WatchApplicationsServer, l *applicationListener, replayed replayedRevisions) error {
+	signalRegistered(server)
+	for {
+		e, err := l.next(server.Context())
+		if err != nil {
+			return err
+		}
+		if alreadyReplayed(e.Event, replayed) {
+			continue
+		}
+		if err = server.Send(e); err != nil {
 			return err
 		}
 	}
-	return nil
 }
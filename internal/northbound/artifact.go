@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
-	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
 	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"github.com/open-edge-platform/app-orch-catalog/pkg/malware"
@@ -55,6 +54,11 @@ func (g *Server) CreateArtifact(ctx context.Context, req *catalogv3.CreateArtifa
 		return nil, err
 	}
 
+	if err = events.persist(ctx, tx); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	err = g.commitTransaction(tx)
 	if err != nil {
 		return nil, errors.NewDBError(errors.WithError(err))
@@ -71,6 +75,7 @@ func (g *Server) CreateArtifact(ctx context.Context, req *catalogv3.CreateArtifa
 			MimeType:    created.MimeType,
 			Artifact:    created.Artifact,
 			CreateTime:  timestamppb.New(created.CreateTime),
+			Etag:        formatETag(created.Etag),
 		},
 	}, nil
 }
@@ -191,7 +196,13 @@ func (g *Server) ListArtifacts(ctx context.Context, req *catalogv3.ListArtifacts
 		return nil, err
 	}
 
-	artifacts, _, totalElements, err := g.getArtifacts(ctx, tx, projectUUID, orderBys, filters, req.PageSize, req.Offset)
+	page, err := newListPage(errors.ArtifactType, req.PageSize, req.Offset, req.PageToken, req.OrderBy, req.Filter, "", req.SkipTotal)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	artifacts, _, totalElements, nextPageToken, err := g.getArtifacts(ctx, tx, projectUUID, orderBys, filters, page)
 	if err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
@@ -202,7 +213,7 @@ func (g *Server) ListArtifacts(ctx context.Context, req *catalogv3.ListArtifacts
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
-	return &catalogv3.ListArtifactsResponse{Artifacts: artifacts, TotalElements: totalElements}, nil
+	return &catalogv3.ListArtifactsResponse{Artifacts: artifacts, TotalElements: totalElements, NextPageToken: nextPageToken}, nil
 }
 
 var artifactColumns = map[string]string{
@@ -216,55 +227,57 @@ var artifactColumns = map[string]string{
 }
 
 func (g *Server) getArtifacts(ctx context.Context, tx *generated.Tx,
-	projectUUID string, orderBys []*orderBy, filters []*filter,
-	pageSize int32, offset int32) ([]*catalogv3.Artifact, []string, int32, error) {
+	projectUUID string, orderBys []*orderBy, filterExpr *filter,
+	page *listPage) ([]*catalogv3.Artifact, []string, int32, string, error) {
 	var err error
-	var artifactsDB []*generated.Artifact
 	var orderOptions []artifact.OrderOption
 	artifactsQuery := tx.Artifact.Query()
 
-	options, err := orderByOptions(orderBys, artifactColumns, errors.ArtifactType)
+	filterPred, err := filterPredicate(filterExpr, artifactColumns, errors.ArtifactType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
+	} else if filterPred != nil {
+		artifactsQuery = artifactsQuery.Where(filterPred)
 	}
-	for _, pred := range options {
-		orderOptions = append(orderOptions, pred)
+
+	if projectUUID != AdminProjectID {
+		artifactsQuery = artifactsQuery.Where(artifact.ProjectUUID(projectUUID))
+	}
+
+	var totalElements int32
+	if !page.skipTotal {
+		count, err := artifactsQuery.Clone().Count(ctx)
+		if err != nil {
+			return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
+		}
+		totalElements = int32(count)
 	}
-	artifactsQuery = artifactsQuery.Order(orderOptions...)
 
-	filterPreds, err := filterPredicates(filters, artifactColumns, errors.ArtifactType)
+	options, err := page.orderOptions(orderBys, artifactColumns, errors.ArtifactType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
 	}
-	var artifactPreds []predicate.Artifact
-	for _, pred := range filterPreds {
-		artifactPreds = append(artifactPreds, pred)
+	for _, pred := range options {
+		orderOptions = append(orderOptions, pred)
 	}
-	artifactsQuery = artifactsQuery.Where(artifact.Or(artifactPreds...))
+	artifactsQuery = artifactsQuery.Order(orderOptions...)
 
-	if projectUUID == AdminProjectID {
-		artifactsDB, err = artifactsQuery.All(ctx)
-	} else {
-		artifactsDB, err = artifactsQuery.Where(artifact.ProjectUUID(projectUUID)).All(ctx)
-	}
+	after, err := page.afterPredicate(orderBys, artifactColumns, errors.ArtifactType)
 	if err != nil {
-		return nil, nil, 0, errors.NewDBError(errors.WithError(err))
+		return nil, nil, 0, "", err
+	} else if after != nil {
+		artifactsQuery = artifactsQuery.Where(after)
 	}
 
-	startIndex, endIndex, totalElements, err := computePageRange(pageSize, offset, len(artifactsDB))
+	artifactsDB, err := artifactsQuery.Offset(page.offset).Limit(page.size + 1).All(ctx)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
 	}
+	artifactsDB, more := pageOf(artifactsDB, page)
 
 	artifacts := make([]*catalogv3.Artifact, 0, len(artifactsDB))
 	projectUUIDs := make([]string, 0, len(artifactsDB))
-
-	if len(artifactsDB) == 0 {
-		return []*catalogv3.Artifact{}, []string{}, 0, nil
-	}
-
-	for i := startIndex; i <= endIndex; i++ {
-		artifactDB := artifactsDB[i]
+	for _, artifactDB := range artifactsDB {
 		artifacts = append(artifacts, &catalogv3.Artifact{
 			Name:        artifactDB.Name,
 			DisplayName: artifactDB.DisplayName,
@@ -273,10 +286,16 @@ func (g *Server) getArtifacts(ctx context.Context, tx *generated.Tx,
 			Artifact:    artifactDB.Artifact,
 			CreateTime:  timestamppb.New(artifactDB.CreateTime),
 			UpdateTime:  timestamppb.New(artifactDB.UpdateTime),
+			Etag:        formatETag(artifactDB.Etag),
 		})
 		projectUUIDs = append(projectUUIDs, artifactDB.ProjectUUID)
 	}
-	return artifacts, projectUUIDs, totalElements, nil
+
+	var nextPageToken string
+	if more {
+		nextPageToken = page.nextToken(orderBys, artifacts[len(artifacts)-1], artifactsDB[len(artifactsDB)-1].ID)
+	}
+	return artifacts, projectUUIDs, totalElements, nextPageToken, nil
 }
 
 // GetArtifact gets a single artifact through gRPC
@@ -317,6 +336,7 @@ func (g *Server) GetArtifact(ctx context.Context, req *catalogv3.GetArtifactRequ
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	setETagHeader(ctx, formatETag(artifactDb.Etag))
 	return &catalogv3.GetArtifactResponse{
 		Artifact: &catalogv3.Artifact{
 			Name:        artifactDb.Name,
@@ -326,6 +346,7 @@ func (g *Server) GetArtifact(ctx context.Context, req *catalogv3.GetArtifactRequ
 			Artifact:    artifactDb.Artifact,
 			CreateTime:  timestamppb.New(artifactDb.CreateTime),
 			UpdateTime:  timestamppb.New(artifactDb.UpdateTime),
+			Etag:        formatETag(artifactDb.Etag),
 		},
 	}, nil
 }
@@ -340,14 +361,10 @@ func (g *Server) UpdateArtifact(ctx context.Context, req *catalogv3.UpdateArtifa
 		return nil, errors.NewInvalidArgument(
 			errors.WithResourceType(errors.ArtifactType),
 			errors.WithMessage("incomplete request"))
-	} else if err := req.Artifact.Validate(); err != nil {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.ArtifactType),
-			errors.WithMessage(err.Error()))
-	} else if req.ArtifactName != req.Artifact.Name {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.ArtifactType),
-			errors.WithMessage("name cannot be changed %s != %s", req.ArtifactName, req.Artifact.Name))
+	} else if !isPartialUpdate(req.UpdateMask) {
+		if err := validateArtifactUpdate(req.ArtifactName, req.Artifact); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := g.authCheckAllowed(ctx, req); err != nil {
@@ -359,8 +376,26 @@ func (g *Server) UpdateArtifact(ctx context.Context, req *catalogv3.UpdateArtifa
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.ArtifactType, projectUUID, req.ArtifactName, "", req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	art := req.Artifact
+	if isPartialUpdate(req.UpdateMask) {
+		if art, err = g.maskedArtifact(ctx, tx, projectUUID, req); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, err
+		}
+	}
+
 	events := &ArtifactEvents{}
-	if err = g.updateArtifact(ctx, tx, projectUUID, req.Artifact, events); err != nil {
+	if err = g.updateArtifact(ctx, tx, projectUUID, art, events); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = events.persist(ctx, tx); err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
@@ -370,12 +405,26 @@ func (g *Server) UpdateArtifact(ctx context.Context, req *catalogv3.UpdateArtifa
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
-	logActivity(ctx, "updated", "artifact", projectUUID, req.Artifact.Name)
+	logActivity(ctx, "updated", "artifact", projectUUID, req.ArtifactName)
 	events.sendToAll(g.listeners)
 
 	return &emptypb.Empty{}, nil
 }
 
+// Validates the artifact given to an update of the named artifact.
+func validateArtifactUpdate(name string, art *catalogv3.Artifact) error {
+	if err := art.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ArtifactType),
+			errors.WithMessage(err.Error()))
+	} else if name != art.Name {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ArtifactType),
+			errors.WithMessage("name cannot be changed %s != %s", name, art.Name))
+	}
+	return nil
+}
+
 func (g *Server) updateArtifact(ctx context.Context, tx *generated.Tx, projectUUID string, art *catalogv3.Artifact, events *ArtifactEvents) error {
 	displayName, ok := validateDisplayName(art.Name, art.DisplayName)
 	if !ok {
@@ -394,6 +443,13 @@ func (g *Server) updateArtifact(ctx context.Context, tx *generated.Tx, projectUU
 		return err
 	}
 
+	before, err := g.artifactSnapshot(ctx, tx, projectUUID, art.Name)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return err
+	}
+	events.captureBefore(art.Name, "", before)
+
 	updateCount, err := tx.Artifact.Update().
 		Where(artifact.ProjectUUID(projectUUID), artifact.Name(art.Name)).
 		SetDisplayName(displayName).
@@ -436,7 +492,19 @@ func (g *Server) DeleteArtifact(ctx context.Context, req *catalogv3.DeleteArtifa
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.ArtifactType, projectUUID, req.ArtifactName, "", req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	events := &ArtifactEvents{}
+	before, err := g.artifactSnapshot(ctx, tx, projectUUID, req.ArtifactName)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	events.captureBefore(req.ArtifactName, "", before)
+
 	deleteCount, err := tx.Artifact.Delete().
 		Where(artifact.ProjectUUID(projectUUID), artifact.Name(req.ArtifactName)).Exec(ctx)
 	if err != nil {
@@ -454,10 +522,11 @@ func (g *Server) DeleteArtifact(ctx context.Context, req *catalogv3.DeleteArtifa
 			errors.WithResourceType(errors.ArtifactType),
 			errors.WithResourceName(req.ArtifactName))
 	}
+	events.append(DeletedEvent, projectUUID, &catalogv3.Artifact{Name: req.ArtifactName})
+	err = events.persist(ctx, tx)
 	if _, err = g.checkDeleteResult(ctx, tx, err, fmt.Sprintf("artifact %s", req.ArtifactName), projectUUID); err != nil {
 		return nil, err
 	}
-	events.append(DeletedEvent, projectUUID, &catalogv3.Artifact{Name: req.ArtifactName})
 	events.sendToAll(g.listeners)
 
 	return &emptypb.Empty{}, nil
@@ -564,10 +633,22 @@ func (g *Server) WatchArtifacts(req *catalogv3.WatchArtifactsRequest, server cat
 		return err
 	}
 
-	ch := make(chan *catalogv3.WatchArtifactsResponse)
+	var l *artifactListener
 
-	// If replay requested
-	if !req.NoReplay {
+	// If resuming, replay the events recorded since the given revision and, once the stream is registered,
+	// any events recorded in the meantime
+	var replayed replayedRevisions
+	if req.ResumeFromRevision > 0 {
+		replayed = replayedRevisions{}
+		if err = g.replayArtifactEvents(server, projectUUID, req.ResumeFromRevision, replayed); err != nil {
+			return err
+		}
+		l = g.listeners.addArtifactListener(server.Context(), req)
+		if err = g.replayArtifactEvents(server, projectUUID, req.ResumeFromRevision, replayed); err != nil {
+			g.listeners.deleteArtifactListener(l)
+			return err
+		}
+	} else if !req.NoReplay {
 		// Get list of artifacts
 		ctx := server.Context()
 		tx, err := g.startTransaction(ctx)
@@ -575,7 +656,7 @@ func (g *Server) WatchArtifacts(req *catalogv3.WatchArtifactsRequest, server cat
 			return errors.NewDBError(errors.WithError(err))
 		}
 
-		artifacts, projectUUIDs, _, err := g.getArtifacts(ctx, tx, projectUUID, nil, nil, 0, 0)
+		artifacts, projectUUIDs, _, _, err := g.getArtifacts(ctx, tx, projectUUID, nil, nil, &listPage{size: DefaultPageSize})
 		if err != nil {
 			g.rollbackTransaction(tx)
 			return err
@@ -595,7 +676,7 @@ func (g *Server) WatchArtifacts(req *catalogv3.WatchArtifactsRequest, server cat
 		}
 
 		// Register the stream, so it can start receiving updates
-		g.listeners.addArtifactListener(ch, req)
+		l = g.listeners.addArtifactListener(server.Context(), req)
 
 		err = g.commitTransaction(tx)
 		if err != nil {
@@ -603,17 +684,24 @@ func (g *Server) WatchArtifacts(req *catalogv3.WatchArtifactsRequest, server cat
 		}
 	} else {
 		// Register the stream, so it can start receiving updates
-		g.listeners.addArtifactListener(ch, req)
+		l = g.listeners.addArtifactListener(server.Context(), req)
 	}
-	defer g.listeners.deleteArtifactListener(ch)
-	return g.watchArtifactEvents(server, ch)
+	defer g.listeners.deleteArtifactListener(l)
+	return g.watchArtifactEvents(server, l, replayed)
 }
 
-func (g *Server) watchArtifactEvents(server catalogv3.CatalogService_WatchArtifactsServer, ch chan *catalogv3.WatchArtifactsResponse) error {
-	for e := range ch {
-		if err := server.Send(e); err != nil {
+func (g *Server) watchArtifactEvents(server catalogv3.CatalogService_WatchArtifactsServer, l *artifactListener, replayed replayedRevisions) error {
+	signalRegistered(server)
+	for {
+		e, err := l.next(server.Context())
+		if err != nil {
+			return err
+		}
+		if alreadyReplayed(e.Event, replayed) {
+			continue
+		}
+		if err = server.Send(e); err != nil {
 			return err
 		}
 	}
-	return nil
 }
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Every change made to the catalog entities, whether through the RPCs or uploads, is recorded in the audit log as
+ * part of the same transaction that makes the change. Each record identifies the user who made the change, the
+ * client and the RPC, and holds the state of the entity before and after the change, along with the names of the
+ * fields that differ.
+ *
+ * The changes to the entities are recorded along with their events in the outbox. Since the events only carry the
+ * state of the entities after the change, the state of each entity being updated or deleted is captured in the
+ * event queue before the change is made.
+ */
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/auditevent"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type auditKey struct {
+	name    string
+	version string
+}
+
+// auditTrail holds the state of the entities captured before they are changed.
+type auditTrail struct {
+	before map[auditKey]proto.Message
+}
+
+// Captures the state of the given entity before it is changed; nil if the entity does not exist.
+func (at *auditTrail) captureBefore(name string, version string, entity proto.Message) {
+	if entity == nil {
+		return
+	}
+	if at.before == nil {
+		at.before = make(map[auditKey]proto.Message)
+	}
+	key := auditKey{name: name, version: version}
+	// Only the state prior to the first change made within the transaction is of interest
+	if _, ok := at.before[key]; !ok {
+		at.before[key] = entity
+	}
+}
+
+// Returns the state of the given entity captured before it was changed, or nil if none was captured.
+func (at *auditTrail) capturedBefore(name string, version string) proto.Message {
+	return at.before[auditKey{name: name, version: version}]
+}
+
+// Records the change to the given entity, of which the event was recorded in the outbox, in the audit log.
+func (at *auditTrail) audit(ctx context.Context, tx *generated.Tx, resourceType errors.ResourceType, event *catalogv3.Event,
+	name string, version string, entity proto.Message) error {
+	eventType := EventType(event.Type)
+	if eventType == DeletedEvent {
+		entity = nil
+	}
+	return recordAudit(ctx, tx.AuditEvent, event.ProjectId, resourceType, name, version, eventType, at.capturedBefore(name, version), entity)
+}
+
+// Records the change made to the given entity in the audit log. Either the before or the after state may be nil,
+// if the entity was created or deleted respectively.
+func recordAudit(ctx context.Context, client *generated.AuditEventClient, projectUUID string, resourceType errors.ResourceType,
+	name string, version string, action EventType, before proto.Message, after proto.Message) error {
+	create := client.Create().
+		SetProjectUUID(projectUUID).
+		SetResourceType(string(resourceType)).
+		SetResourceName(name).
+		SetResourceVersion(version).
+		SetAction(string(action))
+
+	user, clientName := auditIdentity(ctx)
+	create = create.SetUserName(user).SetClient(clientName)
+	if method, ok := grpc.Method(ctx); ok {
+		create = create.SetMethod(method)
+	}
+
+	var changed []string
+	switch {
+	case before != nil && after != nil:
+		changed = changedFields(before, after)
+	case before != nil:
+		changed = changedFields(before, before.ProtoReflect().New().Interface())
+	case after != nil:
+		changed = changedFields(after.ProtoReflect().New().Interface(), after)
+	}
+	create = create.SetChangedFields(changed)
+
+	for _, state := range []struct {
+		entity proto.Message
+		set    func(string) *generated.AuditEventCreate
+	}{{before, create.SetBefore}, {after, create.SetAfter}} {
+		if state.entity == nil {
+			continue
+		}
+		js, err := protojson.Marshal(state.entity)
+		if err != nil {
+			return errors.NewInternal(errors.WithError(err))
+		}
+		state.set(string(js))
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// Returns the name of the user and the client making the request, as carried by the request metadata.
+func auditIdentity(ctx context.Context) (string, string) {
+	var user, client string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if name := md.Get("name"); len(name) > 0 {
+			user = name[0]
+		}
+		if c := md.Get("client"); len(c) > 0 {
+			client = c[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && client == "" && p.Addr != nil {
+		client = p.Addr.String()
+	}
+	return user, client
+}
+
+// Returns the current state of the given registry, or nil if it does not exist.
+func (g *Server) registrySnapshot(ctx context.Context, tx *generated.Tx, projectUUID string, name string, showSensitiveInfo bool) (proto.Message, error) {
+	regDB, err := tx.Registry.Query().Where(registry.ProjectUUID(projectUUID), registry.Name(name)).Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	var secretService SecretService
+	if UseSecretService {
+		secretService, err = SecretServiceFactory(ctx)
+		if err != nil {
+			return nil, errors.NewVaultError(errors.WithError(err))
+		}
+		defer secretService.Logout(ctx)
+	}
+	reg, err := g.extractRegistry(ctx, regDB, secretService, showSensitiveInfo)
+	if err != nil {
+		return nil, err
+	}
+	if !showSensitiveInfo {
+		reg = redactRegistry(reg)
+	}
+	return reg, nil
+}
+
+// Returns the current state of the given artifact, or nil if it does not exist.
+func (g *Server) artifactSnapshot(ctx context.Context, tx *generated.Tx, projectUUID string, name string) (proto.Message, error) {
+	artDB, err := tx.Artifact.Query().Where(artifact.ProjectUUID(projectUUID), artifact.Name(name)).Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return &catalogv3.Artifact{
+		Name:        artDB.Name,
+		DisplayName: artDB.DisplayName,
+		Description: artDB.Description,
+		MimeType:    artDB.MimeType,
+		Artifact:    artDB.Artifact,
+	}, nil
+}
+
+// Returns the current state of the given application, or nil if it does not exist.
+func (g *Server) applicationSnapshot(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) (proto.Message, error) {
+	appDB, ok, err := g.getApplication(ctx, tx, projectUUID, name, version)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	} else if !ok {
+		return nil, nil
+	}
+	return g.applicationExtract(ctx, appDB, "")
+}
+
+// Returns the current state of the given deployment package, or nil if it does not exist.
+func (g *Server) deploymentPackageSnapshot(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) (proto.Message, error) {
+	pkgDB, err := tx.DeploymentPackage.Query().
+		Where(
+			deploymentpackage.ProjectUUID(projectUUID),
+			deploymentpackage.Name(name),
+			deploymentpackage.Version(version),
+		).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return extractDeploymentPackage(ctx, pkgDB)
+}
+
+// ListAuditEvents gets a list of the changes made to the catalog entities through gRPC
+func (g *Server) ListAuditEvents(ctx context.Context, req *catalogv3.ListAuditEventsRequest) (*catalogv3.ListAuditEventsResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage("incomplete request"))
+	} else if err := req.Validate(); err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage(err.Error()))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := g.databaseClient.AuditEvent.Query()
+	if projectUUID != AdminProjectID {
+		query = query.Where(auditevent.ProjectUUID(projectUUID))
+	}
+	if req.ResourceType != "" {
+		query = query.Where(auditevent.ResourceType(req.ResourceType))
+	}
+	if req.ResourceName != "" {
+		query = query.Where(auditevent.ResourceName(req.ResourceName))
+	}
+	if req.ResourceVersion != "" {
+		query = query.Where(auditevent.ResourceVersion(req.ResourceVersion))
+	}
+	if req.User != "" {
+		query = query.Where(auditevent.UserName(req.User))
+	}
+	if req.StartTime != nil {
+		query = query.Where(auditevent.CreateTimeGTE(req.StartTime.AsTime()))
+	}
+	if req.EndTime != nil {
+		query = query.Where(auditevent.CreateTimeLT(req.EndTime.AsTime()))
+	}
+
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	startIndex, endIndex, totalElements, err := computePageRange(req.PageSize, req.Offset, count)
+	if err != nil {
+		return nil, err
+	}
+
+	auditEvents := make([]*catalogv3.AuditEvent, 0)
+	if count > 0 {
+		auditEventsDB, err := query.
+			Order(generated.Desc(auditevent.FieldID)).
+			Offset(startIndex).
+			Limit(endIndex - startIndex + 1).
+			All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+		for _, e := range auditEventsDB {
+			auditEvents = append(auditEvents, &catalogv3.AuditEvent{
+				Id:              e.ID,
+				ProjectId:       e.ProjectUUID,
+				User:            e.UserName,
+				Client:          e.Client,
+				Method:          e.Method,
+				ResourceType:    e.ResourceType,
+				ResourceName:    e.ResourceName,
+				ResourceVersion: e.ResourceVersion,
+				Action:          e.Action,
+				ChangedFields:   e.ChangedFields,
+				Before:          e.Before,
+				After:           e.After,
+				CreateTime:      timestamppb.New(e.CreateTime),
+			})
+		}
+	}
+	return &catalogv3.ListAuditEventsResponse{AuditEvents: auditEvents, TotalElements: totalElements}, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"time"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Returns a context carrying the given project and the name of the user making the requests.
+func (s *NorthBoundTestSuite) userContext(project string, user string) context.Context {
+	return metadata.AppendToOutgoingContext(s.ProjectID(project), "name", user, "client", "catalog-cli")
+}
+
+func (s *NorthBoundTestSuite) listAuditEvents(project string, req *catalogv3.ListAuditEventsRequest) []*catalogv3.AuditEvent {
+	resp, err := s.client.ListAuditEvents(s.ProjectID(project), req)
+	s.NoError(err)
+	s.Equal(int32(len(resp.AuditEvents)), resp.TotalElements)
+	return resp.AuditEvents
+}
+
+func (s *NorthBoundTestSuite) TestAuditDeploymentPackage() {
+	start := timestamppb.Now()
+	s.createDeploymentPkg(footen, "audited", "0.1.0")
+
+	ctx := s.userContext(footen, "alice")
+	getResp, err := s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "audited", Version: "0.1.0",
+	})
+	s.NoError(err)
+	pkg := getResp.DeploymentPackage
+	pkg.Description = "Audited package"
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version, DeploymentPackage: pkg,
+	})
+	s.NoError(err)
+	_, err = s.client.DeleteDeploymentPackage(ctx, &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version,
+	})
+	s.NoError(err)
+
+	events := s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{
+		ResourceType: "deployment-package", ResourceName: "audited", ResourceVersion: "0.1.0",
+	})
+	if !s.Len(events, 3) {
+		return
+	}
+
+	// Most recent changes are listed first
+	deleted, updated, created := events[0], events[1], events[2]
+	s.Equal(string(CreatedEvent), created.Action)
+	s.Empty(created.Before)
+	s.Contains(created.After, "This is deployment package audited")
+	s.Contains(created.ChangedFields, "description")
+	s.Equal("/catalog.v3.CatalogService/CreateDeploymentPackage", created.Method)
+
+	s.Equal(string(UpdatedEvent), updated.Action)
+	s.Equal("alice", updated.User)
+	s.Equal("catalog-cli", updated.Client)
+	s.Equal(footen, updated.ProjectId)
+	s.Equal([]string{"description"}, updated.ChangedFields)
+	s.Contains(updated.Before, "This is deployment package audited")
+	s.Contains(updated.After, "Audited package")
+
+	s.Equal(string(DeletedEvent), deleted.Action)
+	s.Contains(deleted.Before, "Audited package")
+	s.Empty(deleted.After)
+
+	// Filter by user and time range
+	s.Len(s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{User: "alice"}), 2)
+	s.Len(s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{ResourceName: "audited", StartTime: start}), 3)
+	s.Empty(s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{ResourceName: "audited", EndTime: start}))
+	s.Empty(s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{
+		StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+	}))
+
+	// Audit events are private to their project
+	s.Empty(s.listAuditEvents(barten, &catalogv3.ListAuditEventsRequest{ResourceName: "audited"}))
+
+	resp, err := s.client.ListAuditEvents(s.ProjectID(footen), &catalogv3.ListAuditEventsRequest{
+		ResourceName: "audited", PageSize: 1, Offset: 1,
+	})
+	s.NoError(err)
+	if s.Len(resp.AuditEvents, 1) {
+		s.Equal(updated.Id, resp.AuditEvents[0].Id)
+	}
+	s.Equal(int32(3), resp.TotalElements)
+}
+
+func (s *NorthBoundTestSuite) TestAuditRegistrySecrets() {
+	s.createRegistry(footen, "audited", "HELM")
+	_, err := s.client.DeleteRegistry(s.ProjectID(footen), &catalogv3.DeleteRegistryRequest{RegistryName: "audited"})
+	s.NoError(err)
+
+	events := s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{ResourceType: "registry", ResourceName: "audited"})
+	if s.Len(events, 2) {
+		for _, e := range events {
+			s.NotContains(e.Before+e.After, "token")
+			s.NotContains(e.Before+e.After, "cacerts")
+		}
+		s.Contains(events[0].Before, "Registry audited")
+	}
+}
+
+func (s *NorthBoundTestSuite) TestAuditUpload() {
+	s.uploadThings()
+
+	events := s.listAuditEvents("intel", &catalogv3.ListAuditEventsRequest{ResourceType: "deployment-package"})
+	if s.NotEmpty(events) {
+		for _, e := range events {
+			s.Equal(string(CreatedEvent), e.Action)
+			s.Equal("/catalog.v3.CatalogService/UploadCatalogEntities", e.Method)
+		}
+	}
+}
+
+func (s *NorthBoundTestSuite) TestAuditWebhook() {
+	ctx := s.userContext(footen, "bob")
+	s.createWebhook(footen, &catalogv3.Webhook{Name: "hook", Url: "https://tools.example.com/hook", Secret: "s3cret"})
+	_, err := s.client.UpdateWebhook(ctx, &catalogv3.UpdateWebhookRequest{
+		WebhookName: "hook", Webhook: &catalogv3.Webhook{Name: "hook", Url: "https://tools.example.com/other"},
+	})
+	s.NoError(err)
+
+	events := s.listAuditEvents(footen, &catalogv3.ListAuditEventsRequest{ResourceType: "webhook"})
+	if s.Len(events, 2) {
+		s.Equal("bob", events[0].User)
+		s.Equal([]string{"url"}, events[0].ChangedFields)
+		s.NotContains(events[0].Before+events[0].After+events[1].After, "s3cret")
+	}
+}
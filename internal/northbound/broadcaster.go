@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Events are delivered to the watchers connected to the catalog replica that made the change directly; the
+ * broadcaster relays them to the watchers connected to all the other replicas.
+ *
+ * Each event recorded in the outbox carries the ID of the replica that recorded it. Once a transaction that recorded
+ * any events commits, a database trigger notifies the replicas listening on the catalog events channel. The notified
+ * replicas then relay all the events recorded since the last one they have seen, other than their own, to their
+ * local listeners. Catching up from the outbox, rather than relying on the notifications alone, means that no events
+ * are lost when notifications are coalesced or while the listening connection is being re-established.
+ *
+ * Revisions are assigned when the events are recorded rather than when their transactions commit, so an event may
+ * become visible after others with higher revisions have been relayed. Before consulting the outbox, the replicas
+ * note the oldest transaction still in progress; any event not visible yet will be recorded by that transaction or
+ * a more recent one. The next time, the events recorded by those transactions are consulted again regardless of
+ * their revisions, skipping the ones relayed already.
+ */
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	ent "github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/orch-library/go/dazl"
+)
+
+const (
+	// Postgres channel on which the replicas are notified of the events recorded in the outbox
+	eventsChannel = "catalog_events"
+
+	listenerMinReconnectInterval = 1 * time.Second
+	listenerMaxReconnectInterval = 30 * time.Second
+	listenerPingInterval         = 90 * time.Second
+)
+
+// Identifies the events recorded in the outbox by this catalog replica.
+var replicaID = uuid.NewString()
+
+// EventBroadcaster relays the events recorded by other catalog replicas to the local event listeners.
+type EventBroadcaster interface {
+	// Start begins relaying the events to the given listeners.
+	Start(listeners *EventListeners) error
+}
+
+// PostgresBroadcaster is an EventBroadcaster driven by Postgres LISTEN/NOTIFY.
+type PostgresBroadcaster struct {
+	databaseClient *ent.Client
+	dataSourceName string
+	listener       *pq.Listener
+	lastRevision   uint64
+
+	// Oldest transaction in progress when the outbox was last consulted, and the transactions of the events relayed
+	// since then, by revision
+	horizon      uint64
+	relayed      map[uint64]uint64
+	snapshotXmin func(ctx context.Context) (uint64, error)
+	db           *sql.DB
+}
+
+// NewPostgresBroadcaster creates a broadcaster relaying the events recorded in the outbox of the given database,
+// listening for notifications via a dedicated connection to the specified data source.
+func NewPostgresBroadcaster(databaseClient *ent.Client, dataSourceName string) *PostgresBroadcaster {
+	b := &PostgresBroadcaster{
+		databaseClient: databaseClient,
+		dataSourceName: dataSourceName,
+		relayed:        make(map[uint64]uint64),
+	}
+	b.snapshotXmin = b.currentSnapshotXmin
+	return b
+}
+
+// Returns the ID of the oldest transaction currently in progress.
+func (b *PostgresBroadcaster) currentSnapshotXmin(ctx context.Context) (uint64, error) {
+	var xmin uint64
+	err := b.db.QueryRowContext(ctx, "SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint").Scan(&xmin)
+	return xmin, err
+}
+
+// Start begins listening for notifications and relaying the events to the given listeners.
+func (b *PostgresBroadcaster) Start(listeners *EventListeners) error {
+	ctx := context.Background()
+
+	db, err := sql.Open("postgres", b.dataSourceName)
+	if err != nil {
+		return err
+	}
+	db.SetMaxOpenConns(1)
+	b.db = db
+
+	// Events recorded before the replica started are of no interest to its listeners, including those of the
+	// transactions in progress that are visible already
+	if b.horizon, err = b.snapshotXmin(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	last, err := b.databaseClient.OutboxEvent.Query().Order(ent.Desc(outboxevent.FieldID)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return errors.NewDBError(errors.WithError(err))
+	} else if last != nil {
+		b.lastRevision = last.ID
+	}
+	recent, err := b.databaseClient.OutboxEvent.Query().Where(outboxevent.TxIDGTE(b.horizon)).All(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	for _, e := range recent {
+		b.relayed[e.ID] = e.TxID
+	}
+
+	b.listener = pq.NewListener(b.dataSourceName, listenerMinReconnectInterval, listenerMaxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Warnw("Event listener connection problem", dazl.Error(err))
+			}
+		})
+	if err = b.listener.Listen(eventsChannel); err != nil {
+		_ = b.listener.Close()
+		return err
+	}
+	log.Infof("Listening for events of other replicas on channel %s", eventsChannel)
+
+	go b.run(ctx, listeners)
+	return nil
+}
+
+func (b *PostgresBroadcaster) run(ctx context.Context, listeners *EventListeners) {
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case n, ok := <-b.listener.Notify:
+			if !ok {
+				return
+			}
+			// A nil notification is sent after the connection has been re-established, in which case some
+			// notifications may have been missed; the events are relayed from the outbox regardless
+			if n != nil && n.Extra == replicaID {
+				continue
+			}
+			if err := b.relay(ctx, listeners); err != nil {
+				log.Warnw("Unable to relay events", dazl.Error(err))
+			}
+		case <-ticker.C:
+			go func() {
+				_ = b.listener.Ping()
+			}()
+		}
+	}
+}
+
+// Relays the events recorded by other replicas since the last event seen, and those of the transactions that were
+// in progress the last time, to the given listeners.
+func (b *PostgresBroadcaster) relay(ctx context.Context, listeners *EventListeners) error {
+	horizon, err := b.snapshotXmin(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	eventsDB, err := b.databaseClient.OutboxEvent.Query().
+		Where(outboxevent.Or(outboxevent.IDGT(b.lastRevision), outboxevent.TxIDGTE(b.horizon))).
+		Order(ent.Asc(outboxevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+
+	for _, e := range eventsDB {
+		if _, ok := b.relayed[e.ID]; ok {
+			continue
+		}
+		if e.TxID >= horizon {
+			b.relayed[e.ID] = e.TxID
+		}
+		b.lastRevision = max(b.lastRevision, e.ID)
+		if e.Origin == replicaID {
+			continue
+		}
+		if err = relayEvent(listeners, e); err != nil {
+			log.Warnw("Unable to relay event", dazl.Uint64("revision", e.ID), dazl.Error(err))
+		}
+	}
+
+	// Transactions older than the horizon have completed, so their events will not be consulted again
+	b.horizon = horizon
+	for revision, txID := range b.relayed {
+		if txID < horizon {
+			delete(b.relayed, revision)
+		}
+	}
+	return nil
+}
+
+// Sends the given outbox event to the local listeners.
+func relayEvent(listeners *EventListeners, e *ent.OutboxEvent) error {
+	switch errors.ResourceType(e.ResourceType) {
+	case errors.RegistryType:
+		resp, err := decodeEvent(e.Payload, &catalogv3.WatchRegistriesResponse{})
+		if err != nil {
+			return err
+		}
+		resp.Event.Revision = e.ID
+		listeners.sendRegistryEvents(resp)
+	case errors.ArtifactType:
+		resp, err := decodeEvent(e.Payload, &catalogv3.WatchArtifactsResponse{})
+		if err != nil {
+			return err
+		}
+		resp.Event.Revision = e.ID
+		listeners.sendArtifactEvents(resp)
+	case errors.ApplicationType:
+		resp, err := decodeEvent(e.Payload, &catalogv3.WatchApplicationsResponse{})
+		if err != nil {
+			return err
+		}
+		resp.Event.Revision = e.ID
+		listeners.sendApplicationEvents(resp)
+	case errors.DeploymentPackageType:
+		resp, err := decodeEvent(e.Payload, &catalogv3.WatchDeploymentPackagesResponse{})
+		if err != nil {
+			return err
+		}
+		resp.Event.Revision = e.ID
+		listeners.sendDeploymentPackageEvents(resp)
+	}
+	return nil
+}
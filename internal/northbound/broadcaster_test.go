@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returns the builder of a deployment package event to be recorded in the outbox as if by the given replica.
+func (s *NorthBoundTestSuite) newDeploymentPackageEvent(origin string, name string) *generated.OutboxEventCreate {
+	payload, err := proto.Marshal(&catalogv3.WatchDeploymentPackagesResponse{
+		Event:             event(CreatedEvent, footen),
+		DeploymentPackage: &catalogv3.DeploymentPackage{Name: name, Version: "0.1.0", Kind: catalogv3.Kind_KIND_NORMAL},
+	})
+	s.NoError(err)
+	return s.dbClient.OutboxEvent.Create().
+		SetProjectUUID(footen).
+		SetResourceType(string(errors.DeploymentPackageType)).
+		SetEventType(string(CreatedEvent)).
+		SetPayload(payload).
+		SetOrigin(origin)
+}
+
+// Records a deployment package event in the outbox as if it were recorded by the given replica.
+func (s *NorthBoundTestSuite) recordDeploymentPackageEvent(origin string, name string) uint64 {
+	e, err := s.newDeploymentPackageEvent(origin, name).Save(s.ctx)
+	s.NoError(err)
+	return e.ID
+}
+
+func (s *NorthBoundTestSuite) TestBroadcasterRelay() {
+	b := NewPostgresBroadcaster(s.dbClient, "")
+	b.snapshotXmin = func(context.Context) (uint64, error) { return 0, nil }
+	b.lastRevision = s.lastRevision()
+
+	listeners := NewEventListeners()
+	l := listeners.addDeploymentPackageListener(s.ctx, &catalogv3.WatchDeploymentPackagesRequest{ProjectId: footen})
+
+	// Events recorded by this replica have already been delivered to its listeners
+	s.recordDeploymentPackageEvent(replicaID, "local")
+	revision := s.recordDeploymentPackageEvent("other-replica", "remote")
+
+	s.NoError(b.relay(s.ctx, listeners))
+	if s.Len(l.queue, 1) {
+		resp := <-l.queue
+		s.Equal("remote", resp.DeploymentPackage.Name)
+		s.Equal(revision, resp.Event.Revision)
+		s.Equal(CreatedEvent, EventType(resp.Event.Type))
+	}
+	s.Equal(revision, b.lastRevision)
+
+	// Events are relayed only once
+	s.NoError(b.relay(s.ctx, listeners))
+	s.Len(l.queue, 0)
+}
+
+func (s *NorthBoundTestSuite) TestBroadcasterRelayLateCommit() {
+	// The events recorded by the transaction in progress remain invisible until it commits
+	inProgress := uint64(10)
+	s.dbClient.OutboxEvent.Intercept(generated.TraverseFunc(func(_ context.Context, q generated.Query) error {
+		q.(*generated.OutboxEventQuery).Where(outboxevent.Or(outboxevent.TxIDIsNil(), outboxevent.TxIDNEQ(inProgress)))
+		return nil
+	}))
+
+	xmin := inProgress
+	b := NewPostgresBroadcaster(s.dbClient, "")
+	b.snapshotXmin = func(context.Context) (uint64, error) { return xmin, nil }
+	b.lastRevision = s.lastRevision()
+	b.horizon = xmin
+
+	listeners := NewEventListeners()
+	l := listeners.addDeploymentPackageListener(s.ctx, &catalogv3.WatchDeploymentPackagesRequest{ProjectId: footen})
+
+	late, err := s.newDeploymentPackageEvent("other-replica", "late").SetTxID(10).SetTxXmin(10).Save(s.ctx)
+	s.NoError(err)
+	seen, err := s.newDeploymentPackageEvent("other-replica", "seen").SetTxID(11).SetTxXmin(10).Save(s.ctx)
+	s.NoError(err)
+	s.Less(late.ID, seen.ID)
+
+	s.NoError(b.relay(s.ctx, listeners))
+	if s.Len(l.queue, 1) {
+		s.Equal("seen", (<-l.queue).DeploymentPackage.Name)
+	}
+	s.Equal(seen.ID, b.lastRevision)
+
+	// The event with the lower revision is relayed once its transaction commits
+	inProgress = 0
+	s.NoError(b.relay(s.ctx, listeners))
+	if s.Len(l.queue, 1) {
+		resp := <-l.queue
+		s.Equal("late", resp.DeploymentPackage.Name)
+		s.Equal(late.ID, resp.Event.Revision)
+	}
+	s.Equal(seen.ID, b.lastRevision)
+
+	// Events are relayed only once, and forgotten once their transactions are older than any in progress
+	s.NoError(b.relay(s.ctx, listeners))
+	s.Len(l.queue, 0)
+	xmin = 12
+	s.NoError(b.relay(s.ctx, listeners))
+	s.Len(l.queue, 0)
+	s.Empty(b.relayed)
+}
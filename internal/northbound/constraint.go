@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Application references and deployment requirements may name a version constraint instead of an exact version:
+ * "latest" matches any release, "~1.4" any 1.4.x release and "^2.0" any 2.x release, following the npm conventions.
+ * Constraints are stored as given and resolved to the highest matching release; pre-releases never match. The
+ * references are linked to their resolution when written, ResolveDeploymentPackage reports the resolution at that
+ * moment, and deploying a deployment package pins its resolution, so that deployed packages remain reproducible.
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentrequirement"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/profile"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const latestVersion = "latest"
+
+// Returns true if the given version is a constraint rather than an exact version.
+func isVersionConstraint(version string) bool {
+	return version == latestVersion || strings.HasPrefix(version, "~") || strings.HasPrefix(version, "^")
+}
+
+// versionRange is the range of releases matched by a version constraint; the upper bound is exclusive and absent
+// for constraints without one.
+type versionRange struct {
+	lower semver.Version
+	upper *semver.Version
+}
+
+// Parses the given version constraint into the range of releases it matches.
+func parseVersionConstraint(constraint string) (*versionRange, error) {
+	if constraint == latestVersion {
+		return &versionRange{}, nil
+	}
+	if !isVersionConstraint(constraint) {
+		return nil, errors.NewInvalidArgument(errors.WithMessage("%s is not a version constraint", constraint))
+	}
+	base := strings.TrimPrefix(constraint[1:], "v")
+	v, err := semver.ParseTolerant(base)
+	if err != nil || len(v.Pre) > 0 || len(v.Build) > 0 || strings.Count(base, ".") > 2 {
+		return nil, errors.NewInvalidArgument(errors.WithMessage("invalid version constraint %s", constraint))
+	}
+
+	// The components given determine which of them may vary; ~1 matches any 1.x, but ~1.4 only any 1.4.x
+	parts := strings.Count(base, ".") + 1
+	var upper semver.Version
+	switch {
+	case constraint[0] == '~' && parts == 1:
+		upper = semver.Version{Major: v.Major + 1}
+	case constraint[0] == '~':
+		upper = semver.Version{Major: v.Major, Minor: v.Minor + 1}
+	case v.Major > 0 || parts == 1:
+		upper = semver.Version{Major: v.Major + 1}
+	case v.Minor > 0 || parts == 2:
+		upper = semver.Version{Minor: v.Minor + 1}
+	default:
+		upper = semver.Version{Patch: v.Patch + 1}
+	}
+	return &versionRange{lower: v, upper: &upper}, nil
+}
+
+// Returns true if the given version is a release within the range.
+func (r *versionRange) matches(version string) bool {
+	v, err := semver.ParseTolerant(version)
+	if err != nil || len(v.Pre) > 0 {
+		return false
+	}
+	return v.GE(r.lower) && (r.upper == nil || v.LT(*r.upper))
+}
+
+// Returns the candidate with the highest version that satisfies the given constraint, and false if none does.
+func highestMatching[T any](constraint string, candidates []T, version func(T) string) (T, bool, error) {
+	var highest T
+	r, err := parseVersionConstraint(constraint)
+	if err != nil {
+		return highest, false, err
+	}
+	found := false
+	for _, candidate := range candidates {
+		if r.matches(version(candidate)) && (!found || VersionKey(version(candidate)) > VersionKey(version(highest))) {
+			highest, found = candidate, true
+		}
+	}
+	return highest, found, nil
+}
+
+// Resolves the given application version, which may be a constraint, to the application it refers to.
+func (g *Server) resolveApplication(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) (*generated.Application, error) {
+	notFound := errors.NewInvalidArgument(
+		errors.WithResourceType(errors.ApplicationReferenceType),
+		errors.WithMessage("application reference not found"),
+		errors.WithResourceName(name),
+		errors.WithResourceVersion(version))
+	if !isVersionConstraint(version) {
+		app, ok, err := g.getApplication(ctx, tx, projectUUID, name, version)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, notFound
+		}
+		return app, nil
+	}
+
+	appsDB, err := tx.Application.Query().
+		Where(application.ProjectUUID(projectUUID), application.Name(name)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	app, ok, err := highestMatching(version, appsDB, func(a *generated.Application) string { return a.Version })
+	if err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationReferenceType),
+			errors.WithResourceName(name),
+			errors.WithMessage("invalid version constraint %s", version))
+	} else if !ok {
+		return nil, notFound
+	}
+	return app, nil
+}
+
+// Resolves the given deployment package version, which may be a constraint, to the deployment package it refers to.
+func (g *Server) resolveRequiredDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) (*generated.DeploymentPackage, error) {
+	notFound := errors.NewNotFound(
+		errors.WithResourceType(errors.DeploymentPackageType),
+		errors.WithMessage("deployment package %s not found", name))
+	if !isVersionConstraint(version) {
+		pkgDB, ok, err := g.getDeploymentPackage(ctx, tx, projectUUID, name, version)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, notFound
+		}
+		return pkgDB, nil
+	}
+
+	pkgsDB, err := tx.DeploymentPackage.Query().
+		Where(deploymentpackage.ProjectUUID(projectUUID), deploymentpackage.Name(name)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	pkgDB, ok, err := highestMatching(version, pkgsDB, func(p *generated.DeploymentPackage) string { return p.Version })
+	if err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithResourceName(name),
+			errors.WithMessage("invalid version constraint %s", version))
+	} else if !ok {
+		return nil, notFound
+	}
+	return pkgDB, nil
+}
+
+// ResolveDeploymentPackage resolves the version constraints of a deployment package through gRPC
+func (g *Server) ResolveDeploymentPackage(ctx context.Context, req *catalogv3.ResolveDeploymentPackageRequest) (*catalogv3.ResolveDeploymentPackageResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.DeploymentPackageName == "" || req.Version == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err = g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	pkgDB, ok, err := g.getDeploymentPackage(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	} else if !ok {
+		g.rollbackTransaction(tx)
+		return nil, errors.NewNotFound(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithResourceName(req.DeploymentPackageName),
+			errors.WithResourceVersion(req.Version))
+	}
+
+	var resp *catalogv3.ResolveDeploymentPackageResponse
+	if pkgDB.PinnedResolution != "" {
+		resp = &catalogv3.ResolveDeploymentPackageResponse{}
+		if err = protojson.Unmarshal([]byte(pkgDB.PinnedResolution), resp); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, errors.NewInternal(errors.WithError(err))
+		}
+	} else if resp, _, err = g.resolveDeploymentPackage(ctx, tx, projectUUID, pkgDB); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = g.commitTransaction(tx); err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return resp, nil
+}
+
+// Resolves the application references of the given deployment package, and the deployment requirements of the
+// profiles of the resolved applications, to their exact versions at this moment. Returns the resolution along with
+// the resolved applications.
+func (g *Server) resolveDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string,
+	pkgDB *generated.DeploymentPackage) (*catalogv3.ResolveDeploymentPackageResponse, []*generated.Application, error) {
+	refs, err := extractApplicationReferences(ctx, pkgDB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := &catalogv3.ResolveDeploymentPackageResponse{
+		ApplicationReferences:  make([]*catalogv3.ApplicationReference, 0, len(refs)),
+		DeploymentRequirements: make([]*catalogv3.ResolvedDeploymentRequirement, 0),
+	}
+	appsDB := make([]*generated.Application, 0, len(refs))
+	for _, ref := range refs {
+		appDB, err := g.resolveApplication(ctx, tx, projectUUID, ref.Name, ref.Version)
+		if err != nil {
+			return nil, nil, err
+		}
+		appsDB = append(appsDB, appDB)
+		resp.ApplicationReferences = append(resp.ApplicationReferences,
+			&catalogv3.ApplicationReference{Name: appDB.Name, Version: appDB.Version})
+
+		profilesDB, err := appDB.QueryProfiles().Order(profile.ByID()).All(ctx)
+		if err != nil {
+			return nil, nil, errors.NewDBError(errors.WithError(err))
+		}
+		for _, profileDB := range profilesDB {
+			requirementsDB, err := profileDB.QueryDeploymentRequirements().
+				Order(deploymentrequirement.ByID()).
+				WithDeploymentPackageFk().
+				WithDeploymentProfileFk().
+				All(ctx)
+			if err != nil {
+				return nil, nil, errors.NewDBError(errors.WithError(err))
+			}
+			for _, drDB := range requirementsDB {
+				required := drDB.Edges.DeploymentPackageFk
+				if drDB.VersionConstraint != "" {
+					if required, err = g.resolveRequiredDeploymentPackage(ctx, tx, projectUUID, required.Name, drDB.VersionConstraint); err != nil {
+						return nil, nil, err
+					}
+				}
+				requirement := &catalogv3.DeploymentRequirement{Name: required.Name, Version: required.Version}
+				if drDB.Edges.DeploymentProfileFk != nil {
+					requirement.DeploymentProfileName = drDB.Edges.DeploymentProfileFk.Name
+				}
+				resp.DeploymentRequirements = append(resp.DeploymentRequirements, &catalogv3.ResolvedDeploymentRequirement{
+					ApplicationName:       appDB.Name,
+					ProfileName:           profileDB.Name,
+					DeploymentRequirement: requirement,
+				})
+			}
+		}
+	}
+	return resp, appsDB, nil
+}
+
+// Pins the resolution of the given deployment package when it becomes deployed, linking it to the applications
+// its constraints resolve to, and releases the pinned resolution when it is no longer deployed.
+func (g *Server) updatePinnedResolution(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string, wasDeployed bool) error {
+	pkgDB, ok, err := g.getDeploymentPackage(ctx, tx, projectUUID, name, version)
+	if err != nil || !ok || pkgDB.IsDeployed == wasDeployed {
+		return err
+	}
+	if !pkgDB.IsDeployed {
+		if err = pkgDB.Update().ClearPinnedResolution().Exec(ctx); err != nil {
+			return errors.NewDBError(errors.WithError(err))
+		}
+		return nil
+	}
+
+	resolution, appsDB, err := g.resolveDeploymentPackage(ctx, tx, projectUUID, pkgDB)
+	if err != nil {
+		return err
+	}
+	if err = g.relinkApplications(ctx, tx, pkgDB, resolution.ApplicationReferences, appsDB); err != nil {
+		return err
+	}
+
+	resolution.Pinned = true
+	js, err := protojson.Marshal(resolution)
+	if err != nil {
+		return errors.NewInternal(errors.WithError(err))
+	}
+	if err = pkgDB.Update().SetPinnedResolution(string(js)).Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// Links the given deployment package to the given applications, along with its application dependencies, namespaces
+// and deployment profiles, unless it is already linked to them.
+func (g *Server) relinkApplications(ctx context.Context, tx *generated.Tx, pkgDB *generated.DeploymentPackage,
+	refs []*catalogv3.ApplicationReference, appsDB []*generated.Application) error {
+	linkedIDs, err := pkgDB.QueryApplications().IDs(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	linked := make(map[uint64]bool, len(linkedIDs))
+	for _, id := range linkedIDs {
+		linked[id] = true
+	}
+	appIDs := make([]uint64, 0, len(appsDB))
+	relink := len(linkedIDs) != len(appsDB)
+	for _, appDB := range appsDB {
+		appIDs = append(appIDs, appDB.ID)
+		relink = relink || !linked[appDB.ID]
+	}
+	if !relink {
+		return nil
+	}
+
+	pkg, err := extractDeploymentPackage(ctx, pkgDB)
+	if err != nil {
+		return err
+	}
+	if pkg.Profiles, err = extractDeploymentProfiles(ctx, pkgDB, false, false); err != nil {
+		return err
+	}
+	pkg.ApplicationReferences = refs
+
+	if err = pkgDB.Update().ClearApplications().AddApplicationIDs(appIDs...).Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	if err = g.updateApplicationDependencies(ctx, tx, pkg, pkgDB); err != nil {
+		return err
+	}
+	if err = g.updateApplicationNamespaces(ctx, tx, pkg, pkgDB); err != nil {
+		return err
+	}
+	return g.updateDeploymentProfiles(ctx, tx, pkg, pkgDB)
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"testing"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestVersionConstraints(t *testing.T) {
+	versions := []string{"0.0.3", "0.0.4", "0.2.0", "0.2.5", "0.3.0", "1.3.9", "v1.4.0", "1.4.7", "1.5.0", "1.9.2",
+		"2.0.0-rc.1", "2.0.0", "2.3.1", "3.0.0-beta", "main"}
+	for constraint, expected := range map[string]string{
+		"latest": "2.3.1",
+		"~1":     "1.9.2",
+		"~1.4":   "1.4.7",
+		"~v1.4":  "1.4.7",
+		"~1.4.3": "1.4.7",
+		"~1.6":   "",
+		"^1":     "1.9.2",
+		"^1.4.5": "1.9.2",
+		"^2.0":   "2.3.1",
+		"^0":     "0.3.0",
+		"^0.2":   "0.2.5",
+		"^0.2.3": "0.2.5",
+		"^0.0":   "0.0.4",
+		"^0.0.3": "0.0.3",
+		"^3":     "",
+	} {
+		highest, ok, err := highestMatching(constraint, versions, func(v string) string { return v })
+		assert.NoError(t, err, constraint)
+		assert.Equal(t, expected != "", ok, constraint)
+		assert.Equal(t, expected, highest, constraint)
+	}
+
+	for _, constraint := range []string{"1.4.0", "~", "^x", "~1.4.x", "^1.2.3.4", "~1.4-rc.1"} {
+		_, _, err := highestMatching(constraint, versions, func(v string) string { return v })
+		assert.Error(t, err, constraint)
+	}
+	assert.True(t, isVersionConstraint("latest"))
+	assert.False(t, isVersionConstraint("v0.1.0"))
+}
+
+func (s *NorthBoundTestSuite) TestResolveVersionConstraints() {
+	ctx := s.ProjectID(footen)
+	for _, version := range []string{"1.4.0", "1.4.2", "1.5.0", "2.0.0", "2.1.0-rc.1"} {
+		s.createApp(footen, fooreg, "ranged", version, 2)
+	}
+	s.createDeploymentPkg(footen, "ranged-latest", "0.1.0", "ranged:latest", "foo:v0.1.0")
+	s.createDeploymentProfile(footen, "ranged-latest", "0.1.0", "dp", map[string]string{"ranged": "p2", "foo": "p1"})
+	s.createDeploymentPkg(footen, "ranged-tilde", "0.1.0", "ranged:~1.4")
+	s.createDeploymentPkg(footen, "ranged-caret", "0.1.0", "ranged:^1.0")
+
+	resolved := func(name string) (string, bool) {
+		resp, err := s.client.ResolveDeploymentPackage(ctx, &catalogv3.ResolveDeploymentPackageRequest{
+			DeploymentPackageName: name, Version: "0.1.0",
+		})
+		s.NoError(err)
+		for _, ref := range resp.ApplicationReferences {
+			if ref.Name == "ranged" {
+				return ref.Version, resp.Pinned
+			}
+		}
+		return "", resp.Pinned
+	}
+	version, _ := resolved("ranged-tilde")
+	s.Equal("1.4.2", version)
+	version, _ = resolved("ranged-caret")
+	s.Equal("1.5.0", version)
+	version, _ = resolved("ranged-latest")
+	s.Equal("2.0.0", version)
+
+	// The constraints are kept as given
+	resp, err := s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{DeploymentPackageName: "ranged-latest", Version: "0.1.0"})
+	s.NoError(err)
+	s.ElementsMatch(appReferences("ranged:latest", "foo:v0.1.0"), resp.DeploymentPackage.ApplicationReferences)
+
+	// Newer versions are picked up until the package is deployed, which pins the resolution
+	s.createApp(footen, fooreg, "ranged", "2.1.0", 2)
+	version, pinned := resolved("ranged-latest")
+	s.Equal("2.1.0", version)
+	s.False(pinned)
+
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ranged-latest", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{IsDeployed: true},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"is_deployed"}},
+	})
+	s.NoError(err)
+	s.createApp(footen, fooreg, "ranged", "2.2.0", 2)
+	version, pinned = resolved("ranged-latest")
+	s.Equal("2.1.0", version)
+	s.True(pinned)
+
+	// The deployed package links the pinned application, keeping its deployment profiles
+	resp, err = s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{DeploymentPackageName: "ranged-latest", Version: "0.1.0"})
+	s.NoError(err)
+	s.ElementsMatch(appReferences("ranged:latest", "foo:v0.1.0"), resp.DeploymentPackage.ApplicationReferences)
+	if s.Len(resp.DeploymentPackage.Profiles, 1) {
+		s.Equal(map[string]string{"ranged": "p2", "foo": "p1"}, resp.DeploymentPackage.Profiles[0].ApplicationProfiles)
+	}
+	_, err = s.client.DeleteApplication(ctx, &catalogv3.DeleteApplicationRequest{ApplicationName: "ranged", Version: "2.1.0"})
+	s.Error(err)
+
+	// Releasing the package releases the pinned resolution
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ranged-latest", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{IsDeployed: false},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"is_deployed"}},
+	})
+	s.NoError(err)
+	version, pinned = resolved("ranged-latest")
+	s.Equal("2.2.0", version)
+	s.False(pinned)
+
+	// Constraints must be satisfiable and well formed
+	for _, ref := range []string{"ranged:^3.0", "ranged:~1.x"} {
+		_, err = s.client.CreateDeploymentPackage(ctx, &catalogv3.CreateDeploymentPackageRequest{DeploymentPackage: &catalogv3.DeploymentPackage{
+			Name: "ranged-bad", Version: "0.1.0", ApplicationReferences: appReferences(ref),
+		}})
+		s.Equal(codes.InvalidArgument, status.Code(err), ref)
+	}
+}
+
+func (s *NorthBoundTestSuite) TestResolveDeploymentRequirementConstraints() {
+	ctx := s.ProjectID(footen)
+	app := &catalogv3.Application{
+		Name: "requiring", Version: "1.0.0", ChartName: "requiring", ChartVersion: "1.0.0", HelmRegistryName: fooreg,
+		Profiles: []*catalogv3.Profile{{
+			Name: "p1",
+			DeploymentRequirement: []*catalogv3.DeploymentRequirement{
+				{Name: "ca-gigi", Version: "^0.2"},
+				{Name: "ca-fifi", Version: "latest"},
+			},
+		}},
+		DefaultProfileName: "p1",
+	}
+	_, err := s.client.CreateApplication(ctx, &catalogv3.CreateApplicationRequest{Application: app})
+	s.NoError(err)
+
+	got, err := s.client.GetApplication(ctx, &catalogv3.GetApplicationRequest{ApplicationName: "requiring", Version: "1.0.0"})
+	s.NoError(err)
+	if s.Len(got.Application.Profiles, 1) {
+		s.ElementsMatch([]string{"^0.2", "latest"}, []string{
+			got.Application.Profiles[0].DeploymentRequirement[0].Version,
+			got.Application.Profiles[0].DeploymentRequirement[1].Version,
+		})
+	}
+
+	// Updating the application with the same constraints is not a change
+	_, err = s.client.UpdateApplication(ctx, &catalogv3.UpdateApplicationRequest{
+		ApplicationName: "requiring", Version: "1.0.0", Application: got.Application,
+	})
+	s.NoError(err)
+
+	s.createDeploymentPkg(footen, "requiring", "0.1.0", "requiring:1.0.0")
+	resp, err := s.client.ResolveDeploymentPackage(ctx, &catalogv3.ResolveDeploymentPackageRequest{
+		DeploymentPackageName: "requiring", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.Equal(appReferences("requiring:1.0.0"), resp.ApplicationReferences)
+	versions := map[string]string{}
+	for _, dr := range resp.DeploymentRequirements {
+		s.Equal("requiring", dr.ApplicationName)
+		s.Equal("p1", dr.ProfileName)
+		versions[dr.DeploymentRequirement.Name] = dr.DeploymentRequirement.Version
+	}
+	s.Equal(map[string]string{"ca-gigi": "v0.2.1", "ca-fifi": "v0.2.0"}, versions)
+
+	_, err = s.client.ResolveDeploymentPackage(ctx, &catalogv3.ResolveDeploymentPackageRequest{
+		DeploymentPackageName: "requiring", Version: "9.9.9",
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+}
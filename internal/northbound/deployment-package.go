@@ -8,12 +8,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/namespace"
-	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
+	"maps"
 	"reflect"
 	"strings"
 
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
-	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/applicationdependency"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/applicationnamespace"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
@@ -25,6 +24,7 @@ import (
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // CreateDeploymentPackage creates an CreateDeploymentPackage from gRPC request
@@ -61,6 +61,24 @@ func (g *Server) CreateDeploymentPackage(ctx context.Context, req *catalogv3.Cre
 		return nil, err
 	}
 
+	// The default profile is set after the row is created, which changes its entity tag
+	etag, _, err := currentETag(ctx, tx, errors.DeploymentPackageType, projectUUID, created.Name, created.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	warnings, err := g.deprecatedApplicationWarnings(ctx, tx, projectUUID, created.Name, created.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = events.persist(ctx, tx); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	err = g.commitTransaction(tx)
 	if err != nil {
 		return nil, errors.NewDBError(errors.WithError(err))
@@ -88,8 +106,12 @@ func (g *Server) CreateDeploymentPackage(ctx context.Context, req *catalogv3.Cre
 			Artifacts:                  pkg.Artifacts,
 			ForbidsMultipleDeployments: pkg.ForbidsMultipleDeployments,
 			Kind:                       kindFromDB(created.Kind),
+			Labels:                     extractedLabels(created.Labels),
+			Deprecation:                extractedDeprecation(created.Deprecated, created.DeprecationMessage, created.ReplacementVersion, created.DeprecateTime),
 			CreateTime:                 timestamppb.New(created.CreateTime),
+			Etag:                       formatETag(etag),
 		},
+		Warnings: warnings,
 	}, nil
 }
 
@@ -148,6 +170,13 @@ func (g *Server) createDeploymentPackage(ctx context.Context, tx *generated.Tx,
 			errors.WithMessage("display name cannot contain leading or trailing spaces"))
 	}
 
+	if err := validateLabels(pkg.Labels, errors.DeploymentPackageType, pkg.Name); err != nil {
+		return nil, err
+	} else if err := validateDeprecation(pkg.Deprecation, errors.DeploymentPackageType, pkg.Name, pkg.Version); err != nil {
+		return nil, err
+	}
+	stampDeprecation(pkg.Deprecation, nil)
+
 	// Make sure that the display name, if specified is unique
 	if err := g.checkDeploymentPackageUniqueness(ctx, tx, projectUUID, pkg); err != nil {
 		return nil, err
@@ -160,10 +189,17 @@ func (g *Server) createDeploymentPackage(ctx context.Context, tx *generated.Tx,
 		SetDisplayNameLc(strings.ToLower(displayName)).
 		SetDescription(pkg.Description).
 		SetVersion(pkg.Version).
+		SetVersionKey(VersionKey(pkg.Version)).
 		SetIsVisible(pkg.IsVisible).
 		SetIsDeployed(pkg.IsDeployed).
 		SetAllowsMultipleDeployments(!pkg.ForbidsMultipleDeployments).
-		SetKind(kindToDB(pkg.Kind))
+		SetSearchText(deploymentPackageSearchText(pkg)).
+		SetKind(kindToDB(pkg.Kind)).
+		SetLabels(storedLabels(pkg.Labels)).
+		SetDeprecated(pkg.Deprecation != nil).
+		SetDeprecationMessage(pkg.Deprecation.GetMessage()).
+		SetReplacementVersion(pkg.Deprecation.GetReplacementVersion()).
+		SetNillableDeprecateTime(deprecateTime(pkg.Deprecation))
 
 	created, err := stmt.Save(ctx)
 	if err != nil {
@@ -217,6 +253,11 @@ func (g *Server) createDeploymentPackage(ctx context.Context, tx *generated.Tx,
 		return nil, err
 	}
 
+	// Pin the resolution of any version constraints, if created as deployed
+	if err = g.updatePinnedResolution(ctx, tx, projectUUID, pkg.Name, pkg.Version, false); err != nil {
+		return nil, err
+	}
+
 	events.append(CreatedEvent, projectUUID, pkg)
 	return created, nil
 }
@@ -239,24 +280,30 @@ func (g *Server) checkDeploymentPackageUniqueness(ctx context.Context, tx *gener
 
 // Returns an error if the deployment package is deployed
 func (g *Server) checkDeploymentPackageNotDeployed(ctx context.Context, tx *generated.Tx, projectUUID string, pkg *catalogv3.DeploymentPackage) error {
+	if !pkg.IsDeployed {
+		return nil
+	}
+	return checkPackageNotDeployed(ctx, tx, projectUUID, pkg.Name, pkg.Version)
+}
+
+// Returns an error if the stored deployment package is deployed. Deployment packages that do not exist are left for
+// the change itself to report.
+func checkPackageNotDeployed(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) error {
 	first, err := tx.DeploymentPackage.Query().
 		Where(
 			deploymentpackage.ProjectUUID(projectUUID),
-			deploymentpackage.And(deploymentpackage.Name(pkg.Name)),
-			deploymentpackage.And(deploymentpackage.Version(pkg.Version))).First(ctx)
-
-	if err != nil {
-		if generated.IsNotFound(err) {
-			return nil
-		}
+			deploymentpackage.Name(name),
+			deploymentpackage.Version(version)).
+		First(ctx)
+	if generated.IsNotFound(err) {
+		return nil
+	} else if err != nil {
 		return errors.NewDBError(errors.WithError(err))
-	}
-
-	if first.IsDeployed && pkg.IsDeployed {
+	} else if first.IsDeployed {
 		return errors.NewFailedPrecondition(
 			errors.WithResourceType(errors.DeploymentPackageType),
-			errors.WithResourceName(pkg.Name),
-			errors.WithResourceVersion(pkg.Version),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
 			errors.WithMessage("cannot modify deployed package"))
 	}
 	return nil
@@ -264,27 +311,32 @@ func (g *Server) checkDeploymentPackageNotDeployed(ctx context.Context, tx *gene
 
 func (g *Server) createApplicationReferences(ctx context.Context, tx *generated.Tx, projectUUID string, pkg *catalogv3.DeploymentPackage, pkgDB *generated.DeploymentPackage) error {
 	appIDs := make([]uint64, 0)
+	constraints := make(map[string]string, 0)
 	for _, appRef := range pkg.ApplicationReferences {
-		appID, err := tx.Application.Query().
-			Where(
-				application.ProjectUUID(projectUUID),
-				application.Name(appRef.Name),
-				application.Version(appRef.Version),
-			).FirstID(ctx)
-		appIDs = append(appIDs, appID)
-		if err != nil {
-			if generated.IsNotFound(err) {
+		if isVersionConstraint(appRef.Version) {
+			// Constraints are recorded by application name, which must therefore be unique
+			if hasDuplicateAppNames(pkg.ApplicationReferences) {
 				return errors.NewInvalidArgument(
 					errors.WithResourceType(errors.ApplicationReferenceType),
-					errors.WithMessage("application reference not found"),
 					errors.WithResourceName(appRef.Name),
-					errors.WithResourceVersion(appRef.Version))
+					errors.WithResourceVersion(appRef.Version),
+					errors.WithMessage("version constraint cannot be used when application names are not unique"))
 			}
-			return errors.NewDBError(errors.WithError(err))
+			constraints[appRef.Name] = appRef.Version
+		}
+		appDB, err := g.resolveApplication(ctx, tx, projectUUID, appRef.Name, appRef.Version)
+		if err != nil {
+			return err
 		}
+		appIDs = append(appIDs, appDB.ID)
 	}
-	err := pkgDB.Update().ClearApplications().AddApplicationIDs(appIDs...).Exec(ctx)
-	if err != nil {
+	stmt := pkgDB.Update().ClearApplications().AddApplicationIDs(appIDs...)
+	if len(constraints) > 0 {
+		stmt.SetApplicationConstraints(constraints)
+	} else {
+		stmt.ClearApplicationConstraints()
+	}
+	if err := stmt.Exec(ctx); err != nil {
 		return errors.NewDBError(errors.WithError(err))
 	}
 	return nil
@@ -528,8 +580,19 @@ func (g *Server) ListDeploymentPackages(ctx context.Context, req *catalogv3.List
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
+	selector, err := parseLabelSelector(req.LabelSelector, errors.DeploymentPackageType)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
 
-	packages, _, totalElements, err := g.getDeploymentPackages(ctx, tx, projectUUID, req.Kinds, orderBys, filters, req.PageSize, req.Offset)
+	page, err := newListPage(errors.DeploymentPackageType, req.PageSize, req.Offset, req.PageToken, req.OrderBy, req.Filter, req.LabelSelector, req.SkipTotal)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	packages, _, totalElements, nextPageToken, err := g.getDeploymentPackages(ctx, tx, projectUUID, req.Kinds, req.ExcludeDeprecated, orderBys, filters, selector, page)
 	if err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
@@ -540,7 +603,7 @@ func (g *Server) ListDeploymentPackages(ctx context.Context, req *catalogv3.List
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 	logActivity(ctx, "listed", "deployment-packages", projectUUID, "Total => "+fmt.Sprintf("%d", totalElements))
-	return &catalogv3.ListDeploymentPackagesResponse{DeploymentPackages: packages, TotalElements: totalElements}, nil
+	return &catalogv3.ListDeploymentPackagesResponse{DeploymentPackages: packages, TotalElements: totalElements, NextPageToken: nextPageToken}, nil
 }
 
 var dpColumns = map[string]string{
@@ -551,70 +614,88 @@ var dpColumns = map[string]string{
 	"version":       "version",
 	"createTime":    "create_time",
 	"updateTime":    "update_time",
+	"isDeployed":    "is_deployed",
+	"isVisible":     "is_visible",
+	"kind":          "kind",
 }
 
-func (g *Server) getDeploymentPackages(ctx context.Context, tx *generated.Tx, projectUUID string, kinds []catalogv3.Kind,
-	orderBys []*orderBy, filters []*filter, pageSize int32, offset int32) ([]*catalogv3.DeploymentPackage, []string, int32, error) {
+func (g *Server) getDeploymentPackages(ctx context.Context, tx *generated.Tx, projectUUID string, kinds []catalogv3.Kind, excludeDeprecated bool,
+	orderBys []*orderBy, filterExpr *filter, selector labels.Selector, page *listPage) ([]*catalogv3.DeploymentPackage, []string, int32, string, error) {
 	var err error
-	var pkgsDB []*generated.DeploymentPackage
 	var orderOptions []deploymentpackage.OrderOption
 	dpQuery := tx.DeploymentPackage.Query()
 
-	options, err := orderByOptions(orderBys, dpColumns, errors.DeploymentPackageType)
+	filterPred, err := filterPredicate(filterExpr, dpColumns, errors.DeploymentPackageType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
+	} else if filterPred != nil {
+		dpQuery = dpQuery.Where(filterPred)
 	}
-	for _, pred := range options {
-		orderOptions = append(orderOptions, pred)
-	}
-	dpQuery = dpQuery.Order(orderOptions...)
 
-	filterPreds, err := filterPredicates(filters, dpColumns, errors.DeploymentPackageType)
-	if err != nil {
-		return nil, nil, 0, err
-	}
-	var dpPreds []predicate.DeploymentPackage
-	for _, pred := range filterPreds {
-		dpPreds = append(dpPreds, pred)
+	if selectorPred := labelSelectorPredicate(selector); selectorPred != nil {
+		dpQuery = dpQuery.Where(selectorPred)
 	}
-	dpQuery = dpQuery.Where(deploymentpackage.Or(dpPreds...))
 
 	kindFilter := kindPredicate(kinds)
 	if kindFilter != nil {
 		dpQuery = dpQuery.Where(kindFilter)
 	}
 
-	if projectUUID == "" {
-		pkgsDB, err = dpQuery.All(ctx)
-	} else {
-		pkgsDB, err = dpQuery.Where(deploymentpackage.ProjectUUID(projectUUID)).All(ctx)
+	if excludeDeprecated {
+		dpQuery = dpQuery.Where(deploymentpackage.Deprecated(false))
+	}
+
+	if projectUUID != "" {
+		dpQuery = dpQuery.Where(deploymentpackage.ProjectUUID(projectUUID))
 	}
+
+	var totalElements int32
+	if !page.skipTotal {
+		count, err := dpQuery.Clone().Count(ctx)
+		if err != nil {
+			return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
+		}
+		totalElements = int32(count)
+	}
+
+	options, err := page.orderOptions(orderBys, dpColumns, errors.DeploymentPackageType)
 	if err != nil {
-		return nil, nil, 0, errors.NewDBError(errors.WithError(err))
+		return nil, nil, 0, "", err
 	}
+	for _, pred := range options {
+		orderOptions = append(orderOptions, pred)
+	}
+	dpQuery = dpQuery.Order(orderOptions...)
 
-	totalElements := int32(len(pkgsDB))
-	startIndex, endIndex, _, err := computePageRange(pageSize, offset, len(pkgsDB))
+	after, err := page.afterPredicate(orderBys, dpColumns, errors.DeploymentPackageType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
+	} else if after != nil {
+		dpQuery = dpQuery.Where(after)
 	}
-	if len(pkgsDB) == 0 {
-		return []*catalogv3.DeploymentPackage{}, []string{}, 0, nil
+
+	pkgsDB, err := dpQuery.Offset(page.offset).Limit(page.size + 1).All(ctx)
+	if err != nil {
+		return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
 	}
+	pkgsDB, more := pageOf(pkgsDB, page)
 
 	packages := make([]*catalogv3.DeploymentPackage, 0, len(pkgsDB))
 	projectUUIDs := make([]string, 0, len(pkgsDB))
-
-	for i := startIndex; i <= endIndex; i++ {
-		pkgDB := pkgsDB[i]
+	for _, pkgDB := range pkgsDB {
 		pkg, err := extractDeploymentPackage(ctx, pkgDB)
 		if err != nil {
-			return nil, nil, 0, err
+			return nil, nil, 0, "", err
 		}
 		packages = append(packages, pkg)
 		projectUUIDs = append(projectUUIDs, pkgDB.ProjectUUID)
 	}
-	return packages, projectUUIDs, totalElements, nil
+
+	var nextPageToken string
+	if more {
+		nextPageToken = page.nextToken(orderBys, packages[len(packages)-1], pkgsDB[len(pkgsDB)-1].ID)
+	}
+	return packages, projectUUIDs, totalElements, nextPageToken, nil
 }
 
 func extractDeploymentPackage(ctx context.Context, pkgDB *generated.DeploymentPackage) (*catalogv3.DeploymentPackage, error) {
@@ -671,8 +752,11 @@ func extractDeploymentPackage(ctx context.Context, pkgDB *generated.DeploymentPa
 		Artifacts:                  artifacts,
 		ForbidsMultipleDeployments: !pkgDB.AllowsMultipleDeployments,
 		Kind:                       kindFromDB(pkgDB.Kind),
+		Labels:                     extractedLabels(pkgDB.Labels),
+		Deprecation:                extractedDeprecation(pkgDB.Deprecated, pkgDB.DeprecationMessage, pkgDB.ReplacementVersion, pkgDB.DeprecateTime),
 		CreateTime:                 timestamppb.New(pkgDB.CreateTime),
 		UpdateTime:                 timestamppb.New(pkgDB.UpdateTime),
+		Etag:                       formatETag(pkgDB.Etag),
 	}
 
 	// Fetch default profile for this deployment package
@@ -701,9 +785,14 @@ func extractApplicationReferences(ctx context.Context, pkgDB *generated.Deployme
 	}
 	applications := make([]*catalogv3.ApplicationReference, 0, len(applicationsDB))
 	for _, appApplicationDB := range applicationsDB {
+		// References given as a version constraint are reported as such, rather than as their resolution
+		version, ok := pkgDB.ApplicationConstraints[appApplicationDB.Name]
+		if !ok {
+			version = appApplicationDB.Version
+		}
 		applications = append(applications, &catalogv3.ApplicationReference{
 			Name:    appApplicationDB.Name,
-			Version: appApplicationDB.Version,
+			Version: version,
 		})
 	}
 	return applications, err
@@ -905,6 +994,7 @@ func (g *Server) GetDeploymentPackageVersions(ctx context.Context, req *catalogv
 			deploymentpackage.ProjectUUID(projectUUID),
 			deploymentpackage.Name(req.DeploymentPackageName),
 		).
+		Order(deploymentpackage.ByVersionKey(), deploymentpackage.ByID()).
 		All(ctx)
 	if err != nil {
 		g.rollbackTransaction(tx)
@@ -986,11 +1076,12 @@ func (g *Server) GetDeploymentPackage(ctx context.Context, req *catalogv3.GetDep
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 	logActivity(ctx, "got", "deployment-package", projectUUID, req.DeploymentPackageName, req.Version)
+	setETagHeader(ctx, ca.Etag)
 	return &catalogv3.GetDeploymentPackageResponse{DeploymentPackage: ca}, nil
 }
 
 type packageChanges struct {
-	kindOrState       bool
+	metadata          bool
 	rootRecord        bool
 	applications      bool
 	profiles          bool
@@ -1007,12 +1098,12 @@ func (c *packageChanges) changed() bool {
 	return c.rootRecord || c.applications || c.profiles || c.profile || c.dependencies || c.defaultNamespaces || c.namespaces || c.extensions || c.artifacts
 }
 
-func (c *packageChanges) changedKindOrDeployedState() bool {
-	return c.kindOrState
+func (c *packageChanges) changedMetadata() bool {
+	return c.metadata
 }
 
 // UpdateDeploymentPackage updates an application through gRPC
-func (g *Server) UpdateDeploymentPackage(ctx context.Context, req *catalogv3.UpdateDeploymentPackageRequest) (*emptypb.Empty, error) {
+func (g *Server) UpdateDeploymentPackage(ctx context.Context, req *catalogv3.UpdateDeploymentPackageRequest) (*catalogv3.UpdateDeploymentPackageResponse, error) {
 	projectUUID, err := GetActiveProjectID(ctx)
 	if err != nil {
 		return nil, err
@@ -1021,20 +1112,10 @@ func (g *Server) UpdateDeploymentPackage(ctx context.Context, req *catalogv3.Upd
 		return nil, errors.NewInvalidArgument(
 			errors.WithResourceType(errors.DeploymentPackageType),
 			errors.WithMessage("incomplete request"))
-	} else if err := req.DeploymentPackage.Validate(); err != nil {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.DeploymentPackageType),
-			errors.WithMessage(err.Error()))
-	} else if req.DeploymentPackageName != req.DeploymentPackage.Name {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.DeploymentPackageType),
-			errors.WithMessage("name cannot be changed %s != %s", req.DeploymentPackageName, req.DeploymentPackage.Name))
-	} else if req.Version != req.DeploymentPackage.Version {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.DeploymentPackageType),
-			errors.WithMessage("version cannot be changed %s != %s", req.Version, req.DeploymentPackage.Version))
-	} else if err := validateDeploymentProfiles(req.DeploymentPackage); err != nil {
-		return nil, err
+	} else if !isPartialUpdate(req.UpdateMask) {
+		if err := validateDeploymentPackageUpdate(req.DeploymentPackageName, req.Version, req.DeploymentPackage); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := g.authCheckAllowed(ctx, req); err != nil {
@@ -1046,8 +1127,32 @@ func (g *Server) UpdateDeploymentPackage(ctx context.Context, req *catalogv3.Upd
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.DeploymentPackageType, projectUUID, req.DeploymentPackageName, req.Version, req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	pkg := req.DeploymentPackage
+	if isPartialUpdate(req.UpdateMask) {
+		if pkg, err = g.maskedDeploymentPackage(ctx, tx, projectUUID, req); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, err
+		}
+	}
+
 	events := &DeploymentPackageEvents{}
-	if err = g.updateDeploymentPackage(ctx, tx, projectUUID, req.DeploymentPackage, events); err != nil {
+	if err = g.updateDeploymentPackage(ctx, tx, projectUUID, pkg, events); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	warnings, err := g.deprecatedApplicationWarnings(ctx, tx, projectUUID, pkg.Name, pkg.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = events.persist(ctx, tx); err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
@@ -1060,7 +1165,25 @@ func (g *Server) UpdateDeploymentPackage(ctx context.Context, req *catalogv3.Upd
 	logActivity(ctx, "updated", "deployment-package", projectUUID, req.DeploymentPackageName, req.Version)
 	events.sendToAll(g.listeners)
 
-	return &emptypb.Empty{}, nil
+	return &catalogv3.UpdateDeploymentPackageResponse{Warnings: warnings}, nil
+}
+
+// Validates the deployment package given to an update of the named package version.
+func validateDeploymentPackageUpdate(name string, version string, pkg *catalogv3.DeploymentPackage) error {
+	if err := pkg.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage(err.Error()))
+	} else if name != pkg.Name {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage("name cannot be changed %s != %s", name, pkg.Name))
+	} else if version != pkg.Version {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage("version cannot be changed %s != %s", version, pkg.Version))
+	}
+	return validateDeploymentProfiles(pkg)
 }
 
 func (g *Server) updateDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string, pkg *catalogv3.DeploymentPackage, events *DeploymentPackageEvents) error {
@@ -1077,7 +1200,12 @@ func (g *Server) updateDeploymentPackage(ctx context.Context, tx *generated.Tx,
 			errors.WithResourceName(pkg.Name),
 			errors.WithResourceVersion(pkg.Version),
 			errors.WithMessage("display name cannot contain leading or trailing spaces"))
+	} else if err := validateLabels(pkg.Labels, errors.DeploymentPackageType, pkg.Name); err != nil {
+		return err
+	} else if err := validateDeprecation(pkg.Deprecation, errors.DeploymentPackageType, pkg.Name, pkg.Version); err != nil {
+		return err
 	}
+	pkg.DisplayName = displayName
 
 	pkgDB, ok, err := g.getDeploymentPackage(ctx, tx, projectUUID, pkg.Name, pkg.Version)
 	if err != nil {
@@ -1089,15 +1217,32 @@ func (g *Server) updateDeploymentPackage(ctx context.Context, tx *generated.Tx,
 			errors.WithResourceName(pkg.Name))
 	}
 
+	before, err := extractDeploymentPackage(ctx, pkgDB)
+	if err != nil {
+		return err
+	}
+	events.captureBefore(pkg.Name, pkg.Version, before)
+
+	stampDeprecation(pkg.Deprecation, pkgDB.DeprecateTime)
 	changes, err := g.computePackageChanges(ctx, pkg, pkgDB)
 	if err != nil {
 		return err
 	}
 
 	// If there are any changes (other than changing the isDeployed bit)...
-	// Changes to the kind field only are exempt.
-	if changes.changedKindOrDeployedState() && !changes.changed() {
-		return g.updatePackageKindOrDeployedState(ctx, tx, projectUUID, pkg)
+	// Changes to the kind, labels and deprecation only are exempt.
+	if changes.changedMetadata() && !changes.changed() {
+		if err = g.updatePackageMetadata(ctx, tx, projectUUID, pkg); err != nil {
+			return err
+		}
+		if err = g.updatePinnedResolution(ctx, tx, projectUUID, pkg.Name, pkg.Version, pkgDB.IsDeployed); err != nil {
+			return err
+		}
+		if err = g.recordRevision(ctx, tx, projectUUID, errors.DeploymentPackageType, pkg.Name, pkg.Version, before); err != nil {
+			return err
+		}
+		events.append(UpdatedEvent, projectUUID, pkg)
+		return nil
 	} else if changes.changed() {
 		// Make sure that CA is not already deployed
 		if err := g.checkDeploymentPackageNotDeployed(ctx, tx, projectUUID, pkg); err != nil {
@@ -1110,7 +1255,7 @@ func (g *Server) updateDeploymentPackage(ctx context.Context, tx *generated.Tx,
 		return err
 	}
 
-	updateCount, err := tx.DeploymentPackage.Update().
+	stmt := tx.DeploymentPackage.Update().
 		Where(
 			deploymentpackage.ProjectUUID(projectUUID),
 			deploymentpackage.Name(pkg.Name),
@@ -1120,11 +1265,14 @@ func (g *Server) updateDeploymentPackage(ctx context.Context, tx *generated.Tx,
 		SetDisplayNameLc(strings.ToLower(displayName)).
 		SetDescription(pkg.Description).
 		SetVersion(pkg.Version).
+		SetVersionKey(VersionKey(pkg.Version)).
 		SetIsVisible(pkg.IsVisible).
 		SetIsDeployed(pkg.IsDeployed).
 		SetAllowsMultipleDeployments(!pkg.ForbidsMultipleDeployments).
+		SetSearchText(deploymentPackageSearchText(pkg)).
 		SetKind(kindToDB(pkg.Kind)).
-		Save(ctx)
+		SetLabels(storedLabels(pkg.Labels))
+	updateCount, err := setPackageDeprecation(stmt, pkg.Deprecation).Save(ctx)
 	if err != nil {
 		return errors.NewDBError(errors.WithError(err))
 	} else if updateCount == 0 {
@@ -1196,12 +1344,20 @@ func (g *Server) updateDeploymentPackage(ctx context.Context, tx *generated.Tx,
 		}
 	}
 
+	// Pin or release the resolution of the version constraints, if the deployed state changed
+	if err = g.updatePinnedResolution(ctx, tx, projectUUID, pkg.Name, pkg.Version, pkgDB.IsDeployed); err != nil {
+		return err
+	}
+
+	if err = g.recordRevision(ctx, tx, projectUUID, errors.DeploymentPackageType, pkg.Name, pkg.Version, before); err != nil {
+		return err
+	}
 	events.append(UpdatedEvent, projectUUID, pkg)
 	return nil
 }
 
-func (g *Server) updatePackageKindOrDeployedState(ctx context.Context, tx *generated.Tx, projectUUID string, pkg *catalogv3.DeploymentPackage) error {
-	updateCount, err := tx.DeploymentPackage.Update().
+func (g *Server) updatePackageMetadata(ctx context.Context, tx *generated.Tx, projectUUID string, pkg *catalogv3.DeploymentPackage) error {
+	stmt := tx.DeploymentPackage.Update().
 		Where(
 			deploymentpackage.ProjectUUID(projectUUID),
 			deploymentpackage.Name(pkg.Name),
@@ -1209,7 +1365,8 @@ func (g *Server) updatePackageKindOrDeployedState(ctx context.Context, tx *gener
 		).
 		SetIsDeployed(pkg.IsDeployed).
 		SetKind(kindToDB(pkg.Kind)).
-		Save(ctx)
+		SetLabels(storedLabels(pkg.Labels))
+	updateCount, err := setPackageDeprecation(stmt, pkg.Deprecation).Save(ctx)
 	if err != nil {
 		return errors.NewDBError(errors.WithError(err))
 	} else if updateCount == 0 {
@@ -1224,7 +1381,8 @@ func (g *Server) updatePackageKindOrDeployedState(ctx context.Context, tx *gener
 func (g *Server) computePackageChanges(ctx context.Context, pkg *catalogv3.DeploymentPackage, pkgDB *generated.DeploymentPackage) (*packageChanges, error) {
 	var err error
 	changes := &packageChanges{}
-	changes.kindOrState = !isSameKind(pkg.Kind, pkgDB.Kind) || pkg.IsDeployed != pkgDB.IsDeployed
+	changes.metadata = !isSameKind(pkg.Kind, pkgDB.Kind) || pkg.IsDeployed != pkgDB.IsDeployed || !maps.Equal(pkg.Labels, pkgDB.Labels) ||
+		deprecationChanged(pkg.Deprecation, pkgDB.Deprecated, pkgDB.DeprecationMessage, pkgDB.ReplacementVersion)
 	changes.rootRecord = g.deploymentPackageChanged(pkg, pkgDB)
 
 	if changes.applications, err = g.applicationReferencesChanged(ctx, pkg, pkgDB); err != nil {
@@ -1665,18 +1823,25 @@ func (g *Server) DeleteDeploymentPackage(ctx context.Context, req *catalogv3.Del
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.DeploymentPackageType, projectUUID, req.DeploymentPackageName, req.Version, req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	// Make sure that CA is not already deployed
-	if err := g.checkDeploymentPackageNotDeployed(ctx, tx, projectUUID,
-		&catalogv3.DeploymentPackage{
-			Name:       req.DeploymentPackageName,
-			Version:    req.Version,
-			IsDeployed: true,
-		}); err != nil {
+	if err := checkPackageNotDeployed(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version); err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
 
 	events := &DeploymentPackageEvents{}
+	before, err := g.deploymentPackageSnapshot(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	events.captureBefore(req.DeploymentPackageName, req.Version, before)
+
 	deleteCount, err := tx.DeploymentPackage.Delete().
 		Where(
 			deploymentpackage.ProjectUUID(projectUUID),
@@ -1694,10 +1859,20 @@ func (g *Server) DeleteDeploymentPackage(ctx context.Context, req *catalogv3.Del
 			errors.WithResourceName(req.DeploymentPackageName),
 			errors.WithResourceVersion(req.Version))
 	}
+	if err = trashEntity(ctx, tx, projectUUID, errors.DeploymentPackageType, req.DeploymentPackageName, req.Version, before, ""); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	if err = deleteRevisions(ctx, tx, projectUUID, errors.DeploymentPackageType, req.DeploymentPackageName, req.Version); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	events.append(DeletedEvent, projectUUID, &catalogv3.DeploymentPackage{Name: req.DeploymentPackageName, Version: req.Version,
+		Labels: snapshotLabels(before)})
+	err = events.persist(ctx, tx)
 	if _, err = g.checkDeleteResult(ctx, tx, err, fmt.Sprintf("deployment package %s:%s", req.DeploymentPackageName, req.Version), projectUUID); err != nil {
 		return nil, err
 	}
-	events.append(DeletedEvent, projectUUID, &catalogv3.DeploymentPackage{Name: req.DeploymentPackageName, Version: req.Version})
 	events.sendToAll(g.listeners)
 
 	return &emptypb.Empty{}, nil
@@ -1718,15 +1893,31 @@ func (g *Server) WatchDeploymentPackages(req *catalogv3.WatchDeploymentPackagesR
 			errors.WithResourceType(errors.DeploymentProfileType),
 			errors.WithMessage("incomplete request"))
 	}
+	selector, err := parseLabelSelector(req.LabelSelector, errors.DeploymentPackageType)
+	if err != nil {
+		return err
+	}
 
 	if err := g.authCheckAllowed(server.Context(), req); err != nil {
 		return err
 	}
 
-	ch := make(chan *catalogv3.WatchDeploymentPackagesResponse)
+	var l *deploymentPackageListener
 
-	// If replay requested
-	if !req.NoReplay {
+	// If resuming, replay the events recorded since the given revision and, once the stream is registered,
+	// any events recorded in the meantime
+	var replayed replayedRevisions
+	if req.ResumeFromRevision > 0 {
+		replayed = replayedRevisions{}
+		if err = g.replayDeploymentPackageEvents(server, projectUUID, req.Kinds, req.LabelSelector, req.ResumeFromRevision, replayed); err != nil {
+			return err
+		}
+		l = g.listeners.addDeploymentPackageListener(server.Context(), req)
+		if err = g.replayDeploymentPackageEvents(server, projectUUID, req.Kinds, req.LabelSelector, req.ResumeFromRevision, replayed); err != nil {
+			g.listeners.deleteDeploymentPackageListener(l)
+			return err
+		}
+	} else if !req.NoReplay {
 		// Get list of packages
 		ctx := server.Context()
 		tx, err := g.startTransaction(ctx)
@@ -1734,7 +1925,7 @@ func (g *Server) WatchDeploymentPackages(req *catalogv3.WatchDeploymentPackagesR
 			return errors.NewDBError(errors.WithError(err))
 		}
 
-		deploymentPackages, projectUUIDs, _, err := g.getDeploymentPackages(ctx, tx, projectUUID, req.Kinds, nil, nil, 0, 0)
+		deploymentPackages, projectUUIDs, _, _, err := g.getDeploymentPackages(ctx, tx, projectUUID, req.Kinds, false, nil, nil, selector, &listPage{size: DefaultPageSize})
 		if err != nil {
 			g.rollbackTransaction(tx)
 			return err
@@ -1754,7 +1945,7 @@ func (g *Server) WatchDeploymentPackages(req *catalogv3.WatchDeploymentPackagesR
 		}
 
 		// Register the stream, so it can start receiving updates
-		g.listeners.addDeploymentPackageListener(ch, req)
+		l = g.listeners.addDeploymentPackageListener(server.Context(), req)
 
 		err = g.commitTransaction(tx)
 		if err != nil {
@@ -1762,18 +1953,25 @@ func (g *Server) WatchDeploymentPackages(req *catalogv3.WatchDeploymentPackagesR
 		}
 	} else {
 		// Register the stream, so it can start receiving updates
-		g.listeners.addDeploymentPackageListener(ch, req)
+		l = g.listeners.addDeploymentPackageListener(server.Context(), req)
 	}
-	defer g.listeners.deleteDeploymentPackageListener(ch)
+	defer g.listeners.deleteDeploymentPackageListener(l)
 	logActivity(server.Context(), "watching", "deployment-packages", projectUUID)
-	return g.watchDeploymentPackageEvents(server, ch)
+	return g.watchDeploymentPackageEvents(server, l, replayed)
 }
 
-func (g *Server) watchDeploymentPackageEvents(server catalogv3.CatalogService_WatchDeploymentPackagesServer, ch chan *catalogv3.WatchDeploymentPackagesResponse) error {
-	for e := range ch {
-		if err := server.Send(e); err != nil {
+func (g *Server) watchDeploymentPackageEvents(server catalogv3.CatalogService_WatchDeploymentPackagesServer, l *deploymentPackageListener, replayed replayedRevisions) error {
+	signalRegistered(server)
+	for {
+		e, err := l.next(server.Context())
+		if err != nil {
+			return err
+		}
+		if alreadyReplayed(e.Event, replayed) {
+			continue
+		}
+		if err = server.Send(e); err != nil {
 			return err
 		}
 	}
-	return nil
 }
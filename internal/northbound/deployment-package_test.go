@@ -310,7 +310,7 @@ func (s *NorthBoundTestSuite) TestListDeploymentPackagesWithOrderBy() {
 		expectedError string
 	}{
 		"none":             {orderBy: "", wantedList: "ca-gigi,ca-gigi,ca-fifi,a1,a2,a3"},
-		"default":          {orderBy: "version", wantedList: "a1,a2,a3,ca-fifi,ca-gigi,ca-gigi"},
+		"default":          {orderBy: "version", wantedList: "ca-fifi,ca-gigi,ca-gigi,a1,a2,a3"},
 		"asc":              {orderBy: "name asc", wantedList: "a1,a2,a3,ca-fifi,ca-gigi,ca-gigi"},
 		"desc":             {orderBy: "name desc", wantedList: "ca-gigi,ca-gigi,ca-fifi,a3,a2,a1"},
 		"camel case field": {orderBy: "displayName desc", wantedList: "a3,a2,a1,ca-gigi,ca-gigi,ca-fifi"},
@@ -348,8 +348,25 @@ func (s *NorthBoundTestSuite) TestListDeploymentPackagesWithFilter() {
 		"match all":         {filter: "name=*", wantedList: "a1,a2,a3,ca-fifi,ca-gigi,ca-gigi", orderBy: "name asc"},
 		"match all no sort": {filter: "name=*", wantedList: "ca-gigi,ca-gigi,ca-fifi,a1,a2,a3"},
 		"or operation":      {filter: "name=*2* OR name=*gi*", wantedList: "a2,ca-gigi,ca-gigi", orderBy: "name asc"},
+		"exact":             {filter: `name="ca-gigi" OR name="ca-fif"`, wantedList: "ca-gigi,ca-gigi", orderBy: "name asc"},
+		"and operation":     {filter: "description=XXX AND version>=2.0.0", wantedList: "a2,a3", orderBy: "name asc"},
+		"implicit and":      {filter: "name=ca- version<v0.3", wantedList: "ca-fifi,ca-gigi", orderBy: "name asc"},
+		"not operation":     {filter: "NOT name=ca-", wantedList: "a1,a2,a3", orderBy: "name asc"},
+		"not equal":         {filter: "name!=*-*i", wantedList: "a1,a2,a3", orderBy: "name asc"},
+		"parentheses":       {filter: "(name=a1 OR name=a2) AND -version<2.0.0", wantedList: "a2", orderBy: "name asc"},
+		"kind":              {filter: "kind=normal AND kind!=KIND_EXTENSION", wantedList: "a1,a2,a3,ca-fifi,ca-gigi,ca-gigi", orderBy: "name asc"},
+		"other kind":        {filter: "kind=addon", wantedList: ""},
+		"boolean":           {filter: "isVisible=false AND NOT isDeployed=true", wantedList: "a1,a2,a3,ca-fifi,ca-gigi,ca-gigi", orderBy: "name asc"},
+		"other boolean":     {filter: "isVisible!=false", wantedList: ""},
+		"timestamp":         {filter: "createTime>2000-01-01 AND updateTime<=2999-12-31T23:59:59Z", wantedList: "a1,a2,a3,ca-fifi,ca-gigi,ca-gigi", orderBy: "name asc"},
+		"older timestamp":   {filter: "createTime<2000-01-01T00:00:00+01:00", wantedList: ""},
 		"bad column":        {filter: "bad=filter", wantedList: "", orderBy: "name asc", expectedError: "invalid"},
 		"bad filter":        {filter: "bad filter", wantedList: "", orderBy: "name asc", expectedError: "invalid"},
+		"bad timestamp":     {filter: "createTime>yesterday", expectedError: "createTime must be compared with a timestamp or date"},
+		"bad boolean":       {filter: "isVisible=yes", expectedError: "isVisible must be compared with true or false"},
+		"bad kind":          {filter: "kind=special", expectedError: "kind must be compared with a kind"},
+		"bad comparator":    {filter: "isDeployed>false", expectedError: "isDeployed cannot be compared with >"},
+		"syntax error":      {filter: "name=a1 AND (version=1.0.0", expectedError: "missing closing parenthesis at position 27"},
 	}
 	s.generateDeploymentPackages(3)
 
@@ -1155,6 +1172,8 @@ func (s *NorthBoundTestSuite) TestDeploymentPackageEvents() {
 	ctx, cancel := context.WithCancel(s.ProjectID(footen))
 	stream, err := s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{NoReplay: true})
 	s.NoError(err)
+	_, err = stream.Header() // Wait for the watch to be registered
+	s.NoError(err)
 
 	pkg := s.createDeploymentPkg(footen, fooreg, "newpkg", "0.1.1", "foo:v0.1.0", "bar:v0.2.1:barten")
 
@@ -1473,6 +1492,7 @@ func (s *NorthBoundDBErrTestSuite) TestDeleteDeploymentPackageDatabaseErrors() {
 	// Test nothing to delete
 	s.mock.ExpectBegin()
 	s.addMockedQueryRowsWithResult(1, 1)
+	s.mock.ExpectQuery("SELECT .*").WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	s.mock.ExpectExec("DELETE FROM .*").WillReturnResult(sqlmock.NewResult(1, 0))
 	r, err = s.server.DeleteDeploymentPackage(s.ctx, deleteRequest)
 	s.validateError(err, codes.NotFound, `deployment-package p:23 not found`, r)
@@ -81,6 +81,7 @@ func (g *Server) updateDeploymentProfile(ctx context.Context, tx *generated.Tx,
 		SetDisplayName(displayName).
 		SetDisplayNameLc(strings.ToLower(displayName)).
 		SetDescription(deploymentProfile.Description).
+		ClearProfiles().
 		AddProfiles(profiles...).
 		Save(ctx)
 	if err != nil {
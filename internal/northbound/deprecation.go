@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Versions of applications and deployment packages may be deprecated, with a message and a suggested replacement
+ * version. Unlike hiding or deleting them, deprecating versions leaves them fully usable, even when deployed; the
+ * deprecation state is therefore metadata, which may be changed at any time, like the kind and the labels.
+ */
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Returns an error if the given deprecation of the named version suggests the version itself as its replacement.
+func validateDeprecation(d *catalogv3.Deprecation, resourceType errors.ResourceType, name string, version string) error {
+	if d != nil && d.ReplacementVersion == version {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
+			errors.WithMessage("replacement version cannot be the deprecated version itself"))
+	}
+	return nil
+}
+
+// Stamps the given deprecation with the time at which the version was deprecated; that is, the given time if it
+// already was deprecated, or now.
+func stampDeprecation(d *catalogv3.Deprecation, deprecatedAt *time.Time) {
+	if d == nil {
+		return
+	} else if deprecatedAt != nil {
+		d.DeprecateTime = timestamppb.New(*deprecatedAt)
+	} else {
+		d.DeprecateTime = timestamppb.Now()
+	}
+}
+
+// Returns the time at which the version with the given stamped deprecation was deprecated; nil if it is not.
+func deprecateTime(d *catalogv3.Deprecation) *time.Time {
+	if d == nil || d.DeprecateTime == nil {
+		return nil
+	}
+	t := d.DeprecateTime.AsTime()
+	return &t
+}
+
+// Returns true if the given deprecation differs from the given stored deprecation state.
+func deprecationChanged(d *catalogv3.Deprecation, deprecated bool, message string, replacementVersion string) bool {
+	if d == nil {
+		return deprecated
+	}
+	return !deprecated || d.Message != message || d.ReplacementVersion != replacementVersion
+}
+
+// Returns the given stored deprecation state as it is to be returned; nil if the version is not deprecated.
+func extractedDeprecation(deprecated bool, message string, replacementVersion string, deprecatedAt *time.Time) *catalogv3.Deprecation {
+	if !deprecated {
+		return nil
+	}
+	d := &catalogv3.Deprecation{Message: message, ReplacementVersion: replacementVersion}
+	if deprecatedAt != nil {
+		d.DeprecateTime = timestamppb.New(*deprecatedAt)
+	}
+	return d
+}
+
+// Sets the given stamped deprecation of the applications to be updated.
+func setApplicationDeprecation(stmt *generated.ApplicationUpdate, d *catalogv3.Deprecation) *generated.ApplicationUpdate {
+	stmt.SetDeprecated(d != nil).
+		SetDeprecationMessage(d.GetMessage()).
+		SetReplacementVersion(d.GetReplacementVersion())
+	if t := deprecateTime(d); t != nil {
+		return stmt.SetDeprecateTime(*t)
+	}
+	return stmt.ClearDeprecateTime()
+}
+
+// Sets the given stamped deprecation of the deployment packages to be updated.
+func setPackageDeprecation(stmt *generated.DeploymentPackageUpdate, d *catalogv3.Deprecation) *generated.DeploymentPackageUpdate {
+	stmt.SetDeprecated(d != nil).
+		SetDeprecationMessage(d.GetMessage()).
+		SetReplacementVersion(d.GetReplacementVersion())
+	if t := deprecateTime(d); t != nil {
+		return stmt.SetDeprecateTime(*t)
+	}
+	return stmt.ClearDeprecateTime()
+}
+
+// Returns the warnings about the deprecated applications referenced by the given deployment package.
+func (g *Server) deprecatedApplicationWarnings(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) ([]string, error) {
+	appsDB, err := tx.DeploymentPackage.Query().
+		Where(
+			deploymentpackage.ProjectUUID(projectUUID),
+			deploymentpackage.Name(name),
+			deploymentpackage.Version(version),
+		).
+		QueryApplications().
+		Where(application.Deprecated(true)).
+		Order(application.ByName(), application.ByVersionKey()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	var warnings []string
+	for _, appDB := range appsDB {
+		warning := fmt.Sprintf("application %s:%s is deprecated", appDB.Name, appDB.Version)
+		if appDB.DeprecationMessage != "" {
+			warning = fmt.Sprintf("%s: %s", warning, appDB.DeprecationMessage)
+		}
+		if appDB.ReplacementVersion != "" {
+			warning = fmt.Sprintf("%s; use version %s instead", warning, appDB.ReplacementVersion)
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings, nil
+}
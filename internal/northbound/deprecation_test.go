@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *NorthBoundTestSuite) deprecateApplication(name string, version string, deprecation *catalogv3.Deprecation) *catalogv3.Application {
+	resp, err := s.client.GetApplication(s.ProjectID(footen), &catalogv3.GetApplicationRequest{ApplicationName: name, Version: version})
+	s.validateResponse(err, resp)
+	resp.Application.Deprecation = deprecation
+	_, err = s.client.UpdateApplication(s.ProjectID(footen), &catalogv3.UpdateApplicationRequest{
+		ApplicationName: name, Version: version, Application: resp.Application,
+	})
+	s.NoError(err)
+	resp, err = s.client.GetApplication(s.ProjectID(footen), &catalogv3.GetApplicationRequest{ApplicationName: name, Version: version})
+	s.validateResponse(err, resp)
+	return resp.Application
+}
+
+func (s *NorthBoundTestSuite) TestDeprecateApplication() {
+	ctx, cancel := context.WithCancel(s.ProjectID(footen))
+	defer cancel()
+	stream, err := s.client.WatchApplications(ctx, &catalogv3.WatchApplicationsRequest{NoReplay: true})
+	s.NoError(err)
+	_, err = stream.Header() // Wait for the watch to be registered
+	s.NoError(err)
+
+	// Applications of deployed packages may be deprecated
+	pkg, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, pkg)
+	pkg.DeploymentPackage.IsDeployed = true
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1", DeploymentPackage: pkg.DeploymentPackage,
+	})
+	s.NoError(err)
+
+	app := s.deprecateApplication("foo", "v0.1.0", &catalogv3.Deprecation{Message: "Chart is unmaintained", ReplacementVersion: "v0.1.1"})
+	if s.NotNil(app.Deprecation) {
+		s.Equal("Chart is unmaintained", app.Deprecation.Message)
+		s.Equal("v0.1.1", app.Deprecation.ReplacementVersion)
+		s.NotNil(app.Deprecation.DeprecateTime)
+	}
+
+	resp, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(UpdatedEvent, EventType(resp.Event.Type))
+	s.Equal("foo", resp.Application.Name)
+	s.NotNil(resp.Application.Deprecation)
+
+	// The time of deprecation is kept when the deprecation is revised
+	revised := s.deprecateApplication("foo", "v0.1.0", &catalogv3.Deprecation{Message: "Chart is no longer maintained", ReplacementVersion: "v0.1.1"})
+	if s.NotNil(revised.Deprecation) {
+		s.Equal("Chart is no longer maintained", revised.Deprecation.Message)
+		s.Equal(app.Deprecation.DeprecateTime.AsTime(), revised.Deprecation.DeprecateTime.AsTime())
+	}
+
+	list, err := s.client.ListApplications(s.ProjectID(footen), &catalogv3.ListApplicationsRequest{ExcludeDeprecated: true})
+	s.NoError(err)
+	s.Len(list.Applications, 3)
+	for _, a := range list.Applications {
+		s.NotEqual("foo", a.Name)
+	}
+	list, err = s.client.ListApplications(s.ProjectID(footen), &catalogv3.ListApplicationsRequest{})
+	s.NoError(err)
+	s.Len(list.Applications, 4)
+
+	// Packages referencing deprecated applications are created and updated with warnings
+	created, err := s.client.CreateDeploymentPackage(s.ProjectID(footen), &catalogv3.CreateDeploymentPackageRequest{
+		DeploymentPackage: &catalogv3.DeploymentPackage{
+			Name: "uses-foo", Version: "0.1.0", ApplicationReferences: appReferences("foo:v0.1.0", "goo:v0.1.2"),
+		},
+	})
+	s.validateResponse(err, created)
+	s.Equal([]string{"application foo:v0.1.0 is deprecated: Chart is no longer maintained; use version v0.1.1 instead"}, created.Warnings)
+
+	created.DeploymentPackage.Description = "Uses foo"
+	updated, err := s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "uses-foo", Version: "0.1.0", DeploymentPackage: created.DeploymentPackage,
+	})
+	s.validateResponse(err, updated)
+	s.Equal(created.Warnings, updated.Warnings)
+
+	// Deprecation may be withdrawn
+	app = s.deprecateApplication("foo", "v0.1.0", nil)
+	s.Nil(app.Deprecation)
+	updated, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "uses-foo", Version: "0.1.0", DeploymentPackage: created.DeploymentPackage,
+	})
+	s.validateResponse(err, updated)
+	s.Empty(updated.Warnings)
+
+	_, err = s.client.UpdateApplication(s.ProjectID(footen), &catalogv3.UpdateApplicationRequest{
+		ApplicationName: "foo", Version: "v0.1.0", Application: &catalogv3.Application{
+			Name: "foo", Version: "v0.1.0", ChartName: "foo", ChartVersion: "v0.1.0", HelmRegistryName: fooreg,
+			Deprecation: &catalogv3.Deprecation{ReplacementVersion: "v0.1.0"},
+		},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestDeprecateDeploymentPackage() {
+	pkg, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, pkg)
+	pkg.DeploymentPackage.IsDeployed = true
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1", DeploymentPackage: pkg.DeploymentPackage,
+	})
+	s.NoError(err)
+
+	// Deployed packages may be deprecated
+	pkg.DeploymentPackage.Deprecation = &catalogv3.Deprecation{ReplacementVersion: "v0.3.4"}
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1", DeploymentPackage: pkg.DeploymentPackage,
+	})
+	s.NoError(err)
+
+	pkg, err = s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, pkg)
+	if s.NotNil(pkg.DeploymentPackage.Deprecation) {
+		s.Equal("v0.3.4", pkg.DeploymentPackage.Deprecation.ReplacementVersion)
+		s.NotNil(pkg.DeploymentPackage.Deprecation.DeprecateTime)
+	}
+	s.True(pkg.DeploymentPackage.IsDeployed)
+
+	list, err := s.client.ListDeploymentPackages(s.ProjectID(footen), &catalogv3.ListDeploymentPackagesRequest{ExcludeDeprecated: true})
+	s.NoError(err)
+	s.Len(list.DeploymentPackages, 2)
+	for _, p := range list.DeploymentPackages {
+		s.False(p.Name == "ca-gigi" && p.Version == "v0.2.1")
+	}
+
+	created, err := s.client.CreateDeploymentPackage(s.ProjectID(footen), &catalogv3.CreateDeploymentPackageRequest{
+		DeploymentPackage: &catalogv3.DeploymentPackage{
+			Name: "old", Version: "0.1.0", ApplicationReferences: appReferences("foo:v0.1.0"),
+			Deprecation: &catalogv3.Deprecation{Message: "Superseded"},
+		},
+	})
+	s.validateResponse(err, created)
+	if s.NotNil(created.DeploymentPackage.Deprecation) {
+		s.Equal("Superseded", created.DeploymentPackage.Deprecation.Message)
+	}
+	s.Empty(created.Warnings)
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Webhook endpoints are given by the users of a project, as are the registries whose connection is tested, whether
+ * they are saved or not, and whose inventory is listed, so the catalog must not be lured into making requests to
+ * destinations internal to its network, such as cloud metadata services, services of the cluster or the catalog
+ * itself. Destinations are checked when they are given, and again whenever a connection is made to them, since the
+ * addresses a host name resolves to may change in the meantime. Loopback, private, link-local, shared and
+ * unspecified addresses are refused, as are host names that are single labels or belong to internal domains.
+ *
+ * Operators may allow specific networks or hosts regardless, e.g. an in-cluster endpoint they trust.
+ */
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Timeout of the resolution of the host names of destinations when they are given.
+const destinationLookupTimeout = 5 * time.Second
+
+var (
+	// Shared address space (RFC 6598), which cloud providers use for metadata services among others
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+	// Domains internal to the cluster or the host
+	internalDomains = []string{"localhost", "local", "internal", "cluster.local", "svc"}
+)
+
+// Networks and host names that may be reached despite being internal.
+type destinationAllowlist struct {
+	networks []*net.IPNet
+	hosts    []string
+}
+
+var allowedDestinations destinationAllowlist
+
+// AllowDestinations allows the given comma-separated networks, in CIDR notation, and host names to be reached
+// despite being internal. Host names starting with a dot allow all the hosts of the domain.
+func AllowDestinations(list string) error {
+	var allowlist destinationAllowlist
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return fmt.Errorf("invalid allowed destination %s: %w", entry, err)
+			}
+			allowlist.networks = append(allowlist.networks, network)
+		} else {
+			allowlist.hosts = append(allowlist.hosts, entry)
+		}
+	}
+	allowedDestinations = allowlist
+	return nil
+}
+
+func (a *destinationAllowlist) allowsHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, allowed := range a.hosts {
+		if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *destinationAllowlist) allowsIP(ip net.IP) bool {
+	for _, network := range a.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns an error if the given address is internal and not allowed.
+func checkDestinationIP(ip net.IP) error {
+	internal := ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip)
+	if internal && !allowedDestinations.allowsIP(ip) {
+		return fmt.Errorf("destination address %s is internal", ip)
+	}
+	return nil
+}
+
+// Returns an error if the given host name is internal and not allowed. IP addresses are checked as such.
+func checkDestinationHost(host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		return checkDestinationIP(ip)
+	} else if allowedDestinations.allowsHost(host) {
+		return nil
+	}
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	if !strings.Contains(name, ".") {
+		return fmt.Errorf("destination host %s is internal", host)
+	}
+	for _, domain := range internalDomains {
+		if strings.HasSuffix(name, "."+domain) {
+			return fmt.Errorf("destination host %s is internal", host)
+		}
+	}
+	return nil
+}
+
+// Returns an error if the given URL is not an HTTP(S) URL or its host is, or resolves to, an internal destination
+// that is not allowed. Host names that do not resolve are left to be checked when connections are made.
+func checkDestination(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("destination scheme %s is not supported", u.Scheme)
+	} else if u.Hostname() == "" {
+		return fmt.Errorf("destination host is missing")
+	}
+
+	host := u.Hostname()
+	if err = checkDestinationHost(host); err != nil || net.ParseIP(host) != nil || allowedDestinations.allowsHost(host) {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, destinationLookupTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if err = checkDestinationIP(addr.IP); err != nil {
+			return fmt.Errorf("destination host %s resolves to an internal address", host)
+		}
+	}
+	return nil
+}
+
+// Dials the given address, refusing to connect to internal destinations that are not allowed.
+func dialDestination(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	} else if err = checkDestinationHost(host); err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !allowedDestinations.allowsHost(host) {
+		// Check the address the host name resolved to, just before connecting to it
+		dialer.Control = func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			return checkDestinationHost(host)
+		}
+	}
+	return dialer.DialContext(ctx, network, address)
+}
+
+// Returns an HTTP transport connecting to destinations that are not internal only. Proxies are not used, as their
+// own addresses are typically internal.
+func newDestinationTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialDestination
+	return transport
+}
@@ -54,12 +54,14 @@ const (
 	ApplicationType          ResourceType = "application"
 	ApplicationReferenceType ResourceType = "application-reference"
 	ArtifactType             ResourceType = "artifact"
+	ChartType                ResourceType = "chart"
 	DeploymentPackageType    ResourceType = "deployment-package"
 	DeploymentProfileType    ResourceType = "deployment-profile"
 	ProfileType              ResourceType = "profile"
 	PublisherType            ResourceType = "publisher"
 	RegistryType             ResourceType = "registry"
 	UploadSession            ResourceType = "upload-session"
+	WebhookType              ResourceType = "webhook"
 )
 
 func WithResourceType(resourceType ResourceType) Option {
@@ -113,6 +115,10 @@ func NewFailedPrecondition(opts ...Option) error {
 	return newCodedError(codes.FailedPrecondition, "failed precondition", opts...)
 }
 
+func NewAborted(opts ...Option) error {
+	return newCodedError(codes.Aborted, "aborted", opts...)
+}
+
 func NewPermissionDenied(opts ...Option) error {
 	return newCodedError(codes.PermissionDenied, "access denied", opts...)
 }
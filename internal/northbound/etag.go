@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Every registry, artifact, application and deployment package carries an opaque entity tag. The tag is not kept up
+ * to date by the database: the ent schema mixin (see ETagMixin in internal/ent/schema/etag.go) sets it to a new random
+ * value through UpdateDefault on every update mutation of the entity row made through ent, so any update made with raw
+ * SQL would leave a stale entity tag behind. Clients may pass the entity tag they last read to the update and
+ * delete RPCs, either in the request or through the If-Match header, to have the change rejected if the entity was
+ * changed by someone else in the meantime.
+ *
+ * The entity row is locked when its entity tag is checked, until the change is committed, so that concurrent changes
+ * conditional upon the same entity tag are made one after another: all but the first are then rejected.
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/artifact"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ifMatchKey is the metadata key under which the If-Match header is forwarded by the REST proxy.
+const ifMatchKey = "if-match"
+
+// Returns the external form of the given entity tag.
+func formatETag(etag int64) string {
+	return fmt.Sprintf("%x", etag)
+}
+
+// Sends the given entity tag in the response header, which the REST proxy returns as the ETag header.
+func setETagHeader(ctx context.Context, etag string) {
+	// Fails only if the call is not made through a gRPC server, in which case there is no header to set
+	_ = grpc.SetHeader(ctx, metadata.Pairs("etag", fmt.Sprintf("%q", etag)))
+}
+
+// Returns the entity tag the change is conditional upon; the one given in the request takes precedence over
+// the If-Match header.
+func requestedETag(ctx context.Context, etag string) string {
+	if etag == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(ifMatchKey); len(v) > 0 {
+				etag = v[0]
+			}
+		}
+	}
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	return strings.Trim(etag, `"`)
+}
+
+// Returns the current entity tag of the given entity and whether the entity exists, locking the entity for the rest
+// of the transaction.
+func currentETag(ctx context.Context, tx *generated.Tx, resourceType errors.ResourceType, projectUUID string,
+	name string, version string) (int64, bool, error) {
+	var etag int64
+	var err error
+	switch resourceType {
+	case errors.RegistryType:
+		var regDB *generated.Registry
+		regDB, err = tx.Registry.Query().Where(registry.ProjectUUID(projectUUID), registry.Name(name), forUpdate).Only(ctx)
+		if err == nil {
+			etag = regDB.Etag
+		}
+	case errors.ArtifactType:
+		var artDB *generated.Artifact
+		artDB, err = tx.Artifact.Query().Where(artifact.ProjectUUID(projectUUID), artifact.Name(name), forUpdate).Only(ctx)
+		if err == nil {
+			etag = artDB.Etag
+		}
+	case errors.ApplicationType:
+		var appDB *generated.Application
+		appDB, err = tx.Application.Query().
+			Where(application.ProjectUUID(projectUUID), application.Name(name), application.Version(version), forUpdate).
+			Only(ctx)
+		if err == nil {
+			etag = appDB.Etag
+		}
+	case errors.DeploymentPackageType:
+		var pkgDB *generated.DeploymentPackage
+		pkgDB, err = tx.DeploymentPackage.Query().
+			Where(deploymentpackage.ProjectUUID(projectUUID), deploymentpackage.Name(name), deploymentpackage.Version(version), forUpdate).
+			Only(ctx)
+		if err == nil {
+			etag = pkgDB.Etag
+		}
+	default:
+		return 0, false, errors.NewInternal(errors.WithMessage("entity tags are not kept for %s", resourceType))
+	}
+	if generated.IsNotFound(err) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, errors.NewDBError(errors.WithError(err))
+	}
+	return etag, true, nil
+}
+
+// Checks that the entity tag the change is conditional upon, if any, matches the current entity tag of the given
+// entity. Entities that do not exist are left for the change itself to report.
+func (g *Server) checkETag(ctx context.Context, tx *generated.Tx, resourceType errors.ResourceType, projectUUID string,
+	name string, version string, etag string) error {
+	requested := requestedETag(ctx, etag)
+	if requested == "" || requested == "*" {
+		return nil
+	}
+	current, ok, err := currentETag(ctx, tx, resourceType, projectUUID, name, version)
+	if err != nil || !ok {
+		return err
+	}
+	if requested != formatETag(current) {
+		return errors.NewAborted(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
+			errors.WithMessage("etag %s does not match the current etag; the %s was changed by another request", requested, resourceType))
+	}
+	return nil
+}
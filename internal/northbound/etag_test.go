@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (s *NorthBoundTestSuite) TestETagDeploymentPackage() {
+	ctx := s.ProjectID(footen)
+	created := s.createDeploymentPkg(footen, "tagged", "0.1.0", "foo:v0.1.0")
+
+	var header metadata.MD
+	getResp, err := s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "tagged", Version: "0.1.0",
+	}, grpc.Header(&header))
+	s.NoError(err)
+	pkg := getResp.DeploymentPackage
+	s.NotEmpty(pkg.Etag)
+	s.Equal(created.Etag, pkg.Etag)
+	s.Equal([]string{`"` + pkg.Etag + `"`}, header.Get("etag"))
+
+	// Updating with the current etag succeeds and changes the etag
+	pkg.Description = "Tagged package"
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version, DeploymentPackage: pkg, Etag: pkg.Etag,
+	})
+	s.NoError(err)
+	getResp, err = s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "tagged", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.NotEqual(pkg.Etag, getResp.DeploymentPackage.Etag)
+
+	// Updating or deleting with the stale etag is aborted
+	pkg.Description = "Stale package"
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version, DeploymentPackage: pkg, Etag: pkg.Etag,
+	})
+	s.Equal(codes.Aborted, status.Code(err))
+	_, err = s.client.DeleteDeploymentPackage(ctx, &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version, Etag: pkg.Etag,
+	})
+	s.Equal(codes.Aborted, status.Code(err))
+
+	// The If-Match header is honoured in place of the etag in the request
+	staleCtx := metadata.AppendToOutgoingContext(ctx, "if-match", `"`+pkg.Etag+`"`)
+	_, err = s.client.DeleteDeploymentPackage(staleCtx, &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version,
+	})
+	s.Equal(codes.Aborted, status.Code(err))
+	currentCtx := metadata.AppendToOutgoingContext(ctx, "if-match", `"`+getResp.DeploymentPackage.Etag+`"`)
+	_, err = s.client.DeleteDeploymentPackage(currentCtx, &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version,
+	})
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) TestETagApplicationAndRegistry() {
+	ctx := s.ProjectID(footen)
+	getResp, err := s.client.GetApplication(ctx, &catalogv3.GetApplicationRequest{ApplicationName: "foo", Version: "v0.1.0"})
+	s.NoError(err)
+	app := getResp.Application
+
+	app.Description = "Tagged application"
+	_, err = s.client.UpdateApplication(ctx, &catalogv3.UpdateApplicationRequest{
+		ApplicationName: app.Name, Version: app.Version, Application: app, Etag: app.Etag,
+	})
+	s.NoError(err)
+	_, err = s.client.UpdateApplication(ctx, &catalogv3.UpdateApplicationRequest{
+		ApplicationName: app.Name, Version: app.Version, Application: app, Etag: app.Etag,
+	})
+	s.Equal(codes.Aborted, status.Code(err))
+
+	// Any etag matches the wildcard
+	_, err = s.client.UpdateApplication(ctx, &catalogv3.UpdateApplicationRequest{
+		ApplicationName: app.Name, Version: app.Version, Application: app, Etag: "*",
+	})
+	s.NoError(err)
+
+	reg := s.createRegistry(footen, "tagged", "HELM")
+	regResp, err := s.client.GetRegistry(ctx, &catalogv3.GetRegistryRequest{RegistryName: reg.Name})
+	s.NoError(err)
+	s.NotEmpty(regResp.Registry.Etag)
+	_, err = s.client.DeleteRegistry(ctx, &catalogv3.DeleteRegistryRequest{RegistryName: reg.Name, Etag: "0"})
+	s.Equal(codes.Aborted, status.Code(err))
+	_, err = s.client.DeleteRegistry(ctx, &catalogv3.DeleteRegistryRequest{RegistryName: reg.Name, Etag: regResp.Registry.Etag})
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) TestETagConcurrentUpdates() {
+	// The transactions are run one after another over the single connection, much as the row lock orders them
+	db, err := sql.Open("sqlite3", "file:etag?mode=memory&_fk=1")
+	s.NoError(err)
+	db.SetMaxOpenConns(1)
+	client := generated.NewClient(generated.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	defer client.Close()
+	s.NoError(client.Schema.Create(s.ctx))
+	server := &Server{databaseClient: client, opaClient: s.opa, listeners: NewEventListeners()}
+
+	ctx := s.ServerProjectID(footen)
+	created, err := server.CreateRegistry(ctx, &catalogv3.CreateRegistryRequest{Registry: &catalogv3.Registry{
+		Name: "tagged", RootUrl: "https://registry.example.com", Type: helmType,
+	}})
+	s.NoError(err)
+	resp, err := server.GetRegistry(ctx, &catalogv3.GetRegistryRequest{RegistryName: created.Registry.Name})
+	s.NoError(err)
+	etag := resp.Registry.Etag
+
+	const updates = 5
+	var wg sync.WaitGroup
+	codeCh := make(chan codes.Code, updates)
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reg := &catalogv3.Registry{Name: "tagged", RootUrl: "https://registry.example.com", Type: helmType,
+				Description: "Concurrent update"}
+			_, err := server.UpdateRegistry(ctx, &catalogv3.UpdateRegistryRequest{RegistryName: reg.Name, Registry: reg, Etag: etag})
+			codeCh <- status.Code(err)
+		}()
+	}
+	wg.Wait()
+	close(codeCh)
+
+	counts := make(map[codes.Code]int)
+	for code := range codeCh {
+		counts[code]++
+	}
+	s.Equal(map[codes.Code]int{codes.OK: 1, codes.Aborted: updates - 1}, counts)
+}
+
+func TestCheckETagLocks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	client := generated.NewClient(generated.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FOR UPDATE`).WillReturnRows(sqlmock.NewRows([]string{"id", "etag"}).AddRow(1, 42))
+	mock.ExpectRollback()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, (&Server{}).checkETag(ctx, tx, errors.RegistryType, footen, "tagged", "", formatETag(42)))
+	assert.NoError(t, tx.Rollback())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
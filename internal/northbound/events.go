@@ -5,7 +5,12 @@
 package northbound
 
 import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/proto"
 )
 
 type EventType string
@@ -24,6 +29,7 @@ func event(eventType EventType, projectUUID string) *catalogv3.Event {
 
 // RegistryEvents is a queue of registry events.
 type RegistryEvents struct {
+	auditTrail
 	queue []*catalogv3.WatchRegistriesResponse
 }
 
@@ -31,6 +37,46 @@ func (re *RegistryEvents) append(eventType EventType, projectUUID string, r *cat
 	re.queue = append(re.queue, &catalogv3.WatchRegistriesResponse{Event: event(eventType, projectUUID), Registry: r})
 }
 
+// Records the queued events in the outbox and the audit log; registry secrets are never recorded.
+func (re *RegistryEvents) persist(ctx context.Context, tx *generated.Tx) error {
+	for _, e := range re.queue {
+		r := &catalogv3.WatchRegistriesResponse{Event: e.Event, Registry: redactRegistry(e.Registry)}
+		if err := persistEvent(ctx, tx, errors.RegistryType, e.Event, r); err != nil {
+			return err
+		}
+		// Updated registries are captured along with their secrets, for uploads to tell whether those changed
+		var before proto.Message
+		if b, ok := re.capturedBefore(e.Registry.Name, "").(*catalogv3.Registry); ok {
+			before = redactRegistry(b)
+		}
+		var after proto.Message = r.Registry
+		if EventType(e.Event.Type) == DeletedEvent {
+			after = nil
+		}
+		if err := recordAudit(ctx, tx.AuditEvent, e.Event.ProjectId, errors.RegistryType, e.Registry.Name, "",
+			EventType(e.Event.Type), before, after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns a copy of the given registry without any of its secrets.
+func redactRegistry(r *catalogv3.Registry) *catalogv3.Registry {
+	return &catalogv3.Registry{
+		Name:         r.Name,
+		DisplayName:  r.DisplayName,
+		Description:  r.Description,
+		RootUrl:      r.RootUrl,
+		InventoryUrl: r.InventoryUrl,
+		Type:         r.Type,
+		ApiType:      r.ApiType,
+		Labels:       r.Labels,
+		CreateTime:   r.CreateTime,
+		UpdateTime:   r.UpdateTime,
+	}
+}
+
 func (re *RegistryEvents) sendToAll(listeners *EventListeners) {
 	for _, e := range re.queue {
 		listeners.sendRegistryEvents(e)
@@ -39,6 +85,7 @@ func (re *RegistryEvents) sendToAll(listeners *EventListeners) {
 
 // ArtifactEvents is a queue of artifact events.
 type ArtifactEvents struct {
+	auditTrail
 	queue []*catalogv3.WatchArtifactsResponse
 }
 
@@ -46,6 +93,19 @@ func (are *ArtifactEvents) append(eventType EventType, projectUUID string, ar *c
 	are.queue = append(are.queue, &catalogv3.WatchArtifactsResponse{Event: event(eventType, projectUUID), Artifact: ar})
 }
 
+// Records the queued events in the outbox and the audit log.
+func (are *ArtifactEvents) persist(ctx context.Context, tx *generated.Tx) error {
+	for _, e := range are.queue {
+		if err := persistEvent(ctx, tx, errors.ArtifactType, e.Event, e); err != nil {
+			return err
+		}
+		if err := are.audit(ctx, tx, errors.ArtifactType, e.Event, e.Artifact.Name, "", e.Artifact); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (are *ArtifactEvents) sendToAll(listeners *EventListeners) {
 	for _, e := range are.queue {
 		listeners.sendArtifactEvents(e)
@@ -54,6 +114,7 @@ func (are *ArtifactEvents) sendToAll(listeners *EventListeners) {
 
 // ApplicationEvents is a queue of application events.
 type ApplicationEvents struct {
+	auditTrail
 	queue []*catalogv3.WatchApplicationsResponse
 }
 
@@ -61,6 +122,19 @@ func (ape *ApplicationEvents) append(eventType EventType, projectUUID string, ap
 	ape.queue = append(ape.queue, &catalogv3.WatchApplicationsResponse{Event: event(eventType, projectUUID), Application: app})
 }
 
+// Records the queued events in the outbox and the audit log.
+func (ape *ApplicationEvents) persist(ctx context.Context, tx *generated.Tx) error {
+	for _, e := range ape.queue {
+		if err := persistEvent(ctx, tx, errors.ApplicationType, e.Event, e); err != nil {
+			return err
+		}
+		if err := ape.audit(ctx, tx, errors.ApplicationType, e.Event, e.Application.Name, e.Application.Version, e.Application); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ape *ApplicationEvents) sendToAll(listeners *EventListeners) {
 	for _, e := range ape.queue {
 		listeners.sendApplicationEvents(e)
@@ -69,6 +143,7 @@ func (ape *ApplicationEvents) sendToAll(listeners *EventListeners) {
 
 // DeploymentPackageEvents is a queue of deployment package events.
 type DeploymentPackageEvents struct {
+	auditTrail
 	queue []*catalogv3.WatchDeploymentPackagesResponse
 }
 
@@ -76,6 +151,19 @@ func (dpe *DeploymentPackageEvents) append(eventType EventType, projectUUID stri
 	dpe.queue = append(dpe.queue, &catalogv3.WatchDeploymentPackagesResponse{Event: event(eventType, projectUUID), DeploymentPackage: p})
 }
 
+// Records the queued events in the outbox and the audit log.
+func (dpe *DeploymentPackageEvents) persist(ctx context.Context, tx *generated.Tx) error {
+	for _, e := range dpe.queue {
+		if err := persistEvent(ctx, tx, errors.DeploymentPackageType, e.Event, e); err != nil {
+			return err
+		}
+		if err := dpe.audit(ctx, tx, errors.DeploymentPackageType, e.Event, e.DeploymentPackage.Name, e.DeploymentPackage.Version, e.DeploymentPackage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (dpe *DeploymentPackageEvents) sendToAll(listeners *EventListeners) {
 	for _, e := range dpe.queue {
 		listeners.sendDeploymentPackageEvents(e)
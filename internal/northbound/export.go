@@ -0,0 +1,465 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* The Catalog Service supports Exports via the ExportDeploymentPackage endpoint.
+ *
+ * An export produces a gzipped tarball of YAML files describing a deployment package along with
+ * every application, profile values file, registry and artifact it relies on. The resulting
+ * tarball is in the same form as accepted by the Upload endpoint, and can therefore be used to
+ * recreate the deployment package in another project or in another orchestrator.
+ */
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	b64 "encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/app-orch-catalog/pkg/schema/upload"
+	yaml "gopkg.in/yaml.v3"
+)
+
+const (
+	exportSchema        = "https://schema.intel.com/catalog.orchestrator/0.1/schema"
+	exportSchemaVersion = "0.1"
+)
+
+// ExportDeploymentPackage exports a deployment package and its dependencies as a tarball of YAML files through gRPC
+func (g *Server) ExportDeploymentPackage(ctx context.Context, req *catalogv3.ExportDeploymentPackageRequest) (*catalogv3.ExportDeploymentPackageResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.DeploymentPackageName == "" || req.Version == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	requestName := "ExportDeploymentPackageRequest"
+	if req.ShowSensitiveInfo {
+		requestName = "ExportDeploymentPackageWithSensitiveInfoRequest"
+	}
+	if err := g.authCheckAllowed(ctx, req, requestName); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	files, err := g.exportDeploymentPackage(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version, req.ShowSensitiveInfo)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	contents, err := files.createTarball()
+	if err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithResourceName(req.DeploymentPackageName),
+			errors.WithResourceVersion(req.Version),
+			errors.WithMessage("unable to create tarball: %v", err))
+	}
+
+	logActivity(ctx, "exported", "deployment-package", projectUUID, req.DeploymentPackageName, req.Version)
+	return &catalogv3.ExportDeploymentPackageResponse{
+		FileName: fmt.Sprintf("%s-%s.tar.gz", req.DeploymentPackageName, req.Version),
+		Contents: contents,
+	}, nil
+}
+
+// Collects the YAML files of the specified deployment package and all entities it references into a fileset.
+func (g *Server) exportDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string,
+	pkgName string, pkgVersion string, showSensitiveInfo bool) (fileSet, error) {
+	pkgDB, err := tx.DeploymentPackage.Query().
+		Where(
+			deploymentpackage.ProjectUUID(projectUUID),
+			deploymentpackage.Name(pkgName),
+			deploymentpackage.Version(pkgVersion),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, errors.NewNotFound(
+				errors.WithResourceType(errors.DeploymentPackageType),
+				errors.WithResourceName(pkgName),
+				errors.WithResourceVersion(pkgVersion))
+		}
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	pkg, err := extractDeploymentPackage(ctx, pkgDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Export only the explicitly defined deployment profiles; the synthetic default profile is
+	// re-created implicitly when the package is loaded again.
+	pkg.Profiles, err = extractDeploymentProfiles(ctx, pkgDB, hasDuplicateAppNames(pkg.ApplicationReferences), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkg.Profiles) == 0 {
+		pkg.DefaultProfileName = ""
+	}
+
+	files := make(fileSet, 0)
+	if err = files.addSpec(fmt.Sprintf("deployment-package-%s-%s.yaml", pkg.Name, pkg.Version), deploymentPackageSpec(pkg)); err != nil {
+		return nil, err
+	}
+
+	// Export all applications referenced by the package, along with their profile values and registries
+	appsDB, err := pkgDB.QueryApplications().All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	registriesDB := make(map[string]*generated.Registry, 0)
+	for _, appDB := range appsDB {
+		app, err := g.applicationExtract(ctx, appDB, "")
+		if err != nil {
+			return nil, err
+		}
+
+		spec := applicationSpec(app)
+		for i, p := range app.Profiles {
+			valuesFileName := fmt.Sprintf("values-%s-%s-%s.yaml", app.Name, app.Version, p.Name)
+			spec.Profiles[i].ValuesFileName = valuesFileName
+			files[valuesFileName] = []byte(p.ChartValues)
+		}
+		if err = files.addSpec(fmt.Sprintf("application-%s-%s.yaml", app.Name, app.Version), spec); err != nil {
+			return nil, err
+		}
+
+		helmRegistryDB, err := appDB.QueryRegistryFk().Only(ctx)
+		if err != nil {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+		registriesDB[helmRegistryDB.Name] = helmRegistryDB
+
+		imageRegistryDB, err := appDB.QueryImageRegistryFk().Only(ctx)
+		if err == nil {
+			registriesDB[imageRegistryDB.Name] = imageRegistryDB
+		} else if !generated.IsNotFound(err) {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+	}
+
+	var secretService SecretService
+	if UseSecretService && len(registriesDB) > 0 {
+		secretService, err = SecretServiceFactory(ctx)
+		if err != nil {
+			return nil, errors.NewVaultError(errors.WithError(err))
+		}
+		defer secretService.Logout(ctx)
+	}
+
+	for _, registryDB := range registriesDB {
+		reg, err := g.extractRegistry(ctx, registryDB, secretService, showSensitiveInfo)
+		if err != nil {
+			return nil, err
+		}
+		if err = files.addSpec(fmt.Sprintf("registry-%s.yaml", reg.Name), registrySpec(reg)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Export all artifacts referenced by the package
+	artifactRefsDB, err := pkgDB.QueryArtifacts().All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	for _, refDB := range artifactRefsDB {
+		artifactDB, err := refDB.QueryArtifact().Only(ctx)
+		if err != nil {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+		if err = files.addSpec(fmt.Sprintf("artifact-%s.yaml", artifactDB.Name), artifactSpec(artifactDB)); err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// Adds the specified YAML spec to the fileset under the given file name.
+func (f fileSet) addSpec(fileName string, spec upload.YamlSpec) error {
+	node := &yaml.Node{}
+	if err := node.Encode(spec); err != nil {
+		return errors.NewInvalidArgument(errors.WithMessage("unable to encode %s: %v", fileName, err))
+	}
+
+	// Prefix the spec with the schema directive and make sure the description, which is required
+	// by the schema, is always present.
+	content := []*yaml.Node{stringNode("$schema"), stringNode(exportSchema)}
+	content = append(content, node.Content...)
+	if spec.Description == "" {
+		content = append(content, stringNode("description"), stringNode(""))
+	}
+	node.Content = content
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return errors.NewInvalidArgument(errors.WithMessage("unable to encode %s: %v", fileName, err))
+	}
+	if err := encoder.Close(); err != nil {
+		return errors.NewInvalidArgument(errors.WithMessage("unable to encode %s: %v", fileName, err))
+	}
+	f[fileName] = buf.Bytes()
+	return nil
+}
+
+func stringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// Produces a gzipped tarball from the contents of the fileset; files are added in lexical order.
+func (f fileSet) createTarball() ([]byte, error) {
+	fileNames := make([]string, 0, len(f))
+	for fileName := range f {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	modTime := time.Now()
+	for _, fileName := range fileNames {
+		header := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     fileName,
+			Mode:     0644,
+			Size:     int64(len(f[fileName])),
+			ModTime:  modTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tarWriter.Write(f[fileName]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func deprecationSpec(d *catalogv3.Deprecation) *upload.Deprecation {
+	if d == nil {
+		return nil
+	}
+	return &upload.Deprecation{Message: d.Message, ReplacementVersion: d.ReplacementVersion}
+}
+
+func registrySpec(reg *catalogv3.Registry) upload.YamlSpec {
+	return upload.YamlSpec{
+		SpecSchema:    upload.RegistryType,
+		SchemaVersion: exportSchemaVersion,
+		Name:          reg.Name,
+		DisplayName:   reg.DisplayName,
+		Description:   reg.Description,
+		Type:          reg.Type,
+		APIType:       reg.ApiType,
+		RootURL:       reg.RootUrl,
+		InventoryURL:  reg.InventoryUrl,
+		UserName:      reg.Username,
+		AuthToken:     reg.AuthToken,
+		CACerts:       reg.Cacerts,
+		Labels:        reg.Labels,
+	}
+}
+
+func artifactSpec(artifactDB *generated.Artifact) upload.YamlSpec {
+	return upload.YamlSpec{
+		SpecSchema:    upload.ArtifactType,
+		SchemaVersion: exportSchemaVersion,
+		Name:          artifactDB.Name,
+		DisplayName:   artifactDB.DisplayName,
+		Description:   artifactDB.Description,
+		MimeType:      artifactDB.MimeType,
+		Artifact:      b64.StdEncoding.EncodeToString(artifactDB.Artifact),
+	}
+}
+
+func applicationSpec(app *catalogv3.Application) upload.YamlSpec {
+	spec := upload.YamlSpec{
+		SpecSchema:     upload.ApplicationType,
+		SchemaVersion:  exportSchemaVersion,
+		Name:           app.Name,
+		DisplayName:    app.DisplayName,
+		Version:        app.Version,
+		Kind:           kindToDB(app.Kind),
+		Labels:         app.Labels,
+		Deprecation:    deprecationSpec(app.Deprecation),
+		Description:    app.Description,
+		HelmRegistry:   app.HelmRegistryName,
+		ImageRegistry:  app.ImageRegistryName,
+		ChartName:      app.ChartName,
+		ChartVersion:   app.ChartVersion,
+		DefaultProfile: app.DefaultProfileName,
+	}
+
+	for _, p := range app.Profiles {
+		profile := upload.Profile{
+			Name:        p.Name,
+			DisplayName: p.DisplayName,
+			Description: p.Description,
+		}
+		for _, pt := range p.ParameterTemplates {
+			profile.ParameterTemplates = append(profile.ParameterTemplates, upload.ParameterTemplate{
+				Name:            pt.Name,
+				DisplayName:     pt.DisplayName,
+				Type:            pt.Type,
+				Default:         pt.Default,
+				Validator:       pt.Validator,
+				SuggestedValues: pt.SuggestedValues,
+				Secret:          pt.Secret,
+				Mandatory:       pt.Mandatory,
+			})
+		}
+		for _, dr := range p.DeploymentRequirement {
+			profile.DeploymentRequirements = append(profile.DeploymentRequirements, upload.DeploymentRequirement{
+				Name:              dr.Name,
+				Version:           dr.Version,
+				DeploymentProfile: dr.DeploymentProfileName,
+			})
+		}
+		spec.Profiles = append(spec.Profiles, profile)
+	}
+
+	for _, r := range app.IgnoredResources {
+		spec.IgnoredResources = append(spec.IgnoredResources, upload.ResourceReference{
+			Name:      r.Name,
+			Kind:      r.Kind,
+			Namespace: r.Namespace,
+		})
+	}
+	return spec
+}
+
+func deploymentPackageSpec(pkg *catalogv3.DeploymentPackage) upload.YamlSpec {
+	spec := upload.YamlSpec{
+		SpecSchema:                 upload.DeploymentPackageType,
+		SchemaVersion:              exportSchemaVersion,
+		Name:                       pkg.Name,
+		DisplayName:                pkg.DisplayName,
+		Version:                    pkg.Version,
+		Kind:                       kindToDB(pkg.Kind),
+		Labels:                     pkg.Labels,
+		Deprecation:                deprecationSpec(pkg.Deprecation),
+		Description:                pkg.Description,
+		DefaultProfile:             pkg.DefaultProfileName,
+		Applications:               make([]upload.Application, 0, len(pkg.ApplicationReferences)),
+		DefaultNamespaces:          pkg.DefaultNamespaces,
+		IsVisible:                  pkg.IsVisible,
+		ForbidsMultipleDeployments: pkg.ForbidsMultipleDeployments,
+	}
+
+	for _, a := range pkg.ApplicationReferences {
+		spec.Applications = append(spec.Applications, upload.Application{
+			Name:    a.Name,
+			Version: a.Version,
+		})
+	}
+
+	for _, a := range pkg.ApplicationDependencies {
+		spec.ApplicationDependencies = append(spec.ApplicationDependencies, upload.ApplicationDependency{
+			Name:     a.Name,
+			Requires: a.Requires,
+		})
+	}
+
+	for _, p := range pkg.Profiles {
+		profile := upload.DeploymentProfile{
+			Name:        p.Name,
+			DisplayName: p.DisplayName,
+			Description: p.Description,
+		}
+		appNames := make([]string, 0, len(p.ApplicationProfiles))
+		for appName := range p.ApplicationProfiles {
+			appNames = append(appNames, appName)
+		}
+		sort.Strings(appNames)
+		for _, appName := range appNames {
+			profile.ApplicationProfiles = append(profile.ApplicationProfiles, upload.ApplicationProfile{
+				ApplicationName: appName,
+				ProfileName:     p.ApplicationProfiles[appName],
+			})
+		}
+		spec.DeploymentProfiles = append(spec.DeploymentProfiles, profile)
+	}
+
+	for _, e := range pkg.Extensions {
+		extension := upload.APIExtension{
+			Name:        e.Name,
+			Version:     e.Version,
+			DisplayName: e.DisplayName,
+			Description: e.Description,
+		}
+		for _, ep := range e.Endpoints {
+			extension.Endpoints = append(extension.Endpoints, &upload.Endpoint{
+				ServiceName:  ep.ServiceName,
+				ExternalPath: ep.ExternalPath,
+				InternalPath: ep.InternalPath,
+				Scheme:       ep.Scheme,
+				AuthType:     ep.AuthType,
+				AppName:      ep.AppName,
+			})
+		}
+		if e.UiExtension != nil {
+			label := e.UiExtension.Label
+			extension.UIExtension = &upload.UIExtension{
+				ServiceName: e.UiExtension.ServiceName,
+				Description: e.UiExtension.Description,
+				Label:       &label,
+				FileName:    e.UiExtension.FileName,
+				AppName:     e.UiExtension.AppName,
+				ModuleName:  e.UiExtension.ModuleName,
+			}
+		}
+		spec.Extensions = append(spec.Extensions, extension)
+	}
+
+	for _, a := range pkg.Artifacts {
+		spec.Artifacts = append(spec.Artifacts, upload.ArtifactReference{
+			Name:    a.Name,
+			Purpose: a.Purpose,
+		})
+	}
+
+	for _, ns := range pkg.Namespaces {
+		spec.Namespaces = append(spec.Namespaces, upload.Namespace{
+			Name:        ns.Name,
+			Labels:      ns.Labels,
+			Annotations: ns.Annotations,
+		})
+	}
+	return spec
+}
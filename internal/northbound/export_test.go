@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+const exportTarget = "exported"
+
+// Exports the specified deployment package and uploads the resulting tarball into the given project.
+func (s *NorthBoundTestSuite) exportAndUpload(fromProject string, name string, version string, toProject string) {
+	resp, err := s.client.ExportDeploymentPackage(s.ProjectID(fromProject), &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: name, Version: version, ShowSensitiveInfo: true,
+	})
+	s.validateResponse(err, resp)
+	s.Equal(name+"-"+version+".tar.gz", resp.FileName)
+
+	uresp, err := s.client.UploadCatalogEntities(s.ProjectID(toProject), &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, Upload: &catalogv3.Upload{FileName: resp.FileName, Artifact: resp.Contents},
+	})
+	s.validateResponse(err, uresp)
+}
+
+func (s *NorthBoundTestSuite) getExportedPackage(ctx context.Context, name string, version string) *catalogv3.DeploymentPackage {
+	resp, err := s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{DeploymentPackageName: name, Version: version})
+	s.validateResponse(err, resp)
+	pkg := resp.DeploymentPackage
+	pkg.CreateTime, pkg.UpdateTime, pkg.Etag = nil, nil, ""
+	// Application references are returned in the order in which the applications were created
+	sort.Slice(pkg.ApplicationReferences, func(i, j int) bool {
+		return pkg.ApplicationReferences[i].Name < pkg.ApplicationReferences[j].Name
+	})
+	for _, p := range pkg.Profiles {
+		p.CreateTime, p.UpdateTime = nil, nil
+	}
+	return pkg
+}
+
+func (s *NorthBoundTestSuite) getExportedApplication(ctx context.Context, name string, version string) *catalogv3.Application {
+	resp, err := s.client.GetApplication(ctx, &catalogv3.GetApplicationRequest{ApplicationName: name, Version: version})
+	s.validateResponse(err, resp)
+	app := resp.Application
+	app.CreateTime, app.UpdateTime, app.Etag = nil, nil, ""
+	for _, p := range app.Profiles {
+		p.CreateTime, p.UpdateTime = nil, nil
+	}
+	return app
+}
+
+func (s *NorthBoundTestSuite) TestExportDeploymentPackage() {
+	s.exportAndUpload(footen, "ca-gigi", "v0.3.4", exportTarget)
+
+	original := s.getExportedPackage(s.ProjectID(footen), "ca-gigi", "v0.3.4")
+	exported := s.getExportedPackage(s.ProjectID(exportTarget), "ca-gigi", "v0.3.4")
+	s.True(proto.Equal(original, exported), "expected %v; got %v", original, exported)
+
+	for _, ref := range original.ApplicationReferences {
+		originalApp := s.getExportedApplication(s.ProjectID(footen), ref.Name, ref.Version)
+		exportedApp := s.getExportedApplication(s.ProjectID(exportTarget), ref.Name, ref.Version)
+		s.True(proto.Equal(originalApp, exportedApp), "expected %v; got %v", originalApp, exportedApp)
+	}
+
+	greg, err := s.client.GetRegistry(s.ProjectID(exportTarget), &catalogv3.GetRegistryRequest{RegistryName: fooreg, ShowSensitiveInfo: true})
+	s.validateResponse(err, greg)
+	s.validateRegistry(greg.Registry, fooreg, "Registry fooreg", "Registry that holds fooreg",
+		"http://footen.com/fooreg", "admin", "token", "cacerts")
+
+	// Exporting again and re-uploading into the same project should be a no-op
+	s.exportAndUpload(exportTarget, "ca-gigi", "v0.3.4", exportTarget)
+	s.True(proto.Equal(original, s.getExportedPackage(s.ProjectID(exportTarget), "ca-gigi", "v0.3.4")))
+}
+
+func (s *NorthBoundTestSuite) TestExportDeploymentPackageWithArtifactsAndExtensions() {
+	s.uploadThings()
+	s.exportAndUpload("intel", "librespeed-app", "0.0.2", exportTarget)
+
+	original := s.getExportedPackage(s.ProjectID("intel"), "librespeed-app", "0.0.2")
+	exported := s.getExportedPackage(s.ProjectID(exportTarget), "librespeed-app", "0.0.2")
+	s.True(proto.Equal(original, exported), "expected %v; got %v", original, exported)
+	s.Len(exported.Extensions, 1)
+	s.Len(exported.Namespaces, 2)
+
+	originalApp := s.getExportedApplication(s.ProjectID("intel"), "librespeed-vm", "0.0.2")
+	exportedApp := s.getExportedApplication(s.ProjectID(exportTarget), "librespeed-vm", "0.0.2")
+	s.True(proto.Equal(originalApp, exportedApp), "expected %v; got %v", originalApp, exportedApp)
+
+	originalArt, err := s.client.GetArtifact(s.ProjectID("intel"), &catalogv3.GetArtifactRequest{ArtifactName: "librespeed-thumbnail"})
+	s.validateResponse(err, originalArt)
+	exportedArt, err := s.client.GetArtifact(s.ProjectID(exportTarget), &catalogv3.GetArtifactRequest{ArtifactName: "librespeed-thumbnail"})
+	s.validateResponse(err, exportedArt)
+	s.validateArtifact(exportedArt.Artifact, originalArt.Artifact.Name, originalArt.Artifact.DisplayName,
+		originalArt.Artifact.Description, originalArt.Artifact.MimeType, originalArt.Artifact.Artifact)
+}
+
+func (s *NorthBoundTestSuite) TestExportDeploymentPackageRedacted() {
+	resp, err := s.client.ExportDeploymentPackage(s.ProjectID(footen), &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, resp)
+
+	files, err := (&uploadSession{}).extractTarball(resp.Contents)
+	s.NoError(err)
+	s.Contains(files, "deployment-package-ca-gigi-v0.2.1.yaml")
+	s.Contains(files, "application-foo-v0.1.0.yaml")
+	s.Contains(files, "application-bar-v0.2.1.yaml")
+	s.Contains(files, "values-foo-v0.1.0-p2.yaml")
+	if s.Contains(files, "registry-fooreg.yaml") {
+		registryYAML := string(files["registry-fooreg.yaml"])
+		s.True(strings.Contains(registryYAML, "rootUrl: http://footen.com/fooreg"))
+		s.False(strings.Contains(registryYAML, "authToken"))
+		s.False(strings.Contains(registryYAML, "userName"))
+	}
+}
+
+func (s *NorthBoundTestSuite) TestExportDeploymentPackageNotDeployed() {
+	// Mark the deployment package as deployed; the export should not carry that state
+	resp, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, resp)
+	resp.DeploymentPackage.IsDeployed = true
+	none, err := s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1", DeploymentPackage: resp.DeploymentPackage,
+	})
+	s.validateResponse(err, none)
+
+	eresp, err := s.client.ExportDeploymentPackage(s.ProjectID(footen), &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, eresp)
+	files, err := (&uploadSession{}).extractTarball(eresp.Contents)
+	s.NoError(err)
+	if s.Contains(files, "deployment-package-ca-gigi-v0.2.1.yaml") {
+		s.False(strings.Contains(string(files["deployment-package-ca-gigi-v0.2.1.yaml"]), "isDeployed"))
+	}
+
+	s.exportAndUpload(footen, "ca-gigi", "v0.2.1", exportTarget)
+	s.False(s.getExportedPackage(s.ProjectID(exportTarget), "ca-gigi", "v0.2.1").IsDeployed)
+}
+
+func (s *NorthBoundTestSuite) TestExportDeploymentPackageErrors() {
+	resp, err := s.client.ExportDeploymentPackage(s.ProjectID(footen), &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v9.9.9",
+	})
+	s.validateNotFound(err, resp)
+
+	resp, err = s.client.ExportDeploymentPackage(s.ProjectID(footen), &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi",
+	})
+	s.validateError(err, codes.InvalidArgument, resp)
+
+	// Packages are not visible outside their project
+	resp, err = s.client.ExportDeploymentPackage(s.ProjectID(barten), &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.3.4",
+	})
+	s.validateNotFound(err, resp)
+}
@@ -0,0 +1,485 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* The list RPCs accept filters in the style of AIP-160, such as
+ *
+ *     kind != EXTENSION AND (name = "foo" OR displayName : bar) AND NOT createTime < 2026-01-01
+ *
+ * AND binds less tightly than OR, terms separated by spaces only are combined with AND, and NOT or a leading "-"
+ * negates a term. The comparators are =, !=, <, <=, >, >= and the : (has) operator.
+ *
+ * For compatibility with the earlier filters, an unquoted text value compared with = or != matches the text anywhere,
+ * regardless of case and with * matching any characters, and it extends over the following words up to the next
+ * keyword, parenthesis or comparison. A quoted text value compared with = or != must match exactly. Timestamps are
+ * given either as RFC 3339 date-times or as dates, and booleans as true or false. Versions are ordered by semantic
+ * version precedence, and a comparison may be followed by further comparisons of the same attribute to give a range,
+ * as in "version >= 1.2.0 < 2.0.0".
+ */
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/blang/semver/v4"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+)
+
+// Operators of the filter expressions, besides the comparators.
+const (
+	filterAnd = "AND"
+	filterOr  = "OR"
+	filterNot = "NOT"
+)
+
+// filter is a node of a parsed filter expression; either a boolean operation on its operands or a comparison of the
+// named attribute with the value.
+type filter struct {
+	op       string
+	operands []*filter
+	name     string
+	value    string
+	quoted   bool
+	pos      int
+}
+
+// boolColumns lists the columns holding booleans; those not set read as false.
+var boolColumns = map[string]bool{
+	"is_deployed": true,
+	"is_visible":  true,
+}
+
+// Returns true if the given column holds booleans.
+func isBoolColumn(column string) bool {
+	return boolColumns[column]
+}
+
+// Returns true if the given column holds the kind of the entity.
+func isKindColumn(column string) bool {
+	return column == "kind"
+}
+
+// filterParser is a recursive descent parser of filter expressions.
+type filterParser struct {
+	input        string
+	pos          int
+	resourceType errors.ResourceType
+}
+
+// Parses the given filter expression, returning nil if there is none.
+func parseFilter(filterParameter string, resourceType errors.ResourceType) (*filter, error) {
+	p := &filterParser{input: filterParameter, resourceType: resourceType}
+	p.skipSpaces()
+	if p.atEnd() {
+		return nil, nil
+	}
+	f, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if !p.atEnd() {
+		return nil, p.errorf("unexpected %q", p.peekWord())
+	}
+	return f, nil
+}
+
+// Returns the invalid argument error reporting the problem at the current position, counted from 1.
+func (p *filterParser) errorf(format string, args ...any) error {
+	return errors.NewInvalidArgument(
+		errors.WithResourceType(p.resourceType),
+		errors.WithMessage("filter: invalid filter request: %s at position %d", fmt.Sprintf(format, args...), p.pos+1))
+}
+
+func (p *filterParser) atEnd() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *filterParser) skipSpaces() {
+	for !p.atEnd() && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// Returns true if the given character ends a word.
+func isFilterDelimiter(c byte) bool {
+	return unicode.IsSpace(rune(c)) || strings.IndexByte(`()=!<>:"`, c) >= 0
+}
+
+// Returns the word at the current position, without consuming it.
+func (p *filterParser) peekWord() string {
+	end := p.pos
+	for end < len(p.input) && !isFilterDelimiter(p.input[end]) {
+		end++
+	}
+	if end == p.pos && !p.atEnd() {
+		end++
+	}
+	return p.input[p.pos:end]
+}
+
+// Consumes the given keyword if it is next, followed by a space or parenthesis.
+func (p *filterParser) acceptKeyword(keyword string) bool {
+	if p.peekWord() != keyword {
+		return false
+	}
+	p.pos += len(keyword)
+	p.skipSpaces()
+	return true
+}
+
+// Returns true if a term, rather than an operator or the end of the expression or group, is next.
+func (p *filterParser) atTerm() bool {
+	if p.atEnd() || p.input[p.pos] == ')' {
+		return false
+	}
+	word := p.peekWord()
+	return word != filterAnd && word != filterOr
+}
+
+// expression := sequence { "AND" sequence }
+func (p *filterParser) parseExpression() (*filter, error) {
+	return p.parseOperation(filterAnd, p.parseSequence)
+}
+
+// sequence := factor { factor }
+func (p *filterParser) parseSequence() (*filter, error) {
+	f, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*filter{f}
+	for p.atTerm() {
+		if f, err = p.parseFactor(); err != nil {
+			return nil, err
+		}
+		operands = append(operands, f)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &filter{op: filterAnd, operands: operands}, nil
+}
+
+// factor := term { "OR" term }
+func (p *filterParser) parseFactor() (*filter, error) {
+	return p.parseOperation(filterOr, p.parseTerm)
+}
+
+// Parses operands separated by the given operator.
+func (p *filterParser) parseOperation(op string, parseOperand func() (*filter, error)) (*filter, error) {
+	f, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*filter{f}
+	for p.acceptKeyword(op) {
+		if !p.atTerm() {
+			return nil, p.errorf("missing operand of %s", op)
+		}
+		if f, err = parseOperand(); err != nil {
+			return nil, err
+		}
+		operands = append(operands, f)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &filter{op: op, operands: operands}, nil
+}
+
+// term := [ "NOT" | "-" ] ( "(" expression ")" | comparison )
+func (p *filterParser) parseTerm() (*filter, error) {
+	if !p.atTerm() {
+		return nil, p.errorf("expected a comparison")
+	}
+	if p.acceptKeyword(filterNot) || p.input[p.pos] == '-' {
+		if p.input[p.pos] == '-' {
+			p.pos++
+		}
+		f, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		return &filter{op: filterNot, operands: []*filter{f}}, nil
+	}
+	if p.input[p.pos] != '(' {
+		return p.parseComparison()
+	}
+
+	p.pos++
+	p.skipSpaces()
+	f, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if p.atEnd() || p.input[p.pos] != ')' {
+		return nil, p.errorf("missing closing parenthesis")
+	}
+	p.pos++
+	p.skipSpaces()
+	return f, nil
+}
+
+// Returns the comparator at the current position, or "" if there is none.
+func (p *filterParser) peekComparator() string {
+	for _, op := range []string{"!=", "<=", ">=", "=", "<", ">", ":"} {
+		if strings.HasPrefix(p.input[p.pos:], op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// comparison := name comparator value { comparator value }
+func (p *filterParser) parseComparison() (*filter, error) {
+	pos, name := p.pos, p.peekWord()
+	if name == "" || isFilterDelimiter(name[0]) {
+		return nil, p.errorf("expected an attribute name")
+	}
+	p.pos += len(name)
+	p.skipSpaces()
+	if p.peekComparator() == "" {
+		return nil, p.errorf("expected a comparator after %s", name)
+	}
+
+	// Further comparisons without a name, as in the range "version >= 1.2.0 < 2.0.0", apply to the same attribute
+	var operands []*filter
+	for op := p.peekComparator(); op != "" && (len(operands) == 0 || op != ":"); op = p.peekComparator() {
+		f := &filter{pos: pos, name: name, op: op}
+		p.pos += len(op)
+		p.skipSpaces()
+		if err := p.parseValue(f); err != nil {
+			return nil, err
+		}
+		operands = append(operands, f)
+		pos = p.pos
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &filter{op: filterAnd, operands: operands}, nil
+}
+
+// Parses the value of the given comparison.
+func (p *filterParser) parseValue(f *filter) error {
+	if !p.atEnd() && p.input[p.pos] == '"' {
+		value, err := p.parseQuoted()
+		if err != nil {
+			return err
+		}
+		f.value, f.quoted = value, true
+		return nil
+	}
+
+	words := []string{p.parseValueWord()}
+	if words[0] == "" {
+		return p.errorf("expected a value for %s", f.name)
+	}
+	// Unquoted values extend over the following words that do not start another term
+	for p.atTerm() && !isFilterDelimiter(p.input[p.pos]) && p.input[p.pos] != '-' && p.peekWord() != filterNot {
+		start := p.pos
+		p.pos += len(p.peekWord())
+		p.skipSpaces()
+		nextIsComparison := p.peekComparator() != ""
+		p.pos = start
+		if nextIsComparison {
+			break
+		}
+		words = append(words, p.parseValueWord())
+	}
+	f.value = strings.Join(words, " ")
+	return nil
+}
+
+// Consumes the unquoted value at the current position, which may contain comparator characters such as the colons
+// of a timestamp.
+func (p *filterParser) parseValueWord() string {
+	start := p.pos
+	for !p.atEnd() && !unicode.IsSpace(rune(p.input[p.pos])) && p.input[p.pos] != '(' && p.input[p.pos] != ')' {
+		p.pos++
+	}
+	value := p.input[start:p.pos]
+	p.skipSpaces()
+	return value
+}
+
+// Consumes the quoted string at the current position, in which backslashes escape the following character.
+func (p *filterParser) parseQuoted() (string, error) {
+	start := p.pos
+	p.pos++
+	var value strings.Builder
+	for !p.atEnd() && p.input[p.pos] != '"' {
+		if p.input[p.pos] == '\\' && p.pos+1 < len(p.input) {
+			p.pos++
+		}
+		value.WriteByte(p.input[p.pos])
+		p.pos++
+	}
+	if p.atEnd() {
+		p.pos = start
+		return "", p.errorf("unterminated string")
+	}
+	p.pos++
+	p.skipSpaces()
+	return value.String(), nil
+}
+
+// Returns the predicate selecting the entities matching the given filter expression, or nil if there is none.
+func filterPredicate(f *filter, columns map[string]string, resourceType errors.ResourceType) (func(*entsql.Selector), error) {
+	if f == nil {
+		return nil, nil
+	}
+	build, err := f.predicate(columns, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *entsql.Selector) {
+		s.Where(build(s))
+	}, nil
+}
+
+// Returns the function building the predicate of the filter expression for a selector.
+func (f *filter) predicate(columns map[string]string, resourceType errors.ResourceType) (func(*entsql.Selector) *entsql.Predicate, error) {
+	switch f.op {
+	case filterAnd, filterOr, filterNot:
+		builds := make([]func(*entsql.Selector) *entsql.Predicate, 0, len(f.operands))
+		for _, operand := range f.operands {
+			build, err := operand.predicate(columns, resourceType)
+			if err != nil {
+				return nil, err
+			}
+			builds = append(builds, build)
+		}
+		return func(s *entsql.Selector) *entsql.Predicate {
+			preds := make([]*entsql.Predicate, 0, len(builds))
+			for _, build := range builds {
+				preds = append(preds, build(s))
+			}
+			switch f.op {
+			case filterAnd:
+				return entsql.And(preds...)
+			case filterOr:
+				return entsql.Or(preds...)
+			}
+			return entsql.Not(preds[0])
+		}, nil
+	}
+
+	column, err := findColumnName(f.name, columns, resourceType, "filter")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case isTimeColumn(column):
+		return f.timePredicate(column, resourceType)
+	case isBoolColumn(column):
+		return f.boolPredicate(column, resourceType)
+	case isKindColumn(column):
+		return f.kindPredicate(column, resourceType)
+	case versionKeyColumns[column] != "" && f.op != "=" && f.op != "!=" && f.op != ":":
+		return f.versionPredicate(versionKeyColumns[column], resourceType)
+	}
+	return f.textPredicate(column), nil
+}
+
+// Returns the invalid argument error reporting that the comparison is not valid.
+func (f *filter) errorf(resourceType errors.ResourceType, format string, args ...any) error {
+	return errors.NewInvalidArgument(
+		errors.WithResourceType(resourceType),
+		errors.WithMessage("filter: invalid filter request: %s at position %d", fmt.Sprintf(format, args...), f.pos+1))
+}
+
+// Returns a predicate comparing the given expression with the value, passed as an argument in the placeholder form of
+// the dialect.
+func comparePredicate(expr string, op string, value any) *entsql.Predicate {
+	return entsql.P(func(b *entsql.Builder) {
+		b.WriteString(fmt.Sprintf("%s %s ", expr, op)).Arg(value)
+	})
+}
+
+func (f *filter) textPredicate(column string) func(*entsql.Selector) *entsql.Predicate {
+	return func(s *entsql.Selector) *entsql.Predicate {
+		expr := pageColumnExpr(s, column)
+		if f.op == ":" || (!f.quoted && (f.op == "=" || f.op == "!=")) {
+			likeValue := "%" + strings.ToLower(strings.ReplaceAll(f.value, "*", "%")) + "%"
+			pred := comparePredicate(fmt.Sprintf("LOWER(%s)", expr), "LIKE", likeValue)
+			if f.op == "!=" {
+				return entsql.Not(pred)
+			}
+			return pred
+		}
+		return comparePredicate(expr, f.op, f.value)
+	}
+}
+
+func (f *filter) versionPredicate(keyColumn string, resourceType errors.ResourceType) (func(*entsql.Selector) *entsql.Predicate, error) {
+	if _, err := semver.ParseTolerant(f.value); err != nil {
+		return nil, f.errorf(resourceType, "%s must be compared with a semantic version, not %q", f.name, f.value)
+	}
+	key := VersionKey(f.value)
+	return func(s *entsql.Selector) *entsql.Predicate {
+		return comparePredicate(pageColumnExpr(s, keyColumn), f.op, key)
+	}, nil
+}
+
+func (f *filter) timePredicate(column string, resourceType errors.ResourceType) (func(*entsql.Selector) *entsql.Predicate, error) {
+	if f.op == ":" {
+		return nil, f.errorf(resourceType, "%s cannot be compared with %s", f.name, f.op)
+	}
+	t, err := time.Parse(time.RFC3339Nano, f.value)
+	if err != nil {
+		if t, err = time.Parse(time.DateOnly, f.value); err != nil {
+			return nil, f.errorf(resourceType, "%s must be compared with a timestamp or date, not %q", f.name, f.value)
+		}
+	}
+	// Timestamps are written in local time, which matters to the databases storing them as text
+	value := t.Local()
+	return func(s *entsql.Selector) *entsql.Predicate {
+		return comparePredicate(s.C(column), f.op, value)
+	}, nil
+}
+
+func (f *filter) boolPredicate(column string, resourceType errors.ResourceType) (func(*entsql.Selector) *entsql.Predicate, error) {
+	if f.op != "=" && f.op != "!=" {
+		return nil, f.errorf(resourceType, "%s cannot be compared with %s", f.name, f.op)
+	}
+	value, err := strconv.ParseBool(f.value)
+	if err != nil {
+		return nil, f.errorf(resourceType, "%s must be compared with true or false, not %q", f.name, f.value)
+	}
+	if f.op == "!=" {
+		value = !value
+	}
+	return func(s *entsql.Selector) *entsql.Predicate {
+		if value {
+			return entsql.And(entsql.NotNull(s.C(column)), entsql.EQ(s.C(column), true))
+		}
+		return entsql.Or(entsql.IsNull(s.C(column)), entsql.EQ(s.C(column), false))
+	}, nil
+}
+
+func (f *filter) kindPredicate(column string, resourceType errors.ResourceType) (func(*entsql.Selector) *entsql.Predicate, error) {
+	if f.op != "=" && f.op != "!=" {
+		return nil, f.errorf(resourceType, "%s cannot be compared with %s", f.name, f.op)
+	}
+	name := strings.ToUpper(f.value)
+	kind, ok := catalogv3.Kind_value[name]
+	if !ok {
+		kind, ok = catalogv3.Kind_value["KIND_"+name]
+	}
+	if !ok || kind == int32(catalogv3.Kind_KIND_UNSPECIFIED) {
+		return nil, f.errorf(resourceType, "%s must be compared with a kind, not %q", f.name, f.value)
+	}
+	kindDB := kindToDB(catalogv3.Kind(kind))
+	return func(s *entsql.Selector) *entsql.Predicate {
+		// Entities of no kind are normal
+		return comparePredicate(fmt.Sprintf("COALESCE(%s, '%s')", s.C(column), kindNormal), f.op, kindDB)
+	}, nil
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Registries, deployment packages and applications carry free-form labels, by which they are selected using
+ * selectors in the style of Kubernetes, e.g. "team=vision,env in (prod,stage),!deprecated". The labels are stored as
+ * a JSON object. On Postgres, the selectors match the labels by containment and key existence, which are served by
+ * the GIN indexes of the labels; other databases, such as the one used by the tests, extract the values by key.
+ *
+ * A label that is absent satisfies the negative requirements, as it does in Kubernetes; so do the entities stored
+ * before they had any labels.
+ */
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const labelsColumn = "labels"
+
+// Returns an error if any of the given labels of the named entity does not follow the syntax of Kubernetes labels.
+func validateLabels(l map[string]string, resourceType errors.ResourceType, name string) error {
+	for _, key := range slices.Sorted(maps.Keys(l)) {
+		problems := validation.IsQualifiedName(key)
+		if len(problems) == 0 {
+			problems = validation.IsValidLabelValue(l[key])
+		}
+		if len(problems) > 0 {
+			return errors.NewInvalidArgument(
+				errors.WithResourceType(resourceType),
+				errors.WithResourceName(name),
+				errors.WithMessage("invalid label %s=%s: %s", key, l[key], strings.Join(problems, "; ")))
+		}
+	}
+	return nil
+}
+
+// Returns the given labels as they are to be stored; entities without labels are stored with an empty set.
+func storedLabels(l map[string]string) map[string]string {
+	if l == nil {
+		return map[string]string{}
+	}
+	return l
+}
+
+// Returns the given stored labels as they are to be returned; nil if there are none.
+func extractedLabels(l map[string]string) map[string]string {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// Returns the labels of the given snapshot of an entity, so that the events of its deletion can be selected by them.
+func snapshotLabels(snapshot proto.Message) map[string]string {
+	if labeled, ok := snapshot.(interface{ GetLabels() map[string]string }); ok {
+		return labeled.GetLabels()
+	}
+	return nil
+}
+
+// Returns the parsed label selector, or nil if the selector is empty and therefore selects all entities.
+func parseLabelSelector(selector string, resourceType errors.ResourceType) (labels.Selector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(resourceType),
+			errors.WithMessage("invalid label selector: %s", err.Error()))
+	}
+	requirements, _ := parsed.Requirements()
+	for _, r := range requirements {
+		if r.Operator() == selection.GreaterThan || r.Operator() == selection.LessThan {
+			return nil, errors.NewInvalidArgument(
+				errors.WithResourceType(resourceType),
+				errors.WithMessage("invalid label selector: operator %s of %s is not supported", r.Operator(), r.Key()))
+		}
+	}
+	return parsed, nil
+}
+
+// Returns true if the given labels satisfy the given selector, which has been validated when the watch started.
+func labelsMatch(selector string, l map[string]string) bool {
+	parsed, err := parseLabelSelector(selector, "")
+	if err != nil {
+		return false
+	}
+	return parsed == nil || parsed.Matches(labels.Set(l))
+}
+
+// Returns the predicate matching the entities whose labels satisfy the given selector, or nil if it selects all.
+func labelSelectorPredicate(selector labels.Selector) func(*entsql.Selector) {
+	if selector == nil {
+		return nil
+	}
+	requirements, _ := selector.Requirements()
+	return func(s *entsql.Selector) {
+		column := s.C(labelsColumn)
+		for _, r := range requirements {
+			values := r.Values().List()
+			switch r.Operator() {
+			case selection.Equals, selection.DoubleEquals, selection.In:
+				s.Where(hasLabelValue(s, column, r.Key(), values))
+			case selection.NotEquals, selection.NotIn:
+				s.Where(entsql.Or(entsql.IsNull(column), entsql.Not(hasLabel(s, column, r.Key())),
+					entsql.Not(hasLabelValue(s, column, r.Key(), values))))
+			case selection.Exists:
+				s.Where(hasLabel(s, column, r.Key()))
+			case selection.DoesNotExist:
+				s.Where(entsql.Or(entsql.IsNull(column), entsql.Not(hasLabel(s, column, r.Key()))))
+			}
+		}
+	}
+}
+
+// Returns the predicate matching the labels with the given key.
+func hasLabel(s *entsql.Selector, column string, key string) *entsql.Predicate {
+	if s.Dialect() == dialect.Postgres {
+		return entsql.P(func(b *entsql.Builder) {
+			b.WriteString(column + " ? ").Arg(key)
+		})
+	}
+	return sqljson.HasKey(column, sqljson.Path(key))
+}
+
+// Returns the predicate matching the labels with the given key and any of the given values.
+func hasLabelValue(s *entsql.Selector, column string, key string, values []string) *entsql.Predicate {
+	if s.Dialect() == dialect.Postgres {
+		ors := make([]*entsql.Predicate, 0, len(values))
+		for _, value := range values {
+			contained, _ := json.Marshal(map[string]string{key: value})
+			ors = append(ors, entsql.P(func(b *entsql.Builder) {
+				b.WriteString(column + " @> ").Arg(string(contained)).WriteString("::jsonb")
+			}))
+		}
+		return entsql.Or(ors...)
+	}
+	args := make([]any, 0, len(values))
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return sqljson.ValueIn(column, args, sqljson.Path(key))
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	selector, err := parseLabelSelector("", errors.ApplicationType)
+	assert.NoError(t, err)
+	assert.Nil(t, selector)
+
+	selector, err = parseLabelSelector("team=vision, env in (prod,stage), !deprecated", errors.ApplicationType)
+	assert.NoError(t, err)
+	assert.Equal(t, "!deprecated,env in (prod,stage),team=vision", selector.String())
+
+	for _, bad := range []string{"-team=x", "env in prod", "=vision", "replicas>1", "team=a b"} {
+		_, err = parseLabelSelector(bad, errors.ApplicationType)
+		if assert.Error(t, err, bad) {
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), bad)
+		}
+	}
+}
+
+func TestLabelsMatch(t *testing.T) {
+	assert.True(t, labelsMatch("", nil))
+	assert.True(t, labelsMatch("env!=prod", nil))
+	assert.False(t, labelsMatch("team=vision", nil))
+	assert.True(t, labelsMatch("team=vision,env in (prod,stage)", map[string]string{"team": "vision", "env": "stage"}))
+	assert.False(t, labelsMatch("team=vision,!env", map[string]string{"team": "vision", "env": "stage"}))
+}
+
+func TestLabelSelectorPostgresQuery(t *testing.T) {
+	selector, err := parseLabelSelector("team=vision,env in (prod,stage),!deprecated", errors.ApplicationType)
+	require.NoError(t, err)
+	s := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table("applications"))
+	labelSelectorPredicate(selector)(s)
+	query, args := s.Query()
+	assert.Contains(t, query, `NOT ("applications"."labels" ? $1)`)
+	assert.Contains(t, query, `"applications"."labels" @> $2::jsonb OR "applications"."labels" @> $3::jsonb`)
+	assert.Contains(t, query, `"applications"."labels" @> $4::jsonb`)
+	assert.Equal(t, []any{"deprecated", `{"env":"prod"}`, `{"env":"stage"}`, `{"team":"vision"}`}, args)
+}
+
+func (s *NorthBoundTestSuite) labelApplication(name string, version string, labels map[string]string) {
+	resp, err := s.client.GetApplication(s.ProjectID(footen), &catalogv3.GetApplicationRequest{ApplicationName: name, Version: version})
+	s.validateResponse(err, resp)
+	resp.Application.Labels = labels
+	_, err = s.client.UpdateApplication(s.ProjectID(footen), &catalogv3.UpdateApplicationRequest{
+		ApplicationName: name, Version: version, Application: resp.Application,
+	})
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) listApplicationsBySelector(selector string) []string {
+	resp, err := s.client.ListApplications(s.ProjectID(footen), &catalogv3.ListApplicationsRequest{
+		LabelSelector: selector, OrderBy: "name,version",
+	})
+	s.NoError(err)
+	names := make([]string, 0)
+	if resp != nil {
+		for _, app := range resp.Applications {
+			names = append(names, app.Name+":"+app.Version)
+		}
+	}
+	return names
+}
+
+func (s *NorthBoundTestSuite) TestLabelSelectors() {
+	s.labelApplication("foo", "v0.1.0", map[string]string{"team": "vision", "env": "prod"})
+	s.labelApplication("goo", "v0.1.2", map[string]string{"team": "vision", "env": "stage", "deprecated": "true"})
+	s.labelApplication("bar", "v0.2.0", map[string]string{"team": "infra", "env": "dev"})
+
+	resp, err := s.client.GetApplication(s.ProjectID(footen), &catalogv3.GetApplicationRequest{ApplicationName: "foo", Version: "v0.1.0"})
+	s.validateResponse(err, resp)
+	s.Equal(map[string]string{"team": "vision", "env": "prod"}, resp.Application.Labels)
+
+	s.Equal([]string{"foo:v0.1.0", "goo:v0.1.2"}, s.listApplicationsBySelector("team=vision"))
+	s.Equal([]string{"foo:v0.1.0"}, s.listApplicationsBySelector("env in (prod,stage),!deprecated"))
+	s.Equal([]string{"bar:v0.2.0", "bar:v0.2.1", "goo:v0.1.2"}, s.listApplicationsBySelector("env!=prod"))
+	s.Equal([]string{"bar:v0.2.1"}, s.listApplicationsBySelector("!team"))
+	s.Equal([]string{"bar:v0.2.0", "foo:v0.1.0", "goo:v0.1.2"}, s.listApplicationsBySelector("env"))
+	s.Equal([]string{"bar:v0.2.0", "bar:v0.2.1"}, s.listApplicationsBySelector("team notin (vision)"))
+
+	// Labels of other projects are not selected
+	other, err := s.client.ListApplications(s.ProjectID(barten), &catalogv3.ListApplicationsRequest{LabelSelector: "team"})
+	s.NoError(err)
+	s.Empty(other.Applications)
+
+	// Page tokens are bound to the selector
+	page, err := s.client.ListApplications(s.ProjectID(footen), &catalogv3.ListApplicationsRequest{LabelSelector: "team", PageSize: 1})
+	s.NoError(err)
+	s.Len(page.Applications, 1)
+	s.NotEmpty(page.NextPageToken)
+	_, err = s.client.ListApplications(s.ProjectID(footen), &catalogv3.ListApplicationsRequest{
+		LabelSelector: "team=vision", PageSize: 1, PageToken: page.NextPageToken,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.client.ListApplications(s.ProjectID(footen), &catalogv3.ListApplicationsRequest{LabelSelector: "env in prod"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.client.CreateApplication(s.ProjectID(footen), &catalogv3.CreateApplicationRequest{Application: &catalogv3.Application{
+		Name: "badlabels", Version: "1.0.0", ChartName: "chart", ChartVersion: "1.0.0", HelmRegistryName: fooreg,
+		Labels: map[string]string{"team": "not a value"},
+	}})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	resp.Application.Labels = map[string]string{"-team": "vision"}
+	_, err = s.client.UpdateApplication(s.ProjectID(footen), &catalogv3.UpdateApplicationRequest{
+		ApplicationName: "foo", Version: "v0.1.0", Application: resp.Application,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Registries and deployment packages are selected likewise
+	reg, err := s.client.GetRegistry(s.ProjectID(footen), &catalogv3.GetRegistryRequest{RegistryName: fooregalt, ShowSensitiveInfo: true})
+	s.validateResponse(err, reg)
+	reg.Registry.Labels = map[string]string{"tier": "certified"}
+	_, err = s.client.UpdateRegistry(s.ProjectID(footen), &catalogv3.UpdateRegistryRequest{RegistryName: fooregalt, Registry: reg.Registry})
+	s.NoError(err)
+	regs, err := s.client.ListRegistries(s.ProjectID(footen), &catalogv3.ListRegistriesRequest{LabelSelector: "tier=certified"})
+	s.NoError(err)
+	if s.Len(regs.Registries, 1) {
+		s.Equal(fooregalt, regs.Registries[0].Name)
+		s.Equal(map[string]string{"tier": "certified"}, regs.Registries[0].Labels)
+	}
+
+	// Labels of a deployed package can still be changed
+	pkg, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1",
+	})
+	s.validateResponse(err, pkg)
+	pkg.DeploymentPackage.IsDeployed = true
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1", DeploymentPackage: pkg.DeploymentPackage,
+	})
+	s.NoError(err)
+	pkg.DeploymentPackage.Labels = map[string]string{"team": "vision"}
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.2.1", DeploymentPackage: pkg.DeploymentPackage,
+	})
+	s.NoError(err)
+	pkgs, err := s.client.ListDeploymentPackages(s.ProjectID(footen), &catalogv3.ListDeploymentPackagesRequest{LabelSelector: "team=vision"})
+	s.NoError(err)
+	if s.Len(pkgs.DeploymentPackages, 1) {
+		s.Equal("ca-gigi", pkgs.DeploymentPackages[0].Name)
+		s.Equal("v0.2.1", pkgs.DeploymentPackages[0].Version)
+		s.True(pkgs.DeploymentPackages[0].IsDeployed)
+	}
+	pkgs, err = s.client.ListDeploymentPackages(s.ProjectID(footen), &catalogv3.ListDeploymentPackagesRequest{LabelSelector: "!team"})
+	s.NoError(err)
+	s.Len(pkgs.DeploymentPackages, 2)
+}
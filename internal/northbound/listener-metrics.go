@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	watchDroppedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "catalog",
+		Subsystem: "watch",
+		Name:      "dropped_events_total",
+		Help:      "Number of events dropped from the queues of watchers that fell behind.",
+	}, []string{"kind"})
+	watchDisconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "catalog",
+		Subsystem: "watch",
+		Name:      "overflow_disconnects_total",
+		Help:      "Number of watchers disconnected because their event queue was full.",
+	}, []string{"kind"})
+
+	watchersDesc = prometheus.NewDesc("catalog_watch_watchers",
+		"Number of watchers connected.", []string{"kind"}, nil)
+	watchQueuedEventsDesc = prometheus.NewDesc("catalog_watch_queued_events",
+		"Number of events queued for the watchers, yet to be taken by them.", []string{"kind"}, nil)
+	watchStalledDesc = prometheus.NewDesc("catalog_watch_max_stalled_seconds",
+		"Longest time for which any watcher with queued events has not taken any of them.", []string{"kind"}, nil)
+
+	listenerLabels           = []string{"kind", "listener", "client"}
+	listenerQueuedEventsDesc = prometheus.NewDesc("catalog_watch_listener_queued_events",
+		"Number of events queued for a lagging watcher, yet to be taken by it.", listenerLabels, nil)
+	listenerStalledDesc = prometheus.NewDesc("catalog_watch_listener_stalled_seconds",
+		"Time for which a lagging watcher with queued events has not taken any of them.", listenerLabels, nil)
+	listenerDroppedEventsDesc = prometheus.NewDesc("catalog_watch_listener_dropped_events_total",
+		"Number of events dropped from the queue of a lagging watcher.", listenerLabels, nil)
+)
+
+// Maximum number of lagging listeners exposed individually, which bounds the cardinality of their metrics
+const maxLaggingListenerMetrics = 20
+
+// Kinds of the listeners, as reported in the metrics
+var listenerKinds = []string{"registry", "artifact", "application", "deployment-package"}
+
+// Collects the metrics of the event listeners, including their lag at the time of collection.
+type listenerCollector struct {
+	listeners *EventListeners
+}
+
+func newListenerCollector(listeners *EventListeners) prometheus.Collector {
+	return &listenerCollector{listeners: listeners}
+}
+
+func (c *listenerCollector) Describe(ch chan<- *prometheus.Desc) {
+	watchDroppedEvents.Describe(ch)
+	watchDisconnects.Describe(ch)
+	ch <- watchersDesc
+	ch <- watchQueuedEventsDesc
+	ch <- watchStalledDesc
+	ch <- listenerQueuedEventsDesc
+	ch <- listenerStalledDesc
+	ch <- listenerDroppedEventsDesc
+}
+
+func (c *listenerCollector) Collect(ch chan<- prometheus.Metric) {
+	watchDroppedEvents.Collect(ch)
+	watchDisconnects.Collect(ch)
+
+	watchers := make(map[string]int)
+	queued := make(map[string]int)
+	stalled := make(map[string]float64)
+	var lagging []ListenerLag
+	c.listeners.eachLag(func(lag ListenerLag) {
+		watchers[lag.Kind]++
+		queued[lag.Kind] += lag.Queued
+		stalled[lag.Kind] = max(stalled[lag.Kind], lag.Stalled.Seconds())
+		if lag.Queued > 0 || lag.Dropped > 0 {
+			lagging = append(lagging, lag)
+		}
+	})
+	for _, kind := range listenerKinds {
+		ch <- prometheus.MustNewConstMetric(watchersDesc, prometheus.GaugeValue, float64(watchers[kind]), kind)
+		ch <- prometheus.MustNewConstMetric(watchQueuedEventsDesc, prometheus.GaugeValue, float64(queued[kind]), kind)
+		ch <- prometheus.MustNewConstMetric(watchStalledDesc, prometheus.GaugeValue, stalled[kind], kind)
+	}
+
+	// The listeners stalled the longest come first, and those no longer lagging or connected are left out
+	sort.Slice(lagging, func(i, j int) bool {
+		if lagging[i].Stalled != lagging[j].Stalled {
+			return lagging[i].Stalled > lagging[j].Stalled
+		} else if lagging[i].Queued != lagging[j].Queued {
+			return lagging[i].Queued > lagging[j].Queued
+		}
+		return lagging[i].ID < lagging[j].ID
+	})
+	for _, lag := range lagging[:min(len(lagging), maxLaggingListenerMetrics)] {
+		labels := []string{lag.Kind, strconv.FormatUint(lag.ID, 10), lag.Client}
+		ch <- prometheus.MustNewConstMetric(listenerQueuedEventsDesc, prometheus.GaugeValue, float64(lag.Queued), labels...)
+		ch <- prometheus.MustNewConstMetric(listenerStalledDesc, prometheus.GaugeValue, lag.Stalled.Seconds(), labels...)
+		ch <- prometheus.MustNewConstMetric(listenerDroppedEventsDesc, prometheus.CounterValue, float64(lag.Dropped), labels...)
+	}
+}
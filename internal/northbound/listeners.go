@@ -4,118 +4,346 @@
 
 package northbound
 
+/* Events are delivered to each listener via its own bounded queue, so that a slow watcher does not hold up the
+ * delivery of events to other watchers, or the RPCs making the changes. If the queue of a listener is full, the
+ * overflow policy either drops the oldest queued event to make room for the new one, or disconnects the watcher
+ * with a RESOURCE_EXHAUSTED error, telling it to resync.
+ *
+ * The lag of each listener, i.e. the number of events queued for it and how long it has not taken any of them,
+ * is reported periodically for all listeners that fall behind, and exposed as metrics along with the number of
+ * events dropped and watchers disconnected. The listeners lagging the most are also exposed individually, identified
+ * by their ID and client, so that the slow watchers can be found.
+ */
+
 import (
-	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/orch-library/go/dazl"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// OverflowPolicy determines what happens to a listener whose event queue is full.
+type OverflowPolicy string
+
+const (
+	// DropOldestPolicy drops the oldest queued event to make room for the new one.
+	DropOldestPolicy OverflowPolicy = "drop-oldest"
+	// DisconnectPolicy disconnects the watcher, telling it to resync.
+	DisconnectPolicy OverflowPolicy = "disconnect"
+)
+
+var (
+	// ListenerQueueSize is the maximum number of events queued for each listener
+	ListenerQueueSize = 1024
+	// ListenerOverflowPolicy is the policy applied to listeners whose event queue is full
+	ListenerOverflowPolicy = DisconnectPolicy
+	// ListenerLagReportInterval is the interval at which the lagging listeners are reported
+	ListenerLagReportInterval = time.Minute
 )
 
+// ParseOverflowPolicy returns the overflow policy with the given name.
+func ParseOverflowPolicy(name string) (OverflowPolicy, error) {
+	switch policy := OverflowPolicy(name); policy {
+	case DropOldestPolicy, DisconnectPolicy:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown overflow policy %s; must be %s or %s", name, DropOldestPolicy, DisconnectPolicy)
+}
+
+// ListenerLag describes how far behind a listener is in taking its events.
+type ListenerLag struct {
+	ID      uint64
+	Client  string
+	Kind    string
+	Queued  int
+	Dropped uint64
+	Stalled time.Duration
+}
+
+// Source of the IDs of the listeners
+var listenerIDs atomic.Uint64
+
+// listener holds the queue of events for a single watcher.
+type listener[T any] struct {
+	id     uint64
+	client string
+	kind   string
+	queue  chan T
+
+	overflowed chan struct{}
+	overflow   sync.Once
+	dropped    atomic.Uint64
+
+	// Time at which the watcher last took an event from the queue, or since the queue has been empty
+	lastTaken atomic.Int64
+}
+
+func newListener[T any](ctx context.Context, kind string) *listener[T] {
+	l := &listener[T]{
+		id:         listenerIDs.Add(1),
+		client:     clientDescription(ctx),
+		kind:       kind,
+		queue:      make(chan T, ListenerQueueSize),
+		overflowed: make(chan struct{}),
+	}
+	l.lastTaken.Store(time.Now().UnixNano())
+	return l
+}
+
+// Tells the watcher that its listener is registered by sending the response headers, unless they already went out
+// with the replayed events.
+func signalRegistered(server grpc.ServerStream) {
+	_ = server.SendHeader(nil)
+}
+
+// Returns a description of the client of the given context, suitable for finding the client in the logs.
+func clientDescription(ctx context.Context) string {
+	client := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if name := md.Get("name"); len(name) > 0 {
+			client = fmt.Sprintf("%s (user %s)", client, name[0])
+		}
+		if ua := md.Get("user-agent"); len(ua) > 0 {
+			client = fmt.Sprintf("%s [%s]", client, ua[0])
+		}
+	}
+	return client
+}
+
+// Queues the given event without blocking, applying the overflow policy if the queue is full.
+func (l *listener[T]) enqueue(event T) {
+	if len(l.queue) == 0 {
+		l.lastTaken.Store(time.Now().UnixNano())
+	}
+	for {
+		select {
+		case l.queue <- event:
+			return
+		default:
+		}
+
+		if ListenerOverflowPolicy != DropOldestPolicy {
+			l.overflow.Do(func() {
+				log.Warnw("Disconnecting watcher whose event queue is full", dazl.String("client", l.client), dazl.String("kind", l.kind))
+				watchDisconnects.WithLabelValues(l.kind).Inc()
+				close(l.overflowed)
+			})
+			return
+		}
+
+		select {
+		case <-l.queue:
+			l.dropped.Add(1)
+			watchDroppedEvents.WithLabelValues(l.kind).Inc()
+		default:
+		}
+	}
+}
+
+// Returns the next event from the queue, or an error if the listener has overflowed or the context is done.
+func (l *listener[T]) next(ctx context.Context) (T, error) {
+	var event T
+	select {
+	case event = <-l.queue:
+		l.lastTaken.Store(time.Now().UnixNano())
+		return event, nil
+	case <-l.overflowed:
+		return event, errors.New(errors.WithCode(codes.ResourceExhausted),
+			errors.WithMessage("watcher fell behind by more than %d %s events; resync and watch again", ListenerQueueSize, l.kind))
+	case <-ctx.Done():
+		return event, ctx.Err()
+	}
+}
+
+func (l *listener[T]) lag() ListenerLag {
+	lag := ListenerLag{ID: l.id, Client: l.client, Kind: l.kind, Queued: len(l.queue), Dropped: l.dropped.Load()}
+	if lag.Queued > 0 {
+		lag.Stalled = time.Since(time.Unix(0, l.lastTaken.Load()))
+	}
+	return lag
+}
+
+type registryListener = listener[*catalogv3.WatchRegistriesResponse]
+type artifactListener = listener[*catalogv3.WatchArtifactsResponse]
+type applicationListener = listener[*catalogv3.WatchApplicationsResponse]
+type deploymentPackageListener = listener[*catalogv3.WatchDeploymentPackagesResponse]
+
 // EventListeners tracks current listeners for different type of entity events.
 type EventListeners struct {
 	lock sync.RWMutex
 
-	registryListeners          map[chan *catalogv3.WatchRegistriesResponse]*catalogv3.WatchRegistriesRequest
-	artifactListeners          map[chan *catalogv3.WatchArtifactsResponse]*catalogv3.WatchArtifactsRequest
-	applicationListeners       map[chan *catalogv3.WatchApplicationsResponse]*catalogv3.WatchApplicationsRequest
-	deploymentPackageListeners map[chan *catalogv3.WatchDeploymentPackagesResponse]*catalogv3.WatchDeploymentPackagesRequest
+	registryListeners          map[*registryListener]*catalogv3.WatchRegistriesRequest
+	artifactListeners          map[*artifactListener]*catalogv3.WatchArtifactsRequest
+	applicationListeners       map[*applicationListener]*catalogv3.WatchApplicationsRequest
+	deploymentPackageListeners map[*deploymentPackageListener]*catalogv3.WatchDeploymentPackagesRequest
 }
 
 func NewEventListeners() *EventListeners {
 	return &EventListeners{
-		registryListeners:          make(map[chan *catalogv3.WatchRegistriesResponse]*catalogv3.WatchRegistriesRequest),
-		artifactListeners:          make(map[chan *catalogv3.WatchArtifactsResponse]*catalogv3.WatchArtifactsRequest),
-		applicationListeners:       make(map[chan *catalogv3.WatchApplicationsResponse]*catalogv3.WatchApplicationsRequest),
-		deploymentPackageListeners: make(map[chan *catalogv3.WatchDeploymentPackagesResponse]*catalogv3.WatchDeploymentPackagesRequest),
+		registryListeners:          make(map[*registryListener]*catalogv3.WatchRegistriesRequest),
+		artifactListeners:          make(map[*artifactListener]*catalogv3.WatchArtifactsRequest),
+		applicationListeners:       make(map[*applicationListener]*catalogv3.WatchApplicationsRequest),
+		deploymentPackageListeners: make(map[*deploymentPackageListener]*catalogv3.WatchDeploymentPackagesRequest),
 	}
 }
 
-func (el *EventListeners) addRegistryListener(ch chan *catalogv3.WatchRegistriesResponse, req *catalogv3.WatchRegistriesRequest) {
+func (el *EventListeners) addRegistryListener(ctx context.Context, req *catalogv3.WatchRegistriesRequest) *registryListener {
+	l := newListener[*catalogv3.WatchRegistriesResponse](ctx, "registry")
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	el.registryListeners[ch] = req
+	el.registryListeners[l] = req
+	return l
 }
 
-func (el *EventListeners) deleteRegistryListener(ch chan *catalogv3.WatchRegistriesResponse) {
+func (el *EventListeners) deleteRegistryListener(l *registryListener) {
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	delete(el.registryListeners, ch)
+	delete(el.registryListeners, l)
 }
 
 func (el *EventListeners) sendRegistryEvents(event *catalogv3.WatchRegistriesResponse) {
 	el.lock.RLock()
 	defer el.lock.RUnlock()
-	for ch, req := range el.registryListeners {
+	for l, req := range el.registryListeners {
 		if req.ProjectId == "" || req.ProjectId == event.Event.ProjectId {
-			ch <- event
+			if labelsMatch(req.LabelSelector, event.Registry.Labels) {
+				l.enqueue(event)
+			}
 		}
 	}
 }
 
-func (el *EventListeners) addArtifactListener(ch chan *catalogv3.WatchArtifactsResponse, req *catalogv3.WatchArtifactsRequest) {
+func (el *EventListeners) addArtifactListener(ctx context.Context, req *catalogv3.WatchArtifactsRequest) *artifactListener {
+	l := newListener[*catalogv3.WatchArtifactsResponse](ctx, "artifact")
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	el.artifactListeners[ch] = req
+	el.artifactListeners[l] = req
+	return l
 }
 
-func (el *EventListeners) deleteArtifactListener(ch chan *catalogv3.WatchArtifactsResponse) {
+func (el *EventListeners) deleteArtifactListener(l *artifactListener) {
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	delete(el.artifactListeners, ch)
+	delete(el.artifactListeners, l)
 }
 
 func (el *EventListeners) sendArtifactEvents(event *catalogv3.WatchArtifactsResponse) {
 	el.lock.RLock()
 	defer el.lock.RUnlock()
-	for ch, req := range el.artifactListeners {
+	for l, req := range el.artifactListeners {
 		if req.ProjectId == "" || req.ProjectId == event.Event.ProjectId {
-			ch <- event
+			l.enqueue(event)
 		}
 	}
 }
 
-func (el *EventListeners) addApplicationListener(ch chan *catalogv3.WatchApplicationsResponse, req *catalogv3.WatchApplicationsRequest) {
+func (el *EventListeners) addApplicationListener(ctx context.Context, req *catalogv3.WatchApplicationsRequest) *applicationListener {
+	l := newListener[*catalogv3.WatchApplicationsResponse](ctx, "application")
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	el.applicationListeners[ch] = req
+	el.applicationListeners[l] = req
+	return l
 }
 
-func (el *EventListeners) deleteApplicationListener(ch chan *catalogv3.WatchApplicationsResponse) {
+func (el *EventListeners) deleteApplicationListener(l *applicationListener) {
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	delete(el.applicationListeners, ch)
+	delete(el.applicationListeners, l)
 }
 
 func (el *EventListeners) sendApplicationEvents(event *catalogv3.WatchApplicationsResponse) {
 	el.lock.RLock()
 	defer el.lock.RUnlock()
-	for ch, req := range el.applicationListeners {
+	for l, req := range el.applicationListeners {
 		if req.ProjectId == "" || req.ProjectId == event.Event.ProjectId {
-			if kindMatches(req.Kinds, event.Application.Kind) {
-				ch <- event
+			if kindMatches(req.Kinds, event.Application.Kind) && labelsMatch(req.LabelSelector, event.Application.Labels) {
+				l.enqueue(event)
 			}
 		}
 	}
 }
 
-func (el *EventListeners) addDeploymentPackageListener(ch chan *catalogv3.WatchDeploymentPackagesResponse, req *catalogv3.WatchDeploymentPackagesRequest) {
+func (el *EventListeners) addDeploymentPackageListener(ctx context.Context, req *catalogv3.WatchDeploymentPackagesRequest) *deploymentPackageListener {
+	l := newListener[*catalogv3.WatchDeploymentPackagesResponse](ctx, "deployment-package")
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	el.deploymentPackageListeners[ch] = req
+	el.deploymentPackageListeners[l] = req
+	return l
 }
 
-func (el *EventListeners) deleteDeploymentPackageListener(ch chan *catalogv3.WatchDeploymentPackagesResponse) {
+func (el *EventListeners) deleteDeploymentPackageListener(l *deploymentPackageListener) {
 	el.lock.Lock()
 	defer el.lock.Unlock()
-	delete(el.deploymentPackageListeners, ch)
+	delete(el.deploymentPackageListeners, l)
 }
 
 func (el *EventListeners) sendDeploymentPackageEvents(event *catalogv3.WatchDeploymentPackagesResponse) {
 	el.lock.RLock()
 	defer el.lock.RUnlock()
-	for ch, req := range el.deploymentPackageListeners {
+	for l, req := range el.deploymentPackageListeners {
 		if req.ProjectId == "" || req.ProjectId == event.Event.ProjectId {
-			if kindMatches(req.Kinds, event.DeploymentPackage.Kind) {
-				ch <- event
+			if kindMatches(req.Kinds, event.DeploymentPackage.Kind) && labelsMatch(req.LabelSelector, event.DeploymentPackage.Labels) {
+				l.enqueue(event)
+			}
+		}
+	}
+}
+
+// Lags returns the lag of all the listeners that have any events queued or have had any events dropped.
+func (el *EventListeners) Lags() []ListenerLag {
+	var lags []ListenerLag
+	el.eachLag(func(lag ListenerLag) {
+		if lag.Queued > 0 || lag.Dropped > 0 {
+			lags = append(lags, lag)
+		}
+	})
+	return lags
+}
+
+// Passes the lag of each listener to the given function.
+func (el *EventListeners) eachLag(f func(lag ListenerLag)) {
+	el.lock.RLock()
+	defer el.lock.RUnlock()
+	for l := range el.registryListeners {
+		f(l.lag())
+	}
+	for l := range el.artifactListeners {
+		f(l.lag())
+	}
+	for l := range el.applicationListeners {
+		f(l.lag())
+	}
+	for l := range el.deploymentPackageListeners {
+		f(l.lag())
+	}
+}
+
+// Periodically reports the lag of the listeners that fall behind until the given context is done.
+func (el *EventListeners) reportLags(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, lag := range el.Lags() {
+				log.Warnw("Watcher is lagging behind", dazl.Uint64("listener", lag.ID),
+					dazl.String("client", lag.Client), dazl.String("kind", lag.Kind), dazl.Int("queued", lag.Queued),
+					dazl.Uint64("dropped", lag.Dropped), dazl.Duration("stalled", lag.Stalled))
 			}
+		case <-ctx.Done():
+			return
 		}
 	}
 }
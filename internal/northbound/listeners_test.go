@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Adds a deployment package listener with a queue of the given size, subject to the given overflow policy.
+func (s *NorthBoundTestSuite) boundedListener(listeners *EventListeners, size int, policy OverflowPolicy) *deploymentPackageListener {
+	queueSize, overflowPolicy := ListenerQueueSize, ListenerOverflowPolicy
+	ListenerQueueSize, ListenerOverflowPolicy = size, policy
+	s.T().Cleanup(func() {
+		ListenerQueueSize, ListenerOverflowPolicy = queueSize, overflowPolicy
+	})
+	return listeners.addDeploymentPackageListener(s.ctx, &catalogv3.WatchDeploymentPackagesRequest{ProjectId: footen})
+}
+
+func (s *NorthBoundTestSuite) sendDeploymentPackageEvents(listeners *EventListeners, names ...string) {
+	for _, name := range names {
+		listeners.sendDeploymentPackageEvents(&catalogv3.WatchDeploymentPackagesResponse{
+			Event:             event(CreatedEvent, footen),
+			DeploymentPackage: &catalogv3.DeploymentPackage{Name: name, Version: "0.1.0"},
+		})
+	}
+}
+
+func (s *NorthBoundTestSuite) TestListenerDropOldest() {
+	listeners := NewEventListeners()
+	l := s.boundedListener(listeners, 2, DropOldestPolicy)
+
+	s.sendDeploymentPackageEvents(listeners, "p1", "p2", "p3")
+	for _, name := range []string{"p2", "p3"} {
+		resp, err := l.next(s.ctx)
+		s.NoError(err)
+		s.Equal(name, resp.DeploymentPackage.Name)
+	}
+	s.Equal(uint64(1), l.lag().Dropped)
+}
+
+func (s *NorthBoundTestSuite) TestListenerDisconnect() {
+	listeners := NewEventListeners()
+	l := s.boundedListener(listeners, 2, DisconnectPolicy)
+	other := listeners.addDeploymentPackageListener(s.ctx, &catalogv3.WatchDeploymentPackagesRequest{ProjectId: barten})
+
+	// Sending events to the overflowing listener does not block
+	s.sendDeploymentPackageEvents(listeners, "p1", "p2", "p3", "p4")
+	s.Len(l.queue, 2)
+	s.Len(other.queue, 0)
+
+	var err error
+	for i := 0; i < 3 && err == nil; i++ {
+		_, err = l.next(s.ctx)
+	}
+	s.Error(err)
+	s.Equal(codes.ResourceExhausted, status.Code(err))
+	s.Contains(err.Error(), "resync")
+}
+
+func (s *NorthBoundTestSuite) TestListenerLags() {
+	listeners := NewEventListeners()
+	l := s.boundedListener(listeners, 4, DisconnectPolicy)
+	s.Empty(listeners.Lags())
+
+	s.sendDeploymentPackageEvents(listeners, "p1", "p2")
+	lags := listeners.Lags()
+	if s.Len(lags, 1) {
+		s.Equal("deployment-package", lags[0].Kind)
+		s.Equal(2, lags[0].Queued)
+		s.Positive(lags[0].Stalled)
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+	_, err := l.next(s.ctx)
+	s.NoError(err)
+	_, err = l.next(s.ctx)
+	s.NoError(err)
+	s.Empty(listeners.Lags())
+	_, err = l.next(ctx)
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *NorthBoundTestSuite) TestListenerMetrics() {
+	listeners := NewEventListeners()
+	collector := newListenerCollector(listeners)
+	dropped := testutil.ToFloat64(watchDroppedEvents.WithLabelValues("deployment-package"))
+
+	l := s.boundedListener(listeners, 2, DropOldestPolicy)
+	listeners.addRegistryListener(s.ctx, &catalogv3.WatchRegistriesRequest{})
+	s.sendDeploymentPackageEvents(listeners, "p1", "p2", "p3")
+	s.Equal(dropped+1, testutil.ToFloat64(watchDroppedEvents.WithLabelValues("deployment-package")))
+
+	s.NoError(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP catalog_watch_queued_events Number of events queued for the watchers, yet to be taken by them.
+# TYPE catalog_watch_queued_events gauge
+catalog_watch_queued_events{kind="application"} 0
+catalog_watch_queued_events{kind="artifact"} 0
+catalog_watch_queued_events{kind="deployment-package"} 2
+catalog_watch_queued_events{kind="registry"} 0
+# HELP catalog_watch_watchers Number of watchers connected.
+# TYPE catalog_watch_watchers gauge
+catalog_watch_watchers{kind="application"} 0
+catalog_watch_watchers{kind="artifact"} 0
+catalog_watch_watchers{kind="deployment-package"} 1
+catalog_watch_watchers{kind="registry"} 1
+`), "catalog_watch_queued_events", "catalog_watch_watchers"))
+	s.Equal(4, testutil.CollectAndCount(collector, "catalog_watch_max_stalled_seconds"))
+
+	// The lagging watcher is identified, unlike those keeping up
+	labels := fmt.Sprintf(`{client=%q,kind="deployment-package",listener="%d"}`, l.client, l.id)
+	s.NoError(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP catalog_watch_listener_dropped_events_total Number of events dropped from the queue of a lagging watcher.
+# TYPE catalog_watch_listener_dropped_events_total counter
+catalog_watch_listener_dropped_events_total`+labels+` 1
+# HELP catalog_watch_listener_queued_events Number of events queued for a lagging watcher, yet to be taken by it.
+# TYPE catalog_watch_listener_queued_events gauge
+catalog_watch_listener_queued_events`+labels+` 2
+`), "catalog_watch_listener_queued_events", "catalog_watch_listener_dropped_events_total"))
+	s.Equal(1, testutil.CollectAndCount(collector, "catalog_watch_listener_stalled_seconds"))
+
+	// Disconnected watchers are no longer exposed
+	listeners.deleteDeploymentPackageListener(l)
+	s.Equal(0, testutil.CollectAndCount(collector, "catalog_watch_listener_queued_events"))
+
+	// Only the watchers lagging the most are exposed
+	for i := 0; i < maxLaggingListenerMetrics+5; i++ {
+		listeners.addRegistryListener(s.ctx, &catalogv3.WatchRegistriesRequest{})
+	}
+	listeners.sendRegistryEvents(&catalogv3.WatchRegistriesResponse{Event: event(CreatedEvent, footen), Registry: &catalogv3.Registry{Name: "reg"}})
+	s.Equal(maxLaggingListenerMetrics, testutil.CollectAndCount(collector, "catalog_watch_listener_queued_events"))
+}
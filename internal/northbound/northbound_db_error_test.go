@@ -54,10 +54,10 @@ func (s *NorthBoundDBErrTestSuite) SetupTest() {
 		databaseClient:                    s.entClient,
 		listeners: &EventListeners{
 			lock:                       sync.RWMutex{},
-			registryListeners:          map[chan *catalogv3.WatchRegistriesResponse]*catalogv3.WatchRegistriesRequest{},
-			artifactListeners:          map[chan *catalogv3.WatchArtifactsResponse]*catalogv3.WatchArtifactsRequest{},
-			applicationListeners:       map[chan *catalogv3.WatchApplicationsResponse]*catalogv3.WatchApplicationsRequest{},
-			deploymentPackageListeners: map[chan *catalogv3.WatchDeploymentPackagesResponse]*catalogv3.WatchDeploymentPackagesRequest{},
+			registryListeners:          map[*registryListener]*catalogv3.WatchRegistriesRequest{},
+			artifactListeners:          map[*artifactListener]*catalogv3.WatchArtifactsRequest{},
+			applicationListeners:       map[*applicationListener]*catalogv3.WatchApplicationsRequest{},
+			deploymentPackageListeners: map[*deploymentPackageListener]*catalogv3.WatchDeploymentPackagesRequest{},
 		},
 	}
 
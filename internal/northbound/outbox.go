@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Entity events are recorded in a transactional outbox as part of the same transaction that changes the entities.
+ * The ID of each recorded event serves as its revision, which increases monotonically across all entity types.
+ *
+ * Watch requests may specify the revision of the last event the client has seen, in which case the events recorded
+ * since then are replayed from the outbox instead of replaying the existing entities. The stream is then registered
+ * with the event listeners, after which the outbox is consulted once more to catch up with any events recorded in
+ * the meantime. Events received from the listeners that were already replayed are skipped.
+ *
+ * Revisions are assigned when the events are recorded, whereas the events become visible once their transaction
+ * commits, so an event may become visible after another one with a higher revision. The database records the ID of
+ * the transaction that recorded each event, along with the oldest transaction still in progress at the time. When
+ * resuming, the events with lower revisions recorded by the transactions that were in progress alongside that of
+ * the given revision are replayed as well, so clients may receive some of those events twice.
+ *
+ * Events are kept in the outbox for the retention period only. Watches resuming from a revision that has been
+ * purged already fail with OUT_OF_RANGE, upon which clients must resync by watching with a replay of the entities.
+ */
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// OutboxRetention is the period for which events are kept in the outbox, within which watches may resume.
+var OutboxRetention = 7 * 24 * time.Hour
+
+// Records the event carried by the given watch response in the outbox and sets the revision of the event.
+func persistEvent(ctx context.Context, tx *generated.Tx, resourceType errors.ResourceType, event *catalogv3.Event, resp proto.Message) error {
+	payload, err := proto.Marshal(resp)
+	if err != nil {
+		return errors.NewInternal(errors.WithError(err))
+	}
+	created, err := tx.OutboxEvent.Create().
+		SetProjectUUID(event.ProjectId).
+		SetResourceType(string(resourceType)).
+		SetEventType(event.Type).
+		SetPayload(payload).
+		SetOrigin(replicaID).
+		Save(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	event.Revision = created.ID
+	return nil
+}
+
+// Revisions of the events replayed from the outbox, which are skipped when received from the listeners.
+type replayedRevisions map[uint64]bool
+
+// Replays the events of the given resource type the client may not have seen after the given revision, passing the
+// revision and payload of each event not replayed yet to the supplied function and adding its revision to the
+// replayed ones.
+func (g *Server) replayEvents(ctx context.Context, resourceType errors.ResourceType, projectUUID string, afterRevision uint64,
+	replayed replayedRevisions, replay func(revision uint64, payload []byte) error) error {
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	defer g.rollbackTransaction(tx)
+
+	horizon, err := resumeHorizon(ctx, tx, afterRevision)
+	if err != nil {
+		return err
+	}
+	after := outboxevent.IDGT(afterRevision)
+	if horizon > 0 {
+		after = outboxevent.Or(after, outboxevent.And(outboxevent.IDLT(afterRevision), outboxevent.TxIDGTE(horizon)))
+	}
+	query := tx.OutboxEvent.Query().
+		Where(outboxevent.ResourceType(string(resourceType)), after).
+		Order(generated.Asc(outboxevent.FieldID))
+	if projectUUID != AdminProjectID {
+		query = query.Where(outboxevent.ProjectUUID(projectUUID))
+	}
+	eventsDB, err := query.All(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+
+	for _, e := range eventsDB {
+		if replayed[e.ID] {
+			continue
+		}
+		if err = replay(e.ID, e.Payload); err != nil {
+			return err
+		}
+		replayed[e.ID] = true
+	}
+	return nil
+}
+
+// Returns the oldest transaction that was in progress when the event of the given revision was recorded, whose
+// events the client may have missed despite their lower revisions; zero if there were none. Returns an error if the
+// events following the given revision may have been purged from the outbox.
+func resumeHorizon(ctx context.Context, tx *generated.Tx, revision uint64) (uint64, error) {
+	e, err := tx.OutboxEvent.Get(ctx, revision)
+	if err == nil {
+		return e.TxXmin, nil
+	} else if !generated.IsNotFound(err) {
+		return 0, errors.NewDBError(errors.WithError(err))
+	}
+
+	oldest, err := tx.OutboxEvent.Query().Order(generated.Asc(outboxevent.FieldID)).First(ctx)
+	if err != nil && !generated.IsNotFound(err) {
+		return 0, errors.NewDBError(errors.WithError(err))
+	} else if oldest != nil && oldest.ID > revision {
+		return 0, errors.New(errors.WithCode(codes.OutOfRange),
+			errors.WithMessage("revision %d is too old; resync and watch again", revision))
+	}
+	return 0, nil
+}
+
+// PurgeOutbox deletes the events recorded in the outbox before the given time, except for the most recent of them,
+// which marks the oldest revision from which watches may resume. Returns the number of events purged.
+func PurgeOutbox(ctx context.Context, client *generated.Client, before time.Time) (int, error) {
+	floor, err := client.OutboxEvent.Query().
+		Where(outboxevent.CreateTimeLT(before)).
+		Order(generated.Desc(outboxevent.FieldID)).
+		First(ctx)
+	if generated.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return client.OutboxEvent.Delete().Where(outboxevent.IDLT(floor.ID)).Exec(ctx)
+}
+
+// Returns the watch response of the given type decoded from the payload of an outbox event.
+func decodeEvent[T proto.Message](payload []byte, resp T) (T, error) {
+	if err := proto.Unmarshal(payload, resp); err != nil {
+		return resp, errors.NewInternal(errors.WithError(err))
+	}
+	return resp, nil
+}
+
+// Returns true if the event received from the listeners has already been replayed from the outbox.
+func alreadyReplayed(event *catalogv3.Event, replayed replayedRevisions) bool {
+	return event.Revision != 0 && replayed[event.Revision]
+}
+
+func (g *Server) replayRegistryEvents(server catalogv3.CatalogService_WatchRegistriesServer, projectUUID string, labelSelector string, afterRevision uint64, replayed replayedRevisions) error {
+	return g.replayEvents(server.Context(), errors.RegistryType, projectUUID, afterRevision, replayed, func(revision uint64, payload []byte) error {
+		e, err := decodeEvent(payload, &catalogv3.WatchRegistriesResponse{})
+		if err != nil {
+			return err
+		}
+		e.Event.Revision = revision
+		if !labelsMatch(labelSelector, e.Registry.Labels) {
+			return nil
+		}
+		return server.Send(e)
+	})
+}
+
+func (g *Server) replayArtifactEvents(server catalogv3.CatalogService_WatchArtifactsServer, projectUUID string, afterRevision uint64, replayed replayedRevisions) error {
+	return g.replayEvents(server.Context(), errors.ArtifactType, projectUUID, afterRevision, replayed, func(revision uint64, payload []byte) error {
+		e, err := decodeEvent(payload, &catalogv3.WatchArtifactsResponse{})
+		if err != nil {
+			return err
+		}
+		e.Event.Revision = revision
+		return server.Send(e)
+	})
+}
+
+func (g *Server) replayApplicationEvents(server catalogv3.CatalogService_WatchApplicationsServer, projectUUID string, kinds []catalogv3.Kind, labelSelector string, afterRevision uint64, replayed replayedRevisions) error {
+	return g.replayEvents(server.Context(), errors.ApplicationType, projectUUID, afterRevision, replayed, func(revision uint64, payload []byte) error {
+		e, err := decodeEvent(payload, &catalogv3.WatchApplicationsResponse{})
+		if err != nil {
+			return err
+		}
+		e.Event.Revision = revision
+		if !kindMatches(kinds, e.Application.Kind) || !labelsMatch(labelSelector, e.Application.Labels) {
+			return nil
+		}
+		return server.Send(e)
+	})
+}
+
+func (g *Server) replayDeploymentPackageEvents(server catalogv3.CatalogService_WatchDeploymentPackagesServer, projectUUID string, kinds []catalogv3.Kind, labelSelector string, afterRevision uint64, replayed replayedRevisions) error {
+	return g.replayEvents(server.Context(), errors.DeploymentPackageType, projectUUID, afterRevision, replayed, func(revision uint64, payload []byte) error {
+		e, err := decodeEvent(payload, &catalogv3.WatchDeploymentPackagesResponse{})
+		if err != nil {
+			return err
+		}
+		e.Event.Revision = revision
+		if !kindMatches(kinds, e.DeploymentPackage.Kind) || !labelsMatch(labelSelector, e.DeploymentPackage.Labels) {
+			return nil
+		}
+		return server.Send(e)
+	})
+}
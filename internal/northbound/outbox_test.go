@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/outboxevent"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Returns the revision of the most recent event recorded in the outbox.
+func (s *NorthBoundTestSuite) lastRevision() uint64 {
+	e, err := s.dbClient.OutboxEvent.Query().Order(generated.Desc(outboxevent.FieldID)).First(s.ctx)
+	if generated.IsNotFound(err) {
+		return 0
+	}
+	s.NoError(err)
+	return e.ID
+}
+
+func (s *NorthBoundTestSuite) TestDeploymentPackageEventsResume() {
+	revision := s.lastRevision()
+	pkg := s.createDeploymentPkg(footen, "newpkg", "0.1.1", "foo:v0.1.0")
+	pkg.DisplayName = "New Package"
+	_, err := s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version, DeploymentPackage: pkg,
+	})
+	s.NoError(err)
+	_, err = s.client.DeleteDeploymentPackage(s.ProjectID(footen), &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version,
+	})
+	s.NoError(err)
+
+	ctx, cancel := context.WithCancel(s.ProjectID(footen))
+	defer cancel()
+	stream, err := s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{ResumeFromRevision: revision})
+	s.NoError(err)
+
+	var revisions []uint64
+	for _, eventType := range []EventType{CreatedEvent, UpdatedEvent, DeletedEvent} {
+		resp, err := stream.Recv()
+		s.NoError(err)
+		s.Equal(eventType, EventType(resp.Event.Type))
+		s.Equal(footen, resp.Event.ProjectId)
+		s.Equal(pkg.Name, resp.DeploymentPackage.Name)
+		s.Greater(resp.Event.Revision, revision)
+		revisions = append(revisions, resp.Event.Revision)
+		revision = resp.Event.Revision
+	}
+	s.Len(revisions, 3)
+
+	// Events recorded after resuming arrive through the listeners, or else the replay following their registration
+	s.createDeploymentPkg(footen, "newpkg1", "0.1.1", "foo:v0.1.0")
+	resp, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(CreatedEvent, EventType(resp.Event.Type))
+	s.Equal("newpkg1", resp.DeploymentPackage.Name)
+	s.Greater(resp.Event.Revision, revision)
+
+	// Events of other projects are not replayed
+	ctx, cancel = context.WithCancel(s.ProjectID(barten))
+	defer cancel()
+	stream, err = s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{ResumeFromRevision: revisions[0] - 1})
+	s.NoError(err)
+	_, err = stream.Header() // Wait for the watch to be registered
+	s.NoError(err)
+	s.createDeploymentPkg(barten, "newpkg2", "0.1.1")
+	resp, err = stream.Recv()
+	s.NoError(err)
+	s.Equal("newpkg2", resp.DeploymentPackage.Name)
+}
+
+func (s *NorthBoundTestSuite) TestRegistryEventsResumeRedacted() {
+	revision := s.lastRevision()
+	s.createRegistry(footen, "newreg", "HELM")
+
+	ctx, cancel := context.WithCancel(s.ProjectID(footen))
+	defer cancel()
+	stream, err := s.client.WatchRegistries(ctx, &catalogv3.WatchRegistriesRequest{ResumeFromRevision: revision, ShowSensitiveInfo: true})
+	s.NoError(err)
+
+	resp, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(CreatedEvent, EventType(resp.Event.Type))
+	s.Equal(revision+1, resp.Event.Revision)
+	s.validateRegistry(resp.Registry, "newreg", "Registry newreg", "Registry that holds newreg", "http://footen.com/newreg", "", "", "")
+}
+
+func (s *NorthBoundTestSuite) TestUploadEventsResume() {
+	revision := s.lastRevision()
+	s.uploadThings()
+
+	ctx, cancel := context.WithCancel(s.ProjectID("intel"))
+	defer cancel()
+	stream, err := s.client.WatchApplications(ctx, &catalogv3.WatchApplicationsRequest{ResumeFromRevision: revision})
+	s.NoError(err)
+
+	names := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		resp, err := stream.Recv()
+		s.NoError(err)
+		s.Equal(CreatedEvent, EventType(resp.Event.Type))
+		s.Greater(resp.Event.Revision, revision)
+		names[resp.Application.Name+":"+resp.Application.Version] = true
+	}
+	s.Len(names, 2)
+}
+
+func (s *NorthBoundTestSuite) TestEventsResumeLateCommit() {
+	// The event of the client's revision was recorded while the transaction recording the late event was in progress
+	early, err := s.newDeploymentPackageEvent("other-replica", "early").SetTxID(5).SetTxXmin(5).Save(s.ctx)
+	s.NoError(err)
+	late, err := s.newDeploymentPackageEvent("other-replica", "late").SetTxID(10).SetTxXmin(10).Save(s.ctx)
+	s.NoError(err)
+	seen, err := s.newDeploymentPackageEvent("other-replica", "seen").SetTxID(11).SetTxXmin(10).Save(s.ctx)
+	s.NoError(err)
+	s.Less(early.ID, late.ID)
+
+	ctx, cancel := context.WithCancel(s.ProjectID(footen))
+	defer cancel()
+	stream, err := s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{ResumeFromRevision: seen.ID})
+	s.NoError(err)
+	resp, err := stream.Recv()
+	s.NoError(err)
+	s.Equal("late", resp.DeploymentPackage.Name)
+	s.Equal(late.ID, resp.Event.Revision)
+
+	// The late event is replayed only once, despite the outbox being consulted again after registration
+	s.createDeploymentPkg(footen, "newpkg", "0.1.1")
+	resp, err = stream.Recv()
+	s.NoError(err)
+	s.Equal("newpkg", resp.DeploymentPackage.Name)
+}
+
+func (s *NorthBoundTestSuite) TestEventsResumeTooOld() {
+	first := s.recordDeploymentPackageEvent("other-replica", "first")
+	floor := s.recordDeploymentPackageEvent("other-replica", "floor")
+	purged, err := PurgeOutbox(s.ctx, s.dbClient, time.Now().Add(time.Hour))
+	s.NoError(err)
+	s.Greater(purged, 0)
+	s.Equal(floor, s.lastRevision())
+
+	ctx, cancel := context.WithCancel(s.ProjectID(footen))
+	defer cancel()
+	stream, err := s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{ResumeFromRevision: first})
+	s.NoError(err)
+	_, err = stream.Recv()
+	s.Equal(codes.OutOfRange, status.Code(err))
+	s.ErrorContains(err, "too old")
+
+	// Watches may still resume from the most recent of the purged events
+	stream, err = s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{ResumeFromRevision: floor})
+	s.NoError(err)
+	_, err = stream.Header() // Wait for the watch to be registered
+	s.NoError(err)
+	s.createDeploymentPkg(footen, "newpkg", "0.1.1")
+	resp, err := stream.Recv()
+	s.NoError(err)
+	s.Equal("newpkg", resp.DeploymentPackage.Name)
+}
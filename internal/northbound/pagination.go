@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* The list RPCs page through the entities either by offset or by page token. The page token identifies the last
+ * entity of the previous page by the values of the columns the entities are ordered by and its ID, which breaks the
+ * ties. The next page then starts right after that entity, regardless of any entities added or removed meanwhile,
+ * and neither the entities before it nor the total count need to be loaded.
+ *
+ * The optional text columns may be null, which the databases order differently; such columns are therefore ordered
+ * and compared as empty strings. Versions are ordered by their keys, while the page token holds the versions.
+ */
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pageToken identifies the last entity of a page.
+type pageToken struct {
+	OrderBy       string   `json:"o,omitempty"`
+	Filter        string   `json:"f,omitempty"`
+	LabelSelector string   `json:"l,omitempty"`
+	Keys          []string `json:"k,omitempty"`
+	ID            uint64   `json:"i"`
+}
+
+// listPage describes the page of entities requested from a list RPC.
+type listPage struct {
+	size          int
+	offset        int
+	after         *pageToken
+	orderBy       string
+	filter        string
+	labelSelector string
+	skipTotal     bool
+}
+
+// Returns the page of entities requested, failing if the page size, offset or page token are not valid.
+func newListPage(resourceType errors.ResourceType, pageSize int32, offset int32, token string, orderBy string,
+	filter string, labelSelector string, skipTotal bool) (*listPage, error) {
+	if _, _, _, err := computePageRange(pageSize, offset, 0); err != nil {
+		return nil, err
+	}
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	page := &listPage{size: int(pageSize), offset: int(offset), orderBy: orderBy, filter: filter, labelSelector: labelSelector,
+		skipTotal: skipTotal}
+	if token == "" {
+		return page, nil
+	}
+
+	if offset != 0 {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(resourceType),
+			errors.WithMessage("invalid pagination: offset cannot be combined with a page token"))
+	}
+	page.after = &pageToken{}
+	js, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(js, page.after)
+	}
+	if err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(resourceType),
+			errors.WithMessage("invalid pagination: malformed page token"))
+	}
+	if page.after.OrderBy != orderBy || page.after.Filter != filter || page.after.LabelSelector != labelSelector {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(resourceType),
+			errors.WithMessage("invalid pagination: page token does not match the order by, filter and label selector"))
+	}
+	return page, nil
+}
+
+// Returns true if the given column holds timestamps, rather than text.
+func isTimeColumn(column string) bool {
+	return column == "create_time" || column == "update_time"
+}
+
+// Returns the columns the entities are ordered by, which end with the ID.
+func pageColumns(orderBys []*orderBy, columns map[string]string, resourceType errors.ResourceType) ([]string, []bool, error) {
+	names := make([]string, 0, len(orderBys)+1)
+	descending := make([]bool, 0, len(orderBys)+1)
+	for _, o := range orderBys {
+		column, err := findColumnName(o.name, columns, resourceType, "orderBy")
+		if err != nil {
+			return nil, nil, err
+		} else if isBoolColumn(column) || isKindColumn(column) {
+			return nil, nil, errors.NewInvalidArgument(
+				errors.WithResourceType(resourceType),
+				errors.WithMessage("orderBy: cannot orderBy on attribute: %s", o.name))
+		} else if keyColumn, ok := versionKeyColumns[column]; ok {
+			column = keyColumn
+		}
+		names = append(names, column)
+		descending = append(descending, o.isDesc)
+	}
+	return append(names, "id"), append(descending, false), nil
+}
+
+// Returns the expression of the given column, as it is ordered and compared.
+func pageColumnExpr(s *entsql.Selector, column string) string {
+	if column == "id" || isTimeColumn(column) {
+		return s.C(column)
+	}
+	return fmt.Sprintf("COALESCE(%s, '')", s.C(column))
+}
+
+// Returns the options ordering the entities by the requested columns and then by ID.
+func (p *listPage) orderOptions(orderBys []*orderBy, columns map[string]string, resourceType errors.ResourceType) ([]func(*entsql.Selector), error) {
+	names, descending, err := pageColumns(orderBys, columns, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return []func(*entsql.Selector){func(s *entsql.Selector) {
+		for i, column := range names {
+			direction := " ASC"
+			if descending[i] {
+				direction = " DESC"
+			}
+			s.OrderExpr(entsql.Expr(pageColumnExpr(s, column) + direction))
+		}
+	}}, nil
+}
+
+// Returns the predicate confining the entities to those following the page token, or nil if there is none.
+func (p *listPage) afterPredicate(orderBys []*orderBy, columns map[string]string, resourceType errors.ResourceType) (func(*entsql.Selector), error) {
+	if p.after == nil {
+		return nil, nil
+	}
+	names, descending, err := pageColumns(orderBys, columns, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.after.Keys) != len(names)-1 {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(resourceType),
+			errors.WithMessage("invalid pagination: malformed page token"))
+	}
+
+	keys := make([]any, 0, len(names))
+	for i, key := range p.after.Keys {
+		if isVersionKeyColumn(names[i]) {
+			keys = append(keys, VersionKey(key))
+			continue
+		} else if !isTimeColumn(names[i]) {
+			keys = append(keys, key)
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, key)
+		if err != nil {
+			return nil, errors.NewInvalidArgument(
+				errors.WithResourceType(resourceType),
+				errors.WithMessage("invalid pagination: malformed page token"))
+		}
+		// Timestamps are written in local time, which matters to the databases storing them as text
+		keys = append(keys, t.Local())
+	}
+	keys = append(keys, p.after.ID)
+
+	// (c1 > k1) OR (c1 = k1 AND c2 > k2) OR ... with the comparison reversed for the descending columns
+	return func(s *entsql.Selector) {
+		var ors []*entsql.Predicate
+		for i := range names {
+			var ands []*entsql.Predicate
+			for j := 0; j < i; j++ {
+				ands = append(ands, comparePredicate(pageColumnExpr(s, names[j]), "=", keys[j]))
+			}
+			op := ">"
+			if descending[i] {
+				op = "<"
+			}
+			ands = append(ands, comparePredicate(pageColumnExpr(s, names[i]), op, keys[i]))
+			ors = append(ors, entsql.And(ands...))
+		}
+		s.Where(entsql.Or(ors...))
+	}, nil
+}
+
+// Returns the token of the page following the given last entity of the page.
+func (p *listPage) nextToken(orderBys []*orderBy, last proto.Message, id uint64) string {
+	token := &pageToken{OrderBy: p.orderBy, Filter: p.filter, LabelSelector: p.labelSelector, ID: id}
+	m := last.ProtoReflect()
+	for _, o := range orderBys {
+		var key string
+		if fd := fieldByName(m.Descriptor(), o.name); fd != nil && fd.Kind() == protoreflect.MessageKind {
+			if ts, ok := m.Get(fd).Message().Interface().(*timestamppb.Timestamp); ok {
+				key = ts.AsTime().Format(time.RFC3339Nano)
+			}
+		} else if fd != nil {
+			key = m.Get(fd).String()
+		}
+		token.Keys = append(token.Keys, key)
+	}
+	return token.encode()
+}
+
+func (t *pageToken) encode() string {
+	js, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(js)
+}
+
+// Returns the range of the page of a list loaded in full, such as the charts of a registry, and the token of the
+// next page, if there are more items to return. The items are ordered by their keys, and the page token holds the
+// key of the last item of the previous page; follows tells whether a key comes after that of the last item.
+func (p *listPage) listRange(keys []string, follows func(key string, last string) bool,
+	resourceType errors.ResourceType) (int, int, string, error) {
+	start := min(p.offset, len(keys))
+	if p.after != nil {
+		if len(p.after.Keys) != 1 {
+			return 0, 0, "", errors.NewInvalidArgument(
+				errors.WithResourceType(resourceType),
+				errors.WithMessage("invalid pagination: malformed page token"))
+		}
+		start = sort.Search(len(keys), func(i int) bool { return follows(keys[i], p.after.Keys[0]) })
+	}
+	end := min(start+p.size, len(keys))
+	var next string
+	if end < len(keys) {
+		next = (&pageToken{Keys: []string{keys[end-1]}}).encode()
+	}
+	return start, end, next, nil
+}
+
+// Returns the entities of the page, loaded with one more than the page size, and whether there are more to follow.
+func pageOf[T any](entities []T, page *listPage) ([]T, bool) {
+	if len(entities) > page.size {
+		return entities[:page.size], true
+	}
+	return entities, false
+}
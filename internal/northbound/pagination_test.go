@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *NorthBoundTestSuite) TestListApplicationsPageToken() {
+	ctx := s.ProjectID(footen)
+	for _, orderBy := range []string{"", "name desc, version", "createTime asc", "displayName"} {
+		all, err := s.client.ListApplications(ctx, &catalogv3.ListApplicationsRequest{OrderBy: orderBy, PageSize: 100})
+		s.NoError(err)
+		s.Empty(all.NextPageToken)
+
+		// Paging one by one through the tokens yields the same applications in the same order
+		var paged []*catalogv3.Application
+		token := ""
+		for {
+			resp, err := s.client.ListApplications(ctx, &catalogv3.ListApplicationsRequest{
+				OrderBy: orderBy, PageSize: 1, PageToken: token, SkipTotal: true,
+			})
+			s.NoError(err)
+			s.Zero(resp.TotalElements)
+			paged = append(paged, resp.Applications...)
+			if token = resp.NextPageToken; token == "" {
+				break
+			}
+			s.Len(resp.Applications, 1)
+		}
+		s.Equal(len(all.Applications), len(paged), orderBy)
+		for i, app := range paged {
+			s.Equal(all.Applications[i].Name, app.Name, orderBy)
+			s.Equal(all.Applications[i].Version, app.Version, orderBy)
+		}
+	}
+}
+
+func (s *NorthBoundTestSuite) TestListDeploymentPackagesPageToken() {
+	ctx := s.ProjectID(footen)
+	s.createDeploymentPkg(footen, "paged-a", "0.1.0", "foo:v0.1.0")
+	s.createDeploymentPkg(footen, "paged-b", "0.1.0", "foo:v0.1.0")
+	s.createDeploymentPkg(footen, "paged-c", "0.1.0", "foo:v0.1.0")
+
+	resp, err := s.client.ListDeploymentPackages(ctx, &catalogv3.ListDeploymentPackagesRequest{
+		Filter: "name=paged", OrderBy: "name", PageSize: 2,
+	})
+	s.NoError(err)
+	s.Equal(int32(3), resp.GetTotalElements())
+	s.Len(resp.DeploymentPackages, 2)
+	s.NotEmpty(resp.NextPageToken)
+
+	// Packages added before the page token position do not shift the next page
+	s.createDeploymentPkg(footen, "paged-0", "0.1.0", "foo:v0.1.0")
+	next, err := s.client.ListDeploymentPackages(ctx, &catalogv3.ListDeploymentPackagesRequest{
+		Filter: "name=paged", OrderBy: "name", PageSize: 2, PageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Equal(int32(4), next.GetTotalElements())
+	if s.Len(next.DeploymentPackages, 1) {
+		s.Equal("paged-c", next.DeploymentPackages[0].Name)
+	}
+	s.Empty(next.NextPageToken)
+
+	// The page token is bound to the order and filter it was issued for, and excludes an offset
+	_, err = s.client.ListDeploymentPackages(ctx, &catalogv3.ListDeploymentPackagesRequest{
+		Filter: "name=paged", OrderBy: "name desc", PageSize: 2, PageToken: resp.NextPageToken,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.ListDeploymentPackages(ctx, &catalogv3.ListDeploymentPackagesRequest{
+		OrderBy: "name", PageSize: 2, PageToken: resp.NextPageToken,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.ListDeploymentPackages(ctx, &catalogv3.ListDeploymentPackagesRequest{
+		Filter: "name=paged", OrderBy: "name", PageSize: 2, Offset: 1, PageToken: resp.NextPageToken,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.ListDeploymentPackages(ctx, &catalogv3.ListDeploymentPackagesRequest{
+		Filter: "name=paged", OrderBy: "name", PageSize: 2, PageToken: "not a token",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestListRegistriesAndArtifactsPageToken() {
+	ctx := s.ProjectID(footen)
+	registries, err := s.client.ListRegistries(ctx, &catalogv3.ListRegistriesRequest{PageSize: 1})
+	s.NoError(err)
+	s.Len(registries.Registries, 1)
+	s.NotEmpty(registries.NextPageToken)
+	more, err := s.client.ListRegistries(ctx, &catalogv3.ListRegistriesRequest{PageSize: 1, PageToken: registries.NextPageToken})
+	s.NoError(err)
+	if s.Len(more.Registries, 1) {
+		s.NotEqual(registries.Registries[0].Name, more.Registries[0].Name)
+	}
+
+	artifacts, err := s.client.ListArtifacts(ctx, &catalogv3.ListArtifactsRequest{PageSize: 100, SkipTotal: true})
+	s.NoError(err)
+	s.Zero(artifacts.TotalElements)
+	s.Empty(artifacts.NextPageToken)
+}
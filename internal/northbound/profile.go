@@ -109,21 +109,17 @@ func (g *Server) injectProfile(ctx context.Context, tx *generated.Tx, projectUUI
 
 func (g *Server) injectDeploymentRequirement(ctx context.Context, tx *generated.Tx, projectUUID string, profileDB *generated.Profile, requirement *catalogv3.DeploymentRequirement) error {
 	log.Infof("Injecting DR: %+v", requirement)
-	pkgID, err := tx.DeploymentPackage.Query().Where(
-		deploymentpackage.ProjectUUID(projectUUID),
-		deploymentpackage.Name(requirement.Name),
-		deploymentpackage.Version(requirement.Version)).FirstID(ctx)
+	pkgDB, err := g.resolveRequiredDeploymentPackage(ctx, tx, projectUUID, requirement.Name, requirement.Version)
 	if err != nil {
-		if generated.IsNotFound(err) {
-			return errors.NewNotFound(
-				errors.WithResourceType(errors.DeploymentPackageType),
-				errors.WithMessage("deployment package %s not found", requirement.Name))
-		}
-		return errors.NewDBError(errors.WithError(err))
+		return err
 	}
+	pkgID := pkgDB.ID
 	drCreateStmt := tx.DeploymentRequirement.Create().
 		SetProfileFk(profileDB).
 		SetDeploymentPackageFkID(pkgID)
+	if isVersionConstraint(requirement.Version) {
+		drCreateStmt.SetVersionConstraint(requirement.Version)
+	}
 	if requirement.DeploymentProfileName != "" {
 		dpID, err := tx.DeploymentProfile.Query().Where(
 			deploymentprofile.HasDeploymentPackageFkWith(deploymentpackage.ID(pkgID)),
@@ -192,6 +188,9 @@ func (g *Server) extractDeploymentRequirements(ctx context.Context, profileDB *g
 			Version:               dpkgDB.Version,
 			DeploymentProfileName: dprofName,
 		}
+		if drDB.VersionConstraint != "" {
+			requirement.Version = drDB.VersionConstraint
+		}
 
 		dpProfileDB, err := drDB.QueryDeploymentProfileFk().First(ctx)
 		if err == nil {
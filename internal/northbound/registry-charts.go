@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/helm"
+	nberrors "github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// RegistryInventoryTimeout bounds the time spent listing the charts or chart versions of a registry.
+var RegistryInventoryTimeout = 30 * time.Second
+
+// ListRegistryCharts lists the charts hosted by a registry through gRPC
+func (g *Server) ListRegistryCharts(ctx context.Context, req *catalogv3.ListRegistryChartsRequest) (*catalogv3.ListRegistryChartsResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.RegistryName == "" {
+		return nil, nberrors.NewInvalidArgument(
+			nberrors.WithResourceType(nberrors.RegistryType),
+			nberrors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+	page, err := newListPage(nberrors.RegistryType, req.PageSize, req.Offset, req.PageToken, "", "", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := g.getRegistryInventory(ctx, projectUUID, req.RegistryName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RegistryInventoryTimeout)
+	defer cancel()
+	charts, err := inventory.ListCharts(ctx)
+	if err != nil {
+		return nil, inventoryError(err, nberrors.RegistryType, req.RegistryName)
+	}
+
+	sort.Strings(charts)
+	startIndex, endIndex, nextPageToken, err := page.listRange(charts, func(name string, last string) bool {
+		return name > last
+	}, nberrors.RegistryType)
+	if err != nil {
+		return nil, err
+	}
+	chartNames := append(make([]string, 0, endIndex-startIndex), charts[startIndex:endIndex]...)
+
+	logActivity(ctx, "listed", "registry charts", projectUUID, req.RegistryName, "total", fmt.Sprintf("%d", len(charts)))
+	return &catalogv3.ListRegistryChartsResponse{ChartNames: chartNames, TotalElements: int32(len(charts)), NextPageToken: nextPageToken}, nil
+}
+
+// ListChartVersions lists the versions of a chart hosted by a registry through gRPC
+func (g *Server) ListChartVersions(ctx context.Context, req *catalogv3.ListChartVersionsRequest) (*catalogv3.ListChartVersionsResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.RegistryName == "" || req.ChartName == "" {
+		return nil, nberrors.NewInvalidArgument(
+			nberrors.WithResourceType(nberrors.ChartType),
+			nberrors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+	page, err := newListPage(nberrors.ChartType, req.PageSize, req.Offset, req.PageToken, "", "", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := g.getRegistryInventory(ctx, projectUUID, req.RegistryName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RegistryInventoryTimeout)
+	defer cancel()
+	versions, err := inventory.ListChartVersions(ctx, req.ChartName)
+	if err != nil {
+		return nil, inventoryError(err, nberrors.ChartType, req.ChartName)
+	}
+
+	// The latest versions come first; versions with the same key, which differ only by their build metadata,
+	// are ordered by the version itself, so that the pages are stable
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versionFollows(versions[j].Version, versions[i].Version)
+	})
+	keys := make([]string, 0, len(versions))
+	for _, cv := range versions {
+		keys = append(keys, cv.Version)
+	}
+	startIndex, endIndex, nextPageToken, err := page.listRange(keys, versionFollows, nberrors.ChartType)
+	if err != nil {
+		return nil, err
+	}
+	// Only the versions of the page are described, as it may take a request per version
+	pageVersions := versions[startIndex:endIndex]
+	if err = inventory.DescribeChartVersions(ctx, req.ChartName, pageVersions); err != nil {
+		return nil, inventoryError(err, nberrors.ChartType, req.ChartName)
+	}
+
+	chartVersions := make([]*catalogv3.ChartVersion, 0, len(pageVersions))
+	for _, cv := range pageVersions {
+		chartVersion := &catalogv3.ChartVersion{
+			Version:     cv.Version,
+			AppVersion:  cv.AppVersion,
+			Description: cv.Description,
+			Digest:      cv.Digest,
+		}
+		if !cv.Created.IsZero() {
+			chartVersion.CreateTime = timestamppb.New(cv.Created)
+		}
+		chartVersions = append(chartVersions, chartVersion)
+	}
+
+	logActivity(ctx, "listed", "chart versions", projectUUID, req.RegistryName, req.ChartName, "total", fmt.Sprintf("%d", len(versions)))
+	return &catalogv3.ListChartVersionsResponse{ChartVersions: chartVersions, TotalElements: int32(len(versions)),
+		NextPageToken: nextPageToken}, nil
+}
+
+// Returns true if the given version comes after the last one in the order of the chart versions, latest first.
+func versionFollows(version string, last string) bool {
+	if key, lastKey := VersionKey(version), VersionKey(last); key != lastKey {
+		return key < lastKey
+	}
+	return version < last
+}
+
+// Returns the inventory of the stored registry, which is accessed with its credentials on behalf of the caller.
+func (g *Server) getRegistryInventory(ctx context.Context, projectUUID string, name string) (helm.Inventory, error) {
+	reg, err := g.getRegistryWithSecrets(ctx, projectUUID, name)
+	if err != nil {
+		return nil, err
+	}
+	if reg.InventoryUrl == "" {
+		return nil, nberrors.NewFailedPrecondition(
+			nberrors.WithResourceType(nberrors.RegistryType),
+			nberrors.WithResourceName(name),
+			nberrors.WithMessage("has no inventory URL"))
+	}
+
+	cacerts := reg.Cacerts
+	if cacerts == dynamicCACertsName {
+		if cacerts, err = readTLSCert(); err != nil {
+			return nil, nberrors.NewVaultError(nberrors.WithError(err))
+		}
+	}
+	access := helm.RegistryAccess{RootURL: reg.InventoryUrl, Username: reg.Username, Password: reg.AuthToken, CACerts: cacerts,
+		DialContext: dialDestination}
+	inventory, err := helm.NewInventory(reg.ApiType, access)
+	if err != nil {
+		return nil, inventoryError(err, nberrors.RegistryType, name)
+	}
+
+	// The inventory URL is given by the users of the project, as is the root URL of the registries they test
+	if err = checkRegistryDestination(ctx, reg.InventoryUrl); err != nil {
+		return nil, nberrors.NewFailedPrecondition(
+			nberrors.WithResourceType(nberrors.RegistryType),
+			nberrors.WithResourceName(name),
+			nberrors.WithMessage("inventory url is not allowed: %v", err))
+	}
+	return inventory, nil
+}
+
+// Translates the failure to obtain the inventory of a registry into a gRPC error. Only the outcome is reported, as
+// the details of the failure would tell more about the destination than whether it is a working registry.
+func inventoryError(err error, resourceType nberrors.ResourceType, name string) error {
+	var unsupportedErr *helm.UnsupportedInventoryError
+	var errResp *errcode.ErrorResponse
+	switch {
+	case errors.As(err, &unsupportedErr):
+		return nberrors.New(nberrors.WithCode(codes.Unimplemented), nberrors.WithMessage("%s", unsupportedErr.Msg))
+	case resourceType == nberrors.ChartType &&
+		(errors.Is(err, errdef.ErrNotFound) || errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotFound):
+		return nberrors.NewNotFound(
+			nberrors.WithResourceType(resourceType),
+			nberrors.WithResourceName(name))
+	default:
+		log.Warnf("Unable to obtain the inventory of %s %s: %v", resourceType, name, err)
+		return nberrors.NewUnavailable(
+			nberrors.WithResourceType(resourceType),
+			nberrors.WithResourceName(name),
+			nberrors.WithMessage("%s", connectionClassification(helm.FailureStatus(err))))
+	}
+}
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/helm"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testChartIndex = `apiVersion: v1
+entries:
+  nginx:
+  - name: nginx
+    version: 1.1.0
+    appVersion: 1.27.0
+    description: Web server
+    digest: 0123abcd
+    created: "2025-03-01T10:00:00Z"
+  - name: nginx
+    version: 1.0.0
+  redis:
+  - name: redis
+    version: 0.1.0
+  postgres:
+  - name: postgres
+    version: 2.0.0
+`
+
+func (s *NorthBoundTestSuite) TestListRegistryCharts() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else if r.URL.Path == "/stable/index.yaml" {
+			_, _ = w.Write([]byte(testChartIndex))
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	reg := &catalogv3.Registry{
+		Name: "stable", RootUrl: srv.URL + "/stable", InventoryUrl: srv.URL + "/stable", Type: helmType,
+		ApiType: helm.APITypeHelm, Username: "user", AuthToken: "secret",
+	}
+	created, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: reg})
+	s.validateResponse(err, created)
+
+	// Inventories internal to the catalog are not listed, unless allowed
+	_, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+	s.ErrorContains(err, "inventory url is not allowed")
+	s.allowLoopbackDestinations()
+
+	charts, err := s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable"})
+	s.validateResponse(err, charts)
+	s.Equal([]string{"nginx", "postgres", "redis"}, charts.ChartNames)
+	s.Equal(int32(3), charts.TotalElements)
+
+	charts, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable", PageSize: 2, Offset: 1})
+	s.validateResponse(err, charts)
+	s.Equal([]string{"postgres", "redis"}, charts.ChartNames)
+	s.Equal(int32(3), charts.TotalElements)
+
+	charts, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable", PageSize: 2})
+	s.validateResponse(err, charts)
+	s.Equal([]string{"nginx", "postgres"}, charts.ChartNames)
+	s.NotEmpty(charts.NextPageToken)
+	charts, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable", PageSize: 2,
+		PageToken: charts.NextPageToken})
+	s.validateResponse(err, charts)
+	s.Equal([]string{"redis"}, charts.ChartNames)
+	s.Empty(charts.NextPageToken)
+	_, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable", PageToken: "bad"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	charts, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable", Offset: 5})
+	s.validateResponse(err, charts)
+	s.Empty(charts.ChartNames)
+
+	_, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.ListRegistryCharts(s.ProjectID(barten), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable"})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	// Registries rejecting the stored credentials are unavailable, which is all that is reported
+	reg.AuthToken = "wrong"
+	_, err = s.client.UpdateRegistry(s.ProjectID(footen), &catalogv3.UpdateRegistryRequest{RegistryName: "stable", Registry: reg})
+	s.NoError(err)
+	_, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable"})
+	s.Equal(codes.Unavailable, status.Code(err))
+	s.ErrorContains(err, "registry rejected the credentials")
+	s.NotContains(err.Error(), srv.URL)
+
+	reg.ApiType = "native"
+	_, err = s.client.UpdateRegistry(s.ProjectID(footen), &catalogv3.UpdateRegistryRequest{RegistryName: "stable", Registry: reg})
+	s.NoError(err)
+	_, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable"})
+	s.Equal(codes.Unimplemented, status.Code(err))
+
+	reg.InventoryUrl = ""
+	_, err = s.client.UpdateRegistry(s.ProjectID(footen), &catalogv3.UpdateRegistryRequest{RegistryName: "stable", Registry: reg})
+	s.NoError(err)
+	_, err = s.client.ListRegistryCharts(s.ProjectID(footen), &catalogv3.ListRegistryChartsRequest{RegistryName: "stable"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestListChartVersions() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/index.yaml" {
+			_, _ = w.Write([]byte(testChartIndex))
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	reg := &catalogv3.Registry{Name: "stable", RootUrl: srv.URL, InventoryUrl: srv.URL, Type: helmType, ApiType: helm.APITypeHelm}
+	created, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: reg})
+	s.validateResponse(err, created)
+	s.allowLoopbackDestinations()
+
+	versions, err := s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{RegistryName: "stable", ChartName: "nginx"})
+	s.validateResponse(err, versions)
+	s.Equal(int32(2), versions.TotalElements)
+	if s.Len(versions.ChartVersions, 2) {
+		latest := versions.ChartVersions[0]
+		s.Equal("1.1.0", latest.Version)
+		s.Equal("1.27.0", latest.AppVersion)
+		s.Equal("Web server", latest.Description)
+		s.Equal("0123abcd", latest.Digest)
+		s.Equal(int32(2025), int32(latest.CreateTime.AsTime().Year()))
+		s.Equal("1.0.0", versions.ChartVersions[1].Version)
+		s.Nil(versions.ChartVersions[1].CreateTime)
+	}
+
+	versions, err = s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{RegistryName: "stable", ChartName: "nginx", PageSize: 1, Offset: 1})
+	s.validateResponse(err, versions)
+	s.Equal(int32(2), versions.TotalElements)
+	if s.Len(versions.ChartVersions, 1) {
+		s.Equal("1.0.0", versions.ChartVersions[0].Version)
+	}
+
+	_, err = s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{RegistryName: "stable", ChartName: "missing"})
+	s.Equal(codes.NotFound, status.Code(err))
+	_, err = s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{RegistryName: "stable"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{RegistryName: "stable", ChartName: "nginx", PageSize: MaxPageSize + 1})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestListChartVersionsOrder() {
+	index := `apiVersion: v1
+entries:
+  nginx:
+  - name: nginx
+    version: 1.0.0
+  - name: nginx
+    version: 1.10.0
+  - name: nginx
+    version: 1.2.0-rc.1
+  - name: nginx
+    version: 1.2.0
+  - name: nginx
+    version: 1.9.0
+`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(index))
+	}))
+	defer srv.Close()
+
+	reg := &catalogv3.Registry{Name: "stable", RootUrl: srv.URL, InventoryUrl: srv.URL, Type: helmType, ApiType: helm.APITypeHelm}
+	created, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: reg})
+	s.validateResponse(err, created)
+	s.allowLoopbackDestinations()
+
+	// The versions are paged through latest first, regardless of the order listed by the registry
+	var versions []string
+	pageToken := ""
+	for i := 0; i < 5; i++ {
+		resp, err := s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{
+			RegistryName: "stable", ChartName: "nginx", PageSize: 2, PageToken: pageToken})
+		s.validateResponse(err, resp)
+		s.Equal(int32(5), resp.TotalElements)
+		for _, cv := range resp.ChartVersions {
+			versions = append(versions, cv.Version)
+		}
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+	s.Equal([]string{"1.10.0", "1.9.0", "1.2.0", "1.2.0-rc.1", "1.0.0"}, versions)
+
+	resp, err := s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{
+		RegistryName: "stable", ChartName: "nginx", PageSize: 2, Offset: 2})
+	s.validateResponse(err, resp)
+	if s.Len(resp.ChartVersions, 2) {
+		s.Equal("1.2.0", resp.ChartVersions[0].Version)
+		s.Equal("1.2.0-rc.1", resp.ChartVersions[1].Version)
+	}
+
+	_, err = s.client.ListChartVersions(s.ProjectID(footen), &catalogv3.ListChartVersionsRequest{
+		RegistryName: "stable", ChartName: "nginx", Offset: 1, PageToken: resp.NextPageToken})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/helm"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+)
+
+// RegistryConnectionTimeout bounds the time spent testing the connection to a registry.
+var RegistryConnectionTimeout = 10 * time.Second
+
+// TestRegistryConnection tests the connection to a stored or an unsaved registry through gRPC
+func (g *Server) TestRegistryConnection(ctx context.Context, req *catalogv3.TestRegistryConnectionRequest) (*catalogv3.TestRegistryConnectionResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.RegistryName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithMessage("incomplete request"))
+	} else if req.Registry != nil {
+		if err := req.Registry.Validate(); err != nil {
+			return nil, errors.NewInvalidArgument(
+				errors.WithResourceType(errors.RegistryType),
+				errors.WithMessage(err.Error()))
+		} else if req.Registry.Name != req.RegistryName {
+			return nil, errors.NewInvalidArgument(
+				errors.WithResourceType(errors.RegistryType),
+				errors.WithMessage("name %s does not match the registry name %s", req.Registry.Name, req.RegistryName))
+		}
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	reg := req.Registry
+	if reg == nil {
+		if reg, err = g.getRegistryWithSecrets(ctx, projectUUID, req.RegistryName); err != nil {
+			return nil, err
+		}
+	}
+
+	cacerts := reg.Cacerts
+	if cacerts == dynamicCACertsName {
+		if cacerts, err = readTLSCert(); err != nil {
+			return nil, errors.NewVaultError(errors.WithError(err))
+		}
+	}
+	// Registries are given by the users of the project, whether they are stored or not, as are webhook endpoints,
+	// so they must not be internal
+	if err := checkRegistryDestination(ctx, reg.RootUrl); err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithResourceName(reg.Name),
+			errors.WithMessage("root url is not allowed: %v", err))
+	}
+	access := helm.RegistryAccess{RootURL: reg.RootUrl, Username: reg.Username, Password: reg.AuthToken, CACerts: cacerts,
+		DialContext: dialDestination}
+
+	ctx, cancel := context.WithTimeout(ctx, RegistryConnectionTimeout)
+	defer cancel()
+	var conn helm.Connection
+	if reg.Type == imageType {
+		conn = helm.CheckImageRegistry(ctx, access)
+	} else if reg.ApiType == helm.APITypeHelm {
+		conn = helm.CheckIndexRepository(ctx, access)
+	} else {
+		conn = helm.CheckChartRegistry(ctx, access)
+	}
+
+	logActivity(ctx, "tested", "registry", projectUUID, req.RegistryName, conn.Status)
+	// The details of the failure would tell more about the destination than whether it is a working registry
+	conn.Message = connectionClassification(conn.Status)
+	return &catalogv3.TestRegistryConnectionResponse{Status: conn.Status, Message: conn.Message, ApiType: conn.APIType}, nil
+}
+
+// Returns an error if the given URL of a registry is an internal destination.
+func checkRegistryDestination(ctx context.Context, registryURL string) error {
+	if !strings.Contains(registryURL, "://") {
+		registryURL = "https://" + registryURL
+	} else if strings.HasPrefix(registryURL, "oci://") {
+		registryURL = "https://" + strings.TrimPrefix(registryURL, "oci://")
+	}
+	return checkDestination(ctx, registryURL)
+}
+
+// Returns the message describing the given outcome of a connection check, without any details.
+func connectionClassification(status string) string {
+	switch status {
+	case helm.ConnectionOK:
+		return ""
+	case helm.ConnectionUnreachable:
+		return "registry is unreachable"
+	case helm.ConnectionTLSError:
+		return "TLS connection to the registry failed"
+	case helm.ConnectionAuthFailed:
+		return "registry rejected the credentials"
+	default:
+		return "registry is not a working registry of the given type"
+	}
+}
+
+// Returns the stored registry including its credentials and CA certificates.
+func (g *Server) getRegistryWithSecrets(ctx context.Context, projectUUID string, name string) (*catalogv3.Registry, error) {
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	registryDB, err := tx.Registry.Query().
+		Where(registry.ProjectUUID(projectUUID), registry.Name(name)).First(ctx)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		if generated.IsNotFound(err) {
+			return nil, errors.NewNotFound(
+				errors.WithResourceType(errors.RegistryType),
+				errors.WithResourceName(name))
+		}
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	var secretService SecretService
+	if UseSecretService {
+		secretService, err = SecretServiceFactory(ctx)
+		if err != nil {
+			g.rollbackTransaction(tx)
+			return nil, errors.NewVaultError(errors.WithError(err))
+		}
+		defer secretService.Logout(ctx)
+	}
+
+	reg, err := g.extractRegistry(ctx, registryDB, secretService, true)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = g.commitTransaction(tx); err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return reg, nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/helm"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *NorthBoundTestSuite) TestTestRegistryConnection() {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); r.URL.Path != "/v2/" || !ok || user != "user" || password != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer srv.Close()
+	cacerts := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	reg := &catalogv3.Registry{
+		Name: "edge", RootUrl: srv.URL + "/images", Type: imageType, Username: "user", AuthToken: "secret", Cacerts: cacerts,
+	}
+	created, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: reg})
+	s.validateResponse(err, created)
+
+	// Registries are tested provided that they are not internal, whether they are stored or not
+	_, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	s.ErrorContains(err, "root url is not allowed")
+	_, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge", Registry: reg})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	s.ErrorContains(err, "root url is not allowed")
+	for _, rootURL := range []string{"oci://10.0.0.1:5000", "localhost:8080", "http://metadata.google.internal"} {
+		internal := &catalogv3.Registry{Name: "metadata", RootUrl: rootURL, Type: helmType}
+		_, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "metadata", Registry: internal})
+		s.Equal(codes.InvalidArgument, status.Code(err), rootURL)
+	}
+	metadata := &catalogv3.Registry{Name: "metadata", RootUrl: "http://169.254.169.254/latest", Type: helmType}
+	created, err = s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: metadata})
+	s.validateResponse(err, created)
+	_, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "metadata"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Stored registries are tested using their stored credentials
+	s.allowLoopbackDestinations()
+	resp, err := s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge"})
+	s.validateResponse(err, resp)
+	s.Equal(helm.ConnectionOK, resp.Status, resp.Message)
+	s.Empty(resp.Message)
+	s.Equal(helm.APITypeOCI, resp.ApiType)
+
+	// Unsaved registries are tested as given
+	reg.AuthToken = "wrong"
+	resp, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge", Registry: reg})
+	s.validateResponse(err, resp)
+	s.Equal(helm.ConnectionAuthFailed, resp.Status)
+	s.Equal("registry rejected the credentials", resp.Message)
+	s.Empty(resp.ApiType)
+
+	reg.AuthToken = "secret"
+	reg.Cacerts = ""
+	resp, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge", Registry: reg})
+	s.validateResponse(err, resp)
+	s.Equal(helm.ConnectionTLSError, resp.Status)
+	s.Equal("TLS connection to the registry failed", resp.Message)
+
+	reg.Cacerts = cacerts
+	reg.Type = helmType
+	reg.Name = "edge-charts"
+	resp, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge-charts", Registry: reg})
+	s.validateResponse(err, resp)
+	s.Equal(helm.ConnectionOK, resp.Status, resp.Message)
+
+	_, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge", Registry: reg})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.client.TestRegistryConnection(s.ProjectID(barten), &catalogv3.TestRegistryConnectionRequest{RegistryName: "edge"})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	// Only the outcome is reported, whether the registry is stored or not
+	unreachable := &catalogv3.Registry{Name: "closed", RootUrl: "https://127.0.0.1:1", Type: helmType}
+	created, err = s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: unreachable})
+	s.validateResponse(err, created)
+	resp, err = s.client.TestRegistryConnection(s.ProjectID(footen), &catalogv3.TestRegistryConnectionRequest{RegistryName: "closed"})
+	s.validateResponse(err, resp)
+	s.Equal(helm.ConnectionUnreachable, resp.Status)
+	s.Equal("registry is unreachable", resp.Message)
+}
@@ -13,13 +13,13 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
 	ent "github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
-	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/predicate"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
 	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"os"
@@ -50,19 +50,24 @@ type registrySecretData struct {
 	Cacerts      string
 }
 
+// Base64Strings encodes the secret data of the registry with the given secret path, to which encrypted secret data
+// is bound.
 type Base64Strings interface {
-	EncodeBase64(r registrySecretData) string
-	DecodeBase64(r *registrySecretData, encodedData string) error
+	EncodeBase64(r registrySecretData, secretPath string) string
+	DecodeBase64(r *registrySecretData, encodedData string, secretPath string) error
 }
 
 type base64Strings struct{}
 
-func (b *base64Strings) EncodeBase64(r registrySecretData) string {
+func (b *base64Strings) EncodeBase64(r registrySecretData, _ string) string {
 	dataBlob, _ := json.Marshal(r)
 	return base64.URLEncoding.EncodeToString(dataBlob)
 }
 
-func (b *base64Strings) DecodeBase64(r *registrySecretData, encodedData string) error {
+func (b *base64Strings) DecodeBase64(r *registrySecretData, encodedData string, _ string) error {
+	if strings.HasPrefix(encodedData, envelopePrefix) {
+		return fmt.Errorf("secret data is encrypted but no secrets key file is configured")
+	}
 	decodedBytes, err := base64.URLEncoding.DecodeString(encodedData)
 	if err != nil {
 		return err
@@ -107,6 +112,12 @@ func MakeSecretPath(projectUUID string, registryName string) string {
 	return "cat-" + projectUUID + "_" + registryName
 }
 
+// Returns true if the registry secret data should be stored in the database rather than in the secret
+// service. Dry-runs must not write to the secret service, so they keep the secrets in the transaction.
+func storeSecretInDB(ctx context.Context) bool {
+	return !UseSecretService || isDryRun(ctx)
+}
+
 // CreateRegistry creates a Registry from gRPC request
 func (g *Server) CreateRegistry(ctx context.Context, req *catalogv3.CreateRegistryRequest) (*catalogv3.CreateRegistryResponse, error) {
 	projectUUID, err := GetActiveProjectID(ctx)
@@ -139,6 +150,11 @@ func (g *Server) CreateRegistry(ctx context.Context, req *catalogv3.CreateRegist
 		return nil, err
 	}
 
+	if err = events.persist(ctx, tx); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	err = g.commitTransaction(tx)
 	if err != nil {
 		return nil, errors.NewDBError(errors.WithError(err))
@@ -159,7 +175,9 @@ func (g *Server) CreateRegistry(ctx context.Context, req *catalogv3.CreateRegist
 			Cacerts:      req.Registry.Cacerts,
 			Type:         created.Type,
 			ApiType:      req.Registry.ApiType,
+			Labels:       extractedLabels(created.Labels),
 			CreateTime:   timestamppb.New(created.CreateTime),
+			Etag:         formatETag(created.Etag),
 		},
 	}, nil
 }
@@ -173,6 +191,10 @@ func (g *Server) createRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 	}
 	reg.DisplayName = displayName
 
+	if err := validateLabels(reg.Labels, errors.RegistryType, reg.Name); err != nil {
+		return nil, err
+	}
+
 	// Make sure that the display name, if specified is unique
 	if err := g.checkRegistryUniqueness(ctx, tx, projectUUID, reg); err != nil {
 		return nil, err
@@ -184,7 +206,9 @@ func (g *Server) createRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 		SetDisplayName(displayName).
 		SetDisplayNameLc(strings.ToLower(displayName)).
 		SetDescription(reg.Description).
-		SetType(reg.Type)
+		SetType(reg.Type).
+		SetAPIType(reg.ApiType).
+		SetLabels(storedLabels(reg.Labels))
 
 	registrySecret := &registrySecretData{
 		RootURL:      reg.RootUrl,
@@ -194,8 +218,9 @@ func (g *Server) createRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 		Cacerts:      reg.Cacerts,
 	}
 
-	registrySecretData := Base64Factory().EncodeBase64(*registrySecret)
-	if !UseSecretService {
+	registryKey := MakeSecretPath(projectUUID, reg.Name)
+	registrySecretData := Base64Factory().EncodeBase64(*registrySecret, registryKey)
+	if storeSecretInDB(ctx) {
 		create.SetAuthToken(registrySecretData)
 	}
 
@@ -209,14 +234,13 @@ func (g *Server) createRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 		}
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
-	if UseSecretService {
+	if !storeSecretInDB(ctx) {
 		secretService, err := SecretServiceFactory(ctx)
 		if err != nil {
 			return nil, errors.NewVaultError(errors.WithError(err))
 		}
 		defer secretService.Logout(ctx)
 
-		registryKey := MakeSecretPath(projectUUID, reg.Name)
 		err = secretService.WriteSecret(ctx, registryKey, registrySecretData)
 		if err != nil {
 			return nil, errors.NewVaultError(errors.WithError(err))
@@ -278,7 +302,18 @@ func (g *Server) ListRegistries(ctx context.Context, req *catalogv3.ListRegistri
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
-	registries, _, count, err := g.getRegistries(ctx, tx, projectUUID, req.ShowSensitiveInfo, orderBys, filters, req.PageSize, req.Offset)
+	selector, err := parseLabelSelector(req.LabelSelector, errors.RegistryType)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	page, err := newListPage(errors.RegistryType, req.PageSize, req.Offset, req.PageToken, req.OrderBy, req.Filter, req.LabelSelector, req.SkipTotal)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	registries, _, count, nextPageToken, err := g.getRegistries(ctx, tx, projectUUID, req.ShowSensitiveInfo, orderBys, filters, selector, page)
 	if err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
@@ -291,7 +326,8 @@ func (g *Server) ListRegistries(ctx context.Context, req *catalogv3.ListRegistri
 	logActivity(ctx, "listed", "registries", projectUUID, "Total => "+fmt.Sprintf("%d", count))
 	return &catalogv3.ListRegistriesResponse{
 			Registries:    registries,
-			TotalElements: count},
+			TotalElements: count,
+			NextPageToken: nextPageToken},
 		nil
 }
 
@@ -311,70 +347,83 @@ var registryColumns = map[string]string{
 }
 
 func (g *Server) getRegistries(ctx context.Context, tx *generated.Tx, projectUUID string, showSensitiveInfo bool,
-	orderBys []*orderBy, filters []*filter,
-	pageSize int32, offset int32) ([]*catalogv3.Registry, []string, int32, error) {
+	orderBys []*orderBy, filterExpr *filter, selector labels.Selector,
+	page *listPage) ([]*catalogv3.Registry, []string, int32, string, error) {
 	var err error
-	var registriesDB []*generated.Registry
 	var orderOptions []registry.OrderOption
 
 	var secretService SecretService
 	if UseSecretService {
 		secretService, err = SecretServiceFactory(ctx)
 		if err != nil {
-			return nil, nil, 0, errors.NewVaultError(errors.WithError(err))
+			return nil, nil, 0, "", errors.NewVaultError(errors.WithError(err))
 		}
 		defer secretService.Logout(ctx)
 	}
 
 	registriesQuery := tx.Registry.Query()
-	options, err := orderByOptions(orderBys, registryColumns, errors.RegistryType)
+	filterPred, err := filterPredicate(filterExpr, registryColumns, errors.RegistryType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
+	} else if filterPred != nil {
+		registriesQuery = registriesQuery.Where(filterPred)
 	}
-	for _, pred := range options {
-		orderOptions = append(orderOptions, pred)
-	}
-	registriesQuery = registriesQuery.Order(orderOptions...)
 
-	filterPreds, err := filterPredicates(filters, registryColumns, errors.RegistryType)
-	if err != nil {
-		return nil, nil, 0, err
+	if selectorPred := labelSelectorPredicate(selector); selectorPred != nil {
+		registriesQuery = registriesQuery.Where(selectorPred)
 	}
-	var registryPreds []predicate.Registry
-	for _, pred := range filterPreds {
-		registryPreds = append(registryPreds, pred)
+
+	if projectUUID != "" {
+		registriesQuery = registriesQuery.Where(registry.ProjectUUID(projectUUID))
 	}
-	registriesQuery = registriesQuery.Where(registry.Or(registryPreds...))
 
-	if projectUUID == "" {
-		registriesDB, err = registriesQuery.All(ctx)
-	} else {
-		registriesDB, err = registriesQuery.Where(registry.ProjectUUID(projectUUID)).All(ctx)
+	var totalElements int32
+	if !page.skipTotal {
+		count, err := registriesQuery.Clone().Count(ctx)
+		if err != nil {
+			return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
+		}
+		totalElements = int32(count)
 	}
+
+	options, err := page.orderOptions(orderBys, registryColumns, errors.RegistryType)
 	if err != nil {
-		return nil, nil, 0, errors.NewDBError(errors.WithError(err))
+		return nil, nil, 0, "", err
+	}
+	for _, pred := range options {
+		orderOptions = append(orderOptions, pred)
 	}
+	registriesQuery = registriesQuery.Order(orderOptions...)
 
-	startIndex, endIndex, totalElements, err := computePageRange(pageSize, offset, len(registriesDB))
+	after, err := page.afterPredicate(orderBys, registryColumns, errors.RegistryType)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, "", err
+	} else if after != nil {
+		registriesQuery = registriesQuery.Where(after)
 	}
-	if len(registriesDB) == 0 {
-		return []*catalogv3.Registry{}, []string{}, 0, nil
+
+	registriesDB, err := registriesQuery.Offset(page.offset).Limit(page.size + 1).All(ctx)
+	if err != nil {
+		return nil, nil, 0, "", errors.NewDBError(errors.WithError(err))
 	}
+	registriesDB, more := pageOf(registriesDB, page)
 
-	registries := make([]*catalogv3.Registry, 0)
-	projectUUIDs := make([]string, 0)
-	for i := startIndex; i <= endIndex; i++ {
-		registryDB := registriesDB[i]
+	registries := make([]*catalogv3.Registry, 0, len(registriesDB))
+	projectUUIDs := make([]string, 0, len(registriesDB))
+	for _, registryDB := range registriesDB {
 		reg, err := g.extractRegistry(ctx, registryDB, secretService, showSensitiveInfo)
 		if err != nil {
-			return nil, nil, 0, err
+			return nil, nil, 0, "", err
 		}
 		registries = append(registries, reg)
 		projectUUIDs = append(projectUUIDs, registryDB.ProjectUUID)
 	}
-	return registries, projectUUIDs, totalElements, nil
+
+	var nextPageToken string
+	if more {
+		nextPageToken = page.nextToken(orderBys, registries[len(registries)-1], registriesDB[len(registriesDB)-1].ID)
+	}
+	return registries, projectUUIDs, totalElements, nextPageToken, nil
 }
 
 func (g *Server) extractRegistry(ctx context.Context, registryDB *generated.Registry, secretService SecretService, showSensitiveInfo bool) (*catalogv3.Registry, error) {
@@ -385,7 +434,8 @@ func (g *Server) extractRegistry(ctx context.Context, registryDB *generated.Regi
 	// Transient cache of the dynamically loaded CA certs
 	dynamicCACert := ""
 
-	if UseSecretService {
+	// Secrets of registries loaded during a dry-run are kept only in the database transaction
+	if UseSecretService && (!isDryRun(ctx) || registryDB.AuthToken == "") {
 		registryKey := MakeSecretPath(registryDB.ProjectUUID, registryDB.Name)
 
 		// Fetch the stored secret
@@ -396,7 +446,7 @@ func (g *Server) extractRegistry(ctx context.Context, registryDB *generated.Regi
 	} else {
 		encodedSecretData = registryDB.AuthToken
 	}
-	err = Base64Factory().DecodeBase64(&rsd, encodedSecretData)
+	err = Base64Factory().DecodeBase64(&rsd, encodedSecretData, MakeSecretPath(registryDB.ProjectUUID, registryDB.Name))
 	if err != nil {
 		return nil, errors.NewVaultError(errors.WithError(err))
 	}
@@ -408,8 +458,10 @@ func (g *Server) extractRegistry(ctx context.Context, registryDB *generated.Regi
 		InventoryUrl: rsd.InventoryURL,
 		Type:         registryDB.Type,
 		ApiType:      registryDB.APIType,
+		Labels:       extractedLabels(registryDB.Labels),
 		CreateTime:   timestamppb.New(registryDB.CreateTime),
 		UpdateTime:   timestamppb.New(registryDB.UpdateTime),
+		Etag:         formatETag(registryDB.Etag),
 	}
 	if showSensitiveInfo {
 		reg.Username = rsd.Username
@@ -486,6 +538,7 @@ func (g *Server) GetRegistry(ctx context.Context, req *catalogv3.GetRegistryRequ
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 	logActivity(ctx, "got", "registry", projectUUID, req.RegistryName)
+	setETagHeader(ctx, reg.Etag)
 	return &catalogv3.GetRegistryResponse{Registry: reg}, nil
 }
 
@@ -499,14 +552,10 @@ func (g *Server) UpdateRegistry(ctx context.Context, req *catalogv3.UpdateRegist
 		return nil, errors.NewInvalidArgument(
 			errors.WithResourceType(errors.RegistryType),
 			errors.WithMessage("incomplete request"))
-	} else if err := req.Registry.Validate(); err != nil {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.RegistryType),
-			errors.WithMessage(err.Error()))
-	} else if req.RegistryName != req.Registry.Name {
-		return nil, errors.NewInvalidArgument(
-			errors.WithResourceType(errors.RegistryType),
-			errors.WithMessage("name cannot be changed %s != %s", req.RegistryName, req.Registry.Name))
+	} else if !isPartialUpdate(req.UpdateMask) {
+		if err := validateRegistryUpdate(req.RegistryName, req.Registry); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := g.authCheckAllowed(ctx, req); err != nil {
@@ -518,8 +567,26 @@ func (g *Server) UpdateRegistry(ctx context.Context, req *catalogv3.UpdateRegist
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.RegistryType, projectUUID, req.RegistryName, "", req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	reg := req.Registry
+	if isPartialUpdate(req.UpdateMask) {
+		if reg, err = g.maskedRegistry(ctx, tx, projectUUID, req); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, err
+		}
+	}
+
 	events := &RegistryEvents{}
-	if err = g.updateRegistry(ctx, tx, projectUUID, req.Registry, events); err != nil {
+	if err = g.updateRegistry(ctx, tx, projectUUID, reg, events); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = events.persist(ctx, tx); err != nil {
 		g.rollbackTransaction(tx)
 		return nil, err
 	}
@@ -535,6 +602,20 @@ func (g *Server) UpdateRegistry(ctx context.Context, req *catalogv3.UpdateRegist
 	return &emptypb.Empty{}, nil
 }
 
+// Validates the registry given to an update of the named registry.
+func validateRegistryUpdate(name string, reg *catalogv3.Registry) error {
+	if err := reg.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithMessage(err.Error()))
+	} else if name != reg.Name {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithMessage("name cannot be changed %s != %s", name, reg.Name))
+	}
+	return nil
+}
+
 func (g *Server) updateRegistry(ctx context.Context, tx *ent.Tx, projectUUID string, reg *catalogv3.Registry, events *RegistryEvents) error {
 	displayName, ok := validateDisplayName(reg.Name, reg.DisplayName)
 	if !ok {
@@ -543,6 +624,11 @@ func (g *Server) updateRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 			errors.WithResourceName(reg.Name),
 			errors.WithMessage("display name cannot contain leading or trailing spaces"))
 	}
+	reg.DisplayName = displayName
+
+	if err := validateLabels(reg.Labels, errors.RegistryType, reg.Name); err != nil {
+		return err
+	}
 
 	// Make sure that the display name, if specified is unique
 	if err := g.checkRegistryUniqueness(ctx, tx, projectUUID, reg); err != nil {
@@ -554,13 +640,21 @@ func (g *Server) updateRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 		return err
 	}
 
+	before, err := g.registrySnapshot(ctx, tx, projectUUID, reg.Name, true)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return err
+	}
+	events.captureBefore(reg.Name, "", before)
+
 	update := tx.Registry.Update().
 		Where(registry.ProjectUUID(projectUUID), registry.Name(reg.Name)).
 		SetDisplayName(displayName).
 		SetDisplayNameLc(strings.ToLower(displayName)).
 		SetDescription(reg.GetDescription()).
 		SetType(reg.Type).
-		SetAPIType(reg.ApiType)
+		SetAPIType(reg.ApiType).
+		SetLabels(storedLabels(reg.Labels))
 
 	registrySecret := &registrySecretData{
 		RootURL:      reg.RootUrl,
@@ -569,8 +663,9 @@ func (g *Server) updateRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 		AuthToken:    reg.AuthToken,
 		Cacerts:      reg.Cacerts,
 	}
-	registrySecretData := Base64Factory().EncodeBase64(*registrySecret)
-	if !UseSecretService {
+	registryKey := MakeSecretPath(projectUUID, reg.Name)
+	registrySecretData := Base64Factory().EncodeBase64(*registrySecret, registryKey)
+	if storeSecretInDB(ctx) {
 		update.SetAuthToken(registrySecretData)
 	}
 	updateCount, err := update.Save(ctx)
@@ -584,8 +679,7 @@ func (g *Server) updateRegistry(ctx context.Context, tx *ent.Tx, projectUUID str
 			errors.WithResourceName(reg.Name),
 			errors.WithMessage(`registry not found`))
 	}
-	if UseSecretService {
-		registryKey := MakeSecretPath(projectUUID, reg.Name)
+	if !storeSecretInDB(ctx) {
 		secretService, err := SecretServiceFactory(ctx)
 		if err != nil {
 			return errors.NewVaultError(errors.WithError(err))
@@ -653,6 +747,11 @@ func (g *Server) DeleteRegistry(ctx context.Context, req *catalogv3.DeleteRegist
 		return nil, errors.NewDBError(errors.WithError(err))
 	}
 
+	if err = g.checkETag(ctx, tx, errors.RegistryType, projectUUID, req.RegistryName, "", req.Etag); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	events := &RegistryEvents{}
 	uses, err := tx.Application.Query().Where(
 		application.ProjectUUID(projectUUID),
@@ -672,6 +771,19 @@ func (g *Server) DeleteRegistry(ctx context.Context, req *catalogv3.DeleteRegist
 			errors.WithMessage("cannot delete registry while in use"))
 	}
 
+	before, err := g.registrySnapshot(ctx, tx, projectUUID, req.RegistryName, false)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	events.captureBefore(req.RegistryName, "", before)
+
+	// The credentials are kept in the secret service until the registry is purged from the trash
+	if err = trashRegistry(ctx, tx, projectUUID, req.RegistryName, before); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
 	deleteCount, err := tx.Registry.Delete().
 		Where(registry.ProjectUUID(projectUUID), registry.Name(req.RegistryName)).Exec(ctx)
 	if err != nil {
@@ -684,26 +796,15 @@ func (g *Server) DeleteRegistry(ctx context.Context, req *catalogv3.DeleteRegist
 			errors.WithResourceName(req.RegistryName))
 	}
 
-	var secretService SecretService
-	if UseSecretService {
-		registryKey := MakeSecretPath(projectUUID, req.RegistryName)
-		secretService, err = SecretServiceFactory(ctx)
-		if err != nil {
-			g.rollbackTransaction(tx)
-			return nil, errors.NewVaultError(errors.WithError(err))
-		}
-		defer secretService.Logout(ctx)
-
-		err = secretService.DeleteSecret(ctx, registryKey)
-		if err != nil {
-			log.Warnf("failed to delete key %s from secret service: %v", registryKey, err)
-			return nil, errors.NewVaultError(errors.WithError(err))
-		}
+	events.append(DeletedEvent, projectUUID, &catalogv3.Registry{Name: req.RegistryName, Labels: snapshotLabels(before)})
+	if err = events.persist(ctx, tx); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
 	}
+
 	if _, err = g.checkDeleteResult(ctx, tx, err, fmt.Sprintf("registry %s", req.RegistryName), projectUUID); err != nil {
 		return nil, err
 	}
-	events.append(DeletedEvent, projectUUID, &catalogv3.Registry{Name: req.RegistryName})
 	events.sendToAll(g.listeners)
 	logActivity(ctx, "deleted", "registry", projectUUID, req.RegistryName)
 	return &emptypb.Empty{}, nil
@@ -724,15 +825,31 @@ func (g *Server) WatchRegistries(req *catalogv3.WatchRegistriesRequest, server c
 			errors.WithResourceType(errors.RegistryType),
 			errors.WithMessage("incomplete request"))
 	}
+	selector, err := parseLabelSelector(req.LabelSelector, errors.RegistryType)
+	if err != nil {
+		return err
+	}
 
 	if err := g.authCheckAllowed(server.Context(), req); err != nil {
 		return err
 	}
 
-	ch := make(chan *catalogv3.WatchRegistriesResponse)
+	var l *registryListener
 
-	// If replay requested
-	if !req.NoReplay {
+	// If resuming, replay the events recorded since the given revision and, once the stream is registered,
+	// any events recorded in the meantime
+	var replayed replayedRevisions
+	if req.ResumeFromRevision > 0 {
+		replayed = replayedRevisions{}
+		if err = g.replayRegistryEvents(server, projectUUID, req.LabelSelector, req.ResumeFromRevision, replayed); err != nil {
+			return err
+		}
+		l = g.listeners.addRegistryListener(server.Context(), req)
+		if err = g.replayRegistryEvents(server, projectUUID, req.LabelSelector, req.ResumeFromRevision, replayed); err != nil {
+			g.listeners.deleteRegistryListener(l)
+			return err
+		}
+	} else if !req.NoReplay {
 		// Get list of registries
 		ctx := server.Context()
 		tx, err := g.startTransaction(ctx)
@@ -740,7 +857,7 @@ func (g *Server) WatchRegistries(req *catalogv3.WatchRegistriesRequest, server c
 			return errors.NewDBError(errors.WithError(err))
 		}
 
-		registries, projectUUIDs, _, err := g.getRegistries(ctx, tx, projectUUID, req.ShowSensitiveInfo, nil, nil, 0, 0)
+		registries, projectUUIDs, _, _, err := g.getRegistries(ctx, tx, projectUUID, req.ShowSensitiveInfo, nil, nil, selector, &listPage{size: DefaultPageSize})
 		if err != nil {
 			g.rollbackTransaction(tx)
 			return err
@@ -760,7 +877,7 @@ func (g *Server) WatchRegistries(req *catalogv3.WatchRegistriesRequest, server c
 		}
 
 		// Register the stream, so it can start receiving updates
-		g.listeners.addRegistryListener(ch, req)
+		l = g.listeners.addRegistryListener(server.Context(), req)
 
 		err = g.commitTransaction(tx)
 		if err != nil {
@@ -768,18 +885,25 @@ func (g *Server) WatchRegistries(req *catalogv3.WatchRegistriesRequest, server c
 		}
 	} else {
 		// Register the stream, so it can start receiving updates
-		g.listeners.addRegistryListener(ch, req)
+		l = g.listeners.addRegistryListener(server.Context(), req)
 	}
-	defer g.listeners.deleteRegistryListener(ch)
+	defer g.listeners.deleteRegistryListener(l)
 	logActivity(server.Context(), "watched", "registries", projectUUID, "")
-	return g.watchRegistryEvents(server, ch)
+	return g.watchRegistryEvents(server, l, replayed)
 }
 
-func (g *Server) watchRegistryEvents(server catalogv3.CatalogService_WatchRegistriesServer, ch chan *catalogv3.WatchRegistriesResponse) error {
-	for e := range ch {
-		if err := server.Send(e); err != nil {
+func (g *Server) watchRegistryEvents(server catalogv3.CatalogService_WatchRegistriesServer, l *registryListener, replayed replayedRevisions) error {
+	signalRegistered(server)
+	for {
+		e, err := l.next(server.Context())
+		if err != nil {
+			return err
+		}
+		if alreadyReplayed(e.Event, replayed) {
+			continue
+		}
+		if err = server.Send(e); err != nil {
 			return err
 		}
 	}
-	return nil
 }
@@ -70,6 +70,7 @@ func (s *NorthBoundTestSuite) TestCreateRegistry() {
 			AuthToken:   "token",
 			Cacerts:     "cacerts",
 			Type:        helmType,
+			ApiType:     "native",
 		},
 	})
 	s.validateResponse(err, created)
@@ -79,6 +80,7 @@ func (s *NorthBoundTestSuite) TestCreateRegistry() {
 	resp, err := s.client.GetRegistry(s.ProjectID(footen), &catalogv3.GetRegistryRequest{RegistryName: "test-registry"})
 	s.validateResponse(err, resp)
 	s.validateRegistry(created.Registry, "test-registry", "Test registry", "This is a Test", "https://raw.githubusercontent.com/intel/DevcloudContent-helm/dev", "user", "token", "cacerts")
+	s.Equal("native", resp.Registry.ApiType)
 
 	// Create one with duplicated name
 	_, err = s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{
@@ -843,11 +845,11 @@ type base64Error struct{}
 
 var errCannotDecodeError = errors.New("base64 cannot decode error")
 
-func (b *base64Error) EncodeBase64(_ registrySecretData) string {
+func (b *base64Error) EncodeBase64(_ registrySecretData, _ string) string {
 	return ""
 }
 
-func (b *base64Error) DecodeBase64(_ *registrySecretData, _ string) error {
+func (b *base64Error) DecodeBase64(_ *registrySecretData, _ string, _ string) error {
 	return errCannotDecodeError
 }
 
@@ -857,7 +859,7 @@ func newBase64Error() Base64Strings {
 }
 
 func (s *NorthBoundTestSuite) TestRegistryBase64Errors() {
-	err := Base64Factory().DecodeBase64(&registrySecretData{}, "this is not Base64")
+	err := Base64Factory().DecodeBase64(&registrySecretData{}, "this is not Base64", "")
 	s.Error(err)
 
 	saveBase64Factory := Base64Factory
@@ -0,0 +1,439 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Updates to applications and deployment packages overwrite their rows in place. To allow a bad edit to be undone,
+ * every update that changes the entity records an immutable revision holding a snapshot of the updated entity. The
+ * entities created prior to the revision history have no revisions, so their state prior to the first update is
+ * recorded as the first revision.
+ *
+ * Restoring a revision simply updates the entity with the snapshot, which in turn records a new revision; the same
+ * rules apply to the restore as to any other update.
+ *
+ * The revisions are deleted along with their entity: restoring the entity from the trash creates it anew, as does
+ * creating another entity of the same name and version, neither of which inherits the history of the deleted entity.
+ * Any revisions still left when the entity is purged from the trash are deleted then, unless the entity was created
+ * anew in the meantime.
+ */
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Returns the type of the entity of which the revisions are requested, provided that the entity is identified.
+func revisionResourceType(resourceType string, name string, version string) (errors.ResourceType, error) {
+	if name == "" || version == "" {
+		return "", errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ResourceType(resourceType)),
+			errors.WithMessage("incomplete request"))
+	}
+	switch errors.ResourceType(resourceType) {
+	case errors.ApplicationType, errors.DeploymentPackageType:
+		return errors.ResourceType(resourceType), nil
+	}
+	return "", errors.NewInvalidArgument(
+		errors.WithMessage("revisions are not kept for %q; must be %s or %s",
+			resourceType, errors.ApplicationType, errors.DeploymentPackageType))
+}
+
+// Returns the query for the revisions of the given entity.
+func revisionQuery(client *generated.RevisionClient, projectUUID string, resourceType errors.ResourceType, name string, version string) *generated.RevisionQuery {
+	return client.Query().
+		Where(
+			revision.ProjectUUID(projectUUID),
+			revision.ResourceType(string(resourceType)),
+			revision.Name(name),
+			revision.Version(version),
+		)
+}
+
+// Returns the snapshot of the given entity as recorded in a revision, without the fields generated by the server or
+// the deployed state, none of which is subject to restore.
+func revisionSnapshot(entity proto.Message) proto.Message {
+	snapshot := proto.Clone(entity)
+	clearGeneratedFields(snapshot.ProtoReflect())
+	if pkg, ok := snapshot.(*catalogv3.DeploymentPackage); ok {
+		pkg.IsDeployed = false
+	}
+	return snapshot
+}
+
+// Records a revision holding the current state of the given entity, unless it is the same as the latest revision.
+// If the entity has no revisions yet, the given state of the entity before the update is recorded first.
+func (g *Server) recordRevision(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType,
+	name string, version string, before proto.Message) error {
+	// Lock the entity, so that concurrent updates number their revisions one after another
+	if err := lockEntity(ctx, tx, projectUUID, resourceType, name, version); err != nil {
+		return err
+	}
+
+	var current proto.Message
+	var err error
+	if resourceType == errors.ApplicationType {
+		current, err = g.applicationSnapshot(ctx, tx, projectUUID, name, version)
+	} else {
+		current, err = g.deploymentPackageSnapshot(ctx, tx, projectUUID, name, version)
+	}
+	if err != nil {
+		return err
+	} else if current == nil {
+		return errors.NewNotFound(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version))
+	}
+	current = revisionSnapshot(current)
+
+	latest, err := revisionQuery(tx.Revision, projectUUID, resourceType, name, version).
+		Order(generated.Desc(revision.FieldRevision)).
+		First(ctx)
+	if err != nil && !generated.IsNotFound(err) {
+		return errors.NewDBError(errors.WithError(err))
+	}
+
+	var number uint32
+	if latest != nil {
+		previous := current.ProtoReflect().New().Interface()
+		if err := protojson.Unmarshal([]byte(latest.Snapshot), previous); err != nil {
+			return errors.NewInternal(errors.WithError(err))
+		}
+		if proto.Equal(previous, current) {
+			return nil
+		}
+		number = latest.Revision
+	} else if before != nil {
+		if err := createRevision(ctx, tx, projectUUID, resourceType, name, version, 1, revisionSnapshot(before)); err != nil {
+			return err
+		}
+		number = 1
+	}
+	return createRevision(ctx, tx, projectUUID, resourceType, name, version, number+1, current)
+}
+
+// Locks the row of the given application or deployment package until the end of the transaction.
+func lockEntity(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType, name string, version string) error {
+	var err error
+	if resourceType == errors.ApplicationType {
+		_, err = tx.Application.Query().
+			Where(
+				application.ProjectUUID(projectUUID),
+				application.Name(name),
+				application.Version(version),
+				forUpdate,
+			).
+			FirstID(ctx)
+	} else {
+		_, err = tx.DeploymentPackage.Query().
+			Where(
+				deploymentpackage.ProjectUUID(projectUUID),
+				deploymentpackage.Name(name),
+				deploymentpackage.Version(version),
+				forUpdate,
+			).
+			FirstID(ctx)
+	}
+	if err != nil && !generated.IsNotFound(err) {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// Deletes the revisions of the given entity.
+func deleteRevisions(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType, name string, version string) error {
+	_, err := tx.Revision.Delete().
+		Where(
+			revision.ProjectUUID(projectUUID),
+			revision.ResourceType(string(resourceType)),
+			revision.Name(name),
+			revision.Version(version),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// Deletes the revisions of the given entity purged from the trash, unless the entity was created anew since.
+func purgeRevisions(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType, name string, version string) error {
+	var live bool
+	var err error
+	if resourceType == errors.ApplicationType {
+		live, err = tx.Application.Query().
+			Where(application.ProjectUUID(projectUUID), application.Name(name), application.Version(version)).
+			Exist(ctx)
+	} else {
+		live, err = tx.DeploymentPackage.Query().
+			Where(deploymentpackage.ProjectUUID(projectUUID), deploymentpackage.Name(name), deploymentpackage.Version(version)).
+			Exist(ctx)
+	}
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	} else if live {
+		return nil
+	}
+	return deleteRevisions(ctx, tx, projectUUID, resourceType, name, version)
+}
+
+// Records the given snapshot of the entity as a revision.
+func createRevision(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType,
+	name string, version string, number uint32, snapshot proto.Message) error {
+	js, err := protojson.Marshal(snapshot)
+	if err != nil {
+		return errors.NewInternal(errors.WithError(err))
+	}
+	user, _ := auditIdentity(ctx)
+	err = tx.Revision.Create().
+		SetProjectUUID(projectUUID).
+		SetResourceType(string(resourceType)).
+		SetName(name).
+		SetVersion(version).
+		SetRevision(number).
+		SetUserName(user).
+		SetSnapshot(string(js)).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// Returns the API representation of the given revision, optionally including the snapshot of the entity.
+func revisionFromDB(r *generated.Revision, withSnapshot bool) (*catalogv3.Revision, error) {
+	rev := &catalogv3.Revision{
+		ResourceType: r.ResourceType,
+		Name:         r.Name,
+		Version:      r.Version,
+		Revision:     r.Revision,
+		User:         r.UserName,
+		CreateTime:   timestamppb.New(r.CreateTime),
+	}
+	if !withSnapshot {
+		return rev, nil
+	}
+
+	var snapshot proto.Message
+	if errors.ResourceType(r.ResourceType) == errors.ApplicationType {
+		rev.Application = &catalogv3.Application{}
+		snapshot = rev.Application
+	} else {
+		rev.DeploymentPackage = &catalogv3.DeploymentPackage{}
+		snapshot = rev.DeploymentPackage
+	}
+	if err := protojson.Unmarshal([]byte(r.Snapshot), snapshot); err != nil {
+		return nil, errors.NewInternal(errors.WithError(err))
+	}
+	return rev, nil
+}
+
+// Returns the given revision of the given entity, or a not-found error if there is no such revision.
+func getRevision(ctx context.Context, client *generated.RevisionClient, projectUUID string, resourceType errors.ResourceType,
+	name string, version string, number uint32) (*generated.Revision, error) {
+	revDB, err := revisionQuery(client, projectUUID, resourceType, name, version).
+		Where(revision.Revision(number)).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, errors.NewNotFound(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
+			errors.WithMessage("revision %d", number))
+	} else if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return revDB, nil
+}
+
+// ListRevisions gets a list of the revisions of an application or a deployment package through gRPC
+func (g *Server) ListRevisions(ctx context.Context, req *catalogv3.ListRevisionsRequest) (*catalogv3.ListRevisionsResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage("incomplete request"))
+	}
+	resourceType, err := revisionResourceType(req.ResourceType, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := revisionQuery(g.databaseClient.Revision, projectUUID, resourceType, req.Name, req.Version)
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	startIndex, endIndex, totalElements, err := computePageRange(req.PageSize, req.Offset, count)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*catalogv3.Revision, 0)
+	if count > 0 {
+		revisionsDB, err := query.
+			Order(generated.Desc(revision.FieldRevision)).
+			Offset(startIndex).
+			Limit(endIndex - startIndex + 1).
+			All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+		for _, r := range revisionsDB {
+			rev, _ := revisionFromDB(r, false)
+			revisions = append(revisions, rev)
+		}
+	}
+	return &catalogv3.ListRevisionsResponse{Revisions: revisions, TotalElements: totalElements}, nil
+}
+
+// GetRevision gets a specific revision of an application or a deployment package through gRPC
+func (g *Server) GetRevision(ctx context.Context, req *catalogv3.GetRevisionRequest) (*catalogv3.GetRevisionResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage("incomplete request"))
+	}
+	resourceType, err := revisionResourceType(req.ResourceType, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	revDB, err := getRevision(ctx, g.databaseClient.Revision, projectUUID, resourceType, req.Name, req.Version, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := revisionFromDB(revDB, true)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv3.GetRevisionResponse{Revision: rev}, nil
+}
+
+// RestoreRevision restores an application or a deployment package to the state held by a revision through gRPC
+func (g *Server) RestoreRevision(ctx context.Context, req *catalogv3.RestoreRevisionRequest) (*catalogv3.RestoreRevisionResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage("incomplete request"))
+	}
+	resourceType, err := revisionResourceType(req.ResourceType, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	revDB, err := getRevision(ctx, tx.Revision, projectUUID, resourceType, req.Name, req.Version, req.Revision)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+	rev, err := revisionFromDB(revDB, true)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	var sendToAll func(*EventListeners)
+	if resourceType == errors.ApplicationType {
+		events := &ApplicationEvents{}
+		if err = g.restoreApplication(ctx, tx, projectUUID, rev.Application, events); err == nil {
+			err = events.persist(ctx, tx)
+		}
+		sendToAll = events.sendToAll
+	} else {
+		events := &DeploymentPackageEvents{}
+		if err = g.restoreDeploymentPackage(ctx, tx, projectUUID, rev.DeploymentPackage, events); err == nil {
+			err = events.persist(ctx, tx)
+		}
+		sendToAll = events.sendToAll
+	}
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	// Return the latest revision, which holds the restored state
+	latest, err := revisionQuery(tx.Revision, projectUUID, resourceType, req.Name, req.Version).
+		Order(generated.Desc(revision.FieldRevision)).
+		First(ctx)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	if rev, err = revisionFromDB(latest, true); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	logActivity(ctx, "restored", string(resourceType), projectUUID, req.Name, req.Version)
+	sendToAll(g.listeners)
+
+	return &catalogv3.RestoreRevisionResponse{Revision: rev}, nil
+}
+
+// Updates the application with the given snapshot.
+func (g *Server) restoreApplication(ctx context.Context, tx *generated.Tx, projectUUID string, app *catalogv3.Application, events *ApplicationEvents) error {
+	if err := app.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage(err.Error()))
+	}
+	return g.updateApplication(ctx, tx, projectUUID, app, events)
+}
+
+// Updates the deployment package with the given snapshot, unless the deployment package is deployed.
+func (g *Server) restoreDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string, pkg *catalogv3.DeploymentPackage, events *DeploymentPackageEvents) error {
+	if err := pkg.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage(err.Error()))
+	} else if err := validateDeploymentProfiles(pkg); err != nil {
+		return err
+	}
+
+	if err := checkPackageNotDeployed(ctx, tx, projectUUID, pkg.Name, pkg.Version); err != nil {
+		return err
+	}
+	return g.updateDeploymentPackage(ctx, tx, projectUUID, pkg, events)
+}
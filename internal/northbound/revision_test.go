@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/revision"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *NorthBoundTestSuite) listRevisions(resourceType string, name string, version string) []*catalogv3.Revision {
+	resp, err := s.client.ListRevisions(s.ProjectID(footen), &catalogv3.ListRevisionsRequest{
+		ResourceType: resourceType, Name: name, Version: version,
+	})
+	s.NoError(err)
+	return resp.Revisions
+}
+
+func (s *NorthBoundTestSuite) updatePackageDescription(name string, version string, description string) {
+	resp, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: name, Version: version,
+	})
+	s.NoError(err)
+	pkg := resp.DeploymentPackage
+	pkg.Description = description
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: name, Version: version, DeploymentPackage: pkg,
+	})
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) TestDeploymentPackageRevisions() {
+	s.createDeploymentPkg(footen, "revised", "0.1.0", "foo:v0.1.0")
+	s.Empty(s.listRevisions("deployment-package", "revised", "0.1.0"))
+
+	s.updatePackageDescription("revised", "0.1.0", "First edit")
+	s.updatePackageDescription("revised", "0.1.0", "Bad edit")
+	// Updates that change nothing do not record a revision
+	s.updatePackageDescription("revised", "0.1.0", "Bad edit")
+
+	revisions := s.listRevisions("deployment-package", "revised", "0.1.0")
+	if s.Len(revisions, 3) {
+		s.Equal(uint32(3), revisions[0].Revision)
+		s.Equal(uint32(1), revisions[2].Revision)
+		s.Nil(revisions[0].DeploymentPackage)
+	}
+
+	got, err := s.client.GetRevision(s.ProjectID(footen), &catalogv3.GetRevisionRequest{
+		ResourceType: "deployment-package", Name: "revised", Version: "0.1.0", Revision: 1,
+	})
+	s.NoError(err)
+	s.Equal("This is deployment package revised", got.Revision.DeploymentPackage.Description)
+	s.Len(got.Revision.DeploymentPackage.ApplicationReferences, 1)
+	s.Nil(got.Revision.Application)
+
+	restored, err := s.client.RestoreRevision(s.ProjectID(footen), &catalogv3.RestoreRevisionRequest{
+		ResourceType: "deployment-package", Name: "revised", Version: "0.1.0", Revision: 2,
+	})
+	s.NoError(err)
+	s.Equal(uint32(4), restored.Revision.Revision)
+	s.Equal("First edit", restored.Revision.DeploymentPackage.Description)
+
+	resp, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "revised", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.Equal("First edit", resp.DeploymentPackage.Description)
+
+	_, err = s.client.GetRevision(s.ProjectID(footen), &catalogv3.GetRevisionRequest{
+		ResourceType: "deployment-package", Name: "revised", Version: "0.1.0", Revision: 9,
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	// Revisions are private to their project
+	_, err = s.client.GetRevision(s.ProjectID(barten), &catalogv3.GetRevisionRequest{
+		ResourceType: "deployment-package", Name: "revised", Version: "0.1.0", Revision: 1,
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	_, err = s.client.ListRevisions(s.ProjectID(footen), &catalogv3.ListRevisionsRequest{
+		ResourceType: "registry", Name: "revised", Version: "0.1.0",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestRestoreDeployedPackage() {
+	s.createDeploymentPkg(footen, "revised", "0.1.0", "foo:v0.1.0")
+	s.updatePackageDescription("revised", "0.1.0", "First edit")
+
+	resp, err := s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "revised", Version: "0.1.0",
+	})
+	s.NoError(err)
+	pkg := resp.DeploymentPackage
+	pkg.IsDeployed = true
+	_, err = s.client.UpdateDeploymentPackage(s.ProjectID(footen), &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: pkg.Name, Version: pkg.Version, DeploymentPackage: pkg,
+	})
+	s.NoError(err)
+	// Changes to the deployed state alone do not record a revision
+	s.Len(s.listRevisions("deployment-package", "revised", "0.1.0"), 2)
+
+	_, err = s.client.RestoreRevision(s.ProjectID(footen), &catalogv3.RestoreRevisionRequest{
+		ResourceType: "deployment-package", Name: "revised", Version: "0.1.0", Revision: 1,
+	})
+	s.ErrorIs(err, status.Errorf(codes.FailedPrecondition,
+		"deployment-package revised:0.1.0 failed precondition: cannot modify deployed package"))
+}
+
+func (s *NorthBoundTestSuite) TestApplicationRevisions() {
+	resp, err := s.client.GetApplication(s.ProjectID(footen), &catalogv3.GetApplicationRequest{ApplicationName: "bar", Version: "v0.2.1"})
+	s.NoError(err)
+	app := resp.Application
+	original := app.Profiles[0].ChartValues
+	app.Profiles[0].ChartValues = "broken: true\n"
+	_, err = s.client.UpdateApplication(s.ProjectID(footen), &catalogv3.UpdateApplicationRequest{
+		ApplicationName: app.Name, Version: app.Version, Application: app,
+	})
+	s.NoError(err)
+
+	revisions := s.listRevisions("application", "bar", "v0.2.1")
+	s.Len(revisions, 2)
+
+	restored, err := s.client.RestoreRevision(s.ProjectID(footen), &catalogv3.RestoreRevisionRequest{
+		ResourceType: "application", Name: "bar", Version: "v0.2.1", Revision: 1,
+	})
+	s.NoError(err)
+	s.Equal(uint32(3), restored.Revision.Revision)
+
+	resp, err = s.client.GetApplication(s.ProjectID(footen), &catalogv3.GetApplicationRequest{ApplicationName: "bar", Version: "v0.2.1"})
+	s.NoError(err)
+	for _, p := range resp.Application.Profiles {
+		if p.Name == app.Profiles[0].Name {
+			s.Equal(original, p.ChartValues)
+		}
+	}
+}
+
+func (s *NorthBoundTestSuite) countRevisions(name string, version string) int {
+	count, err := s.dbClient.Revision.Query().Where(revision.Name(name), revision.Version(version)).Count(s.ctx)
+	s.NoError(err)
+	return count
+}
+
+// Records a revision of the given deployment package directly, as if left behind by its deletion.
+func (s *NorthBoundTestSuite) leaveRevision(name string, version string) {
+	err := s.dbClient.Revision.Create().
+		SetProjectUUID(footen).
+		SetResourceType(string(errors.DeploymentPackageType)).
+		SetName(name).
+		SetVersion(version).
+		SetRevision(1).
+		SetSnapshot("{}").
+		Exec(s.ctx)
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) TestDeletedRevisions() {
+	s.createDeploymentPkg(footen, "revised", "0.1.0", "foo:v0.1.0")
+	s.updatePackageDescription("revised", "0.1.0", "First edit")
+	s.Equal(2, s.countRevisions("revised", "0.1.0"))
+
+	// The history of a deleted package is neither kept with it in the trash nor inherited by a package created anew
+	_, err := s.client.DeleteDeploymentPackage(s.ProjectID(footen), &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: "revised", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.Zero(s.countRevisions("revised", "0.1.0"))
+
+	// Revisions left behind are deleted when the package is purged, unless it was created anew in the meantime
+	s.leaveRevision("revised", "0.1.0")
+	s.createDeploymentPkg(footen, "revised", "0.1.0", "foo:v0.1.0")
+	_, err = s.client.PurgeDeploymentPackage(s.ProjectID(footen), &catalogv3.PurgeDeploymentPackageRequest{
+		DeploymentPackageName: "revised", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.Equal(1, s.countRevisions("revised", "0.1.0"))
+
+	_, err = s.client.DeleteDeploymentPackage(s.ProjectID(footen), &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: "revised", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.leaveRevision("revised", "0.1.0")
+	_, err = s.client.PurgeDeploymentPackage(s.ProjectID(footen), &catalogv3.PurgeDeploymentPackageRequest{
+		DeploymentPackageName: "revised", Version: "0.1.0",
+	})
+	s.NoError(err)
+	s.Zero(s.countRevisions("revised", "0.1.0"))
+}
+
+func TestForUpdate(t *testing.T) {
+	s := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table("applications"))
+	forUpdate(s)
+	query, _ := s.Query()
+	assert.Contains(t, query, "FOR UPDATE")
+
+	s = entsql.Dialect(dialect.SQLite).Select("*").From(entsql.Table("applications"))
+	forUpdate(s)
+	query, _ = s.Query()
+	assert.NotContains(t, query, "FOR UPDATE")
+}
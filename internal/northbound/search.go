@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* SearchCatalog finds the applications, deployment packages and registries whose words begin with each of the words
+ * searched for. On Postgres, the entities are matched by search vectors that the database derives from their columns,
+ * weighted by relevance, and ranked by ts_rank; the vectors are backed by GIN indexes. The descriptions of application
+ * profiles and the labels of UI extensions are gathered into the search text of their application or deployment
+ * package when it is written, so that the vectors need not span several tables. Other databases, such as the one used
+ * by the tests, match the columns by LIKE and rank the matches by the same weights.
+ */
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+)
+
+const (
+	maxSearchPageSize = 100
+	maxSearchTerms    = 10
+
+	// Weights of the fields, as applied by ts_rank to the weights A, B and C of the search vectors
+	primaryWeight   = 1.0
+	secondaryWeight = 0.4
+	tertiaryWeight  = 0.2
+)
+
+// SearchText returns the text by which an entity is searched beyond its own columns, composed of the given texts.
+func SearchText(texts ...string) string {
+	nonEmpty := make([]string, 0, len(texts))
+	for _, text := range texts {
+		if text != "" {
+			nonEmpty = append(nonEmpty, text)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// Returns the search text of the given application; the descriptions of its profiles.
+func applicationSearchText(app *catalogv3.Application) string {
+	texts := make([]string, 0, len(app.Profiles))
+	for _, p := range app.Profiles {
+		texts = append(texts, p.Description)
+	}
+	return SearchText(texts...)
+}
+
+// Returns the search text of the given deployment package; the labels of its UI extensions.
+func deploymentPackageSearchText(pkg *catalogv3.DeploymentPackage) string {
+	texts := make([]string, 0, len(pkg.Extensions))
+	for _, e := range pkg.Extensions {
+		texts = append(texts, e.GetUiExtension().GetLabel())
+	}
+	return SearchText(texts...)
+}
+
+// catalogSearch is a search for the given terms, each of which must begin a word of the matching entities.
+type catalogSearch struct {
+	terms []string
+	limit int
+
+	// Whether the database ranked the matches; set as the queries are built
+	ranked bool
+}
+
+// searchField is a field of a matching entity, along with the weight of the matches within its values.
+type searchField struct {
+	name   string
+	weight float64
+	values []string
+}
+
+// searchMatch is an entity matching the search, along with its score where the database did not rank it.
+type searchMatch struct {
+	result *catalogv3.SearchResult
+	score  float64
+}
+
+// Returns the distinct, lowercase words of the given query.
+func searchTerms(query string) []string {
+	terms := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(query), isNotWordRune) {
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// Returns the predicate matching the entities whose search vector, or else one of the given columns, matches all
+// the terms.
+func (c *catalogSearch) where(columns ...string) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		if s.Dialect() == dialect.Postgres {
+			c.ranked = true
+			s.Where(entsql.P(func(b *entsql.Builder) {
+				b.WriteString(s.C("search_vector") + " @@ to_tsquery('simple', ").Arg(c.tsQuery()).WriteString(")")
+			}))
+			return
+		}
+		for _, term := range c.terms {
+			ors := make([]*entsql.Predicate, 0, len(columns))
+			for _, column := range columns {
+				ors = append(ors, comparePredicate("LOWER("+pageColumnExpr(s, column)+")", "LIKE", "%"+term+"%"))
+			}
+			s.Where(entsql.Or(ors...))
+		}
+	}
+}
+
+// Returns the option ordering the matches by rank and limiting them to the page, where the database ranks them.
+func (c *catalogSearch) rankedPage() func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		if s.Dialect() != dialect.Postgres {
+			return
+		}
+		// Rendered along with the selector, so that its placeholder follows those of the predicates
+		s.OrderExpr(entsql.ExprFunc(func(b *entsql.Builder) {
+			b.WriteString("ts_rank(" + s.C("search_vector") + ", to_tsquery('simple', ").Arg(c.tsQuery()).WriteString(")) DESC")
+		}))
+		s.OrderBy(s.C("id"))
+		s.Limit(c.limit)
+	}
+}
+
+// Returns the text search query matching the words that begin with each of the terms.
+func (c *catalogSearch) tsQuery() string {
+	prefixes := make([]string, 0, len(c.terms))
+	for _, term := range c.terms {
+		prefixes = append(prefixes, term+":*")
+	}
+	return strings.Join(prefixes, " & ")
+}
+
+// Returns the given text with the words that begin with any of the terms enclosed in <b> and </b>, and whether
+// there are any such words. The terms found are recorded in the given set.
+func (c *catalogSearch) highlight(text string, found map[string]bool) (string, bool) {
+	var fragment strings.Builder
+	highlighted := false
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		if isNotWordRune(runes[i]) {
+			fragment.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && !isNotWordRune(runes[j]) {
+			j++
+		}
+		word := string(runes[i:j])
+		if c.recordTerms(strings.ToLower(word), found) {
+			fragment.WriteString("<b>" + word + "</b>")
+			highlighted = true
+		} else {
+			fragment.WriteString(word)
+		}
+		i = j
+	}
+	return fragment.String(), highlighted
+}
+
+// Records the terms with which the given lowercase word begins in the given set, and returns whether there are any.
+func (c *catalogSearch) recordTerms(word string, found map[string]bool) bool {
+	matches := false
+	for _, term := range c.terms {
+		if strings.HasPrefix(word, term) {
+			found[term] = true
+			matches = true
+		}
+	}
+	return matches
+}
+
+// Returns the match of the entity with the given fields, highlighting the matching values and scoring them by the
+// weights of their fields, or nil if not all terms begin a word of the entity.
+func (c *catalogSearch) match(name string, version string, displayName string, fields ...searchField) *searchMatch {
+	m := &searchMatch{result: &catalogv3.SearchResult{
+		Name: name, Version: version, DisplayName: displayName, Highlights: make([]*catalogv3.SearchHighlight, 0),
+	}}
+	found := make(map[string]bool, len(c.terms))
+	for _, field := range fields {
+		for _, value := range field.values {
+			if fragment, ok := c.highlight(value, found); ok {
+				m.result.Highlights = append(m.result.Highlights, &catalogv3.SearchHighlight{Field: field.name, Fragment: fragment})
+				m.score += field.weight
+			}
+		}
+	}
+	// LIKE also matches within words, which the search vectors do not
+	if !c.ranked && len(found) < len(c.terms) {
+		return nil
+	}
+	return m
+}
+
+// Returns the results of the given matches, most relevant first, limited to the page.
+func (c *catalogSearch) results(all []*searchMatch) []*catalogv3.SearchResult {
+	matches := make([]*searchMatch, 0, len(all))
+	for _, m := range all {
+		if m != nil {
+			matches = append(matches, m)
+		}
+	}
+	if !c.ranked {
+		sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+		if len(matches) > c.limit {
+			matches = matches[:c.limit]
+		}
+	}
+	results := make([]*catalogv3.SearchResult, 0, len(matches))
+	for _, m := range matches {
+		results = append(results, m.result)
+	}
+	return results
+}
+
+// SearchCatalog searches the applications, deployment packages and registries through gRPC
+func (g *Server) SearchCatalog(ctx context.Context, req *catalogv3.SearchCatalogRequest) (*catalogv3.SearchCatalogResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.Query == "" {
+		return nil, errors.NewInvalidArgument(errors.WithMessage("incomplete request"))
+	} else if req.PageSize < 0 || req.PageSize > maxSearchPageSize {
+		return nil, errors.NewInvalidArgument(errors.WithMessage("pageSize must be between 0 and %d", maxSearchPageSize))
+	}
+	search := &catalogSearch{terms: searchTerms(req.Query), limit: int(req.PageSize)}
+	if search.limit == 0 {
+		search.limit = DefaultPageSize
+	}
+	if len(search.terms) == 0 || len(search.terms) > maxSearchTerms {
+		return nil, errors.NewInvalidArgument(errors.WithMessage("query must contain between 1 and %d words", maxSearchTerms))
+	}
+
+	if err = g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	resp, err := g.searchCatalog(ctx, tx, projectUUID, search)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	if err = g.commitTransaction(tx); err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return resp, nil
+}
+
+func (g *Server) searchCatalog(ctx context.Context, tx *generated.Tx, projectUUID string, search *catalogSearch) (*catalogv3.SearchCatalogResponse, error) {
+	appsDB, err := tx.Application.Query().
+		Where(application.ProjectUUID(projectUUID)).
+		Where(search.where(application.FieldName, application.FieldDisplayName, application.FieldDescription,
+			application.FieldChartName, application.FieldSearchText)).
+		Order(search.rankedPage()).
+		WithProfiles().
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	appMatches := make([]*searchMatch, 0, len(appsDB))
+	for _, appDB := range appsDB {
+		profiles := make([]string, 0, len(appDB.Edges.Profiles))
+		for _, p := range appDB.Edges.Profiles {
+			profiles = append(profiles, p.Description)
+		}
+		appMatches = append(appMatches, search.match(appDB.Name, appDB.Version, appDB.DisplayName,
+			searchField{name: "name", weight: primaryWeight, values: []string{appDB.Name}},
+			searchField{name: "displayName", weight: primaryWeight, values: []string{appDB.DisplayName}},
+			searchField{name: "description", weight: secondaryWeight, values: []string{appDB.Description}},
+			searchField{name: "chartName", weight: secondaryWeight, values: []string{appDB.ChartName}},
+			searchField{name: "profiles.description", weight: tertiaryWeight, values: profiles}))
+	}
+
+	pkgsDB, err := tx.DeploymentPackage.Query().
+		Where(deploymentpackage.ProjectUUID(projectUUID)).
+		Where(search.where(deploymentpackage.FieldName, deploymentpackage.FieldDisplayName,
+			deploymentpackage.FieldDescription, deploymentpackage.FieldSearchText)).
+		Order(search.rankedPage()).
+		WithExtensions().
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	pkgMatches := make([]*searchMatch, 0, len(pkgsDB))
+	for _, pkgDB := range pkgsDB {
+		labels := make([]string, 0, len(pkgDB.Edges.Extensions))
+		for _, e := range pkgDB.Edges.Extensions {
+			labels = append(labels, e.UILabel)
+		}
+		pkgMatches = append(pkgMatches, search.match(pkgDB.Name, pkgDB.Version, pkgDB.DisplayName,
+			searchField{name: "name", weight: primaryWeight, values: []string{pkgDB.Name}},
+			searchField{name: "displayName", weight: primaryWeight, values: []string{pkgDB.DisplayName}},
+			searchField{name: "description", weight: secondaryWeight, values: []string{pkgDB.Description}},
+			searchField{name: "extensions.label", weight: tertiaryWeight, values: labels}))
+	}
+
+	registriesDB, err := tx.Registry.Query().
+		Where(registry.ProjectUUID(projectUUID)).
+		Where(search.where(registry.FieldName, registry.FieldDisplayName, registry.FieldDescription)).
+		Order(search.rankedPage()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	registryMatches := make([]*searchMatch, 0, len(registriesDB))
+	for _, registryDB := range registriesDB {
+		registryMatches = append(registryMatches, search.match(registryDB.Name, "", registryDB.DisplayName,
+			searchField{name: "name", weight: primaryWeight, values: []string{registryDB.Name}},
+			searchField{name: "displayName", weight: primaryWeight, values: []string{registryDB.DisplayName}},
+			searchField{name: "description", weight: secondaryWeight, values: []string{registryDB.Description}}))
+	}
+
+	return &catalogv3.SearchCatalogResponse{
+		Applications:       search.results(appMatches),
+		DeploymentPackages: search.results(pkgMatches),
+		Registries:         search.results(registryMatches),
+	}, nil
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchHighlight(t *testing.T) {
+	search := &catalogSearch{terms: searchTerms("Wid, GIZ wid")}
+	assert.Equal(t, []string{"wid", "giz"}, search.terms)
+	assert.Equal(t, "wid:* & giz:*", search.tsQuery())
+
+	found := make(map[string]bool)
+	fragment, ok := search.highlight("A Widget-based gizmo, not a midwidget", found)
+	assert.True(t, ok)
+	assert.Equal(t, "A <b>Widget</b>-based <b>gizmo</b>, not a midwidget", fragment)
+	assert.Equal(t, map[string]bool{"wid": true, "giz": true}, found)
+
+	_, ok = search.highlight("nothing to see", found)
+	assert.False(t, ok)
+}
+
+func TestSearchPostgresQuery(t *testing.T) {
+	search := &catalogSearch{terms: []string{"foo", "bar"}, limit: 5}
+	s := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table("applications"))
+	search.where("name")(s)
+	search.rankedPage()(s)
+	query, args := s.Query()
+	assert.True(t, search.ranked)
+	assert.Contains(t, query, `"applications"."search_vector" @@ to_tsquery('simple', $1)`)
+	assert.Contains(t, query, `ORDER BY ts_rank("applications"."search_vector", to_tsquery('simple', $2)) DESC`)
+	assert.Contains(t, query, "LIMIT 5")
+	assert.Equal(t, []any{"foo:* & bar:*", "foo:* & bar:*"}, args)
+}
+
+// Checks the queries of the search as run on Postgres, where the placeholders of the search vector predicate and of
+// the ranking follow those of the project predicate.
+func TestSearchPostgresCatalogQueries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	client := generated.NewClient(generated.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	ctx := context.Background()
+
+	query := `FROM "{table}" WHERE "{table}"."project_uuid" = \$1 AND "{table}"."search_vector" @@ to_tsquery\('simple', \$2\) ` +
+		`ORDER BY ts_rank\("{table}"."search_vector", to_tsquery\('simple', \$3\)\) DESC, "{table}"."id" LIMIT 5`
+	mock.ExpectBegin()
+	for _, table := range []string{"applications", "deployment_packages", "registries"} {
+		mock.ExpectQuery(strings.ReplaceAll(query, "{table}", table)).
+			WithArgs(footen, "wid:*", "wid:*").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+	mock.ExpectRollback()
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	search := &catalogSearch{terms: searchTerms("wid"), limit: 5}
+	resp, err := (&Server{}).searchCatalog(ctx, tx, footen, search)
+	require.NoError(t, err)
+	assert.True(t, search.ranked)
+	assert.Empty(t, resp.Applications)
+	assert.NoError(t, tx.Rollback())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func (s *NorthBoundTestSuite) TestSearchCatalog() {
+	ctx := s.ProjectID(footen)
+	_, err := s.client.CreateApplication(ctx, &catalogv3.CreateApplicationRequest{Application: &catalogv3.Application{
+		Name: "searchable", Version: "1.0.0", DisplayName: "Searchable Widget", Description: "Shows what is searched",
+		ChartName: "widget-chart", ChartVersion: "1.0.0", HelmRegistryName: fooreg,
+		Profiles:           []*catalogv3.Profile{{Name: "p1", Description: "Tuned for gizmo clusters"}},
+		DefaultProfileName: "p1",
+	}})
+	s.NoError(err)
+	s.createApp(footen, fooreg, "unrelated", "1.0.0", 1)
+	_, err = s.client.CreateApplication(ctx, &catalogv3.CreateApplicationRequest{Application: &catalogv3.Application{
+		Name: "mentions", Version: "1.0.0", Description: "Works with any widget",
+		ChartName: "mentions", ChartVersion: "1.0.0", HelmRegistryName: fooreg,
+	}})
+	s.NoError(err)
+	_, err = s.client.CreateDeploymentPackage(ctx, &catalogv3.CreateDeploymentPackageRequest{DeploymentPackage: &catalogv3.DeploymentPackage{
+		Name: "dashboards", Version: "0.1.0", ApplicationReferences: appReferences("searchable:1.0.0"),
+		Extensions: []*catalogv3.APIExtension{{
+			Name: "ext", Version: "0.1.0",
+			UiExtension: &catalogv3.UIExtension{Label: "Gizmo Dashboard", Description: "Dashboard of the gadgets", ServiceName: "svc",
+				FileName: "index.html", AppName: "app", ModuleName: "module"},
+		}},
+	}})
+	s.NoError(err)
+
+	highlights := func(result *catalogv3.SearchResult) map[string]string {
+		fragments := make(map[string]string)
+		for _, h := range result.Highlights {
+			fragments[h.Field] = h.Fragment
+		}
+		return fragments
+	}
+
+	// Matches are grouped by type and ranked by the fields in which they are found
+	resp, err := s.client.SearchCatalog(ctx, &catalogv3.SearchCatalogRequest{Query: "widget"})
+	s.NoError(err)
+	if s.Len(resp.Applications, 2) {
+		s.Equal("searchable", resp.Applications[0].Name)
+		s.Equal("1.0.0", resp.Applications[0].Version)
+		s.Equal("Searchable Widget", resp.Applications[0].DisplayName)
+		s.Equal(map[string]string{"displayName": "Searchable <b>Widget</b>", "chartName": "<b>widget</b>-chart"},
+			highlights(resp.Applications[0]))
+		s.Equal("mentions", resp.Applications[1].Name)
+		s.Equal(map[string]string{"description": "Works with any <b>widget</b>"}, highlights(resp.Applications[1]))
+	}
+	s.Empty(resp.DeploymentPackages)
+	s.Empty(resp.Registries)
+
+	// Profile descriptions and extension labels are searched, by the beginnings of their words
+	resp, err = s.client.SearchCatalog(ctx, &catalogv3.SearchCatalogRequest{Query: "giz"})
+	s.NoError(err)
+	if s.Len(resp.Applications, 1) {
+		s.Equal(map[string]string{"profiles.description": "Tuned for <b>gizmo</b> clusters"}, highlights(resp.Applications[0]))
+	}
+	if s.Len(resp.DeploymentPackages, 1) {
+		s.Equal("dashboards", resp.DeploymentPackages[0].Name)
+		s.Equal(map[string]string{"extensions.label": "<b>Gizmo</b> Dashboard"}, highlights(resp.DeploymentPackages[0]))
+	}
+
+	resp, err = s.client.SearchCatalog(ctx, &catalogv3.SearchCatalogRequest{Query: "idget"})
+	s.NoError(err)
+	s.Empty(resp.Applications)
+
+	// All words must match
+	resp, err = s.client.SearchCatalog(ctx, &catalogv3.SearchCatalogRequest{Query: "widget gizmo"})
+	s.NoError(err)
+	if s.Len(resp.Applications, 1) {
+		s.Equal("searchable", resp.Applications[0].Name)
+	}
+
+	resp, err = s.client.SearchCatalog(ctx, &catalogv3.SearchCatalogRequest{Query: "registry fooregalt"})
+	s.NoError(err)
+	if s.Len(resp.Registries, 1) {
+		s.Equal(fooregalt, resp.Registries[0].Name)
+		s.Empty(resp.Registries[0].Version)
+	}
+
+	resp, err = s.client.SearchCatalog(ctx, &catalogv3.SearchCatalogRequest{Query: "widget", PageSize: 1})
+	s.NoError(err)
+	if s.Len(resp.Applications, 1) {
+		s.Equal("searchable", resp.Applications[0].Name)
+	}
+
+	// Other projects are not searched
+	resp, err = s.client.SearchCatalog(s.ProjectID(barten), &catalogv3.SearchCatalogRequest{Query: "widget"})
+	s.NoError(err)
+	s.Empty(resp.Applications)
+
+	for _, req := range []*catalogv3.SearchCatalogRequest{
+		{},
+		{Query: "?!"},
+		{Query: "widget", PageSize: 101},
+		{Query: "widget", PageSize: -1},
+		{Query: "a b c d e f g h i j k"},
+	} {
+		_, err = s.client.SearchCatalog(ctx, req)
+		s.Equal(codes.InvalidArgument, status.Code(err), req.Query)
+	}
+}
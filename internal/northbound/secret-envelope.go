@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Registry secret data is sealed in an envelope: it is encrypted with AES-GCM under a random data key, which is
+// itself encrypted under a key of the key ring. Envelopes read
+//
+//	enc:v2:<key ID>:<encrypted data key>:<encrypted secret data>
+//
+// where both encrypted parts are base64 encoded and prefixed with their nonce. Rotating the keys of the ring only
+// takes encrypting the data keys again. The secret data is bound to the registry or webhook it belongs to by
+// additional data, so that an envelope copied over to another one fails to open.
+const envelopePrefix = "enc:"
+
+const envelopeVersion = "v2"
+
+const secretKeySize = 32 // AES-256
+
+var secretKeyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// SecretKeyRing holds the keys encrypting the data keys of the envelopes. The active key seals new envelopes,
+// while the others are only kept to open the envelopes sealed before the keys were rotated.
+type SecretKeyRing struct {
+	activeID string
+	keys     map[string]cipher.AEAD
+}
+
+// Key ring of the envelopes, if the registry secret data is encrypted.
+var secretKeys *SecretKeyRing
+
+// LoadSecretKeyRing loads the key ring from a file, typically mounted from a Kubernetes secret. Each line of the
+// file holds a key ID and a base64 encoded 256-bit key, separated by a colon; empty lines and lines starting with #
+// are ignored. The first key is the active one. Keys are rotated by prepending a new key, and the previous keys
+// may be removed once the secret data has been encrypted again when the catalog restarts.
+func LoadSecretKeyRing(path string) (*SecretKeyRing, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secrets key file: %w", err)
+	}
+	return parseSecretKeyRing(data)
+}
+
+func parseSecretKeyRing(data []byte) (*SecretKeyRing, error) {
+	ring := &SecretKeyRing{keys: make(map[string]cipher.AEAD)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		id, encodedKey, ok := strings.Cut(text, ":")
+		if !ok || !secretKeyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid key ID on line %d of secrets key file", line)
+		} else if _, ok = ring.keys[id]; ok {
+			return nil, fmt.Errorf("duplicate key ID %s in secrets key file", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil || len(key) != secretKeySize {
+			return nil, fmt.Errorf("key %s of secrets key file is not a base64 encoded %d-bit key", id, secretKeySize*8)
+		}
+		if ring.keys[id], err = newGCM(key); err != nil {
+			return nil, err
+		}
+		if ring.activeID == "" {
+			ring.activeID = id
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	} else if ring.activeID == "" {
+		return nil, fmt.Errorf("no key in secrets key file")
+	}
+	return ring, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypts the plaintext, prefixing it with the nonce. The additional data binds the ciphertext to its context.
+func sealBytes(aead cipher.AEAD, plaintext []byte, additionalData []byte) []byte {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	_, _ = rand.Read(nonce)
+	return aead.Seal(nonce, nonce, plaintext, additionalData)
+}
+
+func openBytes(aead cipher.AEAD, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("truncated ciphertext")
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], additionalData)
+}
+
+// Returns the prefix of the envelopes sealed under the active key.
+func (k *SecretKeyRing) activePrefix() string {
+	return envelopePrefix + envelopeVersion + ":" + k.activeID + ":"
+}
+
+// Seals the secret data in an envelope under the active key, bound to the given additional data.
+func (k *SecretKeyRing) seal(plaintext string, additionalData string) string {
+	dataKey := make([]byte, secretKeySize)
+	_, _ = rand.Read(dataKey)
+	dataAEAD, _ := newGCM(dataKey)
+
+	encryptedKey := sealBytes(k.keys[k.activeID], dataKey, []byte(k.activeID))
+	encryptedData := sealBytes(dataAEAD, []byte(plaintext), []byte(additionalData))
+	return k.activePrefix() +
+		base64.RawURLEncoding.EncodeToString(encryptedKey) + ":" + base64.RawURLEncoding.EncodeToString(encryptedData)
+}
+
+// Splits the envelope and decrypts its data key, returning it along with the encoded secret data.
+func (k *SecretKeyRing) openDataKey(envelope string) ([]byte, string, error) {
+	parts := strings.Split(strings.TrimPrefix(envelope, envelopePrefix), ":")
+	if len(parts) != 4 {
+		return nil, "", fmt.Errorf("malformed secret envelope")
+	}
+	version, keyID := parts[0], parts[1]
+	if version != envelopeVersion {
+		return nil, "", fmt.Errorf("unsupported secret envelope version %s", version)
+	}
+	keyAEAD, ok := k.keys[keyID]
+	if !ok {
+		return nil, "", fmt.Errorf("secret data is encrypted with unknown key %s", keyID)
+	}
+	encryptedKey, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, "", fmt.Errorf("malformed secret envelope: %w", err)
+	}
+	dataKey, err := openBytes(keyAEAD, encryptedKey, []byte(keyID))
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to decrypt data key with key %s: %w", keyID, err)
+	}
+	return dataKey, parts[3], nil
+}
+
+// Opens the envelope, returning the secret data, which must be bound to the given additional data.
+func (k *SecretKeyRing) open(envelope string, additionalData string) (string, error) {
+	dataKey, encodedData, err := k.openDataKey(envelope)
+	if err != nil {
+		return "", err
+	}
+	encryptedData, err := base64.RawURLEncoding.DecodeString(encodedData)
+	if err != nil {
+		return "", fmt.Errorf("malformed secret envelope: %w", err)
+	}
+	dataAEAD, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := openBytes(dataAEAD, encryptedData, []byte(additionalData))
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt secret data: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// Encrypts the data key of the envelope again under the active key, leaving the encrypted secret data as is.
+func (k *SecretKeyRing) rewrap(envelope string) (string, error) {
+	dataKey, encodedData, err := k.openDataKey(envelope)
+	if err != nil {
+		return "", err
+	}
+	encryptedKey := sealBytes(k.keys[k.activeID], dataKey, []byte(k.activeID))
+	return k.activePrefix() + base64.RawURLEncoding.EncodeToString(encryptedKey) + ":" + encodedData, nil
+}
+
+// Codec sealing the base64 encoded registry secret data in envelopes bound to the secret path of the registry.
+// Unsealed secret data, stored before it was encrypted, is still decoded until it is encrypted again.
+type envelopeStrings struct {
+	base64Strings
+	keys *SecretKeyRing
+}
+
+func (e *envelopeStrings) EncodeBase64(r registrySecretData, secretPath string) string {
+	return e.keys.seal(e.base64Strings.EncodeBase64(r, secretPath), secretPath)
+}
+
+func (e *envelopeStrings) DecodeBase64(r *registrySecretData, encodedData string, secretPath string) error {
+	if strings.HasPrefix(encodedData, envelopePrefix) {
+		var err error
+		if encodedData, err = e.keys.open(encodedData, secretPath); err != nil {
+			return err
+		}
+	}
+	return e.base64Strings.DecodeBase64(r, encodedData, secretPath)
+}
+
+func newEnvelope() Base64Strings {
+	return &envelopeStrings{keys: secretKeys}
+}
+
+// SecretsEncrypted returns true if the registry secret data is encrypted.
+func SecretsEncrypted() bool {
+	return secretKeys != nil
+}
+
+// ReencryptSecretData returns the given encoded secret data of the registry with the given secret path sealed under
+// the active key, and whether it differs from the given data, i.e. whether it was either unencrypted or sealed under
+// another key.
+func ReencryptSecretData(encodedData string, secretPath string) (string, bool, error) {
+	if secretKeys != nil && encodedData != "" && !strings.HasPrefix(encodedData, envelopePrefix) {
+		// Check the unencrypted data is valid before sealing it
+		if err := newBase64().DecodeBase64(&registrySecretData{}, encodedData, secretPath); err != nil {
+			return "", false, err
+		}
+	}
+	return ReencryptSecret(encodedData, secretPath)
+}
+
+// ReencryptSecret returns the given stored secret sealed under the active key and bound to the given additional
+// data, and whether it differs from the given secret, i.e. whether it was either unencrypted or sealed under another
+// key.
+func ReencryptSecret(secret string, additionalData string) (string, bool, error) {
+	switch {
+	case secretKeys == nil || secret == "":
+		return secret, false, nil
+	case !strings.HasPrefix(secret, envelopePrefix):
+		return secretKeys.seal(secret, additionalData), true, nil
+	case strings.HasPrefix(secret, secretKeys.activePrefix()):
+		return secret, false, nil
+	default:
+		rewrapped, err := secretKeys.rewrap(secret)
+		return rewrapped, err == nil, err
+	}
+}
+
+// WebhookSecretContext returns the additional data binding the sealed secret of a webhook to the webhook.
+func WebhookSecretContext(projectUUID string, name string) string {
+	return "webhook:" + MakeSecretPath(projectUUID, name)
+}
+
+// Returns the given secret sealed in an envelope bound to the given additional data for storage, or as is if
+// secrets are not encrypted.
+func sealSecret(secret string, additionalData string) string {
+	if secretKeys == nil {
+		return secret
+	}
+	return secretKeys.seal(secret, additionalData)
+}
+
+// Returns the stored secret, opening its envelope if it is sealed in one.
+func openSecret(stored string, additionalData string) (string, error) {
+	if !strings.HasPrefix(stored, envelopePrefix) {
+		return stored, nil
+	} else if secretKeys == nil {
+		return "", fmt.Errorf("secret is encrypted but no secrets key file is configured")
+	}
+	return secretKeys.open(stored, additionalData)
+}
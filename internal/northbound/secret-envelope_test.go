@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T, id string) string {
+	key := make([]byte, secretKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return fmt.Sprintf("%s:%s\n", id, base64.StdEncoding.EncodeToString(key))
+}
+
+// Installs the key ring parsed from the given keys for the duration of the test.
+func useTestKeys(t *testing.T, keys ...string) {
+	ring, err := parseSecretKeyRing([]byte(strings.Join(keys, "")))
+	require.NoError(t, err)
+	saveSecretKeys, saveBase64Factory := secretKeys, Base64Factory
+	t.Cleanup(func() { secretKeys, Base64Factory = saveSecretKeys, saveBase64Factory })
+	secretKeys, Base64Factory = ring, newEnvelope
+}
+
+func TestParseSecretKeyRing(t *testing.T) {
+	key1, key2 := newTestKey(t, "key-1"), newTestKey(t, "key.2")
+	ring, err := parseSecretKeyRing([]byte("# keys\n\n" + key2 + key1))
+	require.NoError(t, err)
+	assert.Equal(t, "key.2", ring.activeID)
+	assert.Len(t, ring.keys, 2)
+
+	_, err = parseSecretKeyRing([]byte("# no keys\n"))
+	assert.ErrorContains(t, err, "no key")
+	_, err = parseSecretKeyRing([]byte(key1 + key1))
+	assert.ErrorContains(t, err, "duplicate key ID key-1")
+	_, err = parseSecretKeyRing([]byte("key 1:" + strings.SplitN(key1, ":", 2)[1]))
+	assert.ErrorContains(t, err, "invalid key ID on line 1")
+	_, err = parseSecretKeyRing([]byte("key1:" + base64.StdEncoding.EncodeToString([]byte("short"))))
+	assert.ErrorContains(t, err, "256-bit key")
+	_, err = LoadSecretKeyRing(t.TempDir() + "/missing")
+	assert.ErrorContains(t, err, "unable to read secrets key file")
+}
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	useTestKeys(t, newTestKey(t, "k1"))
+	rsd := registrySecretData{RootURL: "https://registry.example.com", Username: "user", AuthToken: "token"}
+	path := MakeSecretPath("c0ffee", "registry")
+
+	encoded := Base64Factory().EncodeBase64(rsd, path)
+	assert.True(t, strings.HasPrefix(encoded, "enc:v2:k1:"))
+	assert.NotContains(t, encoded, base64.StdEncoding.EncodeToString([]byte("token")))
+	assert.NotEqual(t, encoded, Base64Factory().EncodeBase64(rsd, path), "envelopes must use fresh data keys")
+
+	decoded := registrySecretData{}
+	require.NoError(t, Base64Factory().DecodeBase64(&decoded, encoded, path))
+	assert.Equal(t, rsd, decoded)
+
+	// Secret data stored before it was encrypted is still readable
+	decoded = registrySecretData{}
+	require.NoError(t, Base64Factory().DecodeBase64(&decoded, newBase64().EncodeBase64(rsd, path), path))
+	assert.Equal(t, rsd, decoded)
+
+	// Envelopes are bound to their registry
+	assert.ErrorContains(t, Base64Factory().DecodeBase64(&registrySecretData{}, encoded, MakeSecretPath("c0ffee", "other")),
+		"unable to decrypt secret data")
+	assert.ErrorContains(t, Base64Factory().DecodeBase64(&registrySecretData{}, encoded, MakeSecretPath("deadbeef", "registry")),
+		"unable to decrypt secret data")
+
+	// Tampering with the envelope is detected
+	tampered := encoded[:len(encoded)-2] + "AA"
+	assert.Error(t, Base64Factory().DecodeBase64(&registrySecretData{}, tampered, path))
+	assert.ErrorContains(t, Base64Factory().DecodeBase64(&registrySecretData{}, "enc:v2:k1:abc", path), "malformed")
+	assert.ErrorContains(t, Base64Factory().DecodeBase64(&registrySecretData{}, "enc:v3:k1:abc:abc", path), "unsupported")
+
+	// Envelopes are not mistaken for base64 when no key file is configured
+	assert.ErrorContains(t, newBase64().DecodeBase64(&registrySecretData{}, encoded, path), "no secrets key file")
+}
+
+func TestReencryptSecretData(t *testing.T) {
+	key1, key2 := newTestKey(t, "k1"), newTestKey(t, "k2")
+	rsd := registrySecretData{RootURL: "https://registry.example.com", AuthToken: "token"}
+	path := MakeSecretPath("c0ffee", "registry")
+	legacy := newBase64().EncodeBase64(rsd, path)
+
+	// Nothing is encrypted without keys
+	saveSecretKeys := secretKeys
+	secretKeys = nil
+	unchanged, changed, err := ReencryptSecretData(legacy, path)
+	secretKeys = saveSecretKeys
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, legacy, unchanged)
+
+	useTestKeys(t, key1)
+	sealed1, changed, err := ReencryptSecretData(legacy, path)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, strings.HasPrefix(sealed1, "enc:v2:k1:"))
+	_, changed, err = ReencryptSecretData(sealed1, path)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	_, _, err = ReencryptSecretData("this is not Base64", path)
+	assert.Error(t, err)
+
+	// Rotating the keys encrypts the data key again, leaving the secret data as is
+	useTestKeys(t, key2, key1)
+	sealed2, changed, err := ReencryptSecretData(sealed1, path)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, strings.HasPrefix(sealed2, "enc:v2:k2:"))
+	assert.Equal(t, sealed1[strings.LastIndex(sealed1, ":"):], sealed2[strings.LastIndex(sealed2, ":"):])
+
+	// Once the previous key is removed, only the data encrypted again is readable
+	useTestKeys(t, key2)
+	decoded := registrySecretData{}
+	require.NoError(t, Base64Factory().DecodeBase64(&decoded, sealed2, path))
+	assert.Equal(t, rsd, decoded)
+	assert.ErrorContains(t, Base64Factory().DecodeBase64(&decoded, sealed1, path), "unknown key k1")
+	_, _, err = ReencryptSecretData(sealed1, path)
+	assert.ErrorContains(t, err, "unknown key k1")
+}
+
+func (s *NorthBoundTestSuite) TestRegistryEncryptedSecrets() {
+	useTestKeys(s.T(), newTestKey(s.T(), "k1"))
+
+	_, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{
+		Registry: &catalogv3.Registry{
+			Name:      "encrypted",
+			RootUrl:   "https://registry.example.com",
+			Username:  "user",
+			AuthToken: "secret-token",
+			Type:      helmType,
+		},
+	})
+	s.NoError(err)
+
+	registryDB, err := s.dbClient.Registry.Query().Where(registry.Name("encrypted")).Only(s.ctx)
+	s.NoError(err)
+	s.True(strings.HasPrefix(registryDB.AuthToken, "enc:v2:k1:"))
+
+	resp, err := s.client.GetRegistry(s.ProjectID(footen), &catalogv3.GetRegistryRequest{RegistryName: "encrypted", ShowSensitiveInfo: true})
+	s.NoError(err)
+	s.Equal("https://registry.example.com", resp.Registry.RootUrl)
+	s.Equal("secret-token", resp.Registry.AuthToken)
+}
+
+func TestSealSecret(t *testing.T) {
+	hook := WebhookSecretContext("c0ffee", "hook")
+	assert.Equal(t, "s3cret", sealSecret("s3cret", hook))
+	secret, err := openSecret("s3cret", hook)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cret", secret)
+
+	key1 := newTestKey(t, "k1")
+	useTestKeys(t, key1)
+	sealed := sealSecret("s3cret", hook)
+	assert.True(t, strings.HasPrefix(sealed, "enc:v2:k1:"))
+	secret, err = openSecret(sealed, hook)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cret", secret)
+
+	// Secrets are bound to their webhook, and cannot be passed off as the secret data of a registry
+	_, err = openSecret(sealed, WebhookSecretContext("c0ffee", "other"))
+	assert.Error(t, err)
+	_, err = openSecret(sealed, MakeSecretPath("c0ffee", "hook"))
+	assert.Error(t, err)
+
+	// Secrets stored before they were encrypted are sealed when encrypted again, without being decoded
+	resealed, changed, err := ReencryptSecret("s3cret", hook)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	secret, err = openSecret(resealed, hook)
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cret", secret)
+
+	useTestKeys(t, newTestKey(t, "k2"), key1)
+	rewrapped, changed, err := ReencryptSecret(sealed, hook)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, strings.HasPrefix(rewrapped, "enc:v2:k2:"))
+
+	saveSecretKeys := secretKeys
+	secretKeys = nil
+	_, err = openSecret(sealed, hook)
+	secretKeys = saveSecretKeys
+	assert.ErrorContains(t, err, "no secrets key file")
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// SecretsDir is the directory of the file backend.
+var SecretsDir = ""
+
+// Stores each secret in a file of the directory, readable only by the catalog. Intended for development, where
+// neither Vault nor Kubernetes is at hand.
+type fileSecretService struct {
+	dir string
+}
+
+func newFileSecretService(_ context.Context) (SecretService, error) {
+	if err := os.MkdirAll(SecretsDir, 0o700); err != nil {
+		return nil, err
+	}
+	return &fileSecretService{dir: SecretsDir}, nil
+}
+
+func (f *fileSecretService) fileName(path string) string {
+	return filepath.Join(f.dir, url.PathEscape(path))
+}
+
+func (f *fileSecretService) ReadSecret(_ context.Context, path string) (string, error) {
+	data, err := os.ReadFile(f.fileName(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", errors.New("secret not found")
+	}
+	return string(data), err
+}
+
+// WriteSecret writes the secret to a temporary file first, so that it is replaced atomically.
+func (f *fileSecretService) WriteSecret(_ context.Context, path string, dataBlob string) error {
+	tmp, err := os.CreateTemp(f.dir, ".secret-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err = tmp.WriteString(dataBlob); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.fileName(path))
+}
+
+func (f *fileSecretService) DeleteSecret(_ context.Context, path string) error {
+	err := os.Remove(f.fileName(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (f *fileSecretService) Logout(_ context.Context) {}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	k8sNamespaceFile        = `/var/run/secrets/kubernetes.io/serviceaccount/namespace` // #nosec
+	k8sSecretNamePrefix     = "catalog-registry-"
+	k8sSecretPathAnnotation = "catalog.orchestrator.apis/secret-path"
+	k8sSecretValueKey       = "value"
+)
+
+// SecretsNamespace is the namespace of the Kubernetes secrets; the namespace of the catalog if empty.
+var SecretsNamespace = ""
+
+func newKubernetesClient() (kubernetes.Interface, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+var kubernetesClientFactory = newKubernetesClient
+
+// Stores each secret in a Kubernetes secret, named after a hash of its path, which is kept in an annotation.
+type kubernetesSecretService struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+func newKubernetesSecretService(_ context.Context) (SecretService, error) {
+	client, err := kubernetesClientFactory()
+	if err != nil {
+		return nil, err
+	}
+	namespace := SecretsNamespace
+	if namespace == "" {
+		data, err := os.ReadFile(k8sNamespaceFile)
+		if err != nil {
+			return nil, err
+		}
+		namespace = strings.TrimSpace(string(data))
+	}
+	return &kubernetesSecretService{client: client, namespace: namespace}, nil
+}
+
+// Secret paths contain underscores and may be too long for the name of a Kubernetes secret.
+func k8sSecretName(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return k8sSecretNamePrefix + hex.EncodeToString(sum[:20])
+}
+
+func (k *kubernetesSecretService) ReadSecret(ctx context.Context, path string) (string, error) {
+	secret, err := k.client.CoreV1().Secrets(k.namespace).Get(ctx, k8sSecretName(path), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return "", errors.New("secret not found")
+	} else if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[k8sSecretValueKey]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+	return string(value), nil
+}
+
+func (k *kubernetesSecretService) WriteSecret(ctx context.Context, path string, dataBlob string) error {
+	secrets := k.client.CoreV1().Secrets(k.namespace)
+	secret, err := secrets.Get(ctx, k8sSecretName(path), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        k8sSecretName(path),
+				Namespace:   k.namespace,
+				Labels:      map[string]string{"app.kubernetes.io/managed-by": "app-orch-catalog"},
+				Annotations: map[string]string{k8sSecretPathAnnotation: path},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{k8sSecretValueKey: []byte(dataBlob)},
+		}
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	secret.Data = map[string][]byte{k8sSecretValueKey: []byte(dataBlob)}
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+func (k *kubernetesSecretService) DeleteSecret(ctx context.Context, path string) error {
+	err := k.client.CoreV1().Secrets(k.namespace).Delete(ctx, k8sSecretName(path), metav1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (k *kubernetesSecretService) Logout(_ context.Context) {}
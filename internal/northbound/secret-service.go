@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+)
+
+// Backends storing the secret data of the registries, i.e. their URLs, credentials and CA certificates.
+const (
+	// SecretBackendDatabase keeps the secret data in the auth_token column of the registries.
+	SecretBackendDatabase = "database"
+	// SecretBackendVault keeps the secret data in Vault.
+	SecretBackendVault = "vault"
+	// SecretBackendKubernetes keeps the secret data in Kubernetes secrets of the namespace of the catalog.
+	SecretBackendKubernetes = "kubernetes"
+	// SecretBackendFile keeps the secret data in files of a local directory; intended for development.
+	SecretBackendFile = "file"
+)
+
+// SecretService stores the secret data of the registries outside the database, by the path given by MakeSecretPath.
+type SecretService interface {
+	// ReadSecret returns the secret stored at the path, or an error if there is none.
+	ReadSecret(ctx context.Context, path string) (string, error)
+	// WriteSecret stores the secret at the path, replacing any previous one.
+	WriteSecret(ctx context.Context, path string, secret string) error
+	// DeleteSecret removes the secret stored at the path.
+	DeleteSecret(ctx context.Context, path string) error
+	// Logout releases the session with the backend, if any.
+	Logout(ctx context.Context)
+}
+
+// SecretServiceFactory opens a session with the secret service selected by ConfigureSecrets.
+var SecretServiceFactory = newVaultSecretService
+
+// SecretsConfig selects and configures the backend storing the registry secret data.
+type SecretsConfig struct {
+	// Backend is one of the SecretBackend names; it defaults to vault if UseSecretService is set, or else to the
+	// database.
+	Backend string
+	// KeyFile is the path of the key ring used to encrypt the secret data, whatever the backend. Secret data is
+	// stored unencrypted if not set.
+	KeyFile string
+	// Namespace of the Kubernetes secrets; defaults to the namespace of the catalog.
+	Namespace string
+	// Dir is the directory of the file backend.
+	Dir string
+}
+
+// ConfigureSecrets selects the backend storing the registry secret data and loads the keys encrypting it.
+func ConfigureSecrets(cfg SecretsConfig) error {
+	backend := cfg.Backend
+	if backend == "" {
+		backend = SecretBackendDatabase
+		if UseSecretService {
+			backend = SecretBackendVault
+		}
+	}
+
+	switch backend {
+	case SecretBackendDatabase:
+		UseSecretService = false
+	case SecretBackendVault:
+		UseSecretService = true
+		SecretServiceFactory = newVaultSecretService
+	case SecretBackendKubernetes:
+		UseSecretService = true
+		SecretsNamespace = cfg.Namespace
+		SecretServiceFactory = newKubernetesSecretService
+	case SecretBackendFile:
+		if cfg.Dir == "" {
+			return fmt.Errorf("the %s secrets backend requires a directory", backend)
+		}
+		UseSecretService = true
+		SecretsDir = cfg.Dir
+		SecretServiceFactory = newFileSecretService
+	default:
+		return fmt.Errorf("unknown secrets backend %s", backend)
+	}
+
+	if cfg.KeyFile != "" {
+		keys, err := LoadSecretKeyRing(cfg.KeyFile)
+		if err != nil {
+			return err
+		}
+		secretKeys = keys
+		Base64Factory = newEnvelope
+		log.Infof("Storing registry secrets in the %s backend, encrypted with key %s", backend, keys.activeID)
+	} else {
+		secretKeys = nil
+		Base64Factory = newBase64
+		log.Warnf("Storing registry secrets in the %s backend unencrypted; a secrets key file is needed to encrypt them", backend)
+	}
+	return nil
+}
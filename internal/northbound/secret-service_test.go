@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// Exercises the secret service returned by the factory through the lifecycle of a registry secret.
+func testSecretServiceLifecycle(t *testing.T, factory func(context.Context) (SecretService, error)) {
+	ctx := context.Background()
+	secretService, err := factory(ctx)
+	require.NoError(t, err)
+	defer secretService.Logout(ctx)
+
+	path := MakeSecretPath("c0ffee", "registry_name")
+	_, err = secretService.ReadSecret(ctx, path)
+	assert.ErrorContains(t, err, "secret not found")
+
+	assert.NoError(t, secretService.WriteSecret(ctx, path, "first"))
+	assert.NoError(t, secretService.WriteSecret(ctx, path, "second"))
+	assert.NoError(t, secretService.WriteSecret(ctx, MakeSecretPath("c0ffee", "other"), "other"))
+	secret, err := secretService.ReadSecret(ctx, path)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", secret)
+
+	assert.NoError(t, secretService.DeleteSecret(ctx, path))
+	assert.NoError(t, secretService.DeleteSecret(ctx, path))
+	_, err = secretService.ReadSecret(ctx, path)
+	assert.ErrorContains(t, err, "secret not found")
+	secret, err = secretService.ReadSecret(ctx, MakeSecretPath("c0ffee", "other"))
+	assert.NoError(t, err)
+	assert.Equal(t, "other", secret)
+}
+
+func TestFileSecretService(t *testing.T) {
+	saveSecretsDir := SecretsDir
+	defer func() { SecretsDir = saveSecretsDir }()
+	SecretsDir = filepath.Join(t.TempDir(), "secrets")
+
+	testSecretServiceLifecycle(t, newFileSecretService)
+
+	files, err := os.ReadDir(SecretsDir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	info, err := files[0].Info()
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestKubernetesSecretService(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	saveClientFactory, saveSecretsNamespace := kubernetesClientFactory, SecretsNamespace
+	defer func() { kubernetesClientFactory, SecretsNamespace = saveClientFactory, saveSecretsNamespace }()
+	kubernetesClientFactory = func() (kubernetes.Interface, error) { return client, nil }
+	SecretsNamespace = "orch-app"
+
+	testSecretServiceLifecycle(t, newKubernetesSecretService)
+
+	secrets, err := client.CoreV1().Secrets("orch-app").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 1)
+	assert.Equal(t, MakeSecretPath("c0ffee", "other"), secrets.Items[0].Annotations[k8sSecretPathAnnotation])
+	assert.LessOrEqual(t, len(secrets.Items[0].Name), 63)
+}
+
+func TestConfigureSecrets(t *testing.T) {
+	saveUseSecretService, saveSecretServiceFactory := UseSecretService, SecretServiceFactory
+	saveSecretKeys, saveBase64Factory, saveSecretsDir := secretKeys, Base64Factory, SecretsDir
+	defer func() {
+		UseSecretService, SecretServiceFactory = saveUseSecretService, saveSecretServiceFactory
+		secretKeys, Base64Factory, SecretsDir = saveSecretKeys, saveBase64Factory, saveSecretsDir
+	}()
+
+	UseSecretService = true
+	require.NoError(t, ConfigureSecrets(SecretsConfig{}))
+	assert.True(t, UseSecretService)
+	assert.False(t, SecretsEncrypted())
+
+	require.NoError(t, ConfigureSecrets(SecretsConfig{Backend: SecretBackendDatabase}))
+	assert.False(t, UseSecretService)
+
+	assert.ErrorContains(t, ConfigureSecrets(SecretsConfig{Backend: SecretBackendFile}), "requires a directory")
+	assert.ErrorContains(t, ConfigureSecrets(SecretsConfig{Backend: "s3"}), "unknown secrets backend s3")
+
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte(newTestKey(t, "k1")), 0o600))
+	require.NoError(t, ConfigureSecrets(SecretsConfig{Backend: SecretBackendFile, Dir: t.TempDir(), KeyFile: keyFile}))
+	assert.True(t, UseSecretService)
+	assert.True(t, SecretsEncrypted())
+	secretService, err := SecretServiceFactory(context.Background())
+	require.NoError(t, err)
+	assert.IsType(t, &fileSecretService{}, secretService)
+	assert.IsType(t, &envelopeStrings{}, Base64Factory())
+}
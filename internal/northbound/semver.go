@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Application, deployment package and chart versions are ordered by their semantic version precedence, so that
+ * 1.9.0 precedes 1.10.0 and pre-releases precede the release. Each version is stored along with a key that orders the
+ * same when compared bytewise; the numbers are zero-padded, each pre-release identifier is prefixed to order numeric
+ * identifiers first, and releases end with "~", which follows the "-" that starts the pre-release identifiers.
+ * Versions that are not semantic versions precede all others, in lexical order.
+ */
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+// versionKeyColumns maps the version columns to the columns holding their keys.
+var versionKeyColumns = map[string]string{
+	"version":       "version_key",
+	"chart_version": "chart_version_key",
+}
+
+// Returns true if the given column holds version keys.
+func isVersionKeyColumn(column string) bool {
+	for _, keyColumn := range versionKeyColumns {
+		if column == keyColumn {
+			return true
+		}
+	}
+	return false
+}
+
+// VersionKey returns the key of the given version, which orders the versions by precedence when compared bytewise.
+func VersionKey(version string) string {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return "0" + version
+	}
+	var key strings.Builder
+	fmt.Fprintf(&key, "1%020d.%020d.%020d", v.Major, v.Minor, v.Patch)
+	if len(v.Pre) == 0 {
+		key.WriteString("~")
+		return key.String()
+	}
+	key.WriteString("-")
+	for _, pre := range v.Pre {
+		// The separator precedes all characters of the identifiers, so that shorter identifiers come first
+		if pre.IsNum {
+			fmt.Fprintf(&key, ",0%020d", pre.VersionNum)
+		} else {
+			key.WriteString(",1" + pre.VersionStr)
+		}
+	}
+	return key.String()
+}
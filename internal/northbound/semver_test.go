@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"sort"
+	"testing"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionKeyOrdering(t *testing.T) {
+	// In order of precedence
+	versions := []string{
+		"latest", "main",
+		"0.9.0", "v1.0.0-0.3.7", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-alpha-x", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.2", "v1.9.0", "1.10.0", "10.0.0",
+	}
+	shuffled := []string{}
+	for i := len(versions) - 1; i >= 0; i-- {
+		shuffled = append(shuffled, versions[i])
+	}
+	sort.Slice(shuffled, func(i, j int) bool {
+		return VersionKey(shuffled[i]) < VersionKey(shuffled[j])
+	})
+	assert.Equal(t, versions, shuffled)
+	assert.Equal(t, VersionKey("v1.2.0"), VersionKey("1.2.0+build.5"))
+}
+
+func (s *NorthBoundTestSuite) TestSemanticVersionOrdering() {
+	ctx := s.ProjectID(footen)
+	for _, version := range []string{"1.10.0", "1.9.0", "2.0.0", "1.10.0-rc.1"} {
+		_, err := s.client.CreateApplication(ctx, &catalogv3.CreateApplicationRequest{Application: &catalogv3.Application{
+			Name: "semver", Version: version, ChartName: "semver", ChartVersion: version, HelmRegistryName: fooreg,
+		}})
+		s.NoError(err)
+		s.createDeploymentPkg(footen, "semver", version, "foo:v0.1.0")
+	}
+	names := func(apps []*catalogv3.Application) []string {
+		versions := make([]string, 0, len(apps))
+		for _, app := range apps {
+			versions = append(versions, app.Version)
+		}
+		return versions
+	}
+
+	versions, err := s.client.GetApplicationVersions(ctx, &catalogv3.GetApplicationVersionsRequest{ApplicationName: "semver"})
+	s.NoError(err)
+	s.Equal([]string{"1.9.0", "1.10.0-rc.1", "1.10.0", "2.0.0"}, names(versions.Application))
+
+	pkgVersions, err := s.client.GetDeploymentPackageVersions(ctx, &catalogv3.GetDeploymentPackageVersionsRequest{DeploymentPackageName: "semver"})
+	s.NoError(err)
+	if s.Len(pkgVersions.DeploymentPackages, 4) {
+		s.Equal("1.9.0", pkgVersions.DeploymentPackages[0].Version)
+		s.Equal("2.0.0", pkgVersions.DeploymentPackages[3].Version)
+	}
+
+	// Paging by version follows the precedence as well
+	var paged []*catalogv3.Application
+	token := ""
+	for {
+		resp, err := s.client.ListApplications(ctx, &catalogv3.ListApplicationsRequest{
+			Filter: "name=semver", OrderBy: "chartVersion desc", PageSize: 3, PageToken: token,
+		})
+		s.NoError(err)
+		paged = append(paged, resp.Applications...)
+		if token = resp.NextPageToken; token == "" {
+			break
+		}
+	}
+	s.Equal([]string{"2.0.0", "1.10.0", "1.10.0-rc.1", "1.9.0"}, names(paged))
+
+	// Ranges select by precedence
+	resp, err := s.client.ListApplications(ctx, &catalogv3.ListApplicationsRequest{
+		Filter: "name=semver AND version>=1.9.0 <2.0.0", OrderBy: "version",
+	})
+	s.NoError(err)
+	s.Equal([]string{"1.9.0", "1.10.0-rc.1", "1.10.0"}, names(resp.Applications))
+	resp, err = s.client.ListApplications(ctx, &catalogv3.ListApplicationsRequest{
+		Filter: "name=semver AND (version>1.10.0-rc.1 OR chartVersion<=v1.9)", OrderBy: "version desc",
+	})
+	s.NoError(err)
+	s.Equal([]string{"2.0.0", "1.10.0", "1.9.0"}, names(resp.Applications))
+
+	_, err = s.client.ListApplications(ctx, &catalogv3.ListApplicationsRequest{Filter: "version>=latest"})
+	s.ErrorContains(err, "version must be compared with a semantic version")
+}
@@ -7,9 +7,9 @@ package northbound
 import (
 	"context"
 	"database/sql"
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
-	"regexp"
 
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
 	ent "github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
@@ -18,6 +18,7 @@ import (
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	"github.com/open-edge-platform/orch-library/go/pkg/northbound"
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -28,11 +29,14 @@ import (
 var log = dazl.GetPackageLogger()
 var utilsLog = dazl.GetPackageLogger().WithSkipCalls(1)
 
-// NewService returns a new catalog Service
-func NewService(databaseClient *ent.Client, opaClient openpolicyagent.ClientWithResponsesInterface) northbound.Service {
+// NewService returns a new catalog Service; the broadcaster and the metrics registerer are optional.
+func NewService(databaseClient *ent.Client, opaClient openpolicyagent.ClientWithResponsesInterface, broadcaster EventBroadcaster,
+	metrics prometheus.Registerer) northbound.Service {
 	return &Service{
 		DatabaseClient: databaseClient,
 		OpaClient:      opaClient,
+		Broadcaster:    broadcaster,
+		Metrics:        metrics,
 	}
 }
 
@@ -40,6 +44,8 @@ func NewService(databaseClient *ent.Client, opaClient openpolicyagent.ClientWith
 type Service struct {
 	DatabaseClient *ent.Client
 	OpaClient      openpolicyagent.ClientWithResponsesInterface
+	Broadcaster    EventBroadcaster
+	Metrics        prometheus.Registerer
 }
 
 // Register registers the Service with the gRPC server.
@@ -52,6 +58,21 @@ func (s Service) Register(r *grpc.Server) {
 		listeners:      NewEventListeners(),
 	}
 
+	// Without a broadcaster, watchers see only the changes made through this replica
+	if s.Broadcaster != nil {
+		if err := s.Broadcaster.Start(server.listeners); err != nil {
+			log.Errorf("Unable to start event broadcaster; events of other replicas will not be relayed: %v", err)
+		}
+	}
+
+	go server.listeners.reportLags(context.Background(), ListenerLagReportInterval)
+	if s.Metrics != nil {
+		if err := s.Metrics.Register(newListenerCollector(server.listeners)); err != nil {
+			log.Errorf("Unable to register the watch metrics: %v", err)
+		}
+	}
+	NewWebhookDispatcher(s.DatabaseClient).Start(context.Background(), server.listeners)
+
 	catalogv3.RegisterCatalogServiceServer(r, server)
 }
 
@@ -139,6 +160,14 @@ func (g *Server) rollbackTransaction(tx *generated.Tx) {
 	_ = tx.Rollback()
 }
 
+// Locks the selected rows until the end of the transaction, so that concurrent changes to them are made one after
+// another. SQLite has no such clause, as it serializes all writes anyway.
+func forUpdate(s *entsql.Selector) {
+	if s.Dialect() == dialect.Postgres {
+		s.ForUpdate()
+	}
+}
+
 func (g *Server) checkApplication(ctx context.Context, tx *generated.Tx, name, version, projectUUID string) error {
 	ok, err := tx.Application.Query().
 		Where(
@@ -257,105 +286,6 @@ func parseOrderBy(orderByParameter string, resourceType errors.ResourceType) ([]
 	return orderBys, nil
 }
 
-func (o *orderBy) orderByDirection() entsql.OrderTermOption {
-	orderMap := map[bool]entsql.OrderTermOption{
-		true:  entsql.OrderDesc(),
-		false: entsql.OrderAsc(),
-	}
-	return orderMap[o.isDesc]
-}
-
-func orderByOptions(orderBys []*orderBy, columns map[string]string, resourceType errors.ResourceType) ([]func(selector *entsql.Selector), error) {
-	var options []func(s *entsql.Selector)
-
-	if len(orderBys) != 0 {
-		for _, o := range orderBys {
-			orderTermOption := o.orderByDirection()
-			columnName, err := findColumnName(o.name, columns, resourceType, "orderBy")
-			if err != nil {
-				return nil, err
-			}
-			options = append(options, entsql.OrderByField(columnName, orderTermOption).ToFunc())
-		}
-	}
-	return options, nil
-}
-
-type filter struct {
-	name  string
-	value string
-}
-
-func parseFilter(filterParameter string, resourceType errors.ResourceType) ([]*filter, error) {
-	if filterParameter == "" {
-		return nil, nil
-	}
-	normalizeEqualsRe := regexp.MustCompile("[ \t]*=[ \t]*")
-	normalizedFilterParameter := normalizeEqualsRe.ReplaceAllString(filterParameter, "=")
-
-	elements := strings.Split(normalizedFilterParameter, " ")
-	var filters []*filter
-	var currentFilter *filter
-
-	for index, element := range elements {
-		if strings.Contains(element, "=") {
-			selectors := strings.Split(element, "=")
-			if currentFilter != nil || len(selectors) != 2 || selectors[0] == "" || selectors[1] == "" {
-				// Error condition - too many equals
-				return nil, errors.NewInvalidArgument(
-					errors.WithResourceType(resourceType),
-					errors.WithMessage("filter: invalid filter request: %s", elements))
-			}
-			currentFilter = &filter{}
-			// This is the start of a selector. Grab the name and the value
-			currentFilter.name = selectors[0]
-			currentFilter.value = selectors[1]
-		} else if element == "OR" {
-			if currentFilter == nil || index == len(elements)-1 {
-				//  Error condition - OR with no other term
-				return nil, errors.NewInvalidArgument(
-					errors.WithResourceType(resourceType),
-					errors.WithMessage("filter: invalid filter request: %s", elements))
-			}
-			filters = append(filters, currentFilter)
-			currentFilter = nil
-			continue
-		} else {
-			if currentFilter == nil {
-				// Error condition - missing an =
-				return nil, errors.NewInvalidArgument(
-					errors.WithResourceType(resourceType),
-					errors.WithMessage("filter: invalid filter request: %s", elements))
-			}
-			currentFilter.value = currentFilter.value + " " + element
-		}
-	}
-	if currentFilter != nil {
-		filters = append(filters, currentFilter)
-	}
-
-	return filters, nil
-}
-
-func filterPredicates(filters []*filter, columns map[string]string, resourceType errors.ResourceType) ([]func(s *entsql.Selector), error) {
-	var preds []func(s *entsql.Selector)
-	if len(filters) != 0 {
-		for _, f := range filters {
-			column, err := findColumnName(f.name, columns, resourceType, "filter")
-			if err != nil {
-				return nil, err
-			}
-
-			likeValue := "%" + strings.ToLower(strings.ReplaceAll(f.value, "*", "%")) + "%"
-			likePred := func(s *entsql.Selector) {
-				s.Where(entsql.Like(entsql.Lower(column), likeValue))
-			}
-			preds = append(preds, likePred)
-		}
-	}
-	return preds, nil
-}
-
 func kindPredicate(kinds []catalogv3.Kind) func(s *entsql.Selector) {
 	if len(kinds) > 0 {
 		hasNormalKind := false
@@ -6,6 +6,9 @@ package northbound
 
 import (
 	"context"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"fmt"
 	_ "github.com/mattn/go-sqlite3"
 	ent "github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/enttest"
@@ -56,43 +59,60 @@ func TestNewService(t *testing.T) {
 	dbClient := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
 	defer dbClient.Close()
 
-	s := NewService(dbClient, nil)
+	s := NewService(dbClient, nil, nil, nil)
 	assert.NotNil(t, s)
 }
 
-func checkFilters(t *testing.T, filters []*filter, wantedFieldList string, wantedValuesList string) {
-	if len(filters) == 0 && wantedValuesList == "" {
-		return
+// Returns the parsed filter in a form that is easy to compare.
+func formatFilter(f *filter) string {
+	if f == nil {
+		return ""
 	}
-	wantedFields := strings.Split(wantedFieldList, ",")
-	wantedValues := strings.Split(wantedValuesList, ",")
-
-	assert.Len(t, filters, len(wantedFields))
-	for i := range wantedFields {
-		assert.Equal(t, wantedFields[i], filters[i].name)
-		assert.Equal(t, wantedValues[i], filters[i].value)
+	switch f.op {
+	case filterAnd, filterOr, filterNot:
+		operands := make([]string, 0, len(f.operands))
+		for _, operand := range f.operands {
+			operands = append(operands, formatFilter(operand))
+		}
+		return f.op + "(" + strings.Join(operands, ", ") + ")"
 	}
+	if f.quoted {
+		return fmt.Sprintf("%s%s%q", f.name, f.op, f.value)
+	}
+	return f.name + f.op + f.value
 }
 
 func TestFiltersParsing(t *testing.T) {
 	tests := map[string]struct {
-		filter           string
-		wantedFieldList  string
-		wantedValuesList string
-		expectedError    string
+		filter        string
+		wanted        string
+		expectedError string
 	}{
-		"none":            {filter: "", wantedValuesList: "", wantedFieldList: ""},
-		"single":          {filter: "field1=value1", wantedFieldList: "field1", wantedValuesList: "value1"},
-		"double":          {filter: "name=acme OR description=widget company", wantedFieldList: "name,description", wantedValuesList: "acme,widget company"},
-		"triple":          {filter: "f1=v1 OR f2=v2 OR f3=v3", wantedFieldList: "f1,f2,f3", wantedValuesList: "v1,v2,v3"},
-		"equals error":    {filter: "=", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"two equals":      {filter: "= =", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"no field":        {filter: "=v1", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"no value":        {filter: "f1=", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"no equals":       {filter: "f1 v1", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"just OR":         {filter: "OR", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"hanging OR":      {filter: "f1=v1 OR f2=v2 OR", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
-		"OR no left side": {filter: "OR f2=v2", wantedFieldList: "", wantedValuesList: "", expectedError: "invalid filter request"},
+		"none":               {filter: "", wanted: ""},
+		"single":             {filter: "field1=value1", wanted: "field1=value1"},
+		"double":             {filter: "name=acme OR description=widget company", wanted: "OR(name=acme, description=widget company)"},
+		"triple":             {filter: "f1=v1 OR f2=v2 OR f3=v3", wanted: "OR(f1=v1, f2=v2, f3=v3)"},
+		"spaces":             {filter: " f1 = v1  OR f2 != v2 ", wanted: "OR(f1=v1, f2!=v2)"},
+		"and":                {filter: "f1=v1 AND f2>v2", wanted: "AND(f1=v1, f2>v2)"},
+		"and binds loosely":  {filter: "f1=v1 AND f2=v2 OR f3=v3", wanted: "AND(f1=v1, OR(f2=v2, f3=v3))"},
+		"implicit and":       {filter: "f1=v1 f2:v2", wanted: "AND(f1=v1, f2:v2)"},
+		"parentheses":        {filter: "(f1=v1 AND f2=v2) OR f3=v3", wanted: "OR(AND(f1=v1, f2=v2), f3=v3)"},
+		"not":                {filter: "NOT f1=v1 AND -(f2<=v2)", wanted: "AND(NOT(f1=v1), NOT(f2<=v2))"},
+		"quoted":             {filter: `f1="v1 AND \"v2\"" f2=v3`, wanted: `AND(f1="v1 AND \"v2\"", f2=v3)`},
+		"timestamp":          {filter: "createTime >= 2026-01-01T10:00:00Z", wanted: "createTime>=2026-01-01T10:00:00Z"},
+		"range":              {filter: "version >= 1.2.0 <2.0.0 OR version=3", wanted: "OR(AND(version>=1.2.0, version<2.0.0), version=3)"},
+		"range has":          {filter: "f1>v1 :v2", expectedError: "invalid filter request: expected an attribute name at position 7"},
+		"equals error":       {filter: "=", expectedError: "invalid filter request: expected an attribute name at position 1"},
+		"two equals":         {filter: "= =", expectedError: "invalid filter request"},
+		"no field":           {filter: "=v1", expectedError: "invalid filter request"},
+		"no value":           {filter: "f1=", expectedError: "invalid filter request: expected a value for f1 at position 4"},
+		"no equals":          {filter: "f1 v1", expectedError: "invalid filter request: expected a comparator after f1 at position 4"},
+		"just OR":            {filter: "OR", expectedError: "invalid filter request"},
+		"hanging OR":         {filter: "f1=v1 OR f2=v2 OR", expectedError: "invalid filter request: missing operand of OR at position 18"},
+		"OR no left side":    {filter: "OR f2=v2", expectedError: "invalid filter request"},
+		"unbalanced":         {filter: "(f1=v1 OR f2=v2", expectedError: "invalid filter request: missing closing parenthesis at position 16"},
+		"extra parenthesis":  {filter: "f1=v1)", expectedError: "invalid filter request: unexpected \")\" at position 6"},
+		"unterminated quote": {filter: `f1="v1`, expectedError: "invalid filter request: unterminated string at position 4"},
 	}
 
 	for name, testCase := range tests {
@@ -102,12 +122,43 @@ func TestFiltersParsing(t *testing.T) {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), testCase.expectedError)
 			} else {
-				checkFilters(t, resp, testCase.wantedFieldList, testCase.wantedValuesList)
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.wanted, formatFilter(resp))
 			}
 		})
 	}
 }
 
+func TestFilterPlaceholders(t *testing.T) {
+	f, err := parseFilter("name=foo OR description:bar", errors.ApplicationType)
+	assert.NoError(t, err)
+	where, err := filterPredicate(f, map[string]string{"name": "name", "description": "description"}, errors.ApplicationType)
+	assert.NoError(t, err)
+
+	s := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table("applications"))
+	where(s)
+	query, args := s.Query()
+	assert.Contains(t, query, "LIKE $1")
+	assert.Contains(t, query, "LIKE $2")
+	assert.Equal(t, []any{"%foo%", "%bar%"}, args)
+}
+
+func TestPageTokenPlaceholders(t *testing.T) {
+	orderBys, err := parseOrderBy("name desc", errors.ApplicationType)
+	assert.NoError(t, err)
+	page := &listPage{after: &pageToken{OrderBy: "name desc", Keys: []string{"foo"}, ID: 7}}
+	after, err := page.afterPredicate(orderBys, map[string]string{"name": "name"}, errors.ApplicationType)
+	assert.NoError(t, err)
+
+	s := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table("applications"))
+	after(s)
+	query, args := s.Query()
+	assert.Contains(t, query, "< $1")
+	assert.Contains(t, query, "= $2")
+	assert.Contains(t, query, "> $3")
+	assert.Equal(t, []any{"foo", "foo", uint64(7)}, args)
+}
+
 func TestComputePageRange(t *testing.T) {
 	tests := map[string]struct {
 		pageSize      int32
@@ -0,0 +1,689 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Deleted registries, applications and deployment packages are moved to the trash of their project, from which they
+ * may be restored until they are purged, either explicitly or by the manager once their retention period expires.
+ *
+ * Deleting an entity still removes its rows, along with its profiles, parameter templates and namespaces; the trash
+ * holds a snapshot of the entity, from which restoring creates it anew. The referential checks therefore only ever see
+ * the live entities: an application that is part of a trashed deployment package may be deleted, and a registry used
+ * only by trashed applications may be deleted as well. Restoring an entity requires the entities it references to be
+ * live again, so they have to be restored first. Deleting an entity that is already in the trash replaces the older
+ * snapshot.
+ *
+ * Registry credentials held by the secret service are kept there until the registry is purged; those held in the
+ * database are kept with the snapshot, which is otherwise redacted.
+ */
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/application"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/trashedentity"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TrashRetention is the period for which deleted entities are kept in the trash before they are purged.
+var TrashRetention = 30 * 24 * time.Hour
+
+// Returns the query for the given entity in the trash.
+func trashQuery(client *generated.TrashedEntityClient, projectUUID string, resourceType errors.ResourceType, name string, version string) *generated.TrashedEntityQuery {
+	return client.Query().
+		Where(
+			trashedentity.ProjectUUID(projectUUID),
+			trashedentity.ResourceType(string(resourceType)),
+			trashedentity.Name(name),
+			trashedentity.Version(version),
+		)
+}
+
+// Moves the given snapshot of the entity being deleted to the trash, replacing any earlier snapshot of the entity.
+func trashEntity(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType,
+	name string, version string, snapshot proto.Message, secret string) error {
+	if snapshot == nil {
+		return errors.NewNotFound(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version))
+	}
+	js, err := protojson.Marshal(snapshot)
+	if err != nil {
+		return errors.NewInternal(errors.WithError(err))
+	}
+	if _, err = tx.TrashedEntity.Delete().
+		Where(
+			trashedentity.ProjectUUID(projectUUID),
+			trashedentity.ResourceType(string(resourceType)),
+			trashedentity.Name(name),
+			trashedentity.Version(version),
+		).
+		Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+
+	user, _ := auditIdentity(ctx)
+	err = tx.TrashedEntity.Create().
+		SetProjectUUID(projectUUID).
+		SetResourceType(string(resourceType)).
+		SetName(name).
+		SetVersion(version).
+		SetUserName(user).
+		SetSnapshot(string(js)).
+		SetSecret(secret).
+		Exec(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// Moves the given redacted snapshot of the registry being deleted to the trash, along with the secret data the
+// registry keeps in the database, if any.
+func trashRegistry(ctx context.Context, tx *generated.Tx, projectUUID string, name string, snapshot proto.Message) error {
+	regDB, err := tx.Registry.Query().Where(registry.ProjectUUID(projectUUID), registry.Name(name)).Only(ctx)
+	if generated.IsNotFound(err) {
+		return errors.NewNotFound(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithResourceName(name))
+	} else if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return trashEntity(ctx, tx, projectUUID, errors.RegistryType, name, "", snapshot, regDB.AuthToken)
+}
+
+// Returns the given entity in the trash, or a not-found error if it is not there.
+func getTrashedEntity(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType,
+	name string, version string) (*generated.TrashedEntity, error) {
+	trashed, err := trashQuery(tx.TrashedEntity, projectUUID, resourceType, name, version).Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, errors.NewNotFound(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
+			errors.WithMessage("no deleted %s %s found", resourceType, name))
+	} else if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return trashed, nil
+}
+
+// Returns the API representation of the given entity in the trash.
+func deletedEntityFromDB(t *generated.TrashedEntity) (*catalogv3.DeletedEntity, error) {
+	deleted := &catalogv3.DeletedEntity{
+		ResourceType: t.ResourceType,
+		Name:         t.Name,
+		Version:      t.Version,
+		User:         t.UserName,
+		DeleteTime:   timestamppb.New(t.DeleteTime),
+		ExpireTime:   timestamppb.New(t.DeleteTime.Add(TrashRetention)),
+	}
+
+	var snapshot proto.Message
+	switch errors.ResourceType(t.ResourceType) {
+	case errors.RegistryType:
+		deleted.Registry = &catalogv3.Registry{}
+		snapshot = deleted.Registry
+	case errors.ApplicationType:
+		deleted.Application = &catalogv3.Application{}
+		snapshot = deleted.Application
+	default:
+		deleted.DeploymentPackage = &catalogv3.DeploymentPackage{}
+		snapshot = deleted.DeploymentPackage
+	}
+	if err := protojson.Unmarshal([]byte(t.Snapshot), snapshot); err != nil {
+		return nil, errors.NewInternal(errors.WithError(err))
+	}
+	return deleted, nil
+}
+
+// Returns the entity held by the given entry of the trash, ready to be created anew.
+func restoredSnapshot(t *generated.TrashedEntity) (proto.Message, error) {
+	deleted, err := deletedEntityFromDB(t)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot proto.Message
+	switch {
+	case deleted.Registry != nil:
+		snapshot = deleted.Registry
+	case deleted.Application != nil:
+		snapshot = deleted.Application
+	default:
+		snapshot = deleted.DeploymentPackage
+	}
+	clearGeneratedFields(snapshot.ProtoReflect())
+	return snapshot, nil
+}
+
+// Returns the reason why the given entity referenced by an entity being restored cannot be used.
+func missingReference(ctx context.Context, tx *generated.Tx, projectUUID string, resourceType errors.ResourceType,
+	name string, version string) string {
+	what := fmt.Sprintf("%s %s", resourceType, name)
+	if version != "" {
+		what = fmt.Sprintf("%s:%s", what, version)
+	}
+	if trashed, err := trashQuery(tx.TrashedEntity, projectUUID, resourceType, name, version).Exist(ctx); err == nil && trashed {
+		return fmt.Sprintf("%s is deleted and must be restored first", what)
+	}
+	return fmt.Sprintf("%s no longer exists", what)
+}
+
+// Permanently removes the given entry of the trash. The credentials of a registry are removed from the secret
+// service as well, unless a registry of the same name has since been created, which now owns them; the same goes for
+// the revisions of an application or deployment package.
+func purgeTrashedEntity(ctx context.Context, tx *generated.Tx, t *generated.TrashedEntity) error {
+	if err := tx.TrashedEntity.DeleteOne(t).Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	if resourceType := errors.ResourceType(t.ResourceType); resourceType == errors.ApplicationType || resourceType == errors.DeploymentPackageType {
+		return purgeRevisions(ctx, tx, t.ProjectUUID, resourceType, t.Name, t.Version)
+	}
+	if errors.ResourceType(t.ResourceType) != errors.RegistryType || t.Secret != "" || !UseSecretService {
+		return nil
+	}
+
+	live, err := tx.Registry.Query().Where(registry.ProjectUUID(t.ProjectUUID), registry.Name(t.Name)).Exist(ctx)
+	if err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	} else if live {
+		return nil
+	}
+	secretService, err := SecretServiceFactory(ctx)
+	if err != nil {
+		return errors.NewVaultError(errors.WithError(err))
+	}
+	defer secretService.Logout(ctx)
+
+	registryKey := MakeSecretPath(t.ProjectUUID, t.Name)
+	if err = secretService.DeleteSecret(ctx, registryKey); err != nil {
+		log.Warnf("failed to delete key %s from secret service: %v", registryKey, err)
+		return errors.NewVaultError(errors.WithError(err))
+	}
+	return nil
+}
+
+// PurgeExpiredTrash permanently removes the entities deleted before the given time from the trash of all projects,
+// returning the number of entities purged.
+func PurgeExpiredTrash(ctx context.Context, client *generated.Client, before time.Time) (int, error) {
+	expired, err := client.TrashedEntity.Query().
+		Where(trashedentity.DeleteTimeLT(before)).
+		Order(generated.Asc(trashedentity.FieldDeleteTime)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, t := range expired {
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			return purged, err
+		}
+		if err = purgeTrashedEntity(ctx, tx, t); err != nil {
+			_ = tx.Rollback()
+			return purged, err
+		}
+		if err = tx.Commit(); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// ListDeleted gets a list of the deleted entities held in the trash through gRPC
+func (g *Server) ListDeleted(ctx context.Context, req *catalogv3.ListDeletedRequest) (*catalogv3.ListDeletedResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage("incomplete request"))
+	}
+	switch errors.ResourceType(req.ResourceType) {
+	case "", errors.RegistryType, errors.ApplicationType, errors.DeploymentPackageType:
+	default:
+		return nil, errors.NewInvalidArgument(
+			errors.WithMessage("deleted entities are not kept for %q; must be %s, %s or %s", req.ResourceType,
+				errors.RegistryType, errors.ApplicationType, errors.DeploymentPackageType))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	query := g.databaseClient.TrashedEntity.Query().Where(trashedentity.ProjectUUID(projectUUID))
+	if req.ResourceType != "" {
+		query.Where(trashedentity.ResourceType(req.ResourceType))
+	}
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	startIndex, endIndex, totalElements, err := computePageRange(req.PageSize, req.Offset, count)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedEntities := make([]*catalogv3.DeletedEntity, 0)
+	if count > 0 {
+		trashedDB, err := query.
+			Order(generated.Desc(trashedentity.FieldDeleteTime), generated.Desc(trashedentity.FieldID)).
+			Offset(startIndex).
+			Limit(endIndex - startIndex + 1).
+			All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+		for _, t := range trashedDB {
+			deleted, err := deletedEntityFromDB(t)
+			if err != nil {
+				return nil, err
+			}
+			deletedEntities = append(deletedEntities, deleted)
+		}
+	}
+	return &catalogv3.ListDeletedResponse{DeletedEntities: deletedEntities, TotalElements: totalElements}, nil
+}
+
+// RestoreRegistry restores a deleted registry from the trash through gRPC
+func (g *Server) RestoreRegistry(ctx context.Context, req *catalogv3.RestoreRegistryRequest) (*catalogv3.RestoreRegistryResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.RegistryName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	events := &RegistryEvents{}
+	if err = g.restoreRegistry(ctx, tx, projectUUID, req.RegistryName, events); err == nil {
+		err = events.persist(ctx, tx)
+	}
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	restored, err := g.registrySnapshot(ctx, tx, projectUUID, req.RegistryName, false)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	logActivity(ctx, "restored", "registry", projectUUID, req.RegistryName)
+	events.sendToAll(g.listeners)
+
+	return &catalogv3.RestoreRegistryResponse{Registry: restored.(*catalogv3.Registry)}, nil
+}
+
+// Creates the given registry anew from the trash, along with its credentials.
+func (g *Server) restoreRegistry(ctx context.Context, tx *generated.Tx, projectUUID string, name string, events *RegistryEvents) error {
+	trashed, err := getTrashedEntity(ctx, tx, projectUUID, errors.RegistryType, name, "")
+	if err != nil {
+		return err
+	}
+	snapshot, err := restoredSnapshot(trashed)
+	if err != nil {
+		return err
+	}
+	reg := snapshot.(*catalogv3.Registry)
+
+	if exists, err := tx.Registry.Query().Where(registry.ProjectUUID(projectUUID), registry.Name(name)).Exist(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	} else if exists {
+		return errors.NewAlreadyExists(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithResourceName(name),
+			errors.WithMessage("registry %s has been created since it was deleted", name))
+	}
+
+	encodedSecretData := trashed.Secret
+	if encodedSecretData == "" && UseSecretService {
+		secretService, err := SecretServiceFactory(ctx)
+		if err != nil {
+			return errors.NewVaultError(errors.WithError(err))
+		}
+		defer secretService.Logout(ctx)
+
+		if encodedSecretData, err = secretService.ReadSecret(ctx, MakeSecretPath(projectUUID, name)); err != nil {
+			return errors.NewVaultError(errors.WithError(err))
+		}
+	}
+	rsd := registrySecretData{}
+	if err = Base64Factory().DecodeBase64(&rsd, encodedSecretData, MakeSecretPath(projectUUID, name)); err != nil {
+		return errors.NewVaultError(errors.WithError(err))
+	}
+	reg.RootUrl = rsd.RootURL
+	reg.InventoryUrl = rsd.InventoryURL
+	reg.Username = rsd.Username
+	reg.AuthToken = rsd.AuthToken
+	reg.Cacerts = rsd.Cacerts
+
+	if err = reg.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithMessage(err.Error()))
+	}
+	if _, err = g.createRegistry(ctx, tx, projectUUID, reg, events); err != nil {
+		return err
+	}
+	if err = tx.TrashedEntity.DeleteOne(trashed).Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// RestoreApplication restores a deleted application from the trash through gRPC
+func (g *Server) RestoreApplication(ctx context.Context, req *catalogv3.RestoreApplicationRequest) (*catalogv3.RestoreApplicationResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.ApplicationName == "" || req.Version == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	events := &ApplicationEvents{}
+	if err = g.restoreDeletedApplication(ctx, tx, projectUUID, req.ApplicationName, req.Version, events); err == nil {
+		err = events.persist(ctx, tx)
+	}
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	restored, err := g.applicationSnapshot(ctx, tx, projectUUID, req.ApplicationName, req.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	logActivity(ctx, "restored", "application", projectUUID, req.ApplicationName, req.Version)
+	events.sendToAll(g.listeners)
+
+	return &catalogv3.RestoreApplicationResponse{Application: restored.(*catalogv3.Application)}, nil
+}
+
+// Creates the given application anew from the trash, provided that its registries exist.
+func (g *Server) restoreDeletedApplication(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string, events *ApplicationEvents) error {
+	trashed, err := getTrashedEntity(ctx, tx, projectUUID, errors.ApplicationType, name, version)
+	if err != nil {
+		return err
+	}
+	snapshot, err := restoredSnapshot(trashed)
+	if err != nil {
+		return err
+	}
+	app := snapshot.(*catalogv3.Application)
+
+	if exists, err := tx.Application.Query().
+		Where(
+			application.ProjectUUID(projectUUID),
+			application.Name(name),
+			application.Version(version),
+		).
+		Exist(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	} else if exists {
+		return errors.NewAlreadyExists(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
+			errors.WithMessage("application %s:%s has been created since it was deleted", name, version))
+	}
+
+	for _, registryName := range []string{app.HelmRegistryName, app.ImageRegistryName} {
+		if registryName == "" {
+			continue
+		}
+		exists, err := tx.Registry.Query().Where(registry.ProjectUUID(projectUUID), registry.Name(registryName)).Exist(ctx)
+		if err != nil {
+			return errors.NewDBError(errors.WithError(err))
+		} else if !exists {
+			return errors.NewFailedPrecondition(
+				errors.WithResourceType(errors.ApplicationType),
+				errors.WithResourceName(name),
+				errors.WithResourceVersion(version),
+				errors.WithMessage("%s", missingReference(ctx, tx, projectUUID, errors.RegistryType, registryName, "")))
+		}
+	}
+
+	if err = app.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage(err.Error()))
+	}
+	if _, err = g.createApplication(ctx, tx, projectUUID, app, events); err != nil {
+		return err
+	}
+	if err = tx.TrashedEntity.DeleteOne(trashed).Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// RestoreDeploymentPackage restores a deleted deployment package from the trash through gRPC
+func (g *Server) RestoreDeploymentPackage(ctx context.Context, req *catalogv3.RestoreDeploymentPackageRequest) (*catalogv3.RestoreDeploymentPackageResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.DeploymentPackageName == "" || req.Version == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	events := &DeploymentPackageEvents{}
+	if err = g.restoreDeletedDeploymentPackage(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version, events); err == nil {
+		err = events.persist(ctx, tx)
+	}
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	restored, err := g.deploymentPackageSnapshot(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	logActivity(ctx, "restored", "deployment-package", projectUUID, req.DeploymentPackageName, req.Version)
+	events.sendToAll(g.listeners)
+
+	return &catalogv3.RestoreDeploymentPackageResponse{DeploymentPackage: restored.(*catalogv3.DeploymentPackage)}, nil
+}
+
+// Creates the given deployment package anew from the trash, provided that the applications it references exist.
+func (g *Server) restoreDeletedDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string, events *DeploymentPackageEvents) error {
+	trashed, err := getTrashedEntity(ctx, tx, projectUUID, errors.DeploymentPackageType, name, version)
+	if err != nil {
+		return err
+	}
+	snapshot, err := restoredSnapshot(trashed)
+	if err != nil {
+		return err
+	}
+	pkg := snapshot.(*catalogv3.DeploymentPackage)
+
+	if exists, err := tx.DeploymentPackage.Query().
+		Where(
+			deploymentpackage.ProjectUUID(projectUUID),
+			deploymentpackage.Name(name),
+			deploymentpackage.Version(version),
+		).
+		Exist(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	} else if exists {
+		return errors.NewAlreadyExists(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version),
+			errors.WithMessage("deployment package %s:%s has been created since it was deleted", name, version))
+	}
+
+	for _, ref := range pkg.ApplicationReferences {
+		exists, err := tx.Application.Query().
+			Where(
+				application.ProjectUUID(projectUUID),
+				application.Name(ref.Name),
+				application.Version(ref.Version),
+			).
+			Exist(ctx)
+		if err != nil {
+			return errors.NewDBError(errors.WithError(err))
+		} else if !exists {
+			return errors.NewFailedPrecondition(
+				errors.WithResourceType(errors.DeploymentPackageType),
+				errors.WithResourceName(name),
+				errors.WithResourceVersion(version),
+				errors.WithMessage("%s", missingReference(ctx, tx, projectUUID, errors.ApplicationType, ref.Name, ref.Version)))
+		}
+	}
+
+	if err = pkg.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage(err.Error()))
+	} else if err = validateDeploymentProfiles(pkg); err != nil {
+		return err
+	}
+	if _, err = g.createDeploymentPackage(ctx, tx, projectUUID, pkg, events); err != nil {
+		return err
+	}
+	if err = tx.TrashedEntity.DeleteOne(trashed).Exec(ctx); err != nil {
+		return errors.NewDBError(errors.WithError(err))
+	}
+	return nil
+}
+
+// PurgeRegistry permanently removes a deleted registry from the trash through gRPC
+func (g *Server) PurgeRegistry(ctx context.Context, req *catalogv3.PurgeRegistryRequest) (*emptypb.Empty, error) {
+	if req == nil || req.RegistryName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.RegistryType),
+			errors.WithMessage("incomplete request"))
+	}
+	return g.purge(ctx, req, errors.RegistryType, req.RegistryName, "")
+}
+
+// PurgeApplication permanently removes a deleted application from the trash through gRPC
+func (g *Server) PurgeApplication(ctx context.Context, req *catalogv3.PurgeApplicationRequest) (*emptypb.Empty, error) {
+	if req == nil || req.ApplicationName == "" || req.Version == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.ApplicationType),
+			errors.WithMessage("incomplete request"))
+	}
+	return g.purge(ctx, req, errors.ApplicationType, req.ApplicationName, req.Version)
+}
+
+// PurgeDeploymentPackage permanently removes a deleted deployment package from the trash through gRPC
+func (g *Server) PurgeDeploymentPackage(ctx context.Context, req *catalogv3.PurgeDeploymentPackageRequest) (*emptypb.Empty, error) {
+	if req == nil || req.DeploymentPackageName == "" || req.Version == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.DeploymentPackageType),
+			errors.WithMessage("incomplete request"))
+	}
+	return g.purge(ctx, req, errors.DeploymentPackageType, req.DeploymentPackageName, req.Version)
+}
+
+// Permanently removes the given entity from the trash of the active project.
+func (g *Server) purge(ctx context.Context, req proto.Message, resourceType errors.ResourceType, name string, version string) (*emptypb.Empty, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	trashed, err := getTrashedEntity(ctx, tx, projectUUID, resourceType, name, version)
+	if err == nil {
+		err = purgeTrashedEntity(ctx, tx, trashed)
+	}
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	if version != "" {
+		logActivity(ctx, "purged", string(resourceType), projectUUID, name, version)
+	} else {
+		logActivity(ctx, "purged", string(resourceType), projectUUID, name)
+	}
+	return &emptypb.Empty{}, nil
+}
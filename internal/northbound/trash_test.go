@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"time"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *NorthBoundTestSuite) listDeleted(resourceType string) []*catalogv3.DeletedEntity {
+	resp, err := s.client.ListDeleted(s.ProjectID(footen), &catalogv3.ListDeletedRequest{ResourceType: resourceType})
+	s.validateResponse(err, resp)
+	return resp.DeletedEntities
+}
+
+func (s *NorthBoundTestSuite) TestTrashDeploymentPackage() {
+	_, err := s.client.DeleteDeploymentPackage(s.ProjectID(footen), &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.3.4",
+	})
+	s.NoError(err)
+	_, err = s.client.DeleteDeploymentPackage(s.ProjectID(footen), &catalogv3.DeleteDeploymentPackageRequest{
+		DeploymentPackageName: "ca-fifi", Version: "v0.2.0",
+	})
+	s.NoError(err)
+	_, err = s.client.GetDeploymentPackage(s.ProjectID(footen), &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.3.4",
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	deleted := s.listDeleted("")
+	if s.Len(deleted, 2) {
+		s.Equal("ca-fifi", deleted[0].Name)
+		s.Equal("ca-gigi", deleted[1].Name)
+		s.Equal("deployment-package", deleted[1].ResourceType)
+		s.Equal("v0.3.4", deleted[1].Version)
+		s.Equal(TrashRetention, deleted[1].ExpireTime.AsTime().Sub(deleted[1].DeleteTime.AsTime()))
+		if s.NotNil(deleted[1].DeploymentPackage) {
+			s.Len(deleted[1].DeploymentPackage.Profiles, 2)
+			s.Len(deleted[1].DeploymentPackage.ApplicationReferences, 3)
+		}
+	}
+	s.Len(s.listDeleted("deployment-package"), 2)
+	s.Empty(s.listDeleted("application"))
+	s.Empty(s.listDeleted("registry"))
+	_, err = s.client.ListDeleted(s.ProjectID(footen), &catalogv3.ListDeletedRequest{ResourceType: "artifact"})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Trashed packages do not keep their applications from being deleted, but have to wait for them to be restored
+	_, err = s.client.DeleteApplication(s.ProjectID(footen), &catalogv3.DeleteApplicationRequest{ApplicationName: "goo", Version: "v0.1.2"})
+	s.NoError(err)
+	_, err = s.client.RestoreDeploymentPackage(s.ProjectID(footen), &catalogv3.RestoreDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.3.4",
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+	s.Contains(err.Error(), "application goo:v0.1.2 is deleted and must be restored first")
+
+	app, err := s.client.RestoreApplication(s.ProjectID(footen), &catalogv3.RestoreApplicationRequest{ApplicationName: "goo", Version: "v0.1.2"})
+	s.validateResponse(err, app)
+	s.Equal("goo", app.Application.Name)
+	s.NotEmpty(app.Application.Profiles)
+
+	ctx, cancel := context.WithCancel(s.ProjectID(footen))
+	defer cancel()
+	stream, err := s.client.WatchDeploymentPackages(ctx, &catalogv3.WatchDeploymentPackagesRequest{NoReplay: true})
+	s.NoError(err)
+	_, err = stream.Header() // Wait for the watch to be registered
+	s.NoError(err)
+
+	pkg, err := s.client.RestoreDeploymentPackage(s.ProjectID(footen), &catalogv3.RestoreDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.3.4",
+	})
+	s.validateResponse(err, pkg)
+	s.Len(pkg.DeploymentPackage.Profiles, 2)
+	s.Len(pkg.DeploymentPackage.ApplicationReferences, 3)
+	s.NotEmpty(pkg.DeploymentPackage.Etag)
+
+	event, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(CreatedEvent, EventType(event.Event.Type))
+	s.Equal("ca-gigi", event.DeploymentPackage.Name)
+
+	_, err = s.client.RestoreDeploymentPackage(s.ProjectID(footen), &catalogv3.RestoreDeploymentPackageRequest{
+		DeploymentPackageName: "ca-gigi", Version: "v0.3.4",
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	// A package created anew in place of a trashed one cannot be overwritten by a restore
+	_, err = s.client.CreateDeploymentPackage(s.ProjectID(footen), &catalogv3.CreateDeploymentPackageRequest{
+		DeploymentPackage: &catalogv3.DeploymentPackage{Name: "ca-fifi", Version: "v0.2.0", ApplicationReferences: appReferences("foo:v0.1.0")},
+	})
+	s.NoError(err)
+	_, err = s.client.RestoreDeploymentPackage(s.ProjectID(footen), &catalogv3.RestoreDeploymentPackageRequest{
+		DeploymentPackageName: "ca-fifi", Version: "v0.2.0",
+	})
+	s.Equal(codes.AlreadyExists, status.Code(err))
+
+	_, err = s.client.PurgeDeploymentPackage(s.ProjectID(footen), &catalogv3.PurgeDeploymentPackageRequest{
+		DeploymentPackageName: "ca-fifi", Version: "v0.2.0",
+	})
+	s.NoError(err)
+	s.Empty(s.listDeleted(""))
+	_, err = s.client.PurgeDeploymentPackage(s.ProjectID(footen), &catalogv3.PurgeDeploymentPackageRequest{
+		DeploymentPackageName: "ca-fifi", Version: "v0.2.0",
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+
+	// The trash of other projects is separate
+	other, err := s.client.ListDeleted(s.ProjectID(barten), &catalogv3.ListDeletedRequest{})
+	s.NoError(err)
+	s.Empty(other.DeletedEntities)
+}
+
+func (s *NorthBoundTestSuite) TestTrashRegistry() {
+	reg := &catalogv3.Registry{
+		Name: "trashreg", RootUrl: "https://trash.example.com", Username: "user", AuthToken: "token", Cacerts: "certs",
+		Type: helmType, ApiType: "native",
+	}
+	_, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: reg})
+	s.NoError(err)
+	_, err = s.client.CreateApplication(s.ProjectID(footen), &catalogv3.CreateApplicationRequest{Application: &catalogv3.Application{
+		Name: "trashapp", Version: "1.0.0", ChartName: "chart", ChartVersion: "1.0.0", HelmRegistryName: "trashreg",
+	}})
+	s.NoError(err)
+
+	_, err = s.client.DeleteRegistry(s.ProjectID(footen), &catalogv3.DeleteRegistryRequest{RegistryName: "trashreg"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = s.client.DeleteApplication(s.ProjectID(footen), &catalogv3.DeleteApplicationRequest{ApplicationName: "trashapp", Version: "1.0.0"})
+	s.NoError(err)
+	_, err = s.client.DeleteRegistry(s.ProjectID(footen), &catalogv3.DeleteRegistryRequest{RegistryName: "trashreg"})
+	s.NoError(err)
+
+	// Trashed registries are redacted
+	deleted := s.listDeleted("registry")
+	if s.Len(deleted, 1) && s.NotNil(deleted[0].Registry) {
+		s.Equal("https://trash.example.com", deleted[0].Registry.RootUrl)
+		s.Empty(deleted[0].Registry.AuthToken)
+		s.Empty(deleted[0].Registry.Username)
+	}
+
+	_, err = s.client.RestoreApplication(s.ProjectID(footen), &catalogv3.RestoreApplicationRequest{ApplicationName: "trashapp", Version: "1.0.0"})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+	s.Contains(err.Error(), "registry trashreg is deleted and must be restored first")
+
+	restored, err := s.client.RestoreRegistry(s.ProjectID(footen), &catalogv3.RestoreRegistryRequest{RegistryName: "trashreg"})
+	s.validateResponse(err, restored)
+	s.Empty(restored.Registry.AuthToken)
+	resp, err := s.client.GetRegistry(s.ProjectID(footen), &catalogv3.GetRegistryRequest{RegistryName: "trashreg", ShowSensitiveInfo: true})
+	s.validateResponse(err, resp)
+	s.validateRegistry(resp.Registry, "trashreg", "trashreg", "", "https://trash.example.com", "user", "token", "certs")
+	s.Equal("native", resp.Registry.ApiType)
+
+	_, err = s.client.RestoreApplication(s.ProjectID(footen), &catalogv3.RestoreApplicationRequest{ApplicationName: "trashapp", Version: "1.0.0"})
+	s.NoError(err)
+	s.Empty(s.listDeleted(""))
+
+	// Expired entities are purged
+	_, err = s.client.DeleteApplication(s.ProjectID(footen), &catalogv3.DeleteApplicationRequest{ApplicationName: "trashapp", Version: "1.0.0"})
+	s.NoError(err)
+	purged, err := PurgeExpiredTrash(s.ctx, s.dbClient, time.Now().Add(-time.Hour))
+	s.NoError(err)
+	s.Equal(0, purged)
+	purged, err = PurgeExpiredTrash(s.ctx, s.dbClient, time.Now().Add(time.Hour))
+	s.NoError(err)
+	s.Equal(1, purged)
+	s.Empty(s.listDeleted(""))
+
+	_, err = s.client.RestoreApplication(s.ProjectID(footen), &catalogv3.RestoreApplicationRequest{ApplicationName: "trashapp", Version: "1.0.0"})
+	s.Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestTrashRegistrySecrets() {
+	saveSecretFactory := SecretServiceFactory
+	saveUseSecretService := UseSecretService
+	defer func() {
+		SecretServiceFactory = saveSecretFactory
+		UseSecretService = saveUseSecretService
+	}()
+	SecretServiceFactory = newSecretsMap
+	UseSecretService = true
+	secretPath := MakeSecretPath(footen, "vaultreg")
+
+	createRegistry := func(token string) {
+		_, err := s.client.CreateRegistry(s.ProjectID(footen), &catalogv3.CreateRegistryRequest{Registry: &catalogv3.Registry{
+			Name: "vaultreg", RootUrl: "https://vault.example.com", AuthToken: token, Type: helmType,
+		}})
+		s.NoError(err)
+	}
+	deleteRegistry := func() {
+		_, err := s.client.DeleteRegistry(s.ProjectID(footen), &catalogv3.DeleteRegistryRequest{RegistryName: "vaultreg"})
+		s.NoError(err)
+	}
+
+	// The credentials remain in the secret service while the registry is in the trash
+	createRegistry("first")
+	deleteRegistry()
+	s.Contains(secretsMap, secretPath)
+	_, err := s.client.RestoreRegistry(s.ProjectID(footen), &catalogv3.RestoreRegistryRequest{RegistryName: "vaultreg"})
+	s.NoError(err)
+	resp, err := s.client.GetRegistry(s.ProjectID(footen), &catalogv3.GetRegistryRequest{RegistryName: "vaultreg", ShowSensitiveInfo: true})
+	s.validateResponse(err, resp)
+	s.Equal("first", resp.Registry.AuthToken)
+
+	// Purging a registry leaves the credentials of a registry created anew under its name in place
+	deleteRegistry()
+	createRegistry("second")
+	_, err = s.client.PurgeRegistry(s.ProjectID(footen), &catalogv3.PurgeRegistryRequest{RegistryName: "vaultreg"})
+	s.NoError(err)
+	s.Contains(secretsMap, secretPath)
+
+	deleteRegistry()
+	_, err = s.client.PurgeRegistry(s.ProjectID(footen), &catalogv3.PurgeRegistryRequest{RegistryName: "vaultreg"})
+	s.NoError(err)
+	s.NotContains(secretsMap, secretPath)
+}
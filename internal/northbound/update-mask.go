@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* The update RPCs replace the entity as a whole, unless the request carries an update mask listing the fields to be
+ * updated. In that case the listed fields are taken from the request and applied to the current state of the entity,
+ * and the result goes through the same validation and update as a complete entity would.
+ *
+ * Paths may refer to nested fields. A path traversing a list of named elements, such as "profiles.chart_values",
+ * updates the field of each of the current elements from the element of the same name, and version if the elements
+ * are versioned, given in the request; a path ending at the list replaces the list as a whole.
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Returns true if the update is confined to the fields listed in the given mask.
+func isPartialUpdate(mask *fieldmaskpb.FieldMask) bool {
+	return len(mask.GetPaths()) > 0
+}
+
+// Returns a copy of the current state of an entity with the fields listed in the mask taken from the update.
+func applyUpdateMask(resourceType errors.ResourceType, mask *fieldmaskpb.FieldMask, current proto.Message, update proto.Message) (proto.Message, error) {
+	for _, path := range mask.GetPaths() {
+		if err := validatePath(current.ProtoReflect().Descriptor(), strings.Split(path, ".")); err != nil {
+			return nil, errors.NewInvalidArgument(
+				errors.WithResourceType(resourceType),
+				errors.WithMessage("invalid update mask path %q: %s", path, err.Error()))
+		}
+	}
+
+	merged := proto.Clone(current)
+	for _, path := range mask.GetPaths() {
+		applyPath(merged.ProtoReflect(), update.ProtoReflect(), strings.Split(path, "."))
+	}
+	return merged, nil
+}
+
+// Validates that the given path refers to a field of the message, traversing only singular messages and lists of
+// named elements.
+func validatePath(md protoreflect.MessageDescriptor, parts []string) error {
+	fd := fieldByName(md, parts[0])
+	switch {
+	case fd == nil:
+		return fmt.Errorf("unknown field %s", parts[0])
+	case len(parts) == 1:
+		return nil
+	case fd.IsMap() || fd.Message() == nil:
+		return fmt.Errorf("%s has no nested fields", parts[0])
+	case fd.IsList() && fieldByName(fd.Message(), "name") == nil:
+		return fmt.Errorf("elements of %s are not named", parts[0])
+	}
+	return validatePath(fd.Message(), parts[1:])
+}
+
+// Applies the field at the given valid path from the source message to the destination message.
+func applyPath(dst protoreflect.Message, src protoreflect.Message, parts []string) {
+	fd := fieldByName(dst.Descriptor(), parts[0])
+	switch {
+	case len(parts) == 1 && src.Has(fd):
+		dst.Set(fd, src.Get(fd))
+	case len(parts) == 1:
+		dst.Clear(fd)
+	case fd.IsList():
+		dl, sl := dst.Mutable(fd).List(), src.Get(fd).List()
+		for i := 0; i < dl.Len(); i++ {
+			d := dl.Get(i).Message()
+			for j := 0; j < sl.Len(); j++ {
+				if s := sl.Get(j).Message(); sameElement(d, s) {
+					applyPath(d, s, parts[1:])
+					break
+				}
+			}
+		}
+	default:
+		applyPath(dst.Mutable(fd).Message(), src.Get(fd).Message(), parts[1:])
+	}
+}
+
+// Returns the field of the given name, either as declared or in its JSON form.
+func fieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return md.Fields().ByJSONName(name)
+}
+
+// Returns true if the two list elements have the same name and, if they are versioned, the same version.
+func sameElement(a protoreflect.Message, b protoreflect.Message) bool {
+	for _, key := range []string{"name", "version"} {
+		if fd := fieldByName(a.Descriptor(), key); fd != nil && a.Get(fd).String() != b.Get(fd).String() {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the entity resulting from applying the partial update to the given current state of the entity, which
+// is nil if the entity does not exist.
+func mergeUpdate(resourceType errors.ResourceType, name string, version string, mask *fieldmaskpb.FieldMask,
+	update proto.Message, current proto.Message, err error) (proto.Message, error) {
+	if err != nil {
+		return nil, err
+	} else if current == nil {
+		return nil, errors.NewNotFound(
+			errors.WithResourceType(resourceType),
+			errors.WithResourceName(name),
+			errors.WithResourceVersion(version))
+	}
+	return applyUpdateMask(resourceType, mask, current, update)
+}
+
+// Returns the registry resulting from applying the partial update to the current state of the registry.
+func (g *Server) maskedRegistry(ctx context.Context, tx *generated.Tx, projectUUID string, req *catalogv3.UpdateRegistryRequest) (*catalogv3.Registry, error) {
+	current, err := g.registrySnapshot(ctx, tx, projectUUID, req.RegistryName, true)
+	merged, err := mergeUpdate(errors.RegistryType, req.RegistryName, "", req.UpdateMask, req.Registry, current, err)
+	if err != nil {
+		return nil, err
+	}
+	reg := merged.(*catalogv3.Registry)
+	if err = validateRegistryUpdate(req.RegistryName, reg); err != nil {
+		return nil, err
+	}
+	return reg, nil
+}
+
+// Returns the artifact resulting from applying the partial update to the current state of the artifact.
+func (g *Server) maskedArtifact(ctx context.Context, tx *generated.Tx, projectUUID string, req *catalogv3.UpdateArtifactRequest) (*catalogv3.Artifact, error) {
+	current, err := g.artifactSnapshot(ctx, tx, projectUUID, req.ArtifactName)
+	merged, err := mergeUpdate(errors.ArtifactType, req.ArtifactName, "", req.UpdateMask, req.Artifact, current, err)
+	if err != nil {
+		return nil, err
+	}
+	art := merged.(*catalogv3.Artifact)
+	if err = validateArtifactUpdate(req.ArtifactName, art); err != nil {
+		return nil, err
+	}
+	return art, nil
+}
+
+// Returns the application resulting from applying the partial update to the current state of the application.
+func (g *Server) maskedApplication(ctx context.Context, tx *generated.Tx, projectUUID string, req *catalogv3.UpdateApplicationRequest) (*catalogv3.Application, error) {
+	current, err := g.applicationSnapshot(ctx, tx, projectUUID, req.ApplicationName, req.Version)
+	merged, err := mergeUpdate(errors.ApplicationType, req.ApplicationName, req.Version, req.UpdateMask, req.Application, current, err)
+	if err != nil {
+		return nil, err
+	}
+	app := merged.(*catalogv3.Application)
+	if err = validateApplicationUpdate(req.ApplicationName, req.Version, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// Returns the current state of the deployment package to which a partial update is applied. Only the explicitly
+// defined deployment profiles are included, as in an export; the synthetic default profile would otherwise be stored
+// as a real one.
+func deploymentPackageUpdateBase(ctx context.Context, tx *generated.Tx, projectUUID string, name string, version string) (proto.Message, error) {
+	pkgDB, err := tx.DeploymentPackage.Query().
+		Where(
+			deploymentpackage.ProjectUUID(projectUUID),
+			deploymentpackage.Name(name),
+			deploymentpackage.Version(version),
+		).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	pkg, err := extractDeploymentPackage(ctx, pkgDB)
+	if err != nil {
+		return nil, err
+	}
+	pkg.Profiles, err = extractDeploymentProfiles(ctx, pkgDB, hasDuplicateAppNames(pkg.ApplicationReferences), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkg.Profiles) == 0 {
+		pkg.DefaultProfileName = ""
+	}
+	return pkg, nil
+}
+
+// Returns the deployment package resulting from applying the partial update to the current state of the package.
+func (g *Server) maskedDeploymentPackage(ctx context.Context, tx *generated.Tx, projectUUID string, req *catalogv3.UpdateDeploymentPackageRequest) (*catalogv3.DeploymentPackage, error) {
+	current, err := deploymentPackageUpdateBase(ctx, tx, projectUUID, req.DeploymentPackageName, req.Version)
+	merged, err := mergeUpdate(errors.DeploymentPackageType, req.DeploymentPackageName, req.Version, req.UpdateMask, req.DeploymentPackage, current, err)
+	if err != nil {
+		return nil, err
+	}
+	pkg := merged.(*catalogv3.DeploymentPackage)
+	if err = validateDeploymentPackageUpdate(req.DeploymentPackageName, req.Version, pkg); err != nil {
+		return nil, err
+	}
+	return pkg, nil
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentpackage"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/deploymentprofile"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *NorthBoundTestSuite) TestPartialUpdateDeploymentPackage() {
+	ctx := s.ProjectID(footen)
+	created := s.createDeploymentPkg(footen, "masked", "0.1.0", "foo:v0.1.0")
+
+	// Only the listed fields change; the package need not be complete
+	_, err := s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "masked", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{IsVisible: true, DisplayName: "Ignored"},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"is_visible"}},
+	})
+	s.NoError(err)
+	resp, err := s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{DeploymentPackageName: "masked", Version: "0.1.0"})
+	s.NoError(err)
+	s.True(resp.DeploymentPackage.IsVisible)
+	s.Equal(created.DisplayName, resp.DeploymentPackage.DisplayName)
+	s.Equal(created.Description, resp.DeploymentPackage.Description)
+	s.Len(resp.DeploymentPackage.ApplicationReferences, 1)
+
+	// Fields listed in the mask but not set are cleared
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "masked", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"description", "isVisible"}},
+	})
+	s.NoError(err)
+	resp, err = s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{DeploymentPackageName: "masked", Version: "0.1.0"})
+	s.NoError(err)
+	s.False(resp.DeploymentPackage.IsVisible)
+	s.Empty(resp.DeploymentPackage.Description)
+	s.Equal(created.DisplayName, resp.DeploymentPackage.DisplayName)
+
+	// The merged package is validated as a whole
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "masked", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{Name: "renamed"},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	for _, path := range []string{"bogus", "description.length", "application_references.bogus"} {
+		_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+			DeploymentPackageName: "masked", Version: "0.1.0",
+			DeploymentPackage: &catalogv3.DeploymentPackage{},
+			UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		s.Equal(codes.InvalidArgument, status.Code(err), path)
+	}
+
+	_, err = s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "missing", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	s.Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestPartialUpdateImplicitDefaultProfile() {
+	ctx := s.ProjectID(footen)
+	s.createDeploymentPkg(footen, "masked", "0.1.0", "foo:v0.1.0")
+
+	_, err := s.client.UpdateDeploymentPackage(ctx, &catalogv3.UpdateDeploymentPackageRequest{
+		DeploymentPackageName: "masked", Version: "0.1.0",
+		DeploymentPackage: &catalogv3.DeploymentPackage{Description: "Masked package"},
+		UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	s.NoError(err)
+
+	// The synthetic default profile is not stored as a real one
+	count, err := s.dbClient.DeploymentProfile.Query().
+		Where(deploymentprofile.HasDeploymentPackageFkWith(deploymentpackage.Name("masked"))).
+		Count(s.ctx)
+	s.NoError(err)
+	s.Zero(count)
+	resp, err := s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{DeploymentPackageName: "masked", Version: "0.1.0"})
+	s.NoError(err)
+	s.Equal("Masked package", resp.DeploymentPackage.Description)
+	if s.Len(resp.DeploymentPackage.Profiles, 1) {
+		s.Equal("implicit-default", resp.DeploymentPackage.Profiles[0].Name)
+	}
+}
+
+func (s *NorthBoundTestSuite) TestPartialUpdateApplicationProfiles() {
+	ctx := s.ProjectID(footen)
+	getResp, err := s.client.GetApplication(ctx, &catalogv3.GetApplicationRequest{ApplicationName: "goo", Version: "v0.1.2"})
+	s.NoError(err)
+	original := getResp.Application
+
+	// Nested fields are updated for the profiles of the same name only
+	_, err = s.client.UpdateApplication(ctx, &catalogv3.UpdateApplicationRequest{
+		ApplicationName: "goo", Version: "v0.1.2",
+		Application: &catalogv3.Application{Profiles: []*catalogv3.Profile{
+			{Name: "p2", ChartValues: "goo: updated\n", DisplayName: "Ignored"},
+			{Name: "p9", ChartValues: "ignored: true\n"},
+		}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"profiles.chart_values"}},
+	})
+	s.NoError(err)
+
+	getResp, err = s.client.GetApplication(ctx, &catalogv3.GetApplicationRequest{ApplicationName: "goo", Version: "v0.1.2"})
+	s.NoError(err)
+	app := getResp.Application
+	s.Equal(original.DisplayName, app.DisplayName)
+	s.Equal(original.DefaultProfileName, app.DefaultProfileName)
+	if s.Len(app.Profiles, len(original.Profiles)) {
+		for i, p := range app.Profiles {
+			s.Equal(original.Profiles[i].Name, p.Name)
+			s.Equal(original.Profiles[i].DisplayName, p.DisplayName)
+			if p.Name == "p2" {
+				s.Equal("goo: updated\n", p.ChartValues)
+			} else {
+				s.Equal(original.Profiles[i].ChartValues, p.ChartValues)
+			}
+		}
+	}
+}
+
+func (s *NorthBoundTestSuite) TestPartialUpdateRegistryAndWebhook() {
+	ctx := s.ProjectID(footen)
+	s.createRegistry(footen, "masked", "HELM")
+	_, err := s.client.UpdateRegistry(ctx, &catalogv3.UpdateRegistryRequest{
+		RegistryName: "masked",
+		Registry:     &catalogv3.Registry{Description: "Masked registry"},
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	s.NoError(err)
+	resp, err := s.client.GetRegistry(ctx, &catalogv3.GetRegistryRequest{RegistryName: "masked", ShowSensitiveInfo: true})
+	s.NoError(err)
+	s.validateRegistry(resp.Registry, "masked", "Registry masked", "Masked registry",
+		"http://footen.com/masked", "admin", "token", "cacerts")
+
+	s.createWebhook(footen, &catalogv3.Webhook{Name: "hook", Url: "https://tools.example.com/hook", Secret: "s3cret", EventTypes: []string{"created"}})
+	_, err = s.client.UpdateWebhook(ctx, &catalogv3.UpdateWebhookRequest{
+		WebhookName: "hook",
+		Webhook:     &catalogv3.Webhook{Url: "https://tools.example.com/other"},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"url"}},
+	})
+	s.NoError(err)
+	stored, err := s.dbClient.Webhook.Query().Only(s.ctx)
+	s.NoError(err)
+	s.Equal("https://tools.example.com/other", stored.URL)
+	s.Equal("s3cret", stored.Secret)
+	s.Equal([]string{"created"}, stored.EventTypes)
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Uploads may be processed as a dry-run, in which case the upload session is loaded in full
+ * within a transaction that is always rolled back. As each entity is loaded, its state is captured
+ * before and after the create or update and the difference is recorded in the upload plan that is
+ * returned to the client instead of applying the changes.
+ *
+ * Dry-runs must not have any side effects outside the database transaction; in particular, registry
+ * secrets are not written to the secret service.
+ */
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	nberrors "github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/app-orch-catalog/pkg/schema/upload"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type dryRunKey struct{}
+
+// Returns a context marking the operations performed with it as part of a dry-run.
+func withDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// Returns true if the operations performed with the given context are part of a dry-run.
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// Snapshot of the current state of the entity described by the given spec.
+type entitySnapshot func(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) (proto.Message, error)
+
+// Processes the upload session as a dry-run and returns the plan of changes the upload would make.
+func (u *uploadSession) planUploadSession(ctx context.Context) (*catalogv3.UploadPlan, error) {
+	tx, err := u.g.startTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Always roll back; nothing loaded during a dry-run is to be committed
+	defer u.g.rollbackTransaction(tx)
+
+	u.plan = &catalogv3.UploadPlan{}
+	defer func() { u.plan = nil }()
+
+	if err = u.processUploadSession(withDryRun(ctx), tx); err != nil {
+		u.addValidationError(err)
+	}
+	return u.plan, nil
+}
+
+// Records the given error as a validation error in the upload plan.
+func (u *uploadSession) addValidationError(err error) {
+	u.plan.ValidationErrors = append(u.plan.ValidationErrors, status.Convert(err).Message())
+}
+
+// Records the change made to the entity described by the given spec in the upload plan, if one is being produced.
+func (u *uploadSession) addPlannedChange(d upload.YamlSpec, action catalogv3.UploadPlannedChange_Action, changedFields []string) {
+	if u.plan == nil {
+		return
+	}
+	u.plan.Changes = append(u.plan.Changes, &catalogv3.UploadPlannedChange{
+		FileName:      d.FileName,
+		SpecSchema:    specSchemaName(d.SpecSchema),
+		Name:          d.Name,
+		Version:       d.Version,
+		Action:        action,
+		ChangedFields: changedFields,
+	})
+}
+
+func (u *uploadSession) registrySnapshot(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) (proto.Message, error) {
+	snapshot, err := u.g.registrySnapshot(ctx, tx, u.projectUUID, d.Name, true)
+	return existingSnapshot(d, snapshot, err)
+}
+
+func (u *uploadSession) artifactSnapshot(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) (proto.Message, error) {
+	snapshot, err := u.g.artifactSnapshot(ctx, tx, u.projectUUID, d.Name)
+	return existingSnapshot(d, snapshot, err)
+}
+
+func (u *uploadSession) applicationSnapshot(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) (proto.Message, error) {
+	snapshot, err := u.g.applicationSnapshot(ctx, tx, u.projectUUID, d.Name, d.Version)
+	return existingSnapshot(d, snapshot, err)
+}
+
+func (u *uploadSession) deploymentPackageSnapshot(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) (proto.Message, error) {
+	snapshot, err := u.g.deploymentPackageSnapshot(ctx, tx, u.projectUUID, d.Name, d.Version)
+	return existingSnapshot(d, snapshot, err)
+}
+
+// Returns the given snapshot of the entity described by the spec, or an error if the entity does not exist.
+func existingSnapshot(d upload.YamlSpec, snapshot proto.Message, err error) (proto.Message, error) {
+	if err == nil && snapshot == nil {
+		return nil, nberrors.NewNotFound(
+			nberrors.WithResourceType(specResourceTypes[d.SpecSchema]),
+			nberrors.WithResourceName(d.Name),
+			nberrors.WithResourceVersion(d.Version))
+	}
+	return snapshot, err
+}
+
+// Returns the JSON names of the top-level fields whose values differ between the two messages of the
+// same type. The fields generated by the server are ignored at all levels.
+func changedFields(before proto.Message, after proto.Message) []string {
+	b := proto.Clone(before).ProtoReflect()
+	a := proto.Clone(after).ProtoReflect()
+	clearGeneratedFields(b)
+	clearGeneratedFields(a)
+
+	var fields []string
+	fds := b.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		bf, af := b.New(), a.New()
+		if b.Has(fd) {
+			bf.Set(fd, b.Get(fd))
+		}
+		if a.Has(fd) {
+			af.Set(fd, a.Get(fd))
+		}
+		if !proto.Equal(bf.Interface(), af.Interface()) {
+			fields = append(fields, fd.JSONName())
+		}
+	}
+	return fields
+}
+
+// Clears the fields generated by the server, i.e. the creation and update timestamps and the entity tags, of the
+// given message and all messages nested within it.
+func clearGeneratedFields(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Name() == "create_time" || fd.Name() == "update_time" || fd.Name() == "etag":
+			m.Clear(fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					clearGeneratedFields(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for i := 0; i < v.List().Len(); i++ {
+					clearGeneratedFields(v.List().Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			clearGeneratedFields(v.Message())
+		}
+		return true
+	})
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"bytes"
+	"context"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+)
+
+var uploadThingsFiles = []string{
+	"testdata/registry-intel.yaml",
+	"testdata/registry-new.yaml",
+	"testdata/artifact.yaml",
+	"testdata/application-librespeed.yaml",
+	"testdata/application-librespeed-0.0.2.yaml",
+	"testdata/deployment-package.yaml",
+	"testdata/deployment-package-old.yaml",
+	"testdata/values.yaml",
+}
+
+// Uploads the given files as a single dry-run session and returns the resulting plan.
+func (s *NorthBoundTestSuite) planUploads(ctx context.Context, uploads ...*catalogv3.Upload) *catalogv3.UploadPlan {
+	sessionID := ""
+	var resp *catalogv3.UploadCatalogEntitiesResponse
+	var err error
+	for i, u := range uploads {
+		last := i == len(uploads)-1
+		resp, err = s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+			SessionId: sessionID, LastUpload: last, DryRun: true, Upload: u,
+		})
+		s.validateResponse(err, resp)
+		if !last {
+			s.Nil(resp.Plan)
+		}
+		sessionID = resp.SessionId
+	}
+	s.NotNil(resp.Plan)
+	return resp.Plan
+}
+
+func (s *NorthBoundTestSuite) getUploads(fileNames ...string) []*catalogv3.Upload {
+	uploads := make([]*catalogv3.Upload, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		uploads = append(uploads, s.getUpload(fileName))
+	}
+	return uploads
+}
+
+func (s *NorthBoundTestSuite) TestUploadDryRunCreate() {
+	ctx := s.ProjectID("intel")
+	plan := s.planUploads(ctx, s.getUploads(uploadThingsFiles...)...)
+	s.Empty(plan.ValidationErrors)
+	if s.Len(plan.Changes, 7) {
+		for _, c := range plan.Changes {
+			s.Equal(catalogv3.UploadPlannedChange_ACTION_CREATE, c.Action, "%s %s", c.SpecSchema, c.Name)
+			s.Empty(c.ChangedFields)
+		}
+		s.Equal("Registry", plan.Changes[0].SpecSchema)
+		s.Equal("DeploymentPackage", plan.Changes[5].SpecSchema)
+		s.Equal("librespeed-app", plan.Changes[5].Name)
+		s.Equal("testdata/deployment-package.yaml", plan.Changes[5].FileName)
+	}
+
+	// Make sure nothing was actually created
+	_, err := s.client.GetRegistry(ctx, &catalogv3.GetRegistryRequest{RegistryName: "intel-harbor"})
+	s.validateNotFound(err, nil)
+	_, err = s.client.GetDeploymentPackage(ctx, &catalogv3.GetDeploymentPackageRequest{
+		DeploymentPackageName: "librespeed-app", Version: "0.0.2",
+	})
+	s.validateNotFound(err, nil)
+}
+
+func (s *NorthBoundTestSuite) TestUploadDryRunUpdate() {
+	ctx := s.ProjectID("intel")
+	s.uploadThings()
+
+	plan := s.planUploads(ctx, s.getUploads(uploadThingsFiles...)...)
+	s.Empty(plan.ValidationErrors)
+	if s.Len(plan.Changes, 7) {
+		for _, c := range plan.Changes {
+			s.Equal(catalogv3.UploadPlannedChange_ACTION_UNCHANGED, c.Action, "%s %s", c.SpecSchema, c.Name)
+		}
+	}
+
+	registryUpload := s.getUpload("testdata/registry-intel.yaml")
+	registryUpload.Artifact = bytes.Replace(registryUpload.Artifact,
+		[]byte(`description: "The registry"`), []byte(`description: "The updated registry"`), 1)
+	registryUpload.Artifact = bytes.Replace(registryUpload.Artifact,
+		[]byte(`https://registry.intel.com/repo/charts`), []byte(`https://registry.intel.com/repo/index`), 1)
+
+	plan = s.planUploads(ctx, registryUpload)
+	s.Empty(plan.ValidationErrors)
+	if s.Len(plan.Changes, 1) {
+		s.Equal(catalogv3.UploadPlannedChange_ACTION_UPDATE, plan.Changes[0].Action)
+		s.Equal("intel-harbor", plan.Changes[0].Name)
+		s.Equal([]string{"description", "inventoryUrl"}, plan.Changes[0].ChangedFields)
+	}
+
+	// Make sure nothing was actually updated
+	resp, err := s.client.GetRegistry(ctx, &catalogv3.GetRegistryRequest{RegistryName: "intel-harbor"})
+	s.validateResponse(err, resp)
+	s.Equal("The registry", resp.Registry.Description)
+	s.Equal("https://registry.intel.com/repo/charts", resp.Registry.InventoryUrl)
+}
+
+func (s *NorthBoundTestSuite) TestUploadDryRunValidationErrors() {
+	ctx := s.ProjectID("intel")
+	plan := s.planUploads(ctx, s.getUploads("testdata/badyaml/registry-intel.yaml", "testdata/badyaml/artifact.yaml")...)
+	s.Len(plan.ValidationErrors, 2)
+	s.Empty(plan.Changes)
+
+	// Loading stops at the first error raised while loading entities
+	plan = s.planUploads(ctx, s.getUploads("testdata/registry-intel.yaml", "testdata/application-librespeed.yaml")...)
+	if s.Len(plan.Changes, 1) {
+		s.Equal(catalogv3.UploadPlannedChange_ACTION_CREATE, plan.Changes[0].Action)
+	}
+	if s.Len(plan.ValidationErrors, 1) {
+		s.Contains(plan.ValidationErrors[0], "uploaded file testdata/application-librespeed.yaml")
+	}
+}
+
+func (s *NorthBoundTestSuite) TestUploadDryRunWithSecretService() {
+	saveSecretFactory := SecretServiceFactory
+	saveUseSecretService := UseSecretService
+	saveErrorOnWrite := errorOnWrite
+	defer func() {
+		SecretServiceFactory = saveSecretFactory
+		UseSecretService = saveUseSecretService
+		errorOnWrite = saveErrorOnWrite
+	}()
+
+	// Dry-runs must not write to the secret service
+	SecretServiceFactory = testSecretServiceFactory
+	UseSecretService = true
+	errorOnWrite = true
+
+	plan := s.planUploads(s.ProjectID("intel"), s.getUploads("testdata/registry-intel.yaml")...)
+	s.Empty(plan.ValidationErrors)
+	if s.Len(plan.Changes, 1) {
+		s.Equal(catalogv3.UploadPlannedChange_ACTION_CREATE, plan.Changes[0].Action)
+	}
+}
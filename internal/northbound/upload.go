@@ -40,6 +40,9 @@ import (
 	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/registry"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"github.com/open-edge-platform/app-orch-catalog/pkg/malware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	yaml "gopkg.in/yaml.v3"
 )
 
@@ -59,6 +62,17 @@ type uploadSession struct {
 	uploads     []*catalogv3.Upload
 	g           *Server
 
+	// Plan of changes being produced; set only while the session is processed as a dry-run
+	plan *catalogv3.UploadPlan
+
+	// Policies applied to uploaded entities that already exist and the entities skipped as a result
+	conflictPolicy   catalogv3.UploadConflictPolicy
+	conflictPolicies *catalogv3.UploadConflictPolicies
+	skipped          []*catalogv3.UploadEntityReference
+
+	// Outcome for each entity specification processed so far
+	results []*catalogv3.UploadResult
+
 	registryEvents          *RegistryEvents
 	artifactEvents          *ArtifactEvents
 	applicationEvents       *ApplicationEvents
@@ -159,6 +173,20 @@ func (g *Server) UploadCatalogEntities(ctx context.Context, req *catalogv3.Uploa
 	session.uploads = append(session.uploads, req.Upload)
 	resp := &catalogv3.UploadCatalogEntitiesResponse{SessionId: session.sessionID, ErrorMessages: nil}
 
+	if req.LastUpload {
+		session.conflictPolicy = req.ConflictPolicy
+		session.conflictPolicies = req.ConflictPolicies
+	}
+
+	// If this is a last upload of a dry-run, process all uploaded entities without committing them
+	if req.LastUpload && req.DryRun {
+		resp.Plan, err = session.planUploadSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
 	// If this is a last upload, process all uploaded entities in a single transaction
 	if req.LastUpload {
 		tx, err := g.startTransaction(ctx)
@@ -167,6 +195,11 @@ func (g *Server) UploadCatalogEntities(ctx context.Context, req *catalogv3.Uploa
 		}
 
 		if err := session.processUploadSession(ctx, tx); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, session.withResults(err)
+		}
+
+		if err := session.persistEvents(ctx, tx); err != nil {
 			g.rollbackTransaction(tx)
 			return nil, err
 		}
@@ -180,12 +213,17 @@ func (g *Server) UploadCatalogEntities(ctx context.Context, req *catalogv3.Uploa
 		session.artifactEvents.sendToAll(g.listeners)
 		session.applicationEvents.sendToAll(g.listeners)
 		session.deploymentPackageEvents.sendToAll(g.listeners)
+		resp.Skipped = session.skipped
+		resp.Results = session.results
 	}
 
 	return resp, nil
 }
 
 func (u *uploadSession) processUploadSession(ctx context.Context, tx *generated.Tx) error {
+	u.skipped = nil
+	u.results = nil
+
 	// Turn the uploads into independent filesets. Each tarball will be a fileset, and
 	// any raw files will be collected into a fileset.
 	fileSets, err := u.loadFileSets()
@@ -213,9 +251,12 @@ func (u *uploadSession) processUploadSession(ctx context.Context, tx *generated.
 			case upload.ArtifactType:
 				err = u.loadArtifact(ctx, tx, d)
 			default:
-				return nberrors.NewInvalidArgument(nberrors.WithMessage("uploaded file %s: unhandled type %s", d.FileName, d.SpecSchema))
+				err = nberrors.NewInvalidArgument(nberrors.WithMessage("uploaded file %s: unhandled type %s", d.FileName, d.SpecSchema))
+				u.addFailedResult(d, err)
+				return err
 			}
 			if err != nil {
+				u.addFailedResult(d, err)
 				return nberrors.NewInvalidArgument(nberrors.WithError(err), nberrors.WithMessage("uploaded file %s: %v", d.FileName, err))
 			}
 		}
@@ -224,6 +265,20 @@ func (u *uploadSession) processUploadSession(ctx context.Context, tx *generated.
 	return nil
 }
 
+// Records the events of all entities loaded by the session in the outbox.
+func (u *uploadSession) persistEvents(ctx context.Context, tx *generated.Tx) error {
+	if err := u.registryEvents.persist(ctx, tx); err != nil {
+		return err
+	}
+	if err := u.artifactEvents.persist(ctx, tx); err != nil {
+		return err
+	}
+	if err := u.applicationEvents.persist(ctx, tx); err != nil {
+		return err
+	}
+	return u.deploymentPackageEvents.persist(ctx, tx)
+}
+
 // shouldValidateYAMLSchema determines if the schema checker should run on the given
 // artifact. If the YAML can be unmarshaled or contains the schema directive,
 // it should be validated. Values files containing {{ }} markers should
@@ -295,7 +350,9 @@ func (u *uploadSession) loadFileSets() ([]fileSet, error) {
 			// The file is a tarvball. Extract the individual files from it and add them to a fileset
 			extractedFileSet, err := u.extractTarball(file.Artifact)
 			if err != nil {
-				return nil, fmt.Errorf("failed to extract tarball %s: %w", file.FileName, err)
+				err = fmt.Errorf("failed to extract tarball %s: %w", file.FileName, err)
+				u.addFailedResult(upload.YamlSpec{FileName: file.FileName}, err)
+				return nil, err
 			}
 			fileSets = append(fileSets, extractedFileSet)
 		} else {
@@ -317,43 +374,68 @@ func (u *uploadSession) loadFileSets() ([]fileSet, error) {
 func (u *uploadSession) loadYamlSpecs(files fileSet) (upload.YamlSpecs, error) {
 	orderedSpecs := make(upload.YamlSpecs, 0)
 
-	for fileName, fileBytes := range files {
-		if shouldValidateYAMLSchema(fileBytes) {
-			// Deal only with files that can be successfully unmarshalled; value.yaml files with templates can't be for example
-			decoder := yaml.NewDecoder(bytes.NewBuffer(fileBytes))
-			for {
-				var d upload.YamlSpec
-				if err := decoder.Decode(&d); err != nil {
-					if errors.Is(err, io.EOF) {
-						break
-					}
-					return nil, fmt.Errorf("document decode failed: %w", err)
-				}
-				d.FileName = fileName
-				if d.SpecSchema != "" {
-					// check that the uploaded YAML complies with the schema
-					v, err := validator.NewValidator()
-					if err != nil {
-						return nil, err
-					}
-
-					err = v.Validate(fileBytes)
-					if err != nil {
-						log.Infof("YAML validation failed for %s:%s", fileName, err)
-						return nil, nberrors.NewInvalidArgument(
-							nberrors.WithMessage("uploaded file %s is invalid YAML: %+v", fileName, err),
-							nberrors.WithError(err))
-					}
-					orderedSpecs = append(orderedSpecs, d)
-				}
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		specs, err := u.loadYamlFile(fileName, files[fileName])
+		if err != nil {
+			u.addFailedResult(upload.YamlSpec{FileName: fileName}, err)
+			// When planning, report all invalid files rather than just the first one
+			if u.plan == nil {
+				return nil, err
 			}
+			u.addValidationError(err)
+			continue
 		}
+		orderedSpecs = append(orderedSpecs, specs...)
 	}
 
 	sort.Sort(orderedSpecs)
 	return orderedSpecs, nil
 }
 
+// loadYamlFile loads the YamlSpecs from the documents of the specified file
+func (u *uploadSession) loadYamlFile(fileName string, fileBytes []byte) (upload.YamlSpecs, error) {
+	specs := make(upload.YamlSpecs, 0)
+	if !shouldValidateYAMLSchema(fileBytes) {
+		return specs, nil
+	}
+
+	// Deal only with files that can be successfully unmarshalled; value.yaml files with templates can't be for example
+	decoder := yaml.NewDecoder(bytes.NewBuffer(fileBytes))
+	for {
+		var d upload.YamlSpec
+		if err := decoder.Decode(&d); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("document decode failed: %w", err)
+		}
+		d.FileName = fileName
+		if d.SpecSchema != "" {
+			// check that the uploaded YAML complies with the schema
+			v, err := validator.NewValidator()
+			if err != nil {
+				return nil, err
+			}
+
+			err = v.Validate(fileBytes)
+			if err != nil {
+				log.Infof("YAML validation failed for %s:%s", fileName, err)
+				return nil, nberrors.NewInvalidArgument(
+					nberrors.WithMessage("uploaded file %s is invalid YAML: %+v", fileName, err),
+					nberrors.WithError(err))
+			}
+			specs = append(specs, d)
+		}
+	}
+	return specs, nil
+}
+
 func valueOrDefault(val string, def string) string {
 	if val == "" {
 		return def
@@ -361,6 +443,177 @@ func valueOrDefault(val string, def string) string {
 	return val
 }
 
+func deprecationFromSpec(d *upload.Deprecation) *catalogv3.Deprecation {
+	if d == nil {
+		return nil
+	}
+	return &catalogv3.Deprecation{Message: d.Message, ReplacementVersion: d.ReplacementVersion}
+}
+
+// Maps the entity spec schemas to the corresponding resource types.
+var specResourceTypes = map[string]nberrors.ResourceType{
+	upload.RegistryType:                nberrors.RegistryType,
+	upload.ArtifactType:                nberrors.ArtifactType,
+	upload.ApplicationType:             nberrors.ApplicationType,
+	upload.DeploymentPackageType:       nberrors.DeploymentPackageType,
+	upload.DeploymentPackageLegacyType: nberrors.DeploymentPackageType,
+}
+
+// Returns the canonical name of the given entity spec schema.
+func specSchemaName(specSchema string) string {
+	if specSchema == upload.DeploymentPackageLegacyType {
+		return upload.DeploymentPackageType
+	}
+	return specSchema
+}
+
+// Returns the policy to apply if the entity of the given spec schema already exists.
+func (u *uploadSession) conflictPolicyFor(specSchema string) catalogv3.UploadConflictPolicy {
+	policy := catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_UNSPECIFIED
+	switch specSchemaName(specSchema) {
+	case upload.RegistryType:
+		policy = u.conflictPolicies.GetRegistries()
+	case upload.ArtifactType:
+		policy = u.conflictPolicies.GetArtifacts()
+	case upload.ApplicationType:
+		policy = u.conflictPolicies.GetApplications()
+	case upload.DeploymentPackageType:
+		policy = u.conflictPolicies.GetDeploymentPackages()
+	}
+	if policy == catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_UNSPECIFIED {
+		return u.conflictPolicy
+	}
+	return policy
+}
+
+// Creates the entity described by the given spec using the supplied function and records the creation.
+func (u *uploadSession) createEntity(d upload.YamlSpec, create func() error) error {
+	if err := create(); err != nil {
+		return err
+	}
+	u.addPlannedChange(d, catalogv3.UploadPlannedChange_ACTION_CREATE, nil)
+	u.addResult(d, catalogv3.UploadResult_ACTION_CREATED, nil)
+	return nil
+}
+
+// Updates the existing entity described by the given spec using the supplied function, unless the conflict
+// policy says otherwise. Whether anything changed is told by comparing the state of the entity captured by the
+// update with the updated entity queued in its event. If a plan is being produced, the entity is rather captured
+// in full before and after the update, and the fields that differ are recorded in the plan.
+func (u *uploadSession) updateEntity(ctx context.Context, tx *generated.Tx, d upload.YamlSpec, snapshot entitySnapshot,
+	updated updatedEntity, update func() error) error {
+	switch u.conflictPolicyFor(d.SpecSchema) {
+	case catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_FAIL:
+		return nberrors.NewAlreadyExists(
+			nberrors.WithResourceType(specResourceTypes[d.SpecSchema]),
+			nberrors.WithResourceName(d.Name),
+			nberrors.WithResourceVersion(d.Version))
+	case catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_SKIP:
+		u.skipped = append(u.skipped, &catalogv3.UploadEntityReference{
+			FileName:   d.FileName,
+			SpecSchema: specSchemaName(d.SpecSchema),
+			Name:       d.Name,
+			Version:    d.Version,
+		})
+		u.addPlannedChange(d, catalogv3.UploadPlannedChange_ACTION_SKIP, nil)
+		u.addResult(d, catalogv3.UploadResult_ACTION_SKIPPED, nil)
+		return nil
+	}
+
+	var before, after proto.Message
+	var err error
+	if u.plan != nil {
+		if before, err = snapshot(ctx, tx, d); err != nil {
+			return err
+		}
+	}
+	if err = update(); err != nil {
+		return err
+	}
+	if u.plan != nil {
+		if after, err = snapshot(ctx, tx, d); err != nil {
+			return err
+		}
+	} else {
+		before, after = updated(d)
+	}
+
+	var fields []string
+	changed := before == nil
+	if !changed {
+		fields = changedFields(before, after)
+		changed = len(fields) > 0
+	}
+	if !changed {
+		u.addPlannedChange(d, catalogv3.UploadPlannedChange_ACTION_UNCHANGED, nil)
+		u.addResult(d, catalogv3.UploadResult_ACTION_UNCHANGED, nil)
+	} else {
+		u.addPlannedChange(d, catalogv3.UploadPlannedChange_ACTION_UPDATE, fields)
+		u.addResult(d, catalogv3.UploadResult_ACTION_UPDATED, nil)
+	}
+	return nil
+}
+
+// State of the entity described by the given spec captured before its update, and the updated entity.
+type updatedEntity func(d upload.YamlSpec) (proto.Message, proto.Message)
+
+func (u *uploadSession) updatedRegistry(d upload.YamlSpec) (proto.Message, proto.Message) {
+	queue := u.registryEvents.queue
+	return u.registryEvents.capturedBefore(d.Name, ""), queue[len(queue)-1].Registry
+}
+
+func (u *uploadSession) updatedArtifact(d upload.YamlSpec) (proto.Message, proto.Message) {
+	queue := u.artifactEvents.queue
+	return u.artifactEvents.capturedBefore(d.Name, ""), queue[len(queue)-1].Artifact
+}
+
+func (u *uploadSession) updatedApplication(d upload.YamlSpec) (proto.Message, proto.Message) {
+	queue := u.applicationEvents.queue
+	return u.applicationEvents.capturedBefore(d.Name, d.Version), queue[len(queue)-1].Application
+}
+
+func (u *uploadSession) updatedDeploymentPackage(d upload.YamlSpec) (proto.Message, proto.Message) {
+	queue := u.deploymentPackageEvents.queue
+	return u.deploymentPackageEvents.capturedBefore(d.Name, d.Version), queue[len(queue)-1].DeploymentPackage
+}
+
+// Records the outcome of loading the entity described by the given spec.
+func (u *uploadSession) addResult(d upload.YamlSpec, action catalogv3.UploadResult_Action, uploadErr *catalogv3.UploadError) {
+	u.results = append(u.results, &catalogv3.UploadResult{
+		FileName:   d.FileName,
+		SpecSchema: specSchemaName(d.SpecSchema),
+		Name:       d.Name,
+		Version:    d.Version,
+		Action:     action,
+		Error:      uploadErr,
+	})
+}
+
+// Records the failure to load the entity described by the given spec, or the file containing it. Errors
+// without a gRPC status stem from reading the uploaded files and are reported as invalid arguments.
+func (u *uploadSession) addFailedResult(d upload.YamlSpec, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(codes.InvalidArgument, err.Error())
+	}
+	u.addResult(d, catalogv3.UploadResult_ACTION_FAILED, &catalogv3.UploadError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	})
+}
+
+// Attaches the results of the failed upload to the given error as a status detail.
+func (u *uploadSession) withResults(err error) error {
+	st, detailErr := status.Convert(err).WithDetails(&catalogv3.UploadCatalogEntitiesResponse{
+		SessionId: u.sessionID,
+		Results:   u.results,
+	})
+	if detailErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
 func (u *uploadSession) loadRegistry(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) error {
 	reg := &catalogv3.Registry{
 		Name:         d.Name,
@@ -373,14 +626,19 @@ func (u *uploadSession) loadRegistry(ctx context.Context, tx *generated.Tx, d up
 		Type:         valueOrDefault(d.GetRegistryType(), helmType),
 		ApiType:      d.APIType,
 		Cacerts:      d.CACerts,
+		Labels:       d.Labels,
 	}
 
 	_, err := tx.Registry.Query().Where(registry.ProjectUUID(u.projectUUID), registry.Name(reg.Name)).First(ctx)
 	if err != nil {
-		_, err = u.g.createRegistry(ctx, tx, u.projectUUID, reg, u.registryEvents)
-		return err
+		return u.createEntity(d, func() error {
+			_, err := u.g.createRegistry(ctx, tx, u.projectUUID, reg, u.registryEvents)
+			return err
+		})
 	}
-	return u.g.updateRegistry(ctx, tx, u.projectUUID, reg, u.registryEvents)
+	return u.updateEntity(ctx, tx, d, u.registrySnapshot, u.updatedRegistry, func() error {
+		return u.g.updateRegistry(ctx, tx, u.projectUUID, reg, u.registryEvents)
+	})
 }
 
 func (u *uploadSession) loadArtifact(ctx context.Context, tx *generated.Tx, d upload.YamlSpec) error {
@@ -419,10 +677,14 @@ func (u *uploadSession) loadArtifact(ctx context.Context, tx *generated.Tx, d up
 
 	_, err = tx.Artifact.Query().Where(artifact.ProjectUUID(u.projectUUID), artifact.Name(art.Name)).First(ctx)
 	if err != nil {
-		_, err = u.g.createArtifact(ctx, tx, u.projectUUID, art, u.artifactEvents)
-		return err
+		return u.createEntity(d, func() error {
+			_, err := u.g.createArtifact(ctx, tx, u.projectUUID, art, u.artifactEvents)
+			return err
+		})
 	}
-	return u.g.updateArtifact(ctx, tx, u.projectUUID, art, u.artifactEvents)
+	return u.updateEntity(ctx, tx, d, u.artifactSnapshot, u.updatedArtifact, func() error {
+		return u.g.updateArtifact(ctx, tx, u.projectUUID, art, u.artifactEvents)
+	})
 }
 
 func (u *uploadSession) loadApplication(ctx context.Context, tx *generated.Tx, d upload.YamlSpec, f fileSet) error {
@@ -430,6 +692,8 @@ func (u *uploadSession) loadApplication(ctx context.Context, tx *generated.Tx, d
 		Name:               d.Name,
 		Version:            d.Version,
 		Kind:               kindFromDB(d.Kind),
+		Labels:             d.Labels,
+		Deprecation:        deprecationFromSpec(d.Deprecation),
 		DisplayName:        d.DisplayName,
 		Description:        d.Description,
 		ChartName:          d.ChartName,
@@ -466,10 +730,14 @@ func (u *uploadSession) loadApplication(ctx context.Context, tx *generated.Tx, d
 
 	_, err := tx.Application.Query().Where(application.ProjectUUID(u.projectUUID), application.Name(app.Name), application.Version(app.Version)).First(ctx)
 	if err != nil {
-		_, err = u.g.createApplication(ctx, tx, u.projectUUID, app, u.applicationEvents)
-		return err
+		return u.createEntity(d, func() error {
+			_, err := u.g.createApplication(ctx, tx, u.projectUUID, app, u.applicationEvents)
+			return err
+		})
 	}
-	return u.g.updateApplication(ctx, tx, u.projectUUID, app, u.applicationEvents)
+	return u.updateEntity(ctx, tx, d, u.applicationSnapshot, u.updatedApplication, func() error {
+		return u.g.updateApplication(ctx, tx, u.projectUUID, app, u.applicationEvents)
+	})
 }
 
 func (u *uploadSession) loadProfile(appFileName string, p upload.Profile, f fileSet) (*catalogv3.Profile, error) {
@@ -525,6 +793,8 @@ func (u *uploadSession) loadDeploymentPackage(ctx context.Context, tx *generated
 		Description:             d.Description,
 		Version:                 d.Version,
 		Kind:                    kindFromDB(d.Kind),
+		Labels:                  d.Labels,
+		Deprecation:             deprecationFromSpec(d.Deprecation),
 		DefaultProfileName:      d.DefaultProfile,
 		Profiles:                make([]*catalogv3.DeploymentProfile, 0, len(d.DeploymentProfiles)),
 		ApplicationReferences:   make([]*catalogv3.ApplicationReference, 0, len(d.Applications)),
@@ -630,10 +900,14 @@ func (u *uploadSession) loadDeploymentPackage(ctx context.Context, tx *generated
 	_, err := tx.DeploymentPackage.Query().Where(deploymentpackage.ProjectUUID(u.projectUUID),
 		deploymentpackage.Name(pkg.Name), deploymentpackage.Version(pkg.Version)).First(ctx)
 	if err != nil {
-		_, err = u.g.createDeploymentPackage(ctx, tx, u.projectUUID, pkg, u.deploymentPackageEvents)
-		return err
+		return u.createEntity(d, func() error {
+			_, err := u.g.createDeploymentPackage(ctx, tx, u.projectUUID, pkg, u.deploymentPackageEvents)
+			return err
+		})
 	}
-	return u.g.updateDeploymentPackage(ctx, tx, u.projectUUID, pkg, u.deploymentPackageEvents)
+	return u.updateEntity(ctx, tx, d, u.deploymentPackageSnapshot, u.updatedDeploymentPackage, func() error {
+		return u.g.updateDeploymentPackage(ctx, tx, u.projectUUID, pkg, u.deploymentPackageEvents)
+	})
 }
 
 func (u *uploadSession) deploymentProfile(deploymentProfile upload.DeploymentProfile) *catalogv3.DeploymentProfile {
@@ -5,11 +5,15 @@
 package northbound
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	internaltesting "github.com/open-edge-platform/app-orch-catalog/internal/testing"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"github.com/open-edge-platform/app-orch-catalog/pkg/malware"
 	"github.com/open-edge-platform/app-orch-catalog/pkg/schema/upload"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"os"
 	"time"
 )
@@ -381,3 +385,190 @@ func (s *NorthBoundTestSuite) TestUploadAppDeps() {
 	s.Len(appB.DeploymentPackage.DefaultNamespaces, 1)
 	s.Equal("ns", appB.DeploymentPackage.DefaultNamespaces["b"])
 }
+
+// Returns the registry upload with the description changed to the given one
+func (s *NorthBoundTestSuite) getRegistryUpload(description string) *catalogv3.Upload {
+	u := s.getUpload("testdata/registry-intel.yaml")
+	u.Artifact = bytes.Replace(u.Artifact, []byte(`description: "The registry"`),
+		[]byte(fmt.Sprintf("description: %q", description)), 1)
+	return u
+}
+
+func (s *NorthBoundTestSuite) getRegistryDescription(ctx context.Context) string {
+	resp, err := s.client.GetRegistry(ctx, &catalogv3.GetRegistryRequest{RegistryName: "intel-harbor"})
+	s.validateResponse(err, resp)
+	return resp.Registry.Description
+}
+
+func (s *NorthBoundTestSuite) TestUploadConflictPolicySkip() {
+	ctx := s.ProjectID("intel")
+	s.uploadThings()
+
+	resp, err := s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, Upload: s.getRegistryUpload("Skipped"),
+		ConflictPolicy: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_SKIP,
+	})
+	s.validateResponse(err, resp)
+	if s.Len(resp.Skipped, 1) {
+		s.Equal("intel-harbor", resp.Skipped[0].Name)
+		s.Equal(upload.RegistryType, resp.Skipped[0].SpecSchema)
+		s.Equal("testdata/registry-intel.yaml", resp.Skipped[0].FileName)
+	}
+	s.Equal("The registry", s.getRegistryDescription(ctx))
+}
+
+func (s *NorthBoundTestSuite) TestUploadConflictPolicyFail() {
+	ctx := s.ProjectID("intel")
+	s.uploadThings()
+
+	resp, err := s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, Upload: s.getRegistryUpload("Failed"),
+		ConflictPolicy: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_FAIL,
+	})
+	s.validateError(err, codes.InvalidArgument, resp)
+	s.Contains(err.Error(), "registry intel-harbor already exists")
+	s.Equal("The registry", s.getRegistryDescription(ctx))
+}
+
+func (s *NorthBoundTestSuite) TestUploadConflictPolicyPerKind() {
+	ctx := s.ProjectID("intel")
+	s.uploadThings()
+
+	resp, err := s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		Upload: s.getUpload("testdata/artifact.yaml"),
+	})
+	s.validateResponse(err, resp)
+	resp, err = s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		SessionId: resp.SessionId, LastUpload: true, Upload: s.getRegistryUpload("Overwritten"),
+		ConflictPolicy: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_SKIP,
+		ConflictPolicies: &catalogv3.UploadConflictPolicies{
+			Registries: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_OVERWRITE,
+		},
+	})
+	s.validateResponse(err, resp)
+	if s.Len(resp.Skipped, 1) {
+		s.Equal("librespeed-thumbnail", resp.Skipped[0].Name)
+		s.Equal(upload.ArtifactType, resp.Skipped[0].SpecSchema)
+	}
+	s.Equal("Overwritten", s.getRegistryDescription(ctx))
+
+	// Per-kind policies also apply to dry-runs
+	resp, err = s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, DryRun: true, Upload: s.getRegistryUpload("Planned"),
+		ConflictPolicies: &catalogv3.UploadConflictPolicies{
+			Registries: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_FAIL,
+		},
+	})
+	s.validateResponse(err, resp)
+	if s.Len(resp.Plan.ValidationErrors, 1) {
+		s.Contains(resp.Plan.ValidationErrors[0], "registry intel-harbor already exists")
+	}
+
+	resp, err = s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, DryRun: true, Upload: s.getRegistryUpload("Planned"),
+		ConflictPolicies: &catalogv3.UploadConflictPolicies{
+			Registries: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_SKIP,
+		},
+	})
+	s.validateResponse(err, resp)
+	if s.Len(resp.Plan.Changes, 1) {
+		s.Equal(catalogv3.UploadPlannedChange_ACTION_SKIP, resp.Plan.Changes[0].Action)
+	}
+	s.Empty(resp.Skipped)
+}
+
+// Uploads the given files as a single session and returns the response to the last upload request.
+func (s *NorthBoundTestSuite) uploadFiles(ctx context.Context, uploads ...*catalogv3.Upload) (*catalogv3.UploadCatalogEntitiesResponse, error) {
+	sessionID := ""
+	for i, u := range uploads {
+		resp, err := s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+			SessionId: sessionID, LastUpload: i == len(uploads)-1, Upload: u,
+		})
+		if err != nil || i == len(uploads)-1 {
+			return resp, err
+		}
+		sessionID = resp.SessionId
+	}
+	return nil, nil
+}
+
+func (s *NorthBoundTestSuite) TestUploadResults() {
+	ctx := s.ProjectID("intel")
+	resp, err := s.uploadFiles(ctx, s.getUploads(uploadThingsFiles...)...)
+	s.validateResponse(err, resp)
+	if s.Len(resp.Results, 7) {
+		for _, r := range resp.Results {
+			s.Equal(catalogv3.UploadResult_ACTION_CREATED, r.Action, "%s %s", r.SpecSchema, r.Name)
+			s.Nil(r.Error)
+		}
+		s.Equal(upload.RegistryType, resp.Results[0].SpecSchema)
+		s.Equal(upload.DeploymentPackageType, resp.Results[5].SpecSchema)
+		s.Equal("librespeed-app", resp.Results[5].Name)
+		s.Equal("0.0.2", resp.Results[5].Version)
+		s.Equal("testdata/deployment-package.yaml", resp.Results[5].FileName)
+	}
+
+	resp, err = s.uploadFiles(ctx, s.getRegistryUpload("Updated"), s.getUpload("testdata/artifact.yaml"))
+	s.validateResponse(err, resp)
+	if s.Len(resp.Results, 2) {
+		s.Equal(catalogv3.UploadResult_ACTION_UPDATED, resp.Results[0].Action)
+		s.Equal("intel-harbor", resp.Results[0].Name)
+		s.Equal(catalogv3.UploadResult_ACTION_UNCHANGED, resp.Results[1].Action)
+		s.Equal("librespeed-thumbnail", resp.Results[1].Name)
+	}
+
+	// Uploading the same entities again leaves them all unchanged
+	resp, err = s.uploadFiles(ctx, s.getUploads(uploadThingsFiles...)...)
+	s.validateResponse(err, resp)
+	if s.Len(resp.Results, 7) {
+		for _, r := range resp.Results {
+			if r.Name == "intel-harbor" {
+				s.Equal(catalogv3.UploadResult_ACTION_UPDATED, r.Action, "%s %s", r.SpecSchema, r.Name)
+			} else {
+				s.Equal(catalogv3.UploadResult_ACTION_UNCHANGED, r.Action, "%s %s", r.SpecSchema, r.Name)
+			}
+		}
+	}
+}
+
+func (s *NorthBoundTestSuite) TestUploadResultsOnFailure() {
+	ctx := s.ProjectID("intel")
+	s.uploadThings()
+
+	resp, err := s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, Upload: s.getRegistryUpload("Failed"),
+		ConflictPolicy: catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_FAIL,
+	})
+	s.validateError(err, codes.InvalidArgument, resp)
+
+	details := status.Convert(err).Details()
+	if s.Len(details, 1) {
+		failed, ok := details[0].(*catalogv3.UploadCatalogEntitiesResponse)
+		if s.True(ok) && s.Len(failed.Results, 1) {
+			result := failed.Results[0]
+			s.Equal(catalogv3.UploadResult_ACTION_FAILED, result.Action)
+			s.Equal("intel-harbor", result.Name)
+			s.Equal("testdata/registry-intel.yaml", result.FileName)
+			if s.NotNil(result.Error) {
+				s.Equal(codes.AlreadyExists.String(), result.Error.Code)
+				s.Equal("registry intel-harbor already exists", result.Error.Message)
+			}
+		}
+	}
+
+	// Files that cannot be parsed are reported without the entity they describe
+	resp, err = s.client.UploadCatalogEntities(ctx, &catalogv3.UploadCatalogEntitiesRequest{
+		LastUpload: true, Upload: s.getUpload("testdata/badyaml/artifact.yaml"),
+	})
+	s.validateError(err, codes.InvalidArgument, resp)
+	details = status.Convert(err).Details()
+	if s.Len(details, 1) {
+		failed, ok := details[0].(*catalogv3.UploadCatalogEntitiesResponse)
+		if s.True(ok) && s.Len(failed.Results, 1) {
+			s.Equal(catalogv3.UploadResult_ACTION_FAILED, failed.Results[0].Action)
+			s.Equal("testdata/badyaml/artifact.yaml", failed.Results[0].FileName)
+			s.Empty(failed.Results[0].Name)
+			s.NotNil(failed.Results[0].Error)
+		}
+	}
+}
@@ -24,19 +24,12 @@ const (
 	vaultRevokeSelfURL = `/v1/auth/token/revoke-self` // #nosec
 )
 
-type SecretService interface {
-	ReadSecret(ctx context.Context, path string) (string, error)
-	WriteSecret(ctx context.Context, path string, secret string) error
-	DeleteSecret(ctx context.Context, path string) error
-	Logout(ctx context.Context)
-}
-
 type vaultServer struct {
 	httpClient *http.Client
 	vaultToken string
 }
 
-func newSecretService(ctx context.Context) (SecretService, error) {
+func newVaultSecretService(ctx context.Context) (SecretService, error) {
 	ss := &vaultServer{}
 	err := ss.login(ctx)
 	if err != nil {
@@ -45,7 +38,6 @@ func newSecretService(ctx context.Context) (SecretService, error) {
 	return ss, err
 }
 
-var SecretServiceFactory = newSecretService
 var K8STokenFile = vaultK8STokenFile // #nosec
 var VaultServerAddress = os.Getenv("VAULT_SERVER_ADDRESS")
 
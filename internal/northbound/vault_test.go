@@ -225,7 +225,7 @@ func (s *NorthBoundTestSuite) TestNewSecretServiceErrors() {
 
 	// Test can't create client error
 	server := s.NewTestHTTPServer().Start()
-	ss, err := newSecretService(s.ctx)
+	ss, err := newVaultSecretService(s.ctx)
 	s.NotNil(ss)
 	s.NoError(err)
 	readAllFactory = saveReadAllFactory
@@ -234,7 +234,7 @@ func (s *NorthBoundTestSuite) TestNewSecretServiceErrors() {
 	// login error - can't get token
 	server = s.NewTestHTTPServer().Start()
 	K8STokenFile = `testdata/k8stoken-no-such-file` // #nosec
-	ss, err = newSecretService(s.ctx)
+	ss, err = newVaultSecretService(s.ctx)
 	s.Nil(ss)
 	s.Error(err)
 	server.Stop()
@@ -245,13 +245,13 @@ func (s *NorthBoundTestSuite) TestWriteSecretErrors() {
 	defer server.Stop()
 
 	// Test can't create client error
-	ss, err := newSecretService(s.ctx)
+	ss, err := newVaultSecretService(s.ctx)
 	s.NotNil(ss)
 	s.NoError(err)
 	err = ss.WriteSecret(NilContext(), "path", "secret")
 	s.Error(err)
 
-	ss, err = newSecretService(s.ctx)
+	ss, err = newVaultSecretService(s.ctx)
 	s.NotNil(ss)
 	s.NoError(err)
 
@@ -269,13 +269,13 @@ func (s *NorthBoundTestSuite) TestReadSecretErrors() {
 	server := s.NewTestHTTPServer().Start()
 	defer server.Stop()
 
-	ss, err := newSecretService(s.ctx)
+	ss, err := newVaultSecretService(s.ctx)
 	s.NotNil(ss)
 	s.NoError(err)
 	_, err = ss.ReadSecret(NilContext(), "path")
 	s.Error(err)
 
-	ss, err = newSecretService(s.ctx)
+	ss, err = newVaultSecretService(s.ctx)
 	s.NotNil(ss)
 	s.NoError(err)
 
@@ -316,7 +316,7 @@ func (s *NorthBoundTestSuite) TestDeleteSecretErrors() {
 	server := s.NewTestHTTPServer().Start()
 	defer server.Stop()
 
-	ss, err := newSecretService(s.ctx)
+	ss, err := newVaultSecretService(s.ctx)
 	s.NotNil(ss)
 	s.NoError(err)
 
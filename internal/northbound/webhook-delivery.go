@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+/* Webhooks are fed from the same event listeners as the Watch* streams. For every event, the dispatcher records a
+ * delivery in the log of each matching webhook of the project and then posts the event to the webhook endpoint,
+ * retrying with exponential backoff until the endpoint accepts it or the attempts are exhausted.
+ *
+ * Each replica receives every event, either directly or relayed by the broadcaster. Since the delivery log admits
+ * each event only once per webhook, only the replica that manages to record the delivery goes on to attempt it.
+ *
+ * Deliveries still pending when the replica attempting them stops are left behind. Each replica periodically sweeps
+ * the log for pending deliveries that have not been attempted for longer than any backoff, takes them over by
+ * updating them on the condition that they are still untouched, and resumes delivering them.
+ */
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	ent "github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhook"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhookdelivery"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/orch-library/go/dazl"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	deliveryPending   = "pending"
+	deliveryDelivered = "delivered"
+	deliveryFailed    = "failed"
+
+	signatureHeader = "X-Catalog-Signature"
+	deliveryHeader  = "X-Catalog-Delivery"
+	eventHeader     = "X-Catalog-Event"
+)
+
+var (
+	// WebhookMaxAttempts is the maximum number of attempts made to deliver an event to a webhook
+	WebhookMaxAttempts = 6
+	// WebhookInitialBackoff is the delay before the first retry; the delay doubles with each subsequent retry
+	WebhookInitialBackoff = 2 * time.Second
+	// WebhookMaxBackoff is the maximum delay between retries
+	WebhookMaxBackoff = 5 * time.Minute
+	// WebhookTimeout is the time allowed for the webhook endpoint to respond
+	WebhookTimeout = 10 * time.Second
+	// WebhookSweepInterval is the interval at which abandoned pending deliveries are swept for
+	WebhookSweepInterval = time.Minute
+)
+
+// WebhookDispatcher delivers the events received by the event listeners to the matching webhooks.
+type WebhookDispatcher struct {
+	databaseClient *ent.Client
+	httpClient     *http.Client
+}
+
+// NewWebhookDispatcher creates a dispatcher delivering events to the webhooks registered in the given database.
+func NewWebhookDispatcher(databaseClient *ent.Client) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		databaseClient: databaseClient,
+		httpClient:     &http.Client{Timeout: WebhookTimeout, Transport: newDestinationTransport()},
+	}
+}
+
+// Start begins dispatching the events received by the given listeners until the given context is done.
+func (d *WebhookDispatcher) Start(ctx context.Context, listeners *EventListeners) {
+	go d.sweepPendingDeliveries(ctx)
+	go dispatchEvents(ctx, d, errors.RegistryType,
+		func() *registryListener {
+			return listeners.addRegistryListener(ctx, &catalogv3.WatchRegistriesRequest{})
+		},
+		listeners.deleteRegistryListener,
+		func(e *catalogv3.WatchRegistriesResponse) (*catalogv3.Event, string, proto.Message) {
+			return e.Event, e.Registry.Name, &catalogv3.WatchRegistriesResponse{Event: e.Event, Registry: redactRegistry(e.Registry)}
+		})
+	go dispatchEvents(ctx, d, errors.ArtifactType,
+		func() *artifactListener {
+			return listeners.addArtifactListener(ctx, &catalogv3.WatchArtifactsRequest{})
+		},
+		listeners.deleteArtifactListener,
+		func(e *catalogv3.WatchArtifactsResponse) (*catalogv3.Event, string, proto.Message) {
+			return e.Event, e.Artifact.Name, e
+		})
+	go dispatchEvents(ctx, d, errors.ApplicationType,
+		func() *applicationListener {
+			return listeners.addApplicationListener(ctx, &catalogv3.WatchApplicationsRequest{})
+		},
+		listeners.deleteApplicationListener,
+		func(e *catalogv3.WatchApplicationsResponse) (*catalogv3.Event, string, proto.Message) {
+			return e.Event, fmt.Sprintf("%s:%s", e.Application.Name, e.Application.Version), e
+		})
+	go dispatchEvents(ctx, d, errors.DeploymentPackageType,
+		func() *deploymentPackageListener {
+			return listeners.addDeploymentPackageListener(ctx, &catalogv3.WatchDeploymentPackagesRequest{})
+		},
+		listeners.deleteDeploymentPackageListener,
+		func(e *catalogv3.WatchDeploymentPackagesResponse) (*catalogv3.Event, string, proto.Message) {
+			return e.Event, fmt.Sprintf("%s:%s", e.DeploymentPackage.Name, e.DeploymentPackage.Version), e
+		})
+}
+
+// Takes the events of a single resource type from a listener and dispatches them to the webhooks. The listener is
+// re-registered if it overflows, in which case the events dropped from its queue are not delivered.
+func dispatchEvents[T any](ctx context.Context, d *WebhookDispatcher, resourceType errors.ResourceType,
+	add func() *listener[T], remove func(*listener[T]),
+	describe func(T) (*catalogv3.Event, string, proto.Message)) {
+	l := add()
+	for {
+		e, err := l.next(ctx)
+		if err != nil {
+			remove(l)
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warnw("Webhook dispatcher fell behind; some events will not be delivered",
+				dazl.String("resourceType", string(resourceType)), dazl.Error(err))
+			l = add()
+			continue
+		}
+		event, name, resp := describe(e)
+		if err = d.dispatch(ctx, resourceType, event, name, resp); err != nil {
+			log.Warnw("Unable to dispatch event to webhooks", dazl.String("resourceType", string(resourceType)),
+				dazl.Uint64("revision", event.Revision), dazl.Error(err))
+		}
+	}
+}
+
+// Records a delivery of the given event for each matching webhook and starts delivering it.
+func (d *WebhookDispatcher) dispatch(ctx context.Context, resourceType errors.ResourceType, event *catalogv3.Event, name string, resp proto.Message) error {
+	webhooks, err := d.databaseClient.Webhook.Query().Where(webhook.ProjectUUID(event.ProjectId)).All(ctx)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+
+	payload, err := protojson.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range webhooks {
+		if !webhookMatches(w, resourceType, event.Type) {
+			continue
+		}
+		delivery, err := d.databaseClient.WebhookDelivery.Create().
+			SetWebhook(w).
+			SetRevision(event.Revision).
+			SetResourceType(string(resourceType)).
+			SetResourceName(name).
+			SetEventType(event.Type).
+			SetPayload(payload).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Another replica has taken on the delivery
+			continue
+		} else if err != nil {
+			return err
+		}
+		go d.deliver(ctx, w, delivery)
+	}
+	return nil
+}
+
+// Periodically resumes the pending deliveries abandoned by their replicas, until the given context is done.
+func (d *WebhookDispatcher) sweepPendingDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(WebhookSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := d.resumePendingDeliveries(ctx); err != nil {
+			log.Warnw("Unable to resume pending webhook deliveries", dazl.Error(err))
+		}
+	}
+}
+
+// Takes over the pending deliveries that have not been attempted for longer than the maximum backoff and the time
+// allowed for an attempt, and resumes delivering them.
+func (d *WebhookDispatcher) resumePendingDeliveries(ctx context.Context) error {
+	deliveries, err := d.databaseClient.WebhookDelivery.Query().
+		Where(webhookdelivery.State(deliveryPending),
+			webhookdelivery.UpdateTimeLT(time.Now().Add(-WebhookMaxBackoff-2*WebhookTimeout))).
+		WithWebhook().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		// Another replica sweeping concurrently may have taken over the delivery already
+		taken, err := d.databaseClient.WebhookDelivery.Update().
+			Where(webhookdelivery.ID(delivery.ID), webhookdelivery.State(deliveryPending),
+				webhookdelivery.UpdateTime(delivery.UpdateTime)).
+			Save(ctx)
+		if err != nil {
+			return err
+		} else if taken == 0 {
+			continue
+		}
+		log.Infow("Resuming abandoned webhook delivery", dazl.String("webhook", delivery.Edges.Webhook.Name),
+			dazl.Uint64("delivery", delivery.ID), dazl.Int("attempts", delivery.Attempts))
+		go d.deliver(ctx, delivery.Edges.Webhook, delivery)
+	}
+	return nil
+}
+
+// Returns true if the given webhook is interested in events of the given resource and event type.
+func webhookMatches(w *ent.Webhook, resourceType errors.ResourceType, eventType string) bool {
+	return (len(w.ResourceTypes) == 0 || slices.Contains(w.ResourceTypes, string(resourceType))) &&
+		(len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType))
+}
+
+// Attempts to deliver the given delivery to the webhook endpoint, following on from the attempts made already and
+// backing off between attempts, and records the outcome of each attempt in the delivery log.
+func (d *WebhookDispatcher) deliver(ctx context.Context, w *ent.Webhook, delivery *ent.WebhookDelivery) {
+	backoff := WebhookInitialBackoff
+	for attempt := delivery.Attempts + 1; ; attempt++ {
+		code, err := d.post(ctx, w, delivery)
+
+		state, lastError := deliveryDelivered, ""
+		if err != nil {
+			state, lastError = deliveryPending, err.Error()
+			if attempt >= WebhookMaxAttempts {
+				state = deliveryFailed
+			}
+		}
+		if err := d.databaseClient.WebhookDelivery.UpdateOneID(delivery.ID).
+			SetState(state).
+			SetAttempts(attempt).
+			SetResponseCode(code).
+			SetLastError(lastError).
+			Exec(ctx); err != nil {
+			log.Warnw("Unable to record webhook delivery attempt", dazl.String("webhook", w.Name),
+				dazl.Uint64("delivery", delivery.ID), dazl.Error(err))
+		}
+		if state != deliveryPending {
+			if state == deliveryFailed {
+				log.Warnw("Unable to deliver event to webhook", dazl.String("webhook", w.Name),
+					dazl.Uint64("delivery", delivery.ID), dazl.Int("attempts", attempt), dazl.Error(err))
+			}
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		backoff = min(2*backoff, WebhookMaxBackoff)
+	}
+}
+
+// Posts the payload of the given delivery to the webhook endpoint and returns the status code of the response.
+func (d *WebhookDispatcher) post(ctx context.Context, w *ent.Webhook, delivery *ent.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, delivery.EventType)
+	req.Header.Set(deliveryHeader, strconv.FormatUint(delivery.ID, 10))
+	secret, err := openSecret(w.Secret, WebhookSecretContext(w.ProjectUUID, w.Name))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set(signatureHeader, "sha256="+signPayload(secret, delivery.Payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Returns the hex-encoded HMAC-SHA256 digest of the payload, keyed by the given secret.
+func signPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,406 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhook"
+	"github.com/open-edge-platform/app-orch-catalog/internal/ent/generated/webhookdelivery"
+	"github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateWebhook creates a webhook through gRPC
+func (g *Server) CreateWebhook(ctx context.Context, req *catalogv3.CreateWebhookRequest) (*catalogv3.CreateWebhookResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.Webhook == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("incomplete request"))
+	} else if err := req.Webhook.Validate(); err != nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithResourceName(req.Webhook.Name),
+			errors.WithMessage(err.Error()))
+	} else if req.Webhook.Secret == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithResourceName(req.Webhook.Name),
+			errors.WithMessage("secret is required"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := checkWebhookURL(ctx, req.Webhook); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	wh := req.Webhook
+	created, err := tx.Webhook.Create().
+		SetProjectUUID(projectUUID).
+		SetName(wh.Name).
+		SetDescription(wh.Description).
+		SetURL(wh.Url).
+		SetResourceTypes(wh.ResourceTypes).
+		SetEventTypes(wh.EventTypes).
+		SetSecret(sealSecret(wh.Secret, WebhookSecretContext(projectUUID, wh.Name))).
+		Save(ctx)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		if generated.IsConstraintError(err) {
+			return nil, errors.NewAlreadyExists(
+				errors.WithResourceType(errors.WebhookType),
+				errors.WithResourceName(wh.Name))
+		}
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	if err = recordAudit(ctx, tx.AuditEvent, projectUUID, errors.WebhookType, wh.Name, "", CreatedEvent, nil, webhookFromDB(created)); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	logActivity(ctx, "created", "webhook", projectUUID, wh.Name)
+	return &catalogv3.CreateWebhookResponse{Webhook: webhookFromDB(created)}, nil
+}
+
+// ListWebhooks gets a list of all webhooks through gRPC
+func (g *Server) ListWebhooks(ctx context.Context, req *catalogv3.ListWebhooksRequest) (*catalogv3.ListWebhooksResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	webhooksDB, err := g.databaseClient.Webhook.Query().
+		Where(webhook.ProjectUUID(projectUUID)).
+		Order(generated.Asc(webhook.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	startIndex, endIndex, totalElements, err := computePageRange(req.PageSize, req.Offset, len(webhooksDB))
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*catalogv3.Webhook, 0, len(webhooksDB))
+	if len(webhooksDB) > 0 {
+		for _, w := range webhooksDB[startIndex : endIndex+1] {
+			webhooks = append(webhooks, webhookFromDB(w))
+		}
+	}
+	return &catalogv3.ListWebhooksResponse{Webhooks: webhooks, TotalElements: totalElements}, nil
+}
+
+// GetWebhook gets a single webhook through gRPC
+func (g *Server) GetWebhook(ctx context.Context, req *catalogv3.GetWebhookRequest) (*catalogv3.GetWebhookResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.WebhookName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	w, err := g.getWebhook(ctx, projectUUID, req.WebhookName)
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv3.GetWebhookResponse{Webhook: webhookFromDB(w)}, nil
+}
+
+func (g *Server) getWebhook(ctx context.Context, projectUUID string, name string) (*generated.Webhook, error) {
+	w, err := g.databaseClient.Webhook.Query().
+		Where(webhook.ProjectUUID(projectUUID), webhook.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, errors.NewNotFound(
+				errors.WithResourceType(errors.WebhookType),
+				errors.WithResourceName(name))
+		}
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	return w, nil
+}
+
+// UpdateWebhook updates a webhook through gRPC
+func (g *Server) UpdateWebhook(ctx context.Context, req *catalogv3.UpdateWebhookRequest) (*emptypb.Empty, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.Webhook == nil || req.WebhookName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("incomplete request"))
+	} else if !isPartialUpdate(req.UpdateMask) {
+		if err := validateWebhookUpdate(req.WebhookName, req.Webhook); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	wh := req.Webhook
+	w, err := tx.Webhook.Query().
+		Where(webhook.ProjectUUID(projectUUID), webhook.Name(req.WebhookName)).
+		Only(ctx)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		if generated.IsNotFound(err) {
+			return nil, errors.NewNotFound(
+				errors.WithResourceType(errors.WebhookType),
+				errors.WithResourceName(req.WebhookName))
+		}
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	if isPartialUpdate(req.UpdateMask) {
+		// The secret is never returned, so it is retained unless listed in the mask
+		merged, err := applyUpdateMask(errors.WebhookType, req.UpdateMask, webhookFromDB(w), wh)
+		if err == nil {
+			wh = merged.(*catalogv3.Webhook)
+			err = validateWebhookUpdate(req.WebhookName, wh)
+		}
+		if err != nil {
+			g.rollbackTransaction(tx)
+			return nil, err
+		}
+	}
+
+	if wh.Url != w.URL {
+		if err = checkWebhookURL(ctx, wh); err != nil {
+			g.rollbackTransaction(tx)
+			return nil, err
+		}
+	}
+
+	update := tx.Webhook.UpdateOne(w).
+		SetDescription(wh.Description).
+		SetURL(wh.Url).
+		SetResourceTypes(wh.ResourceTypes).
+		SetEventTypes(wh.EventTypes)
+	if wh.Secret != "" {
+		update = update.SetSecret(sealSecret(wh.Secret, WebhookSecretContext(w.ProjectUUID, w.Name)))
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+	if err = recordAudit(ctx, tx.AuditEvent, projectUUID, errors.WebhookType, wh.Name, "", UpdatedEvent, webhookFromDB(w), webhookFromDB(updated)); err != nil {
+		g.rollbackTransaction(tx)
+		return nil, err
+	}
+
+	err = g.commitTransaction(tx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	logActivity(ctx, "updated", "webhook", projectUUID, wh.Name)
+	return &emptypb.Empty{}, nil
+}
+
+// Validates the webhook given to an update of the named webhook.
+func validateWebhookUpdate(name string, wh *catalogv3.Webhook) error {
+	if err := wh.Validate(); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage(err.Error()))
+	} else if name != wh.Name {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("name cannot be changed %s != %s", name, wh.Name))
+	}
+	return nil
+}
+
+// Returns an error if the endpoint of the given webhook is an internal destination.
+func checkWebhookURL(ctx context.Context, wh *catalogv3.Webhook) error {
+	if err := checkDestination(ctx, wh.Url); err != nil {
+		return errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithResourceName(wh.Name),
+			errors.WithMessage("url is not allowed: %v", err))
+	}
+	return nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log through gRPC
+func (g *Server) DeleteWebhook(ctx context.Context, req *catalogv3.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.WebhookName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("incomplete request"))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := g.startTransaction(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	w, err := tx.Webhook.Query().
+		Where(webhook.ProjectUUID(projectUUID), webhook.Name(req.WebhookName)).
+		Only(ctx)
+	if err != nil {
+		g.rollbackTransaction(tx)
+		if generated.IsNotFound(err) {
+			return nil, errors.NewNotFound(
+				errors.WithResourceType(errors.WebhookType),
+				errors.WithResourceName(req.WebhookName))
+		}
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	// Delete the delivery log along with the webhook
+	if _, err = tx.WebhookDelivery.Delete().Where(webhookdelivery.HasWebhookWith(webhook.ID(w.ID))).Exec(ctx); err == nil {
+		err = tx.Webhook.DeleteOne(w).Exec(ctx)
+	}
+	if err != nil {
+		err = errors.NewDBError(errors.WithError(err))
+	} else {
+		err = recordAudit(ctx, tx.AuditEvent, projectUUID, errors.WebhookType, w.Name, "", DeletedEvent, webhookFromDB(w), nil)
+	}
+	if _, err = g.checkDeleteResult(ctx, tx, err, fmt.Sprintf("webhook %s", req.WebhookName), projectUUID); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookDeliveries gets the log of deliveries made to a webhook through gRPC
+func (g *Server) ListWebhookDeliveries(ctx context.Context, req *catalogv3.ListWebhookDeliveriesRequest) (*catalogv3.ListWebhookDeliveriesResponse, error) {
+	projectUUID, err := GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.WebhookName == "" {
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithMessage("incomplete request"))
+	}
+	switch req.State {
+	case "", deliveryPending, deliveryDelivered, deliveryFailed:
+	default:
+		return nil, errors.NewInvalidArgument(
+			errors.WithResourceType(errors.WebhookType),
+			errors.WithResourceName(req.WebhookName),
+			errors.WithMessage("invalid delivery state %s", req.State))
+	}
+
+	if err := g.authCheckAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	w, err := g.getWebhook(ctx, projectUUID, req.WebhookName)
+	if err != nil {
+		return nil, err
+	}
+
+	query := g.databaseClient.WebhookDelivery.Query().Where(webhookdelivery.HasWebhookWith(webhook.ID(w.ID)))
+	if req.State != "" {
+		query = query.Where(webhookdelivery.State(req.State))
+	}
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.NewDBError(errors.WithError(err))
+	}
+
+	startIndex, endIndex, totalElements, err := computePageRange(req.PageSize, req.Offset, count)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*catalogv3.WebhookDelivery, 0)
+	if count > 0 {
+		deliveriesDB, err := query.
+			Order(generated.Desc(webhookdelivery.FieldID)).
+			Offset(startIndex).
+			Limit(endIndex - startIndex + 1).
+			All(ctx)
+		if err != nil {
+			return nil, errors.NewDBError(errors.WithError(err))
+		}
+		for _, d := range deliveriesDB {
+			deliveries = append(deliveries, &catalogv3.WebhookDelivery{
+				Id:           d.ID,
+				Revision:     d.Revision,
+				ResourceType: d.ResourceType,
+				ResourceName: d.ResourceName,
+				EventType:    d.EventType,
+				State:        d.State,
+				Attempts:     int32(d.Attempts),
+				ResponseCode: int32(d.ResponseCode),
+				LastError:    d.LastError,
+				CreateTime:   timestamppb.New(d.CreateTime),
+				UpdateTime:   timestamppb.New(d.UpdateTime),
+			})
+		}
+	}
+	return &catalogv3.ListWebhookDeliveriesResponse{Deliveries: deliveries, TotalElements: totalElements}, nil
+}
+
+// Returns the API representation of the given webhook; the secret is never returned.
+func webhookFromDB(w *generated.Webhook) *catalogv3.Webhook {
+	return &catalogv3.Webhook{
+		Name:          w.Name,
+		Description:   w.Description,
+		Url:           w.URL,
+		ResourceTypes: w.ResourceTypes,
+		EventTypes:    w.EventTypes,
+		CreateTime:    timestamppb.New(w.CreateTime),
+		UpdateTime:    timestamppb.New(w.UpdateTime),
+	}
+}
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Records the requests received by a webhook endpoint, failing the first few of them.
+type webhookEndpoint struct {
+	lock     sync.Mutex
+	failures int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (e *webhookEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.requests = append(e.requests, r)
+	e.bodies = append(e.bodies, body)
+	if e.failures > 0 {
+		e.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *webhookEndpoint) count() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return len(e.requests)
+}
+
+// Shortens the webhook retry backoff for the duration of the test.
+func (s *NorthBoundTestSuite) fastWebhookRetries(maxAttempts int) {
+	attempts, backoff := WebhookMaxAttempts, WebhookInitialBackoff
+	WebhookMaxAttempts, WebhookInitialBackoff = maxAttempts, 10*time.Millisecond
+	s.T().Cleanup(func() {
+		WebhookMaxAttempts, WebhookInitialBackoff = attempts, backoff
+	})
+}
+
+// Allows the webhooks to reach the endpoints served locally for the duration of the test.
+func (s *NorthBoundTestSuite) allowLoopbackDestinations() {
+	s.NoError(AllowDestinations("127.0.0.0/8, ::1/128"))
+	s.T().Cleanup(func() {
+		allowedDestinations = destinationAllowlist{}
+	})
+}
+
+func (s *NorthBoundTestSuite) createWebhook(project string, wh *catalogv3.Webhook) {
+	_, err := s.client.CreateWebhook(s.ProjectID(project), &catalogv3.CreateWebhookRequest{Webhook: wh})
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) listDeliveries(project string, name string) []*catalogv3.WebhookDelivery {
+	resp, err := s.client.ListWebhookDeliveries(s.ProjectID(project), &catalogv3.ListWebhookDeliveriesRequest{WebhookName: name})
+	s.NoError(err)
+	return resp.Deliveries
+}
+
+func (s *NorthBoundTestSuite) TestWebhookCRUD() {
+	ctx := s.ProjectID(footen)
+	wh := &catalogv3.Webhook{Name: "hook", Url: "https://tools.example.com/hook", EventTypes: []string{"created"}}
+
+	_, err := s.client.CreateWebhook(ctx, &catalogv3.CreateWebhookRequest{Webhook: wh})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	wh.Secret = "s3cret"
+	resp, err := s.client.CreateWebhook(ctx, &catalogv3.CreateWebhookRequest{Webhook: wh})
+	s.NoError(err)
+	s.Equal("hook", resp.Webhook.Name)
+	s.Empty(resp.Webhook.Secret)
+
+	_, err = s.client.CreateWebhook(ctx, &catalogv3.CreateWebhookRequest{Webhook: wh})
+	s.Equal(codes.AlreadyExists, status.Code(err))
+
+	bad := &catalogv3.Webhook{Name: "bad", Url: "https://tools.example.com/hook", Secret: "x", ResourceTypes: []string{"publisher"}}
+	_, err = s.client.CreateWebhook(ctx, &catalogv3.CreateWebhookRequest{Webhook: bad})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Updating without a secret retains the existing one
+	wh.Secret = ""
+	wh.Description = "Updated"
+	_, err = s.client.UpdateWebhook(ctx, &catalogv3.UpdateWebhookRequest{WebhookName: wh.Name, Webhook: wh})
+	s.NoError(err)
+	stored, err := s.dbClient.Webhook.Query().Only(s.ctx)
+	s.NoError(err)
+	s.Equal("s3cret", stored.Secret)
+	s.Equal("Updated", stored.Description)
+
+	got, err := s.client.GetWebhook(ctx, &catalogv3.GetWebhookRequest{WebhookName: wh.Name})
+	s.NoError(err)
+	s.Equal("Updated", got.Webhook.Description)
+	s.Equal([]string{"created"}, got.Webhook.EventTypes)
+	s.Empty(got.Webhook.Secret)
+
+	// Webhooks are private to their project
+	_, err = s.client.GetWebhook(s.ProjectID(barten), &catalogv3.GetWebhookRequest{WebhookName: wh.Name})
+	s.Equal(codes.NotFound, status.Code(err))
+	list, err := s.client.ListWebhooks(ctx, &catalogv3.ListWebhooksRequest{})
+	s.NoError(err)
+	s.Len(list.Webhooks, 1)
+	s.Equal(int32(1), list.TotalElements)
+
+	_, err = s.client.DeleteWebhook(ctx, &catalogv3.DeleteWebhookRequest{WebhookName: wh.Name})
+	s.NoError(err)
+	_, err = s.client.DeleteWebhook(ctx, &catalogv3.DeleteWebhookRequest{WebhookName: wh.Name})
+	s.Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *NorthBoundTestSuite) TestWebhookDelivery() {
+	s.fastWebhookRetries(3)
+	s.allowLoopbackDestinations()
+	useTestKeys(s.T(), newTestKey(s.T(), "k1"))
+	endpoint := &webhookEndpoint{failures: 1}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	s.createWebhook(footen, &catalogv3.Webhook{
+		Name: "packages", Url: server.URL, Secret: "s3cret",
+		ResourceTypes: []string{"deployment-package"}, EventTypes: []string{"created"},
+	})
+
+	// The secret is sealed at rest, yet signs the deliveries
+	stored, err := s.dbClient.Webhook.Query().Only(s.ctx)
+	s.NoError(err)
+	s.True(strings.HasPrefix(stored.Secret, "enc:v2:k1:"))
+
+	// Only the events matching the webhook are delivered
+	s.createRegistry(footen, "newreg", "HELM")
+	s.createDeploymentPkg(barten, "otherpkg", "0.1.1")
+	s.createDeploymentPkg(footen, "newpkg", "0.1.1", "foo:v0.1.0")
+
+	var deliveries []*catalogv3.WebhookDelivery
+	s.Eventually(func() bool {
+		deliveries = s.listDeliveries(footen, "packages")
+		return len(deliveries) == 1 && deliveries[0].State == deliveryDelivered
+	}, 5*time.Second, 20*time.Millisecond)
+	s.Equal("newpkg:0.1.1", deliveries[0].ResourceName)
+	s.Equal("deployment-package", deliveries[0].ResourceType)
+	s.Equal(string(CreatedEvent), deliveries[0].EventType)
+	s.Equal(int32(2), deliveries[0].Attempts)
+	s.Equal(int32(http.StatusNoContent), deliveries[0].ResponseCode)
+	s.Empty(deliveries[0].LastError)
+	s.NotZero(deliveries[0].Revision)
+
+	s.Equal(2, endpoint.count())
+	req, body := endpoint.requests[1], endpoint.bodies[1]
+	s.Equal("sha256="+signPayload("s3cret", body), req.Header.Get(signatureHeader))
+	s.Equal(string(CreatedEvent), req.Header.Get(eventHeader))
+	s.NotEmpty(req.Header.Get(deliveryHeader))
+
+	var payload map[string]map[string]interface{}
+	s.NoError(json.Unmarshal(body, &payload))
+	s.Equal("newpkg", payload["deploymentPackage"]["name"])
+	s.Equal(footen, payload["event"]["projectId"])
+}
+
+func (s *NorthBoundTestSuite) TestWebhookDeliveryFailed() {
+	s.fastWebhookRetries(2)
+	s.allowLoopbackDestinations()
+	endpoint := &webhookEndpoint{failures: 5}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	s.createWebhook(footen, &catalogv3.Webhook{Name: "registries", Url: server.URL, Secret: "s3cret", ResourceTypes: []string{"registry"}})
+	s.createRegistry(footen, "newreg", "HELM")
+
+	var deliveries []*catalogv3.WebhookDelivery
+	s.Eventually(func() bool {
+		deliveries = s.listDeliveries(footen, "registries")
+		return len(deliveries) == 1 && deliveries[0].State == deliveryFailed
+	}, 5*time.Second, 20*time.Millisecond)
+	s.Equal(int32(2), deliveries[0].Attempts)
+	s.Equal(int32(http.StatusServiceUnavailable), deliveries[0].ResponseCode)
+	s.Contains(deliveries[0].LastError, "503")
+	s.Equal(2, endpoint.count())
+
+	// Registry secrets are never delivered
+	s.NotContains(string(endpoint.bodies[0]), "authToken")
+
+	resp, err := s.client.ListWebhookDeliveries(s.ProjectID(footen), &catalogv3.ListWebhookDeliveriesRequest{
+		WebhookName: "registries", State: deliveryDelivered,
+	})
+	s.NoError(err)
+	s.Empty(resp.Deliveries)
+	s.Equal(int32(0), resp.TotalElements)
+}
+
+func (s *NorthBoundTestSuite) TestWebhookDestinations() {
+	ctx := s.ProjectID(footen)
+	for _, url := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://[::1]/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+		"http://localhost/hook",
+		"http://kubernetes/hook",
+		"http://app-orch-catalog.orch-app.svc/hook",
+		"http://metadata.google.internal/computeMetadata/v1",
+		"ftp://tools.example.com/hook",
+	} {
+		_, err := s.client.CreateWebhook(ctx, &catalogv3.CreateWebhookRequest{
+			Webhook: &catalogv3.Webhook{Name: "internal", Url: url, Secret: "s3cret"},
+		})
+		s.Equal(codes.InvalidArgument, status.Code(err), url)
+	}
+
+	wh := &catalogv3.Webhook{Name: "hook", Url: "https://203.0.113.10/hook", Secret: "s3cret"}
+	s.createWebhook(footen, wh)
+	wh.Url = "http://192.168.1.1/hook"
+	_, err := s.client.UpdateWebhook(ctx, &catalogv3.UpdateWebhookRequest{WebhookName: wh.Name, Webhook: wh})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Operators may allow internal destinations
+	s.NoError(AllowDestinations("192.168.0.0/16,.svc"))
+	s.T().Cleanup(func() {
+		allowedDestinations = destinationAllowlist{}
+	})
+	_, err = s.client.UpdateWebhook(ctx, &catalogv3.UpdateWebhookRequest{WebhookName: wh.Name, Webhook: wh})
+	s.NoError(err)
+	wh.Url = "http://app-orch-catalog.orch-app.svc/hook"
+	_, err = s.client.UpdateWebhook(ctx, &catalogv3.UpdateWebhookRequest{WebhookName: wh.Name, Webhook: wh})
+	s.NoError(err)
+}
+
+func (s *NorthBoundTestSuite) TestWebhookDialInternal() {
+	endpoint := &webhookEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	// Endpoints whose addresses have become internal since they were given are not reached
+	client := &http.Client{Transport: newDestinationTransport()}
+	_, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	s.ErrorContains(err, "is internal")
+	s.Equal(0, endpoint.count())
+
+	s.allowLoopbackDestinations()
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	s.NoError(err)
+	s.NoError(resp.Body.Close())
+	s.Equal(1, endpoint.count())
+}
+
+func (s *NorthBoundTestSuite) TestWebhookResumePendingDeliveries() {
+	s.allowLoopbackDestinations()
+	endpoint := &webhookEndpoint{}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	s.createWebhook(footen, &catalogv3.Webhook{Name: "hook", Url: server.URL, Secret: "s3cret", ResourceTypes: []string{"artifact"}})
+	w, err := s.dbClient.Webhook.Query().Only(s.ctx)
+	s.NoError(err)
+
+	// A delivery left pending by a replica that stopped, and one that is being attempted by another replica
+	abandoned, err := s.dbClient.WebhookDelivery.Create().SetWebhook(w).SetRevision(1).SetResourceType("artifact").
+		SetResourceName("abandoned").SetEventType(string(CreatedEvent)).SetPayload([]byte("{}")).SetAttempts(2).
+		SetUpdateTime(time.Now().Add(-time.Hour)).Save(s.ctx)
+	s.NoError(err)
+	_, err = s.dbClient.WebhookDelivery.Create().SetWebhook(w).SetRevision(2).SetResourceType("artifact").
+		SetResourceName("in-progress").SetEventType(string(CreatedEvent)).SetPayload([]byte("{}")).SetAttempts(1).
+		Save(s.ctx)
+	s.NoError(err)
+
+	d := NewWebhookDispatcher(s.dbClient)
+	s.NoError(d.resumePendingDeliveries(s.ctx))
+	s.Eventually(func() bool {
+		delivery, err := s.dbClient.WebhookDelivery.Get(s.ctx, abandoned.ID)
+		return err == nil && delivery.State == deliveryDelivered && delivery.Attempts == 3
+	}, 5*time.Second, 20*time.Millisecond)
+	s.Equal(1, endpoint.count())
+
+	// Deliveries are resumed once only
+	s.NoError(d.resumePendingDeliveries(s.ctx))
+	s.Equal(1, endpoint.count())
+}
@@ -7,15 +7,20 @@ package restproxy
 import (
 	"context"
 	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"net/http"
-	"strings"
 )
 
+// Number of charts or chart versions requested per page from the catalog service.
+const chartsPageSize = 500
+
 // ChartsHandler provides reverse proxy for access to a list of Help charts provided by a named repository.
 type ChartsHandler struct {
 	grpcEndpoint string
@@ -54,9 +59,10 @@ func NewChartsHandler(endpoint string, opts []grpc.DialOption) (*ChartsHandler,
 	}, nil
 }
 
-// FetchChartsList relays the Help charts list request to the back-end registry with appropriate authentication.
+// FetchChartsList lists the Helm charts of a named registry, or the versions of a given chart, as a JSON array
+// of names. The registry is accessed by the catalog service, so that its credentials never reach the proxy.
 func (h *ChartsHandler) FetchChartsList(c *gin.Context) {
-	// Grab the publisher and registry parameters
+	// Grab the registry and chart parameters
 	query := c.Request.URL.Query()
 	registryName := query.Get("registry")
 	chartName := query.Get("chart")
@@ -69,23 +75,64 @@ func (h *ChartsHandler) FetchChartsList(c *gin.Context) {
 
 	log.Infof("Getting charts for registry %s (%s)[%s]...", registryName, c.Request.Header.Get(ActiveProjectID), chartName)
 
-	resp, err := h.grpcClient.GetRegistry(mdCtx, &catalogv3.GetRegistryRequest{RegistryName: registryName, ShowSensitiveInfo: true})
+	var items []string
+	var err error
+	if chartName == "" {
+		items, err = h.listCharts(mdCtx, registryName)
+	} else {
+		items, err = h.listChartVersions(mdCtx, registryName, chartName)
+	}
 	if err != nil {
-		log.Errorf("Unable to get registry: %+v", err)
-		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			log.Debugf("Registry %s does not support inventory retrieval", registryName)
+			c.AbortWithStatus(http.StatusNoContent)
+		case codes.Unimplemented:
+			log.Warnf("Registry %s %s", registryName, status.Convert(err).Message())
+			c.AbortWithStatusJSON(http.StatusNotImplemented, gin.H{"message": status.Convert(err).Message()})
+		default:
+			log.Errorf("Unable to fetch charts from registry: %+v", err)
+			_ = c.AbortWithError(runtime.HTTPStatusFromCode(status.Code(err)), err)
+		}
 		return
 	}
+	c.JSON(http.StatusOK, items)
+}
 
-	registry := resp.Registry
-	if registry.InventoryUrl == "" {
-		log.Debugf("Registry %s does not support inventory retrieval", registry.Name)
-		c.AbortWithStatus(http.StatusNoContent)
+// Lists the names of all the charts of the registry, page by page.
+func (h *ChartsHandler) listCharts(ctx context.Context, registryName string) ([]string, error) {
+	items := []string{}
+	pageToken := ""
+	for {
+		resp, err := h.grpcClient.ListRegistryCharts(ctx, &catalogv3.ListRegistryChartsRequest{
+			RegistryName: registryName, PageSize: chartsPageSize, PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, resp.ChartNames...)
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			return items, nil
+		}
 	}
+}
 
-	if strings.Contains(registry.InventoryUrl, "/api/v2.0/projects/") {
-		fetchOCIChartsList(c, registry, chartName)
-	} else {
-		log.Warnf("Registrry %s Not supported non-OCI registry inventory url %s: %v", registry.Name, registry.InventoryUrl)
-		c.AbortWithStatusJSON(http.StatusNotImplemented, gin.H{"message": "Not supported non-OCI registry inventory url"})
+// Lists all the versions of the chart, page by page.
+func (h *ChartsHandler) listChartVersions(ctx context.Context, registryName string, chartName string) ([]string, error) {
+	items := []string{}
+	pageToken := ""
+	for {
+		resp, err := h.grpcClient.ListChartVersions(ctx, &catalogv3.ListChartVersionsRequest{
+			RegistryName: registryName, ChartName: chartName, PageSize: chartsPageSize, PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, cv := range resp.ChartVersions {
+			items = append(items, cv.Version)
+		}
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			return items, nil
+		}
 	}
 }
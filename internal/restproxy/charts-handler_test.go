@@ -9,8 +9,6 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"net/http"
 	"net/http/httptest"
-	"os"
-	"strings"
 )
 
 const (
@@ -116,7 +114,7 @@ func (s *ProxyTestSuite) TestOCIChartProxyChartsVersionsNoAuth() {
 
 	s.createTestRegistry(ociURL(server.URL), "", "auth")
 	s.checkRequestBody(s.newRequest("catalog.orchestrator.apis/charts?registry=reg&chart=chart1"), 200,
-		`["v1.0","v2.0"]`)
+		`["v2.0","v1.0"]`)
 }
 
 func (s *ProxyTestSuite) TestChartProxyNoInventoryURL() {
@@ -131,27 +129,113 @@ func (s *ProxyTestSuite) TestChartProxyNoInventoryURL() {
 }
 
 func (s *ProxyTestSuite) TestChartProxyBadPublisherOrRegistry() {
-	s.checkRequest(s.newRequest("catalog.orchestrator.apis/charts?registry=bad"), 500)
+	s.checkRequest(s.newRequest("catalog.orchestrator.apis/charts?registry=bad"), 404)
 }
 
-func (s *ProxyTestSuite) TestParseOCIChartNames() {
-	body, err := os.ReadFile("testdata/repositories.json")
-	s.NoError(err)
+// OCI Distribution Helm Chart Tests Follow
+
+// Starts a registry implementing the OCI distribution API, which grants access through Bearer tokens
+// obtained from its token endpoint with the given credentials. All the chart versions share an empty config.
+func newDistributionServer() *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/token", func(rw http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "pwd" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = rw.Write([]byte(`{"token":"granted"}`))
+	})
+	mux.HandleFunc("/v2/", func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer granted" {
+			rw.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, server.URL))
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/_catalog":
+			_, _ = rw.Write([]byte(`{"repositories":["charts/chart2","charts/chart1","images/app"]}`))
+		case "/v2/charts/chart1/tags/list":
+			_, _ = rw.Write([]byte(`{"name":"charts/chart1","tags":["v1.0","2.0.0_build.1","sha256-1234.sig"]}`))
+		case "/v2/charts/chart1/manifests/v1.0", "/v2/charts/chart1/manifests/2.0.0_build.1":
+			rw.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_, _ = rw.Write([]byte(`{"schemaVersion":2,"config":{"mediaType":"application/vnd.cncf.helm.config.v1+json",` +
+				`"digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[]}`))
+		case "/v2/charts/chart1/blobs/sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a":
+			_, _ = rw.Write([]byte(`{}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return server
+}
+
+func (s *ProxyTestSuite) TestDistributionChartProxyAllCharts() {
+	server := newDistributionServer()
+	defer server.Close()
+
+	s.createTestRegistryWithAPIType(server.URL+"/charts", "oci", "user", "pwd")
+	s.checkRequestBody(s.newRequest("catalog.orchestrator.apis/charts?registry=reg"), 200, `["chart1","chart2"]`)
+	s.cleanUpTestRegistry()
+}
+
+func (s *ProxyTestSuite) TestDistributionChartProxyChartVersions() {
+	server := newDistributionServer()
+	defer server.Close()
+
+	s.createTestRegistryWithAPIType(server.URL+"/charts/", "oci", "user", "pwd")
+	s.checkRequestBody(s.newRequest("catalog.orchestrator.apis/charts?registry=reg&chart=chart1"), 200,
+		`["2.0.0+build.1","v1.0"]`)
+	s.cleanUpTestRegistry()
+}
+
+func (s *ProxyTestSuite) TestDistributionChartProxyBadAuth() {
+	server := newDistributionServer()
+	defer server.Close()
 
-	names, err := parseChartNames(body)
-	s.NoError(err)
-	s.Len(names, 3)
-	s.False(strings.Contains(names[0], "/"))
-	s.False(strings.Contains(names[1], "/"))
-	s.False(strings.Contains(names[2], "/"))
-	fmt.Print(names)
+	s.createTestRegistryWithAPIType(server.URL+"/charts", "oci", "user", "bad")
+	s.checkRequest(s.newRequest("catalog.orchestrator.apis/charts?registry=reg"), 503)
+	s.cleanUpTestRegistry()
 }
 
-func (s *ProxyTestSuite) TestParseOCIChartVersions() {
-	body, err := os.ReadFile("testdata/artifacts.json")
-	s.NoError(err)
+func (s *ProxyTestSuite) TestChartProxyUnknownAPIType() {
+	s.createTestRegistryWithAPIType("http://foobar.com/charts", "native", "", "")
+	s.checkRequestBody(s.newRequest("catalog.orchestrator.apis/charts?registry=reg"), 501,
+		`{"message":"Not supported registry API type native"}`)
+	s.cleanUpTestRegistry()
+}
 
-	versions, err := parseChartVersions(body)
-	s.NoError(err)
-	s.Len(versions, 1)
+// Classic Helm Repository Chart Tests Follow
+
+func (s *ProxyTestSuite) TestIndexChartProxy() {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "pwd" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/stable/index.yaml" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(`apiVersion: v1
+entries:
+  chart2:
+  - name: chart2
+    version: 0.1.0
+  chart1:
+  - name: chart1
+    version: 1.1.0
+  - name: chart1
+    version: 1.0.0
+`))
+	}))
+	defer server.Close()
+
+	s.createTestRegistryWithAPIType(server.URL+"/stable", "helm", "user", "pwd")
+	s.checkRequestBody(s.newRequest("catalog.orchestrator.apis/charts?registry=reg"), 200, `["chart1","chart2"]`)
+	s.checkRequestBody(s.newRequest("catalog.orchestrator.apis/charts?registry=reg&chart=chart1"), 200, `["1.1.0","1.0.0"]`)
+
+	s.createTestRegistryWithAPIType(server.URL+"/stable", "helm", "user", "bad")
+	s.checkRequest(s.newRequest("catalog.orchestrator.apis/charts?registry=reg"), 503)
+	s.cleanUpTestRegistry()
 }
@@ -40,6 +40,7 @@ const (
 	subscribedOp   = "subscribed"
 	unsubscribeOp  = "unsubscribe"
 	unsubscribedOp = "unsubscribed"
+	resyncOp       = "resync"
 )
 
 var upgrader = websocket.Upgrader{
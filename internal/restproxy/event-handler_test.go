@@ -41,9 +41,14 @@ func (s *ProxyTestSuite) readEvent(kind string, eventType string, name string) {
 }
 
 func (s *ProxyTestSuite) createTestRegistry(inventoryURL string, username string, auth string) {
+	s.createTestRegistryWithAPIType(inventoryURL, "", username, auth)
+}
+
+func (s *ProxyTestSuite) createTestRegistryWithAPIType(inventoryURL string, apiType string, username string, auth string) {
 	s.cleanUpTestRegistry()
 	_, err := s.client.CreateRegistry(s.ctx, &catalogv3.CreateRegistryRequest{Registry: &catalogv3.Registry{
-		Name: "reg", RootUrl: "http://foobar.com", Type: "HELM", InventoryUrl: inventoryURL, Username: username, AuthToken: auth,
+		Name: "reg", RootUrl: "http://foobar.com", Type: "HELM", InventoryUrl: inventoryURL, ApiType: apiType,
+		Username: username, AuthToken: auth,
 	}})
 	s.NoError(err)
 }
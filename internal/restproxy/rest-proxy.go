@@ -44,6 +44,7 @@ type RESTProxy struct {
 
 var allowedHeaders = map[string]struct{}{
 	"x-request-id": {},
+	"etag":         {},
 }
 
 const ActiveProjectID = "ActiveProjectID"
@@ -74,6 +75,10 @@ func NewRESTProxy(cfg *Config) (*RESTProxy, error) {
 			projectIDHeader := request.Header.Get(ActiveProjectID)
 			// send all the headers received from the client
 			md := metadata.Pairs("authorization", authHeader, "user-agent", uaHeader, "activeprojectid", projectIDHeader)
+			// conditional updates and deletes
+			if ifMatchHeader := request.Header.Get("If-Match"); ifMatchHeader != "" {
+				md.Set("if-match", ifMatchHeader)
+			}
 			return md
 		}),
 		runtime.WithRoutingErrorHandler(ginutils.HandleRoutingError),
@@ -133,9 +138,18 @@ func NewRESTProxy(cfg *Config) (*RESTProxy, error) {
 	//  -F "files=@path-to-file/file1.zip" \
 	//  -F "files=@path-to-file/file2.zip" \
 	//  -H "Content-Type: multipart/form-data"
+	// Append ?dryRun=true to get the plan of changes the upload would make, without applying them.
+	// Append ?conflictPolicy=UPLOAD_CONFLICT_POLICY_SKIP (or _FAIL) to leave existing entities intact
+	// (or reject the upload); conflictPolicies.registries etc. set the policy for individual kinds of entities.
+	// The response lists the outcome for each entity specification processed, including any that failed.
 	engine.Handle("POST", fmt.Sprintf("%scatalog.orchestrator.apis/upload", cfg.BasePath), func(c *gin.Context) {
 		fileHandler.Upload(c)
 	})
+	// Example:
+	// curl -o package.tar.gz "http://localhost:8080/export?name=my-package&version=1.0.0&showSensitiveInfo=false"
+	engine.Handle("GET", fmt.Sprintf("%scatalog.orchestrator.apis/export", cfg.BasePath), func(c *gin.Context) {
+		fileHandler.Export(c)
+	})
 	spec, err := openapiutils.LoadOpenAPISpec(cfg.SpecFilePath)
 	if err != nil {
 		return nil, err
@@ -49,3 +49,25 @@ func (s *ProxyTestSuite) TestEndpointTest() {
 func (s *ProxyTestSuite) TestOIDCExternalTest() {
 	s.checkRequest(s.newRequest("openidc-issuer"), 200)
 }
+
+func (s *ProxyTestSuite) TestRegistryETag() {
+	s.createTestRegistry("http://silly/", "", "")
+
+	resp, err := s.httpClient.Do(s.newRequest("catalog.orchestrator.apis/v3/registries/reg"))
+	s.NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	s.NotEmpty(etag)
+	_ = resp.Body.Close()
+
+	// Deleting with a stale If-Match header is rejected with a conflict
+	req := s.newRequest("catalog.orchestrator.apis/v3/registries/reg")
+	req.Method = http.MethodDelete
+	req.Header.Set("If-Match", `"0"`)
+	s.checkRequest(req, http.StatusConflict)
+
+	req = s.newRequest("catalog.orchestrator.apis/v3/registries/reg")
+	req.Method = http.MethodDelete
+	req.Header.Set("If-Match", etag)
+	s.checkRequest(req, http.StatusOK)
+}
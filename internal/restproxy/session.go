@@ -11,7 +11,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"io"
 	"sync"
@@ -240,7 +242,7 @@ func (s *Session) watchRegistries(ctx context.Context, projectUUID string) {
 	log.Infof("Started watching registry events")
 	for {
 		event, err := stream.Recv()
-		if !s.processError(err) {
+		if !s.processError(ctx, err, registryKind, projectUUID) {
 			break
 		}
 		buf, _ := protojson.Marshal(event.Registry)
@@ -259,7 +261,7 @@ func (s *Session) watchArtifacts(ctx context.Context, projectUUID string) {
 	log.Infof("Started watching artifact events")
 	for {
 		event, err := stream.Recv()
-		if !s.processError(err) {
+		if !s.processError(ctx, err, artifactKind, projectUUID) {
 			break
 		}
 		buf, _ := protojson.Marshal(event.Artifact)
@@ -278,7 +280,7 @@ func (s *Session) watchApplications(ctx context.Context, projectUUID string) {
 	log.Infof("Started watching application events")
 	for {
 		event, err := stream.Recv()
-		if !s.processError(err) {
+		if !s.processError(ctx, err, applicationKind, projectUUID) {
 			break
 		}
 		buf, _ := protojson.Marshal(event.Application)
@@ -297,7 +299,7 @@ func (s *Session) watchDeploymentPackages(ctx context.Context, projectUUID strin
 	log.Infof("Started watching deployment package events")
 	for {
 		event, err := stream.Recv()
-		if !s.processError(err) {
+		if !s.processError(ctx, err, deploymentPackageKind, projectUUID) {
 			break
 		}
 		buf, _ := protojson.Marshal(event.DeploymentPackage)
@@ -306,7 +308,14 @@ func (s *Session) watchDeploymentPackages(ctx context.Context, projectUUID strin
 	log.Infof("Stopped watching deployment package events")
 }
 
-func (s *Session) processError(err error) bool {
+func (s *Session) processError(ctx context.Context, err error, kind string, projectUUID string) bool {
+	if status.Code(err) == codes.ResourceExhausted && ctx.Err() == nil {
+		// The watch fell behind and was disconnected; let the client know it needs to resync and watch anew
+		log.Warnf("Watching %s events fell behind; resyncing: %v", kind, err)
+		s.processEvent(Message{Op: resyncOp, Kind: kind, Project: projectUUID})
+		s.startWatching(ctx, kind, projectUUID)
+		return false
+	}
 	if err != nil && err != io.EOF {
 		log.Warnf("Unable to read message: %v", err)
 	}
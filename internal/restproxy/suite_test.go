@@ -62,6 +62,9 @@ func (s *ProxyTestSuite) SetupSuite() {
 
 	s.dbClient = enttest.Open(s.T(), "sqlite3", "file:ent?mode=memory&_fk=1")
 
+	// The test registries are served locally, which is internal to the catalog
+	s.NoError(northbound.AllowDestinations("127.0.0.0/8, ::1/128"))
+
 	ctx := metadata.AppendToOutgoingContext(context.Background(), "activeprojectid", "project")
 	s.ctx, s.cancel = context.WithTimeout(ctx, 10*time.Minute)
 
@@ -126,6 +129,16 @@ func (s *ProxyTestSuite) StartRESTProxy() {
 	s.proxy, err = NewRESTProxy(s.cfg)
 	s.NoError(err)
 	go func() { _ = s.proxy.Run() }()
+
+	// Wait for the proxy to start accepting connections
+	s.Eventually(func() bool {
+		conn, err := net.Dial("tcp", "localhost:6942")
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}, 10*time.Second, 20*time.Millisecond)
 }
 
 func TestNorthBound(t *testing.T) {
@@ -6,16 +6,20 @@ package restproxy
 
 import (
 	"context"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/open-edge-platform/app-orch-catalog/internal/shared/jsonrenderer"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 )
 
 type FileHandler struct {
@@ -38,6 +42,27 @@ func (h *FileHandler) Upload(c *gin.Context) {
 	}
 	returnStatus := http.StatusOK
 
+	// A dry-run evaluates the uploaded files against the catalog without applying any changes
+	dryRun, _ := strconv.ParseBool(c.Query("dryRun"))
+
+	conflictPolicy, err := conflictPolicyParam(c, "conflictPolicy")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	conflictPolicies := &catalogv3.UploadConflictPolicies{}
+	for name, policy := range map[string]*catalogv3.UploadConflictPolicy{
+		"conflictPolicies.registries":         &conflictPolicies.Registries,
+		"conflictPolicies.artifacts":          &conflictPolicies.Artifacts,
+		"conflictPolicies.applications":       &conflictPolicies.Applications,
+		"conflictPolicies.deploymentPackages": &conflictPolicies.DeploymentPackages,
+	} {
+		if *policy, err = conflictPolicyParam(c, name); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	// Regular expression matcher for extracting full filename from Content-Disposition header
 	ffnregex, _ := regexp.Compile("filename=\\\"(.*)\\\"")
 
@@ -69,8 +94,11 @@ func (h *FileHandler) Upload(c *gin.Context) {
 			return
 		}
 		res, err := h.grpcClient.UploadCatalogEntities(mdCtx, &catalogv3.UploadCatalogEntitiesRequest{
-			SessionId:  sessionID,
-			LastUpload: (index + 1) == filesCount,
+			SessionId:        sessionID,
+			LastUpload:       (index + 1) == filesCount,
+			DryRun:           dryRun,
+			ConflictPolicy:   conflictPolicy,
+			ConflictPolicies: conflictPolicies,
 			Upload: &catalogv3.Upload{
 				FileName: path,
 				Artifact: content,
@@ -78,11 +106,7 @@ func (h *FileHandler) Upload(c *gin.Context) {
 		})
 
 		if err != nil {
-			responses.Responses = append(responses.Responses,
-				&catalogv3.UploadCatalogEntitiesResponse{
-					SessionId:     sessionID,
-					ErrorMessages: []string{err.Error()},
-				})
+			responses.Responses = append(responses.Responses, uploadErrorResponse(sessionID, err))
 			returnStatus = http.StatusBadRequest
 			log.Errorw("error processing file", dazl.String("file", file.Filename), dazl.Error(err))
 		} else {
@@ -98,6 +122,60 @@ func (h *FileHandler) Upload(c *gin.Context) {
 	c.Render(returnStatus, renderer)
 }
 
+// Returns the response for the failed upload request, including the per-entity results the catalog
+// attached to the error, if any.
+func uploadErrorResponse(sessionID string, err error) *catalogv3.UploadCatalogEntitiesResponse {
+	resp := &catalogv3.UploadCatalogEntitiesResponse{SessionId: sessionID}
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*catalogv3.UploadCatalogEntitiesResponse); ok {
+			resp.Results = d.Results
+		}
+	}
+	resp.ErrorMessages = []string{err.Error()}
+	return resp
+}
+
+// Returns the upload conflict policy given by the named query parameter, if any.
+func conflictPolicyParam(c *gin.Context, name string) (catalogv3.UploadConflictPolicy, error) {
+	value := c.Query(name)
+	if value == "" {
+		return catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_UNSPECIFIED, nil
+	}
+	policy, ok := catalogv3.UploadConflictPolicy_value[value]
+	if !ok {
+		return catalogv3.UploadConflictPolicy_UPLOAD_CONFLICT_POLICY_UNSPECIFIED, fmt.Errorf("invalid %s: %s", name, value)
+	}
+	return catalogv3.UploadConflictPolicy(policy), nil
+}
+
+// Export relays the deployment package export request and returns the resulting tarball as a file download.
+func (h *FileHandler) Export(c *gin.Context) {
+	query := c.Request.URL.Query()
+	name := query.Get("name")
+	version := query.Get("version")
+	showSensitiveInfo, _ := strconv.ParseBool(query.Get("showSensitiveInfo"))
+
+	mdCtx := metadata.NewOutgoingContext(c, metadata.Pairs(
+		"authorization", c.Request.Header.Get("Authorization"),
+		"user-agent", c.Request.Header.Get("User-Agent"),
+		"activeprojectid", c.Request.Header.Get(ActiveProjectID),
+	))
+
+	res, err := h.grpcClient.ExportDeploymentPackage(mdCtx, &catalogv3.ExportDeploymentPackageRequest{
+		DeploymentPackageName: name,
+		Version:               version,
+		ShowSensitiveInfo:     showSensitiveInfo,
+	})
+	if err != nil {
+		log.Errorw("error exporting deployment package", dazl.String("name", name), dazl.String("version", version), dazl.Error(err))
+		c.JSON(runtime.HTTPStatusFromCode(status.Code(err)), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+	c.Data(http.StatusOK, "application/gzip", res.Contents)
+}
+
 func NewFileHandler(endpoint string, opts []grpc.DialOption) (*FileHandler, error) {
 	log.Infow("Creating FileHandler", dazl.String("grpcEndpoint", endpoint))
 
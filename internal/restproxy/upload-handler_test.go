@@ -7,10 +7,13 @@ package restproxy
 import (
 	"bytes"
 	_ "github.com/mattn/go-sqlite3"
+	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"os"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func (s *ProxyTestSuite) TestUploadNoBody() {
@@ -37,6 +40,52 @@ func (s *ProxyTestSuite) TestUploadFile() {
 	}
 }
 
+func (s *ProxyTestSuite) TestUploadFileDryRun() {
+	resp, err := uploadMultipartFile(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/upload?dryRun=true",
+		[]string{
+			"../northbound/testdata/registry-new.yaml",
+		})
+	s.NoError(err)
+	if s.NotNil(resp) {
+		s.Equal(200, resp.StatusCode)
+		responses := s.readUploadResponses(resp)
+		if s.Len(responses.Responses, 1) && s.NotNil(responses.Responses[0].Plan) && s.Len(responses.Responses[0].Plan.Changes, 1) {
+			s.Equal("intel-new", responses.Responses[0].Plan.Changes[0].Name)
+			s.Equal(catalogv3.UploadPlannedChange_ACTION_CREATE, responses.Responses[0].Plan.Changes[0].Action)
+		}
+	}
+
+	// Make sure the registry was not created
+	_, err = s.client.GetRegistry(s.ctx, &catalogv3.GetRegistryRequest{RegistryName: "intel-new"})
+	s.Error(err)
+}
+
+func (s *ProxyTestSuite) TestUploadFileConflictPolicy() {
+	url := "http://localhost:6942/catalog.orchestrator.apis/upload?conflictPolicy=UPLOAD_CONFLICT_POLICY_SKIP"
+	for i := 0; i < 2; i++ {
+		resp, err := uploadMultipartFile(&s.httpClient, url, []string{"../northbound/testdata/artifact.yaml"})
+		s.NoError(err)
+		if s.NotNil(resp) {
+			s.Equal(200, resp.StatusCode)
+			responses := s.readUploadResponses(resp)
+			// The artifact may have been uploaded by another test; it will be skipped the second time around
+			if i == 1 && s.Len(responses.Responses, 1) && s.Len(responses.Responses[0].Skipped, 1) {
+				s.Equal("librespeed-thumbnail", responses.Responses[0].Skipped[0].Name)
+				if s.Len(responses.Responses[0].Results, 1) {
+					s.Equal(catalogv3.UploadResult_ACTION_SKIPPED, responses.Responses[0].Results[0].Action)
+				}
+			}
+		}
+	}
+
+	resp, err := uploadMultipartFile(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/upload?conflictPolicies.artifacts=NEVER",
+		[]string{"../northbound/testdata/artifact.yaml"})
+	s.NoError(err)
+	if s.NotNil(resp) {
+		s.Equal(400, resp.StatusCode)
+	}
+}
+
 func (s *ProxyTestSuite) TestUploadBadYAMLFiles() {
 	resp, _ := uploadMultipartFile(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/upload",
 		[]string{
@@ -44,7 +93,73 @@ func (s *ProxyTestSuite) TestUploadBadYAMLFiles() {
 		})
 	if s.NotNil(resp) {
 		s.Equal(400, resp.StatusCode)
+		responses := s.readUploadResponses(resp)
+		if s.Len(responses.Responses, 1) && s.Len(responses.Responses[0].Results, 1) {
+			result := responses.Responses[0].Results[0]
+			s.Equal("../northbound/testdata/badyaml/registry-intel.yaml", result.FileName)
+			s.Equal(catalogv3.UploadResult_ACTION_FAILED, result.Action)
+			if s.NotNil(result.Error) {
+				s.Equal("InvalidArgument", result.Error.Code)
+			}
+		}
+	}
+}
+
+func (s *ProxyTestSuite) TestExportDeploymentPackage() {
+	resp, err := uploadMultipartFile(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/upload",
+		[]string{
+			"../northbound/testdata/registry-intel.yaml",
+			"../northbound/testdata/artifact.yaml",
+			"../northbound/testdata/application-librespeed-0.0.2.yaml",
+			"../northbound/testdata/values.yaml",
+			"../northbound/testdata/deployment-package.yaml",
+		})
+	s.NoError(err)
+	if s.NotNil(resp) {
+		s.Equal(200, resp.StatusCode)
+	}
+
+	resp, err = exportPackage(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/export?name=librespeed-app&version=0.0.2")
+	s.NoError(err)
+	if s.NotNil(resp) {
+		body, err := io.ReadAll(resp.Body)
+		s.NoError(err)
+		s.Equal(200, resp.StatusCode)
+		s.Equal("application/gzip", resp.Header.Get("Content-Type"))
+		s.Equal(`attachment; filename="librespeed-app-0.0.2.tar.gz"`, resp.Header.Get("Content-Disposition"))
+		s.NotEmpty(body)
+	}
+}
+
+func (s *ProxyTestSuite) TestExportDeploymentPackageNotFound() {
+	resp, err := exportPackage(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/export?name=missing&version=0.1.2")
+	s.NoError(err)
+	if s.NotNil(resp) {
+		s.Equal(404, resp.StatusCode)
+	}
+
+	resp, err = exportPackage(&s.httpClient, "http://localhost:6942/catalog.orchestrator.apis/export?name=missing")
+	s.NoError(err)
+	if s.NotNil(resp) {
+		s.Equal(400, resp.StatusCode)
+	}
+}
+
+func (s *ProxyTestSuite) readUploadResponses(resp *http.Response) *catalogv3.UploadMultipleCatalogEntitiesResponse {
+	body, err := io.ReadAll(resp.Body)
+	s.NoError(err)
+	responses := &catalogv3.UploadMultipleCatalogEntitiesResponse{}
+	s.NoError(protojson.Unmarshal(body, responses))
+	return responses
+}
+
+func exportPackage(client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
 	}
+	req.Header.Set(ActiveProjectID, "project")
+	return client.Do(req)
 }
 
 func uploadMultipartFile(client *http.Client, url string, files []string) (*http.Response, error) {
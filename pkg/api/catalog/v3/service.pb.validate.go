@@ -92,6 +92,39 @@ func (m *UploadCatalogEntitiesRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for DryRun
+
+	// no validation rules for ConflictPolicy
+
+	if all {
+		switch v := interface{}(m.GetConflictPolicies()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadCatalogEntitiesRequestValidationError{
+					field:  "ConflictPolicies",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadCatalogEntitiesRequestValidationError{
+					field:  "ConflictPolicies",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConflictPolicies()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadCatalogEntitiesRequestValidationError{
+				field:  "ConflictPolicies",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UploadCatalogEntitiesRequestMultiError(errors)
 	}
@@ -173,46 +206,50 @@ var _ interface {
 	ErrorName() string
 } = UploadCatalogEntitiesRequestValidationError{}
 
-// Validate checks the field values on UploadCatalogEntitiesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UploadConflictPolicies with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UploadCatalogEntitiesResponse) Validate() error {
+func (m *UploadConflictPolicies) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadCatalogEntitiesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UploadCatalogEntitiesResponseMultiError, or nil if none found.
-func (m *UploadCatalogEntitiesResponse) ValidateAll() error {
+// ValidateAll checks the field values on UploadConflictPolicies with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadConflictPoliciesMultiError, or nil if none found.
+func (m *UploadConflictPolicies) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadCatalogEntitiesResponse) validate(all bool) error {
+func (m *UploadConflictPolicies) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SessionId
+	// no validation rules for Registries
 
-	// no validation rules for UploadNumber
+	// no validation rules for Artifacts
+
+	// no validation rules for Applications
+
+	// no validation rules for DeploymentPackages
 
 	if len(errors) > 0 {
-		return UploadCatalogEntitiesResponseMultiError(errors)
+		return UploadConflictPoliciesMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadCatalogEntitiesResponseMultiError is an error wrapping multiple
-// validation errors returned by UploadCatalogEntitiesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UploadCatalogEntitiesResponseMultiError []error
+// UploadConflictPoliciesMultiError is an error wrapping multiple validation
+// errors returned by UploadConflictPolicies.ValidateAll() if the designated
+// constraints aren't met.
+type UploadConflictPoliciesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadCatalogEntitiesResponseMultiError) Error() string {
+func (m UploadConflictPoliciesMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -221,12 +258,11 @@ func (m UploadCatalogEntitiesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UploadCatalogEntitiesResponseMultiError) AllErrors() []error { return m }
+func (m UploadConflictPoliciesMultiError) AllErrors() []error { return m }
 
-// UploadCatalogEntitiesResponseValidationError is the validation error
-// returned by UploadCatalogEntitiesResponse.Validate if the designated
-// constraints aren't met.
-type UploadCatalogEntitiesResponseValidationError struct {
+// UploadConflictPoliciesValidationError is the validation error returned by
+// UploadConflictPolicies.Validate if the designated constraints aren't met.
+type UploadConflictPoliciesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -234,24 +270,24 @@ type UploadCatalogEntitiesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadCatalogEntitiesResponseValidationError) Field() string { return e.field }
+func (e UploadConflictPoliciesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadCatalogEntitiesResponseValidationError) Reason() string { return e.reason }
+func (e UploadConflictPoliciesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadCatalogEntitiesResponseValidationError) Cause() error { return e.cause }
+func (e UploadConflictPoliciesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadCatalogEntitiesResponseValidationError) Key() bool { return e.key }
+func (e UploadConflictPoliciesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadCatalogEntitiesResponseValidationError) ErrorName() string {
-	return "UploadCatalogEntitiesResponseValidationError"
+func (e UploadConflictPoliciesValidationError) ErrorName() string {
+	return "UploadConflictPoliciesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UploadCatalogEntitiesResponseValidationError) Error() string {
+func (e UploadConflictPoliciesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -263,14 +299,14 @@ func (e UploadCatalogEntitiesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadCatalogEntitiesResponse.%s: %s%s",
+		"invalid %sUploadConflictPolicies.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadCatalogEntitiesResponseValidationError{}
+var _ error = UploadConflictPoliciesValidationError{}
 
 var _ interface {
 	Field() string
@@ -278,48 +314,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadCatalogEntitiesResponseValidationError{}
+} = UploadConflictPoliciesValidationError{}
 
-// Validate checks the field values on UploadMultipleCatalogEntitiesResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UploadMultipleCatalogEntitiesResponse) Validate() error {
+// Validate checks the field values on UploadCatalogEntitiesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadCatalogEntitiesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadMultipleCatalogEntitiesResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UploadMultipleCatalogEntitiesResponseMultiError, or nil if none found.
-func (m *UploadMultipleCatalogEntitiesResponse) ValidateAll() error {
+// ValidateAll checks the field values on UploadCatalogEntitiesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UploadCatalogEntitiesResponseMultiError, or nil if none found.
+func (m *UploadCatalogEntitiesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadMultipleCatalogEntitiesResponse) validate(all bool) error {
+func (m *UploadCatalogEntitiesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResponses() {
+	// no validation rules for SessionId
+
+	// no validation rules for UploadNumber
+
+	if all {
+		switch v := interface{}(m.GetPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadCatalogEntitiesResponseValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadCatalogEntitiesResponseValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadCatalogEntitiesResponseValidationError{
+				field:  "Plan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSkipped() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UploadMultipleCatalogEntitiesResponseValidationError{
-						field:  fmt.Sprintf("Responses[%v]", idx),
+					errors = append(errors, UploadCatalogEntitiesResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, UploadMultipleCatalogEntitiesResponseValidationError{
-						field:  fmt.Sprintf("Responses[%v]", idx),
+					errors = append(errors, UploadCatalogEntitiesResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -327,8 +395,42 @@ func (m *UploadMultipleCatalogEntitiesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return UploadMultipleCatalogEntitiesResponseValidationError{
-					field:  fmt.Sprintf("Responses[%v]", idx),
+				return UploadCatalogEntitiesResponseValidationError{
+					field:  fmt.Sprintf("Skipped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadCatalogEntitiesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadCatalogEntitiesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadCatalogEntitiesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -338,20 +440,19 @@ func (m *UploadMultipleCatalogEntitiesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UploadMultipleCatalogEntitiesResponseMultiError(errors)
+		return UploadCatalogEntitiesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadMultipleCatalogEntitiesResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// UploadMultipleCatalogEntitiesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UploadMultipleCatalogEntitiesResponseMultiError []error
+// UploadCatalogEntitiesResponseMultiError is an error wrapping multiple
+// validation errors returned by UploadCatalogEntitiesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UploadCatalogEntitiesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadMultipleCatalogEntitiesResponseMultiError) Error() string {
+func (m UploadCatalogEntitiesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -360,12 +461,12 @@ func (m UploadMultipleCatalogEntitiesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UploadMultipleCatalogEntitiesResponseMultiError) AllErrors() []error { return m }
+func (m UploadCatalogEntitiesResponseMultiError) AllErrors() []error { return m }
 
-// UploadMultipleCatalogEntitiesResponseValidationError is the validation error
-// returned by UploadMultipleCatalogEntitiesResponse.Validate if the
-// designated constraints aren't met.
-type UploadMultipleCatalogEntitiesResponseValidationError struct {
+// UploadCatalogEntitiesResponseValidationError is the validation error
+// returned by UploadCatalogEntitiesResponse.Validate if the designated
+// constraints aren't met.
+type UploadCatalogEntitiesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -373,24 +474,24 @@ type UploadMultipleCatalogEntitiesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadMultipleCatalogEntitiesResponseValidationError) Field() string { return e.field }
+func (e UploadCatalogEntitiesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadMultipleCatalogEntitiesResponseValidationError) Reason() string { return e.reason }
+func (e UploadCatalogEntitiesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadMultipleCatalogEntitiesResponseValidationError) Cause() error { return e.cause }
+func (e UploadCatalogEntitiesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadMultipleCatalogEntitiesResponseValidationError) Key() bool { return e.key }
+func (e UploadCatalogEntitiesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadMultipleCatalogEntitiesResponseValidationError) ErrorName() string {
-	return "UploadMultipleCatalogEntitiesResponseValidationError"
+func (e UploadCatalogEntitiesResponseValidationError) ErrorName() string {
+	return "UploadCatalogEntitiesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UploadMultipleCatalogEntitiesResponseValidationError) Error() string {
+func (e UploadCatalogEntitiesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -402,14 +503,14 @@ func (e UploadMultipleCatalogEntitiesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadMultipleCatalogEntitiesResponse.%s: %s%s",
+		"invalid %sUploadCatalogEntitiesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadMultipleCatalogEntitiesResponseValidationError{}
+var _ error = UploadCatalogEntitiesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -417,53 +518,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadMultipleCatalogEntitiesResponseValidationError{}
+} = UploadCatalogEntitiesResponseValidationError{}
 
-// Validate checks the field values on CreateRegistryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRegistryRequest) Validate() error {
+// Validate checks the field values on UploadResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRegistryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateRegistryRequestMultiError, or nil if none found.
-func (m *CreateRegistryRequest) ValidateAll() error {
+// ValidateAll checks the field values on UploadResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadResultMultiError, or
+// nil if none found.
+func (m *UploadResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRegistryRequest) validate(all bool) error {
+func (m *UploadResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for FileName
+
+	// no validation rules for SpecSchema
+
+	// no validation rules for Name
+
+	// no validation rules for Version
+
+	// no validation rules for Action
+
 	if all {
-		switch v := interface{}(m.GetRegistry()).(type) {
+		switch v := interface{}(m.GetError()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRegistryRequestValidationError{
-					field:  "Registry",
+				errors = append(errors, UploadResultValidationError{
+					field:  "Error",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRegistryRequestValidationError{
-					field:  "Registry",
+				errors = append(errors, UploadResultValidationError{
+					field:  "Error",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateRegistryRequestValidationError{
-				field:  "Registry",
+			return UploadResultValidationError{
+				field:  "Error",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -471,19 +582,18 @@ func (m *CreateRegistryRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateRegistryRequestMultiError(errors)
+		return UploadResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRegistryRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateRegistryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateRegistryRequestMultiError []error
+// UploadResultMultiError is an error wrapping multiple validation errors
+// returned by UploadResult.ValidateAll() if the designated constraints aren't met.
+type UploadResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRegistryRequestMultiError) Error() string {
+func (m UploadResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -492,11 +602,11 @@ func (m CreateRegistryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRegistryRequestMultiError) AllErrors() []error { return m }
+func (m UploadResultMultiError) AllErrors() []error { return m }
 
-// CreateRegistryRequestValidationError is the validation error returned by
-// CreateRegistryRequest.Validate if the designated constraints aren't met.
-type CreateRegistryRequestValidationError struct {
+// UploadResultValidationError is the validation error returned by
+// UploadResult.Validate if the designated constraints aren't met.
+type UploadResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -504,24 +614,22 @@ type CreateRegistryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRegistryRequestValidationError) Field() string { return e.field }
+func (e UploadResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRegistryRequestValidationError) Reason() string { return e.reason }
+func (e UploadResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRegistryRequestValidationError) Cause() error { return e.cause }
+func (e UploadResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRegistryRequestValidationError) Key() bool { return e.key }
+func (e UploadResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRegistryRequestValidationError) ErrorName() string {
-	return "CreateRegistryRequestValidationError"
-}
+func (e UploadResultValidationError) ErrorName() string { return "UploadResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRegistryRequestValidationError) Error() string {
+func (e UploadResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -533,14 +641,14 @@ func (e CreateRegistryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRegistryRequest.%s: %s%s",
+		"invalid %sUploadResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRegistryRequestValidationError{}
+var _ error = UploadResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -548,73 +656,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRegistryRequestValidationError{}
+} = UploadResultValidationError{}
 
-// Validate checks the field values on CreateRegistryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRegistryResponse) Validate() error {
+// Validate checks the field values on UploadError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRegistryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateRegistryResponseMultiError, or nil if none found.
-func (m *CreateRegistryResponse) ValidateAll() error {
+// ValidateAll checks the field values on UploadError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadErrorMultiError, or
+// nil if none found.
+func (m *UploadError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRegistryResponse) validate(all bool) error {
+func (m *UploadError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRegistry()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRegistryResponseValidationError{
-					field:  "Registry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRegistryResponseValidationError{
-					field:  "Registry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRegistryResponseValidationError{
-				field:  "Registry",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Code
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return CreateRegistryResponseMultiError(errors)
+		return UploadErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRegistryResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateRegistryResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateRegistryResponseMultiError []error
+// UploadErrorMultiError is an error wrapping multiple validation errors
+// returned by UploadError.ValidateAll() if the designated constraints aren't met.
+type UploadErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRegistryResponseMultiError) Error() string {
+func (m UploadErrorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -623,11 +705,11 @@ func (m CreateRegistryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRegistryResponseMultiError) AllErrors() []error { return m }
+func (m UploadErrorMultiError) AllErrors() []error { return m }
 
-// CreateRegistryResponseValidationError is the validation error returned by
-// CreateRegistryResponse.Validate if the designated constraints aren't met.
-type CreateRegistryResponseValidationError struct {
+// UploadErrorValidationError is the validation error returned by
+// UploadError.Validate if the designated constraints aren't met.
+type UploadErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -635,24 +717,22 @@ type CreateRegistryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRegistryResponseValidationError) Field() string { return e.field }
+func (e UploadErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRegistryResponseValidationError) Reason() string { return e.reason }
+func (e UploadErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRegistryResponseValidationError) Cause() error { return e.cause }
+func (e UploadErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRegistryResponseValidationError) Key() bool { return e.key }
+func (e UploadErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRegistryResponseValidationError) ErrorName() string {
-	return "CreateRegistryResponseValidationError"
-}
+func (e UploadErrorValidationError) ErrorName() string { return "UploadErrorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRegistryResponseValidationError) Error() string {
+func (e UploadErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -664,14 +744,14 @@ func (e CreateRegistryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRegistryResponse.%s: %s%s",
+		"invalid %sUploadError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRegistryResponseValidationError{}
+var _ error = UploadErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -679,54 +759,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRegistryResponseValidationError{}
+} = UploadErrorValidationError{}
 
-// Validate checks the field values on ListRegistriesRequest with the rules
+// Validate checks the field values on UploadEntityReference with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRegistriesRequest) Validate() error {
+func (m *UploadEntityReference) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRegistriesRequest with the rules
+// ValidateAll checks the field values on UploadEntityReference with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRegistriesRequestMultiError, or nil if none found.
-func (m *ListRegistriesRequest) ValidateAll() error {
+// UploadEntityReferenceMultiError, or nil if none found.
+func (m *UploadEntityReference) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRegistriesRequest) validate(all bool) error {
+func (m *UploadEntityReference) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderBy
-
-	// no validation rules for Filter
+	// no validation rules for FileName
 
-	// no validation rules for PageSize
+	// no validation rules for SpecSchema
 
-	// no validation rules for Offset
+	// no validation rules for Name
 
-	// no validation rules for ShowSensitiveInfo
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return ListRegistriesRequestMultiError(errors)
+		return UploadEntityReferenceMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRegistriesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListRegistriesRequest.ValidateAll() if the designated
+// UploadEntityReferenceMultiError is an error wrapping multiple validation
+// errors returned by UploadEntityReference.ValidateAll() if the designated
 // constraints aren't met.
-type ListRegistriesRequestMultiError []error
+type UploadEntityReferenceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRegistriesRequestMultiError) Error() string {
+func (m UploadEntityReferenceMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -735,11 +813,11 @@ func (m ListRegistriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRegistriesRequestMultiError) AllErrors() []error { return m }
+func (m UploadEntityReferenceMultiError) AllErrors() []error { return m }
 
-// ListRegistriesRequestValidationError is the validation error returned by
-// ListRegistriesRequest.Validate if the designated constraints aren't met.
-type ListRegistriesRequestValidationError struct {
+// UploadEntityReferenceValidationError is the validation error returned by
+// UploadEntityReference.Validate if the designated constraints aren't met.
+type UploadEntityReferenceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -747,24 +825,24 @@ type ListRegistriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRegistriesRequestValidationError) Field() string { return e.field }
+func (e UploadEntityReferenceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRegistriesRequestValidationError) Reason() string { return e.reason }
+func (e UploadEntityReferenceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRegistriesRequestValidationError) Cause() error { return e.cause }
+func (e UploadEntityReferenceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRegistriesRequestValidationError) Key() bool { return e.key }
+func (e UploadEntityReferenceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRegistriesRequestValidationError) ErrorName() string {
-	return "ListRegistriesRequestValidationError"
+func (e UploadEntityReferenceValidationError) ErrorName() string {
+	return "UploadEntityReferenceValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRegistriesRequestValidationError) Error() string {
+func (e UploadEntityReferenceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -776,14 +854,14 @@ func (e ListRegistriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRegistriesRequest.%s: %s%s",
+		"invalid %sUploadEntityReference.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRegistriesRequestValidationError{}
+var _ error = UploadEntityReferenceValidationError{}
 
 var _ interface {
 	Field() string
@@ -791,47 +869,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRegistriesRequestValidationError{}
+} = UploadEntityReferenceValidationError{}
 
-// Validate checks the field values on ListRegistriesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRegistriesResponse) Validate() error {
+// Validate checks the field values on UploadPlan with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadPlan) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRegistriesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListRegistriesResponseMultiError, or nil if none found.
-func (m *ListRegistriesResponse) ValidateAll() error {
+// ValidateAll checks the field values on UploadPlan with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadPlanMultiError, or
+// nil if none found.
+func (m *UploadPlan) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRegistriesResponse) validate(all bool) error {
+func (m *UploadPlan) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRegistries() {
+	for idx, item := range m.GetChanges() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRegistriesResponseValidationError{
-						field:  fmt.Sprintf("Registries[%v]", idx),
+					errors = append(errors, UploadPlanValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRegistriesResponseValidationError{
-						field:  fmt.Sprintf("Registries[%v]", idx),
+					errors = append(errors, UploadPlanValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -839,8 +917,8 @@ func (m *ListRegistriesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListRegistriesResponseValidationError{
-					field:  fmt.Sprintf("Registries[%v]", idx),
+				return UploadPlanValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -849,22 +927,19 @@ func (m *ListRegistriesResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalElements
-
 	if len(errors) > 0 {
-		return ListRegistriesResponseMultiError(errors)
+		return UploadPlanMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRegistriesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListRegistriesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListRegistriesResponseMultiError []error
+// UploadPlanMultiError is an error wrapping multiple validation errors
+// returned by UploadPlan.ValidateAll() if the designated constraints aren't met.
+type UploadPlanMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRegistriesResponseMultiError) Error() string {
+func (m UploadPlanMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -873,11 +948,11 @@ func (m ListRegistriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRegistriesResponseMultiError) AllErrors() []error { return m }
+func (m UploadPlanMultiError) AllErrors() []error { return m }
 
-// ListRegistriesResponseValidationError is the validation error returned by
-// ListRegistriesResponse.Validate if the designated constraints aren't met.
-type ListRegistriesResponseValidationError struct {
+// UploadPlanValidationError is the validation error returned by
+// UploadPlan.Validate if the designated constraints aren't met.
+type UploadPlanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -885,24 +960,22 @@ type ListRegistriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRegistriesResponseValidationError) Field() string { return e.field }
+func (e UploadPlanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRegistriesResponseValidationError) Reason() string { return e.reason }
+func (e UploadPlanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRegistriesResponseValidationError) Cause() error { return e.cause }
+func (e UploadPlanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRegistriesResponseValidationError) Key() bool { return e.key }
+func (e UploadPlanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRegistriesResponseValidationError) ErrorName() string {
-	return "ListRegistriesResponseValidationError"
-}
+func (e UploadPlanValidationError) ErrorName() string { return "UploadPlanValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListRegistriesResponseValidationError) Error() string {
+func (e UploadPlanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -914,14 +987,14 @@ func (e ListRegistriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRegistriesResponse.%s: %s%s",
+		"invalid %sUploadPlan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRegistriesResponseValidationError{}
+var _ error = UploadPlanValidationError{}
 
 var _ interface {
 	Field() string
@@ -929,48 +1002,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRegistriesResponseValidationError{}
+} = UploadPlanValidationError{}
 
-// Validate checks the field values on GetRegistryRequest with the rules
+// Validate checks the field values on UploadPlannedChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRegistryRequest) Validate() error {
+func (m *UploadPlannedChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRegistryRequest with the rules
+// ValidateAll checks the field values on UploadPlannedChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRegistryRequestMultiError, or nil if none found.
-func (m *GetRegistryRequest) ValidateAll() error {
+// UploadPlannedChangeMultiError, or nil if none found.
+func (m *UploadPlannedChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRegistryRequest) validate(all bool) error {
+func (m *UploadPlannedChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RegistryName
+	// no validation rules for FileName
 
-	// no validation rules for ShowSensitiveInfo
+	// no validation rules for SpecSchema
+
+	// no validation rules for Name
+
+	// no validation rules for Version
+
+	// no validation rules for Action
 
 	if len(errors) > 0 {
-		return GetRegistryRequestMultiError(errors)
+		return UploadPlannedChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRegistryRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRegistryRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetRegistryRequestMultiError []error
+// UploadPlannedChangeMultiError is an error wrapping multiple validation
+// errors returned by UploadPlannedChange.ValidateAll() if the designated
+// constraints aren't met.
+type UploadPlannedChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRegistryRequestMultiError) Error() string {
+func (m UploadPlannedChangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -979,11 +1058,11 @@ func (m GetRegistryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRegistryRequestMultiError) AllErrors() []error { return m }
+func (m UploadPlannedChangeMultiError) AllErrors() []error { return m }
 
-// GetRegistryRequestValidationError is the validation error returned by
-// GetRegistryRequest.Validate if the designated constraints aren't met.
-type GetRegistryRequestValidationError struct {
+// UploadPlannedChangeValidationError is the validation error returned by
+// UploadPlannedChange.Validate if the designated constraints aren't met.
+type UploadPlannedChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -991,24 +1070,24 @@ type GetRegistryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRegistryRequestValidationError) Field() string { return e.field }
+func (e UploadPlannedChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRegistryRequestValidationError) Reason() string { return e.reason }
+func (e UploadPlannedChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRegistryRequestValidationError) Cause() error { return e.cause }
+func (e UploadPlannedChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRegistryRequestValidationError) Key() bool { return e.key }
+func (e UploadPlannedChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRegistryRequestValidationError) ErrorName() string {
-	return "GetRegistryRequestValidationError"
+func (e UploadPlannedChangeValidationError) ErrorName() string {
+	return "UploadPlannedChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRegistryRequestValidationError) Error() string {
+func (e UploadPlannedChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1020,14 +1099,14 @@ func (e GetRegistryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRegistryRequest.%s: %s%s",
+		"invalid %sUploadPlannedChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRegistryRequestValidationError{}
+var _ error = UploadPlannedChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1035,73 +1114,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRegistryRequestValidationError{}
+} = UploadPlannedChangeValidationError{}
 
-// Validate checks the field values on GetRegistryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRegistryResponse) Validate() error {
+// Validate checks the field values on UploadMultipleCatalogEntitiesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UploadMultipleCatalogEntitiesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRegistryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRegistryResponseMultiError, or nil if none found.
-func (m *GetRegistryResponse) ValidateAll() error {
+// ValidateAll checks the field values on UploadMultipleCatalogEntitiesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UploadMultipleCatalogEntitiesResponseMultiError, or nil if none found.
+func (m *UploadMultipleCatalogEntitiesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRegistryResponse) validate(all bool) error {
+func (m *UploadMultipleCatalogEntitiesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRegistry()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRegistryResponseValidationError{
-					field:  "Registry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetResponses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadMultipleCatalogEntitiesResponseValidationError{
+						field:  fmt.Sprintf("Responses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadMultipleCatalogEntitiesResponseValidationError{
+						field:  fmt.Sprintf("Responses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRegistryResponseValidationError{
-					field:  "Registry",
+				return UploadMultipleCatalogEntitiesResponseValidationError{
+					field:  fmt.Sprintf("Responses[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRegistryResponseValidationError{
-				field:  "Registry",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetRegistryResponseMultiError(errors)
+		return UploadMultipleCatalogEntitiesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRegistryResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRegistryResponse.ValidateAll() if the designated
+// UploadMultipleCatalogEntitiesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UploadMultipleCatalogEntitiesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRegistryResponseMultiError []error
+type UploadMultipleCatalogEntitiesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRegistryResponseMultiError) Error() string {
+func (m UploadMultipleCatalogEntitiesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1110,11 +1196,12 @@ func (m GetRegistryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRegistryResponseMultiError) AllErrors() []error { return m }
+func (m UploadMultipleCatalogEntitiesResponseMultiError) AllErrors() []error { return m }
 
-// GetRegistryResponseValidationError is the validation error returned by
-// GetRegistryResponse.Validate if the designated constraints aren't met.
-type GetRegistryResponseValidationError struct {
+// UploadMultipleCatalogEntitiesResponseValidationError is the validation error
+// returned by UploadMultipleCatalogEntitiesResponse.Validate if the
+// designated constraints aren't met.
+type UploadMultipleCatalogEntitiesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1122,24 +1209,24 @@ type GetRegistryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRegistryResponseValidationError) Field() string { return e.field }
+func (e UploadMultipleCatalogEntitiesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRegistryResponseValidationError) Reason() string { return e.reason }
+func (e UploadMultipleCatalogEntitiesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRegistryResponseValidationError) Cause() error { return e.cause }
+func (e UploadMultipleCatalogEntitiesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRegistryResponseValidationError) Key() bool { return e.key }
+func (e UploadMultipleCatalogEntitiesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRegistryResponseValidationError) ErrorName() string {
-	return "GetRegistryResponseValidationError"
+func (e UploadMultipleCatalogEntitiesResponseValidationError) ErrorName() string {
+	return "UploadMultipleCatalogEntitiesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRegistryResponseValidationError) Error() string {
+func (e UploadMultipleCatalogEntitiesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1151,14 +1238,14 @@ func (e GetRegistryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRegistryResponse.%s: %s%s",
+		"invalid %sUploadMultipleCatalogEntitiesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRegistryResponseValidationError{}
+var _ error = UploadMultipleCatalogEntitiesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1166,37 +1253,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRegistryResponseValidationError{}
+} = UploadMultipleCatalogEntitiesResponseValidationError{}
 
-// Validate checks the field values on UpdateRegistryRequest with the rules
+// Validate checks the field values on CreateRegistryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRegistryRequest) Validate() error {
+func (m *CreateRegistryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRegistryRequest with the rules
+// ValidateAll checks the field values on CreateRegistryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRegistryRequestMultiError, or nil if none found.
-func (m *UpdateRegistryRequest) ValidateAll() error {
+// CreateRegistryRequestMultiError, or nil if none found.
+func (m *CreateRegistryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRegistryRequest) validate(all bool) error {
+func (m *CreateRegistryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RegistryName
-
 	if all {
 		switch v := interface{}(m.GetRegistry()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRegistryRequestValidationError{
+				errors = append(errors, CreateRegistryRequestValidationError{
 					field:  "Registry",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1204,7 +1289,7 @@ func (m *UpdateRegistryRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRegistryRequestValidationError{
+				errors = append(errors, CreateRegistryRequestValidationError{
 					field:  "Registry",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1213,7 +1298,7 @@ func (m *UpdateRegistryRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateRegistryRequestValidationError{
+			return CreateRegistryRequestValidationError{
 				field:  "Registry",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1222,19 +1307,19 @@ func (m *UpdateRegistryRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRegistryRequestMultiError(errors)
+		return CreateRegistryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRegistryRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateRegistryRequest.ValidateAll() if the designated
+// CreateRegistryRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRegistryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateRegistryRequestMultiError []error
+type CreateRegistryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRegistryRequestMultiError) Error() string {
+func (m CreateRegistryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1243,11 +1328,11 @@ func (m UpdateRegistryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRegistryRequestMultiError) AllErrors() []error { return m }
+func (m CreateRegistryRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRegistryRequestValidationError is the validation error returned by
-// UpdateRegistryRequest.Validate if the designated constraints aren't met.
-type UpdateRegistryRequestValidationError struct {
+// CreateRegistryRequestValidationError is the validation error returned by
+// CreateRegistryRequest.Validate if the designated constraints aren't met.
+type CreateRegistryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1255,24 +1340,24 @@ type UpdateRegistryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRegistryRequestValidationError) Field() string { return e.field }
+func (e CreateRegistryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRegistryRequestValidationError) Reason() string { return e.reason }
+func (e CreateRegistryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRegistryRequestValidationError) Cause() error { return e.cause }
+func (e CreateRegistryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRegistryRequestValidationError) Key() bool { return e.key }
+func (e CreateRegistryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRegistryRequestValidationError) ErrorName() string {
-	return "UpdateRegistryRequestValidationError"
+func (e CreateRegistryRequestValidationError) ErrorName() string {
+	return "CreateRegistryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRegistryRequestValidationError) Error() string {
+func (e CreateRegistryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1284,14 +1369,14 @@ func (e UpdateRegistryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRegistryRequest.%s: %s%s",
+		"invalid %sCreateRegistryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRegistryRequestValidationError{}
+var _ error = CreateRegistryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1299,46 +1384,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRegistryRequestValidationError{}
+} = CreateRegistryRequestValidationError{}
 
-// Validate checks the field values on DeleteRegistryRequest with the rules
+// Validate checks the field values on CreateRegistryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRegistryRequest) Validate() error {
+func (m *CreateRegistryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRegistryRequest with the rules
+// ValidateAll checks the field values on CreateRegistryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRegistryRequestMultiError, or nil if none found.
-func (m *DeleteRegistryRequest) ValidateAll() error {
+// CreateRegistryResponseMultiError, or nil if none found.
+func (m *CreateRegistryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRegistryRequest) validate(all bool) error {
+func (m *CreateRegistryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RegistryName
+	if all {
+		switch v := interface{}(m.GetRegistry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRegistryResponseValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRegistryResponseValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRegistryResponseValidationError{
+				field:  "Registry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteRegistryRequestMultiError(errors)
+		return CreateRegistryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRegistryRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteRegistryRequest.ValidateAll() if the designated
+// CreateRegistryResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateRegistryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteRegistryRequestMultiError []error
+type CreateRegistryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRegistryRequestMultiError) Error() string {
+func (m CreateRegistryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1347,11 +1459,11 @@ func (m DeleteRegistryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRegistryRequestMultiError) AllErrors() []error { return m }
+func (m CreateRegistryResponseMultiError) AllErrors() []error { return m }
 
-// DeleteRegistryRequestValidationError is the validation error returned by
-// DeleteRegistryRequest.Validate if the designated constraints aren't met.
-type DeleteRegistryRequestValidationError struct {
+// CreateRegistryResponseValidationError is the validation error returned by
+// CreateRegistryResponse.Validate if the designated constraints aren't met.
+type CreateRegistryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1359,24 +1471,24 @@ type DeleteRegistryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRegistryRequestValidationError) Field() string { return e.field }
+func (e CreateRegistryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRegistryRequestValidationError) Reason() string { return e.reason }
+func (e CreateRegistryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRegistryRequestValidationError) Cause() error { return e.cause }
+func (e CreateRegistryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRegistryRequestValidationError) Key() bool { return e.key }
+func (e CreateRegistryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRegistryRequestValidationError) ErrorName() string {
-	return "DeleteRegistryRequestValidationError"
+func (e CreateRegistryResponseValidationError) ErrorName() string {
+	return "CreateRegistryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRegistryRequestValidationError) Error() string {
+func (e CreateRegistryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1388,14 +1500,14 @@ func (e DeleteRegistryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRegistryRequest.%s: %s%s",
+		"invalid %sCreateRegistryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRegistryRequestValidationError{}
+var _ error = CreateRegistryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1403,50 +1515,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRegistryRequestValidationError{}
+} = CreateRegistryResponseValidationError{}
 
-// Validate checks the field values on WatchRegistriesRequest with the rules
+// Validate checks the field values on ListRegistriesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchRegistriesRequest) Validate() error {
+func (m *ListRegistriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchRegistriesRequest with the rules
+// ValidateAll checks the field values on ListRegistriesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchRegistriesRequestMultiError, or nil if none found.
-func (m *WatchRegistriesRequest) ValidateAll() error {
+// ListRegistriesRequestMultiError, or nil if none found.
+func (m *ListRegistriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchRegistriesRequest) validate(all bool) error {
+func (m *ListRegistriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for OrderBy
 
-	// no validation rules for NoReplay
+	// no validation rules for Filter
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
 
 	// no validation rules for ShowSensitiveInfo
 
+	// no validation rules for PageToken
+
+	// no validation rules for SkipTotal
+
+	// no validation rules for LabelSelector
+
 	if len(errors) > 0 {
-		return WatchRegistriesRequestMultiError(errors)
+		return ListRegistriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchRegistriesRequestMultiError is an error wrapping multiple validation
-// errors returned by WatchRegistriesRequest.ValidateAll() if the designated
+// ListRegistriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRegistriesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type WatchRegistriesRequestMultiError []error
+type ListRegistriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchRegistriesRequestMultiError) Error() string {
+func (m ListRegistriesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1455,11 +1577,11 @@ func (m WatchRegistriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchRegistriesRequestMultiError) AllErrors() []error { return m }
+func (m ListRegistriesRequestMultiError) AllErrors() []error { return m }
 
-// WatchRegistriesRequestValidationError is the validation error returned by
-// WatchRegistriesRequest.Validate if the designated constraints aren't met.
-type WatchRegistriesRequestValidationError struct {
+// ListRegistriesRequestValidationError is the validation error returned by
+// ListRegistriesRequest.Validate if the designated constraints aren't met.
+type ListRegistriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1467,24 +1589,24 @@ type WatchRegistriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchRegistriesRequestValidationError) Field() string { return e.field }
+func (e ListRegistriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchRegistriesRequestValidationError) Reason() string { return e.reason }
+func (e ListRegistriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchRegistriesRequestValidationError) Cause() error { return e.cause }
+func (e ListRegistriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchRegistriesRequestValidationError) Key() bool { return e.key }
+func (e ListRegistriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchRegistriesRequestValidationError) ErrorName() string {
-	return "WatchRegistriesRequestValidationError"
+func (e ListRegistriesRequestValidationError) ErrorName() string {
+	return "ListRegistriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchRegistriesRequestValidationError) Error() string {
+func (e ListRegistriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1496,14 +1618,14 @@ func (e WatchRegistriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchRegistriesRequest.%s: %s%s",
+		"invalid %sListRegistriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchRegistriesRequestValidationError{}
+var _ error = ListRegistriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1511,64 +1633,281 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchRegistriesRequestValidationError{}
+} = ListRegistriesRequestValidationError{}
 
-// Validate checks the field values on WatchRegistriesResponse with the rules
+// Validate checks the field values on ListRegistriesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchRegistriesResponse) Validate() error {
+func (m *ListRegistriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchRegistriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRegistriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchRegistriesResponseMultiError, or nil if none found.
-func (m *WatchRegistriesResponse) ValidateAll() error {
+// ListRegistriesResponseMultiError, or nil if none found.
+func (m *ListRegistriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchRegistriesResponse) validate(all bool) error {
+func (m *ListRegistriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchRegistriesResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetRegistries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRegistriesResponseValidationError{
+						field:  fmt.Sprintf("Registries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRegistriesResponseValidationError{
+						field:  fmt.Sprintf("Registries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchRegistriesResponseValidationError{
-					field:  "Event",
+				return ListRegistriesResponseValidationError{
+					field:  fmt.Sprintf("Registries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchRegistriesResponseValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetRegistry()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchRegistriesResponseValidationError{
+	// no validation rules for TotalElements
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRegistriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRegistriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRegistriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRegistriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRegistriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRegistriesResponseMultiError) AllErrors() []error { return m }
+
+// ListRegistriesResponseValidationError is the validation error returned by
+// ListRegistriesResponse.Validate if the designated constraints aren't met.
+type ListRegistriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegistriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegistriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegistriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegistriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegistriesResponseValidationError) ErrorName() string {
+	return "ListRegistriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegistriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegistriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegistriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegistriesResponseValidationError{}
+
+// Validate checks the field values on GetRegistryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRegistryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRegistryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRegistryRequestMultiError, or nil if none found.
+func (m *GetRegistryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRegistryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegistryName
+
+	// no validation rules for ShowSensitiveInfo
+
+	if len(errors) > 0 {
+		return GetRegistryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRegistryRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRegistryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRegistryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRegistryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRegistryRequestMultiError) AllErrors() []error { return m }
+
+// GetRegistryRequestValidationError is the validation error returned by
+// GetRegistryRequest.Validate if the designated constraints aren't met.
+type GetRegistryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRegistryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRegistryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRegistryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRegistryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRegistryRequestValidationError) ErrorName() string {
+	return "GetRegistryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRegistryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRegistryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRegistryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRegistryRequestValidationError{}
+
+// Validate checks the field values on GetRegistryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRegistryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRegistryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRegistryResponseMultiError, or nil if none found.
+func (m *GetRegistryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRegistryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRegistry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRegistryResponseValidationError{
 					field:  "Registry",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1576,7 +1915,7 @@ func (m *WatchRegistriesResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchRegistriesResponseValidationError{
+				errors = append(errors, GetRegistryResponseValidationError{
 					field:  "Registry",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1585,7 +1924,7 @@ func (m *WatchRegistriesResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WatchRegistriesResponseValidationError{
+			return GetRegistryResponseValidationError{
 				field:  "Registry",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1594,19 +1933,6346 @@ func (m *WatchRegistriesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WatchRegistriesResponseMultiError(errors)
+		return GetRegistryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRegistryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRegistryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRegistryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRegistryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRegistryResponseMultiError) AllErrors() []error { return m }
+
+// GetRegistryResponseValidationError is the validation error returned by
+// GetRegistryResponse.Validate if the designated constraints aren't met.
+type GetRegistryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRegistryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRegistryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRegistryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRegistryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRegistryResponseValidationError) ErrorName() string {
+	return "GetRegistryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRegistryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRegistryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRegistryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRegistryResponseValidationError{}
+
+// Validate checks the field values on UpdateRegistryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRegistryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRegistryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRegistryRequestMultiError, or nil if none found.
+func (m *UpdateRegistryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRegistryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegistryName
+
+	if all {
+		switch v := interface{}(m.GetRegistry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRegistryRequestValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRegistryRequestValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRegistryRequestValidationError{
+				field:  "Registry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRegistryRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRegistryRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRegistryRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRegistryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRegistryRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRegistryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRegistryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRegistryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRegistryRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRegistryRequestValidationError is the validation error returned by
+// UpdateRegistryRequest.Validate if the designated constraints aren't met.
+type UpdateRegistryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRegistryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRegistryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRegistryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRegistryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRegistryRequestValidationError) ErrorName() string {
+	return "UpdateRegistryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRegistryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRegistryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRegistryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRegistryRequestValidationError{}
+
+// Validate checks the field values on DeleteRegistryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRegistryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRegistryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRegistryRequestMultiError, or nil if none found.
+func (m *DeleteRegistryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRegistryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegistryName
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return DeleteRegistryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRegistryRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRegistryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRegistryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRegistryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRegistryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRegistryRequestValidationError is the validation error returned by
+// DeleteRegistryRequest.Validate if the designated constraints aren't met.
+type DeleteRegistryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRegistryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRegistryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRegistryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRegistryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRegistryRequestValidationError) ErrorName() string {
+	return "DeleteRegistryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRegistryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRegistryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRegistryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRegistryRequestValidationError{}
+
+// Validate checks the field values on WatchRegistriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchRegistriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRegistriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRegistriesRequestMultiError, or nil if none found.
+func (m *WatchRegistriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRegistriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for NoReplay
+
+	// no validation rules for ShowSensitiveInfo
+
+	// no validation rules for ResumeFromRevision
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return WatchRegistriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRegistriesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchRegistriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchRegistriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRegistriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRegistriesRequestMultiError) AllErrors() []error { return m }
+
+// WatchRegistriesRequestValidationError is the validation error returned by
+// WatchRegistriesRequest.Validate if the designated constraints aren't met.
+type WatchRegistriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRegistriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRegistriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRegistriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRegistriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRegistriesRequestValidationError) ErrorName() string {
+	return "WatchRegistriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRegistriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRegistriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRegistriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRegistriesRequestValidationError{}
+
+// Validate checks the field values on WatchRegistriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchRegistriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRegistriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRegistriesResponseMultiError, or nil if none found.
+func (m *WatchRegistriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRegistriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchRegistriesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchRegistriesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchRegistriesResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRegistry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchRegistriesResponseValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchRegistriesResponseValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchRegistriesResponseValidationError{
+				field:  "Registry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchRegistriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRegistriesResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchRegistriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchRegistriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRegistriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRegistriesResponseMultiError) AllErrors() []error { return m }
+
+// WatchRegistriesResponseValidationError is the validation error returned by
+// WatchRegistriesResponse.Validate if the designated constraints aren't met.
+type WatchRegistriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRegistriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRegistriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRegistriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRegistriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRegistriesResponseValidationError) ErrorName() string {
+	return "WatchRegistriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRegistriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRegistriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRegistriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRegistriesResponseValidationError{}
+
+// Validate checks the field values on TestRegistryConnectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestRegistryConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestRegistryConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TestRegistryConnectionRequestMultiError, or nil if none found.
+func (m *TestRegistryConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestRegistryConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegistryName
+
+	if all {
+		switch v := interface{}(m.GetRegistry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestRegistryConnectionRequestValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestRegistryConnectionRequestValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestRegistryConnectionRequestValidationError{
+				field:  "Registry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TestRegistryConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestRegistryConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by TestRegistryConnectionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type TestRegistryConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestRegistryConnectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestRegistryConnectionRequestMultiError) AllErrors() []error { return m }
+
+// TestRegistryConnectionRequestValidationError is the validation error
+// returned by TestRegistryConnectionRequest.Validate if the designated
+// constraints aren't met.
+type TestRegistryConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestRegistryConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestRegistryConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestRegistryConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestRegistryConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestRegistryConnectionRequestValidationError) ErrorName() string {
+	return "TestRegistryConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestRegistryConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestRegistryConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestRegistryConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestRegistryConnectionRequestValidationError{}
+
+// Validate checks the field values on TestRegistryConnectionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestRegistryConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestRegistryConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TestRegistryConnectionResponseMultiError, or nil if none found.
+func (m *TestRegistryConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestRegistryConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for ApiType
+
+	if len(errors) > 0 {
+		return TestRegistryConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestRegistryConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by TestRegistryConnectionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type TestRegistryConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestRegistryConnectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestRegistryConnectionResponseMultiError) AllErrors() []error { return m }
+
+// TestRegistryConnectionResponseValidationError is the validation error
+// returned by TestRegistryConnectionResponse.Validate if the designated
+// constraints aren't met.
+type TestRegistryConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestRegistryConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestRegistryConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestRegistryConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestRegistryConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestRegistryConnectionResponseValidationError) ErrorName() string {
+	return "TestRegistryConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestRegistryConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestRegistryConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestRegistryConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestRegistryConnectionResponseValidationError{}
+
+// Validate checks the field values on ListRegistryChartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRegistryChartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRegistryChartsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRegistryChartsRequestMultiError, or nil if none found.
+func (m *ListRegistryChartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRegistryChartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegistryName
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListRegistryChartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRegistryChartsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRegistryChartsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRegistryChartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRegistryChartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRegistryChartsRequestMultiError) AllErrors() []error { return m }
+
+// ListRegistryChartsRequestValidationError is the validation error returned by
+// ListRegistryChartsRequest.Validate if the designated constraints aren't met.
+type ListRegistryChartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegistryChartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegistryChartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegistryChartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegistryChartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegistryChartsRequestValidationError) ErrorName() string {
+	return "ListRegistryChartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegistryChartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegistryChartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegistryChartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegistryChartsRequestValidationError{}
+
+// Validate checks the field values on ListRegistryChartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRegistryChartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRegistryChartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRegistryChartsResponseMultiError, or nil if none found.
+func (m *ListRegistryChartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRegistryChartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalElements
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRegistryChartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRegistryChartsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRegistryChartsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRegistryChartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRegistryChartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRegistryChartsResponseMultiError) AllErrors() []error { return m }
+
+// ListRegistryChartsResponseValidationError is the validation error returned
+// by ListRegistryChartsResponse.Validate if the designated constraints aren't met.
+type ListRegistryChartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRegistryChartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRegistryChartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRegistryChartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRegistryChartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRegistryChartsResponseValidationError) ErrorName() string {
+	return "ListRegistryChartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRegistryChartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRegistryChartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRegistryChartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRegistryChartsResponseValidationError{}
+
+// Validate checks the field values on ListChartVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChartVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChartVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChartVersionsRequestMultiError, or nil if none found.
+func (m *ListChartVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChartVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegistryName
+
+	// no validation rules for ChartName
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListChartVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChartVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListChartVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListChartVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChartVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChartVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListChartVersionsRequestValidationError is the validation error returned by
+// ListChartVersionsRequest.Validate if the designated constraints aren't met.
+type ListChartVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChartVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChartVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChartVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChartVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChartVersionsRequestValidationError) ErrorName() string {
+	return "ListChartVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChartVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChartVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChartVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChartVersionsRequestValidationError{}
+
+// Validate checks the field values on ListChartVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChartVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChartVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChartVersionsResponseMultiError, or nil if none found.
+func (m *ListChartVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChartVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChartVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChartVersionsResponseValidationError{
+						field:  fmt.Sprintf("ChartVersions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChartVersionsResponseValidationError{
+						field:  fmt.Sprintf("ChartVersions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListChartVersionsResponseValidationError{
+					field:  fmt.Sprintf("ChartVersions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListChartVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChartVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListChartVersionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListChartVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChartVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChartVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListChartVersionsResponseValidationError is the validation error returned by
+// ListChartVersionsResponse.Validate if the designated constraints aren't met.
+type ListChartVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChartVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChartVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChartVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChartVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChartVersionsResponseValidationError) ErrorName() string {
+	return "ListChartVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChartVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChartVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChartVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChartVersionsResponseValidationError{}
+
+// Validate checks the field values on CreateDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDeploymentPackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateDeploymentPackageRequestMultiError, or nil if none found.
+func (m *CreateDeploymentPackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDeploymentPackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDeploymentPackageRequestValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDeploymentPackageRequestValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDeploymentPackageRequestValidationError{
+				field:  "DeploymentPackage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDeploymentPackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateDeploymentPackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDeploymentPackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+
+// CreateDeploymentPackageRequestValidationError is the validation error
+// returned by CreateDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type CreateDeploymentPackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDeploymentPackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDeploymentPackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDeploymentPackageRequestValidationError) ErrorName() string {
+	return "CreateDeploymentPackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDeploymentPackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDeploymentPackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDeploymentPackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDeploymentPackageRequestValidationError{}
+
+// Validate checks the field values on CreateDeploymentPackageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDeploymentPackageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateDeploymentPackageResponseMultiError, or nil if none found.
+func (m *CreateDeploymentPackageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDeploymentPackageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDeploymentPackageResponseValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDeploymentPackageResponseValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDeploymentPackageResponseValidationError{
+				field:  "DeploymentPackage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDeploymentPackageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDeploymentPackageResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDeploymentPackageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateDeploymentPackageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDeploymentPackageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+
+// CreateDeploymentPackageResponseValidationError is the validation error
+// returned by CreateDeploymentPackageResponse.Validate if the designated
+// constraints aren't met.
+type CreateDeploymentPackageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDeploymentPackageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDeploymentPackageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDeploymentPackageResponseValidationError) ErrorName() string {
+	return "CreateDeploymentPackageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDeploymentPackageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDeploymentPackageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDeploymentPackageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDeploymentPackageResponseValidationError{}
+
+// Validate checks the field values on ListDeploymentPackagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeploymentPackagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeploymentPackagesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDeploymentPackagesRequestMultiError, or nil if none found.
+func (m *ListDeploymentPackagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeploymentPackagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	// no validation rules for PageToken
+
+	// no validation rules for SkipTotal
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for ExcludeDeprecated
+
+	if len(errors) > 0 {
+		return ListDeploymentPackagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentPackagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeploymentPackagesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListDeploymentPackagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentPackagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentPackagesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeploymentPackagesRequestValidationError is the validation error
+// returned by ListDeploymentPackagesRequest.Validate if the designated
+// constraints aren't met.
+type ListDeploymentPackagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentPackagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentPackagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentPackagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentPackagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentPackagesRequestValidationError) ErrorName() string {
+	return "ListDeploymentPackagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentPackagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentPackagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentPackagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentPackagesRequestValidationError{}
+
+// Validate checks the field values on ListDeploymentPackagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeploymentPackagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeploymentPackagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDeploymentPackagesResponseMultiError, or nil if none found.
+func (m *ListDeploymentPackagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeploymentPackagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeploymentPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeploymentPackagesResponseValidationError{
+						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeploymentPackagesResponseValidationError{
+						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeploymentPackagesResponseValidationError{
+					field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeploymentPackagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentPackagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeploymentPackagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListDeploymentPackagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentPackagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentPackagesResponseMultiError) AllErrors() []error { return m }
+
+// ListDeploymentPackagesResponseValidationError is the validation error
+// returned by ListDeploymentPackagesResponse.Validate if the designated
+// constraints aren't met.
+type ListDeploymentPackagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentPackagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentPackagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentPackagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentPackagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentPackagesResponseValidationError) ErrorName() string {
+	return "ListDeploymentPackagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentPackagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentPackagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentPackagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentPackagesResponseValidationError{}
+
+// Validate checks the field values on GetDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentPackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentPackageRequestMultiError, or nil if none found.
+func (m *GetDeploymentPackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentPackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentPackageName
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetDeploymentPackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDeploymentPackageRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDeploymentPackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentPackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+
+// GetDeploymentPackageRequestValidationError is the validation error returned
+// by GetDeploymentPackageRequest.Validate if the designated constraints
+// aren't met.
+type GetDeploymentPackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentPackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentPackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentPackageRequestValidationError) ErrorName() string {
+	return "GetDeploymentPackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentPackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentPackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentPackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentPackageRequestValidationError{}
+
+// Validate checks the field values on GetDeploymentPackageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentPackageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentPackageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentPackageResponseMultiError, or nil if none found.
+func (m *GetDeploymentPackageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentPackageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeploymentPackageResponseValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeploymentPackageResponseValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeploymentPackageResponseValidationError{
+				field:  "DeploymentPackage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentPackageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentPackageResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDeploymentPackageResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDeploymentPackageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentPackageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+
+// GetDeploymentPackageResponseValidationError is the validation error returned
+// by GetDeploymentPackageResponse.Validate if the designated constraints
+// aren't met.
+type GetDeploymentPackageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentPackageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentPackageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentPackageResponseValidationError) ErrorName() string {
+	return "GetDeploymentPackageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentPackageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentPackageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentPackageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentPackageResponseValidationError{}
+
+// Validate checks the field values on GetDeploymentPackageVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDeploymentPackageVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentPackageVersionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDeploymentPackageVersionsRequestMultiError, or nil if none found.
+func (m *GetDeploymentPackageVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentPackageVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentPackageName
+
+	if len(errors) > 0 {
+		return GetDeploymentPackageVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentPackageVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDeploymentPackageVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeploymentPackageVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentPackageVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentPackageVersionsRequestMultiError) AllErrors() []error { return m }
+
+// GetDeploymentPackageVersionsRequestValidationError is the validation error
+// returned by GetDeploymentPackageVersionsRequest.Validate if the designated
+// constraints aren't met.
+type GetDeploymentPackageVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentPackageVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentPackageVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentPackageVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentPackageVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentPackageVersionsRequestValidationError) ErrorName() string {
+	return "GetDeploymentPackageVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentPackageVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentPackageVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentPackageVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentPackageVersionsRequestValidationError{}
+
+// Validate checks the field values on GetDeploymentPackageVersionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetDeploymentPackageVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentPackageVersionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDeploymentPackageVersionsResponseMultiError, or nil if none found.
+func (m *GetDeploymentPackageVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentPackageVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeploymentPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDeploymentPackageVersionsResponseValidationError{
+						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDeploymentPackageVersionsResponseValidationError{
+						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDeploymentPackageVersionsResponseValidationError{
+					field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentPackageVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentPackageVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDeploymentPackageVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeploymentPackageVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentPackageVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentPackageVersionsResponseMultiError) AllErrors() []error { return m }
+
+// GetDeploymentPackageVersionsResponseValidationError is the validation error
+// returned by GetDeploymentPackageVersionsResponse.Validate if the designated
+// constraints aren't met.
+type GetDeploymentPackageVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentPackageVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentPackageVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentPackageVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentPackageVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentPackageVersionsResponseValidationError) ErrorName() string {
+	return "GetDeploymentPackageVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentPackageVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentPackageVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentPackageVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentPackageVersionsResponseValidationError{}
+
+// Validate checks the field values on ResolveDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveDeploymentPackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResolveDeploymentPackageRequestMultiError, or nil if none found.
+func (m *ResolveDeploymentPackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveDeploymentPackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentPackageName
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return ResolveDeploymentPackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by ResolveDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ResolveDeploymentPackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveDeploymentPackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+
+// ResolveDeploymentPackageRequestValidationError is the validation error
+// returned by ResolveDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type ResolveDeploymentPackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveDeploymentPackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveDeploymentPackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveDeploymentPackageRequestValidationError) ErrorName() string {
+	return "ResolveDeploymentPackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveDeploymentPackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveDeploymentPackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveDeploymentPackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveDeploymentPackageRequestValidationError{}
+
+// Validate checks the field values on ResolveDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ResolveDeploymentPackageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResolveDeploymentPackageResponseMultiError, or nil if none found.
+func (m *ResolveDeploymentPackageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveDeploymentPackageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApplicationReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResolveDeploymentPackageResponseValidationError{
+						field:  fmt.Sprintf("ApplicationReferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResolveDeploymentPackageResponseValidationError{
+						field:  fmt.Sprintf("ApplicationReferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResolveDeploymentPackageResponseValidationError{
+					field:  fmt.Sprintf("ApplicationReferences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDeploymentRequirements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResolveDeploymentPackageResponseValidationError{
+						field:  fmt.Sprintf("DeploymentRequirements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResolveDeploymentPackageResponseValidationError{
+						field:  fmt.Sprintf("DeploymentRequirements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResolveDeploymentPackageResponseValidationError{
+					field:  fmt.Sprintf("DeploymentRequirements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Pinned
+
+	if len(errors) > 0 {
+		return ResolveDeploymentPackageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveDeploymentPackageResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ResolveDeploymentPackageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveDeploymentPackageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveDeploymentPackageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+
+// ResolveDeploymentPackageResponseValidationError is the validation error
+// returned by ResolveDeploymentPackageResponse.Validate if the designated
+// constraints aren't met.
+type ResolveDeploymentPackageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveDeploymentPackageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveDeploymentPackageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveDeploymentPackageResponseValidationError) ErrorName() string {
+	return "ResolveDeploymentPackageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveDeploymentPackageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveDeploymentPackageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveDeploymentPackageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveDeploymentPackageResponseValidationError{}
+
+// Validate checks the field values on ResolvedDeploymentRequirement with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolvedDeploymentRequirement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolvedDeploymentRequirement with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResolvedDeploymentRequirementMultiError, or nil if none found.
+func (m *ResolvedDeploymentRequirement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolvedDeploymentRequirement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for ProfileName
+
+	if all {
+		switch v := interface{}(m.GetDeploymentRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolvedDeploymentRequirementValidationError{
+					field:  "DeploymentRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolvedDeploymentRequirementValidationError{
+					field:  "DeploymentRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolvedDeploymentRequirementValidationError{
+				field:  "DeploymentRequirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResolvedDeploymentRequirementMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolvedDeploymentRequirementMultiError is an error wrapping multiple
+// validation errors returned by ResolvedDeploymentRequirement.ValidateAll()
+// if the designated constraints aren't met.
+type ResolvedDeploymentRequirementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolvedDeploymentRequirementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolvedDeploymentRequirementMultiError) AllErrors() []error { return m }
+
+// ResolvedDeploymentRequirementValidationError is the validation error
+// returned by ResolvedDeploymentRequirement.Validate if the designated
+// constraints aren't met.
+type ResolvedDeploymentRequirementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolvedDeploymentRequirementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolvedDeploymentRequirementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolvedDeploymentRequirementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolvedDeploymentRequirementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolvedDeploymentRequirementValidationError) ErrorName() string {
+	return "ResolvedDeploymentRequirementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolvedDeploymentRequirementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolvedDeploymentRequirement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolvedDeploymentRequirementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolvedDeploymentRequirementValidationError{}
+
+// Validate checks the field values on ExportDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportDeploymentPackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportDeploymentPackageRequestMultiError, or nil if none found.
+func (m *ExportDeploymentPackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportDeploymentPackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentPackageName
+
+	// no validation rules for Version
+
+	// no validation rules for ShowSensitiveInfo
+
+	if len(errors) > 0 {
+		return ExportDeploymentPackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ExportDeploymentPackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportDeploymentPackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+
+// ExportDeploymentPackageRequestValidationError is the validation error
+// returned by ExportDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type ExportDeploymentPackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportDeploymentPackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportDeploymentPackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportDeploymentPackageRequestValidationError) ErrorName() string {
+	return "ExportDeploymentPackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportDeploymentPackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportDeploymentPackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportDeploymentPackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportDeploymentPackageRequestValidationError{}
+
+// Validate checks the field values on ExportDeploymentPackageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportDeploymentPackageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportDeploymentPackageResponseMultiError, or nil if none found.
+func (m *ExportDeploymentPackageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportDeploymentPackageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for Contents
+
+	if len(errors) > 0 {
+		return ExportDeploymentPackageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportDeploymentPackageResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportDeploymentPackageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ExportDeploymentPackageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportDeploymentPackageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+
+// ExportDeploymentPackageResponseValidationError is the validation error
+// returned by ExportDeploymentPackageResponse.Validate if the designated
+// constraints aren't met.
+type ExportDeploymentPackageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportDeploymentPackageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportDeploymentPackageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportDeploymentPackageResponseValidationError) ErrorName() string {
+	return "ExportDeploymentPackageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportDeploymentPackageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportDeploymentPackageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportDeploymentPackageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportDeploymentPackageResponseValidationError{}
+
+// Validate checks the field values on UpdateDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDeploymentPackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateDeploymentPackageRequestMultiError, or nil if none found.
+func (m *UpdateDeploymentPackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDeploymentPackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentPackageName
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeploymentPackageRequestValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeploymentPackageRequestValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeploymentPackageRequestValidationError{
+				field:  "DeploymentPackage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeploymentPackageRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeploymentPackageRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeploymentPackageRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDeploymentPackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateDeploymentPackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDeploymentPackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDeploymentPackageRequestValidationError is the validation error
+// returned by UpdateDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type UpdateDeploymentPackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDeploymentPackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDeploymentPackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDeploymentPackageRequestValidationError) ErrorName() string {
+	return "UpdateDeploymentPackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDeploymentPackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDeploymentPackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDeploymentPackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDeploymentPackageRequestValidationError{}
+
+// Validate checks the field values on UpdateDeploymentPackageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDeploymentPackageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateDeploymentPackageResponseMultiError, or nil if none found.
+func (m *UpdateDeploymentPackageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDeploymentPackageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateDeploymentPackageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDeploymentPackageResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDeploymentPackageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateDeploymentPackageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDeploymentPackageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDeploymentPackageResponseValidationError is the validation error
+// returned by UpdateDeploymentPackageResponse.Validate if the designated
+// constraints aren't met.
+type UpdateDeploymentPackageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDeploymentPackageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDeploymentPackageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDeploymentPackageResponseValidationError) ErrorName() string {
+	return "UpdateDeploymentPackageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDeploymentPackageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDeploymentPackageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDeploymentPackageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDeploymentPackageResponseValidationError{}
+
+// Validate checks the field values on DeleteDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDeploymentPackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteDeploymentPackageRequestMultiError, or nil if none found.
+func (m *DeleteDeploymentPackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDeploymentPackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentPackageName
+
+	// no validation rules for Version
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return DeleteDeploymentPackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteDeploymentPackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDeploymentPackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDeploymentPackageRequestValidationError is the validation error
+// returned by DeleteDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type DeleteDeploymentPackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDeploymentPackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDeploymentPackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDeploymentPackageRequestValidationError) ErrorName() string {
+	return "DeleteDeploymentPackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDeploymentPackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDeploymentPackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDeploymentPackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDeploymentPackageRequestValidationError{}
+
+// Validate checks the field values on WatchDeploymentPackagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDeploymentPackagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchDeploymentPackagesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WatchDeploymentPackagesRequestMultiError, or nil if none found.
+func (m *WatchDeploymentPackagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchDeploymentPackagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for NoReplay
+
+	// no validation rules for ResumeFromRevision
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return WatchDeploymentPackagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchDeploymentPackagesRequestMultiError is an error wrapping multiple
+// validation errors returned by WatchDeploymentPackagesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type WatchDeploymentPackagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchDeploymentPackagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchDeploymentPackagesRequestMultiError) AllErrors() []error { return m }
+
+// WatchDeploymentPackagesRequestValidationError is the validation error
+// returned by WatchDeploymentPackagesRequest.Validate if the designated
+// constraints aren't met.
+type WatchDeploymentPackagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchDeploymentPackagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchDeploymentPackagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchDeploymentPackagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchDeploymentPackagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchDeploymentPackagesRequestValidationError) ErrorName() string {
+	return "WatchDeploymentPackagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchDeploymentPackagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchDeploymentPackagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchDeploymentPackagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchDeploymentPackagesRequestValidationError{}
+
+// Validate checks the field values on WatchDeploymentPackagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDeploymentPackagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchDeploymentPackagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WatchDeploymentPackagesResponseMultiError, or nil if none found.
+func (m *WatchDeploymentPackagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchDeploymentPackagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDeploymentPackagesResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
+					field:  "DeploymentPackage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDeploymentPackagesResponseValidationError{
+				field:  "DeploymentPackage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchDeploymentPackagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchDeploymentPackagesResponseMultiError is an error wrapping multiple
+// validation errors returned by WatchDeploymentPackagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type WatchDeploymentPackagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchDeploymentPackagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchDeploymentPackagesResponseMultiError) AllErrors() []error { return m }
+
+// WatchDeploymentPackagesResponseValidationError is the validation error
+// returned by WatchDeploymentPackagesResponse.Validate if the designated
+// constraints aren't met.
+type WatchDeploymentPackagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchDeploymentPackagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchDeploymentPackagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchDeploymentPackagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchDeploymentPackagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchDeploymentPackagesResponseValidationError) ErrorName() string {
+	return "WatchDeploymentPackagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchDeploymentPackagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchDeploymentPackagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchDeploymentPackagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchDeploymentPackagesResponseValidationError{}
+
+// Validate checks the field values on CreateApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApplicationRequestMultiError, or nil if none found.
+func (m *CreateApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApplicationRequestValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApplicationRequestValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApplicationRequestValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApplicationRequestMultiError) AllErrors() []error { return m }
+
+// CreateApplicationRequestValidationError is the validation error returned by
+// CreateApplicationRequest.Validate if the designated constraints aren't met.
+type CreateApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApplicationRequestValidationError) ErrorName() string {
+	return "CreateApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApplicationRequestValidationError{}
+
+// Validate checks the field values on CreateApplicationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApplicationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApplicationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApplicationResponseMultiError, or nil if none found.
+func (m *CreateApplicationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApplicationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApplicationResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApplicationResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApplicationResponseValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateApplicationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApplicationResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateApplicationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateApplicationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApplicationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApplicationResponseMultiError) AllErrors() []error { return m }
+
+// CreateApplicationResponseValidationError is the validation error returned by
+// CreateApplicationResponse.Validate if the designated constraints aren't met.
+type CreateApplicationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApplicationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApplicationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApplicationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApplicationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApplicationResponseValidationError) ErrorName() string {
+	return "CreateApplicationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApplicationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApplicationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApplicationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApplicationResponseValidationError{}
+
+// Validate checks the field values on ListApplicationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApplicationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApplicationsRequestMultiError, or nil if none found.
+func (m *ListApplicationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	// no validation rules for PageToken
+
+	// no validation rules for SkipTotal
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for ExcludeDeprecated
+
+	if len(errors) > 0 {
+		return ListApplicationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListApplicationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationsRequestMultiError) AllErrors() []error { return m }
+
+// ListApplicationsRequestValidationError is the validation error returned by
+// ListApplicationsRequest.Validate if the designated constraints aren't met.
+type ListApplicationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationsRequestValidationError) ErrorName() string {
+	return "ListApplicationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationsRequestValidationError{}
+
+// Validate checks the field values on ListApplicationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApplicationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApplicationsResponseMultiError, or nil if none found.
+func (m *ListApplicationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApplications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListApplicationsResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListApplicationsResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListApplicationsResponseValidationError{
+					field:  fmt.Sprintf("Applications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListApplicationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListApplicationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationsResponseMultiError) AllErrors() []error { return m }
+
+// ListApplicationsResponseValidationError is the validation error returned by
+// ListApplicationsResponse.Validate if the designated constraints aren't met.
+type ListApplicationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationsResponseValidationError) ErrorName() string {
+	return "ListApplicationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationsResponseValidationError{}
+
+// Validate checks the field values on GetApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetApplicationRequestMultiError, or nil if none found.
+func (m *GetApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApplicationRequestMultiError) AllErrors() []error { return m }
+
+// GetApplicationRequestValidationError is the validation error returned by
+// GetApplicationRequest.Validate if the designated constraints aren't met.
+type GetApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationRequestValidationError) ErrorName() string {
+	return "GetApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationRequestValidationError{}
+
+// Validate checks the field values on GetApplicationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApplicationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApplicationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetApplicationResponseMultiError, or nil if none found.
+func (m *GetApplicationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApplicationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetApplicationResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetApplicationResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetApplicationResponseValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetApplicationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApplicationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetApplicationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetApplicationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApplicationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApplicationResponseMultiError) AllErrors() []error { return m }
+
+// GetApplicationResponseValidationError is the validation error returned by
+// GetApplicationResponse.Validate if the designated constraints aren't met.
+type GetApplicationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationResponseValidationError) ErrorName() string {
+	return "GetApplicationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationResponseValidationError{}
+
+// Validate checks the field values on GetApplicationReferenceCountRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetApplicationReferenceCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApplicationReferenceCountRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetApplicationReferenceCountRequestMultiError, or nil if none found.
+func (m *GetApplicationReferenceCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApplicationReferenceCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetApplicationReferenceCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApplicationReferenceCountRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetApplicationReferenceCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetApplicationReferenceCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApplicationReferenceCountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApplicationReferenceCountRequestMultiError) AllErrors() []error { return m }
+
+// GetApplicationReferenceCountRequestValidationError is the validation error
+// returned by GetApplicationReferenceCountRequest.Validate if the designated
+// constraints aren't met.
+type GetApplicationReferenceCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationReferenceCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationReferenceCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationReferenceCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationReferenceCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationReferenceCountRequestValidationError) ErrorName() string {
+	return "GetApplicationReferenceCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationReferenceCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationReferenceCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationReferenceCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationReferenceCountRequestValidationError{}
+
+// Validate checks the field values on GetApplicationReferenceCountResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetApplicationReferenceCountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApplicationReferenceCountResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetApplicationReferenceCountResponseMultiError, or nil if none found.
+func (m *GetApplicationReferenceCountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApplicationReferenceCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReferenceCount
+
+	if len(errors) > 0 {
+		return GetApplicationReferenceCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApplicationReferenceCountResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetApplicationReferenceCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetApplicationReferenceCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApplicationReferenceCountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApplicationReferenceCountResponseMultiError) AllErrors() []error { return m }
+
+// GetApplicationReferenceCountResponseValidationError is the validation error
+// returned by GetApplicationReferenceCountResponse.Validate if the designated
+// constraints aren't met.
+type GetApplicationReferenceCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationReferenceCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationReferenceCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationReferenceCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationReferenceCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationReferenceCountResponseValidationError) ErrorName() string {
+	return "GetApplicationReferenceCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationReferenceCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationReferenceCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationReferenceCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationReferenceCountResponseValidationError{}
+
+// Validate checks the field values on GetApplicationVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApplicationVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApplicationVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetApplicationVersionsRequestMultiError, or nil if none found.
+func (m *GetApplicationVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApplicationVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	if len(errors) > 0 {
+		return GetApplicationVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApplicationVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetApplicationVersionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetApplicationVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApplicationVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApplicationVersionsRequestMultiError) AllErrors() []error { return m }
+
+// GetApplicationVersionsRequestValidationError is the validation error
+// returned by GetApplicationVersionsRequest.Validate if the designated
+// constraints aren't met.
+type GetApplicationVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationVersionsRequestValidationError) ErrorName() string {
+	return "GetApplicationVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationVersionsRequestValidationError{}
+
+// Validate checks the field values on GetApplicationVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetApplicationVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetApplicationVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetApplicationVersionsResponseMultiError, or nil if none found.
+func (m *GetApplicationVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetApplicationVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApplication() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetApplicationVersionsResponseValidationError{
+						field:  fmt.Sprintf("Application[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetApplicationVersionsResponseValidationError{
+						field:  fmt.Sprintf("Application[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetApplicationVersionsResponseValidationError{
+					field:  fmt.Sprintf("Application[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetApplicationVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetApplicationVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetApplicationVersionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetApplicationVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetApplicationVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetApplicationVersionsResponseMultiError) AllErrors() []error { return m }
+
+// GetApplicationVersionsResponseValidationError is the validation error
+// returned by GetApplicationVersionsResponse.Validate if the designated
+// constraints aren't met.
+type GetApplicationVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationVersionsResponseValidationError) ErrorName() string {
+	return "GetApplicationVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationVersionsResponseValidationError{}
+
+// Validate checks the field values on UpdateApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateApplicationRequestMultiError, or nil if none found.
+func (m *UpdateApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateApplicationRequestValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateApplicationRequestValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateApplicationRequestValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateApplicationRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateApplicationRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateApplicationRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateApplicationRequestMultiError) AllErrors() []error { return m }
+
+// UpdateApplicationRequestValidationError is the validation error returned by
+// UpdateApplicationRequest.Validate if the designated constraints aren't met.
+type UpdateApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateApplicationRequestValidationError) ErrorName() string {
+	return "UpdateApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateApplicationRequestValidationError{}
+
+// Validate checks the field values on DeleteApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteApplicationRequestMultiError, or nil if none found.
+func (m *DeleteApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Version
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return DeleteApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteApplicationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteApplicationRequestValidationError is the validation error returned by
+// DeleteApplicationRequest.Validate if the designated constraints aren't met.
+type DeleteApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteApplicationRequestValidationError) ErrorName() string {
+	return "DeleteApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteApplicationRequestValidationError{}
+
+// Validate checks the field values on WatchApplicationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchApplicationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchApplicationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchApplicationsRequestMultiError, or nil if none found.
+func (m *WatchApplicationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchApplicationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for NoReplay
+
+	// no validation rules for ResumeFromRevision
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return WatchApplicationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchApplicationsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchApplicationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchApplicationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchApplicationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchApplicationsRequestMultiError) AllErrors() []error { return m }
+
+// WatchApplicationsRequestValidationError is the validation error returned by
+// WatchApplicationsRequest.Validate if the designated constraints aren't met.
+type WatchApplicationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchApplicationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchApplicationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchApplicationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchApplicationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchApplicationsRequestValidationError) ErrorName() string {
+	return "WatchApplicationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchApplicationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchApplicationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchApplicationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchApplicationsRequestValidationError{}
+
+// Validate checks the field values on WatchApplicationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchApplicationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchApplicationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchApplicationsResponseMultiError, or nil if none found.
+func (m *WatchApplicationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchApplicationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchApplicationsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchApplicationsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchApplicationsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchApplicationsResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchApplicationsResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchApplicationsResponseValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchApplicationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchApplicationsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchApplicationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type WatchApplicationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchApplicationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchApplicationsResponseMultiError) AllErrors() []error { return m }
+
+// WatchApplicationsResponseValidationError is the validation error returned by
+// WatchApplicationsResponse.Validate if the designated constraints aren't met.
+type WatchApplicationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchApplicationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchApplicationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchApplicationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchApplicationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchApplicationsResponseValidationError) ErrorName() string {
+	return "WatchApplicationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchApplicationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchApplicationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchApplicationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchApplicationsResponseValidationError{}
+
+// Validate checks the field values on CreateArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateArtifactRequestMultiError, or nil if none found.
+func (m *CreateArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateArtifactRequestValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateArtifactRequestValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateArtifactRequestValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateArtifactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateArtifactRequestMultiError) AllErrors() []error { return m }
+
+// CreateArtifactRequestValidationError is the validation error returned by
+// CreateArtifactRequest.Validate if the designated constraints aren't met.
+type CreateArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateArtifactRequestValidationError) ErrorName() string {
+	return "CreateArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateArtifactRequestValidationError{}
+
+// Validate checks the field values on CreateArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateArtifactResponseMultiError, or nil if none found.
+func (m *CreateArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateArtifactResponseValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateArtifactResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateArtifactResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateArtifactResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateArtifactResponseMultiError) AllErrors() []error { return m }
+
+// CreateArtifactResponseValidationError is the validation error returned by
+// CreateArtifactResponse.Validate if the designated constraints aren't met.
+type CreateArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateArtifactResponseValidationError) ErrorName() string {
+	return "CreateArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateArtifactResponseValidationError{}
+
+// Validate checks the field values on ListArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArtifactsRequestMultiError, or nil if none found.
+func (m *ListArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Filter
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	// no validation rules for PageToken
+
+	// no validation rules for SkipTotal
+
+	if len(errors) > 0 {
+		return ListArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListArtifactsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArtifactsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// ListArtifactsRequestValidationError is the validation error returned by
+// ListArtifactsRequest.Validate if the designated constraints aren't met.
+type ListArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArtifactsRequestValidationError) ErrorName() string {
+	return "ListArtifactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArtifactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArtifactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArtifactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArtifactsRequestValidationError{}
+
+// Validate checks the field values on ListArtifactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArtifactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArtifactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArtifactsResponseMultiError, or nil if none found.
+func (m *ListArtifactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArtifactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListArtifactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArtifactsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListArtifactsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListArtifactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArtifactsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArtifactsResponseMultiError) AllErrors() []error { return m }
+
+// ListArtifactsResponseValidationError is the validation error returned by
+// ListArtifactsResponse.Validate if the designated constraints aren't met.
+type ListArtifactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArtifactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArtifactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArtifactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArtifactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArtifactsResponseValidationError) ErrorName() string {
+	return "ListArtifactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArtifactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArtifactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArtifactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArtifactsResponseValidationError{}
+
+// Validate checks the field values on GetArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArtifactRequestMultiError, or nil if none found.
+func (m *GetArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArtifactName
+
+	if len(errors) > 0 {
+		return GetArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArtifactRequestMultiError is an error wrapping multiple validation errors
+// returned by GetArtifactRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArtifactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArtifactRequestMultiError) AllErrors() []error { return m }
+
+// GetArtifactRequestValidationError is the validation error returned by
+// GetArtifactRequest.Validate if the designated constraints aren't met.
+type GetArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArtifactRequestValidationError) ErrorName() string {
+	return "GetArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArtifactRequestValidationError{}
+
+// Validate checks the field values on GetArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArtifactResponseMultiError, or nil if none found.
+func (m *GetArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArtifactResponseValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArtifactResponseMultiError is an error wrapping multiple validation
+// errors returned by GetArtifactResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArtifactResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArtifactResponseMultiError) AllErrors() []error { return m }
+
+// GetArtifactResponseValidationError is the validation error returned by
+// GetArtifactResponse.Validate if the designated constraints aren't met.
+type GetArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArtifactResponseValidationError) ErrorName() string {
+	return "GetArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArtifactResponseValidationError{}
+
+// Validate checks the field values on UpdateArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateArtifactRequestMultiError, or nil if none found.
+func (m *UpdateArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArtifactName
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateArtifactRequestValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateArtifactRequestValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateArtifactRequestValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateArtifactRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateArtifactRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateArtifactRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateArtifactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateArtifactRequestMultiError) AllErrors() []error { return m }
+
+// UpdateArtifactRequestValidationError is the validation error returned by
+// UpdateArtifactRequest.Validate if the designated constraints aren't met.
+type UpdateArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateArtifactRequestValidationError) ErrorName() string {
+	return "UpdateArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateArtifactRequestValidationError{}
+
+// Validate checks the field values on DeleteArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteArtifactRequestMultiError, or nil if none found.
+func (m *DeleteArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArtifactName
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return DeleteArtifactRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchRegistriesResponseMultiError is an error wrapping multiple validation
-// errors returned by WatchRegistriesResponse.ValidateAll() if the designated
+// DeleteArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteArtifactRequest.ValidateAll() if the designated
 // constraints aren't met.
-type WatchRegistriesResponseMultiError []error
+type DeleteArtifactRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchRegistriesResponseMultiError) Error() string {
+func (m DeleteArtifactRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1615,11 +8281,11 @@ func (m WatchRegistriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchRegistriesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteArtifactRequestMultiError) AllErrors() []error { return m }
 
-// WatchRegistriesResponseValidationError is the validation error returned by
-// WatchRegistriesResponse.Validate if the designated constraints aren't met.
-type WatchRegistriesResponseValidationError struct {
+// DeleteArtifactRequestValidationError is the validation error returned by
+// DeleteArtifactRequest.Validate if the designated constraints aren't met.
+type DeleteArtifactRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1627,24 +8293,24 @@ type WatchRegistriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchRegistriesResponseValidationError) Field() string { return e.field }
+func (e DeleteArtifactRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchRegistriesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteArtifactRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchRegistriesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteArtifactRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchRegistriesResponseValidationError) Key() bool { return e.key }
+func (e DeleteArtifactRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchRegistriesResponseValidationError) ErrorName() string {
-	return "WatchRegistriesResponseValidationError"
+func (e DeleteArtifactRequestValidationError) ErrorName() string {
+	return "DeleteArtifactRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchRegistriesResponseValidationError) Error() string {
+func (e DeleteArtifactRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1656,14 +8322,14 @@ func (e WatchRegistriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchRegistriesResponse.%s: %s%s",
+		"invalid %sDeleteArtifactRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchRegistriesResponseValidationError{}
+var _ error = DeleteArtifactRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1671,73 +8337,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchRegistriesResponseValidationError{}
+} = DeleteArtifactRequestValidationError{}
 
-// Validate checks the field values on CreateDeploymentPackageRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WatchArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDeploymentPackageRequest) Validate() error {
+func (m *WatchArtifactsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDeploymentPackageRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateDeploymentPackageRequestMultiError, or nil if none found.
-func (m *CreateDeploymentPackageRequest) ValidateAll() error {
+// ValidateAll checks the field values on WatchArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchArtifactsRequestMultiError, or nil if none found.
+func (m *WatchArtifactsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDeploymentPackageRequest) validate(all bool) error {
+func (m *WatchArtifactsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDeploymentPackage()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDeploymentPackageRequestValidationError{
-					field:  "DeploymentPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDeploymentPackageRequestValidationError{
-					field:  "DeploymentPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateDeploymentPackageRequestValidationError{
-				field:  "DeploymentPackage",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ProjectId
+
+	// no validation rules for NoReplay
+
+	// no validation rules for ResumeFromRevision
 
 	if len(errors) > 0 {
-		return CreateDeploymentPackageRequestMultiError(errors)
+		return WatchArtifactsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDeploymentPackageRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateDeploymentPackageRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CreateDeploymentPackageRequestMultiError []error
+// WatchArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchArtifactsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchArtifactsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDeploymentPackageRequestMultiError) Error() string {
+func (m WatchArtifactsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1746,12 +8389,11 @@ func (m CreateDeploymentPackageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+func (m WatchArtifactsRequestMultiError) AllErrors() []error { return m }
 
-// CreateDeploymentPackageRequestValidationError is the validation error
-// returned by CreateDeploymentPackageRequest.Validate if the designated
-// constraints aren't met.
-type CreateDeploymentPackageRequestValidationError struct {
+// WatchArtifactsRequestValidationError is the validation error returned by
+// WatchArtifactsRequest.Validate if the designated constraints aren't met.
+type WatchArtifactsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1759,24 +8401,24 @@ type CreateDeploymentPackageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDeploymentPackageRequestValidationError) Field() string { return e.field }
+func (e WatchArtifactsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+func (e WatchArtifactsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+func (e WatchArtifactsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDeploymentPackageRequestValidationError) Key() bool { return e.key }
+func (e WatchArtifactsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDeploymentPackageRequestValidationError) ErrorName() string {
-	return "CreateDeploymentPackageRequestValidationError"
+func (e WatchArtifactsRequestValidationError) ErrorName() string {
+	return "WatchArtifactsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDeploymentPackageRequestValidationError) Error() string {
+func (e WatchArtifactsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1788,14 +8430,14 @@ func (e CreateDeploymentPackageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDeploymentPackageRequest.%s: %s%s",
+		"invalid %sWatchArtifactsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDeploymentPackageRequestValidationError{}
+var _ error = WatchArtifactsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1803,24 +8445,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDeploymentPackageRequestValidationError{}
+} = WatchArtifactsRequestValidationError{}
 
-// Validate checks the field values on CreateDeploymentPackageResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WatchArtifactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDeploymentPackageResponse) Validate() error {
+func (m *WatchArtifactsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDeploymentPackageResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateDeploymentPackageResponseMultiError, or nil if none found.
-func (m *CreateDeploymentPackageResponse) ValidateAll() error {
+// ValidateAll checks the field values on WatchArtifactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchArtifactsResponseMultiError, or nil if none found.
+func (m *WatchArtifactsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDeploymentPackageResponse) validate(all bool) error {
+func (m *WatchArtifactsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1828,28 +8470,57 @@ func (m *CreateDeploymentPackageResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		switch v := interface{}(m.GetEvent()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDeploymentPackageResponseValidationError{
-					field:  "DeploymentPackage",
+				errors = append(errors, WatchArtifactsResponseValidationError{
+					field:  "Event",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDeploymentPackageResponseValidationError{
-					field:  "DeploymentPackage",
+				errors = append(errors, WatchArtifactsResponseValidationError{
+					field:  "Event",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateDeploymentPackageResponseValidationError{
-				field:  "DeploymentPackage",
+			return WatchArtifactsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchArtifactsResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchArtifactsResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchArtifactsResponseValidationError{
+				field:  "Artifact",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1857,19 +8528,19 @@ func (m *CreateDeploymentPackageResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateDeploymentPackageResponseMultiError(errors)
+		return WatchArtifactsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDeploymentPackageResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateDeploymentPackageResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CreateDeploymentPackageResponseMultiError []error
+// WatchArtifactsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchArtifactsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchArtifactsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDeploymentPackageResponseMultiError) Error() string {
+func (m WatchArtifactsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1878,12 +8549,11 @@ func (m CreateDeploymentPackageResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+func (m WatchArtifactsResponseMultiError) AllErrors() []error { return m }
 
-// CreateDeploymentPackageResponseValidationError is the validation error
-// returned by CreateDeploymentPackageResponse.Validate if the designated
-// constraints aren't met.
-type CreateDeploymentPackageResponseValidationError struct {
+// WatchArtifactsResponseValidationError is the validation error returned by
+// WatchArtifactsResponse.Validate if the designated constraints aren't met.
+type WatchArtifactsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1891,24 +8561,24 @@ type CreateDeploymentPackageResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDeploymentPackageResponseValidationError) Field() string { return e.field }
+func (e WatchArtifactsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+func (e WatchArtifactsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+func (e WatchArtifactsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDeploymentPackageResponseValidationError) Key() bool { return e.key }
+func (e WatchArtifactsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDeploymentPackageResponseValidationError) ErrorName() string {
-	return "CreateDeploymentPackageResponseValidationError"
+func (e WatchArtifactsResponseValidationError) ErrorName() string {
+	return "WatchArtifactsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDeploymentPackageResponseValidationError) Error() string {
+func (e WatchArtifactsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1920,14 +8590,14 @@ func (e CreateDeploymentPackageResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDeploymentPackageResponse.%s: %s%s",
+		"invalid %sWatchArtifactsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDeploymentPackageResponseValidationError{}
+var _ error = WatchArtifactsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1935,52 +8605,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDeploymentPackageResponseValidationError{}
+} = WatchArtifactsResponseValidationError{}
 
-// Validate checks the field values on ListDeploymentPackagesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentPackagesRequest) Validate() error {
+func (m *CreateWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentPackagesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListDeploymentPackagesRequestMultiError, or nil if none found.
-func (m *ListDeploymentPackagesRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentPackagesRequest) validate(all bool) error {
+func (m *CreateWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderBy
-
-	// no validation rules for Filter
-
-	// no validation rules for PageSize
-
-	// no validation rules for Offset
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookRequestValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookRequestValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookRequestValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListDeploymentPackagesRequestMultiError(errors)
+		return CreateWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentPackagesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListDeploymentPackagesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListDeploymentPackagesRequestMultiError []error
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentPackagesRequestMultiError) Error() string {
+func (m CreateWebhookRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1989,12 +8680,11 @@ func (m ListDeploymentPackagesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentPackagesRequestMultiError) AllErrors() []error { return m }
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
 
-// ListDeploymentPackagesRequestValidationError is the validation error
-// returned by ListDeploymentPackagesRequest.Validate if the designated
-// constraints aren't met.
-type ListDeploymentPackagesRequestValidationError struct {
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2002,24 +8692,24 @@ type ListDeploymentPackagesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentPackagesRequestValidationError) Field() string { return e.field }
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentPackagesRequestValidationError) Reason() string { return e.reason }
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentPackagesRequestValidationError) Cause() error { return e.cause }
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentPackagesRequestValidationError) Key() bool { return e.key }
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentPackagesRequestValidationError) ErrorName() string {
-	return "ListDeploymentPackagesRequestValidationError"
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentPackagesRequestValidationError) Error() string {
+func (e CreateWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2031,14 +8721,14 @@ func (e ListDeploymentPackagesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentPackagesRequest.%s: %s%s",
+		"invalid %sCreateWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentPackagesRequestValidationError{}
+var _ error = CreateWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2046,80 +8736,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentPackagesRequestValidationError{}
+} = CreateWebhookRequestValidationError{}
 
-// Validate checks the field values on ListDeploymentPackagesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentPackagesResponse) Validate() error {
+func (m *CreateWebhookResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentPackagesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListDeploymentPackagesResponseMultiError, or nil if none found.
-func (m *ListDeploymentPackagesResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookResponseMultiError, or nil if none found.
+func (m *CreateWebhookResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentPackagesResponse) validate(all bool) error {
+func (m *CreateWebhookResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDeploymentPackages() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListDeploymentPackagesResponseValidationError{
-						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListDeploymentPackagesResponseValidationError{
-						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListDeploymentPackagesResponseValidationError{
-					field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookResponseValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for TotalElements
-
 	if len(errors) > 0 {
-		return ListDeploymentPackagesResponseMultiError(errors)
+		return CreateWebhookResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentPackagesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListDeploymentPackagesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListDeploymentPackagesResponseMultiError []error
+// CreateWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentPackagesResponseMultiError) Error() string {
+func (m CreateWebhookResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2128,12 +8811,11 @@ func (m ListDeploymentPackagesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentPackagesResponseMultiError) AllErrors() []error { return m }
+func (m CreateWebhookResponseMultiError) AllErrors() []error { return m }
 
-// ListDeploymentPackagesResponseValidationError is the validation error
-// returned by ListDeploymentPackagesResponse.Validate if the designated
-// constraints aren't met.
-type ListDeploymentPackagesResponseValidationError struct {
+// CreateWebhookResponseValidationError is the validation error returned by
+// CreateWebhookResponse.Validate if the designated constraints aren't met.
+type CreateWebhookResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2141,24 +8823,24 @@ type ListDeploymentPackagesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentPackagesResponseValidationError) Field() string { return e.field }
+func (e CreateWebhookResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentPackagesResponseValidationError) Reason() string { return e.reason }
+func (e CreateWebhookResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentPackagesResponseValidationError) Cause() error { return e.cause }
+func (e CreateWebhookResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentPackagesResponseValidationError) Key() bool { return e.key }
+func (e CreateWebhookResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentPackagesResponseValidationError) ErrorName() string {
-	return "ListDeploymentPackagesResponseValidationError"
+func (e CreateWebhookResponseValidationError) ErrorName() string {
+	return "CreateWebhookResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentPackagesResponseValidationError) Error() string {
+func (e CreateWebhookResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2170,14 +8852,14 @@ func (e ListDeploymentPackagesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentPackagesResponse.%s: %s%s",
+		"invalid %sCreateWebhookResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentPackagesResponseValidationError{}
+var _ error = CreateWebhookResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2185,48 +8867,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentPackagesResponseValidationError{}
+} = CreateWebhookResponseValidationError{}
 
-// Validate checks the field values on GetDeploymentPackageRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentPackageRequest) Validate() error {
+func (m *ListWebhooksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentPackageRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDeploymentPackageRequestMultiError, or nil if none found.
-func (m *GetDeploymentPackageRequest) ValidateAll() error {
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentPackageRequest) validate(all bool) error {
+func (m *ListWebhooksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentPackageName
+	// no validation rules for PageSize
 
-	// no validation rules for Version
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return GetDeploymentPackageRequestMultiError(errors)
+		return ListWebhooksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentPackageRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDeploymentPackageRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDeploymentPackageRequestMultiError []error
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentPackageRequestMultiError) Error() string {
+func (m ListWebhooksRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2235,12 +8917,11 @@ func (m GetDeploymentPackageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
 
-// GetDeploymentPackageRequestValidationError is the validation error returned
-// by GetDeploymentPackageRequest.Validate if the designated constraints
-// aren't met.
-type GetDeploymentPackageRequestValidationError struct {
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2248,24 +8929,24 @@ type GetDeploymentPackageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentPackageRequestValidationError) Field() string { return e.field }
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentPackageRequestValidationError) Key() bool { return e.key }
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentPackageRequestValidationError) ErrorName() string {
-	return "GetDeploymentPackageRequestValidationError"
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentPackageRequestValidationError) Error() string {
+func (e ListWebhooksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2277,14 +8958,14 @@ func (e GetDeploymentPackageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentPackageRequest.%s: %s%s",
+		"invalid %sListWebhooksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentPackageRequestValidationError{}
+var _ error = ListWebhooksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2292,73 +8973,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentPackageRequestValidationError{}
+} = ListWebhooksRequestValidationError{}
 
-// Validate checks the field values on GetDeploymentPackageResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWebhooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentPackageResponse) Validate() error {
+func (m *ListWebhooksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentPackageResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListWebhooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDeploymentPackageResponseMultiError, or nil if none found.
-func (m *GetDeploymentPackageResponse) ValidateAll() error {
+// ListWebhooksResponseMultiError, or nil if none found.
+func (m *ListWebhooksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentPackageResponse) validate(all bool) error {
+func (m *ListWebhooksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDeploymentPackage()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDeploymentPackageResponseValidationError{
-					field:  "DeploymentPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDeploymentPackageResponseValidationError{
-					field:  "DeploymentPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		}
-	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetDeploymentPackageResponseValidationError{
-				field:  "DeploymentPackage",
-				reason: "embedded message failed validation",
-				cause:  err,
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksResponseValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalElements
+
 	if len(errors) > 0 {
-		return GetDeploymentPackageResponseMultiError(errors)
+		return ListWebhooksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentPackageResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDeploymentPackageResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDeploymentPackageResponseMultiError []error
+// ListWebhooksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentPackageResponseMultiError) Error() string {
+func (m ListWebhooksResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2367,12 +9055,11 @@ func (m GetDeploymentPackageResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentPackageResponseMultiError) AllErrors() []error { return m }
+func (m ListWebhooksResponseMultiError) AllErrors() []error { return m }
 
-// GetDeploymentPackageResponseValidationError is the validation error returned
-// by GetDeploymentPackageResponse.Validate if the designated constraints
-// aren't met.
-type GetDeploymentPackageResponseValidationError struct {
+// ListWebhooksResponseValidationError is the validation error returned by
+// ListWebhooksResponse.Validate if the designated constraints aren't met.
+type ListWebhooksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2380,24 +9067,24 @@ type GetDeploymentPackageResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentPackageResponseValidationError) Field() string { return e.field }
+func (e ListWebhooksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentPackageResponseValidationError) Reason() string { return e.reason }
+func (e ListWebhooksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentPackageResponseValidationError) Cause() error { return e.cause }
+func (e ListWebhooksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentPackageResponseValidationError) Key() bool { return e.key }
+func (e ListWebhooksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentPackageResponseValidationError) ErrorName() string {
-	return "GetDeploymentPackageResponseValidationError"
+func (e ListWebhooksResponseValidationError) ErrorName() string {
+	return "ListWebhooksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentPackageResponseValidationError) Error() string {
+func (e ListWebhooksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2409,14 +9096,14 @@ func (e GetDeploymentPackageResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentPackageResponse.%s: %s%s",
+		"invalid %sListWebhooksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentPackageResponseValidationError{}
+var _ error = ListWebhooksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2424,48 +9111,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentPackageResponseValidationError{}
+} = ListWebhooksResponseValidationError{}
 
-// Validate checks the field values on GetDeploymentPackageVersionsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetDeploymentPackageVersionsRequest) Validate() error {
+// Validate checks the field values on GetWebhookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentPackageVersionsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetDeploymentPackageVersionsRequestMultiError, or nil if none found.
-func (m *GetDeploymentPackageVersionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWebhookRequestMultiError, or nil if none found.
+func (m *GetWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentPackageVersionsRequest) validate(all bool) error {
+func (m *GetWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentPackageName
+	// no validation rules for WebhookName
 
 	if len(errors) > 0 {
-		return GetDeploymentPackageVersionsRequestMultiError(errors)
+		return GetWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentPackageVersionsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetDeploymentPackageVersionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetDeploymentPackageVersionsRequestMultiError []error
+// GetWebhookRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWebhookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentPackageVersionsRequestMultiError) Error() string {
+func (m GetWebhookRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2474,12 +9159,11 @@ func (m GetDeploymentPackageVersionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentPackageVersionsRequestMultiError) AllErrors() []error { return m }
+func (m GetWebhookRequestMultiError) AllErrors() []error { return m }
 
-// GetDeploymentPackageVersionsRequestValidationError is the validation error
-// returned by GetDeploymentPackageVersionsRequest.Validate if the designated
-// constraints aren't met.
-type GetDeploymentPackageVersionsRequestValidationError struct {
+// GetWebhookRequestValidationError is the validation error returned by
+// GetWebhookRequest.Validate if the designated constraints aren't met.
+type GetWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2487,24 +9171,24 @@ type GetDeploymentPackageVersionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentPackageVersionsRequestValidationError) Field() string { return e.field }
+func (e GetWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentPackageVersionsRequestValidationError) Reason() string { return e.reason }
+func (e GetWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentPackageVersionsRequestValidationError) Cause() error { return e.cause }
+func (e GetWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentPackageVersionsRequestValidationError) Key() bool { return e.key }
+func (e GetWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentPackageVersionsRequestValidationError) ErrorName() string {
-	return "GetDeploymentPackageVersionsRequestValidationError"
+func (e GetWebhookRequestValidationError) ErrorName() string {
+	return "GetWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentPackageVersionsRequestValidationError) Error() string {
+func (e GetWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2516,14 +9200,14 @@ func (e GetDeploymentPackageVersionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentPackageVersionsRequest.%s: %s%s",
+		"invalid %sGetWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentPackageVersionsRequestValidationError{}
+var _ error = GetWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2531,80 +9215,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentPackageVersionsRequestValidationError{}
+} = GetWebhookRequestValidationError{}
 
-// Validate checks the field values on GetDeploymentPackageVersionsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetDeploymentPackageVersionsResponse) Validate() error {
+// Validate checks the field values on GetWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentPackageVersionsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetDeploymentPackageVersionsResponseMultiError, or nil if none found.
-func (m *GetDeploymentPackageVersionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWebhookResponseMultiError, or nil if none found.
+func (m *GetWebhookResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentPackageVersionsResponse) validate(all bool) error {
+func (m *GetWebhookResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDeploymentPackages() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDeploymentPackageVersionsResponseValidationError{
-						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDeploymentPackageVersionsResponseValidationError{
-						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetDeploymentPackageVersionsResponseValidationError{
-					field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+				errors = append(errors, GetWebhookResponseValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWebhookResponseValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetDeploymentPackageVersionsResponseMultiError(errors)
+		return GetWebhookResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentPackageVersionsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GetDeploymentPackageVersionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetDeploymentPackageVersionsResponseMultiError []error
+// GetWebhookResponseMultiError is an error wrapping multiple validation errors
+// returned by GetWebhookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetWebhookResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentPackageVersionsResponseMultiError) Error() string {
+func (m GetWebhookResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2613,12 +9290,11 @@ func (m GetDeploymentPackageVersionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentPackageVersionsResponseMultiError) AllErrors() []error { return m }
+func (m GetWebhookResponseMultiError) AllErrors() []error { return m }
 
-// GetDeploymentPackageVersionsResponseValidationError is the validation error
-// returned by GetDeploymentPackageVersionsResponse.Validate if the designated
-// constraints aren't met.
-type GetDeploymentPackageVersionsResponseValidationError struct {
+// GetWebhookResponseValidationError is the validation error returned by
+// GetWebhookResponse.Validate if the designated constraints aren't met.
+type GetWebhookResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2626,24 +9302,24 @@ type GetDeploymentPackageVersionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentPackageVersionsResponseValidationError) Field() string { return e.field }
+func (e GetWebhookResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentPackageVersionsResponseValidationError) Reason() string { return e.reason }
+func (e GetWebhookResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentPackageVersionsResponseValidationError) Cause() error { return e.cause }
+func (e GetWebhookResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentPackageVersionsResponseValidationError) Key() bool { return e.key }
+func (e GetWebhookResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentPackageVersionsResponseValidationError) ErrorName() string {
-	return "GetDeploymentPackageVersionsResponseValidationError"
+func (e GetWebhookResponseValidationError) ErrorName() string {
+	return "GetWebhookResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentPackageVersionsResponseValidationError) Error() string {
+func (e GetWebhookResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2655,14 +9331,14 @@ func (e GetDeploymentPackageVersionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentPackageVersionsResponse.%s: %s%s",
+		"invalid %sGetWebhookResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentPackageVersionsResponseValidationError{}
+var _ error = GetWebhookResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2670,57 +9346,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentPackageVersionsResponseValidationError{}
+} = GetWebhookResponseValidationError{}
 
-// Validate checks the field values on UpdateDeploymentPackageRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDeploymentPackageRequest) Validate() error {
+func (m *UpdateWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDeploymentPackageRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateDeploymentPackageRequestMultiError, or nil if none found.
-func (m *UpdateDeploymentPackageRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWebhookRequestMultiError, or nil if none found.
+func (m *UpdateWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDeploymentPackageRequest) validate(all bool) error {
+func (m *UpdateWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentPackageName
+	// no validation rules for WebhookName
 
-	// no validation rules for Version
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWebhookRequestValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWebhookRequestValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWebhookRequestValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetDeploymentPackage()).(type) {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateDeploymentPackageRequestValidationError{
-					field:  "DeploymentPackage",
+				errors = append(errors, UpdateWebhookRequestValidationError{
+					field:  "UpdateMask",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateDeploymentPackageRequestValidationError{
-					field:  "DeploymentPackage",
+				errors = append(errors, UpdateWebhookRequestValidationError{
+					field:  "UpdateMask",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateDeploymentPackageRequestValidationError{
-				field:  "DeploymentPackage",
+			return UpdateWebhookRequestValidationError{
+				field:  "UpdateMask",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2728,19 +9431,19 @@ func (m *UpdateDeploymentPackageRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateDeploymentPackageRequestMultiError(errors)
+		return UpdateWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDeploymentPackageRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateDeploymentPackageRequest.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateDeploymentPackageRequestMultiError []error
+// UpdateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDeploymentPackageRequestMultiError) Error() string {
+func (m UpdateWebhookRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2749,12 +9452,11 @@ func (m UpdateDeploymentPackageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+func (m UpdateWebhookRequestMultiError) AllErrors() []error { return m }
 
-// UpdateDeploymentPackageRequestValidationError is the validation error
-// returned by UpdateDeploymentPackageRequest.Validate if the designated
-// constraints aren't met.
-type UpdateDeploymentPackageRequestValidationError struct {
+// UpdateWebhookRequestValidationError is the validation error returned by
+// UpdateWebhookRequest.Validate if the designated constraints aren't met.
+type UpdateWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2762,24 +9464,24 @@ type UpdateDeploymentPackageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDeploymentPackageRequestValidationError) Field() string { return e.field }
+func (e UpdateWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+func (e UpdateWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+func (e UpdateWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDeploymentPackageRequestValidationError) Key() bool { return e.key }
+func (e UpdateWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDeploymentPackageRequestValidationError) ErrorName() string {
-	return "UpdateDeploymentPackageRequestValidationError"
+func (e UpdateWebhookRequestValidationError) ErrorName() string {
+	return "UpdateWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDeploymentPackageRequestValidationError) Error() string {
+func (e UpdateWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2791,14 +9493,14 @@ func (e UpdateDeploymentPackageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDeploymentPackageRequest.%s: %s%s",
+		"invalid %sUpdateWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDeploymentPackageRequestValidationError{}
+var _ error = UpdateWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2806,48 +9508,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDeploymentPackageRequestValidationError{}
+} = UpdateWebhookRequestValidationError{}
 
-// Validate checks the field values on DeleteDeploymentPackageRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDeploymentPackageRequest) Validate() error {
+func (m *DeleteWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDeploymentPackageRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// DeleteDeploymentPackageRequestMultiError, or nil if none found.
-func (m *DeleteDeploymentPackageRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDeploymentPackageRequest) validate(all bool) error {
+func (m *DeleteWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentPackageName
-
-	// no validation rules for Version
+	// no validation rules for WebhookName
 
 	if len(errors) > 0 {
-		return DeleteDeploymentPackageRequestMultiError(errors)
+		return DeleteWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDeploymentPackageRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteDeploymentPackageRequest.ValidateAll()
-// if the designated constraints aren't met.
-type DeleteDeploymentPackageRequestMultiError []error
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDeploymentPackageRequestMultiError) Error() string {
+func (m DeleteWebhookRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2856,12 +9556,11 @@ func (m DeleteDeploymentPackageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDeploymentPackageRequestMultiError) AllErrors() []error { return m }
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
 
-// DeleteDeploymentPackageRequestValidationError is the validation error
-// returned by DeleteDeploymentPackageRequest.Validate if the designated
-// constraints aren't met.
-type DeleteDeploymentPackageRequestValidationError struct {
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2869,24 +9568,24 @@ type DeleteDeploymentPackageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDeploymentPackageRequestValidationError) Field() string { return e.field }
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDeploymentPackageRequestValidationError) Reason() string { return e.reason }
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDeploymentPackageRequestValidationError) Cause() error { return e.cause }
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDeploymentPackageRequestValidationError) Key() bool { return e.key }
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDeploymentPackageRequestValidationError) ErrorName() string {
-	return "DeleteDeploymentPackageRequestValidationError"
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDeploymentPackageRequestValidationError) Error() string {
+func (e DeleteWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2898,14 +9597,14 @@ func (e DeleteDeploymentPackageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDeploymentPackageRequest.%s: %s%s",
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDeploymentPackageRequestValidationError{}
+var _ error = DeleteWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2913,48 +9612,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDeploymentPackageRequestValidationError{}
+} = DeleteWebhookRequestValidationError{}
 
-// Validate checks the field values on WatchDeploymentPackagesRequest with the
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchDeploymentPackagesRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on WatchDeploymentPackagesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// WatchDeploymentPackagesRequestMultiError, or nil if none found.
-func (m *WatchDeploymentPackagesRequest) ValidateAll() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchDeploymentPackagesRequest) validate(all bool) error {
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for WebhookName
 
-	// no validation rules for NoReplay
+	// no validation rules for State
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return WatchDeploymentPackagesRequestMultiError(errors)
+		return ListWebhookDeliveriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchDeploymentPackagesRequestMultiError is an error wrapping multiple
-// validation errors returned by WatchDeploymentPackagesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type WatchDeploymentPackagesRequestMultiError []error
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchDeploymentPackagesRequestMultiError) Error() string {
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2963,12 +9666,12 @@ func (m WatchDeploymentPackagesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchDeploymentPackagesRequestMultiError) AllErrors() []error { return m }
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
 
-// WatchDeploymentPackagesRequestValidationError is the validation error
-// returned by WatchDeploymentPackagesRequest.Validate if the designated
-// constraints aren't met.
-type WatchDeploymentPackagesRequestValidationError struct {
+// ListWebhookDeliveriesRequestValidationError is the validation error returned
+// by ListWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2976,24 +9679,24 @@ type WatchDeploymentPackagesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchDeploymentPackagesRequestValidationError) Field() string { return e.field }
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchDeploymentPackagesRequestValidationError) Reason() string { return e.reason }
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchDeploymentPackagesRequestValidationError) Cause() error { return e.cause }
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchDeploymentPackagesRequestValidationError) Key() bool { return e.key }
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchDeploymentPackagesRequestValidationError) ErrorName() string {
-	return "WatchDeploymentPackagesRequestValidationError"
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchDeploymentPackagesRequestValidationError) Error() string {
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3005,14 +9708,14 @@ func (e WatchDeploymentPackagesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchDeploymentPackagesRequest.%s: %s%s",
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchDeploymentPackagesRequestValidationError{}
+var _ error = ListWebhookDeliveriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3020,102 +9723,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchDeploymentPackagesRequestValidationError{}
+} = ListWebhookDeliveriesRequestValidationError{}
 
-// Validate checks the field values on WatchDeploymentPackagesResponse with the
+// Validate checks the field values on ListWebhookDeliveriesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchDeploymentPackagesResponse) Validate() error {
+func (m *ListWebhookDeliveriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchDeploymentPackagesResponse with
+// ValidateAll checks the field values on ListWebhookDeliveriesResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// WatchDeploymentPackagesResponseMultiError, or nil if none found.
-func (m *WatchDeploymentPackagesResponse) ValidateAll() error {
+// ListWebhookDeliveriesResponseMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchDeploymentPackagesResponse) validate(all bool) error {
+func (m *ListWebhookDeliveriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchDeploymentPackagesResponseValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetDeploymentPackage()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
-					field:  "DeploymentPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchDeploymentPackagesResponseValidationError{
-					field:  "DeploymentPackage",
+				return ListWebhookDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchDeploymentPackagesResponseValidationError{
-				field:  "DeploymentPackage",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalElements
+
 	if len(errors) > 0 {
-		return WatchDeploymentPackagesResponseMultiError(errors)
+		return ListWebhookDeliveriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchDeploymentPackagesResponseMultiError is an error wrapping multiple
-// validation errors returned by WatchDeploymentPackagesResponse.ValidateAll()
+// ListWebhookDeliveriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesResponse.ValidateAll()
 // if the designated constraints aren't met.
-type WatchDeploymentPackagesResponseMultiError []error
+type ListWebhookDeliveriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchDeploymentPackagesResponseMultiError) Error() string {
+func (m ListWebhookDeliveriesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3124,12 +9805,12 @@ func (m WatchDeploymentPackagesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchDeploymentPackagesResponseMultiError) AllErrors() []error { return m }
+func (m ListWebhookDeliveriesResponseMultiError) AllErrors() []error { return m }
 
-// WatchDeploymentPackagesResponseValidationError is the validation error
-// returned by WatchDeploymentPackagesResponse.Validate if the designated
+// ListWebhookDeliveriesResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesResponse.Validate if the designated
 // constraints aren't met.
-type WatchDeploymentPackagesResponseValidationError struct {
+type ListWebhookDeliveriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3137,24 +9818,24 @@ type WatchDeploymentPackagesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchDeploymentPackagesResponseValidationError) Field() string { return e.field }
+func (e ListWebhookDeliveriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchDeploymentPackagesResponseValidationError) Reason() string { return e.reason }
+func (e ListWebhookDeliveriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchDeploymentPackagesResponseValidationError) Cause() error { return e.cause }
+func (e ListWebhookDeliveriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchDeploymentPackagesResponseValidationError) Key() bool { return e.key }
+func (e ListWebhookDeliveriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchDeploymentPackagesResponseValidationError) ErrorName() string {
-	return "WatchDeploymentPackagesResponseValidationError"
+func (e ListWebhookDeliveriesResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchDeploymentPackagesResponseValidationError) Error() string {
+func (e ListWebhookDeliveriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3166,14 +9847,14 @@ func (e WatchDeploymentPackagesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchDeploymentPackagesResponse.%s: %s%s",
+		"invalid %sListWebhookDeliveriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchDeploymentPackagesResponseValidationError{}
+var _ error = ListWebhookDeliveriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3181,73 +9862,114 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchDeploymentPackagesResponseValidationError{}
+} = ListWebhookDeliveriesResponseValidationError{}
 
-// Validate checks the field values on CreateApplicationRequest with the rules
+// Validate checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateApplicationRequest) Validate() error {
+func (m *ListAuditEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateApplicationRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateApplicationRequestMultiError, or nil if none found.
-func (m *CreateApplicationRequest) ValidateAll() error {
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateApplicationRequest) validate(all bool) error {
+func (m *ListAuditEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceName
+
+	// no validation rules for ResourceVersion
+
+	// no validation rules for User
+
 	if all {
-		switch v := interface{}(m.GetApplication()).(type) {
+		switch v := interface{}(m.GetStartTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateApplicationRequestValidationError{
-					field:  "Application",
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateApplicationRequestValidationError{
-					field:  "Application",
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateApplicationRequestValidationError{
-				field:  "Application",
+			return ListAuditEventsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "EndTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
 	if len(errors) > 0 {
-		return CreateApplicationRequestMultiError(errors)
+		return ListAuditEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateApplicationRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateApplicationRequest.ValidateAll() if the designated
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateApplicationRequestMultiError []error
+type ListAuditEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateApplicationRequestMultiError) Error() string {
+func (m ListAuditEventsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3256,11 +9978,11 @@ func (m CreateApplicationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateApplicationRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
 
-// CreateApplicationRequestValidationError is the validation error returned by
-// CreateApplicationRequest.Validate if the designated constraints aren't met.
-type CreateApplicationRequestValidationError struct {
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3268,24 +9990,24 @@ type CreateApplicationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateApplicationRequestValidationError) Field() string { return e.field }
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateApplicationRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateApplicationRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateApplicationRequestValidationError) Key() bool { return e.key }
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateApplicationRequestValidationError) ErrorName() string {
-	return "CreateApplicationRequestValidationError"
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateApplicationRequestValidationError) Error() string {
+func (e ListAuditEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3297,14 +10019,14 @@ func (e CreateApplicationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateApplicationRequest.%s: %s%s",
+		"invalid %sListAuditEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateApplicationRequestValidationError{}
+var _ error = ListAuditEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3312,73 +10034,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateApplicationRequestValidationError{}
+} = ListAuditEventsRequestValidationError{}
 
-// Validate checks the field values on CreateApplicationResponse with the rules
+// Validate checks the field values on ListAuditEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateApplicationResponse) Validate() error {
+func (m *ListAuditEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateApplicationResponse with the
+// ValidateAll checks the field values on ListAuditEventsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateApplicationResponseMultiError, or nil if none found.
-func (m *CreateApplicationResponse) ValidateAll() error {
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateApplicationResponse) validate(all bool) error {
+func (m *ListAuditEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetApplication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateApplicationResponseValidationError{
-					field:  "Application",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetAuditEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("AuditEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("AuditEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateApplicationResponseValidationError{
-					field:  "Application",
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("AuditEvents[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateApplicationResponseValidationError{
-				field:  "Application",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalElements
+
 	if len(errors) > 0 {
-		return CreateApplicationResponseMultiError(errors)
+		return ListAuditEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateApplicationResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateApplicationResponse.ValidateAll() if the
-// designated constraints aren't met.
-type CreateApplicationResponseMultiError []error
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateApplicationResponseMultiError) Error() string {
+func (m ListAuditEventsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3387,11 +10116,11 @@ func (m CreateApplicationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateApplicationResponseMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
 
-// CreateApplicationResponseValidationError is the validation error returned by
-// CreateApplicationResponse.Validate if the designated constraints aren't met.
-type CreateApplicationResponseValidationError struct {
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3399,24 +10128,24 @@ type CreateApplicationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateApplicationResponseValidationError) Field() string { return e.field }
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateApplicationResponseValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateApplicationResponseValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateApplicationResponseValidationError) Key() bool { return e.key }
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateApplicationResponseValidationError) ErrorName() string {
-	return "CreateApplicationResponseValidationError"
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateApplicationResponseValidationError) Error() string {
+func (e ListAuditEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3428,14 +10157,14 @@ func (e CreateApplicationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateApplicationResponse.%s: %s%s",
+		"invalid %sListAuditEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateApplicationResponseValidationError{}
+var _ error = ListAuditEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3443,52 +10172,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateApplicationResponseValidationError{}
+} = ListAuditEventsResponseValidationError{}
 
-// Validate checks the field values on ListApplicationsRequest with the rules
+// Validate checks the field values on ListRevisionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListApplicationsRequest) Validate() error {
+func (m *ListRevisionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListApplicationsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListApplicationsRequestMultiError, or nil if none found.
-func (m *ListApplicationsRequest) ValidateAll() error {
+// ListRevisionsRequestMultiError, or nil if none found.
+func (m *ListRevisionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListApplicationsRequest) validate(all bool) error {
+func (m *ListRevisionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderBy
+	// no validation rules for ResourceType
 
-	// no validation rules for Filter
+	// no validation rules for Name
+
+	// no validation rules for Version
 
 	// no validation rules for PageSize
 
 	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return ListApplicationsRequestMultiError(errors)
+		return ListRevisionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListApplicationsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListApplicationsRequest.ValidateAll() if the designated
+// ListRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListApplicationsRequestMultiError []error
+type ListRevisionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListApplicationsRequestMultiError) Error() string {
+func (m ListRevisionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3497,11 +10228,11 @@ func (m ListApplicationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListApplicationsRequestMultiError) AllErrors() []error { return m }
+func (m ListRevisionsRequestMultiError) AllErrors() []error { return m }
 
-// ListApplicationsRequestValidationError is the validation error returned by
-// ListApplicationsRequest.Validate if the designated constraints aren't met.
-type ListApplicationsRequestValidationError struct {
+// ListRevisionsRequestValidationError is the validation error returned by
+// ListRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRevisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3509,24 +10240,24 @@ type ListApplicationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListApplicationsRequestValidationError) Field() string { return e.field }
+func (e ListRevisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListApplicationsRequestValidationError) Reason() string { return e.reason }
+func (e ListRevisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListApplicationsRequestValidationError) Cause() error { return e.cause }
+func (e ListRevisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListApplicationsRequestValidationError) Key() bool { return e.key }
+func (e ListRevisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListApplicationsRequestValidationError) ErrorName() string {
-	return "ListApplicationsRequestValidationError"
+func (e ListRevisionsRequestValidationError) ErrorName() string {
+	return "ListRevisionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListApplicationsRequestValidationError) Error() string {
+func (e ListRevisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3538,14 +10269,14 @@ func (e ListApplicationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListApplicationsRequest.%s: %s%s",
+		"invalid %sListRevisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListApplicationsRequestValidationError{}
+var _ error = ListRevisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3553,47 +10284,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListApplicationsRequestValidationError{}
+} = ListRevisionsRequestValidationError{}
 
-// Validate checks the field values on ListApplicationsResponse with the rules
+// Validate checks the field values on ListRevisionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListApplicationsResponse) Validate() error {
+func (m *ListRevisionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListApplicationsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListApplicationsResponseMultiError, or nil if none found.
-func (m *ListApplicationsResponse) ValidateAll() error {
+// ListRevisionsResponseMultiError, or nil if none found.
+func (m *ListRevisionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListApplicationsResponse) validate(all bool) error {
+func (m *ListRevisionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetApplications() {
+	for idx, item := range m.GetRevisions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListApplicationsResponseValidationError{
-						field:  fmt.Sprintf("Applications[%v]", idx),
+					errors = append(errors, ListRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListApplicationsResponseValidationError{
-						field:  fmt.Sprintf("Applications[%v]", idx),
+					errors = append(errors, ListRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3601,8 +10332,8 @@ func (m *ListApplicationsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListApplicationsResponseValidationError{
-					field:  fmt.Sprintf("Applications[%v]", idx),
+				return ListRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3614,19 +10345,19 @@ func (m *ListApplicationsResponse) validate(all bool) error {
 	// no validation rules for TotalElements
 
 	if len(errors) > 0 {
-		return ListApplicationsResponseMultiError(errors)
+		return ListRevisionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListApplicationsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListApplicationsResponse.ValidateAll() if the designated
+// ListRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListApplicationsResponseMultiError []error
+type ListRevisionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListApplicationsResponseMultiError) Error() string {
+func (m ListRevisionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3635,11 +10366,11 @@ func (m ListApplicationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListApplicationsResponseMultiError) AllErrors() []error { return m }
+func (m ListRevisionsResponseMultiError) AllErrors() []error { return m }
 
-// ListApplicationsResponseValidationError is the validation error returned by
-// ListApplicationsResponse.Validate if the designated constraints aren't met.
-type ListApplicationsResponseValidationError struct {
+// ListRevisionsResponseValidationError is the validation error returned by
+// ListRevisionsResponse.Validate if the designated constraints aren't met.
+type ListRevisionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3647,24 +10378,24 @@ type ListApplicationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListApplicationsResponseValidationError) Field() string { return e.field }
+func (e ListRevisionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListApplicationsResponseValidationError) Reason() string { return e.reason }
+func (e ListRevisionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListApplicationsResponseValidationError) Cause() error { return e.cause }
+func (e ListRevisionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListApplicationsResponseValidationError) Key() bool { return e.key }
+func (e ListRevisionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListApplicationsResponseValidationError) ErrorName() string {
-	return "ListApplicationsResponseValidationError"
+func (e ListRevisionsResponseValidationError) ErrorName() string {
+	return "ListRevisionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListApplicationsResponseValidationError) Error() string {
+func (e ListRevisionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3676,14 +10407,14 @@ func (e ListApplicationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListApplicationsResponse.%s: %s%s",
+		"invalid %sListRevisionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListApplicationsResponseValidationError{}
+var _ error = ListRevisionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3691,48 +10422,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListApplicationsResponseValidationError{}
+} = ListRevisionsResponseValidationError{}
 
-// Validate checks the field values on GetApplicationRequest with the rules
+// Validate checks the field values on GetRevisionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetApplicationRequest) Validate() error {
+func (m *GetRevisionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetApplicationRequest with the rules
+// ValidateAll checks the field values on GetRevisionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetApplicationRequestMultiError, or nil if none found.
-func (m *GetApplicationRequest) ValidateAll() error {
+// GetRevisionRequestMultiError, or nil if none found.
+func (m *GetRevisionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetApplicationRequest) validate(all bool) error {
+func (m *GetRevisionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApplicationName
+	// no validation rules for ResourceType
+
+	// no validation rules for Name
 
 	// no validation rules for Version
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
-		return GetApplicationRequestMultiError(errors)
+		return GetRevisionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetApplicationRequestMultiError is an error wrapping multiple validation
-// errors returned by GetApplicationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetApplicationRequestMultiError []error
+// GetRevisionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetApplicationRequestMultiError) Error() string {
+func (m GetRevisionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3741,11 +10476,11 @@ func (m GetApplicationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetApplicationRequestMultiError) AllErrors() []error { return m }
+func (m GetRevisionRequestMultiError) AllErrors() []error { return m }
 
-// GetApplicationRequestValidationError is the validation error returned by
-// GetApplicationRequest.Validate if the designated constraints aren't met.
-type GetApplicationRequestValidationError struct {
+// GetRevisionRequestValidationError is the validation error returned by
+// GetRevisionRequest.Validate if the designated constraints aren't met.
+type GetRevisionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3753,24 +10488,24 @@ type GetApplicationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetApplicationRequestValidationError) Field() string { return e.field }
+func (e GetRevisionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetApplicationRequestValidationError) Reason() string { return e.reason }
+func (e GetRevisionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetApplicationRequestValidationError) Cause() error { return e.cause }
+func (e GetRevisionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetApplicationRequestValidationError) Key() bool { return e.key }
+func (e GetRevisionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetApplicationRequestValidationError) ErrorName() string {
-	return "GetApplicationRequestValidationError"
+func (e GetRevisionRequestValidationError) ErrorName() string {
+	return "GetRevisionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetApplicationRequestValidationError) Error() string {
+func (e GetRevisionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3782,14 +10517,14 @@ func (e GetApplicationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetApplicationRequest.%s: %s%s",
+		"invalid %sGetRevisionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetApplicationRequestValidationError{}
+var _ error = GetRevisionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3797,24 +10532,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetApplicationRequestValidationError{}
+} = GetRevisionRequestValidationError{}
 
-// Validate checks the field values on GetApplicationResponse with the rules
+// Validate checks the field values on GetRevisionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetApplicationResponse) Validate() error {
+func (m *GetRevisionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetApplicationResponse with the rules
+// ValidateAll checks the field values on GetRevisionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetApplicationResponseMultiError, or nil if none found.
-func (m *GetApplicationResponse) ValidateAll() error {
+// GetRevisionResponseMultiError, or nil if none found.
+func (m *GetRevisionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetApplicationResponse) validate(all bool) error {
+func (m *GetRevisionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3822,28 +10557,28 @@ func (m *GetApplicationResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetApplication()).(type) {
+		switch v := interface{}(m.GetRevision()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetApplicationResponseValidationError{
-					field:  "Application",
+				errors = append(errors, GetRevisionResponseValidationError{
+					field:  "Revision",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetApplicationResponseValidationError{
-					field:  "Application",
+				errors = append(errors, GetRevisionResponseValidationError{
+					field:  "Revision",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetApplicationResponseValidationError{
-				field:  "Application",
+			return GetRevisionResponseValidationError{
+				field:  "Revision",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3851,19 +10586,19 @@ func (m *GetApplicationResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetApplicationResponseMultiError(errors)
+		return GetRevisionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetApplicationResponseMultiError is an error wrapping multiple validation
-// errors returned by GetApplicationResponse.ValidateAll() if the designated
+// GetRevisionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRevisionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetApplicationResponseMultiError []error
+type GetRevisionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetApplicationResponseMultiError) Error() string {
+func (m GetRevisionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3872,11 +10607,11 @@ func (m GetApplicationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetApplicationResponseMultiError) AllErrors() []error { return m }
+func (m GetRevisionResponseMultiError) AllErrors() []error { return m }
 
-// GetApplicationResponseValidationError is the validation error returned by
-// GetApplicationResponse.Validate if the designated constraints aren't met.
-type GetApplicationResponseValidationError struct {
+// GetRevisionResponseValidationError is the validation error returned by
+// GetRevisionResponse.Validate if the designated constraints aren't met.
+type GetRevisionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3884,24 +10619,24 @@ type GetApplicationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetApplicationResponseValidationError) Field() string { return e.field }
+func (e GetRevisionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetApplicationResponseValidationError) Reason() string { return e.reason }
+func (e GetRevisionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetApplicationResponseValidationError) Cause() error { return e.cause }
+func (e GetRevisionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetApplicationResponseValidationError) Key() bool { return e.key }
+func (e GetRevisionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetApplicationResponseValidationError) ErrorName() string {
-	return "GetApplicationResponseValidationError"
+func (e GetRevisionResponseValidationError) ErrorName() string {
+	return "GetRevisionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetApplicationResponseValidationError) Error() string {
+func (e GetRevisionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3913,14 +10648,14 @@ func (e GetApplicationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetApplicationResponse.%s: %s%s",
+		"invalid %sGetRevisionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetApplicationResponseValidationError{}
+var _ error = GetRevisionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3928,157 +10663,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetApplicationResponseValidationError{}
+} = GetRevisionResponseValidationError{}
 
-// Validate checks the field values on GetApplicationReferenceCountRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetApplicationReferenceCountRequest) Validate() error {
+// Validate checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRevisionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetApplicationReferenceCountRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetApplicationReferenceCountRequestMultiError, or nil if none found.
-func (m *GetApplicationReferenceCountRequest) ValidateAll() error {
+// ValidateAll checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionRequestMultiError, or nil if none found.
+func (m *RestoreRevisionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetApplicationReferenceCountRequest) validate(all bool) error {
+func (m *RestoreRevisionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApplicationName
-
-	// no validation rules for Version
-
-	if len(errors) > 0 {
-		return GetApplicationReferenceCountRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetApplicationReferenceCountRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetApplicationReferenceCountRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetApplicationReferenceCountRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetApplicationReferenceCountRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m GetApplicationReferenceCountRequestMultiError) AllErrors() []error { return m }
-
-// GetApplicationReferenceCountRequestValidationError is the validation error
-// returned by GetApplicationReferenceCountRequest.Validate if the designated
-// constraints aren't met.
-type GetApplicationReferenceCountRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e GetApplicationReferenceCountRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e GetApplicationReferenceCountRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e GetApplicationReferenceCountRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e GetApplicationReferenceCountRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e GetApplicationReferenceCountRequestValidationError) ErrorName() string {
-	return "GetApplicationReferenceCountRequestValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e GetApplicationReferenceCountRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sGetApplicationReferenceCountRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = GetApplicationReferenceCountRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetApplicationReferenceCountRequestValidationError{}
-
-// Validate checks the field values on GetApplicationReferenceCountResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetApplicationReferenceCountResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetApplicationReferenceCountResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetApplicationReferenceCountResponseMultiError, or nil if none found.
-func (m *GetApplicationReferenceCountResponse) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for ResourceType
 
-func (m *GetApplicationReferenceCountResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	// no validation rules for Name
 
-	var errors []error
+	// no validation rules for Version
 
-	// no validation rules for ReferenceCount
+	// no validation rules for Revision
 
 	if len(errors) > 0 {
-		return GetApplicationReferenceCountResponseMultiError(errors)
+		return RestoreRevisionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetApplicationReferenceCountResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GetApplicationReferenceCountResponse.ValidateAll() if the designated
+// RestoreRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetApplicationReferenceCountResponseMultiError []error
+type RestoreRevisionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetApplicationReferenceCountResponseMultiError) Error() string {
+func (m RestoreRevisionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4087,12 +10717,11 @@ func (m GetApplicationReferenceCountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetApplicationReferenceCountResponseMultiError) AllErrors() []error { return m }
+func (m RestoreRevisionRequestMultiError) AllErrors() []error { return m }
 
-// GetApplicationReferenceCountResponseValidationError is the validation error
-// returned by GetApplicationReferenceCountResponse.Validate if the designated
-// constraints aren't met.
-type GetApplicationReferenceCountResponseValidationError struct {
+// RestoreRevisionRequestValidationError is the validation error returned by
+// RestoreRevisionRequest.Validate if the designated constraints aren't met.
+type RestoreRevisionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4100,24 +10729,24 @@ type GetApplicationReferenceCountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetApplicationReferenceCountResponseValidationError) Field() string { return e.field }
+func (e RestoreRevisionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetApplicationReferenceCountResponseValidationError) Reason() string { return e.reason }
+func (e RestoreRevisionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetApplicationReferenceCountResponseValidationError) Cause() error { return e.cause }
+func (e RestoreRevisionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetApplicationReferenceCountResponseValidationError) Key() bool { return e.key }
+func (e RestoreRevisionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetApplicationReferenceCountResponseValidationError) ErrorName() string {
-	return "GetApplicationReferenceCountResponseValidationError"
+func (e RestoreRevisionRequestValidationError) ErrorName() string {
+	return "RestoreRevisionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetApplicationReferenceCountResponseValidationError) Error() string {
+func (e RestoreRevisionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4129,14 +10758,14 @@ func (e GetApplicationReferenceCountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetApplicationReferenceCountResponse.%s: %s%s",
+		"invalid %sRestoreRevisionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetApplicationReferenceCountResponseValidationError{}
+var _ error = RestoreRevisionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4144,46 +10773,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetApplicationReferenceCountResponseValidationError{}
+} = RestoreRevisionRequestValidationError{}
 
-// Validate checks the field values on GetApplicationVersionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RestoreRevisionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetApplicationVersionsRequest) Validate() error {
+func (m *RestoreRevisionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetApplicationVersionsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetApplicationVersionsRequestMultiError, or nil if none found.
-func (m *GetApplicationVersionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on RestoreRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionResponseMultiError, or nil if none found.
+func (m *RestoreRevisionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetApplicationVersionsRequest) validate(all bool) error {
+func (m *RestoreRevisionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApplicationName
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRevisionResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetApplicationVersionsRequestMultiError(errors)
+		return RestoreRevisionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetApplicationVersionsRequestMultiError is an error wrapping multiple
-// validation errors returned by GetApplicationVersionsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetApplicationVersionsRequestMultiError []error
+// RestoreRevisionResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRevisionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetApplicationVersionsRequestMultiError) Error() string {
+func (m RestoreRevisionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4192,12 +10848,11 @@ func (m GetApplicationVersionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetApplicationVersionsRequestMultiError) AllErrors() []error { return m }
+func (m RestoreRevisionResponseMultiError) AllErrors() []error { return m }
 
-// GetApplicationVersionsRequestValidationError is the validation error
-// returned by GetApplicationVersionsRequest.Validate if the designated
-// constraints aren't met.
-type GetApplicationVersionsRequestValidationError struct {
+// RestoreRevisionResponseValidationError is the validation error returned by
+// RestoreRevisionResponse.Validate if the designated constraints aren't met.
+type RestoreRevisionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4205,24 +10860,24 @@ type GetApplicationVersionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetApplicationVersionsRequestValidationError) Field() string { return e.field }
+func (e RestoreRevisionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetApplicationVersionsRequestValidationError) Reason() string { return e.reason }
+func (e RestoreRevisionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetApplicationVersionsRequestValidationError) Cause() error { return e.cause }
+func (e RestoreRevisionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetApplicationVersionsRequestValidationError) Key() bool { return e.key }
+func (e RestoreRevisionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetApplicationVersionsRequestValidationError) ErrorName() string {
-	return "GetApplicationVersionsRequestValidationError"
+func (e RestoreRevisionResponseValidationError) ErrorName() string {
+	return "RestoreRevisionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetApplicationVersionsRequestValidationError) Error() string {
+func (e RestoreRevisionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4234,14 +10889,14 @@ func (e GetApplicationVersionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetApplicationVersionsRequest.%s: %s%s",
+		"invalid %sRestoreRevisionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetApplicationVersionsRequestValidationError{}
+var _ error = RestoreRevisionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4249,78 +10904,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetApplicationVersionsRequestValidationError{}
+} = RestoreRevisionResponseValidationError{}
 
-// Validate checks the field values on GetApplicationVersionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetApplicationVersionsResponse) Validate() error {
+func (m *SearchCatalogRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetApplicationVersionsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetApplicationVersionsResponseMultiError, or nil if none found.
-func (m *GetApplicationVersionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on SearchCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCatalogRequestMultiError, or nil if none found.
+func (m *SearchCatalogRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetApplicationVersionsResponse) validate(all bool) error {
+func (m *SearchCatalogRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetApplication() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetApplicationVersionsResponseValidationError{
-						field:  fmt.Sprintf("Application[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetApplicationVersionsResponseValidationError{
-						field:  fmt.Sprintf("Application[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetApplicationVersionsResponseValidationError{
-					field:  fmt.Sprintf("Application[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Query
 
-	}
+	// no validation rules for PageSize
 
 	if len(errors) > 0 {
-		return GetApplicationVersionsResponseMultiError(errors)
+		return SearchCatalogRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetApplicationVersionsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetApplicationVersionsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetApplicationVersionsResponseMultiError []error
+// SearchCatalogRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchCatalogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCatalogRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetApplicationVersionsResponseMultiError) Error() string {
+func (m SearchCatalogRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4329,12 +10954,11 @@ func (m GetApplicationVersionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetApplicationVersionsResponseMultiError) AllErrors() []error { return m }
+func (m SearchCatalogRequestMultiError) AllErrors() []error { return m }
 
-// GetApplicationVersionsResponseValidationError is the validation error
-// returned by GetApplicationVersionsResponse.Validate if the designated
-// constraints aren't met.
-type GetApplicationVersionsResponseValidationError struct {
+// SearchCatalogRequestValidationError is the validation error returned by
+// SearchCatalogRequest.Validate if the designated constraints aren't met.
+type SearchCatalogRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4342,24 +10966,24 @@ type GetApplicationVersionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetApplicationVersionsResponseValidationError) Field() string { return e.field }
+func (e SearchCatalogRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetApplicationVersionsResponseValidationError) Reason() string { return e.reason }
+func (e SearchCatalogRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetApplicationVersionsResponseValidationError) Cause() error { return e.cause }
+func (e SearchCatalogRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetApplicationVersionsResponseValidationError) Key() bool { return e.key }
+func (e SearchCatalogRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetApplicationVersionsResponseValidationError) ErrorName() string {
-	return "GetApplicationVersionsResponseValidationError"
+func (e SearchCatalogRequestValidationError) ErrorName() string {
+	return "SearchCatalogRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetApplicationVersionsResponseValidationError) Error() string {
+func (e SearchCatalogRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4371,14 +10995,14 @@ func (e GetApplicationVersionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetApplicationVersionsResponse.%s: %s%s",
+		"invalid %sSearchCatalogRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetApplicationVersionsResponseValidationError{}
+var _ error = SearchCatalogRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4386,77 +11010,146 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetApplicationVersionsResponseValidationError{}
+} = SearchCatalogRequestValidationError{}
 
-// Validate checks the field values on UpdateApplicationRequest with the rules
+// Validate checks the field values on SearchCatalogResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateApplicationRequest) Validate() error {
+func (m *SearchCatalogResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateApplicationRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateApplicationRequestMultiError, or nil if none found.
-func (m *UpdateApplicationRequest) ValidateAll() error {
+// SearchCatalogResponseMultiError, or nil if none found.
+func (m *SearchCatalogResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateApplicationRequest) validate(all bool) error {
+func (m *SearchCatalogResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApplicationName
-
-	// no validation rules for Version
+	for idx, item := range m.GetApplications() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetApplication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateApplicationRequestValidationError{
-					field:  "Application",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchCatalogResponseValidationError{
+					field:  fmt.Sprintf("Applications[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
-		case interface{ Validate() error }:
+		}
+
+	}
+
+	for idx, item := range m.GetDeploymentPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateApplicationRequestValidationError{
-					field:  "Application",
+				return SearchCatalogResponseValidationError{
+					field:  fmt.Sprintf("DeploymentPackages[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateApplicationRequestValidationError{
-				field:  "Application",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	for idx, item := range m.GetRegistries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("Registries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchCatalogResponseValidationError{
+						field:  fmt.Sprintf("Registries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchCatalogResponseValidationError{
+					field:  fmt.Sprintf("Registries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateApplicationRequestMultiError(errors)
+		return SearchCatalogResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateApplicationRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateApplicationRequest.ValidateAll() if the designated
+// SearchCatalogResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchCatalogResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateApplicationRequestMultiError []error
+type SearchCatalogResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateApplicationRequestMultiError) Error() string {
+func (m SearchCatalogResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4465,11 +11158,11 @@ func (m UpdateApplicationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateApplicationRequestMultiError) AllErrors() []error { return m }
+func (m SearchCatalogResponseMultiError) AllErrors() []error { return m }
 
-// UpdateApplicationRequestValidationError is the validation error returned by
-// UpdateApplicationRequest.Validate if the designated constraints aren't met.
-type UpdateApplicationRequestValidationError struct {
+// SearchCatalogResponseValidationError is the validation error returned by
+// SearchCatalogResponse.Validate if the designated constraints aren't met.
+type SearchCatalogResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4477,24 +11170,24 @@ type UpdateApplicationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateApplicationRequestValidationError) Field() string { return e.field }
+func (e SearchCatalogResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateApplicationRequestValidationError) Reason() string { return e.reason }
+func (e SearchCatalogResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateApplicationRequestValidationError) Cause() error { return e.cause }
+func (e SearchCatalogResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateApplicationRequestValidationError) Key() bool { return e.key }
+func (e SearchCatalogResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateApplicationRequestValidationError) ErrorName() string {
-	return "UpdateApplicationRequestValidationError"
+func (e SearchCatalogResponseValidationError) ErrorName() string {
+	return "SearchCatalogResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateApplicationRequestValidationError) Error() string {
+func (e SearchCatalogResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4506,14 +11199,14 @@ func (e UpdateApplicationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateApplicationRequest.%s: %s%s",
+		"invalid %sSearchCatalogResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateApplicationRequestValidationError{}
+var _ error = SearchCatalogResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4521,48 +11214,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateApplicationRequestValidationError{}
+} = SearchCatalogResponseValidationError{}
 
-// Validate checks the field values on DeleteApplicationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteApplicationRequest) Validate() error {
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteApplicationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteApplicationRequestMultiError, or nil if none found.
-func (m *DeleteApplicationRequest) ValidateAll() error {
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteApplicationRequest) validate(all bool) error {
+func (m *SearchResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApplicationName
+	// no validation rules for Name
 
 	// no validation rules for Version
 
+	// no validation rules for DisplayName
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchResultValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteApplicationRequestMultiError(errors)
+		return SearchResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteApplicationRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteApplicationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteApplicationRequestMultiError []error
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't met.
+type SearchResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteApplicationRequestMultiError) Error() string {
+func (m SearchResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4571,11 +11299,11 @@ func (m DeleteApplicationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteApplicationRequestMultiError) AllErrors() []error { return m }
+func (m SearchResultMultiError) AllErrors() []error { return m }
 
-// DeleteApplicationRequestValidationError is the validation error returned by
-// DeleteApplicationRequest.Validate if the designated constraints aren't met.
-type DeleteApplicationRequestValidationError struct {
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4583,24 +11311,22 @@ type DeleteApplicationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteApplicationRequestValidationError) Field() string { return e.field }
+func (e SearchResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteApplicationRequestValidationError) Reason() string { return e.reason }
+func (e SearchResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteApplicationRequestValidationError) Cause() error { return e.cause }
+func (e SearchResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteApplicationRequestValidationError) Key() bool { return e.key }
+func (e SearchResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteApplicationRequestValidationError) ErrorName() string {
-	return "DeleteApplicationRequestValidationError"
-}
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteApplicationRequestValidationError) Error() string {
+func (e SearchResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4612,14 +11338,14 @@ func (e DeleteApplicationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteApplicationRequest.%s: %s%s",
+		"invalid %sSearchResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteApplicationRequestValidationError{}
+var _ error = SearchResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -4627,48 +11353,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteApplicationRequestValidationError{}
+} = SearchResultValidationError{}
 
-// Validate checks the field values on WatchApplicationsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchApplicationsRequest) Validate() error {
+// Validate checks the field values on SearchHighlight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchHighlight) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchApplicationsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchHighlight with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchApplicationsRequestMultiError, or nil if none found.
-func (m *WatchApplicationsRequest) ValidateAll() error {
+// SearchHighlightMultiError, or nil if none found.
+func (m *SearchHighlight) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchApplicationsRequest) validate(all bool) error {
+func (m *SearchHighlight) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for Field
 
-	// no validation rules for NoReplay
+	// no validation rules for Fragment
 
 	if len(errors) > 0 {
-		return WatchApplicationsRequestMultiError(errors)
+		return SearchHighlightMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchApplicationsRequestMultiError is an error wrapping multiple validation
-// errors returned by WatchApplicationsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type WatchApplicationsRequestMultiError []error
+// SearchHighlightMultiError is an error wrapping multiple validation errors
+// returned by SearchHighlight.ValidateAll() if the designated constraints
+// aren't met.
+type SearchHighlightMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchApplicationsRequestMultiError) Error() string {
+func (m SearchHighlightMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4677,11 +11403,11 @@ func (m WatchApplicationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchApplicationsRequestMultiError) AllErrors() []error { return m }
+func (m SearchHighlightMultiError) AllErrors() []error { return m }
 
-// WatchApplicationsRequestValidationError is the validation error returned by
-// WatchApplicationsRequest.Validate if the designated constraints aren't met.
-type WatchApplicationsRequestValidationError struct {
+// SearchHighlightValidationError is the validation error returned by
+// SearchHighlight.Validate if the designated constraints aren't met.
+type SearchHighlightValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4689,24 +11415,22 @@ type WatchApplicationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchApplicationsRequestValidationError) Field() string { return e.field }
+func (e SearchHighlightValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchApplicationsRequestValidationError) Reason() string { return e.reason }
+func (e SearchHighlightValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchApplicationsRequestValidationError) Cause() error { return e.cause }
+func (e SearchHighlightValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchApplicationsRequestValidationError) Key() bool { return e.key }
+func (e SearchHighlightValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchApplicationsRequestValidationError) ErrorName() string {
-	return "WatchApplicationsRequestValidationError"
-}
+func (e SearchHighlightValidationError) ErrorName() string { return "SearchHighlightValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WatchApplicationsRequestValidationError) Error() string {
+func (e SearchHighlightValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4718,14 +11442,14 @@ func (e WatchApplicationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchApplicationsRequest.%s: %s%s",
+		"invalid %sSearchHighlight.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchApplicationsRequestValidationError{}
+var _ error = SearchHighlightValidationError{}
 
 var _ interface {
 	Field() string
@@ -4733,102 +11457,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchApplicationsRequestValidationError{}
+} = SearchHighlightValidationError{}
 
-// Validate checks the field values on WatchApplicationsResponse with the rules
+// Validate checks the field values on ListDeletedRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchApplicationsResponse) Validate() error {
+func (m *ListDeletedRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchApplicationsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListDeletedRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchApplicationsResponseMultiError, or nil if none found.
-func (m *WatchApplicationsResponse) ValidateAll() error {
+// ListDeletedRequestMultiError, or nil if none found.
+func (m *ListDeletedRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchApplicationsResponse) validate(all bool) error {
+func (m *ListDeletedRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchApplicationsResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchApplicationsResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchApplicationsResponseValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ResourceType
 
-	if all {
-		switch v := interface{}(m.GetApplication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchApplicationsResponseValidationError{
-					field:  "Application",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchApplicationsResponseValidationError{
-					field:  "Application",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchApplicationsResponseValidationError{
-				field:  "Application",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return WatchApplicationsResponseMultiError(errors)
+		return ListDeletedRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchApplicationsResponseMultiError is an error wrapping multiple validation
-// errors returned by WatchApplicationsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type WatchApplicationsResponseMultiError []error
+// ListDeletedRequestMultiError is an error wrapping multiple validation errors
+// returned by ListDeletedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListDeletedRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchApplicationsResponseMultiError) Error() string {
+func (m ListDeletedRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4837,11 +11509,11 @@ func (m WatchApplicationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchApplicationsResponseMultiError) AllErrors() []error { return m }
+func (m ListDeletedRequestMultiError) AllErrors() []error { return m }
 
-// WatchApplicationsResponseValidationError is the validation error returned by
-// WatchApplicationsResponse.Validate if the designated constraints aren't met.
-type WatchApplicationsResponseValidationError struct {
+// ListDeletedRequestValidationError is the validation error returned by
+// ListDeletedRequest.Validate if the designated constraints aren't met.
+type ListDeletedRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4849,24 +11521,24 @@ type WatchApplicationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchApplicationsResponseValidationError) Field() string { return e.field }
+func (e ListDeletedRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchApplicationsResponseValidationError) Reason() string { return e.reason }
+func (e ListDeletedRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchApplicationsResponseValidationError) Cause() error { return e.cause }
+func (e ListDeletedRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchApplicationsResponseValidationError) Key() bool { return e.key }
+func (e ListDeletedRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchApplicationsResponseValidationError) ErrorName() string {
-	return "WatchApplicationsResponseValidationError"
+func (e ListDeletedRequestValidationError) ErrorName() string {
+	return "ListDeletedRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchApplicationsResponseValidationError) Error() string {
+func (e ListDeletedRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4878,14 +11550,14 @@ func (e WatchApplicationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchApplicationsResponse.%s: %s%s",
+		"invalid %sListDeletedRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchApplicationsResponseValidationError{}
+var _ error = ListDeletedRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4893,73 +11565,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchApplicationsResponseValidationError{}
+} = ListDeletedRequestValidationError{}
 
-// Validate checks the field values on CreateArtifactRequest with the rules
+// Validate checks the field values on ListDeletedResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateArtifactRequest) Validate() error {
+func (m *ListDeletedResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateArtifactRequest with the rules
+// ValidateAll checks the field values on ListDeletedResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateArtifactRequestMultiError, or nil if none found.
-func (m *CreateArtifactRequest) ValidateAll() error {
+// ListDeletedResponseMultiError, or nil if none found.
+func (m *ListDeletedResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateArtifactRequest) validate(all bool) error {
+func (m *ListDeletedResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetArtifact()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateArtifactRequestValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetDeletedEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedResponseValidationError{
+						field:  fmt.Sprintf("DeletedEntities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedResponseValidationError{
+						field:  fmt.Sprintf("DeletedEntities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateArtifactRequestValidationError{
-					field:  "Artifact",
+				return ListDeletedResponseValidationError{
+					field:  fmt.Sprintf("DeletedEntities[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateArtifactRequestValidationError{
-				field:  "Artifact",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalElements
+
 	if len(errors) > 0 {
-		return CreateArtifactRequestMultiError(errors)
+		return ListDeletedResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateArtifactRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateArtifactRequest.ValidateAll() if the designated
+// ListDeletedResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateArtifactRequestMultiError []error
+type ListDeletedResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateArtifactRequestMultiError) Error() string {
+func (m ListDeletedResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4968,11 +11647,11 @@ func (m CreateArtifactRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateArtifactRequestMultiError) AllErrors() []error { return m }
-
-// CreateArtifactRequestValidationError is the validation error returned by
-// CreateArtifactRequest.Validate if the designated constraints aren't met.
-type CreateArtifactRequestValidationError struct {
+func (m ListDeletedResponseMultiError) AllErrors() []error { return m }
+
+// ListDeletedResponseValidationError is the validation error returned by
+// ListDeletedResponse.Validate if the designated constraints aren't met.
+type ListDeletedResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4980,24 +11659,24 @@ type CreateArtifactRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateArtifactRequestValidationError) Field() string { return e.field }
+func (e ListDeletedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateArtifactRequestValidationError) Reason() string { return e.reason }
+func (e ListDeletedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateArtifactRequestValidationError) Cause() error { return e.cause }
+func (e ListDeletedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateArtifactRequestValidationError) Key() bool { return e.key }
+func (e ListDeletedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateArtifactRequestValidationError) ErrorName() string {
-	return "CreateArtifactRequestValidationError"
+func (e ListDeletedResponseValidationError) ErrorName() string {
+	return "ListDeletedResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateArtifactRequestValidationError) Error() string {
+func (e ListDeletedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5009,14 +11688,14 @@ func (e CreateArtifactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateArtifactRequest.%s: %s%s",
+		"invalid %sListDeletedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateArtifactRequestValidationError{}
+var _ error = ListDeletedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5024,73 +11703,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateArtifactRequestValidationError{}
+} = ListDeletedResponseValidationError{}
 
-// Validate checks the field values on CreateArtifactResponse with the rules
+// Validate checks the field values on RestoreRegistryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateArtifactResponse) Validate() error {
+func (m *RestoreRegistryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateArtifactResponse with the rules
+// ValidateAll checks the field values on RestoreRegistryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateArtifactResponseMultiError, or nil if none found.
-func (m *CreateArtifactResponse) ValidateAll() error {
+// RestoreRegistryRequestMultiError, or nil if none found.
+func (m *RestoreRegistryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateArtifactResponse) validate(all bool) error {
+func (m *RestoreRegistryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetArtifact()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateArtifactResponseValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateArtifactResponseValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateArtifactResponseValidationError{
-				field:  "Artifact",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for RegistryName
 
 	if len(errors) > 0 {
-		return CreateArtifactResponseMultiError(errors)
+		return RestoreRegistryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateArtifactResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateArtifactResponse.ValidateAll() if the designated
+// RestoreRegistryRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreRegistryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateArtifactResponseMultiError []error
+type RestoreRegistryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateArtifactResponseMultiError) Error() string {
+func (m RestoreRegistryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5099,11 +11751,11 @@ func (m CreateArtifactResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateArtifactResponseMultiError) AllErrors() []error { return m }
+func (m RestoreRegistryRequestMultiError) AllErrors() []error { return m }
 
-// CreateArtifactResponseValidationError is the validation error returned by
-// CreateArtifactResponse.Validate if the designated constraints aren't met.
-type CreateArtifactResponseValidationError struct {
+// RestoreRegistryRequestValidationError is the validation error returned by
+// RestoreRegistryRequest.Validate if the designated constraints aren't met.
+type RestoreRegistryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5111,24 +11763,24 @@ type CreateArtifactResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateArtifactResponseValidationError) Field() string { return e.field }
+func (e RestoreRegistryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateArtifactResponseValidationError) Reason() string { return e.reason }
+func (e RestoreRegistryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateArtifactResponseValidationError) Cause() error { return e.cause }
+func (e RestoreRegistryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateArtifactResponseValidationError) Key() bool { return e.key }
+func (e RestoreRegistryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateArtifactResponseValidationError) ErrorName() string {
-	return "CreateArtifactResponseValidationError"
+func (e RestoreRegistryRequestValidationError) ErrorName() string {
+	return "RestoreRegistryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateArtifactResponseValidationError) Error() string {
+func (e RestoreRegistryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5140,14 +11792,14 @@ func (e CreateArtifactResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateArtifactResponse.%s: %s%s",
+		"invalid %sRestoreRegistryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateArtifactResponseValidationError{}
+var _ error = RestoreRegistryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5155,52 +11807,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateArtifactResponseValidationError{}
+} = RestoreRegistryRequestValidationError{}
 
-// Validate checks the field values on ListArtifactsRequest with the rules
+// Validate checks the field values on RestoreRegistryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListArtifactsRequest) Validate() error {
+func (m *RestoreRegistryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListArtifactsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RestoreRegistryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListArtifactsRequestMultiError, or nil if none found.
-func (m *ListArtifactsRequest) ValidateAll() error {
+// RestoreRegistryResponseMultiError, or nil if none found.
+func (m *RestoreRegistryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListArtifactsRequest) validate(all bool) error {
+func (m *RestoreRegistryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderBy
-
-	// no validation rules for Filter
-
-	// no validation rules for PageSize
-
-	// no validation rules for Offset
+	if all {
+		switch v := interface{}(m.GetRegistry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRegistryResponseValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRegistryResponseValidationError{
+					field:  "Registry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRegistryResponseValidationError{
+				field:  "Registry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListArtifactsRequestMultiError(errors)
+		return RestoreRegistryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListArtifactsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListArtifactsRequest.ValidateAll() if the designated
+// RestoreRegistryResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreRegistryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListArtifactsRequestMultiError []error
+type RestoreRegistryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListArtifactsRequestMultiError) Error() string {
+func (m RestoreRegistryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5209,11 +11882,11 @@ func (m ListArtifactsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListArtifactsRequestMultiError) AllErrors() []error { return m }
+func (m RestoreRegistryResponseMultiError) AllErrors() []error { return m }
 
-// ListArtifactsRequestValidationError is the validation error returned by
-// ListArtifactsRequest.Validate if the designated constraints aren't met.
-type ListArtifactsRequestValidationError struct {
+// RestoreRegistryResponseValidationError is the validation error returned by
+// RestoreRegistryResponse.Validate if the designated constraints aren't met.
+type RestoreRegistryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5221,24 +11894,24 @@ type ListArtifactsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListArtifactsRequestValidationError) Field() string { return e.field }
+func (e RestoreRegistryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListArtifactsRequestValidationError) Reason() string { return e.reason }
+func (e RestoreRegistryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListArtifactsRequestValidationError) Cause() error { return e.cause }
+func (e RestoreRegistryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListArtifactsRequestValidationError) Key() bool { return e.key }
+func (e RestoreRegistryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListArtifactsRequestValidationError) ErrorName() string {
-	return "ListArtifactsRequestValidationError"
+func (e RestoreRegistryResponseValidationError) ErrorName() string {
+	return "RestoreRegistryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListArtifactsRequestValidationError) Error() string {
+func (e RestoreRegistryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5250,14 +11923,14 @@ func (e ListArtifactsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListArtifactsRequest.%s: %s%s",
+		"invalid %sRestoreRegistryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListArtifactsRequestValidationError{}
+var _ error = RestoreRegistryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5265,80 +11938,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListArtifactsRequestValidationError{}
+} = RestoreRegistryResponseValidationError{}
 
-// Validate checks the field values on ListArtifactsResponse with the rules
+// Validate checks the field values on RestoreApplicationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListArtifactsResponse) Validate() error {
+func (m *RestoreApplicationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListArtifactsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RestoreApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListArtifactsResponseMultiError, or nil if none found.
-func (m *ListArtifactsResponse) ValidateAll() error {
+// RestoreApplicationRequestMultiError, or nil if none found.
+func (m *RestoreApplicationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListArtifactsResponse) validate(all bool) error {
+func (m *RestoreApplicationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetArtifacts() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListArtifactsResponseValidationError{
-						field:  fmt.Sprintf("Artifacts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListArtifactsResponseValidationError{
-						field:  fmt.Sprintf("Artifacts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListArtifactsResponseValidationError{
-					field:  fmt.Sprintf("Artifacts[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for ApplicationName
 
-	// no validation rules for TotalElements
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return ListArtifactsResponseMultiError(errors)
+		return RestoreApplicationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListArtifactsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListArtifactsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListArtifactsResponseMultiError []error
+// RestoreApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreApplicationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RestoreApplicationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListArtifactsResponseMultiError) Error() string {
+func (m RestoreApplicationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5347,11 +11988,11 @@ func (m ListArtifactsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListArtifactsResponseMultiError) AllErrors() []error { return m }
+func (m RestoreApplicationRequestMultiError) AllErrors() []error { return m }
 
-// ListArtifactsResponseValidationError is the validation error returned by
-// ListArtifactsResponse.Validate if the designated constraints aren't met.
-type ListArtifactsResponseValidationError struct {
+// RestoreApplicationRequestValidationError is the validation error returned by
+// RestoreApplicationRequest.Validate if the designated constraints aren't met.
+type RestoreApplicationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5359,24 +12000,24 @@ type ListArtifactsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListArtifactsResponseValidationError) Field() string { return e.field }
+func (e RestoreApplicationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListArtifactsResponseValidationError) Reason() string { return e.reason }
+func (e RestoreApplicationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListArtifactsResponseValidationError) Cause() error { return e.cause }
+func (e RestoreApplicationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListArtifactsResponseValidationError) Key() bool { return e.key }
+func (e RestoreApplicationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListArtifactsResponseValidationError) ErrorName() string {
-	return "ListArtifactsResponseValidationError"
+func (e RestoreApplicationRequestValidationError) ErrorName() string {
+	return "RestoreApplicationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListArtifactsResponseValidationError) Error() string {
+func (e RestoreApplicationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5388,14 +12029,14 @@ func (e ListArtifactsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListArtifactsResponse.%s: %s%s",
+		"invalid %sRestoreApplicationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListArtifactsResponseValidationError{}
+var _ error = RestoreApplicationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5403,46 +12044,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListArtifactsResponseValidationError{}
+} = RestoreApplicationRequestValidationError{}
 
-// Validate checks the field values on GetArtifactRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RestoreApplicationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetArtifactRequest) Validate() error {
+func (m *RestoreApplicationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetArtifactRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RestoreApplicationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetArtifactRequestMultiError, or nil if none found.
-func (m *GetArtifactRequest) ValidateAll() error {
+// RestoreApplicationResponseMultiError, or nil if none found.
+func (m *RestoreApplicationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetArtifactRequest) validate(all bool) error {
+func (m *RestoreApplicationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ArtifactName
+	if all {
+		switch v := interface{}(m.GetApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreApplicationResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreApplicationResponseValidationError{
+					field:  "Application",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreApplicationResponseValidationError{
+				field:  "Application",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetArtifactRequestMultiError(errors)
+		return RestoreApplicationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetArtifactRequestMultiError is an error wrapping multiple validation errors
-// returned by GetArtifactRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetArtifactRequestMultiError []error
+// RestoreApplicationResponseMultiError is an error wrapping multiple
+// validation errors returned by RestoreApplicationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreApplicationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetArtifactRequestMultiError) Error() string {
+func (m RestoreApplicationResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5451,11 +12119,11 @@ func (m GetArtifactRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetArtifactRequestMultiError) AllErrors() []error { return m }
+func (m RestoreApplicationResponseMultiError) AllErrors() []error { return m }
 
-// GetArtifactRequestValidationError is the validation error returned by
-// GetArtifactRequest.Validate if the designated constraints aren't met.
-type GetArtifactRequestValidationError struct {
+// RestoreApplicationResponseValidationError is the validation error returned
+// by RestoreApplicationResponse.Validate if the designated constraints aren't met.
+type RestoreApplicationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5463,24 +12131,24 @@ type GetArtifactRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetArtifactRequestValidationError) Field() string { return e.field }
+func (e RestoreApplicationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetArtifactRequestValidationError) Reason() string { return e.reason }
+func (e RestoreApplicationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetArtifactRequestValidationError) Cause() error { return e.cause }
+func (e RestoreApplicationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetArtifactRequestValidationError) Key() bool { return e.key }
+func (e RestoreApplicationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetArtifactRequestValidationError) ErrorName() string {
-	return "GetArtifactRequestValidationError"
+func (e RestoreApplicationResponseValidationError) ErrorName() string {
+	return "RestoreApplicationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetArtifactRequestValidationError) Error() string {
+func (e RestoreApplicationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5492,14 +12160,14 @@ func (e GetArtifactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetArtifactRequest.%s: %s%s",
+		"invalid %sRestoreApplicationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetArtifactRequestValidationError{}
+var _ error = RestoreApplicationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5507,73 +12175,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetArtifactRequestValidationError{}
+} = RestoreApplicationResponseValidationError{}
 
-// Validate checks the field values on GetArtifactResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RestoreDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetArtifactResponse) Validate() error {
+func (m *RestoreDeploymentPackageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetArtifactResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetArtifactResponseMultiError, or nil if none found.
-func (m *GetArtifactResponse) ValidateAll() error {
+// ValidateAll checks the field values on RestoreDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RestoreDeploymentPackageRequestMultiError, or nil if none found.
+func (m *RestoreDeploymentPackageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetArtifactResponse) validate(all bool) error {
+func (m *RestoreDeploymentPackageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetArtifact()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetArtifactResponseValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetArtifactResponseValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetArtifactResponseValidationError{
-				field:  "Artifact",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DeploymentPackageName
+
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return GetArtifactResponseMultiError(errors)
+		return RestoreDeploymentPackageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetArtifactResponseMultiError is an error wrapping multiple validation
-// errors returned by GetArtifactResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetArtifactResponseMultiError []error
+// RestoreDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RestoreDeploymentPackageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetArtifactResponseMultiError) Error() string {
+func (m RestoreDeploymentPackageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5582,11 +12225,12 @@ func (m GetArtifactResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetArtifactResponseMultiError) AllErrors() []error { return m }
+func (m RestoreDeploymentPackageRequestMultiError) AllErrors() []error { return m }
 
-// GetArtifactResponseValidationError is the validation error returned by
-// GetArtifactResponse.Validate if the designated constraints aren't met.
-type GetArtifactResponseValidationError struct {
+// RestoreDeploymentPackageRequestValidationError is the validation error
+// returned by RestoreDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type RestoreDeploymentPackageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5594,24 +12238,24 @@ type GetArtifactResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetArtifactResponseValidationError) Field() string { return e.field }
+func (e RestoreDeploymentPackageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetArtifactResponseValidationError) Reason() string { return e.reason }
+func (e RestoreDeploymentPackageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetArtifactResponseValidationError) Cause() error { return e.cause }
+func (e RestoreDeploymentPackageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetArtifactResponseValidationError) Key() bool { return e.key }
+func (e RestoreDeploymentPackageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetArtifactResponseValidationError) ErrorName() string {
-	return "GetArtifactResponseValidationError"
+func (e RestoreDeploymentPackageRequestValidationError) ErrorName() string {
+	return "RestoreDeploymentPackageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetArtifactResponseValidationError) Error() string {
+func (e RestoreDeploymentPackageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5623,14 +12267,14 @@ func (e GetArtifactResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetArtifactResponse.%s: %s%s",
+		"invalid %sRestoreDeploymentPackageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetArtifactResponseValidationError{}
+var _ error = RestoreDeploymentPackageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5638,55 +12282,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetArtifactResponseValidationError{}
+} = RestoreDeploymentPackageRequestValidationError{}
 
-// Validate checks the field values on UpdateArtifactRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateArtifactRequest) Validate() error {
+// Validate checks the field values on RestoreDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RestoreDeploymentPackageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateArtifactRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateArtifactRequestMultiError, or nil if none found.
-func (m *UpdateArtifactRequest) ValidateAll() error {
+// ValidateAll checks the field values on RestoreDeploymentPackageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RestoreDeploymentPackageResponseMultiError, or nil if none found.
+func (m *RestoreDeploymentPackageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateArtifactRequest) validate(all bool) error {
+func (m *RestoreDeploymentPackageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ArtifactName
-
 	if all {
-		switch v := interface{}(m.GetArtifact()).(type) {
+		switch v := interface{}(m.GetDeploymentPackage()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateArtifactRequestValidationError{
-					field:  "Artifact",
+				errors = append(errors, RestoreDeploymentPackageResponseValidationError{
+					field:  "DeploymentPackage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateArtifactRequestValidationError{
-					field:  "Artifact",
+				errors = append(errors, RestoreDeploymentPackageResponseValidationError{
+					field:  "DeploymentPackage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDeploymentPackage()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateArtifactRequestValidationError{
-				field:  "Artifact",
+			return RestoreDeploymentPackageResponseValidationError{
+				field:  "DeploymentPackage",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5694,19 +12337,20 @@ func (m *UpdateArtifactRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateArtifactRequestMultiError(errors)
+		return RestoreDeploymentPackageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateArtifactRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateArtifactRequest.ValidateAll() if the designated
+// RestoreDeploymentPackageResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RestoreDeploymentPackageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateArtifactRequestMultiError []error
+type RestoreDeploymentPackageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateArtifactRequestMultiError) Error() string {
+func (m RestoreDeploymentPackageResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5715,11 +12359,12 @@ func (m UpdateArtifactRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateArtifactRequestMultiError) AllErrors() []error { return m }
+func (m RestoreDeploymentPackageResponseMultiError) AllErrors() []error { return m }
 
-// UpdateArtifactRequestValidationError is the validation error returned by
-// UpdateArtifactRequest.Validate if the designated constraints aren't met.
-type UpdateArtifactRequestValidationError struct {
+// RestoreDeploymentPackageResponseValidationError is the validation error
+// returned by RestoreDeploymentPackageResponse.Validate if the designated
+// constraints aren't met.
+type RestoreDeploymentPackageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5727,24 +12372,24 @@ type UpdateArtifactRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateArtifactRequestValidationError) Field() string { return e.field }
+func (e RestoreDeploymentPackageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateArtifactRequestValidationError) Reason() string { return e.reason }
+func (e RestoreDeploymentPackageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateArtifactRequestValidationError) Cause() error { return e.cause }
+func (e RestoreDeploymentPackageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateArtifactRequestValidationError) Key() bool { return e.key }
+func (e RestoreDeploymentPackageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateArtifactRequestValidationError) ErrorName() string {
-	return "UpdateArtifactRequestValidationError"
+func (e RestoreDeploymentPackageResponseValidationError) ErrorName() string {
+	return "RestoreDeploymentPackageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateArtifactRequestValidationError) Error() string {
+func (e RestoreDeploymentPackageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5756,14 +12401,14 @@ func (e UpdateArtifactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateArtifactRequest.%s: %s%s",
+		"invalid %sRestoreDeploymentPackageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateArtifactRequestValidationError{}
+var _ error = RestoreDeploymentPackageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5771,46 +12416,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateArtifactRequestValidationError{}
+} = RestoreDeploymentPackageResponseValidationError{}
 
-// Validate checks the field values on DeleteArtifactRequest with the rules
+// Validate checks the field values on PurgeRegistryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteArtifactRequest) Validate() error {
+func (m *PurgeRegistryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteArtifactRequest with the rules
+// ValidateAll checks the field values on PurgeRegistryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteArtifactRequestMultiError, or nil if none found.
-func (m *DeleteArtifactRequest) ValidateAll() error {
+// PurgeRegistryRequestMultiError, or nil if none found.
+func (m *PurgeRegistryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteArtifactRequest) validate(all bool) error {
+func (m *PurgeRegistryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ArtifactName
+	// no validation rules for RegistryName
 
 	if len(errors) > 0 {
-		return DeleteArtifactRequestMultiError(errors)
+		return PurgeRegistryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteArtifactRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteArtifactRequest.ValidateAll() if the designated
+// PurgeRegistryRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeRegistryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteArtifactRequestMultiError []error
+type PurgeRegistryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteArtifactRequestMultiError) Error() string {
+func (m PurgeRegistryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5819,11 +12464,11 @@ func (m DeleteArtifactRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteArtifactRequestMultiError) AllErrors() []error { return m }
+func (m PurgeRegistryRequestMultiError) AllErrors() []error { return m }
 
-// DeleteArtifactRequestValidationError is the validation error returned by
-// DeleteArtifactRequest.Validate if the designated constraints aren't met.
-type DeleteArtifactRequestValidationError struct {
+// PurgeRegistryRequestValidationError is the validation error returned by
+// PurgeRegistryRequest.Validate if the designated constraints aren't met.
+type PurgeRegistryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5831,24 +12476,24 @@ type DeleteArtifactRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteArtifactRequestValidationError) Field() string { return e.field }
+func (e PurgeRegistryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteArtifactRequestValidationError) Reason() string { return e.reason }
+func (e PurgeRegistryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteArtifactRequestValidationError) Cause() error { return e.cause }
+func (e PurgeRegistryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteArtifactRequestValidationError) Key() bool { return e.key }
+func (e PurgeRegistryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteArtifactRequestValidationError) ErrorName() string {
-	return "DeleteArtifactRequestValidationError"
+func (e PurgeRegistryRequestValidationError) ErrorName() string {
+	return "PurgeRegistryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteArtifactRequestValidationError) Error() string {
+func (e PurgeRegistryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5860,14 +12505,14 @@ func (e DeleteArtifactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteArtifactRequest.%s: %s%s",
+		"invalid %sPurgeRegistryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteArtifactRequestValidationError{}
+var _ error = PurgeRegistryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5875,48 +12520,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteArtifactRequestValidationError{}
+} = PurgeRegistryRequestValidationError{}
 
-// Validate checks the field values on WatchArtifactsRequest with the rules
+// Validate checks the field values on PurgeApplicationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchArtifactsRequest) Validate() error {
+func (m *PurgeApplicationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchArtifactsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PurgeApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchArtifactsRequestMultiError, or nil if none found.
-func (m *WatchArtifactsRequest) ValidateAll() error {
+// PurgeApplicationRequestMultiError, or nil if none found.
+func (m *PurgeApplicationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchArtifactsRequest) validate(all bool) error {
+func (m *PurgeApplicationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for ApplicationName
 
-	// no validation rules for NoReplay
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return WatchArtifactsRequestMultiError(errors)
+		return PurgeApplicationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchArtifactsRequestMultiError is an error wrapping multiple validation
-// errors returned by WatchArtifactsRequest.ValidateAll() if the designated
+// PurgeApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeApplicationRequest.ValidateAll() if the designated
 // constraints aren't met.
-type WatchArtifactsRequestMultiError []error
+type PurgeApplicationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchArtifactsRequestMultiError) Error() string {
+func (m PurgeApplicationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5925,11 +12570,11 @@ func (m WatchArtifactsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchArtifactsRequestMultiError) AllErrors() []error { return m }
+func (m PurgeApplicationRequestMultiError) AllErrors() []error { return m }
 
-// WatchArtifactsRequestValidationError is the validation error returned by
-// WatchArtifactsRequest.Validate if the designated constraints aren't met.
-type WatchArtifactsRequestValidationError struct {
+// PurgeApplicationRequestValidationError is the validation error returned by
+// PurgeApplicationRequest.Validate if the designated constraints aren't met.
+type PurgeApplicationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5937,24 +12582,24 @@ type WatchArtifactsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchArtifactsRequestValidationError) Field() string { return e.field }
+func (e PurgeApplicationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchArtifactsRequestValidationError) Reason() string { return e.reason }
+func (e PurgeApplicationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchArtifactsRequestValidationError) Cause() error { return e.cause }
+func (e PurgeApplicationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchArtifactsRequestValidationError) Key() bool { return e.key }
+func (e PurgeApplicationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchArtifactsRequestValidationError) ErrorName() string {
-	return "WatchArtifactsRequestValidationError"
+func (e PurgeApplicationRequestValidationError) ErrorName() string {
+	return "PurgeApplicationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchArtifactsRequestValidationError) Error() string {
+func (e PurgeApplicationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5966,14 +12611,14 @@ func (e WatchArtifactsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchArtifactsRequest.%s: %s%s",
+		"invalid %sPurgeApplicationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchArtifactsRequestValidationError{}
+var _ error = PurgeApplicationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5981,102 +12626,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchArtifactsRequestValidationError{}
+} = PurgeApplicationRequestValidationError{}
 
-// Validate checks the field values on WatchArtifactsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PurgeDeploymentPackageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchArtifactsResponse) Validate() error {
+func (m *PurgeDeploymentPackageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchArtifactsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WatchArtifactsResponseMultiError, or nil if none found.
-func (m *WatchArtifactsResponse) ValidateAll() error {
+// ValidateAll checks the field values on PurgeDeploymentPackageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PurgeDeploymentPackageRequestMultiError, or nil if none found.
+func (m *PurgeDeploymentPackageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchArtifactsResponse) validate(all bool) error {
+func (m *PurgeDeploymentPackageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchArtifactsResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchArtifactsResponseValidationError{
-					field:  "Event",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchArtifactsResponseValidationError{
-				field:  "Event",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DeploymentPackageName
 
-	if all {
-		switch v := interface{}(m.GetArtifact()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchArtifactsResponseValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchArtifactsResponseValidationError{
-					field:  "Artifact",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchArtifactsResponseValidationError{
-				field:  "Artifact",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return WatchArtifactsResponseMultiError(errors)
+		return PurgeDeploymentPackageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchArtifactsResponseMultiError is an error wrapping multiple validation
-// errors returned by WatchArtifactsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type WatchArtifactsResponseMultiError []error
+// PurgeDeploymentPackageRequestMultiError is an error wrapping multiple
+// validation errors returned by PurgeDeploymentPackageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PurgeDeploymentPackageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchArtifactsResponseMultiError) Error() string {
+func (m PurgeDeploymentPackageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6085,11 +12676,12 @@ func (m WatchArtifactsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchArtifactsResponseMultiError) AllErrors() []error { return m }
+func (m PurgeDeploymentPackageRequestMultiError) AllErrors() []error { return m }
 
-// WatchArtifactsResponseValidationError is the validation error returned by
-// WatchArtifactsResponse.Validate if the designated constraints aren't met.
-type WatchArtifactsResponseValidationError struct {
+// PurgeDeploymentPackageRequestValidationError is the validation error
+// returned by PurgeDeploymentPackageRequest.Validate if the designated
+// constraints aren't met.
+type PurgeDeploymentPackageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6097,24 +12689,24 @@ type WatchArtifactsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchArtifactsResponseValidationError) Field() string { return e.field }
+func (e PurgeDeploymentPackageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchArtifactsResponseValidationError) Reason() string { return e.reason }
+func (e PurgeDeploymentPackageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchArtifactsResponseValidationError) Cause() error { return e.cause }
+func (e PurgeDeploymentPackageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchArtifactsResponseValidationError) Key() bool { return e.key }
+func (e PurgeDeploymentPackageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchArtifactsResponseValidationError) ErrorName() string {
-	return "WatchArtifactsResponseValidationError"
+func (e PurgeDeploymentPackageRequestValidationError) ErrorName() string {
+	return "PurgeDeploymentPackageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchArtifactsResponseValidationError) Error() string {
+func (e PurgeDeploymentPackageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6126,14 +12718,14 @@ func (e WatchArtifactsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchArtifactsResponse.%s: %s%s",
+		"invalid %sPurgeDeploymentPackageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchArtifactsResponseValidationError{}
+var _ error = PurgeDeploymentPackageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6141,4 +12733,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchArtifactsResponseValidationError{}
+} = PurgeDeploymentPackageRequestValidationError{}
@@ -29,16 +29,26 @@ type CatalogServiceClient interface {
 	UploadCatalogEntities(ctx context.Context, in *UploadCatalogEntitiesRequest, opts ...grpc.CallOption) (*UploadCatalogEntitiesResponse, error)
 	// Creates a new registry.
 	CreateRegistry(ctx context.Context, in *CreateRegistryRequest, opts ...grpc.CallOption) (*CreateRegistryResponse, error)
+	// Gets a list of the charts hosted by a registry, through the inventory URL of the registry, which must not be
+	// internal to the network of the catalog, unless allowed by the operator.
+	ListRegistryCharts(ctx context.Context, in *ListRegistryChartsRequest, opts ...grpc.CallOption) (*ListRegistryChartsResponse, error)
+	// Gets a list of the versions of a chart hosted by a registry, through the inventory URL of the registry, which must
+	// not be internal to the network of the catalog, unless allowed by the operator.
+	ListChartVersions(ctx context.Context, in *ListChartVersionsRequest, opts ...grpc.CallOption) (*ListChartVersionsResponse, error)
 	// Gets a list of registries.
 	ListRegistries(ctx context.Context, in *ListRegistriesRequest, opts ...grpc.CallOption) (*ListRegistriesResponse, error)
 	// Gets a specific registry.
 	GetRegistry(ctx context.Context, in *GetRegistryRequest, opts ...grpc.CallOption) (*GetRegistryResponse, error)
 	// Updates a registry.
 	UpdateRegistry(ctx context.Context, in *UpdateRegistryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Deletes a registry.
+	// Deletes a registry, moving it to the trash.
 	DeleteRegistry(ctx context.Context, in *DeleteRegistryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Watches inventory of registries for changes.
 	WatchRegistries(ctx context.Context, in *WatchRegistriesRequest, opts ...grpc.CallOption) (CatalogService_WatchRegistriesClient, error)
+	// Tests the connection to a stored registry, or to a registry yet to be saved, by authenticating against it using its
+	// credentials and CA certificates. The root URL of the registry must not be internal to the network of the catalog,
+	// unless allowed by the operator.
+	TestRegistryConnection(ctx context.Context, in *TestRegistryConnectionRequest, opts ...grpc.CallOption) (*TestRegistryConnectionResponse, error)
 	// Creates a new deployment package.
 	CreateDeploymentPackage(ctx context.Context, in *CreateDeploymentPackageRequest, opts ...grpc.CallOption) (*CreateDeploymentPackageResponse, error)
 	// Gets a list of deployment packages.
@@ -47,9 +57,15 @@ type CatalogServiceClient interface {
 	GetDeploymentPackage(ctx context.Context, in *GetDeploymentPackageRequest, opts ...grpc.CallOption) (*GetDeploymentPackageResponse, error)
 	// Gets all versions of a named deployment package.
 	GetDeploymentPackageVersions(ctx context.Context, in *GetDeploymentPackageVersionsRequest, opts ...grpc.CallOption) (*GetDeploymentPackageVersionsResponse, error)
+	// Resolves the version constraints of a deployment package to the application and deployment package versions they
+	// match; deployed deployment packages return the resolution pinned when they were deployed.
+	ResolveDeploymentPackage(ctx context.Context, in *ResolveDeploymentPackageRequest, opts ...grpc.CallOption) (*ResolveDeploymentPackageResponse, error)
+	// Exports a deployment package, along with the applications, registries and artifacts it depends on, as a
+	// gzipped tarball of YAML files that can be loaded into another project using UploadCatalogEntities.
+	ExportDeploymentPackage(ctx context.Context, in *ExportDeploymentPackageRequest, opts ...grpc.CallOption) (*ExportDeploymentPackageResponse, error)
 	// Updates a deployment package.
-	UpdateDeploymentPackage(ctx context.Context, in *UpdateDeploymentPackageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Deletes a deployment package.
+	UpdateDeploymentPackage(ctx context.Context, in *UpdateDeploymentPackageRequest, opts ...grpc.CallOption) (*UpdateDeploymentPackageResponse, error)
+	// Deletes a deployment package, moving it to the trash.
 	DeleteDeploymentPackage(ctx context.Context, in *DeleteDeploymentPackageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Watches inventory of deployment packages for changes.
 	WatchDeploymentPackages(ctx context.Context, in *WatchDeploymentPackagesRequest, opts ...grpc.CallOption) (CatalogService_WatchDeploymentPackagesClient, error)
@@ -65,7 +81,7 @@ type CatalogServiceClient interface {
 	GetApplicationVersions(ctx context.Context, in *GetApplicationVersionsRequest, opts ...grpc.CallOption) (*GetApplicationVersionsResponse, error)
 	// Updates an application.
 	UpdateApplication(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Deletes an application.
+	// Deletes an application, moving it to the trash.
 	DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Watches inventory of applications for changes.
 	WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (CatalogService_WatchApplicationsClient, error)
@@ -81,6 +97,46 @@ type CatalogServiceClient interface {
 	DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Watches inventory of artifacts for changes.
 	WatchArtifacts(ctx context.Context, in *WatchArtifactsRequest, opts ...grpc.CallOption) (CatalogService_WatchArtifactsClient, error)
+	// Creates a new webhook.
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// Gets a list of webhooks.
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Gets a specific webhook.
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error)
+	// Updates a webhook.
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Deletes a webhook, along with its delivery log.
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the log of deliveries made to a webhook, most recent first.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Gets a list of the changes made to the catalog entities, most recent first.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Gets a list of the revisions of an application or a deployment package, most recent first.
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	// Gets a specific revision of an application or a deployment package, including the state of the entity.
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	// Restores an application or a deployment package to the state held by the given revision, recording a new
+	// revision. Deployed deployment packages, and applications that are part of them, cannot be restored.
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+	// Searches the names, display names and descriptions of applications, deployment packages and registries, along with
+	// the chart names and profile descriptions of applications and the UI extension labels of deployment packages.
+	// The matches are ranked by relevance, highlighted and grouped by entity type.
+	SearchCatalog(ctx context.Context, in *SearchCatalogRequest, opts ...grpc.CallOption) (*SearchCatalogResponse, error)
+	// Gets a list of the deleted registries, applications and deployment packages held in the trash, most recently
+	// deleted first. Deleted entities are purged once their retention period expires.
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error)
+	// Restores a deleted registry from the trash.
+	RestoreRegistry(ctx context.Context, in *RestoreRegistryRequest, opts ...grpc.CallOption) (*RestoreRegistryResponse, error)
+	// Restores a deleted application from the trash. Its registries must exist.
+	RestoreApplication(ctx context.Context, in *RestoreApplicationRequest, opts ...grpc.CallOption) (*RestoreApplicationResponse, error)
+	// Restores a deleted deployment package from the trash. The applications it references must exist.
+	RestoreDeploymentPackage(ctx context.Context, in *RestoreDeploymentPackageRequest, opts ...grpc.CallOption) (*RestoreDeploymentPackageResponse, error)
+	// Permanently removes a deleted registry from the trash, along with its credentials.
+	PurgeRegistry(ctx context.Context, in *PurgeRegistryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Permanently removes a deleted application from the trash.
+	PurgeApplication(ctx context.Context, in *PurgeApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Permanently removes a deleted deployment package from the trash.
+	PurgeDeploymentPackage(ctx context.Context, in *PurgeDeploymentPackageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type catalogServiceClient struct {
@@ -109,6 +165,24 @@ func (c *catalogServiceClient) CreateRegistry(ctx context.Context, in *CreateReg
 	return out, nil
 }
 
+func (c *catalogServiceClient) ListRegistryCharts(ctx context.Context, in *ListRegistryChartsRequest, opts ...grpc.CallOption) (*ListRegistryChartsResponse, error) {
+	out := new(ListRegistryChartsResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListRegistryCharts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListChartVersions(ctx context.Context, in *ListChartVersionsRequest, opts ...grpc.CallOption) (*ListChartVersionsResponse, error) {
+	out := new(ListChartVersionsResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListChartVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) ListRegistries(ctx context.Context, in *ListRegistriesRequest, opts ...grpc.CallOption) (*ListRegistriesResponse, error) {
 	out := new(ListRegistriesResponse)
 	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListRegistries", in, out, opts...)
@@ -177,6 +251,15 @@ func (x *catalogServiceWatchRegistriesClient) Recv() (*WatchRegistriesResponse,
 	return m, nil
 }
 
+func (c *catalogServiceClient) TestRegistryConnection(ctx context.Context, in *TestRegistryConnectionRequest, opts ...grpc.CallOption) (*TestRegistryConnectionResponse, error) {
+	out := new(TestRegistryConnectionResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/TestRegistryConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) CreateDeploymentPackage(ctx context.Context, in *CreateDeploymentPackageRequest, opts ...grpc.CallOption) (*CreateDeploymentPackageResponse, error) {
 	out := new(CreateDeploymentPackageResponse)
 	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/CreateDeploymentPackage", in, out, opts...)
@@ -213,8 +296,26 @@ func (c *catalogServiceClient) GetDeploymentPackageVersions(ctx context.Context,
 	return out, nil
 }
 
-func (c *catalogServiceClient) UpdateDeploymentPackage(ctx context.Context, in *UpdateDeploymentPackageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *catalogServiceClient) ResolveDeploymentPackage(ctx context.Context, in *ResolveDeploymentPackageRequest, opts ...grpc.CallOption) (*ResolveDeploymentPackageResponse, error) {
+	out := new(ResolveDeploymentPackageResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ResolveDeploymentPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ExportDeploymentPackage(ctx context.Context, in *ExportDeploymentPackageRequest, opts ...grpc.CallOption) (*ExportDeploymentPackageResponse, error) {
+	out := new(ExportDeploymentPackageResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ExportDeploymentPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateDeploymentPackage(ctx context.Context, in *UpdateDeploymentPackageRequest, opts ...grpc.CallOption) (*UpdateDeploymentPackageResponse, error) {
+	out := new(UpdateDeploymentPackageResponse)
 	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/UpdateDeploymentPackage", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -435,6 +536,168 @@ func (x *catalogServiceWatchArtifactsClient) Recv() (*WatchArtifactsResponse, er
 	return m, nil
 }
 
+func (c *catalogServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error) {
+	out := new(GetWebhookResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/GetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/UpdateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	out := new(RestoreRevisionResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SearchCatalog(ctx context.Context, in *SearchCatalogRequest, opts ...grpc.CallOption) (*SearchCatalogResponse, error) {
+	out := new(SearchCatalogResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/SearchCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error) {
+	out := new(ListDeletedResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RestoreRegistry(ctx context.Context, in *RestoreRegistryRequest, opts ...grpc.CallOption) (*RestoreRegistryResponse, error) {
+	out := new(RestoreRegistryResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/RestoreRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RestoreApplication(ctx context.Context, in *RestoreApplicationRequest, opts ...grpc.CallOption) (*RestoreApplicationResponse, error) {
+	out := new(RestoreApplicationResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/RestoreApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RestoreDeploymentPackage(ctx context.Context, in *RestoreDeploymentPackageRequest, opts ...grpc.CallOption) (*RestoreDeploymentPackageResponse, error) {
+	out := new(RestoreDeploymentPackageResponse)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/RestoreDeploymentPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) PurgeRegistry(ctx context.Context, in *PurgeRegistryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/PurgeRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) PurgeApplication(ctx context.Context, in *PurgeApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/PurgeApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) PurgeDeploymentPackage(ctx context.Context, in *PurgeDeploymentPackageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/catalog.v3.CatalogService/PurgeDeploymentPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CatalogServiceServer is the server API for CatalogService service.
 // All implementations should embed UnimplementedCatalogServiceServer
 // for forward compatibility
@@ -445,16 +708,26 @@ type CatalogServiceServer interface {
 	UploadCatalogEntities(context.Context, *UploadCatalogEntitiesRequest) (*UploadCatalogEntitiesResponse, error)
 	// Creates a new registry.
 	CreateRegistry(context.Context, *CreateRegistryRequest) (*CreateRegistryResponse, error)
+	// Gets a list of the charts hosted by a registry, through the inventory URL of the registry, which must not be
+	// internal to the network of the catalog, unless allowed by the operator.
+	ListRegistryCharts(context.Context, *ListRegistryChartsRequest) (*ListRegistryChartsResponse, error)
+	// Gets a list of the versions of a chart hosted by a registry, through the inventory URL of the registry, which must
+	// not be internal to the network of the catalog, unless allowed by the operator.
+	ListChartVersions(context.Context, *ListChartVersionsRequest) (*ListChartVersionsResponse, error)
 	// Gets a list of registries.
 	ListRegistries(context.Context, *ListRegistriesRequest) (*ListRegistriesResponse, error)
 	// Gets a specific registry.
 	GetRegistry(context.Context, *GetRegistryRequest) (*GetRegistryResponse, error)
 	// Updates a registry.
 	UpdateRegistry(context.Context, *UpdateRegistryRequest) (*emptypb.Empty, error)
-	// Deletes a registry.
+	// Deletes a registry, moving it to the trash.
 	DeleteRegistry(context.Context, *DeleteRegistryRequest) (*emptypb.Empty, error)
 	// Watches inventory of registries for changes.
 	WatchRegistries(*WatchRegistriesRequest, CatalogService_WatchRegistriesServer) error
+	// Tests the connection to a stored registry, or to a registry yet to be saved, by authenticating against it using its
+	// credentials and CA certificates. The root URL of the registry must not be internal to the network of the catalog,
+	// unless allowed by the operator.
+	TestRegistryConnection(context.Context, *TestRegistryConnectionRequest) (*TestRegistryConnectionResponse, error)
 	// Creates a new deployment package.
 	CreateDeploymentPackage(context.Context, *CreateDeploymentPackageRequest) (*CreateDeploymentPackageResponse, error)
 	// Gets a list of deployment packages.
@@ -463,9 +736,15 @@ type CatalogServiceServer interface {
 	GetDeploymentPackage(context.Context, *GetDeploymentPackageRequest) (*GetDeploymentPackageResponse, error)
 	// Gets all versions of a named deployment package.
 	GetDeploymentPackageVersions(context.Context, *GetDeploymentPackageVersionsRequest) (*GetDeploymentPackageVersionsResponse, error)
+	// Resolves the version constraints of a deployment package to the application and deployment package versions they
+	// match; deployed deployment packages return the resolution pinned when they were deployed.
+	ResolveDeploymentPackage(context.Context, *ResolveDeploymentPackageRequest) (*ResolveDeploymentPackageResponse, error)
+	// Exports a deployment package, along with the applications, registries and artifacts it depends on, as a
+	// gzipped tarball of YAML files that can be loaded into another project using UploadCatalogEntities.
+	ExportDeploymentPackage(context.Context, *ExportDeploymentPackageRequest) (*ExportDeploymentPackageResponse, error)
 	// Updates a deployment package.
-	UpdateDeploymentPackage(context.Context, *UpdateDeploymentPackageRequest) (*emptypb.Empty, error)
-	// Deletes a deployment package.
+	UpdateDeploymentPackage(context.Context, *UpdateDeploymentPackageRequest) (*UpdateDeploymentPackageResponse, error)
+	// Deletes a deployment package, moving it to the trash.
 	DeleteDeploymentPackage(context.Context, *DeleteDeploymentPackageRequest) (*emptypb.Empty, error)
 	// Watches inventory of deployment packages for changes.
 	WatchDeploymentPackages(*WatchDeploymentPackagesRequest, CatalogService_WatchDeploymentPackagesServer) error
@@ -481,7 +760,7 @@ type CatalogServiceServer interface {
 	GetApplicationVersions(context.Context, *GetApplicationVersionsRequest) (*GetApplicationVersionsResponse, error)
 	// Updates an application.
 	UpdateApplication(context.Context, *UpdateApplicationRequest) (*emptypb.Empty, error)
-	// Deletes an application.
+	// Deletes an application, moving it to the trash.
 	DeleteApplication(context.Context, *DeleteApplicationRequest) (*emptypb.Empty, error)
 	// Watches inventory of applications for changes.
 	WatchApplications(*WatchApplicationsRequest, CatalogService_WatchApplicationsServer) error
@@ -497,6 +776,46 @@ type CatalogServiceServer interface {
 	DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error)
 	// Watches inventory of artifacts for changes.
 	WatchArtifacts(*WatchArtifactsRequest, CatalogService_WatchArtifactsServer) error
+	// Creates a new webhook.
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// Gets a list of webhooks.
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Gets a specific webhook.
+	GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	// Updates a webhook.
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*emptypb.Empty, error)
+	// Deletes a webhook, along with its delivery log.
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// Gets the log of deliveries made to a webhook, most recent first.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Gets a list of the changes made to the catalog entities, most recent first.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Gets a list of the revisions of an application or a deployment package, most recent first.
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	// Gets a specific revision of an application or a deployment package, including the state of the entity.
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	// Restores an application or a deployment package to the state held by the given revision, recording a new
+	// revision. Deployed deployment packages, and applications that are part of them, cannot be restored.
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	// Searches the names, display names and descriptions of applications, deployment packages and registries, along with
+	// the chart names and profile descriptions of applications and the UI extension labels of deployment packages.
+	// The matches are ranked by relevance, highlighted and grouped by entity type.
+	SearchCatalog(context.Context, *SearchCatalogRequest) (*SearchCatalogResponse, error)
+	// Gets a list of the deleted registries, applications and deployment packages held in the trash, most recently
+	// deleted first. Deleted entities are purged once their retention period expires.
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error)
+	// Restores a deleted registry from the trash.
+	RestoreRegistry(context.Context, *RestoreRegistryRequest) (*RestoreRegistryResponse, error)
+	// Restores a deleted application from the trash. Its registries must exist.
+	RestoreApplication(context.Context, *RestoreApplicationRequest) (*RestoreApplicationResponse, error)
+	// Restores a deleted deployment package from the trash. The applications it references must exist.
+	RestoreDeploymentPackage(context.Context, *RestoreDeploymentPackageRequest) (*RestoreDeploymentPackageResponse, error)
+	// Permanently removes a deleted registry from the trash, along with its credentials.
+	PurgeRegistry(context.Context, *PurgeRegistryRequest) (*emptypb.Empty, error)
+	// Permanently removes a deleted application from the trash.
+	PurgeApplication(context.Context, *PurgeApplicationRequest) (*emptypb.Empty, error)
+	// Permanently removes a deleted deployment package from the trash.
+	PurgeDeploymentPackage(context.Context, *PurgeDeploymentPackageRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedCatalogServiceServer should be embedded to have forward compatible implementations.
@@ -509,6 +828,12 @@ func (UnimplementedCatalogServiceServer) UploadCatalogEntities(context.Context,
 func (UnimplementedCatalogServiceServer) CreateRegistry(context.Context, *CreateRegistryRequest) (*CreateRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRegistry not implemented")
 }
+func (UnimplementedCatalogServiceServer) ListRegistryCharts(context.Context, *ListRegistryChartsRequest) (*ListRegistryChartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegistryCharts not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListChartVersions(context.Context, *ListChartVersionsRequest) (*ListChartVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChartVersions not implemented")
+}
 func (UnimplementedCatalogServiceServer) ListRegistries(context.Context, *ListRegistriesRequest) (*ListRegistriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRegistries not implemented")
 }
@@ -524,6 +849,9 @@ func (UnimplementedCatalogServiceServer) DeleteRegistry(context.Context, *Delete
 func (UnimplementedCatalogServiceServer) WatchRegistries(*WatchRegistriesRequest, CatalogService_WatchRegistriesServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchRegistries not implemented")
 }
+func (UnimplementedCatalogServiceServer) TestRegistryConnection(context.Context, *TestRegistryConnectionRequest) (*TestRegistryConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestRegistryConnection not implemented")
+}
 func (UnimplementedCatalogServiceServer) CreateDeploymentPackage(context.Context, *CreateDeploymentPackageRequest) (*CreateDeploymentPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeploymentPackage not implemented")
 }
@@ -536,7 +864,13 @@ func (UnimplementedCatalogServiceServer) GetDeploymentPackage(context.Context, *
 func (UnimplementedCatalogServiceServer) GetDeploymentPackageVersions(context.Context, *GetDeploymentPackageVersionsRequest) (*GetDeploymentPackageVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentPackageVersions not implemented")
 }
-func (UnimplementedCatalogServiceServer) UpdateDeploymentPackage(context.Context, *UpdateDeploymentPackageRequest) (*emptypb.Empty, error) {
+func (UnimplementedCatalogServiceServer) ResolveDeploymentPackage(context.Context, *ResolveDeploymentPackageRequest) (*ResolveDeploymentPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDeploymentPackage not implemented")
+}
+func (UnimplementedCatalogServiceServer) ExportDeploymentPackage(context.Context, *ExportDeploymentPackageRequest) (*ExportDeploymentPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDeploymentPackage not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateDeploymentPackage(context.Context, *UpdateDeploymentPackageRequest) (*UpdateDeploymentPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeploymentPackage not implemented")
 }
 func (UnimplementedCatalogServiceServer) DeleteDeploymentPackage(context.Context, *DeleteDeploymentPackageRequest) (*emptypb.Empty, error) {
@@ -587,6 +921,60 @@ func (UnimplementedCatalogServiceServer) DeleteArtifact(context.Context, *Delete
 func (UnimplementedCatalogServiceServer) WatchArtifacts(*WatchArtifactsRequest, CatalogService_WatchArtifactsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchArtifacts not implemented")
 }
+func (UnimplementedCatalogServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedCatalogServiceServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedCatalogServiceServer) SearchCatalog(context.Context, *SearchCatalogRequest) (*SearchCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCatalog not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (UnimplementedCatalogServiceServer) RestoreRegistry(context.Context, *RestoreRegistryRequest) (*RestoreRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRegistry not implemented")
+}
+func (UnimplementedCatalogServiceServer) RestoreApplication(context.Context, *RestoreApplicationRequest) (*RestoreApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreApplication not implemented")
+}
+func (UnimplementedCatalogServiceServer) RestoreDeploymentPackage(context.Context, *RestoreDeploymentPackageRequest) (*RestoreDeploymentPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDeploymentPackage not implemented")
+}
+func (UnimplementedCatalogServiceServer) PurgeRegistry(context.Context, *PurgeRegistryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeRegistry not implemented")
+}
+func (UnimplementedCatalogServiceServer) PurgeApplication(context.Context, *PurgeApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeApplication not implemented")
+}
+func (UnimplementedCatalogServiceServer) PurgeDeploymentPackage(context.Context, *PurgeDeploymentPackageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeploymentPackage not implemented")
+}
 
 // UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CatalogServiceServer will
@@ -635,6 +1023,42 @@ func _CatalogService_CreateRegistry_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_ListRegistryCharts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistryChartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListRegistryCharts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListRegistryCharts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListRegistryCharts(ctx, req.(*ListRegistryChartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListChartVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChartVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListChartVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListChartVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListChartVersions(ctx, req.(*ListChartVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_ListRegistries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRegistriesRequest)
 	if err := dec(in); err != nil {
@@ -728,6 +1152,24 @@ func (x *catalogServiceWatchRegistriesServer) Send(m *WatchRegistriesResponse) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CatalogService_TestRegistryConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRegistryConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).TestRegistryConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/TestRegistryConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).TestRegistryConnection(ctx, req.(*TestRegistryConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_CreateDeploymentPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDeploymentPackageRequest)
 	if err := dec(in); err != nil {
@@ -800,6 +1242,42 @@ func _CatalogService_GetDeploymentPackageVersions_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_ResolveDeploymentPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDeploymentPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ResolveDeploymentPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ResolveDeploymentPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ResolveDeploymentPackage(ctx, req.(*ResolveDeploymentPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ExportDeploymentPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDeploymentPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ExportDeploymentPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ExportDeploymentPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ExportDeploymentPackage(ctx, req.(*ExportDeploymentPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_UpdateDeploymentPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateDeploymentPackageRequest)
 	if err := dec(in); err != nil {
@@ -1115,6 +1593,330 @@ func (x *catalogServiceWatchArtifactsServer) Send(m *WatchArtifactsResponse) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CatalogService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/GetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/UpdateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/GetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/RestoreRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SearchCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SearchCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/SearchCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SearchCatalog(ctx, req.(*SearchCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RestoreRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/RestoreRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreRegistry(ctx, req.(*RestoreRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RestoreApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/RestoreApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreApplication(ctx, req.(*RestoreApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RestoreDeploymentPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDeploymentPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreDeploymentPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/RestoreDeploymentPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreDeploymentPackage(ctx, req.(*RestoreDeploymentPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_PurgeRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PurgeRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/PurgeRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PurgeRegistry(ctx, req.(*PurgeRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_PurgeApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PurgeApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/PurgeApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PurgeApplication(ctx, req.(*PurgeApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_PurgeDeploymentPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeploymentPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).PurgeDeploymentPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/catalog.v3.CatalogService/PurgeDeploymentPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).PurgeDeploymentPackage(ctx, req.(*PurgeDeploymentPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1130,6 +1932,14 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRegistry",
 			Handler:    _CatalogService_CreateRegistry_Handler,
 		},
+		{
+			MethodName: "ListRegistryCharts",
+			Handler:    _CatalogService_ListRegistryCharts_Handler,
+		},
+		{
+			MethodName: "ListChartVersions",
+			Handler:    _CatalogService_ListChartVersions_Handler,
+		},
 		{
 			MethodName: "ListRegistries",
 			Handler:    _CatalogService_ListRegistries_Handler,
@@ -1146,6 +1956,10 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRegistry",
 			Handler:    _CatalogService_DeleteRegistry_Handler,
 		},
+		{
+			MethodName: "TestRegistryConnection",
+			Handler:    _CatalogService_TestRegistryConnection_Handler,
+		},
 		{
 			MethodName: "CreateDeploymentPackage",
 			Handler:    _CatalogService_CreateDeploymentPackage_Handler,
@@ -1162,6 +1976,14 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeploymentPackageVersions",
 			Handler:    _CatalogService_GetDeploymentPackageVersions_Handler,
 		},
+		{
+			MethodName: "ResolveDeploymentPackage",
+			Handler:    _CatalogService_ResolveDeploymentPackage_Handler,
+		},
+		{
+			MethodName: "ExportDeploymentPackage",
+			Handler:    _CatalogService_ExportDeploymentPackage_Handler,
+		},
 		{
 			MethodName: "UpdateDeploymentPackage",
 			Handler:    _CatalogService_UpdateDeploymentPackage_Handler,
@@ -1218,6 +2040,78 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArtifact",
 			Handler:    _CatalogService_DeleteArtifact_Handler,
 		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _CatalogService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _CatalogService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _CatalogService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _CatalogService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _CatalogService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _CatalogService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _CatalogService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _CatalogService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _CatalogService_GetRevision_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _CatalogService_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "SearchCatalog",
+			Handler:    _CatalogService_SearchCatalog_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _CatalogService_ListDeleted_Handler,
+		},
+		{
+			MethodName: "RestoreRegistry",
+			Handler:    _CatalogService_RestoreRegistry_Handler,
+		},
+		{
+			MethodName: "RestoreApplication",
+			Handler:    _CatalogService_RestoreApplication_Handler,
+		},
+		{
+			MethodName: "RestoreDeploymentPackage",
+			Handler:    _CatalogService_RestoreDeploymentPackage_Handler,
+		},
+		{
+			MethodName: "PurgeRegistry",
+			Handler:    _CatalogService_PurgeRegistry_Handler,
+		},
+		{
+			MethodName: "PurgeApplication",
+			Handler:    _CatalogService_PurgeApplication_Handler,
+		},
+		{
+			MethodName: "PurgeDeploymentPackage",
+			Handler:    _CatalogService_PurgeDeploymentPackage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -101,15 +101,15 @@ type ClientInterface interface {
 	CatalogServiceGetApplicationVersions(ctx context.Context, applicationName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceDeleteApplication request
-	CatalogServiceDeleteApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceDeleteApplication(ctx context.Context, applicationName string, version string, params *CatalogServiceDeleteApplicationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceGetApplication request
 	CatalogServiceGetApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceUpdateApplicationWithBody request with any body
-	CatalogServiceUpdateApplicationWithBody(ctx context.Context, applicationName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateApplicationWithBody(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CatalogServiceUpdateApplication(ctx context.Context, applicationName string, version string, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateApplication(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceGetApplicationReferenceCount request
 	CatalogServiceGetApplicationReferenceCount(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -123,15 +123,39 @@ type ClientInterface interface {
 	CatalogServiceCreateArtifact(ctx context.Context, body CatalogServiceCreateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceDeleteArtifact request
-	CatalogServiceDeleteArtifact(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceDeleteArtifact(ctx context.Context, artifactName string, params *CatalogServiceDeleteArtifactParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceGetArtifact request
 	CatalogServiceGetArtifact(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceUpdateArtifactWithBody request with any body
-	CatalogServiceUpdateArtifactWithBody(ctx context.Context, artifactName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateArtifactWithBody(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CatalogServiceUpdateArtifact(ctx context.Context, artifactName string, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateArtifact(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListAuditEvents request
+	CatalogServiceListAuditEvents(ctx context.Context, params *CatalogServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListDeleted request
+	CatalogServiceListDeleted(ctx context.Context, params *CatalogServiceListDeletedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServicePurgeApplication request
+	CatalogServicePurgeApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceRestoreApplication request
+	CatalogServiceRestoreApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServicePurgeDeploymentPackage request
+	CatalogServicePurgeDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceRestoreDeploymentPackage request
+	CatalogServiceRestoreDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServicePurgeRegistry request
+	CatalogServicePurgeRegistry(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceRestoreRegistry request
+	CatalogServiceRestoreRegistry(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceListDeploymentPackages request
 	CatalogServiceListDeploymentPackages(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -145,15 +169,18 @@ type ClientInterface interface {
 	CatalogServiceGetDeploymentPackageVersions(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceDeleteDeploymentPackage request
-	CatalogServiceDeleteDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceDeleteDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceDeleteDeploymentPackageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceGetDeploymentPackage request
 	CatalogServiceGetDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceUpdateDeploymentPackageWithBody request with any body
-	CatalogServiceUpdateDeploymentPackageWithBody(ctx context.Context, deploymentPackageName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateDeploymentPackageWithBody(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CatalogServiceUpdateDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceResolveDeploymentPackage request
+	CatalogServiceResolveDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceListRegistries request
 	CatalogServiceListRegistries(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -164,20 +191,65 @@ type ClientInterface interface {
 	CatalogServiceCreateRegistry(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceDeleteRegistry request
-	CatalogServiceDeleteRegistry(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceDeleteRegistry(ctx context.Context, registryName string, params *CatalogServiceDeleteRegistryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceGetRegistry request
 	CatalogServiceGetRegistry(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceUpdateRegistryWithBody request with any body
-	CatalogServiceUpdateRegistryWithBody(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CatalogServiceUpdateRegistryWithBody(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CatalogServiceUpdateRegistry(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListRegistryCharts request
+	CatalogServiceListRegistryCharts(ctx context.Context, registryName string, params *CatalogServiceListRegistryChartsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListChartVersions request
+	CatalogServiceListChartVersions(ctx context.Context, registryName string, chartName string, params *CatalogServiceListChartVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceTestRegistryConnectionWithBody request with any body
+	CatalogServiceTestRegistryConnectionWithBody(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CatalogServiceTestRegistryConnection(ctx context.Context, registryName string, body CatalogServiceTestRegistryConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListRevisions request
+	CatalogServiceListRevisions(ctx context.Context, resourceType string, name string, version string, params *CatalogServiceListRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceGetRevision request
+	CatalogServiceGetRevision(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CatalogServiceUpdateRegistry(ctx context.Context, registryName string, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CatalogServiceRestoreRevision request
+	CatalogServiceRestoreRevision(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceSearchCatalog request
+	CatalogServiceSearchCatalog(ctx context.Context, params *CatalogServiceSearchCatalogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CatalogServiceUploadCatalogEntitiesWithBody request with any body
 	CatalogServiceUploadCatalogEntitiesWithBody(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CatalogServiceUploadCatalogEntities(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListWebhooks request
+	CatalogServiceListWebhooks(ctx context.Context, params *CatalogServiceListWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceCreateWebhookWithBody request with any body
+	CatalogServiceCreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CatalogServiceCreateWebhook(ctx context.Context, body CatalogServiceCreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceDeleteWebhook request
+	CatalogServiceDeleteWebhook(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceGetWebhook request
+	CatalogServiceGetWebhook(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceUpdateWebhookWithBody request with any body
+	CatalogServiceUpdateWebhookWithBody(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CatalogServiceUpdateWebhook(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, body CatalogServiceUpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CatalogServiceListWebhookDeliveries request
+	CatalogServiceListWebhookDeliveries(ctx context.Context, webhookName string, params *CatalogServiceListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) CatalogServiceListApplications(ctx context.Context, params *CatalogServiceListApplicationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -228,8 +300,8 @@ func (c *Client) CatalogServiceGetApplicationVersions(ctx context.Context, appli
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceDeleteApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceDeleteApplicationRequest(c.Server, applicationName, version)
+func (c *Client) CatalogServiceDeleteApplication(ctx context.Context, applicationName string, version string, params *CatalogServiceDeleteApplicationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceDeleteApplicationRequest(c.Server, applicationName, version, params)
 	if err != nil {
 		return nil, err
 	}
@@ -252,8 +324,8 @@ func (c *Client) CatalogServiceGetApplication(ctx context.Context, applicationNa
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateApplicationWithBody(ctx context.Context, applicationName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateApplicationRequestWithBody(c.Server, applicationName, version, contentType, body)
+func (c *Client) CatalogServiceUpdateApplicationWithBody(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateApplicationRequestWithBody(c.Server, applicationName, version, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -264,8 +336,8 @@ func (c *Client) CatalogServiceUpdateApplicationWithBody(ctx context.Context, ap
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateApplication(ctx context.Context, applicationName string, version string, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateApplicationRequest(c.Server, applicationName, version, body)
+func (c *Client) CatalogServiceUpdateApplication(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateApplicationRequest(c.Server, applicationName, version, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -324,8 +396,8 @@ func (c *Client) CatalogServiceCreateArtifact(ctx context.Context, body CatalogS
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceDeleteArtifact(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceDeleteArtifactRequest(c.Server, artifactName)
+func (c *Client) CatalogServiceDeleteArtifact(ctx context.Context, artifactName string, params *CatalogServiceDeleteArtifactParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceDeleteArtifactRequest(c.Server, artifactName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -348,8 +420,8 @@ func (c *Client) CatalogServiceGetArtifact(ctx context.Context, artifactName str
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateArtifactWithBody(ctx context.Context, artifactName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateArtifactRequestWithBody(c.Server, artifactName, contentType, body)
+func (c *Client) CatalogServiceUpdateArtifactWithBody(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateArtifactRequestWithBody(c.Server, artifactName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -360,8 +432,8 @@ func (c *Client) CatalogServiceUpdateArtifactWithBody(ctx context.Context, artif
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateArtifact(ctx context.Context, artifactName string, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateArtifactRequest(c.Server, artifactName, body)
+func (c *Client) CatalogServiceUpdateArtifact(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateArtifactRequest(c.Server, artifactName, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -372,8 +444,8 @@ func (c *Client) CatalogServiceUpdateArtifact(ctx context.Context, artifactName
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceListDeploymentPackages(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceListDeploymentPackagesRequest(c.Server, params)
+func (c *Client) CatalogServiceListAuditEvents(ctx context.Context, params *CatalogServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListAuditEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -384,8 +456,8 @@ func (c *Client) CatalogServiceListDeploymentPackages(ctx context.Context, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceCreateDeploymentPackageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceCreateDeploymentPackageRequestWithBody(c.Server, contentType, body)
+func (c *Client) CatalogServiceListDeleted(ctx context.Context, params *CatalogServiceListDeletedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListDeletedRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -396,8 +468,8 @@ func (c *Client) CatalogServiceCreateDeploymentPackageWithBody(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceCreateDeploymentPackage(ctx context.Context, body CatalogServiceCreateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceCreateDeploymentPackageRequest(c.Server, body)
+func (c *Client) CatalogServicePurgeApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServicePurgeApplicationRequest(c.Server, applicationName, version)
 	if err != nil {
 		return nil, err
 	}
@@ -408,8 +480,8 @@ func (c *Client) CatalogServiceCreateDeploymentPackage(ctx context.Context, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceGetDeploymentPackageVersions(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceGetDeploymentPackageVersionsRequest(c.Server, deploymentPackageName)
+func (c *Client) CatalogServiceRestoreApplication(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceRestoreApplicationRequest(c.Server, applicationName, version)
 	if err != nil {
 		return nil, err
 	}
@@ -420,8 +492,8 @@ func (c *Client) CatalogServiceGetDeploymentPackageVersions(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceDeleteDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceDeleteDeploymentPackageRequest(c.Server, deploymentPackageName, version)
+func (c *Client) CatalogServicePurgeDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServicePurgeDeploymentPackageRequest(c.Server, deploymentPackageName, version)
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +504,8 @@ func (c *Client) CatalogServiceDeleteDeploymentPackage(ctx context.Context, depl
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceGetDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceGetDeploymentPackageRequest(c.Server, deploymentPackageName, version)
+func (c *Client) CatalogServiceRestoreDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceRestoreDeploymentPackageRequest(c.Server, deploymentPackageName, version)
 	if err != nil {
 		return nil, err
 	}
@@ -444,8 +516,8 @@ func (c *Client) CatalogServiceGetDeploymentPackage(ctx context.Context, deploym
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateDeploymentPackageWithBody(ctx context.Context, deploymentPackageName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateDeploymentPackageRequestWithBody(c.Server, deploymentPackageName, version, contentType, body)
+func (c *Client) CatalogServicePurgeRegistry(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServicePurgeRegistryRequest(c.Server, registryName)
 	if err != nil {
 		return nil, err
 	}
@@ -456,8 +528,8 @@ func (c *Client) CatalogServiceUpdateDeploymentPackageWithBody(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateDeploymentPackageRequest(c.Server, deploymentPackageName, version, body)
+func (c *Client) CatalogServiceRestoreRegistry(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceRestoreRegistryRequest(c.Server, registryName)
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +540,8 @@ func (c *Client) CatalogServiceUpdateDeploymentPackage(ctx context.Context, depl
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceListRegistries(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceListRegistriesRequest(c.Server, params)
+func (c *Client) CatalogServiceListDeploymentPackages(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListDeploymentPackagesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -480,8 +552,8 @@ func (c *Client) CatalogServiceListRegistries(ctx context.Context, params *Catal
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceCreateRegistryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceCreateRegistryRequestWithBody(c.Server, contentType, body)
+func (c *Client) CatalogServiceCreateDeploymentPackageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceCreateDeploymentPackageRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +564,8 @@ func (c *Client) CatalogServiceCreateRegistryWithBody(ctx context.Context, conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceCreateRegistry(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceCreateRegistryRequest(c.Server, body)
+func (c *Client) CatalogServiceCreateDeploymentPackage(ctx context.Context, body CatalogServiceCreateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceCreateDeploymentPackageRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +576,8 @@ func (c *Client) CatalogServiceCreateRegistry(ctx context.Context, body CatalogS
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceDeleteRegistry(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceDeleteRegistryRequest(c.Server, registryName)
+func (c *Client) CatalogServiceGetDeploymentPackageVersions(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceGetDeploymentPackageVersionsRequest(c.Server, deploymentPackageName)
 	if err != nil {
 		return nil, err
 	}
@@ -516,8 +588,8 @@ func (c *Client) CatalogServiceDeleteRegistry(ctx context.Context, registryName
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceGetRegistry(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceGetRegistryRequest(c.Server, registryName, params)
+func (c *Client) CatalogServiceDeleteDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceDeleteDeploymentPackageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceDeleteDeploymentPackageRequest(c.Server, deploymentPackageName, version, params)
 	if err != nil {
 		return nil, err
 	}
@@ -528,8 +600,8 @@ func (c *Client) CatalogServiceGetRegistry(ctx context.Context, registryName str
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateRegistryWithBody(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateRegistryRequestWithBody(c.Server, registryName, contentType, body)
+func (c *Client) CatalogServiceGetDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceGetDeploymentPackageRequest(c.Server, deploymentPackageName, version)
 	if err != nil {
 		return nil, err
 	}
@@ -540,8 +612,8 @@ func (c *Client) CatalogServiceUpdateRegistryWithBody(ctx context.Context, regis
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUpdateRegistry(ctx context.Context, registryName string, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUpdateRegistryRequest(c.Server, registryName, body)
+func (c *Client) CatalogServiceUpdateDeploymentPackageWithBody(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateDeploymentPackageRequestWithBody(c.Server, deploymentPackageName, version, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -552,8 +624,8 @@ func (c *Client) CatalogServiceUpdateRegistry(ctx context.Context, registryName
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUploadCatalogEntitiesWithBody(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUploadCatalogEntitiesRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) CatalogServiceUpdateDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateDeploymentPackageRequest(c.Server, deploymentPackageName, version, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +636,8 @@ func (c *Client) CatalogServiceUploadCatalogEntitiesWithBody(ctx context.Context
 	return c.Client.Do(req)
 }
 
-func (c *Client) CatalogServiceUploadCatalogEntities(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCatalogServiceUploadCatalogEntitiesRequest(c.Server, params, body)
+func (c *Client) CatalogServiceResolveDeploymentPackage(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceResolveDeploymentPackageRequest(c.Server, deploymentPackageName, version)
 	if err != nil {
 		return nil, err
 	}
@@ -576,372 +648,308 @@ func (c *Client) CatalogServiceUploadCatalogEntities(ctx context.Context, params
 	return c.Client.Do(req)
 }
 
-// NewCatalogServiceListApplicationsRequest generates requests for CatalogServiceListApplications
-func NewCatalogServiceListApplicationsRequest(server string, params *CatalogServiceListApplicationsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CatalogServiceListRegistries(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListRegistriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceCreateRegistryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceCreateRegistryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Kinds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceCreateApplicationRequest calls the generic CatalogServiceCreateApplication builder with application/json body
-func NewCatalogServiceCreateApplicationRequest(server string, body CatalogServiceCreateApplicationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CatalogServiceCreateRegistry(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceCreateRegistryRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceCreateApplicationRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceCreateApplicationRequestWithBody generates requests for CatalogServiceCreateApplication with any type of body
-func NewCatalogServiceCreateApplicationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CatalogServiceDeleteRegistry(ctx context.Context, registryName string, params *CatalogServiceDeleteRegistryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceDeleteRegistryRequest(c.Server, registryName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceGetRegistry(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceGetRegistryRequest(c.Server, registryName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceGetApplicationVersionsRequest generates requests for CatalogServiceGetApplicationVersions
-func NewCatalogServiceGetApplicationVersionsRequest(server string, applicationName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+func (c *Client) CatalogServiceUpdateRegistryWithBody(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateRegistryRequestWithBody(c.Server, registryName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceUpdateRegistry(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateRegistryRequest(c.Server, registryName, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceDeleteApplicationRequest generates requests for CatalogServiceDeleteApplication
-func NewCatalogServiceDeleteApplicationRequest(server string, applicationName string, version string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+func (c *Client) CatalogServiceListRegistryCharts(ctx context.Context, registryName string, params *CatalogServiceListRegistryChartsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListRegistryChartsRequest(c.Server, registryName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CatalogServiceListChartVersions(ctx context.Context, registryName string, chartName string, params *CatalogServiceListChartVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListChartVersionsRequest(c.Server, registryName, chartName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceTestRegistryConnectionWithBody(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceTestRegistryConnectionRequestWithBody(c.Server, registryName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceGetApplicationRequest generates requests for CatalogServiceGetApplication
-func NewCatalogServiceGetApplicationRequest(server string, applicationName string, version string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+func (c *Client) CatalogServiceTestRegistryConnection(ctx context.Context, registryName string, body CatalogServiceTestRegistryConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceTestRegistryConnectionRequest(c.Server, registryName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CatalogServiceListRevisions(ctx context.Context, resourceType string, name string, version string, params *CatalogServiceListRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListRevisionsRequest(c.Server, resourceType, name, version, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceGetRevision(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceGetRevisionRequest(c.Server, resourceType, name, version, revision)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CatalogServiceRestoreRevision(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceRestoreRevisionRequest(c.Server, resourceType, name, version, revision)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceUpdateApplicationRequest calls the generic CatalogServiceUpdateApplication builder with application/json body
-func NewCatalogServiceUpdateApplicationRequest(server string, applicationName string, version string, body CatalogServiceUpdateApplicationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CatalogServiceSearchCatalog(ctx context.Context, params *CatalogServiceSearchCatalogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceSearchCatalogRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceUpdateApplicationRequestWithBody(server, applicationName, version, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceUpdateApplicationRequestWithBody generates requests for CatalogServiceUpdateApplication with any type of body
-func NewCatalogServiceUpdateApplicationRequestWithBody(server string, applicationName string, version string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+func (c *Client) CatalogServiceUploadCatalogEntitiesWithBody(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUploadCatalogEntitiesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+func (c *Client) CatalogServiceUploadCatalogEntities(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUploadCatalogEntitiesRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CatalogServiceListWebhooks(ctx context.Context, params *CatalogServiceListWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListWebhooksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceCreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceCreateWebhookRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) CatalogServiceCreateWebhook(ctx context.Context, body CatalogServiceCreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceCreateWebhookRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceGetApplicationReferenceCountRequest generates requests for CatalogServiceGetApplicationReferenceCount
-func NewCatalogServiceGetApplicationReferenceCountRequest(server string, applicationName string, version string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+func (c *Client) CatalogServiceDeleteWebhook(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceDeleteWebhookRequest(c.Server, webhookName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+func (c *Client) CatalogServiceGetWebhook(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceGetWebhookRequest(c.Server, webhookName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CatalogServiceUpdateWebhookWithBody(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateWebhookRequestWithBody(c.Server, webhookName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s/reference_count", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CatalogServiceUpdateWebhook(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, body CatalogServiceUpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceUpdateWebhookRequest(c.Server, webhookName, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CatalogServiceListWebhookDeliveries(ctx context.Context, webhookName string, params *CatalogServiceListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCatalogServiceListWebhookDeliveriesRequest(c.Server, webhookName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCatalogServiceListArtifactsRequest generates requests for CatalogServiceListArtifacts
-func NewCatalogServiceListArtifactsRequest(server string, params *CatalogServiceListArtifactsParams) (*http.Request, error) {
+// NewCatalogServiceListApplicationsRequest generates requests for CatalogServiceListApplications
+func NewCatalogServiceListApplicationsRequest(server string, params *CatalogServiceListApplicationsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -949,7 +957,7 @@ func NewCatalogServiceListArtifactsRequest(server string, params *CatalogService
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1026,6 +1034,86 @@ func NewCatalogServiceListArtifactsRequest(server string, params *CatalogService
 
 		}
 
+		if params.Kinds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SkipTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skipTotal", runtime.ParamLocationQuery, *params.SkipTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeDeprecated != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "excludeDeprecated", runtime.ParamLocationQuery, *params.ExcludeDeprecated); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -1037,19 +1125,19 @@ func NewCatalogServiceListArtifactsRequest(server string, params *CatalogService
 	return req, nil
 }
 
-// NewCatalogServiceCreateArtifactRequest calls the generic CatalogServiceCreateArtifact builder with application/json body
-func NewCatalogServiceCreateArtifactRequest(server string, body CatalogServiceCreateArtifactJSONRequestBody) (*http.Request, error) {
+// NewCatalogServiceCreateApplicationRequest calls the generic CatalogServiceCreateApplication builder with application/json body
+func NewCatalogServiceCreateApplicationRequest(server string, body CatalogServiceCreateApplicationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceCreateArtifactRequestWithBody(server, "application/json", bodyReader)
+	return NewCatalogServiceCreateApplicationRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCatalogServiceCreateArtifactRequestWithBody generates requests for CatalogServiceCreateArtifact with any type of body
-func NewCatalogServiceCreateArtifactRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCatalogServiceCreateApplicationRequestWithBody generates requests for CatalogServiceCreateApplication with any type of body
+func NewCatalogServiceCreateApplicationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1057,7 +1145,7 @@ func NewCatalogServiceCreateArtifactRequestWithBody(server string, contentType s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1077,13 +1165,13 @@ func NewCatalogServiceCreateArtifactRequestWithBody(server string, contentType s
 	return req, nil
 }
 
-// NewCatalogServiceDeleteArtifactRequest generates requests for CatalogServiceDeleteArtifact
-func NewCatalogServiceDeleteArtifactRequest(server string, artifactName string) (*http.Request, error) {
+// NewCatalogServiceGetApplicationVersionsRequest generates requests for CatalogServiceGetApplicationVersions
+func NewCatalogServiceGetApplicationVersionsRequest(server string, applicationName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "artifactName", runtime.ParamLocationPath, artifactName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
 	if err != nil {
 		return nil, err
 	}
@@ -1093,7 +1181,48 @@ func NewCatalogServiceDeleteArtifactRequest(server string, artifactName string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceDeleteApplicationRequest generates requests for CatalogServiceDeleteApplication
+func NewCatalogServiceDeleteApplicationRequest(server string, applicationName string, version string, params *CatalogServiceDeleteApplicationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1103,6 +1232,28 @@ func NewCatalogServiceDeleteArtifactRequest(server string, artifactName string)
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1111,13 +1262,20 @@ func NewCatalogServiceDeleteArtifactRequest(server string, artifactName string)
 	return req, nil
 }
 
-// NewCatalogServiceGetArtifactRequest generates requests for CatalogServiceGetArtifact
-func NewCatalogServiceGetArtifactRequest(server string, artifactName string) (*http.Request, error) {
+// NewCatalogServiceGetApplicationRequest generates requests for CatalogServiceGetApplication
+func NewCatalogServiceGetApplicationRequest(server string, applicationName string, version string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "artifactName", runtime.ParamLocationPath, artifactName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
 	if err != nil {
 		return nil, err
 	}
@@ -1127,7 +1285,7 @@ func NewCatalogServiceGetArtifactRequest(server string, artifactName string) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1145,24 +1303,31 @@ func NewCatalogServiceGetArtifactRequest(server string, artifactName string) (*h
 	return req, nil
 }
 
-// NewCatalogServiceUpdateArtifactRequest calls the generic CatalogServiceUpdateArtifact builder with application/json body
-func NewCatalogServiceUpdateArtifactRequest(server string, artifactName string, body CatalogServiceUpdateArtifactJSONRequestBody) (*http.Request, error) {
+// NewCatalogServiceUpdateApplicationRequest calls the generic CatalogServiceUpdateApplication builder with application/json body
+func NewCatalogServiceUpdateApplicationRequest(server string, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, body CatalogServiceUpdateApplicationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceUpdateArtifactRequestWithBody(server, artifactName, "application/json", bodyReader)
+	return NewCatalogServiceUpdateApplicationRequestWithBody(server, applicationName, version, params, "application/json", bodyReader)
 }
 
-// NewCatalogServiceUpdateArtifactRequestWithBody generates requests for CatalogServiceUpdateArtifact with any type of body
-func NewCatalogServiceUpdateArtifactRequestWithBody(server string, artifactName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCatalogServiceUpdateApplicationRequestWithBody generates requests for CatalogServiceUpdateApplication with any type of body
+func NewCatalogServiceUpdateApplicationRequestWithBody(server string, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "artifactName", runtime.ParamLocationPath, artifactName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
 	if err != nil {
 		return nil, err
 	}
@@ -1172,7 +1337,7 @@ func NewCatalogServiceUpdateArtifactRequestWithBody(server string, artifactName
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1182,6 +1347,44 @@ func NewCatalogServiceUpdateArtifactRequestWithBody(server string, artifactName
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UpdateMask != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateMask", runtime.ParamLocationQuery, *params.UpdateMask); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -1192,16 +1395,57 @@ func NewCatalogServiceUpdateArtifactRequestWithBody(server string, artifactName
 	return req, nil
 }
 
-// NewCatalogServiceListDeploymentPackagesRequest generates requests for CatalogServiceListDeploymentPackages
-func NewCatalogServiceListDeploymentPackagesRequest(server string, params *CatalogServiceListDeploymentPackagesParams) (*http.Request, error) {
+// NewCatalogServiceGetApplicationReferenceCountRequest generates requests for CatalogServiceGetApplicationReferenceCount
+func NewCatalogServiceGetApplicationReferenceCountRequest(server string, applicationName string, version string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/applications/%s/versions/%s/reference_count", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceListArtifactsRequest generates requests for CatalogServiceListArtifacts
+func NewCatalogServiceListArtifactsRequest(server string, params *CatalogServiceListArtifactsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1278,9 +1522,25 @@ func NewCatalogServiceListDeploymentPackagesRequest(server string, params *Catal
 
 		}
 
-		if params.Kinds != nil {
+		if params.PageToken != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SkipTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skipTotal", runtime.ParamLocationQuery, *params.SkipTotal); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1305,19 +1565,19 @@ func NewCatalogServiceListDeploymentPackagesRequest(server string, params *Catal
 	return req, nil
 }
 
-// NewCatalogServiceCreateDeploymentPackageRequest calls the generic CatalogServiceCreateDeploymentPackage builder with application/json body
-func NewCatalogServiceCreateDeploymentPackageRequest(server string, body CatalogServiceCreateDeploymentPackageJSONRequestBody) (*http.Request, error) {
+// NewCatalogServiceCreateArtifactRequest calls the generic CatalogServiceCreateArtifact builder with application/json body
+func NewCatalogServiceCreateArtifactRequest(server string, body CatalogServiceCreateArtifactJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceCreateDeploymentPackageRequestWithBody(server, "application/json", bodyReader)
+	return NewCatalogServiceCreateArtifactRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCatalogServiceCreateDeploymentPackageRequestWithBody generates requests for CatalogServiceCreateDeploymentPackage with any type of body
-func NewCatalogServiceCreateDeploymentPackageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCatalogServiceCreateArtifactRequestWithBody generates requests for CatalogServiceCreateArtifact with any type of body
+func NewCatalogServiceCreateArtifactRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1325,7 +1585,7 @@ func NewCatalogServiceCreateDeploymentPackageRequestWithBody(server string, cont
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1345,13 +1605,13 @@ func NewCatalogServiceCreateDeploymentPackageRequestWithBody(server string, cont
 	return req, nil
 }
 
-// NewCatalogServiceGetDeploymentPackageVersionsRequest generates requests for CatalogServiceGetDeploymentPackageVersions
-func NewCatalogServiceGetDeploymentPackageVersionsRequest(server string, deploymentPackageName string) (*http.Request, error) {
+// NewCatalogServiceDeleteArtifactRequest generates requests for CatalogServiceDeleteArtifact
+func NewCatalogServiceDeleteArtifactRequest(server string, artifactName string, params *CatalogServiceDeleteArtifactParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "artifactName", runtime.ParamLocationPath, artifactName)
 	if err != nil {
 		return nil, err
 	}
@@ -1361,7 +1621,7 @@ func NewCatalogServiceGetDeploymentPackageVersionsRequest(server string, deploym
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions", pathParam0)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1371,7 +1631,29 @@ func NewCatalogServiceGetDeploymentPackageVersionsRequest(server string, deploym
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1379,20 +1661,13 @@ func NewCatalogServiceGetDeploymentPackageVersionsRequest(server string, deploym
 	return req, nil
 }
 
-// NewCatalogServiceDeleteDeploymentPackageRequest generates requests for CatalogServiceDeleteDeploymentPackage
-func NewCatalogServiceDeleteDeploymentPackageRequest(server string, deploymentPackageName string, version string) (*http.Request, error) {
+// NewCatalogServiceGetArtifactRequest generates requests for CatalogServiceGetArtifact
+func NewCatalogServiceGetArtifactRequest(server string, artifactName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "artifactName", runtime.ParamLocationPath, artifactName)
 	if err != nil {
 		return nil, err
 	}
@@ -1402,7 +1677,7 @@ func NewCatalogServiceDeleteDeploymentPackageRequest(server string, deploymentPa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1412,7 +1687,7 @@ func NewCatalogServiceDeleteDeploymentPackageRequest(server string, deploymentPa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1420,20 +1695,24 @@ func NewCatalogServiceDeleteDeploymentPackageRequest(server string, deploymentPa
 	return req, nil
 }
 
-// NewCatalogServiceGetDeploymentPackageRequest generates requests for CatalogServiceGetDeploymentPackage
-func NewCatalogServiceGetDeploymentPackageRequest(server string, deploymentPackageName string, version string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+// NewCatalogServiceUpdateArtifactRequest calls the generic CatalogServiceUpdateArtifact builder with application/json body
+func NewCatalogServiceUpdateArtifactRequest(server string, artifactName string, params *CatalogServiceUpdateArtifactParams, body CatalogServiceUpdateArtifactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceUpdateArtifactRequestWithBody(server, artifactName, params, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewCatalogServiceUpdateArtifactRequestWithBody generates requests for CatalogServiceUpdateArtifact with any type of body
+func NewCatalogServiceUpdateArtifactRequestWithBody(server string, artifactName string, params *CatalogServiceUpdateArtifactParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "artifactName", runtime.ParamLocationPath, artifactName)
 	if err != nil {
 		return nil, err
 	}
@@ -1443,7 +1722,7 @@ func NewCatalogServiceGetDeploymentPackageRequest(server string, deploymentPacka
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/artifacts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1453,56 +1732,42 @@ func NewCatalogServiceGetDeploymentPackageRequest(server string, deploymentPacka
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewCatalogServiceUpdateDeploymentPackageRequest calls the generic CatalogServiceUpdateDeploymentPackage builder with application/json body
-func NewCatalogServiceUpdateDeploymentPackageRequest(server string, deploymentPackageName string, version string, body CatalogServiceUpdateDeploymentPackageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceUpdateDeploymentPackageRequestWithBody(server, deploymentPackageName, version, "application/json", bodyReader)
-}
-
-// NewCatalogServiceUpdateDeploymentPackageRequestWithBody generates requests for CatalogServiceUpdateDeploymentPackage with any type of body
-func NewCatalogServiceUpdateDeploymentPackageRequestWithBody(server string, deploymentPackageName string, version string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	var pathParam0 string
+		if params.Etag != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
-	if err != nil {
-		return nil, err
-	}
+		if params.UpdateMask != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateMask", runtime.ParamLocationQuery, *params.UpdateMask); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("PUT", queryURL.String(), body)
@@ -1515,8 +1780,8 @@ func NewCatalogServiceUpdateDeploymentPackageRequestWithBody(server string, depl
 	return req, nil
 }
 
-// NewCatalogServiceListRegistriesRequest generates requests for CatalogServiceListRegistries
-func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServiceListRegistriesParams) (*http.Request, error) {
+// NewCatalogServiceListAuditEventsRequest generates requests for CatalogServiceListAuditEvents
+func NewCatalogServiceListAuditEventsRequest(server string, params *CatalogServiceListAuditEventsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1524,7 +1789,7 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/audit_events")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1537,9 +1802,9 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.OrderBy != nil {
+		if params.ResourceType != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceType", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1553,9 +1818,9 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 
 		}
 
-		if params.Filter != nil {
+		if params.ResourceName != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceName", runtime.ParamLocationQuery, *params.ResourceName); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1569,9 +1834,9 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 
 		}
 
-		if params.PageSize != nil {
+		if params.ResourceVersion != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceVersion", runtime.ParamLocationQuery, *params.ResourceVersion); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1585,9 +1850,9 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 
 		}
 
-		if params.Offset != nil {
+		if params.User != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user", runtime.ParamLocationQuery, *params.User); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1601,9 +1866,89 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 
 		}
 
-		if params.ShowSensitiveInfo != nil {
+		if params.StartTimeSeconds != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showSensitiveInfo", runtime.ParamLocationQuery, *params.ShowSensitiveInfo); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime.seconds", runtime.ParamLocationQuery, *params.StartTimeSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartTimeNanos != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime.nanos", runtime.ParamLocationQuery, *params.StartTimeNanos); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTimeSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime.seconds", runtime.ParamLocationQuery, *params.EndTimeSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTimeNanos != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime.nanos", runtime.ParamLocationQuery, *params.EndTimeNanos); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1628,19 +1973,8 @@ func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServic
 	return req, nil
 }
 
-// NewCatalogServiceCreateRegistryRequest calls the generic CatalogServiceCreateRegistry builder with application/json body
-func NewCatalogServiceCreateRegistryRequest(server string, body CatalogServiceCreateRegistryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceCreateRegistryRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCatalogServiceCreateRegistryRequestWithBody generates requests for CatalogServiceCreateRegistry with any type of body
-func NewCatalogServiceCreateRegistryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCatalogServiceListDeletedRequest generates requests for CatalogServiceListDeleted
+func NewCatalogServiceListDeletedRequest(server string, params *CatalogServiceListDeletedParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1648,7 +1982,7 @@ func NewCatalogServiceCreateRegistryRequestWithBody(server string, contentType s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1658,23 +1992,82 @@ func NewCatalogServiceCreateRegistryRequestWithBody(server string, contentType s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ResourceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceType", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCatalogServiceDeleteRegistryRequest generates requests for CatalogServiceDeleteRegistry
-func NewCatalogServiceDeleteRegistryRequest(server string, registryName string) (*http.Request, error) {
+// NewCatalogServicePurgeApplicationRequest generates requests for CatalogServicePurgeApplication
+func NewCatalogServicePurgeApplicationRequest(server string, applicationName string, version string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
 	if err != nil {
 		return nil, err
 	}
@@ -1684,7 +2077,7 @@ func NewCatalogServiceDeleteRegistryRequest(server string, registryName string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted/applications/%s/versions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1702,23 +2095,30 @@ func NewCatalogServiceDeleteRegistryRequest(server string, registryName string)
 	return req, nil
 }
 
-// NewCatalogServiceGetRegistryRequest generates requests for CatalogServiceGetRegistry
-func NewCatalogServiceGetRegistryRequest(server string, registryName string, params *CatalogServiceGetRegistryParams) (*http.Request, error) {
+// NewCatalogServiceRestoreApplicationRequest generates requests for CatalogServiceRestoreApplication
+func NewCatalogServiceRestoreApplicationRequest(server string, applicationName string, version string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "applicationName", runtime.ParamLocationPath, applicationName)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s", pathParam0)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted/applications/%s/versions/%s/restore", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1728,29 +2128,48 @@ func NewCatalogServiceGetRegistryRequest(server string, registryName string, par
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.ShowSensitiveInfo != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showSensitiveInfo", runtime.ParamLocationQuery, *params.ShowSensitiveInfo); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewCatalogServicePurgeDeploymentPackageRequest generates requests for CatalogServicePurgeDeploymentPackage
+func NewCatalogServicePurgeDeploymentPackageRequest(server string, deploymentPackageName string, version string) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1758,19 +2177,49 @@ func NewCatalogServiceGetRegistryRequest(server string, registryName string, par
 	return req, nil
 }
 
-// NewCatalogServiceUpdateRegistryRequest calls the generic CatalogServiceUpdateRegistry builder with application/json body
-func NewCatalogServiceUpdateRegistryRequest(server string, registryName string, body CatalogServiceUpdateRegistryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewCatalogServiceRestoreDeploymentPackageRequest generates requests for CatalogServiceRestoreDeploymentPackage
+func NewCatalogServiceRestoreDeploymentPackageRequest(server string, deploymentPackageName string, version string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceUpdateRegistryRequestWithBody(server, registryName, "application/json", bodyReader)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted/deployment_packages/%s/versions/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewCatalogServiceUpdateRegistryRequestWithBody generates requests for CatalogServiceUpdateRegistry with any type of body
-func NewCatalogServiceUpdateRegistryRequestWithBody(server string, registryName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCatalogServicePurgeRegistryRequest generates requests for CatalogServicePurgeRegistry
+func NewCatalogServicePurgeRegistryRequest(server string, registryName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1785,7 +2234,7 @@ func NewCatalogServiceUpdateRegistryRequestWithBody(server string, registryName
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted/registries/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1795,29 +2244,50 @@ func NewCatalogServiceUpdateRegistryRequestWithBody(server string, registryName
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCatalogServiceUploadCatalogEntitiesRequest calls the generic CatalogServiceUploadCatalogEntities builder with application/json body
-func NewCatalogServiceUploadCatalogEntitiesRequest(server string, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewCatalogServiceRestoreRegistryRequest generates requests for CatalogServiceRestoreRegistry
+func NewCatalogServiceRestoreRegistryRequest(server string, registryName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server, params, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deleted/registries/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewCatalogServiceUploadCatalogEntitiesRequestWithBody generates requests for CatalogServiceUploadCatalogEntities with any type of body
-func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewCatalogServiceListDeploymentPackagesRequest generates requests for CatalogServiceListDeploymentPackages
+func NewCatalogServiceListDeploymentPackagesRequest(server string, params *CatalogServiceListDeploymentPackagesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1825,7 +2295,7 @@ func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/uploads")
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1838,9 +2308,9 @@ func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.SessionId != nil {
+		if params.OrderBy != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sessionId", runtime.ParamLocationQuery, *params.SessionId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1854,9 +2324,9 @@ func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params
 
 		}
 
-		if params.UploadNumber != nil {
+		if params.Filter != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadNumber", runtime.ParamLocationQuery, *params.UploadNumber); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1870,9 +2340,9 @@ func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params
 
 		}
 
-		if params.LastUpload != nil {
+		if params.PageSize != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastUpload", runtime.ParamLocationQuery, *params.LastUpload); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1886,161 +2356,2895 @@ func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+		if params.Offset != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.Kinds != nil {
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
 		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CatalogServiceListApplicationsWithResponse request
-	CatalogServiceListApplicationsWithResponse(ctx context.Context, params *CatalogServiceListApplicationsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListApplicationsResponse, error)
-
-	// CatalogServiceCreateApplicationWithBodyWithResponse request with any body
-	CatalogServiceCreateApplicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error)
 
-	CatalogServiceCreateApplicationWithResponse(ctx context.Context, body CatalogServiceCreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error)
+		if params.PageToken != nil {
 
-	// CatalogServiceGetApplicationVersionsWithResponse request
-	CatalogServiceGetApplicationVersionsWithResponse(ctx context.Context, applicationName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationVersionsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CatalogServiceDeleteApplicationWithResponse request
-	CatalogServiceDeleteApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteApplicationResponse, error)
+		}
 
-	// CatalogServiceGetApplicationWithResponse request
-	CatalogServiceGetApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationResponse, error)
+		if params.SkipTotal != nil {
 
-	// CatalogServiceUpdateApplicationWithBodyWithResponse request with any body
-	CatalogServiceUpdateApplicationWithBodyWithResponse(ctx context.Context, applicationName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skipTotal", runtime.ParamLocationQuery, *params.SkipTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	CatalogServiceUpdateApplicationWithResponse(ctx context.Context, applicationName string, version string, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error)
+		}
 
-	// CatalogServiceGetApplicationReferenceCountWithResponse request
-	CatalogServiceGetApplicationReferenceCountWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationReferenceCountResponse, error)
+		if params.LabelSelector != nil {
 
-	// CatalogServiceListArtifactsWithResponse request
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeDeprecated != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "excludeDeprecated", runtime.ParamLocationQuery, *params.ExcludeDeprecated); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceCreateDeploymentPackageRequest calls the generic CatalogServiceCreateDeploymentPackage builder with application/json body
+func NewCatalogServiceCreateDeploymentPackageRequest(server string, body CatalogServiceCreateDeploymentPackageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceCreateDeploymentPackageRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCatalogServiceCreateDeploymentPackageRequestWithBody generates requests for CatalogServiceCreateDeploymentPackage with any type of body
+func NewCatalogServiceCreateDeploymentPackageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceGetDeploymentPackageVersionsRequest generates requests for CatalogServiceGetDeploymentPackageVersions
+func NewCatalogServiceGetDeploymentPackageVersionsRequest(server string, deploymentPackageName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceDeleteDeploymentPackageRequest generates requests for CatalogServiceDeleteDeploymentPackage
+func NewCatalogServiceDeleteDeploymentPackageRequest(server string, deploymentPackageName string, version string, params *CatalogServiceDeleteDeploymentPackageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceGetDeploymentPackageRequest generates requests for CatalogServiceGetDeploymentPackage
+func NewCatalogServiceGetDeploymentPackageRequest(server string, deploymentPackageName string, version string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceUpdateDeploymentPackageRequest calls the generic CatalogServiceUpdateDeploymentPackage builder with application/json body
+func NewCatalogServiceUpdateDeploymentPackageRequest(server string, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, body CatalogServiceUpdateDeploymentPackageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceUpdateDeploymentPackageRequestWithBody(server, deploymentPackageName, version, params, "application/json", bodyReader)
+}
+
+// NewCatalogServiceUpdateDeploymentPackageRequestWithBody generates requests for CatalogServiceUpdateDeploymentPackage with any type of body
+func NewCatalogServiceUpdateDeploymentPackageRequestWithBody(server string, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UpdateMask != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateMask", runtime.ParamLocationQuery, *params.UpdateMask); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceResolveDeploymentPackageRequest generates requests for CatalogServiceResolveDeploymentPackage
+func NewCatalogServiceResolveDeploymentPackageRequest(server string, deploymentPackageName string, version string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentPackageName", runtime.ParamLocationPath, deploymentPackageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/deployment_packages/%s/versions/%s/resolve", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceListRegistriesRequest generates requests for CatalogServiceListRegistries
+func NewCatalogServiceListRegistriesRequest(server string, params *CatalogServiceListRegistriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShowSensitiveInfo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showSensitiveInfo", runtime.ParamLocationQuery, *params.ShowSensitiveInfo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SkipTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skipTotal", runtime.ParamLocationQuery, *params.SkipTotal); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceCreateRegistryRequest calls the generic CatalogServiceCreateRegistry builder with application/json body
+func NewCatalogServiceCreateRegistryRequest(server string, body CatalogServiceCreateRegistryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceCreateRegistryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCatalogServiceCreateRegistryRequestWithBody generates requests for CatalogServiceCreateRegistry with any type of body
+func NewCatalogServiceCreateRegistryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceDeleteRegistryRequest generates requests for CatalogServiceDeleteRegistry
+func NewCatalogServiceDeleteRegistryRequest(server string, registryName string, params *CatalogServiceDeleteRegistryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceGetRegistryRequest generates requests for CatalogServiceGetRegistry
+func NewCatalogServiceGetRegistryRequest(server string, registryName string, params *CatalogServiceGetRegistryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ShowSensitiveInfo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showSensitiveInfo", runtime.ParamLocationQuery, *params.ShowSensitiveInfo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceUpdateRegistryRequest calls the generic CatalogServiceUpdateRegistry builder with application/json body
+func NewCatalogServiceUpdateRegistryRequest(server string, registryName string, params *CatalogServiceUpdateRegistryParams, body CatalogServiceUpdateRegistryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceUpdateRegistryRequestWithBody(server, registryName, params, "application/json", bodyReader)
+}
+
+// NewCatalogServiceUpdateRegistryRequestWithBody generates requests for CatalogServiceUpdateRegistry with any type of body
+func NewCatalogServiceUpdateRegistryRequestWithBody(server string, registryName string, params *CatalogServiceUpdateRegistryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Etag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "etag", runtime.ParamLocationQuery, *params.Etag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UpdateMask != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateMask", runtime.ParamLocationQuery, *params.UpdateMask); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceListRegistryChartsRequest generates requests for CatalogServiceListRegistryCharts
+func NewCatalogServiceListRegistryChartsRequest(server string, registryName string, params *CatalogServiceListRegistryChartsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s/charts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceListChartVersionsRequest generates requests for CatalogServiceListChartVersions
+func NewCatalogServiceListChartVersionsRequest(server string, registryName string, chartName string, params *CatalogServiceListChartVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "chartName", runtime.ParamLocationPath, chartName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s/charts/%s/versions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceTestRegistryConnectionRequest calls the generic CatalogServiceTestRegistryConnection builder with application/json body
+func NewCatalogServiceTestRegistryConnectionRequest(server string, registryName string, body CatalogServiceTestRegistryConnectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceTestRegistryConnectionRequestWithBody(server, registryName, "application/json", bodyReader)
+}
+
+// NewCatalogServiceTestRegistryConnectionRequestWithBody generates requests for CatalogServiceTestRegistryConnection with any type of body
+func NewCatalogServiceTestRegistryConnectionRequestWithBody(server string, registryName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "registryName", runtime.ParamLocationPath, registryName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/registries/%s/test_connection", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceListRevisionsRequest generates requests for CatalogServiceListRevisions
+func NewCatalogServiceListRevisionsRequest(server string, resourceType string, name string, version string, params *CatalogServiceListRevisionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/revisions/%s/%s/versions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceGetRevisionRequest generates requests for CatalogServiceGetRevision
+func NewCatalogServiceGetRevisionRequest(server string, resourceType string, name string, version string, revision uint32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "revision", runtime.ParamLocationPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/revisions/%s/%s/versions/%s/revisions/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceRestoreRevisionRequest generates requests for CatalogServiceRestoreRevision
+func NewCatalogServiceRestoreRevisionRequest(server string, resourceType string, name string, version string, revision uint32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceType", runtime.ParamLocationPath, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "revision", runtime.ParamLocationPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/revisions/%s/%s/versions/%s/revisions/%s/restore", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceSearchCatalogRequest generates requests for CatalogServiceSearchCatalog
+func NewCatalogServiceSearchCatalogRequest(server string, params *CatalogServiceSearchCatalogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceUploadCatalogEntitiesRequest calls the generic CatalogServiceUploadCatalogEntities builder with application/json body
+func NewCatalogServiceUploadCatalogEntitiesRequest(server string, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCatalogServiceUploadCatalogEntitiesRequestWithBody generates requests for CatalogServiceUploadCatalogEntities with any type of body
+func NewCatalogServiceUploadCatalogEntitiesRequestWithBody(server string, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/uploads")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SessionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sessionId", runtime.ParamLocationQuery, *params.SessionId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UploadNumber != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadNumber", runtime.ParamLocationQuery, *params.UploadNumber); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LastUpload != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastUpload", runtime.ParamLocationQuery, *params.LastUpload); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConflictPolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conflictPolicy", runtime.ParamLocationQuery, *params.ConflictPolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConflictPoliciesRegistries != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conflictPolicies.registries", runtime.ParamLocationQuery, *params.ConflictPoliciesRegistries); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConflictPoliciesArtifacts != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conflictPolicies.artifacts", runtime.ParamLocationQuery, *params.ConflictPoliciesArtifacts); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConflictPoliciesApplications != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conflictPolicies.applications", runtime.ParamLocationQuery, *params.ConflictPoliciesApplications); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConflictPoliciesDeploymentPackages != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conflictPolicies.deploymentPackages", runtime.ParamLocationQuery, *params.ConflictPoliciesDeploymentPackages); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceListWebhooksRequest generates requests for CatalogServiceListWebhooks
+func NewCatalogServiceListWebhooksRequest(server string, params *CatalogServiceListWebhooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceCreateWebhookRequest calls the generic CatalogServiceCreateWebhook builder with application/json body
+func NewCatalogServiceCreateWebhookRequest(server string, body CatalogServiceCreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceCreateWebhookRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCatalogServiceCreateWebhookRequestWithBody generates requests for CatalogServiceCreateWebhook with any type of body
+func NewCatalogServiceCreateWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceDeleteWebhookRequest generates requests for CatalogServiceDeleteWebhook
+func NewCatalogServiceDeleteWebhookRequest(server string, webhookName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookName", runtime.ParamLocationPath, webhookName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceGetWebhookRequest generates requests for CatalogServiceGetWebhook
+func NewCatalogServiceGetWebhookRequest(server string, webhookName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookName", runtime.ParamLocationPath, webhookName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCatalogServiceUpdateWebhookRequest calls the generic CatalogServiceUpdateWebhook builder with application/json body
+func NewCatalogServiceUpdateWebhookRequest(server string, webhookName string, params *CatalogServiceUpdateWebhookParams, body CatalogServiceUpdateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCatalogServiceUpdateWebhookRequestWithBody(server, webhookName, params, "application/json", bodyReader)
+}
+
+// NewCatalogServiceUpdateWebhookRequestWithBody generates requests for CatalogServiceUpdateWebhook with any type of body
+func NewCatalogServiceUpdateWebhookRequestWithBody(server string, webhookName string, params *CatalogServiceUpdateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookName", runtime.ParamLocationPath, webhookName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateMask != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "updateMask", runtime.ParamLocationQuery, *params.UpdateMask); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCatalogServiceListWebhookDeliveriesRequest generates requests for CatalogServiceListWebhookDeliveries
+func NewCatalogServiceListWebhookDeliveriesRequest(server string, webhookName string, params *CatalogServiceListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookName", runtime.ParamLocationPath, webhookName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/catalog.orchestrator.apis/v3/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CatalogServiceListApplicationsWithResponse request
+	CatalogServiceListApplicationsWithResponse(ctx context.Context, params *CatalogServiceListApplicationsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListApplicationsResponse, error)
+
+	// CatalogServiceCreateApplicationWithBodyWithResponse request with any body
+	CatalogServiceCreateApplicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error)
+
+	CatalogServiceCreateApplicationWithResponse(ctx context.Context, body CatalogServiceCreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error)
+
+	// CatalogServiceGetApplicationVersionsWithResponse request
+	CatalogServiceGetApplicationVersionsWithResponse(ctx context.Context, applicationName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationVersionsResponse, error)
+
+	// CatalogServiceDeleteApplicationWithResponse request
+	CatalogServiceDeleteApplicationWithResponse(ctx context.Context, applicationName string, version string, params *CatalogServiceDeleteApplicationParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteApplicationResponse, error)
+
+	// CatalogServiceGetApplicationWithResponse request
+	CatalogServiceGetApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationResponse, error)
+
+	// CatalogServiceUpdateApplicationWithBodyWithResponse request with any body
+	CatalogServiceUpdateApplicationWithBodyWithResponse(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error)
+
+	CatalogServiceUpdateApplicationWithResponse(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error)
+
+	// CatalogServiceGetApplicationReferenceCountWithResponse request
+	CatalogServiceGetApplicationReferenceCountWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationReferenceCountResponse, error)
+
+	// CatalogServiceListArtifactsWithResponse request
 	CatalogServiceListArtifactsWithResponse(ctx context.Context, params *CatalogServiceListArtifactsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListArtifactsResponse, error)
 
-	// CatalogServiceCreateArtifactWithBodyWithResponse request with any body
-	CatalogServiceCreateArtifactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error)
+	// CatalogServiceCreateArtifactWithBodyWithResponse request with any body
+	CatalogServiceCreateArtifactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error)
+
+	CatalogServiceCreateArtifactWithResponse(ctx context.Context, body CatalogServiceCreateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error)
+
+	// CatalogServiceDeleteArtifactWithResponse request
+	CatalogServiceDeleteArtifactWithResponse(ctx context.Context, artifactName string, params *CatalogServiceDeleteArtifactParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteArtifactResponse, error)
+
+	// CatalogServiceGetArtifactWithResponse request
+	CatalogServiceGetArtifactWithResponse(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetArtifactResponse, error)
+
+	// CatalogServiceUpdateArtifactWithBodyWithResponse request with any body
+	CatalogServiceUpdateArtifactWithBodyWithResponse(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error)
+
+	CatalogServiceUpdateArtifactWithResponse(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error)
+
+	// CatalogServiceListAuditEventsWithResponse request
+	CatalogServiceListAuditEventsWithResponse(ctx context.Context, params *CatalogServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListAuditEventsResponse, error)
+
+	// CatalogServiceListDeletedWithResponse request
+	CatalogServiceListDeletedWithResponse(ctx context.Context, params *CatalogServiceListDeletedParams, reqEditors ...RequestEditorFn) (*CatalogServiceListDeletedResponse, error)
+
+	// CatalogServicePurgeApplicationWithResponse request
+	CatalogServicePurgeApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServicePurgeApplicationResponse, error)
+
+	// CatalogServiceRestoreApplicationWithResponse request
+	CatalogServiceRestoreApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreApplicationResponse, error)
+
+	// CatalogServicePurgeDeploymentPackageWithResponse request
+	CatalogServicePurgeDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServicePurgeDeploymentPackageResponse, error)
+
+	// CatalogServiceRestoreDeploymentPackageWithResponse request
+	CatalogServiceRestoreDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreDeploymentPackageResponse, error)
+
+	// CatalogServicePurgeRegistryWithResponse request
+	CatalogServicePurgeRegistryWithResponse(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*CatalogServicePurgeRegistryResponse, error)
+
+	// CatalogServiceRestoreRegistryWithResponse request
+	CatalogServiceRestoreRegistryWithResponse(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreRegistryResponse, error)
+
+	// CatalogServiceListDeploymentPackagesWithResponse request
+	CatalogServiceListDeploymentPackagesWithResponse(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListDeploymentPackagesResponse, error)
+
+	// CatalogServiceCreateDeploymentPackageWithBodyWithResponse request with any body
+	CatalogServiceCreateDeploymentPackageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error)
+
+	CatalogServiceCreateDeploymentPackageWithResponse(ctx context.Context, body CatalogServiceCreateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error)
+
+	// CatalogServiceGetDeploymentPackageVersionsWithResponse request
+	CatalogServiceGetDeploymentPackageVersionsWithResponse(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageVersionsResponse, error)
+
+	// CatalogServiceDeleteDeploymentPackageWithResponse request
+	CatalogServiceDeleteDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceDeleteDeploymentPackageParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteDeploymentPackageResponse, error)
+
+	// CatalogServiceGetDeploymentPackageWithResponse request
+	CatalogServiceGetDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageResponse, error)
+
+	// CatalogServiceUpdateDeploymentPackageWithBodyWithResponse request with any body
+	CatalogServiceUpdateDeploymentPackageWithBodyWithResponse(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error)
+
+	CatalogServiceUpdateDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error)
+
+	// CatalogServiceResolveDeploymentPackageWithResponse request
+	CatalogServiceResolveDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceResolveDeploymentPackageResponse, error)
+
+	// CatalogServiceListRegistriesWithResponse request
+	CatalogServiceListRegistriesWithResponse(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRegistriesResponse, error)
+
+	// CatalogServiceCreateRegistryWithBodyWithResponse request with any body
+	CatalogServiceCreateRegistryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error)
+
+	CatalogServiceCreateRegistryWithResponse(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error)
+
+	// CatalogServiceDeleteRegistryWithResponse request
+	CatalogServiceDeleteRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceDeleteRegistryParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteRegistryResponse, error)
+
+	// CatalogServiceGetRegistryWithResponse request
+	CatalogServiceGetRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*CatalogServiceGetRegistryResponse, error)
+
+	// CatalogServiceUpdateRegistryWithBodyWithResponse request with any body
+	CatalogServiceUpdateRegistryWithBodyWithResponse(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error)
+
+	CatalogServiceUpdateRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error)
+
+	// CatalogServiceListRegistryChartsWithResponse request
+	CatalogServiceListRegistryChartsWithResponse(ctx context.Context, registryName string, params *CatalogServiceListRegistryChartsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRegistryChartsResponse, error)
+
+	// CatalogServiceListChartVersionsWithResponse request
+	CatalogServiceListChartVersionsWithResponse(ctx context.Context, registryName string, chartName string, params *CatalogServiceListChartVersionsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListChartVersionsResponse, error)
+
+	// CatalogServiceTestRegistryConnectionWithBodyWithResponse request with any body
+	CatalogServiceTestRegistryConnectionWithBodyWithResponse(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceTestRegistryConnectionResponse, error)
+
+	CatalogServiceTestRegistryConnectionWithResponse(ctx context.Context, registryName string, body CatalogServiceTestRegistryConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceTestRegistryConnectionResponse, error)
+
+	// CatalogServiceListRevisionsWithResponse request
+	CatalogServiceListRevisionsWithResponse(ctx context.Context, resourceType string, name string, version string, params *CatalogServiceListRevisionsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRevisionsResponse, error)
+
+	// CatalogServiceGetRevisionWithResponse request
+	CatalogServiceGetRevisionWithResponse(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*CatalogServiceGetRevisionResponse, error)
+
+	// CatalogServiceRestoreRevisionWithResponse request
+	CatalogServiceRestoreRevisionWithResponse(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreRevisionResponse, error)
+
+	// CatalogServiceSearchCatalogWithResponse request
+	CatalogServiceSearchCatalogWithResponse(ctx context.Context, params *CatalogServiceSearchCatalogParams, reqEditors ...RequestEditorFn) (*CatalogServiceSearchCatalogResponse, error)
+
+	// CatalogServiceUploadCatalogEntitiesWithBodyWithResponse request with any body
+	CatalogServiceUploadCatalogEntitiesWithBodyWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error)
+
+	CatalogServiceUploadCatalogEntitiesWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error)
+
+	// CatalogServiceListWebhooksWithResponse request
+	CatalogServiceListWebhooksWithResponse(ctx context.Context, params *CatalogServiceListWebhooksParams, reqEditors ...RequestEditorFn) (*CatalogServiceListWebhooksResponse, error)
+
+	// CatalogServiceCreateWebhookWithBodyWithResponse request with any body
+	CatalogServiceCreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateWebhookResponse, error)
+
+	CatalogServiceCreateWebhookWithResponse(ctx context.Context, body CatalogServiceCreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateWebhookResponse, error)
+
+	// CatalogServiceDeleteWebhookWithResponse request
+	CatalogServiceDeleteWebhookWithResponse(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteWebhookResponse, error)
+
+	// CatalogServiceGetWebhookWithResponse request
+	CatalogServiceGetWebhookWithResponse(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetWebhookResponse, error)
+
+	// CatalogServiceUpdateWebhookWithBodyWithResponse request with any body
+	CatalogServiceUpdateWebhookWithBodyWithResponse(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateWebhookResponse, error)
+
+	CatalogServiceUpdateWebhookWithResponse(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, body CatalogServiceUpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateWebhookResponse, error)
+
+	// CatalogServiceListWebhookDeliveriesWithResponse request
+	CatalogServiceListWebhookDeliveriesWithResponse(ctx context.Context, webhookName string, params *CatalogServiceListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListWebhookDeliveriesResponse, error)
+}
+
+type CatalogServiceListApplicationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListApplicationsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListApplicationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListApplicationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceCreateApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateApplicationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceCreateApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceCreateApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceGetApplicationVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetApplicationVersionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetApplicationVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetApplicationVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceDeleteApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceDeleteApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceDeleteApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceGetApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetApplicationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceUpdateApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceUpdateApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceUpdateApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceGetApplicationReferenceCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetApplicationReferenceCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetApplicationReferenceCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetApplicationReferenceCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListArtifactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListArtifactsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListArtifactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListArtifactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceCreateArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateArtifactResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceCreateArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceCreateArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceDeleteArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceDeleteArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceDeleteArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceGetArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetArtifactResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceUpdateArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceUpdateArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceUpdateArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAuditEventsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListDeletedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListDeletedResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListDeletedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListDeletedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServicePurgeApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServicePurgeApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServicePurgeApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceRestoreApplicationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RestoreApplicationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceRestoreApplicationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceRestoreApplicationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServicePurgeDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServicePurgeDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServicePurgeDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceRestoreDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RestoreDeploymentPackageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceRestoreDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceRestoreDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServicePurgeRegistryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServicePurgeRegistryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServicePurgeRegistryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceRestoreRegistryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RestoreRegistryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceRestoreRegistryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceRestoreRegistryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListDeploymentPackagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListDeploymentPackagesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListDeploymentPackagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListDeploymentPackagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceCreateDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateDeploymentPackageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceCreateDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceCreateDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceGetDeploymentPackageVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDeploymentPackageVersionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetDeploymentPackageVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetDeploymentPackageVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceDeleteDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	CatalogServiceCreateArtifactWithResponse(ctx context.Context, body CatalogServiceCreateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceDeleteDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CatalogServiceDeleteArtifactWithResponse request
-	CatalogServiceDeleteArtifactWithResponse(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteArtifactResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceDeleteDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CatalogServiceGetArtifactWithResponse request
-	CatalogServiceGetArtifactWithResponse(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetArtifactResponse, error)
+type CatalogServiceGetDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDeploymentPackageResponse
+}
 
-	// CatalogServiceUpdateArtifactWithBodyWithResponse request with any body
-	CatalogServiceUpdateArtifactWithBodyWithResponse(ctx context.Context, artifactName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CatalogServiceUpdateArtifactWithResponse(ctx context.Context, artifactName string, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CatalogServiceListDeploymentPackagesWithResponse request
-	CatalogServiceListDeploymentPackagesWithResponse(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListDeploymentPackagesResponse, error)
+type CatalogServiceUpdateDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateDeploymentPackageResponse
+}
 
-	// CatalogServiceCreateDeploymentPackageWithBodyWithResponse request with any body
-	CatalogServiceCreateDeploymentPackageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceUpdateDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CatalogServiceCreateDeploymentPackageWithResponse(ctx context.Context, body CatalogServiceCreateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceUpdateDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CatalogServiceGetDeploymentPackageVersionsWithResponse request
-	CatalogServiceGetDeploymentPackageVersionsWithResponse(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageVersionsResponse, error)
+type CatalogServiceResolveDeploymentPackageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResolveDeploymentPackageResponse
+}
 
-	// CatalogServiceDeleteDeploymentPackageWithResponse request
-	CatalogServiceDeleteDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteDeploymentPackageResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceResolveDeploymentPackageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CatalogServiceGetDeploymentPackageWithResponse request
-	CatalogServiceGetDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceResolveDeploymentPackageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CatalogServiceUpdateDeploymentPackageWithBodyWithResponse request with any body
-	CatalogServiceUpdateDeploymentPackageWithBodyWithResponse(ctx context.Context, deploymentPackageName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error)
+type CatalogServiceListRegistriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListRegistriesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListRegistriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListRegistriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceCreateRegistryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateRegistryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceCreateRegistryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceCreateRegistryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceDeleteRegistryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceDeleteRegistryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceDeleteRegistryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceGetRegistryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRegistryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetRegistryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetRegistryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceUpdateRegistryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceUpdateRegistryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceUpdateRegistryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListRegistryChartsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListRegistryChartsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListRegistryChartsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListRegistryChartsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListChartVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListChartVersionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListChartVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListChartVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceTestRegistryConnectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestRegistryConnectionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceTestRegistryConnectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceTestRegistryConnectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CatalogServiceListRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListRevisionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CatalogServiceListRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CatalogServiceUpdateDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CatalogServiceListRegistriesWithResponse request
-	CatalogServiceListRegistriesWithResponse(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRegistriesResponse, error)
+type CatalogServiceGetRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRevisionResponse
+}
 
-	// CatalogServiceCreateRegistryWithBodyWithResponse request with any body
-	CatalogServiceCreateRegistryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceGetRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CatalogServiceCreateRegistryWithResponse(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceGetRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CatalogServiceDeleteRegistryWithResponse request
-	CatalogServiceDeleteRegistryWithResponse(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteRegistryResponse, error)
+type CatalogServiceRestoreRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RestoreRevisionResponse
+}
 
-	// CatalogServiceGetRegistryWithResponse request
-	CatalogServiceGetRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*CatalogServiceGetRegistryResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceRestoreRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CatalogServiceUpdateRegistryWithBodyWithResponse request with any body
-	CatalogServiceUpdateRegistryWithBodyWithResponse(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceRestoreRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	CatalogServiceUpdateRegistryWithResponse(ctx context.Context, registryName string, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error)
+type CatalogServiceSearchCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchCatalogResponse
+}
 
-	// CatalogServiceUploadCatalogEntitiesWithBodyWithResponse request with any body
-	CatalogServiceUploadCatalogEntitiesWithBodyWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error)
+// Status returns HTTPResponse.Status
+func (r CatalogServiceSearchCatalogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CatalogServiceUploadCatalogEntitiesWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceSearchCatalogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type CatalogServiceListApplicationsResponse struct {
+type CatalogServiceUploadCatalogEntitiesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListApplicationsResponse
+	JSON200      *UploadCatalogEntitiesResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceListApplicationsResponse) Status() string {
+func (r CatalogServiceUploadCatalogEntitiesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2048,21 +5252,21 @@ func (r CatalogServiceListApplicationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceListApplicationsResponse) StatusCode() int {
+func (r CatalogServiceUploadCatalogEntitiesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CatalogServiceCreateApplicationResponse struct {
+type CatalogServiceListWebhooksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CreateApplicationResponse
+	JSON200      *ListWebhooksResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceCreateApplicationResponse) Status() string {
+func (r CatalogServiceListWebhooksResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2070,21 +5274,21 @@ func (r CatalogServiceCreateApplicationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceCreateApplicationResponse) StatusCode() int {
+func (r CatalogServiceListWebhooksResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CatalogServiceGetApplicationVersionsResponse struct {
+type CatalogServiceCreateWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetApplicationVersionsResponse
+	JSON200      *CreateWebhookResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceGetApplicationVersionsResponse) Status() string {
+func (r CatalogServiceCreateWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2092,20 +5296,20 @@ func (r CatalogServiceGetApplicationVersionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetApplicationVersionsResponse) StatusCode() int {
+func (r CatalogServiceCreateWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CatalogServiceDeleteApplicationResponse struct {
+type CatalogServiceDeleteWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceDeleteApplicationResponse) Status() string {
+func (r CatalogServiceDeleteWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2113,21 +5317,21 @@ func (r CatalogServiceDeleteApplicationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceDeleteApplicationResponse) StatusCode() int {
+func (r CatalogServiceDeleteWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CatalogServiceGetApplicationResponse struct {
+type CatalogServiceGetWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetApplicationResponse
+	JSON200      *GetWebhookResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceGetApplicationResponse) Status() string {
+func (r CatalogServiceGetWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2135,20 +5339,20 @@ func (r CatalogServiceGetApplicationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetApplicationResponse) StatusCode() int {
+func (r CatalogServiceGetWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CatalogServiceUpdateApplicationResponse struct {
+type CatalogServiceUpdateWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceUpdateApplicationResponse) Status() string {
+func (r CatalogServiceUpdateWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2156,707 +5360,1011 @@ func (r CatalogServiceUpdateApplicationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceUpdateApplicationResponse) StatusCode() int {
+func (r CatalogServiceUpdateWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CatalogServiceGetApplicationReferenceCountResponse struct {
+type CatalogServiceListWebhookDeliveriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *GetApplicationReferenceCountResponse
+	JSON200      *ListWebhookDeliveriesResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r CatalogServiceGetApplicationReferenceCountResponse) Status() string {
+func (r CatalogServiceListWebhookDeliveriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
 	return http.StatusText(0)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetApplicationReferenceCountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StatusCode returns HTTPResponse.StatusCode
+func (r CatalogServiceListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CatalogServiceListApplicationsWithResponse request returning *CatalogServiceListApplicationsResponse
+func (c *ClientWithResponses) CatalogServiceListApplicationsWithResponse(ctx context.Context, params *CatalogServiceListApplicationsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListApplicationsResponse, error) {
+	rsp, err := c.CatalogServiceListApplications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceListApplicationsResponse(rsp)
+}
+
+// CatalogServiceCreateApplicationWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateApplicationResponse
+func (c *ClientWithResponses) CatalogServiceCreateApplicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error) {
+	rsp, err := c.CatalogServiceCreateApplicationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateApplicationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CatalogServiceCreateApplicationWithResponse(ctx context.Context, body CatalogServiceCreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error) {
+	rsp, err := c.CatalogServiceCreateApplication(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateApplicationResponse(rsp)
+}
+
+// CatalogServiceGetApplicationVersionsWithResponse request returning *CatalogServiceGetApplicationVersionsResponse
+func (c *ClientWithResponses) CatalogServiceGetApplicationVersionsWithResponse(ctx context.Context, applicationName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationVersionsResponse, error) {
+	rsp, err := c.CatalogServiceGetApplicationVersions(ctx, applicationName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceGetApplicationVersionsResponse(rsp)
+}
+
+// CatalogServiceDeleteApplicationWithResponse request returning *CatalogServiceDeleteApplicationResponse
+func (c *ClientWithResponses) CatalogServiceDeleteApplicationWithResponse(ctx context.Context, applicationName string, version string, params *CatalogServiceDeleteApplicationParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteApplicationResponse, error) {
+	rsp, err := c.CatalogServiceDeleteApplication(ctx, applicationName, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceDeleteApplicationResponse(rsp)
+}
+
+// CatalogServiceGetApplicationWithResponse request returning *CatalogServiceGetApplicationResponse
+func (c *ClientWithResponses) CatalogServiceGetApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationResponse, error) {
+	rsp, err := c.CatalogServiceGetApplication(ctx, applicationName, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceGetApplicationResponse(rsp)
+}
+
+// CatalogServiceUpdateApplicationWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateApplicationResponse
+func (c *ClientWithResponses) CatalogServiceUpdateApplicationWithBodyWithResponse(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error) {
+	rsp, err := c.CatalogServiceUpdateApplicationWithBody(ctx, applicationName, version, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUpdateApplicationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CatalogServiceUpdateApplicationWithResponse(ctx context.Context, applicationName string, version string, params *CatalogServiceUpdateApplicationParams, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error) {
+	rsp, err := c.CatalogServiceUpdateApplication(ctx, applicationName, version, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUpdateApplicationResponse(rsp)
+}
+
+// CatalogServiceGetApplicationReferenceCountWithResponse request returning *CatalogServiceGetApplicationReferenceCountResponse
+func (c *ClientWithResponses) CatalogServiceGetApplicationReferenceCountWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationReferenceCountResponse, error) {
+	rsp, err := c.CatalogServiceGetApplicationReferenceCount(ctx, applicationName, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceGetApplicationReferenceCountResponse(rsp)
+}
+
+// CatalogServiceListArtifactsWithResponse request returning *CatalogServiceListArtifactsResponse
+func (c *ClientWithResponses) CatalogServiceListArtifactsWithResponse(ctx context.Context, params *CatalogServiceListArtifactsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListArtifactsResponse, error) {
+	rsp, err := c.CatalogServiceListArtifacts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceListArtifactsResponse(rsp)
+}
+
+// CatalogServiceCreateArtifactWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateArtifactResponse
+func (c *ClientWithResponses) CatalogServiceCreateArtifactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error) {
+	rsp, err := c.CatalogServiceCreateArtifactWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateArtifactResponse(rsp)
+}
+
+func (c *ClientWithResponses) CatalogServiceCreateArtifactWithResponse(ctx context.Context, body CatalogServiceCreateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error) {
+	rsp, err := c.CatalogServiceCreateArtifact(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateArtifactResponse(rsp)
+}
+
+// CatalogServiceDeleteArtifactWithResponse request returning *CatalogServiceDeleteArtifactResponse
+func (c *ClientWithResponses) CatalogServiceDeleteArtifactWithResponse(ctx context.Context, artifactName string, params *CatalogServiceDeleteArtifactParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteArtifactResponse, error) {
+	rsp, err := c.CatalogServiceDeleteArtifact(ctx, artifactName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceDeleteArtifactResponse(rsp)
+}
+
+// CatalogServiceGetArtifactWithResponse request returning *CatalogServiceGetArtifactResponse
+func (c *ClientWithResponses) CatalogServiceGetArtifactWithResponse(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetArtifactResponse, error) {
+	rsp, err := c.CatalogServiceGetArtifact(ctx, artifactName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceGetArtifactResponse(rsp)
+}
+
+// CatalogServiceUpdateArtifactWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateArtifactResponse
+func (c *ClientWithResponses) CatalogServiceUpdateArtifactWithBodyWithResponse(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error) {
+	rsp, err := c.CatalogServiceUpdateArtifactWithBody(ctx, artifactName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUpdateArtifactResponse(rsp)
+}
+
+func (c *ClientWithResponses) CatalogServiceUpdateArtifactWithResponse(ctx context.Context, artifactName string, params *CatalogServiceUpdateArtifactParams, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error) {
+	rsp, err := c.CatalogServiceUpdateArtifact(ctx, artifactName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUpdateArtifactResponse(rsp)
+}
+
+// CatalogServiceListAuditEventsWithResponse request returning *CatalogServiceListAuditEventsResponse
+func (c *ClientWithResponses) CatalogServiceListAuditEventsWithResponse(ctx context.Context, params *CatalogServiceListAuditEventsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListAuditEventsResponse, error) {
+	rsp, err := c.CatalogServiceListAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceListAuditEventsResponse(rsp)
+}
+
+// CatalogServiceListDeletedWithResponse request returning *CatalogServiceListDeletedResponse
+func (c *ClientWithResponses) CatalogServiceListDeletedWithResponse(ctx context.Context, params *CatalogServiceListDeletedParams, reqEditors ...RequestEditorFn) (*CatalogServiceListDeletedResponse, error) {
+	rsp, err := c.CatalogServiceListDeleted(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceListDeletedResponse(rsp)
+}
+
+// CatalogServicePurgeApplicationWithResponse request returning *CatalogServicePurgeApplicationResponse
+func (c *ClientWithResponses) CatalogServicePurgeApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServicePurgeApplicationResponse, error) {
+	rsp, err := c.CatalogServicePurgeApplication(ctx, applicationName, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServicePurgeApplicationResponse(rsp)
+}
+
+// CatalogServiceRestoreApplicationWithResponse request returning *CatalogServiceRestoreApplicationResponse
+func (c *ClientWithResponses) CatalogServiceRestoreApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreApplicationResponse, error) {
+	rsp, err := c.CatalogServiceRestoreApplication(ctx, applicationName, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceRestoreApplicationResponse(rsp)
+}
+
+// CatalogServicePurgeDeploymentPackageWithResponse request returning *CatalogServicePurgeDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServicePurgeDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServicePurgeDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServicePurgeDeploymentPackage(ctx, deploymentPackageName, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServicePurgeDeploymentPackageResponse(rsp)
+}
+
+// CatalogServiceRestoreDeploymentPackageWithResponse request returning *CatalogServiceRestoreDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceRestoreDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceRestoreDeploymentPackage(ctx, deploymentPackageName, version, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceRestoreDeploymentPackageResponse(rsp)
 }
 
-type CatalogServiceListArtifactsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListArtifactsResponse
+// CatalogServicePurgeRegistryWithResponse request returning *CatalogServicePurgeRegistryResponse
+func (c *ClientWithResponses) CatalogServicePurgeRegistryWithResponse(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*CatalogServicePurgeRegistryResponse, error) {
+	rsp, err := c.CatalogServicePurgeRegistry(ctx, registryName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServicePurgeRegistryResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceListArtifactsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceRestoreRegistryWithResponse request returning *CatalogServiceRestoreRegistryResponse
+func (c *ClientWithResponses) CatalogServiceRestoreRegistryWithResponse(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreRegistryResponse, error) {
+	rsp, err := c.CatalogServiceRestoreRegistry(ctx, registryName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceRestoreRegistryResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceListArtifactsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceListDeploymentPackagesWithResponse request returning *CatalogServiceListDeploymentPackagesResponse
+func (c *ClientWithResponses) CatalogServiceListDeploymentPackagesWithResponse(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListDeploymentPackagesResponse, error) {
+	rsp, err := c.CatalogServiceListDeploymentPackages(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceListDeploymentPackagesResponse(rsp)
 }
 
-type CatalogServiceCreateArtifactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateArtifactResponse
+// CatalogServiceCreateDeploymentPackageWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceCreateDeploymentPackageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceCreateDeploymentPackageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateDeploymentPackageResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceCreateArtifactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CatalogServiceCreateDeploymentPackageWithResponse(ctx context.Context, body CatalogServiceCreateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceCreateDeploymentPackage(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceCreateDeploymentPackageResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceCreateArtifactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceGetDeploymentPackageVersionsWithResponse request returning *CatalogServiceGetDeploymentPackageVersionsResponse
+func (c *ClientWithResponses) CatalogServiceGetDeploymentPackageVersionsWithResponse(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageVersionsResponse, error) {
+	rsp, err := c.CatalogServiceGetDeploymentPackageVersions(ctx, deploymentPackageName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceGetDeploymentPackageVersionsResponse(rsp)
 }
 
-type CatalogServiceDeleteArtifactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CatalogServiceDeleteDeploymentPackageWithResponse request returning *CatalogServiceDeleteDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceDeleteDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceDeleteDeploymentPackageParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceDeleteDeploymentPackage(ctx, deploymentPackageName, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceDeleteDeploymentPackageResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceDeleteArtifactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceGetDeploymentPackageWithResponse request returning *CatalogServiceGetDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceGetDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceGetDeploymentPackage(ctx, deploymentPackageName, version, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceGetDeploymentPackageResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceDeleteArtifactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceUpdateDeploymentPackageWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceUpdateDeploymentPackageWithBodyWithResponse(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceUpdateDeploymentPackageWithBody(ctx, deploymentPackageName, version, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceUpdateDeploymentPackageResponse(rsp)
 }
 
-type CatalogServiceGetArtifactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetArtifactResponse
+func (c *ClientWithResponses) CatalogServiceUpdateDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, params *CatalogServiceUpdateDeploymentPackageParams, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceUpdateDeploymentPackage(ctx, deploymentPackageName, version, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUpdateDeploymentPackageResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceGetArtifactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceResolveDeploymentPackageWithResponse request returning *CatalogServiceResolveDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceResolveDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceResolveDeploymentPackageResponse, error) {
+	rsp, err := c.CatalogServiceResolveDeploymentPackage(ctx, deploymentPackageName, version, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceResolveDeploymentPackageResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetArtifactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceListRegistriesWithResponse request returning *CatalogServiceListRegistriesResponse
+func (c *ClientWithResponses) CatalogServiceListRegistriesWithResponse(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRegistriesResponse, error) {
+	rsp, err := c.CatalogServiceListRegistries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceListRegistriesResponse(rsp)
 }
 
-type CatalogServiceUpdateArtifactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CatalogServiceCreateRegistryWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateRegistryResponse
+func (c *ClientWithResponses) CatalogServiceCreateRegistryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error) {
+	rsp, err := c.CatalogServiceCreateRegistryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateRegistryResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceUpdateArtifactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CatalogServiceCreateRegistryWithResponse(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error) {
+	rsp, err := c.CatalogServiceCreateRegistry(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceCreateRegistryResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceUpdateArtifactResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceDeleteRegistryWithResponse request returning *CatalogServiceDeleteRegistryResponse
+func (c *ClientWithResponses) CatalogServiceDeleteRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceDeleteRegistryParams, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteRegistryResponse, error) {
+	rsp, err := c.CatalogServiceDeleteRegistry(ctx, registryName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceDeleteRegistryResponse(rsp)
 }
 
-type CatalogServiceListDeploymentPackagesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListDeploymentPackagesResponse
+// CatalogServiceGetRegistryWithResponse request returning *CatalogServiceGetRegistryResponse
+func (c *ClientWithResponses) CatalogServiceGetRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*CatalogServiceGetRegistryResponse, error) {
+	rsp, err := c.CatalogServiceGetRegistry(ctx, registryName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceGetRegistryResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceListDeploymentPackagesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceUpdateRegistryWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateRegistryResponse
+func (c *ClientWithResponses) CatalogServiceUpdateRegistryWithBodyWithResponse(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error) {
+	rsp, err := c.CatalogServiceUpdateRegistryWithBody(ctx, registryName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceUpdateRegistryResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceListDeploymentPackagesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CatalogServiceUpdateRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceUpdateRegistryParams, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error) {
+	rsp, err := c.CatalogServiceUpdateRegistry(ctx, registryName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceUpdateRegistryResponse(rsp)
 }
 
-type CatalogServiceCreateDeploymentPackageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateDeploymentPackageResponse
+// CatalogServiceListRegistryChartsWithResponse request returning *CatalogServiceListRegistryChartsResponse
+func (c *ClientWithResponses) CatalogServiceListRegistryChartsWithResponse(ctx context.Context, registryName string, params *CatalogServiceListRegistryChartsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRegistryChartsResponse, error) {
+	rsp, err := c.CatalogServiceListRegistryCharts(ctx, registryName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceListRegistryChartsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceCreateDeploymentPackageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceListChartVersionsWithResponse request returning *CatalogServiceListChartVersionsResponse
+func (c *ClientWithResponses) CatalogServiceListChartVersionsWithResponse(ctx context.Context, registryName string, chartName string, params *CatalogServiceListChartVersionsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListChartVersionsResponse, error) {
+	rsp, err := c.CatalogServiceListChartVersions(ctx, registryName, chartName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceListChartVersionsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceCreateDeploymentPackageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceTestRegistryConnectionWithBodyWithResponse request with arbitrary body returning *CatalogServiceTestRegistryConnectionResponse
+func (c *ClientWithResponses) CatalogServiceTestRegistryConnectionWithBodyWithResponse(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceTestRegistryConnectionResponse, error) {
+	rsp, err := c.CatalogServiceTestRegistryConnectionWithBody(ctx, registryName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceTestRegistryConnectionResponse(rsp)
 }
 
-type CatalogServiceGetDeploymentPackageVersionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetDeploymentPackageVersionsResponse
+func (c *ClientWithResponses) CatalogServiceTestRegistryConnectionWithResponse(ctx context.Context, registryName string, body CatalogServiceTestRegistryConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceTestRegistryConnectionResponse, error) {
+	rsp, err := c.CatalogServiceTestRegistryConnection(ctx, registryName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceTestRegistryConnectionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceGetDeploymentPackageVersionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceListRevisionsWithResponse request returning *CatalogServiceListRevisionsResponse
+func (c *ClientWithResponses) CatalogServiceListRevisionsWithResponse(ctx context.Context, resourceType string, name string, version string, params *CatalogServiceListRevisionsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRevisionsResponse, error) {
+	rsp, err := c.CatalogServiceListRevisions(ctx, resourceType, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceListRevisionsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetDeploymentPackageVersionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceGetRevisionWithResponse request returning *CatalogServiceGetRevisionResponse
+func (c *ClientWithResponses) CatalogServiceGetRevisionWithResponse(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*CatalogServiceGetRevisionResponse, error) {
+	rsp, err := c.CatalogServiceGetRevision(ctx, resourceType, name, version, revision, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceGetRevisionResponse(rsp)
 }
 
-type CatalogServiceDeleteDeploymentPackageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CatalogServiceRestoreRevisionWithResponse request returning *CatalogServiceRestoreRevisionResponse
+func (c *ClientWithResponses) CatalogServiceRestoreRevisionWithResponse(ctx context.Context, resourceType string, name string, version string, revision uint32, reqEditors ...RequestEditorFn) (*CatalogServiceRestoreRevisionResponse, error) {
+	rsp, err := c.CatalogServiceRestoreRevision(ctx, resourceType, name, version, revision, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceRestoreRevisionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceDeleteDeploymentPackageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceSearchCatalogWithResponse request returning *CatalogServiceSearchCatalogResponse
+func (c *ClientWithResponses) CatalogServiceSearchCatalogWithResponse(ctx context.Context, params *CatalogServiceSearchCatalogParams, reqEditors ...RequestEditorFn) (*CatalogServiceSearchCatalogResponse, error) {
+	rsp, err := c.CatalogServiceSearchCatalog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceSearchCatalogResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceDeleteDeploymentPackageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceUploadCatalogEntitiesWithBodyWithResponse request with arbitrary body returning *CatalogServiceUploadCatalogEntitiesResponse
+func (c *ClientWithResponses) CatalogServiceUploadCatalogEntitiesWithBodyWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error) {
+	rsp, err := c.CatalogServiceUploadCatalogEntitiesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceUploadCatalogEntitiesResponse(rsp)
 }
 
-type CatalogServiceGetDeploymentPackageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetDeploymentPackageResponse
+func (c *ClientWithResponses) CatalogServiceUploadCatalogEntitiesWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error) {
+	rsp, err := c.CatalogServiceUploadCatalogEntities(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUploadCatalogEntitiesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceGetDeploymentPackageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceListWebhooksWithResponse request returning *CatalogServiceListWebhooksResponse
+func (c *ClientWithResponses) CatalogServiceListWebhooksWithResponse(ctx context.Context, params *CatalogServiceListWebhooksParams, reqEditors ...RequestEditorFn) (*CatalogServiceListWebhooksResponse, error) {
+	rsp, err := c.CatalogServiceListWebhooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceListWebhooksResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetDeploymentPackageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceCreateWebhookWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateWebhookResponse
+func (c *ClientWithResponses) CatalogServiceCreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateWebhookResponse, error) {
+	rsp, err := c.CatalogServiceCreateWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceCreateWebhookResponse(rsp)
 }
 
-type CatalogServiceUpdateDeploymentPackageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) CatalogServiceCreateWebhookWithResponse(ctx context.Context, body CatalogServiceCreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateWebhookResponse, error) {
+	rsp, err := c.CatalogServiceCreateWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceCreateWebhookResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceUpdateDeploymentPackageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CatalogServiceDeleteWebhookWithResponse request returning *CatalogServiceDeleteWebhookResponse
+func (c *ClientWithResponses) CatalogServiceDeleteWebhookWithResponse(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteWebhookResponse, error) {
+	rsp, err := c.CatalogServiceDeleteWebhook(ctx, webhookName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceDeleteWebhookResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceUpdateDeploymentPackageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceGetWebhookWithResponse request returning *CatalogServiceGetWebhookResponse
+func (c *ClientWithResponses) CatalogServiceGetWebhookWithResponse(ctx context.Context, webhookName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetWebhookResponse, error) {
+	rsp, err := c.CatalogServiceGetWebhook(ctx, webhookName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceGetWebhookResponse(rsp)
 }
 
-type CatalogServiceListRegistriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListRegistriesResponse
+// CatalogServiceUpdateWebhookWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateWebhookResponse
+func (c *ClientWithResponses) CatalogServiceUpdateWebhookWithBodyWithResponse(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateWebhookResponse, error) {
+	rsp, err := c.CatalogServiceUpdateWebhookWithBody(ctx, webhookName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogServiceUpdateWebhookResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceListRegistriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CatalogServiceUpdateWebhookWithResponse(ctx context.Context, webhookName string, params *CatalogServiceUpdateWebhookParams, body CatalogServiceUpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateWebhookResponse, error) {
+	rsp, err := c.CatalogServiceUpdateWebhook(ctx, webhookName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCatalogServiceUpdateWebhookResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceListRegistriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CatalogServiceListWebhookDeliveriesWithResponse request returning *CatalogServiceListWebhookDeliveriesResponse
+func (c *ClientWithResponses) CatalogServiceListWebhookDeliveriesWithResponse(ctx context.Context, webhookName string, params *CatalogServiceListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListWebhookDeliveriesResponse, error) {
+	rsp, err := c.CatalogServiceListWebhookDeliveries(ctx, webhookName, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCatalogServiceListWebhookDeliveriesResponse(rsp)
 }
 
-type CatalogServiceCreateRegistryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateRegistryResponse
-}
+// ParseCatalogServiceListApplicationsResponse parses an HTTP response from a CatalogServiceListApplicationsWithResponse call
+func ParseCatalogServiceListApplicationsResponse(rsp *http.Response) (*CatalogServiceListApplicationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceCreateRegistryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &CatalogServiceListApplicationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceCreateRegistryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListApplicationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type CatalogServiceDeleteRegistryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceDeleteRegistryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCatalogServiceCreateApplicationResponse parses an HTTP response from a CatalogServiceCreateApplicationWithResponse call
+func ParseCatalogServiceCreateApplicationResponse(rsp *http.Response) (*CatalogServiceCreateApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceDeleteRegistryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CatalogServiceCreateApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CatalogServiceGetRegistryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetRegistryResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateApplicationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceGetRegistryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceGetRegistryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCatalogServiceGetApplicationVersionsResponse parses an HTTP response from a CatalogServiceGetApplicationVersionsWithResponse call
+func ParseCatalogServiceGetApplicationVersionsResponse(rsp *http.Response) (*CatalogServiceGetApplicationVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CatalogServiceUpdateRegistryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	response := &CatalogServiceGetApplicationVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetApplicationVersionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceUpdateRegistryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceUpdateRegistryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCatalogServiceDeleteApplicationResponse parses an HTTP response from a CatalogServiceDeleteApplicationWithResponse call
+func ParseCatalogServiceDeleteApplicationResponse(rsp *http.Response) (*CatalogServiceDeleteApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CatalogServiceUploadCatalogEntitiesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UploadCatalogEntitiesResponse
+	response := &CatalogServiceDeleteApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CatalogServiceUploadCatalogEntitiesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCatalogServiceGetApplicationResponse parses an HTTP response from a CatalogServiceGetApplicationWithResponse call
+func ParseCatalogServiceGetApplicationResponse(rsp *http.Response) (*CatalogServiceGetApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &CatalogServiceGetApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetApplicationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CatalogServiceUploadCatalogEntitiesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCatalogServiceUpdateApplicationResponse parses an HTTP response from a CatalogServiceUpdateApplicationWithResponse call
+func ParseCatalogServiceUpdateApplicationResponse(rsp *http.Response) (*CatalogServiceUpdateApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &CatalogServiceUpdateApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// CatalogServiceListApplicationsWithResponse request returning *CatalogServiceListApplicationsResponse
-func (c *ClientWithResponses) CatalogServiceListApplicationsWithResponse(ctx context.Context, params *CatalogServiceListApplicationsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListApplicationsResponse, error) {
-	rsp, err := c.CatalogServiceListApplications(ctx, params, reqEditors...)
+// ParseCatalogServiceGetApplicationReferenceCountResponse parses an HTTP response from a CatalogServiceGetApplicationReferenceCountWithResponse call
+func ParseCatalogServiceGetApplicationReferenceCountResponse(rsp *http.Response) (*CatalogServiceGetApplicationReferenceCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceListApplicationsResponse(rsp)
+
+	response := &CatalogServiceGetApplicationReferenceCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetApplicationReferenceCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CatalogServiceCreateApplicationWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateApplicationResponse
-func (c *ClientWithResponses) CatalogServiceCreateApplicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error) {
-	rsp, err := c.CatalogServiceCreateApplicationWithBody(ctx, contentType, body, reqEditors...)
+// ParseCatalogServiceListArtifactsResponse parses an HTTP response from a CatalogServiceListArtifactsWithResponse call
+func ParseCatalogServiceListArtifactsResponse(rsp *http.Response) (*CatalogServiceListArtifactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceCreateApplicationResponse(rsp)
-}
 
-func (c *ClientWithResponses) CatalogServiceCreateApplicationWithResponse(ctx context.Context, body CatalogServiceCreateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateApplicationResponse, error) {
-	rsp, err := c.CatalogServiceCreateApplication(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceListArtifactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceCreateApplicationResponse(rsp)
-}
 
-// CatalogServiceGetApplicationVersionsWithResponse request returning *CatalogServiceGetApplicationVersionsResponse
-func (c *ClientWithResponses) CatalogServiceGetApplicationVersionsWithResponse(ctx context.Context, applicationName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationVersionsResponse, error) {
-	rsp, err := c.CatalogServiceGetApplicationVersions(ctx, applicationName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListArtifactsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceGetApplicationVersionsResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceDeleteApplicationWithResponse request returning *CatalogServiceDeleteApplicationResponse
-func (c *ClientWithResponses) CatalogServiceDeleteApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteApplicationResponse, error) {
-	rsp, err := c.CatalogServiceDeleteApplication(ctx, applicationName, version, reqEditors...)
+// ParseCatalogServiceCreateArtifactResponse parses an HTTP response from a CatalogServiceCreateArtifactWithResponse call
+func ParseCatalogServiceCreateArtifactResponse(rsp *http.Response) (*CatalogServiceCreateArtifactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceDeleteApplicationResponse(rsp)
-}
 
-// CatalogServiceGetApplicationWithResponse request returning *CatalogServiceGetApplicationResponse
-func (c *ClientWithResponses) CatalogServiceGetApplicationWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationResponse, error) {
-	rsp, err := c.CatalogServiceGetApplication(ctx, applicationName, version, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceCreateArtifactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceGetApplicationResponse(rsp)
-}
 
-// CatalogServiceUpdateApplicationWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateApplicationResponse
-func (c *ClientWithResponses) CatalogServiceUpdateApplicationWithBodyWithResponse(ctx context.Context, applicationName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error) {
-	rsp, err := c.CatalogServiceUpdateApplicationWithBody(ctx, applicationName, version, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateArtifactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceUpdateApplicationResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CatalogServiceUpdateApplicationWithResponse(ctx context.Context, applicationName string, version string, body CatalogServiceUpdateApplicationJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateApplicationResponse, error) {
-	rsp, err := c.CatalogServiceUpdateApplication(ctx, applicationName, version, body, reqEditors...)
+// ParseCatalogServiceDeleteArtifactResponse parses an HTTP response from a CatalogServiceDeleteArtifactWithResponse call
+func ParseCatalogServiceDeleteArtifactResponse(rsp *http.Response) (*CatalogServiceDeleteArtifactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceUpdateApplicationResponse(rsp)
-}
 
-// CatalogServiceGetApplicationReferenceCountWithResponse request returning *CatalogServiceGetApplicationReferenceCountResponse
-func (c *ClientWithResponses) CatalogServiceGetApplicationReferenceCountWithResponse(ctx context.Context, applicationName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetApplicationReferenceCountResponse, error) {
-	rsp, err := c.CatalogServiceGetApplicationReferenceCount(ctx, applicationName, version, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceDeleteArtifactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceGetApplicationReferenceCountResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceListArtifactsWithResponse request returning *CatalogServiceListArtifactsResponse
-func (c *ClientWithResponses) CatalogServiceListArtifactsWithResponse(ctx context.Context, params *CatalogServiceListArtifactsParams, reqEditors ...RequestEditorFn) (*CatalogServiceListArtifactsResponse, error) {
-	rsp, err := c.CatalogServiceListArtifacts(ctx, params, reqEditors...)
+// ParseCatalogServiceGetArtifactResponse parses an HTTP response from a CatalogServiceGetArtifactWithResponse call
+func ParseCatalogServiceGetArtifactResponse(rsp *http.Response) (*CatalogServiceGetArtifactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceListArtifactsResponse(rsp)
-}
 
-// CatalogServiceCreateArtifactWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateArtifactResponse
-func (c *ClientWithResponses) CatalogServiceCreateArtifactWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error) {
-	rsp, err := c.CatalogServiceCreateArtifactWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceGetArtifactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceCreateArtifactResponse(rsp)
-}
 
-func (c *ClientWithResponses) CatalogServiceCreateArtifactWithResponse(ctx context.Context, body CatalogServiceCreateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateArtifactResponse, error) {
-	rsp, err := c.CatalogServiceCreateArtifact(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetArtifactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceCreateArtifactResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceDeleteArtifactWithResponse request returning *CatalogServiceDeleteArtifactResponse
-func (c *ClientWithResponses) CatalogServiceDeleteArtifactWithResponse(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteArtifactResponse, error) {
-	rsp, err := c.CatalogServiceDeleteArtifact(ctx, artifactName, reqEditors...)
+// ParseCatalogServiceUpdateArtifactResponse parses an HTTP response from a CatalogServiceUpdateArtifactWithResponse call
+func ParseCatalogServiceUpdateArtifactResponse(rsp *http.Response) (*CatalogServiceUpdateArtifactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceDeleteArtifactResponse(rsp)
-}
 
-// CatalogServiceGetArtifactWithResponse request returning *CatalogServiceGetArtifactResponse
-func (c *ClientWithResponses) CatalogServiceGetArtifactWithResponse(ctx context.Context, artifactName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetArtifactResponse, error) {
-	rsp, err := c.CatalogServiceGetArtifact(ctx, artifactName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceUpdateArtifactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceGetArtifactResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceUpdateArtifactWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateArtifactResponse
-func (c *ClientWithResponses) CatalogServiceUpdateArtifactWithBodyWithResponse(ctx context.Context, artifactName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error) {
-	rsp, err := c.CatalogServiceUpdateArtifactWithBody(ctx, artifactName, contentType, body, reqEditors...)
+// ParseCatalogServiceListAuditEventsResponse parses an HTTP response from a CatalogServiceListAuditEventsWithResponse call
+func ParseCatalogServiceListAuditEventsResponse(rsp *http.Response) (*CatalogServiceListAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceUpdateArtifactResponse(rsp)
-}
 
-func (c *ClientWithResponses) CatalogServiceUpdateArtifactWithResponse(ctx context.Context, artifactName string, body CatalogServiceUpdateArtifactJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateArtifactResponse, error) {
-	rsp, err := c.CatalogServiceUpdateArtifact(ctx, artifactName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceListAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceUpdateArtifactResponse(rsp)
-}
 
-// CatalogServiceListDeploymentPackagesWithResponse request returning *CatalogServiceListDeploymentPackagesResponse
-func (c *ClientWithResponses) CatalogServiceListDeploymentPackagesWithResponse(ctx context.Context, params *CatalogServiceListDeploymentPackagesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListDeploymentPackagesResponse, error) {
-	rsp, err := c.CatalogServiceListDeploymentPackages(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAuditEventsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceListDeploymentPackagesResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceCreateDeploymentPackageWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateDeploymentPackageResponse
-func (c *ClientWithResponses) CatalogServiceCreateDeploymentPackageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error) {
-	rsp, err := c.CatalogServiceCreateDeploymentPackageWithBody(ctx, contentType, body, reqEditors...)
+// ParseCatalogServiceListDeletedResponse parses an HTTP response from a CatalogServiceListDeletedWithResponse call
+func ParseCatalogServiceListDeletedResponse(rsp *http.Response) (*CatalogServiceListDeletedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceCreateDeploymentPackageResponse(rsp)
-}
 
-func (c *ClientWithResponses) CatalogServiceCreateDeploymentPackageWithResponse(ctx context.Context, body CatalogServiceCreateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateDeploymentPackageResponse, error) {
-	rsp, err := c.CatalogServiceCreateDeploymentPackage(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceListDeletedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceCreateDeploymentPackageResponse(rsp)
-}
 
-// CatalogServiceGetDeploymentPackageVersionsWithResponse request returning *CatalogServiceGetDeploymentPackageVersionsResponse
-func (c *ClientWithResponses) CatalogServiceGetDeploymentPackageVersionsWithResponse(ctx context.Context, deploymentPackageName string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageVersionsResponse, error) {
-	rsp, err := c.CatalogServiceGetDeploymentPackageVersions(ctx, deploymentPackageName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListDeletedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceGetDeploymentPackageVersionsResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceDeleteDeploymentPackageWithResponse request returning *CatalogServiceDeleteDeploymentPackageResponse
-func (c *ClientWithResponses) CatalogServiceDeleteDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteDeploymentPackageResponse, error) {
-	rsp, err := c.CatalogServiceDeleteDeploymentPackage(ctx, deploymentPackageName, version, reqEditors...)
+// ParseCatalogServicePurgeApplicationResponse parses an HTTP response from a CatalogServicePurgeApplicationWithResponse call
+func ParseCatalogServicePurgeApplicationResponse(rsp *http.Response) (*CatalogServicePurgeApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceDeleteDeploymentPackageResponse(rsp)
-}
 
-// CatalogServiceGetDeploymentPackageWithResponse request returning *CatalogServiceGetDeploymentPackageResponse
-func (c *ClientWithResponses) CatalogServiceGetDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, reqEditors ...RequestEditorFn) (*CatalogServiceGetDeploymentPackageResponse, error) {
-	rsp, err := c.CatalogServiceGetDeploymentPackage(ctx, deploymentPackageName, version, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServicePurgeApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceGetDeploymentPackageResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceUpdateDeploymentPackageWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateDeploymentPackageResponse
-func (c *ClientWithResponses) CatalogServiceUpdateDeploymentPackageWithBodyWithResponse(ctx context.Context, deploymentPackageName string, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error) {
-	rsp, err := c.CatalogServiceUpdateDeploymentPackageWithBody(ctx, deploymentPackageName, version, contentType, body, reqEditors...)
+// ParseCatalogServiceRestoreApplicationResponse parses an HTTP response from a CatalogServiceRestoreApplicationWithResponse call
+func ParseCatalogServiceRestoreApplicationResponse(rsp *http.Response) (*CatalogServiceRestoreApplicationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceUpdateDeploymentPackageResponse(rsp)
-}
 
-func (c *ClientWithResponses) CatalogServiceUpdateDeploymentPackageWithResponse(ctx context.Context, deploymentPackageName string, version string, body CatalogServiceUpdateDeploymentPackageJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateDeploymentPackageResponse, error) {
-	rsp, err := c.CatalogServiceUpdateDeploymentPackage(ctx, deploymentPackageName, version, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceRestoreApplicationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceUpdateDeploymentPackageResponse(rsp)
-}
 
-// CatalogServiceListRegistriesWithResponse request returning *CatalogServiceListRegistriesResponse
-func (c *ClientWithResponses) CatalogServiceListRegistriesWithResponse(ctx context.Context, params *CatalogServiceListRegistriesParams, reqEditors ...RequestEditorFn) (*CatalogServiceListRegistriesResponse, error) {
-	rsp, err := c.CatalogServiceListRegistries(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreApplicationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceListRegistriesResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceCreateRegistryWithBodyWithResponse request with arbitrary body returning *CatalogServiceCreateRegistryResponse
-func (c *ClientWithResponses) CatalogServiceCreateRegistryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error) {
-	rsp, err := c.CatalogServiceCreateRegistryWithBody(ctx, contentType, body, reqEditors...)
+// ParseCatalogServicePurgeDeploymentPackageResponse parses an HTTP response from a CatalogServicePurgeDeploymentPackageWithResponse call
+func ParseCatalogServicePurgeDeploymentPackageResponse(rsp *http.Response) (*CatalogServicePurgeDeploymentPackageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceCreateRegistryResponse(rsp)
+
+	response := &CatalogServicePurgeDeploymentPackageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CatalogServiceCreateRegistryWithResponse(ctx context.Context, body CatalogServiceCreateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceCreateRegistryResponse, error) {
-	rsp, err := c.CatalogServiceCreateRegistry(ctx, body, reqEditors...)
+// ParseCatalogServiceRestoreDeploymentPackageResponse parses an HTTP response from a CatalogServiceRestoreDeploymentPackageWithResponse call
+func ParseCatalogServiceRestoreDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceRestoreDeploymentPackageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceCreateRegistryResponse(rsp)
-}
 
-// CatalogServiceDeleteRegistryWithResponse request returning *CatalogServiceDeleteRegistryResponse
-func (c *ClientWithResponses) CatalogServiceDeleteRegistryWithResponse(ctx context.Context, registryName string, reqEditors ...RequestEditorFn) (*CatalogServiceDeleteRegistryResponse, error) {
-	rsp, err := c.CatalogServiceDeleteRegistry(ctx, registryName, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceRestoreDeploymentPackageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreDeploymentPackageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceDeleteRegistryResponse(rsp)
+
+	return response, nil
 }
 
-// CatalogServiceGetRegistryWithResponse request returning *CatalogServiceGetRegistryResponse
-func (c *ClientWithResponses) CatalogServiceGetRegistryWithResponse(ctx context.Context, registryName string, params *CatalogServiceGetRegistryParams, reqEditors ...RequestEditorFn) (*CatalogServiceGetRegistryResponse, error) {
-	rsp, err := c.CatalogServiceGetRegistry(ctx, registryName, params, reqEditors...)
+// ParseCatalogServicePurgeRegistryResponse parses an HTTP response from a CatalogServicePurgeRegistryWithResponse call
+func ParseCatalogServicePurgeRegistryResponse(rsp *http.Response) (*CatalogServicePurgeRegistryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceGetRegistryResponse(rsp)
-}
 
-// CatalogServiceUpdateRegistryWithBodyWithResponse request with arbitrary body returning *CatalogServiceUpdateRegistryResponse
-func (c *ClientWithResponses) CatalogServiceUpdateRegistryWithBodyWithResponse(ctx context.Context, registryName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error) {
-	rsp, err := c.CatalogServiceUpdateRegistryWithBody(ctx, registryName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServicePurgeRegistryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceUpdateRegistryResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CatalogServiceUpdateRegistryWithResponse(ctx context.Context, registryName string, body CatalogServiceUpdateRegistryJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUpdateRegistryResponse, error) {
-	rsp, err := c.CatalogServiceUpdateRegistry(ctx, registryName, body, reqEditors...)
+// ParseCatalogServiceRestoreRegistryResponse parses an HTTP response from a CatalogServiceRestoreRegistryWithResponse call
+func ParseCatalogServiceRestoreRegistryResponse(rsp *http.Response) (*CatalogServiceRestoreRegistryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCatalogServiceUpdateRegistryResponse(rsp)
-}
 
-// CatalogServiceUploadCatalogEntitiesWithBodyWithResponse request with arbitrary body returning *CatalogServiceUploadCatalogEntitiesResponse
-func (c *ClientWithResponses) CatalogServiceUploadCatalogEntitiesWithBodyWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error) {
-	rsp, err := c.CatalogServiceUploadCatalogEntitiesWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CatalogServiceRestoreRegistryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCatalogServiceUploadCatalogEntitiesResponse(rsp)
-}
 
-func (c *ClientWithResponses) CatalogServiceUploadCatalogEntitiesWithResponse(ctx context.Context, params *CatalogServiceUploadCatalogEntitiesParams, body CatalogServiceUploadCatalogEntitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*CatalogServiceUploadCatalogEntitiesResponse, error) {
-	rsp, err := c.CatalogServiceUploadCatalogEntities(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreRegistryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCatalogServiceUploadCatalogEntitiesResponse(rsp)
+
+	return response, nil
 }
 
-// ParseCatalogServiceListApplicationsResponse parses an HTTP response from a CatalogServiceListApplicationsWithResponse call
-func ParseCatalogServiceListApplicationsResponse(rsp *http.Response) (*CatalogServiceListApplicationsResponse, error) {
+// ParseCatalogServiceListDeploymentPackagesResponse parses an HTTP response from a CatalogServiceListDeploymentPackagesWithResponse call
+func ParseCatalogServiceListDeploymentPackagesResponse(rsp *http.Response) (*CatalogServiceListDeploymentPackagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceListApplicationsResponse{
+	response := &CatalogServiceListDeploymentPackagesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListApplicationsResponse
+		var dest ListDeploymentPackagesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2867,22 +6375,22 @@ func ParseCatalogServiceListApplicationsResponse(rsp *http.Response) (*CatalogSe
 	return response, nil
 }
 
-// ParseCatalogServiceCreateApplicationResponse parses an HTTP response from a CatalogServiceCreateApplicationWithResponse call
-func ParseCatalogServiceCreateApplicationResponse(rsp *http.Response) (*CatalogServiceCreateApplicationResponse, error) {
+// ParseCatalogServiceCreateDeploymentPackageResponse parses an HTTP response from a CatalogServiceCreateDeploymentPackageWithResponse call
+func ParseCatalogServiceCreateDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceCreateDeploymentPackageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceCreateApplicationResponse{
+	response := &CatalogServiceCreateDeploymentPackageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateApplicationResponse
+		var dest CreateDeploymentPackageResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2893,22 +6401,22 @@ func ParseCatalogServiceCreateApplicationResponse(rsp *http.Response) (*CatalogS
 	return response, nil
 }
 
-// ParseCatalogServiceGetApplicationVersionsResponse parses an HTTP response from a CatalogServiceGetApplicationVersionsWithResponse call
-func ParseCatalogServiceGetApplicationVersionsResponse(rsp *http.Response) (*CatalogServiceGetApplicationVersionsResponse, error) {
+// ParseCatalogServiceGetDeploymentPackageVersionsResponse parses an HTTP response from a CatalogServiceGetDeploymentPackageVersionsWithResponse call
+func ParseCatalogServiceGetDeploymentPackageVersionsResponse(rsp *http.Response) (*CatalogServiceGetDeploymentPackageVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetApplicationVersionsResponse{
+	response := &CatalogServiceGetDeploymentPackageVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetApplicationVersionsResponse
+		var dest GetDeploymentPackageVersionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2919,15 +6427,15 @@ func ParseCatalogServiceGetApplicationVersionsResponse(rsp *http.Response) (*Cat
 	return response, nil
 }
 
-// ParseCatalogServiceDeleteApplicationResponse parses an HTTP response from a CatalogServiceDeleteApplicationWithResponse call
-func ParseCatalogServiceDeleteApplicationResponse(rsp *http.Response) (*CatalogServiceDeleteApplicationResponse, error) {
+// ParseCatalogServiceDeleteDeploymentPackageResponse parses an HTTP response from a CatalogServiceDeleteDeploymentPackageWithResponse call
+func ParseCatalogServiceDeleteDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceDeleteDeploymentPackageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceDeleteApplicationResponse{
+	response := &CatalogServiceDeleteDeploymentPackageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2935,22 +6443,22 @@ func ParseCatalogServiceDeleteApplicationResponse(rsp *http.Response) (*CatalogS
 	return response, nil
 }
 
-// ParseCatalogServiceGetApplicationResponse parses an HTTP response from a CatalogServiceGetApplicationWithResponse call
-func ParseCatalogServiceGetApplicationResponse(rsp *http.Response) (*CatalogServiceGetApplicationResponse, error) {
+// ParseCatalogServiceGetDeploymentPackageResponse parses an HTTP response from a CatalogServiceGetDeploymentPackageWithResponse call
+func ParseCatalogServiceGetDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceGetDeploymentPackageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetApplicationResponse{
+	response := &CatalogServiceGetDeploymentPackageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetApplicationResponse
+		var dest GetDeploymentPackageResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2961,38 +6469,48 @@ func ParseCatalogServiceGetApplicationResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceUpdateApplicationResponse parses an HTTP response from a CatalogServiceUpdateApplicationWithResponse call
-func ParseCatalogServiceUpdateApplicationResponse(rsp *http.Response) (*CatalogServiceUpdateApplicationResponse, error) {
+// ParseCatalogServiceUpdateDeploymentPackageResponse parses an HTTP response from a CatalogServiceUpdateDeploymentPackageWithResponse call
+func ParseCatalogServiceUpdateDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceUpdateDeploymentPackageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceUpdateApplicationResponse{
+	response := &CatalogServiceUpdateDeploymentPackageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateDeploymentPackageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseCatalogServiceGetApplicationReferenceCountResponse parses an HTTP response from a CatalogServiceGetApplicationReferenceCountWithResponse call
-func ParseCatalogServiceGetApplicationReferenceCountResponse(rsp *http.Response) (*CatalogServiceGetApplicationReferenceCountResponse, error) {
+// ParseCatalogServiceResolveDeploymentPackageResponse parses an HTTP response from a CatalogServiceResolveDeploymentPackageWithResponse call
+func ParseCatalogServiceResolveDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceResolveDeploymentPackageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetApplicationReferenceCountResponse{
+	response := &CatalogServiceResolveDeploymentPackageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetApplicationReferenceCountResponse
+		var dest ResolveDeploymentPackageResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3003,22 +6521,22 @@ func ParseCatalogServiceGetApplicationReferenceCountResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseCatalogServiceListArtifactsResponse parses an HTTP response from a CatalogServiceListArtifactsWithResponse call
-func ParseCatalogServiceListArtifactsResponse(rsp *http.Response) (*CatalogServiceListArtifactsResponse, error) {
+// ParseCatalogServiceListRegistriesResponse parses an HTTP response from a CatalogServiceListRegistriesWithResponse call
+func ParseCatalogServiceListRegistriesResponse(rsp *http.Response) (*CatalogServiceListRegistriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceListArtifactsResponse{
+	response := &CatalogServiceListRegistriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListArtifactsResponse
+		var dest ListRegistriesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3029,22 +6547,22 @@ func ParseCatalogServiceListArtifactsResponse(rsp *http.Response) (*CatalogServi
 	return response, nil
 }
 
-// ParseCatalogServiceCreateArtifactResponse parses an HTTP response from a CatalogServiceCreateArtifactWithResponse call
-func ParseCatalogServiceCreateArtifactResponse(rsp *http.Response) (*CatalogServiceCreateArtifactResponse, error) {
+// ParseCatalogServiceCreateRegistryResponse parses an HTTP response from a CatalogServiceCreateRegistryWithResponse call
+func ParseCatalogServiceCreateRegistryResponse(rsp *http.Response) (*CatalogServiceCreateRegistryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceCreateArtifactResponse{
+	response := &CatalogServiceCreateRegistryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateArtifactResponse
+		var dest CreateRegistryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3055,15 +6573,15 @@ func ParseCatalogServiceCreateArtifactResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceDeleteArtifactResponse parses an HTTP response from a CatalogServiceDeleteArtifactWithResponse call
-func ParseCatalogServiceDeleteArtifactResponse(rsp *http.Response) (*CatalogServiceDeleteArtifactResponse, error) {
+// ParseCatalogServiceDeleteRegistryResponse parses an HTTP response from a CatalogServiceDeleteRegistryWithResponse call
+func ParseCatalogServiceDeleteRegistryResponse(rsp *http.Response) (*CatalogServiceDeleteRegistryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceDeleteArtifactResponse{
+	response := &CatalogServiceDeleteRegistryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3071,22 +6589,22 @@ func ParseCatalogServiceDeleteArtifactResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceGetArtifactResponse parses an HTTP response from a CatalogServiceGetArtifactWithResponse call
-func ParseCatalogServiceGetArtifactResponse(rsp *http.Response) (*CatalogServiceGetArtifactResponse, error) {
+// ParseCatalogServiceGetRegistryResponse parses an HTTP response from a CatalogServiceGetRegistryWithResponse call
+func ParseCatalogServiceGetRegistryResponse(rsp *http.Response) (*CatalogServiceGetRegistryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetArtifactResponse{
+	response := &CatalogServiceGetRegistryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetArtifactResponse
+		var dest GetRegistryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3097,15 +6615,15 @@ func ParseCatalogServiceGetArtifactResponse(rsp *http.Response) (*CatalogService
 	return response, nil
 }
 
-// ParseCatalogServiceUpdateArtifactResponse parses an HTTP response from a CatalogServiceUpdateArtifactWithResponse call
-func ParseCatalogServiceUpdateArtifactResponse(rsp *http.Response) (*CatalogServiceUpdateArtifactResponse, error) {
+// ParseCatalogServiceUpdateRegistryResponse parses an HTTP response from a CatalogServiceUpdateRegistryWithResponse call
+func ParseCatalogServiceUpdateRegistryResponse(rsp *http.Response) (*CatalogServiceUpdateRegistryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceUpdateArtifactResponse{
+	response := &CatalogServiceUpdateRegistryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3113,22 +6631,22 @@ func ParseCatalogServiceUpdateArtifactResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceListDeploymentPackagesResponse parses an HTTP response from a CatalogServiceListDeploymentPackagesWithResponse call
-func ParseCatalogServiceListDeploymentPackagesResponse(rsp *http.Response) (*CatalogServiceListDeploymentPackagesResponse, error) {
+// ParseCatalogServiceListRegistryChartsResponse parses an HTTP response from a CatalogServiceListRegistryChartsWithResponse call
+func ParseCatalogServiceListRegistryChartsResponse(rsp *http.Response) (*CatalogServiceListRegistryChartsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceListDeploymentPackagesResponse{
+	response := &CatalogServiceListRegistryChartsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListDeploymentPackagesResponse
+		var dest ListRegistryChartsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3139,22 +6657,22 @@ func ParseCatalogServiceListDeploymentPackagesResponse(rsp *http.Response) (*Cat
 	return response, nil
 }
 
-// ParseCatalogServiceCreateDeploymentPackageResponse parses an HTTP response from a CatalogServiceCreateDeploymentPackageWithResponse call
-func ParseCatalogServiceCreateDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceCreateDeploymentPackageResponse, error) {
+// ParseCatalogServiceListChartVersionsResponse parses an HTTP response from a CatalogServiceListChartVersionsWithResponse call
+func ParseCatalogServiceListChartVersionsResponse(rsp *http.Response) (*CatalogServiceListChartVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceCreateDeploymentPackageResponse{
+	response := &CatalogServiceListChartVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateDeploymentPackageResponse
+		var dest ListChartVersionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3165,22 +6683,22 @@ func ParseCatalogServiceCreateDeploymentPackageResponse(rsp *http.Response) (*Ca
 	return response, nil
 }
 
-// ParseCatalogServiceGetDeploymentPackageVersionsResponse parses an HTTP response from a CatalogServiceGetDeploymentPackageVersionsWithResponse call
-func ParseCatalogServiceGetDeploymentPackageVersionsResponse(rsp *http.Response) (*CatalogServiceGetDeploymentPackageVersionsResponse, error) {
+// ParseCatalogServiceTestRegistryConnectionResponse parses an HTTP response from a CatalogServiceTestRegistryConnectionWithResponse call
+func ParseCatalogServiceTestRegistryConnectionResponse(rsp *http.Response) (*CatalogServiceTestRegistryConnectionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetDeploymentPackageVersionsResponse{
+	response := &CatalogServiceTestRegistryConnectionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetDeploymentPackageVersionsResponse
+		var dest TestRegistryConnectionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3191,38 +6709,48 @@ func ParseCatalogServiceGetDeploymentPackageVersionsResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseCatalogServiceDeleteDeploymentPackageResponse parses an HTTP response from a CatalogServiceDeleteDeploymentPackageWithResponse call
-func ParseCatalogServiceDeleteDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceDeleteDeploymentPackageResponse, error) {
+// ParseCatalogServiceListRevisionsResponse parses an HTTP response from a CatalogServiceListRevisionsWithResponse call
+func ParseCatalogServiceListRevisionsResponse(rsp *http.Response) (*CatalogServiceListRevisionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceDeleteDeploymentPackageResponse{
+	response := &CatalogServiceListRevisionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListRevisionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseCatalogServiceGetDeploymentPackageResponse parses an HTTP response from a CatalogServiceGetDeploymentPackageWithResponse call
-func ParseCatalogServiceGetDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceGetDeploymentPackageResponse, error) {
+// ParseCatalogServiceGetRevisionResponse parses an HTTP response from a CatalogServiceGetRevisionWithResponse call
+func ParseCatalogServiceGetRevisionResponse(rsp *http.Response) (*CatalogServiceGetRevisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetDeploymentPackageResponse{
+	response := &CatalogServiceGetRevisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetDeploymentPackageResponse
+		var dest GetRevisionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3233,38 +6761,48 @@ func ParseCatalogServiceGetDeploymentPackageResponse(rsp *http.Response) (*Catal
 	return response, nil
 }
 
-// ParseCatalogServiceUpdateDeploymentPackageResponse parses an HTTP response from a CatalogServiceUpdateDeploymentPackageWithResponse call
-func ParseCatalogServiceUpdateDeploymentPackageResponse(rsp *http.Response) (*CatalogServiceUpdateDeploymentPackageResponse, error) {
+// ParseCatalogServiceRestoreRevisionResponse parses an HTTP response from a CatalogServiceRestoreRevisionWithResponse call
+func ParseCatalogServiceRestoreRevisionResponse(rsp *http.Response) (*CatalogServiceRestoreRevisionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceUpdateDeploymentPackageResponse{
+	response := &CatalogServiceRestoreRevisionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreRevisionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseCatalogServiceListRegistriesResponse parses an HTTP response from a CatalogServiceListRegistriesWithResponse call
-func ParseCatalogServiceListRegistriesResponse(rsp *http.Response) (*CatalogServiceListRegistriesResponse, error) {
+// ParseCatalogServiceSearchCatalogResponse parses an HTTP response from a CatalogServiceSearchCatalogWithResponse call
+func ParseCatalogServiceSearchCatalogResponse(rsp *http.Response) (*CatalogServiceSearchCatalogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceListRegistriesResponse{
+	response := &CatalogServiceSearchCatalogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListRegistriesResponse
+		var dest SearchCatalogResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3275,22 +6813,22 @@ func ParseCatalogServiceListRegistriesResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceCreateRegistryResponse parses an HTTP response from a CatalogServiceCreateRegistryWithResponse call
-func ParseCatalogServiceCreateRegistryResponse(rsp *http.Response) (*CatalogServiceCreateRegistryResponse, error) {
+// ParseCatalogServiceUploadCatalogEntitiesResponse parses an HTTP response from a CatalogServiceUploadCatalogEntitiesWithResponse call
+func ParseCatalogServiceUploadCatalogEntitiesResponse(rsp *http.Response) (*CatalogServiceUploadCatalogEntitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceCreateRegistryResponse{
+	response := &CatalogServiceUploadCatalogEntitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateRegistryResponse
+		var dest UploadCatalogEntitiesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3301,38 +6839,48 @@ func ParseCatalogServiceCreateRegistryResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceDeleteRegistryResponse parses an HTTP response from a CatalogServiceDeleteRegistryWithResponse call
-func ParseCatalogServiceDeleteRegistryResponse(rsp *http.Response) (*CatalogServiceDeleteRegistryResponse, error) {
+// ParseCatalogServiceListWebhooksResponse parses an HTTP response from a CatalogServiceListWebhooksWithResponse call
+func ParseCatalogServiceListWebhooksResponse(rsp *http.Response) (*CatalogServiceListWebhooksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceDeleteRegistryResponse{
+	response := &CatalogServiceListWebhooksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListWebhooksResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseCatalogServiceGetRegistryResponse parses an HTTP response from a CatalogServiceGetRegistryWithResponse call
-func ParseCatalogServiceGetRegistryResponse(rsp *http.Response) (*CatalogServiceGetRegistryResponse, error) {
+// ParseCatalogServiceCreateWebhookResponse parses an HTTP response from a CatalogServiceCreateWebhookWithResponse call
+func ParseCatalogServiceCreateWebhookResponse(rsp *http.Response) (*CatalogServiceCreateWebhookResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceGetRegistryResponse{
+	response := &CatalogServiceCreateWebhookResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetRegistryResponse
+		var dest CreateWebhookResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3343,15 +6891,15 @@ func ParseCatalogServiceGetRegistryResponse(rsp *http.Response) (*CatalogService
 	return response, nil
 }
 
-// ParseCatalogServiceUpdateRegistryResponse parses an HTTP response from a CatalogServiceUpdateRegistryWithResponse call
-func ParseCatalogServiceUpdateRegistryResponse(rsp *http.Response) (*CatalogServiceUpdateRegistryResponse, error) {
+// ParseCatalogServiceDeleteWebhookResponse parses an HTTP response from a CatalogServiceDeleteWebhookWithResponse call
+func ParseCatalogServiceDeleteWebhookResponse(rsp *http.Response) (*CatalogServiceDeleteWebhookResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceUpdateRegistryResponse{
+	response := &CatalogServiceDeleteWebhookResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3359,22 +6907,64 @@ func ParseCatalogServiceUpdateRegistryResponse(rsp *http.Response) (*CatalogServ
 	return response, nil
 }
 
-// ParseCatalogServiceUploadCatalogEntitiesResponse parses an HTTP response from a CatalogServiceUploadCatalogEntitiesWithResponse call
-func ParseCatalogServiceUploadCatalogEntitiesResponse(rsp *http.Response) (*CatalogServiceUploadCatalogEntitiesResponse, error) {
+// ParseCatalogServiceGetWebhookResponse parses an HTTP response from a CatalogServiceGetWebhookWithResponse call
+func ParseCatalogServiceGetWebhookResponse(rsp *http.Response) (*CatalogServiceGetWebhookResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CatalogServiceUploadCatalogEntitiesResponse{
+	response := &CatalogServiceGetWebhookResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UploadCatalogEntitiesResponse
+		var dest GetWebhookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCatalogServiceUpdateWebhookResponse parses an HTTP response from a CatalogServiceUpdateWebhookWithResponse call
+func ParseCatalogServiceUpdateWebhookResponse(rsp *http.Response) (*CatalogServiceUpdateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CatalogServiceUpdateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCatalogServiceListWebhookDeliveriesResponse parses an HTTP response from a CatalogServiceListWebhookDeliveriesWithResponse call
+func ParseCatalogServiceListWebhookDeliveriesResponse(rsp *http.Response) (*CatalogServiceListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CatalogServiceListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListWebhookDeliveriesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -21,6 +21,23 @@ const (
 	DeploymentPackageKindKINDNORMAL    DeploymentPackageKind = "KIND_NORMAL"
 )
 
+// Defines values for UploadPlannedChangeAction.
+const (
+	UploadPlannedChangeActionACTIONCREATE    UploadPlannedChangeAction = "ACTION_CREATE"
+	UploadPlannedChangeActionACTIONSKIP      UploadPlannedChangeAction = "ACTION_SKIP"
+	UploadPlannedChangeActionACTIONUNCHANGED UploadPlannedChangeAction = "ACTION_UNCHANGED"
+	UploadPlannedChangeActionACTIONUPDATE    UploadPlannedChangeAction = "ACTION_UPDATE"
+)
+
+// Defines values for UploadResultAction.
+const (
+	UploadResultActionACTIONCREATED   UploadResultAction = "ACTION_CREATED"
+	UploadResultActionACTIONFAILED    UploadResultAction = "ACTION_FAILED"
+	UploadResultActionACTIONSKIPPED   UploadResultAction = "ACTION_SKIPPED"
+	UploadResultActionACTIONUNCHANGED UploadResultAction = "ACTION_UNCHANGED"
+	UploadResultActionACTIONUPDATED   UploadResultAction = "ACTION_UPDATED"
+)
+
 // Defines values for CatalogServiceListApplicationsParamsKinds.
 const (
 	CatalogServiceListApplicationsParamsKindsKINDADDON     CatalogServiceListApplicationsParamsKinds = "KIND_ADDON"
@@ -35,6 +52,41 @@ const (
 	CatalogServiceListDeploymentPackagesParamsKindsKINDNORMAL    CatalogServiceListDeploymentPackagesParamsKinds = "KIND_NORMAL"
 )
 
+// Defines values for CatalogServiceUploadCatalogEntitiesParamsConflictPolicy.
+const (
+	CatalogServiceUploadCatalogEntitiesParamsConflictPolicyUPLOADCONFLICTPOLICYFAIL      CatalogServiceUploadCatalogEntitiesParamsConflictPolicy = "UPLOAD_CONFLICT_POLICY_FAIL"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPolicyUPLOADCONFLICTPOLICYOVERWRITE CatalogServiceUploadCatalogEntitiesParamsConflictPolicy = "UPLOAD_CONFLICT_POLICY_OVERWRITE"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPolicyUPLOADCONFLICTPOLICYSKIP      CatalogServiceUploadCatalogEntitiesParamsConflictPolicy = "UPLOAD_CONFLICT_POLICY_SKIP"
+)
+
+// Defines values for CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries.
+const (
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistriesUPLOADCONFLICTPOLICYFAIL      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries = "UPLOAD_CONFLICT_POLICY_FAIL"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistriesUPLOADCONFLICTPOLICYOVERWRITE CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries = "UPLOAD_CONFLICT_POLICY_OVERWRITE"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistriesUPLOADCONFLICTPOLICYSKIP      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries = "UPLOAD_CONFLICT_POLICY_SKIP"
+)
+
+// Defines values for CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts.
+const (
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifactsUPLOADCONFLICTPOLICYFAIL      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts = "UPLOAD_CONFLICT_POLICY_FAIL"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifactsUPLOADCONFLICTPOLICYOVERWRITE CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts = "UPLOAD_CONFLICT_POLICY_OVERWRITE"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifactsUPLOADCONFLICTPOLICYSKIP      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts = "UPLOAD_CONFLICT_POLICY_SKIP"
+)
+
+// Defines values for CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications.
+const (
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplicationsUPLOADCONFLICTPOLICYFAIL      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications = "UPLOAD_CONFLICT_POLICY_FAIL"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplicationsUPLOADCONFLICTPOLICYOVERWRITE CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications = "UPLOAD_CONFLICT_POLICY_OVERWRITE"
+	CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplicationsUPLOADCONFLICTPOLICYSKIP      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications = "UPLOAD_CONFLICT_POLICY_SKIP"
+)
+
+// Defines values for CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages.
+const (
+	UPLOADCONFLICTPOLICYFAIL      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages = "UPLOAD_CONFLICT_POLICY_FAIL"
+	UPLOADCONFLICTPOLICYOVERWRITE CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages = "UPLOAD_CONFLICT_POLICY_OVERWRITE"
+	UPLOADCONFLICTPOLICYSKIP      CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages = "UPLOAD_CONFLICT_POLICY_SKIP"
+)
+
 // APIExtension APIExtensions represents some form of an extension to the external API provided by deployment package.
 type APIExtension struct {
 	// Description Description of the API extension. Displayed on user interfaces.
@@ -70,12 +122,18 @@ type Application struct {
 	// DefaultProfileName Name of the profile to be used by default when deploying this application. If at least one profile is available, this field must be set.
 	DefaultProfileName *string `json:"defaultProfileName,omitempty"`
 
+	// Deprecation Deprecation describes why an application or deployment package version is deprecated and which version to use instead.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+
 	// Description Description of the application. Displayed on user interfaces.
 	Description *string `json:"description,omitempty"`
 
 	// DisplayName Display name is an optional human-readable name for the application. When specified, it must be unique among all applications within a project. It is used for display purposes on user interfaces.
 	DisplayName *string `json:"displayName,omitempty"`
 
+	// Etag Opaque tag of the current state of the application; changes whenever the application is updated. May be given to the update and delete requests to make sure that the application was not changed in the meantime.
+	Etag *string `json:"etag,omitempty"`
+
 	// HelmRegistryName ID of the project's registry where the Helm chart of the application is available for download.
 	HelmRegistryName string `json:"helmRegistryName"`
 
@@ -88,6 +146,9 @@ type Application struct {
 	// Kind Field designating whether the application is a system add-on, system extension, or a normal application.
 	Kind *ApplicationKind `json:"kind,omitempty"`
 
+	// Labels Optional labels of the application, such as team=vision or tier=certified, by which the applications can be selected. Keys and values follow the syntax of Kubernetes\* labels.
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name is a human readable unique identifier for the application and must be unique for all applications of a given project. Used in network URIs.
 	Name string `json:"name"`
 
@@ -118,7 +179,7 @@ type ApplicationReference struct {
 	// Name Name of the referenced application.
 	Name string `json:"name"`
 
-	// Version Version of the referenced application.
+	// Version Version of the referenced application; either an exact version or a constraint resolved to the highest matching release: latest, ~1.4 for any 1.4.x or ^2.0 for any 2.x.
 	Version string `json:"version"`
 }
 
@@ -136,6 +197,9 @@ type Artifact struct {
 	// DisplayName Display name is an optional human-readable name for the artifact. When specified, it must be unique among all artifacts within a project. It is used for display purposes on user interfaces.
 	DisplayName *string `json:"displayName,omitempty"`
 
+	// Etag Opaque tag of the current state of the artifact; changes whenever the artifact is updated. May be given to the update and delete requests to make sure that the artifact was not changed in the meantime.
+	Etag *string `json:"etag,omitempty"`
+
 	// MimeType Artifact's MIME type. Only text/plain, application/json, application/yaml, image/png, and image/jpeg are allowed at this time. MIME types are defined and standardized in IETF's RFC 6838.
 	MimeType string `json:"mimeType"`
 
@@ -155,6 +219,66 @@ type ArtifactReference struct {
 	Purpose string `json:"purpose"`
 }
 
+// AuditEvent AuditEvent records a single change made to a catalog entity, through an RPC or an upload.
+type AuditEvent struct {
+	// Action The change made, i.e. created, updated, or deleted.
+	Action *string `json:"action,omitempty"`
+
+	// After State of the entity after the change, as a JSON document; empty for deleted entities. Sensitive information, such as registry credentials, is never recorded.
+	After *string `json:"after,omitempty"`
+
+	// Before State of the entity before the change, as a JSON document; empty for created entities. Sensitive information, such as registry credentials, is never recorded.
+	Before *string `json:"before,omitempty"`
+
+	// ChangedFields Names of the top-level fields of the entity that changed.
+	ChangedFields *[]string `json:"changedFields,omitempty"`
+
+	// Client Client through which the change was made.
+	Client *string `json:"client,omitempty"`
+
+	// CreateTime The time of the change.
+	CreateTime *time.Time `json:"createTime,omitempty"`
+
+	// Id Unique identifier of the audit event.
+	Id *uint64 `json:"id,omitempty"`
+
+	// Method Name of the RPC that made the change, e.g. /catalog.v3.CatalogService/UploadCatalogEntities.
+	Method *string `json:"method,omitempty"`
+
+	// ProjectId ID of the project to which the changed entity belongs.
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// ResourceName Name of the changed entity.
+	ResourceName *string `json:"resourceName,omitempty"`
+
+	// ResourceType Type of the changed entity, e.g. registry, artifact, application, deployment-package, or webhook.
+	ResourceType *string `json:"resourceType,omitempty"`
+
+	// ResourceVersion Version of the changed entity, if it is versioned.
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+	// User Name of the user who made the change, as identified by the JWT claims.
+	User *string `json:"user,omitempty"`
+}
+
+// ChartVersion ChartVersion describes a version of a Helm chart hosted by a registry, as listed by its inventory.
+type ChartVersion struct {
+	// AppVersion Version of the application packaged by the chart, if declared by the chart.
+	AppVersion *string `json:"appVersion,omitempty"`
+
+	// CreateTime The time the chart version was created or pushed, if reported by the registry.
+	CreateTime *time.Time `json:"createTime,omitempty"`
+
+	// Description Description of the chart, if declared by the chart.
+	Description *string `json:"description,omitempty"`
+
+	// Digest Digest of the chart, as reported by the registry; the manifest digest for OCI registries.
+	Digest *string `json:"digest,omitempty"`
+
+	// Version Version of the chart.
+	Version *string `json:"version,omitempty"`
+}
+
 // CreateApplicationResponse Response message for the CreateApplication method.
 type CreateApplicationResponse struct {
 	// Application Application represents a Helm chart that can be deployed to one or more Kubernetes pods.
@@ -171,6 +295,9 @@ type CreateArtifactResponse struct {
 type CreateDeploymentPackageResponse struct {
 	// DeploymentPackage DeploymentPackage represents a collection of applications (referenced by their name and a version) that are deployed together. The package can define one or more deployment profiles that specify the individual application profiles to be used when deploying each application. If applications need to be deployed in a particular order, the package can also define any startup dependencies between its constituent applications as a set of dependency graph edges. The deployment package can also refer to a set of artifacts used for miscellaneous purposes, e.g. a thumbnail, icon, or a Grafana extension.
 	DeploymentPackage DeploymentPackage `json:"deploymentPackage"`
+
+	// Warnings Warnings about the deployment package created, such as references to deprecated applications.
+	Warnings *[]string `json:"warnings,omitempty"`
 }
 
 // CreateRegistryResponse Response message for the CreateRegistry method.
@@ -179,6 +306,42 @@ type CreateRegistryResponse struct {
 	Registry Registry `json:"registry"`
 }
 
+// CreateWebhookResponse Response message for the CreateWebhook method.
+type CreateWebhookResponse struct {
+	// Webhook Webhook represents a registration for the delivery of catalog entity events to an HTTP endpoint. Each delivery is an HTTP POST request carrying the event and the entity as a JSON body, signed with HMAC-SHA256 using the shared secret; the signature is sent in the X-Catalog-Signature header as "sha256=" followed by the hex-encoded digest of the body.
+	Webhook Webhook `json:"webhook"`
+}
+
+// DeletedEntity DeletedEntity is a registry, an application or a deployment package held in the trash of its project after it was deleted. It may be restored until it is purged, either explicitly or once its retention period expires.
+type DeletedEntity struct {
+	// Application Application represents a Helm chart that can be deployed to one or more Kubernetes pods.
+	Application *Application `json:"application,omitempty"`
+
+	// DeleteTime The time the entity was deleted.
+	DeleteTime *time.Time `json:"deleteTime,omitempty"`
+
+	// DeploymentPackage DeploymentPackage represents a collection of applications (referenced by their name and a version) that are deployed together. The package can define one or more deployment profiles that specify the individual application profiles to be used when deploying each application. If applications need to be deployed in a particular order, the package can also define any startup dependencies between its constituent applications as a set of dependency graph edges. The deployment package can also refer to a set of artifacts used for miscellaneous purposes, e.g. a thumbnail, icon, or a Grafana extension.
+	DeploymentPackage *DeploymentPackage `json:"deploymentPackage,omitempty"`
+
+	// ExpireTime The time after which the entity is purged.
+	ExpireTime *time.Time `json:"expireTime,omitempty"`
+
+	// Name Name of the entity.
+	Name *string `json:"name,omitempty"`
+
+	// Registry Registry represents a repository from which various artifacts, such as application Docker\* images or Helm\* charts can be retrieved. As such, the registry entity holds information used for finding and accessing the represented repository.
+	Registry *Registry `json:"registry,omitempty"`
+
+	// ResourceType Type of the entity, i.e. registry, application or deployment-package.
+	ResourceType *string `json:"resourceType,omitempty"`
+
+	// User Name of the user who deleted the entity.
+	User *string `json:"user,omitempty"`
+
+	// Version Version of the entity; empty for registries.
+	Version *string `json:"version,omitempty"`
+}
+
 // DeploymentPackage DeploymentPackage represents a collection of applications (referenced by their name and a version) that are deployed together. The package can define one or more deployment profiles that specify the individual application profiles to be used when deploying each application. If applications need to be deployed in a particular order, the package can also define any startup dependencies between its constituent applications as a set of dependency graph edges. The deployment package can also refer to a set of artifacts used for miscellaneous purposes, e.g. a thumbnail, icon, or a Grafana extension.
 type DeploymentPackage struct {
 	// ApplicationDependencies Optional set of application deployment dependencies, expressed as (name, requires) pairs of edges in the deployment order dependency graph.
@@ -199,12 +362,18 @@ type DeploymentPackage struct {
 	// DefaultProfileName Name of the default deployment profile to be used by default when deploying this package.
 	DefaultProfileName *string `json:"defaultProfileName,omitempty"`
 
+	// Deprecation Deprecation describes why an application or deployment package version is deprecated and which version to use instead.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+
 	// Description Description of the deployment package. Displayed on user interfaces.
 	Description *string `json:"description,omitempty"`
 
 	// DisplayName Display name is an optional human-readable name for the deployment package. When specified, it must be unique among all packages within a project. It is used for display purposes on user interfaces.
 	DisplayName *string `json:"displayName,omitempty"`
 
+	// Etag Opaque tag of the current state of the deployment package; changes whenever the deployment package is updated. May be given to the update and delete requests to make sure that the deployment package was not changed in the meantime.
+	Etag *string `json:"etag,omitempty"`
+
 	// Extensions Optional list of API and UI extensions.
 	Extensions []APIExtension `json:"extensions"`
 
@@ -220,6 +389,9 @@ type DeploymentPackage struct {
 	// Kind Field designating whether the deployment package is a system add-on, system extension, or a normal package.
 	Kind *DeploymentPackageKind `json:"kind,omitempty"`
 
+	// Labels Optional labels of the deployment package, such as team=vision or tier=certified, by which the deployment packages can be selected. Keys and values follow the syntax of Kubernetes\* labels.
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name is a human-readable unique identifier for the deployment package and must be unique for all packages of a given project.
 	Name string `json:"name"`
 
@@ -268,10 +440,22 @@ type DeploymentRequirement struct {
 	// Name Name of the required deployment package.
 	Name string `json:"name"`
 
-	// Version Version of the required deployment package.
+	// Version Version of the required deployment package; either an exact version or a constraint resolved to the highest matching release: latest, ~1.4 for any 1.4.x or ^2.0 for any 2.x.
 	Version string `json:"version"`
 }
 
+// Deprecation Deprecation describes why an application or deployment package version is deprecated and which version to use instead.
+type Deprecation struct {
+	// DeprecateTime The time at which the version was deprecated.
+	DeprecateTime *time.Time `json:"deprecateTime,omitempty"`
+
+	// Message Message explaining why the version is deprecated. Displayed on user interfaces.
+	Message *string `json:"message,omitempty"`
+
+	// ReplacementVersion Version of the same application or deployment package suggested to be used in place of the deprecated one.
+	ReplacementVersion *string `json:"replacementVersion,omitempty"`
+}
+
 // Endpoint Endpoint represents an application service endpoint.
 type Endpoint struct {
 	// AppName The name of the application providing this endpoint.
@@ -334,13 +518,28 @@ type GetRegistryResponse struct {
 	Registry Registry `json:"registry"`
 }
 
+// GetRevisionResponse Response message for the GetRevision method.
+type GetRevisionResponse struct {
+	// Revision Revision is an immutable snapshot of an application or a deployment package, recorded whenever the entity is updated. The first revision of an entity holds its state prior to its first update.
+	Revision Revision `json:"revision"`
+}
+
+// GetWebhookResponse Response message for the GetWebhook method.
+type GetWebhookResponse struct {
+	// Webhook Webhook represents a registration for the delivery of catalog entity events to an HTTP endpoint. Each delivery is an HTTP POST request carrying the event and the entity as a JSON body, signed with HMAC-SHA256 using the shared secret; the signature is sent in the X-Catalog-Signature header as "sha256=" followed by the hex-encoded digest of the body.
+	Webhook Webhook `json:"webhook"`
+}
+
 // ListApplicationsResponse Response message for the ListApplications method.
 type ListApplicationsResponse struct {
 	// Applications A list of applications.
 	Applications []Application `json:"applications"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
+	// NextPageToken Token of the next page, if there are more items to return.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination; not set if skip_total was requested.
+	TotalElements *int32 `json:"totalElements,omitempty"`
 }
 
 // ListArtifactsResponse Response message for the ListArtifacts method.
@@ -348,6 +547,39 @@ type ListArtifactsResponse struct {
 	// Artifacts A list of artifacts.
 	Artifacts []Artifact `json:"artifacts"`
 
+	// NextPageToken Token of the next page, if there are more items to return.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination; not set if skip_total was requested.
+	TotalElements *int32 `json:"totalElements,omitempty"`
+}
+
+// ListAuditEventsResponse Response message for the ListAuditEvents method.
+type ListAuditEventsResponse struct {
+	// AuditEvents A list of audit events.
+	AuditEvents []AuditEvent `json:"auditEvents"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListChartVersionsResponse Response message for the ListChartVersions method.
+type ListChartVersionsResponse struct {
+	// ChartVersions Versions of the chart, latest first.
+	ChartVersions []ChartVersion `json:"chartVersions"`
+
+	// NextPageToken Token of the next page, if there are more items to return.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListDeletedResponse Response message for the ListDeleted method.
+type ListDeletedResponse struct {
+	// DeletedEntities A list of deleted entities, including their state when they were deleted.
+	DeletedEntities []DeletedEntity `json:"deletedEntities"`
+
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
@@ -357,19 +589,64 @@ type ListDeploymentPackagesResponse struct {
 	// DeploymentPackages A list of DeploymentPackages.
 	DeploymentPackages []DeploymentPackage `json:"deploymentPackages"`
 
-	// TotalElements Count of items in the entire list, regardless of pagination.
-	TotalElements int32 `json:"totalElements"`
+	// NextPageToken Token of the next page, if there are more items to return.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination; not set if skip_total was requested.
+	TotalElements *int32 `json:"totalElements,omitempty"`
 }
 
 // ListRegistriesResponse Response message for the ListRegistries method.
 type ListRegistriesResponse struct {
+	// NextPageToken Token of the next page, if there are more items to return.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
 	// Registries A list of registries.
 	Registries []Registry `json:"registries"`
 
+	// TotalElements Count of items in the entire list, regardless of pagination; not set if skip_total was requested.
+	TotalElements *int32 `json:"totalElements,omitempty"`
+}
+
+// ListRegistryChartsResponse Response message for the ListRegistryCharts method.
+type ListRegistryChartsResponse struct {
+	// ChartNames Names of the charts, in alphabetical order.
+	ChartNames []string `json:"chartNames"`
+
+	// NextPageToken Token of the next page, if there are more items to return.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListRevisionsResponse Response message for the ListRevisions method.
+type ListRevisionsResponse struct {
+	// Revisions A list of revisions, without the state of the entity.
+	Revisions []Revision `json:"revisions"`
+
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+}
+
+// ListWebhookDeliveriesResponse Response message for the ListWebhookDeliveries method.
+type ListWebhookDeliveriesResponse struct {
+	// Deliveries A list of deliveries.
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
 	// TotalElements Count of items in the entire list, regardless of pagination.
 	TotalElements int32 `json:"totalElements"`
 }
 
+// ListWebhooksResponse Response message for the ListWebhooks method.
+type ListWebhooksResponse struct {
+	// TotalElements Count of items in the entire list, regardless of pagination.
+	TotalElements int32 `json:"totalElements"`
+
+	// Webhooks A list of webhooks.
+	Webhooks []Webhook `json:"webhooks"`
+}
+
 // Namespace Namespace represents a complex namespace definition with predefined labels and annotations. They are created before any other resources in the deployment.
 type Namespace struct {
 	Annotations *map[string]string `json:"annotations,omitempty"`
@@ -435,7 +712,7 @@ type Profile struct {
 
 // Registry Registry represents a repository from which various artifacts, such as application Docker\* images or Helm\* charts can be retrieved. As such, the registry entity holds information used for finding and accessing the represented repository.
 type Registry struct {
-	// ApiType Optional type of the API used to obtain inventory of the articles hosted by the registry.
+	// ApiType Optional type of the API used to obtain inventory of the articles hosted by the registry: harbor for the Harbor\* API, oci for the OCI distribution API, or helm for the index of a classic Helm repository. If not set, the Harbor API is used if the inventory URL refers to a Harbor project.
 	ApiType *string `json:"apiType,omitempty"`
 
 	// AuthToken Optional authentication token or password for accessing the registry.
@@ -453,9 +730,15 @@ type Registry struct {
 	// DisplayName Display name is an optional human-readable name for the registry. When specified, it must be unique among all registries within a project. It is used for display purposes on user interfaces.
 	DisplayName *string `json:"displayName,omitempty"`
 
+	// Etag Opaque tag of the current state of the registry; changes whenever the registry is updated. May be given to the update and delete requests to make sure that the registry was not changed in the meantime.
+	Etag *string `json:"etag,omitempty"`
+
 	// InventoryUrl Optional URL of the API for accessing inventory of artifacts hosted by the registry.
 	InventoryUrl *string `json:"inventoryUrl,omitempty"`
 
+	// Labels Optional labels of the registry, such as team=vision or tier=certified, by which the registries can be selected. Keys and values follow the syntax of Kubernetes\* labels.
+	Labels *map[string]string `json:"labels,omitempty"`
+
 	// Name Name is a human-readable unique identifier for the registry and must be unique for all registries of a given project.
 	Name string `json:"name"`
 
@@ -472,6 +755,30 @@ type Registry struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// ResolveDeploymentPackageResponse Response message for the ResolveDeploymentPackage method.
+type ResolveDeploymentPackageResponse struct {
+	// ApplicationReferences The applications of the DeploymentPackage, with the exact versions their references resolve to.
+	ApplicationReferences *[]ApplicationReference `json:"applicationReferences,omitempty"`
+
+	// DeploymentRequirements The deployment requirements of the profiles of those applications, with the exact versions they resolve to.
+	DeploymentRequirements *[]ResolvedDeploymentRequirement `json:"deploymentRequirements,omitempty"`
+
+	// Pinned Indicates whether the resolution was pinned when the DeploymentPackage was deployed.
+	Pinned *bool `json:"pinned,omitempty"`
+}
+
+// ResolvedDeploymentRequirement A deployment requirement of an application profile, resolved to an exact version.
+type ResolvedDeploymentRequirement struct {
+	// ApplicationName Name of the application.
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// DeploymentRequirement DeploymentRequirement is a reference to the deployment package that must be deployed first, as a requirement for an application to be deployed.
+	DeploymentRequirement *DeploymentRequirement `json:"deploymentRequirement,omitempty"`
+
+	// ProfileName Name of the application profile.
+	ProfileName *string `json:"profileName,omitempty"`
+}
+
 // ResourceReference ResourceReference represents a Kubernetes resource identifier.
 type ResourceReference struct {
 	// Kind Kubernetes resource kind, e.g. ConfigMap.
@@ -484,6 +791,105 @@ type ResourceReference struct {
 	Namespace *string `json:"namespace,omitempty"`
 }
 
+// RestoreApplicationResponse Response message for the RestoreApplication method.
+type RestoreApplicationResponse struct {
+	// Application Application represents a Helm chart that can be deployed to one or more Kubernetes pods.
+	Application Application `json:"application"`
+}
+
+// RestoreDeploymentPackageResponse Response message for the RestoreDeploymentPackage method.
+type RestoreDeploymentPackageResponse struct {
+	// DeploymentPackage DeploymentPackage represents a collection of applications (referenced by their name and a version) that are deployed together. The package can define one or more deployment profiles that specify the individual application profiles to be used when deploying each application. If applications need to be deployed in a particular order, the package can also define any startup dependencies between its constituent applications as a set of dependency graph edges. The deployment package can also refer to a set of artifacts used for miscellaneous purposes, e.g. a thumbnail, icon, or a Grafana extension.
+	DeploymentPackage DeploymentPackage `json:"deploymentPackage"`
+}
+
+// RestoreRegistryResponse Response message for the RestoreRegistry method.
+type RestoreRegistryResponse struct {
+	// Registry Registry represents a repository from which various artifacts, such as application Docker\* images or Helm\* charts can be retrieved. As such, the registry entity holds information used for finding and accessing the represented repository.
+	Registry Registry `json:"registry"`
+}
+
+// RestoreRevisionResponse Response message for the RestoreRevision method.
+type RestoreRevisionResponse struct {
+	// Revision Revision is an immutable snapshot of an application or a deployment package, recorded whenever the entity is updated. The first revision of an entity holds its state prior to its first update.
+	Revision Revision `json:"revision"`
+}
+
+// Revision Revision is an immutable snapshot of an application or a deployment package, recorded whenever the entity is updated. The first revision of an entity holds its state prior to its first update.
+type Revision struct {
+	// Application Application represents a Helm chart that can be deployed to one or more Kubernetes pods.
+	Application *Application `json:"application,omitempty"`
+
+	// CreateTime The time the revision was recorded.
+	CreateTime *time.Time `json:"createTime,omitempty"`
+
+	// DeploymentPackage DeploymentPackage represents a collection of applications (referenced by their name and a version) that are deployed together. The package can define one or more deployment profiles that specify the individual application profiles to be used when deploying each application. If applications need to be deployed in a particular order, the package can also define any startup dependencies between its constituent applications as a set of dependency graph edges. The deployment package can also refer to a set of artifacts used for miscellaneous purposes, e.g. a thumbnail, icon, or a Grafana extension.
+	DeploymentPackage *DeploymentPackage `json:"deploymentPackage,omitempty"`
+
+	// Name Name of the entity.
+	Name *string `json:"name,omitempty"`
+
+	// ResourceType Type of the entity, i.e. application or deployment-package.
+	ResourceType *string `json:"resourceType,omitempty"`
+
+	// Revision Number of the revision; the revisions of each entity are numbered sequentially, starting with 1.
+	Revision *uint32 `json:"revision,omitempty"`
+
+	// User Name of the user whose change produced the revision.
+	User *string `json:"user,omitempty"`
+
+	// Version Version of the entity.
+	Version *string `json:"version,omitempty"`
+}
+
+// SearchCatalogResponse Response message for the SearchCatalog method.
+type SearchCatalogResponse struct {
+	// Applications Matching applications, most relevant first.
+	Applications []SearchResult `json:"applications"`
+
+	// DeploymentPackages Matching deployment packages, most relevant first.
+	DeploymentPackages []SearchResult `json:"deploymentPackages"`
+
+	// Registries Matching registries, most relevant first.
+	Registries []SearchResult `json:"registries"`
+}
+
+// SearchHighlight A field of an entity that matched a search.
+type SearchHighlight struct {
+	// Field Name of the field, such as displayName or profiles.description.
+	Field *string `json:"field,omitempty"`
+
+	// Fragment Value of the field, with the matching words enclosed in <b> and </b>.
+	Fragment *string `json:"fragment,omitempty"`
+}
+
+// SearchResult An entity matching a search.
+type SearchResult struct {
+	// DisplayName Display name of the entity.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Highlights The fields that matched, with the matching words highlighted.
+	Highlights *[]SearchHighlight `json:"highlights,omitempty"`
+
+	// Name Name of the entity.
+	Name *string `json:"name,omitempty"`
+
+	// Version Version of the entity; empty for registries.
+	Version *string `json:"version,omitempty"`
+}
+
+// TestRegistryConnectionResponse Response message for the TestRegistryConnection method.
+type TestRegistryConnectionResponse struct {
+	// ApiType Flavour of the registry API detected by a successful test, i.e. harbor or oci, suitable as the registry api_type.
+	ApiType *string `json:"apiType,omitempty"`
+
+	// Message Description of the outcome, if it is not OK.
+	Message *string `json:"message,omitempty"`
+
+	// Status Outcome of the test: OK, UNREACHABLE if the registry could not be reached, TLS_ERROR if no secure channel could be established with it, AUTH_FAILED if it rejected the credentials, or ERROR if it responded unexpectedly.
+	Status string `json:"status"`
+}
+
 // UIExtension UIExtension is an augmentation of an API extension.
 type UIExtension struct {
 	// AppName The name of the application corresponding to this UI extension.
@@ -505,6 +911,12 @@ type UIExtension struct {
 	ServiceName string `json:"serviceName"`
 }
 
+// UpdateDeploymentPackageResponse Response message for the UpdateDeploymentPackage method.
+type UpdateDeploymentPackageResponse struct {
+	// Warnings Warnings about the deployment package updated, such as references to deprecated applications.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
 // Upload Upload represents a single file-upload record.
 type Upload struct {
 	// Artifact Raw bytes content of the file being uploaded.
@@ -519,19 +931,172 @@ type UploadCatalogEntitiesResponse struct {
 	// ErrorMessages Any error messages encountered either during YAML parsing or entity creation or update.
 	ErrorMessages *[]string `json:"errorMessages,omitempty"`
 
+	// Plan Plan of changes produced by a dry-run upload.
+	Plan *UploadPlan `json:"plan,omitempty"`
+
+	// Results Outcome for each entity specification processed, listed in the order in which the entities were loaded; populated only for the last upload request. If the upload fails, the same response is attached to the returned error status as a detail, with the results up to and including the failed specification. None of the changes are committed in that case.
+	Results *[]UploadResult `json:"results,omitempty"`
+
 	// SessionId Session ID, generated by the server after the first upload request has been processed.
 	SessionId string `json:"sessionId"`
 
+	// Skipped Uploaded entities that already existed in the catalog and were skipped as per the conflict policy; populated only for the last upload request.
+	Skipped *[]UploadEntityReference `json:"skipped,omitempty"`
+
 	// UploadNumber Deprecated: Next expected upload number or total number of uploads on the last upload request.
 	UploadNumber uint32 `json:"uploadNumber"`
 }
 
+// UploadEntityReference Reference to a catalog entity specified by an uploaded file.
+type UploadEntityReference struct {
+	// FileName Name of the uploaded file containing the entity specification.
+	FileName string `json:"fileName"`
+
+	// Name Name of the entity.
+	Name string `json:"name"`
+
+	// SpecSchema Kind of the entity specification, e.g. Registry, Artifact, Application or DeploymentPackage.
+	SpecSchema string `json:"specSchema"`
+
+	// Version Version of the entity; empty for registries and artifacts.
+	Version *string `json:"version,omitempty"`
+}
+
+// UploadError UploadError describes an error encountered while loading an uploaded entity specification.
+type UploadError struct {
+	// Code Name of the gRPC status code of the error, e.g. InvalidArgument or AlreadyExists.
+	Code string `json:"code"`
+
+	// Message Error message.
+	Message string `json:"message"`
+}
+
+// UploadPlan Plan of changes produced by a dry-run upload.
+type UploadPlan struct {
+	// Changes Changes the upload would make, listed in the order in which the entities would be loaded.
+	Changes *[]UploadPlannedChange `json:"changes,omitempty"`
+
+	// ValidationErrors Validation errors that would cause the upload to be rejected. Loading of the entities stops at the first error that is not a YAML validation error, so the list of changes may be incomplete if any are reported.
+	ValidationErrors *[]string `json:"validationErrors,omitempty"`
+}
+
+// UploadPlannedChange UploadPlannedChange describes the change a dry-run upload would make to a single catalog entity.
+type UploadPlannedChange struct {
+	// Action Action the upload would take on the entity.
+	Action UploadPlannedChangeAction `json:"action"`
+
+	// ChangedFields Names of the fields the upload would change; populated only for updates.
+	ChangedFields *[]string `json:"changedFields,omitempty"`
+
+	// FileName Name of the uploaded file containing the entity specification.
+	FileName string `json:"fileName"`
+
+	// Name Name of the entity.
+	Name string `json:"name"`
+
+	// SpecSchema Kind of the entity specification, e.g. Registry, Artifact, Application or DeploymentPackage.
+	SpecSchema string `json:"specSchema"`
+
+	// Version Version of the entity; empty for registries and artifacts.
+	Version *string `json:"version,omitempty"`
+}
+
+// UploadPlannedChangeAction Action the upload would take on the entity.
+type UploadPlannedChangeAction string
+
+// UploadResult UploadResult describes the outcome of loading a single entity specification from the uploaded files.
+type UploadResult struct {
+	// Action Action taken on the entity.
+	Action UploadResultAction `json:"action"`
+
+	// Error UploadError describes an error encountered while loading an uploaded entity specification.
+	Error *UploadError `json:"error,omitempty"`
+
+	// FileName Name of the uploaded file containing the entity specification.
+	FileName string `json:"fileName"`
+
+	// Name Name of the entity; empty if the file could not be parsed.
+	Name *string `json:"name,omitempty"`
+
+	// SpecSchema Kind of the entity specification, e.g. Registry, Artifact, Application or DeploymentPackage; empty if the file could not be parsed.
+	SpecSchema *string `json:"specSchema,omitempty"`
+
+	// Version Version of the entity; empty for registries and artifacts.
+	Version *string `json:"version,omitempty"`
+}
+
+// UploadResultAction Action taken on the entity.
+type UploadResultAction string
+
+// Webhook Webhook represents a registration for the delivery of catalog entity events to an HTTP endpoint. Each delivery is an HTTP POST request carrying the event and the entity as a JSON body, signed with HMAC-SHA256 using the shared secret; the signature is sent in the X-Catalog-Signature header as "sha256=" followed by the hex-encoded digest of the body.
+type Webhook struct {
+	// CreateTime The creation time of the webhook.
+	CreateTime *time.Time `json:"createTime,omitempty"`
+
+	// Description Description of the webhook. Displayed on user interfaces.
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Types of events delivered, i.e. created, updated, or deleted. Events of all types are delivered if none are specified.
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+
+	// Name Name is a human-readable unique identifier for the webhook and must be unique for all webhooks of a given project.
+	Name string `json:"name"`
+
+	// ResourceTypes Types of entities whose events are delivered, i.e. registry, artifact, application, or deployment-package. Events of all entity types are delivered if none are specified.
+	ResourceTypes *[]string `json:"resourceTypes,omitempty"`
+
+	// Secret Secret shared with the endpoint, used for signing the deliveries. Required when creating a webhook; the existing secret is retained if not specified when updating a webhook. Never returned.
+	Secret *string `json:"secret,omitempty"`
+
+	// UpdateTime The last update time of the webhook.
+	UpdateTime *time.Time `json:"updateTime,omitempty"`
+
+	// Url URL of the endpoint to which the events are delivered. Endpoints internal to the network of the catalog, e.g. loopback, private or link-local addresses and cluster services, are refused unless allowed by the operator.
+	Url string `json:"url"`
+}
+
+// WebhookDelivery WebhookDelivery records the delivery of a single event to a webhook.
+type WebhookDelivery struct {
+	// Attempts Number of delivery attempts made so far.
+	Attempts *int32 `json:"attempts,omitempty"`
+
+	// CreateTime The creation time of the delivery.
+	CreateTime *time.Time `json:"createTime,omitempty"`
+
+	// EventType Type of the event, i.e. created, updated, or deleted.
+	EventType *string `json:"eventType,omitempty"`
+
+	// Id Unique identifier of the delivery; also sent in the X-Catalog-Delivery header.
+	Id *uint64 `json:"id,omitempty"`
+
+	// LastError Error encountered by the last attempt, if any.
+	LastError *string `json:"lastError,omitempty"`
+
+	// ResourceName Name of the subject of the event.
+	ResourceName *string `json:"resourceName,omitempty"`
+
+	// ResourceType Type of the subject of the event, e.g. application or deployment-package.
+	ResourceType *string `json:"resourceType,omitempty"`
+
+	// ResponseCode HTTP status code of the response to the last attempt; zero if no response was received.
+	ResponseCode *int32 `json:"responseCode,omitempty"`
+
+	// Revision Revision of the delivered event in the persisted event log.
+	Revision *uint64 `json:"revision,omitempty"`
+
+	// State State of the delivery, i.e. pending, delivered, or failed. Failed deliveries are not attempted any more.
+	State *string `json:"state,omitempty"`
+
+	// UpdateTime The time of the last delivery attempt.
+	UpdateTime *time.Time `json:"updateTime,omitempty"`
+}
+
 // CatalogServiceListApplicationsParams defines parameters for CatalogServiceListApplications.
 type CatalogServiceListApplicationsParams struct {
 	// OrderBy Names the field to be used for ordering the returned results.
 	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
 
-	// Filter Expression to use for filtering the results.
+	// Filter Expression to use for filtering the results, in the style of AIP-160; for example `kind != EXTENSION AND (name = "foo" OR displayName = bar*) AND createTime > 2026-01-01`. Unquoted text values match anywhere in the text regardless of case, while quoted ones must match exactly.
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// PageSize Maximum number of items to return.
@@ -542,17 +1107,44 @@ type CatalogServiceListApplicationsParams struct {
 
 	// Kinds List of application kinds to be returned; empty list means all kinds.
 	Kinds *[]CatalogServiceListApplicationsParamsKinds `form:"kinds,omitempty" json:"kinds,omitempty"`
+
+	// PageToken Token of the page to return, as returned in the next_page_token of the previous page; the order_by, filter and label_selector must be the same as for the previous page. Pages are stable, unlike offsets, when applications are being added or removed. Cannot be combined with an offset.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// SkipTotal Indicates whether counting the total number of items, which is costly for long lists, is to be skipped.
+	SkipTotal *bool `form:"skipTotal,omitempty" json:"skipTotal,omitempty"`
+
+	// LabelSelector Selector of the applications to return by their labels, in the style of Kubernetes\*; for example `team=vision,env in (prod,stage),!deprecated`. The requirements, separated by commas, must all be satisfied.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// ExcludeDeprecated Indicates whether the deprecated applications are to be excluded.
+	ExcludeDeprecated *bool `form:"excludeDeprecated,omitempty" json:"excludeDeprecated,omitempty"`
 }
 
 // CatalogServiceListApplicationsParamsKinds defines parameters for CatalogServiceListApplications.
 type CatalogServiceListApplicationsParamsKinds string
 
+// CatalogServiceDeleteApplicationParams defines parameters for CatalogServiceDeleteApplication.
+type CatalogServiceDeleteApplicationParams struct {
+	// Etag If set, the application is deleted only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+}
+
+// CatalogServiceUpdateApplicationParams defines parameters for CatalogServiceUpdateApplication.
+type CatalogServiceUpdateApplicationParams struct {
+	// Etag If set, the application is updated only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+
+	// UpdateMask Fields of the application to update, e.g. "description" or "profiles.chart_values"; nested fields of the profiles and other named elements are updated for the elements of the same name. If not set, the application is replaced as a whole.
+	UpdateMask *string `form:"updateMask,omitempty" json:"updateMask,omitempty"`
+}
+
 // CatalogServiceListArtifactsParams defines parameters for CatalogServiceListArtifacts.
 type CatalogServiceListArtifactsParams struct {
 	// OrderBy Names the field to be used for ordering the returned results.
 	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
 
-	// Filter Expression to use for filtering the results.
+	// Filter Expression to use for filtering the results, in the style of AIP-160; for example `kind != EXTENSION AND (name = "foo" OR displayName = bar*) AND createTime > 2026-01-01`. Unquoted text values match anywhere in the text regardless of case, while quoted ones must match exactly.
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// PageSize Maximum number of items to return.
@@ -560,6 +1152,72 @@ type CatalogServiceListArtifactsParams struct {
 
 	// Offset Index of the first item to return.
 	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// PageToken Token of the page to return, as returned in the next_page_token of the previous page; the order_by and filter must be the same as for the previous page. Pages are stable, unlike offsets, when artifacts are being added or removed. Cannot be combined with an offset.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// SkipTotal Indicates whether counting the total number of items, which is costly for long lists, is to be skipped.
+	SkipTotal *bool `form:"skipTotal,omitempty" json:"skipTotal,omitempty"`
+}
+
+// CatalogServiceDeleteArtifactParams defines parameters for CatalogServiceDeleteArtifact.
+type CatalogServiceDeleteArtifactParams struct {
+	// Etag If set, the artifact is deleted only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+}
+
+// CatalogServiceUpdateArtifactParams defines parameters for CatalogServiceUpdateArtifact.
+type CatalogServiceUpdateArtifactParams struct {
+	// Etag If set, the artifact is updated only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+
+	// UpdateMask Fields of the artifact to update, e.g. "description". If not set, the artifact is replaced as a whole.
+	UpdateMask *string `form:"updateMask,omitempty" json:"updateMask,omitempty"`
+}
+
+// CatalogServiceListAuditEventsParams defines parameters for CatalogServiceListAuditEvents.
+type CatalogServiceListAuditEventsParams struct {
+	// ResourceType Return only the changes made to entities of the given type, e.g. deployment-package.
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceName Return only the changes made to entities of the given name.
+	ResourceName *string `form:"resourceName,omitempty" json:"resourceName,omitempty"`
+
+	// ResourceVersion Return only the changes made to entities of the given version.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+
+	// User Return only the changes made by the given user.
+	User *string `form:"user,omitempty" json:"user,omitempty"`
+
+	// StartTimeSeconds Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.
+	StartTimeSeconds *int64 `form:"startTime.seconds,omitempty" json:"startTime.seconds,omitempty"`
+
+	// StartTimeNanos Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.
+	StartTimeNanos *int32 `form:"startTime.nanos,omitempty" json:"startTime.nanos,omitempty"`
+
+	// EndTimeSeconds Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.
+	EndTimeSeconds *int64 `form:"endTime.seconds,omitempty" json:"endTime.seconds,omitempty"`
+
+	// EndTimeNanos Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.
+	EndTimeNanos *int32 `form:"endTime.nanos,omitempty" json:"endTime.nanos,omitempty"`
+
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CatalogServiceListDeletedParams defines parameters for CatalogServiceListDeleted.
+type CatalogServiceListDeletedParams struct {
+	// ResourceType Type of the entities to list, i.e. registry, application or deployment-package; all types if empty.
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // CatalogServiceListDeploymentPackagesParams defines parameters for CatalogServiceListDeploymentPackages.
@@ -567,7 +1225,7 @@ type CatalogServiceListDeploymentPackagesParams struct {
 	// OrderBy Names the field to be used for ordering the returned results.
 	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
 
-	// Filter Expression to use for filtering the results.
+	// Filter Expression to use for filtering the results, in the style of AIP-160; for example `kind != EXTENSION AND (name = "foo" OR displayName = bar*) AND createTime > 2026-01-01`. Unquoted text values match anywhere in the text regardless of case, while quoted ones must match exactly.
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// PageSize Maximum number of items to return.
@@ -578,17 +1236,44 @@ type CatalogServiceListDeploymentPackagesParams struct {
 
 	// Kinds List of deployment package kinds to be returned; empty list means all kinds.
 	Kinds *[]CatalogServiceListDeploymentPackagesParamsKinds `form:"kinds,omitempty" json:"kinds,omitempty"`
+
+	// PageToken Token of the page to return, as returned in the next_page_token of the previous page; the order_by, filter and label_selector must be the same as for the previous page. Pages are stable, unlike offsets, when deployment packages are being added or removed. Cannot be combined with an offset.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// SkipTotal Indicates whether counting the total number of items, which is costly for long lists, is to be skipped.
+	SkipTotal *bool `form:"skipTotal,omitempty" json:"skipTotal,omitempty"`
+
+	// LabelSelector Selector of the deployment packages to return by their labels, in the style of Kubernetes\*; for example `team=vision,env in (prod,stage),!deprecated`. The requirements, separated by commas, must all be satisfied.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// ExcludeDeprecated Indicates whether the deprecated deployment packages are to be excluded.
+	ExcludeDeprecated *bool `form:"excludeDeprecated,omitempty" json:"excludeDeprecated,omitempty"`
 }
 
 // CatalogServiceListDeploymentPackagesParamsKinds defines parameters for CatalogServiceListDeploymentPackages.
 type CatalogServiceListDeploymentPackagesParamsKinds string
 
+// CatalogServiceDeleteDeploymentPackageParams defines parameters for CatalogServiceDeleteDeploymentPackage.
+type CatalogServiceDeleteDeploymentPackageParams struct {
+	// Etag If set, the deployment package is deleted only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+}
+
+// CatalogServiceUpdateDeploymentPackageParams defines parameters for CatalogServiceUpdateDeploymentPackage.
+type CatalogServiceUpdateDeploymentPackageParams struct {
+	// Etag If set, the deployment package is updated only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+
+	// UpdateMask Fields of the deployment package to update, e.g. "description" or "profiles.chart_values"; nested fields of the profiles and other named elements are updated for the elements of the same name. If not set, the deployment package is replaced as a whole.
+	UpdateMask *string `form:"updateMask,omitempty" json:"updateMask,omitempty"`
+}
+
 // CatalogServiceListRegistriesParams defines parameters for CatalogServiceListRegistries.
 type CatalogServiceListRegistriesParams struct {
 	// OrderBy Names the field to be used for ordering the returned results.
 	OrderBy *string `form:"orderBy,omitempty" json:"orderBy,omitempty"`
 
-	// Filter Expression to use for filtering the results.
+	// Filter Expression to use for filtering the results, in the style of AIP-160; for example `kind != EXTENSION AND (name = "foo" OR displayName = bar*) AND createTime > 2026-01-01`. Unquoted text values match anywhere in the text regardless of case, while quoted ones must match exactly.
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// PageSize Maximum number of items to return.
@@ -599,6 +1284,21 @@ type CatalogServiceListRegistriesParams struct {
 
 	// ShowSensitiveInfo Request that sensitive information, such as username, auth_token, and CA certificates are included in the response.
 	ShowSensitiveInfo *bool `form:"showSensitiveInfo,omitempty" json:"showSensitiveInfo,omitempty"`
+
+	// PageToken Token of the page to return, as returned in the next_page_token of the previous page; the order_by, filter and label_selector must be the same as for the previous page. Pages are stable, unlike offsets, when registries are being added or removed. Cannot be combined with an offset.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// SkipTotal Indicates whether counting the total number of items, which is costly for long lists, is to be skipped.
+	SkipTotal *bool `form:"skipTotal,omitempty" json:"skipTotal,omitempty"`
+
+	// LabelSelector Selector of the registries to return by their labels, in the style of Kubernetes\*; for example `team=vision,env in (prod,stage),!deprecated`. The requirements, separated by commas, must all be satisfied.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// CatalogServiceDeleteRegistryParams defines parameters for CatalogServiceDeleteRegistry.
+type CatalogServiceDeleteRegistryParams struct {
+	// Etag If set, the registry is deleted only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
 }
 
 // CatalogServiceGetRegistryParams defines parameters for CatalogServiceGetRegistry.
@@ -607,6 +1307,57 @@ type CatalogServiceGetRegistryParams struct {
 	ShowSensitiveInfo *bool `form:"showSensitiveInfo,omitempty" json:"showSensitiveInfo,omitempty"`
 }
 
+// CatalogServiceUpdateRegistryParams defines parameters for CatalogServiceUpdateRegistry.
+type CatalogServiceUpdateRegistryParams struct {
+	// Etag If set, the registry is updated only if its current etag matches; otherwise the request is aborted. The If-Match header may be used instead through the REST API.
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty"`
+
+	// UpdateMask Fields of the registry to update, e.g. "description". If not set, the registry is replaced as a whole.
+	UpdateMask *string `form:"updateMask,omitempty" json:"updateMask,omitempty"`
+}
+
+// CatalogServiceListRegistryChartsParams defines parameters for CatalogServiceListRegistryCharts.
+type CatalogServiceListRegistryChartsParams struct {
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// PageToken Token of the page to return, as returned in the next_page_token of the previous page. Pages are stable, unlike offsets, when charts are being added or removed. Cannot be combined with an offset.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// CatalogServiceListChartVersionsParams defines parameters for CatalogServiceListChartVersions.
+type CatalogServiceListChartVersionsParams struct {
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// PageToken Token of the page to return, as returned in the next_page_token of the previous page. Pages are stable, unlike offsets, when versions are being added or removed. Cannot be combined with an offset.
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// CatalogServiceListRevisionsParams defines parameters for CatalogServiceListRevisions.
+type CatalogServiceListRevisionsParams struct {
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CatalogServiceSearchCatalogParams defines parameters for CatalogServiceSearchCatalog.
+type CatalogServiceSearchCatalogParams struct {
+	// Query Words to search for; each must begin a word of the entity, case-insensitively.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// PageSize Maximum number of matches to return for each entity type; defaults to 20, and cannot exceed 100.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
 // CatalogServiceUploadCatalogEntitiesParams defines parameters for CatalogServiceUploadCatalogEntities.
 type CatalogServiceUploadCatalogEntitiesParams struct {
 	// SessionId First upload request in the batch must not specify session ID. Subsequent upload requests must copy the session ID from the previously issued response.
@@ -617,6 +1368,66 @@ type CatalogServiceUploadCatalogEntitiesParams struct {
 
 	// LastUpload Must be set to 'true' to perform load of all entity files uploaded as part of this session.
 	LastUpload *bool `form:"lastUpload,omitempty" json:"lastUpload,omitempty"`
+
+	// DryRun Optional flag requesting that the entities uploaded as part of this session are only evaluated against the catalog, without applying any changes. Honored only on the last upload request; the response will then contain the plan of changes that the upload would make.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// ConflictPolicy Optional policy applied to uploaded entities that already exist in the catalog. Existing entities are overwritten if not specified. Honored only on the last upload request.
+	ConflictPolicy *CatalogServiceUploadCatalogEntitiesParamsConflictPolicy `form:"conflictPolicy,omitempty" json:"conflictPolicy,omitempty"`
+
+	// ConflictPoliciesRegistries Conflict policy for registries.
+	ConflictPoliciesRegistries *CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries `form:"conflictPolicies.registries,omitempty" json:"conflictPolicies.registries,omitempty"`
+
+	// ConflictPoliciesArtifacts Conflict policy for artifacts.
+	ConflictPoliciesArtifacts *CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts `form:"conflictPolicies.artifacts,omitempty" json:"conflictPolicies.artifacts,omitempty"`
+
+	// ConflictPoliciesApplications Conflict policy for applications.
+	ConflictPoliciesApplications *CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications `form:"conflictPolicies.applications,omitempty" json:"conflictPolicies.applications,omitempty"`
+
+	// ConflictPoliciesDeploymentPackages Conflict policy for deployment packages.
+	ConflictPoliciesDeploymentPackages *CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages `form:"conflictPolicies.deploymentPackages,omitempty" json:"conflictPolicies.deploymentPackages,omitempty"`
+}
+
+// CatalogServiceUploadCatalogEntitiesParamsConflictPolicy defines parameters for CatalogServiceUploadCatalogEntities.
+type CatalogServiceUploadCatalogEntitiesParamsConflictPolicy string
+
+// CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries defines parameters for CatalogServiceUploadCatalogEntities.
+type CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesRegistries string
+
+// CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts defines parameters for CatalogServiceUploadCatalogEntities.
+type CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesArtifacts string
+
+// CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications defines parameters for CatalogServiceUploadCatalogEntities.
+type CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesApplications string
+
+// CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages defines parameters for CatalogServiceUploadCatalogEntities.
+type CatalogServiceUploadCatalogEntitiesParamsConflictPoliciesDeploymentPackages string
+
+// CatalogServiceListWebhooksParams defines parameters for CatalogServiceListWebhooks.
+type CatalogServiceListWebhooksParams struct {
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CatalogServiceUpdateWebhookParams defines parameters for CatalogServiceUpdateWebhook.
+type CatalogServiceUpdateWebhookParams struct {
+	// UpdateMask Fields of the webhook to update, e.g. "description". If not set, the webhook is replaced as a whole.
+	UpdateMask *string `form:"updateMask,omitempty" json:"updateMask,omitempty"`
+}
+
+// CatalogServiceListWebhookDeliveriesParams defines parameters for CatalogServiceListWebhookDeliveries.
+type CatalogServiceListWebhookDeliveriesParams struct {
+	// State Return only the deliveries in the given state, i.e. pending, delivered, or failed.
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// PageSize Maximum number of items to return.
+	PageSize *int32 `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Offset Index of the first item to return.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // CatalogServiceCreateApplicationJSONRequestBody defines body for CatalogServiceCreateApplication for application/json ContentType.
@@ -643,5 +1454,14 @@ type CatalogServiceCreateRegistryJSONRequestBody = Registry
 // CatalogServiceUpdateRegistryJSONRequestBody defines body for CatalogServiceUpdateRegistry for application/json ContentType.
 type CatalogServiceUpdateRegistryJSONRequestBody = Registry
 
+// CatalogServiceTestRegistryConnectionJSONRequestBody defines body for CatalogServiceTestRegistryConnection for application/json ContentType.
+type CatalogServiceTestRegistryConnectionJSONRequestBody = Registry
+
 // CatalogServiceUploadCatalogEntitiesJSONRequestBody defines body for CatalogServiceUploadCatalogEntities for application/json ContentType.
 type CatalogServiceUploadCatalogEntitiesJSONRequestBody = Upload
+
+// CatalogServiceCreateWebhookJSONRequestBody defines body for CatalogServiceCreateWebhook for application/json ContentType.
+type CatalogServiceCreateWebhookJSONRequestBody = Webhook
+
+// CatalogServiceUpdateWebhookJSONRequestBody defines body for CatalogServiceUpdateWebhook for application/json ContentType.
+type CatalogServiceUpdateWebhookJSONRequestBody = Webhook
@@ -147,6 +147,8 @@ $defs:
       defaultProfile:
         description: Name of the profile to be used by default when deploying this application. If at least one profile is available, this field must be set.
         type: string
+      deprecation:
+        $ref: '#/$defs/Deprecation'
       description:
         description: Description of the application. Displayed on user interfaces.
         maxLength: 1000
@@ -155,6 +157,10 @@ $defs:
         description: Display name is an optional human-readable name for the application. When specified, it must be unique among all applications within a project. It is used for display purposes on user interfaces.
         maxLength: 40
         type: string
+      etag:
+        description: Opaque tag of the current state of the application; changes whenever the application is updated. May be given to the update and delete requests to make sure that the application was not changed in the meantime.
+        readOnly: true
+        type: string
       helmRegistry:
         description: ID of the project's registry where the Helm chart of the application is available for download.
         type: string
@@ -174,6 +180,11 @@ $defs:
           - addon
         format: enum
         type: string
+      labels:
+        additionalProperties:
+          type: string
+        description: Optional labels of the application, such as team=vision or tier=certified, by which the applications can be selected. Keys and values follow the syntax of Kubernetes\* labels.
+        type: object
       name:
         description: Name is a human readable unique identifier for the application and must be unique for all applications of a given project. Used in network URIs.
         maxLength: 26
@@ -231,10 +242,10 @@ $defs:
         pattern: ^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$
         type: string
       version:
-        description: Version of the referenced application.
-        maxLength: 20
+        description: 'Version of the referenced application; either an exact version or a constraint resolved to the highest matching release: latest, ~1.4 for any 1.4.x or ^2.0 for any 2.x.'
+        maxLength: 21
         minLength: 1
-        pattern: ^[a-z0-9][a-z0-9-.]{0,18}[a-z0-9]{0,1}$
+        pattern: ^[~^]?[a-z0-9][a-z0-9-.]{0,18}[a-z0-9]{0,1}$
         type: string
     required:
       - name
@@ -258,6 +269,10 @@ $defs:
         description: Display name is an optional human-readable name for the artifact. When specified, it must be unique among all artifacts within a project. It is used for display purposes on user interfaces.
         maxLength: 40
         type: string
+      etag:
+        description: Opaque tag of the current state of the artifact; changes whenever the artifact is updated. May be given to the update and delete requests to make sure that the artifact was not changed in the meantime.
+        readOnly: true
+        type: string
       mimeType:
         description: Artifact's MIME type. Only text/plain, application/json, application/yaml, image/png, and image/jpeg are allowed at this time. MIME types are defined and standardized in IETF's RFC 6838.
         maxLength: 40
@@ -332,6 +347,8 @@ $defs:
         items:
           $ref: '#/$defs/DeploymentProfile'
         type: array
+      deprecation:
+        $ref: '#/$defs/Deprecation'
       description:
         description: Description of the deployment package. Displayed on user interfaces.
         maxLength: 1000
@@ -340,6 +357,10 @@ $defs:
         description: Display name is an optional human-readable name for the deployment package. When specified, it must be unique among all packages within a project. It is used for display purposes on user interfaces.
         maxLength: 40
         type: string
+      etag:
+        description: Opaque tag of the current state of the deployment package; changes whenever the deployment package is updated. May be given to the update and delete requests to make sure that the deployment package was not changed in the meantime.
+        readOnly: true
+        type: string
       extensions:
         description: Optional list of API and UI extensions.
         items:
@@ -363,6 +384,11 @@ $defs:
           - addon
         format: enum
         type: string
+      labels:
+        additionalProperties:
+          type: string
+        description: Optional labels of the deployment package, such as team=vision or tier=certified, by which the deployment packages can be selected. Keys and values follow the syntax of Kubernetes\* labels.
+        type: object
       name:
         description: Name is a human-readable unique identifier for the deployment package and must be unique for all packages of a given project.
         maxLength: 40
@@ -445,15 +471,33 @@ $defs:
         pattern: ^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$
         type: string
       version:
-        description: Version of the required deployment package.
-        maxLength: 20
+        description: 'Version of the required deployment package; either an exact version or a constraint resolved to the highest matching release: latest, ~1.4 for any 1.4.x or ^2.0 for any 2.x.'
+        maxLength: 21
         minLength: 1
-        pattern: ^[a-z0-9][a-z0-9-.]{0,18}[a-z0-9]{0,1}$
+        pattern: ^[~^]?[a-z0-9][a-z0-9-.]{0,18}[a-z0-9]{0,1}$
         type: string
     required:
       - name
       - version
     type: object
+  Deprecation:
+    description: Deprecation describes why an application or deployment package version is deprecated and which version to use instead.
+    properties:
+      deprecateTime:
+        description: The time at which the version was deprecated.
+        format: date-time
+        readOnly: true
+        type: string
+      message:
+        description: Message explaining why the version is deprecated. Displayed on user interfaces.
+        maxLength: 1000
+        type: string
+      replacementVersion:
+        description: Version of the same application or deployment package suggested to be used in place of the deprecated one.
+        maxLength: 20
+        pattern: ^([a-z0-9][a-z0-9-.]{0,18}[a-z0-9]{0,1}){0,1}$
+        type: string
+    type: object
   Endpoint:
     description: Endpoint represents an application service endpoint.
     properties:
@@ -604,7 +648,7 @@ $defs:
     description: Registry represents a repository from which various artifacts, such as application Docker\* images or Helm\* charts can be retrieved. As such, the registry entity holds information used for finding and accessing the represented repository.
     properties:
       apiType:
-        description: Optional type of the API used to obtain inventory of the articles hosted by the registry.
+        description: 'Optional type of the API used to obtain inventory of the articles hosted by the registry: harbor for the Harbor\* API, oci for the OCI distribution API, or helm for the index of a classic Helm repository. If not set, the Harbor API is used if the inventory URL refers to a Harbor project.'
         maxLength: 16
         pattern: ^\PC*$
         type: string
@@ -629,10 +673,19 @@ $defs:
         description: Display name is an optional human-readable name for the registry. When specified, it must be unique among all registries within a project. It is used for display purposes on user interfaces.
         maxLength: 40
         type: string
+      etag:
+        description: Opaque tag of the current state of the registry; changes whenever the registry is updated. May be given to the update and delete requests to make sure that the registry was not changed in the meantime.
+        readOnly: true
+        type: string
       inventoryUrl:
         description: Optional URL of the API for accessing inventory of artifacts hosted by the registry.
         maxLength: 1000
         type: string
+      labels:
+        additionalProperties:
+          type: string
+        description: Optional labels of the registry, such as team=vision or tier=certified, by which the registries can be selected. Keys and values follow the syntax of Kubernetes\* labels.
+        type: object
       name:
         description: Name is a human-readable unique identifier for the registry and must be unique for all registries of a given project.
         maxLength: 40
@@ -147,9 +147,13 @@ func isNotRequiredField(name string, field string) bool {
 	return name == "DeploymentPackage" && (field == "artifacts" || field == "extensions")
 }
 
-// Returns true if the given named node is relevant to the YAML schema.
+// Returns true if the given named node is relevant to the YAML schema; webhooks, audit events, revisions,
+// resolutions, search results, deleted entities and chart versions cannot be uploaded.
 func isRelevant(name string) bool {
-	return !strings.HasSuffix(name, "Request") && !strings.HasSuffix(name, "Response") && name != "Upload"
+	return !strings.HasSuffix(name, "Request") && !strings.HasSuffix(name, "Response") &&
+		!strings.HasPrefix(name, "Upload") && !strings.HasPrefix(name, "Webhook") && !strings.HasPrefix(name, "Audit") &&
+		!strings.HasPrefix(name, "Resolved") && !strings.HasPrefix(name, "Search") && name != "Revision" &&
+		name != "DeletedEntity" && name != "ChartVersion"
 }
 
 // Loads the specified YAML file as raw structure.
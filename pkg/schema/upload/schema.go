@@ -127,6 +127,12 @@ type Namespace struct {
 	Annotations map[string]string `yaml:"annotations,omitempty"`
 }
 
+// Deprecation is a structure for loading the deprecation state of an application or deployment package version.
+type Deprecation struct {
+	Message            string `yaml:"message,omitempty"`
+	ReplacementVersion string `yaml:"replacementVersion,omitempty"`
+}
+
 // YamlSpec is a main structure for loading application catalog entities.
 type YamlSpec struct {
 	FileName                   string                  `yaml:"filename,omitempty"`
@@ -136,6 +142,8 @@ type YamlSpec struct {
 	DisplayName                string                  `yaml:"displayName,omitempty"`
 	Version                    string                  `yaml:"version,omitempty"`
 	Kind                       string                  `yaml:"kind,omitempty"`
+	Labels                     map[string]string       `yaml:"labels,omitempty"`
+	Deprecation                *Deprecation            `yaml:"deprecation,omitempty"`
 	Description                string                  `yaml:"description,omitempty"`
 	HelmRegistry               string                  `yaml:"helmRegistry,omitempty"`
 	Type                       string                  `yaml:"type,omitempty"`
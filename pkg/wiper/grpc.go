@@ -6,6 +6,7 @@ package wiper
 
 import (
 	"context"
+	nberrors "github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
 	catalogv3 "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	"google.golang.org/grpc/metadata"
@@ -35,7 +36,8 @@ func withActiveProjectID(ctx context.Context, projectUUID string) context.Contex
 	return metadata.AppendToOutgoingContext(ctx, "activeprojectid", projectUUID)
 }
 
-// Wipe deletes all entities (packages, apps, registries, and artifacts) for the given project.
+// Wipe deletes all entities (packages, apps, registries, and artifacts) for the given project, and purges them from
+// the trash.
 func (w *grpcWiper) Wipe(ctx context.Context, projectUUID string) []error {
 	var errors []error
 	pctx := withActiveProjectID(ctx, projectUUID)
@@ -46,6 +48,7 @@ func (w *grpcWiper) Wipe(ctx context.Context, projectUUID string) []error {
 	errors = append(errors, w.wipeApplications(pctx)...)
 	errors = append(errors, w.wipeArtifacts(pctx)...)
 	errors = append(errors, w.wipeRegistries(pctx)...)
+	errors = append(errors, w.wipeTrash(pctx)...)
 	return errors
 }
 
@@ -188,3 +191,38 @@ func (w *grpcWiper) wipeRegistries(ctx context.Context) []error {
 	}
 	return errors
 }
+
+func (w *grpcWiper) wipeTrash(ctx context.Context) []error {
+	var errors []error
+	hasMorePages := true
+	for hasMorePages {
+		resp, err := w.client.ListDeleted(ctx, &catalogv3.ListDeletedRequest{PageSize: maxPageSize})
+		if err != nil {
+			return append(errors, err)
+		}
+		for _, deleted := range resp.DeletedEntities {
+			log.Debugf("Purging %s %s %s", deleted.ResourceType, deleted.Name, deleted.Version)
+			switch nberrors.ResourceType(deleted.ResourceType) {
+			case nberrors.RegistryType:
+				_, err = w.client.PurgeRegistry(ctx, &catalogv3.PurgeRegistryRequest{RegistryName: deleted.Name})
+			case nberrors.ApplicationType:
+				_, err = w.client.PurgeApplication(ctx, &catalogv3.PurgeApplicationRequest{
+					ApplicationName: deleted.Name,
+					Version:         deleted.Version,
+				})
+			default:
+				_, err = w.client.PurgeDeploymentPackage(ctx, &catalogv3.PurgeDeploymentPackageRequest{
+					DeploymentPackageName: deleted.Name,
+					Version:               deleted.Version,
+				})
+			}
+			if err != nil {
+				// Stop rather than list the same entities again
+				return append(errors, err)
+			}
+		}
+		hasMorePages = resp.TotalElements > int32(len(resp.DeletedEntities))
+		log.Debugf("Total elements: %d, len: %d hasMorePages: %t", resp.TotalElements, len(resp.DeletedEntities), hasMorePages)
+	}
+	return errors
+}
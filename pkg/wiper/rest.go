@@ -7,6 +7,7 @@ package wiper
 import (
 	"context"
 	"github.com/open-edge-platform/app-orch-catalog/internal/northbound"
+	nberrors "github.com/open-edge-platform/app-orch-catalog/internal/northbound/errors"
 	restapi "github.com/open-edge-platform/app-orch-catalog/pkg/restClient"
 	"net/http"
 )
@@ -21,7 +22,8 @@ func NewRESTWiper(client restapi.ClientWithResponses, reqEditors ...restapi.Requ
 	return &restWiper{client: client, reqEditors: reqEditors}
 }
 
-// Wipe deletes all entities (packages, apps, registries, and artifacts) for the given project.
+// Wipe deletes all entities (packages, apps, registries, and artifacts) for the given project, and purges them from
+// the trash.
 func (w *restWiper) Wipe(ctx context.Context, projectUUID string) []error {
 	var errors []error
 	pctx := withActiveProjectID(ctx, projectUUID)
@@ -33,6 +35,7 @@ func (w *restWiper) Wipe(ctx context.Context, projectUUID string) []error {
 	errors = append(errors, w.wipeApplications(pctx)...)
 	errors = append(errors, w.wipeArtifacts(pctx)...)
 	errors = append(errors, w.wipeRegistries(pctx)...)
+	errors = append(errors, w.wipeTrash(pctx)...)
 	return errors
 }
 
@@ -78,7 +81,7 @@ func (w *restWiper) preparePackageForDeletion(ctx context.Context, name string,
 	pkg.DefaultNamespaces = nil
 	pkg.DefaultProfileName = nil
 
-	if _, err = w.client.CatalogServiceUpdateDeploymentPackageWithResponse(ctx, name, version, pkg, w.reqEditors...); err != nil {
+	if _, err = w.client.CatalogServiceUpdateDeploymentPackageWithResponse(ctx, name, version, nil, pkg, w.reqEditors...); err != nil {
 		return err
 	}
 	return nil
@@ -87,10 +90,10 @@ func (w *restWiper) preparePackageForDeletion(ctx context.Context, name string,
 // Sweeps through all applications, severing their dependencies on any deployment packages
 func (w *restWiper) prepareApplicationsForDeletion(ctx context.Context) []error {
 	var errors []error
-	offset := int32(0)
+	var pageToken *string
 	hasMorePages := true
 	for hasMorePages {
-		resp, err := w.client.CatalogServiceListApplicationsWithResponse(ctx, &restapi.CatalogServiceListApplicationsParams{PageSize: &maxPageSize, Offset: &offset}, w.reqEditors...)
+		resp, err := w.client.CatalogServiceListApplicationsWithResponse(ctx, &restapi.CatalogServiceListApplicationsParams{PageSize: &maxPageSize, PageToken: pageToken}, w.reqEditors...)
 		if resp.StatusCode() != http.StatusOK {
 			return nil
 		}
@@ -103,8 +106,8 @@ func (w *restWiper) prepareApplicationsForDeletion(ctx context.Context) []error
 				errors = append(errors, err)
 			}
 		}
-		hasMorePages = resp.JSON200.TotalElements > offset+int32(len(resp.JSON200.Applications))
-		offset = offset + int32(len(resp.JSON200.Applications))
+		pageToken = resp.JSON200.NextPageToken
+		hasMorePages = pageToken != nil && *pageToken != ""
 	}
 	return errors
 }
@@ -124,7 +127,7 @@ func (w *restWiper) prepareApplicationForDeletion(ctx context.Context, name stri
 	app.Profiles = nil
 	app.DefaultProfileName = nil
 
-	if _, err = w.client.CatalogServiceUpdateApplicationWithResponse(ctx, name, version, app, w.reqEditors...); err != nil {
+	if _, err = w.client.CatalogServiceUpdateApplicationWithResponse(ctx, name, version, nil, app, w.reqEditors...); err != nil {
 		return err
 	}
 	return nil
@@ -141,7 +144,7 @@ func (w *restWiper) wipePackages(ctx context.Context) []error {
 	}
 
 	for _, pkg := range resp.JSON200.DeploymentPackages {
-		if _, err = w.client.CatalogServiceDeleteDeploymentPackageWithResponse(ctx, pkg.Name, pkg.Version, w.reqEditors...); err != nil {
+		if _, err = w.client.CatalogServiceDeleteDeploymentPackageWithResponse(ctx, pkg.Name, pkg.Version, nil, w.reqEditors...); err != nil {
 			errors = append(errors, err)
 		}
 	}
@@ -159,7 +162,7 @@ func (w *restWiper) wipeApplications(ctx context.Context) []error {
 	}
 
 	for _, app := range resp.JSON200.Applications {
-		if _, err = w.client.CatalogServiceDeleteApplicationWithResponse(ctx, app.Name, app.Version, w.reqEditors...); err != nil {
+		if _, err = w.client.CatalogServiceDeleteApplicationWithResponse(ctx, app.Name, app.Version, nil, w.reqEditors...); err != nil {
 			errors = append(errors, err)
 		}
 	}
@@ -177,7 +180,7 @@ func (w *restWiper) wipeArtifacts(ctx context.Context) []error {
 	}
 
 	for _, artifact := range resp.JSON200.Artifacts {
-		if _, err = w.client.CatalogServiceDeleteArtifactWithResponse(ctx, artifact.Name, w.reqEditors...); err != nil {
+		if _, err = w.client.CatalogServiceDeleteArtifactWithResponse(ctx, artifact.Name, nil, w.reqEditors...); err != nil {
 			errors = append(errors, err)
 		}
 	}
@@ -195,9 +198,44 @@ func (w *restWiper) wipeRegistries(ctx context.Context) []error {
 	}
 
 	for _, registry := range resp.JSON200.Registries {
-		if _, err = w.client.CatalogServiceDeleteRegistryWithResponse(ctx, registry.Name, w.reqEditors...); err != nil {
+		if _, err = w.client.CatalogServiceDeleteRegistryWithResponse(ctx, registry.Name, nil, w.reqEditors...); err != nil {
 			errors = append(errors, err)
 		}
 	}
 	return errors
 }
+
+func (w *restWiper) wipeTrash(ctx context.Context) []error {
+	var errors []error
+	resp, err := w.client.CatalogServiceListDeletedWithResponse(ctx, &restapi.CatalogServiceListDeletedParams{PageSize: &maxPageSize}, w.reqEditors...)
+	if err != nil {
+		return append(errors, err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil
+	}
+
+	for _, deleted := range resp.JSON200.DeletedEntities {
+		name, version := valueOf(deleted.Name), valueOf(deleted.Version)
+		switch nberrors.ResourceType(valueOf(deleted.ResourceType)) {
+		case nberrors.RegistryType:
+			_, err = w.client.CatalogServicePurgeRegistryWithResponse(ctx, name, w.reqEditors...)
+		case nberrors.ApplicationType:
+			_, err = w.client.CatalogServicePurgeApplicationWithResponse(ctx, name, version, w.reqEditors...)
+		default:
+			_, err = w.client.CatalogServicePurgeDeploymentPackageWithResponse(ctx, name, version, w.reqEditors...)
+		}
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return errors
+}
+
+// Returns the value of the given optional string; empty if absent.
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}